@@ -2,6 +2,8 @@ package cache
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -12,6 +14,14 @@ import (
 	"github.com/ethpandaops/dora/utils"
 )
 
+// redisUnlockScript deletes a lock key only if it is still held by the given token,
+// so an expired lock that has been taken over by another replica isn't released.
+var redisUnlockScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0`)
+
 type RedisCache struct {
 	redisRemoteCache *redis.Client
 	keyPrefix        string
@@ -119,3 +129,27 @@ func (cache *RedisCache) Get(ctx context.Context, key string, returnValue interf
 
 	return returnValue, nil
 }
+
+// TryLock tries to acquire the lock with the given key, the lock expires after ttl if not released before.
+// returns the lock token required to release the lock, or an empty token if the lock is held by someone else.
+func (cache *RedisCache) TryLock(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	tokenBytes := make([]byte, 16)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(tokenBytes)
+
+	acquired, err := cache.redisRemoteCache.SetNX(ctx, fmt.Sprintf("%s%s", cache.keyPrefix, key), token, ttl).Result()
+	if err != nil {
+		return "", err
+	}
+	if !acquired {
+		return "", nil
+	}
+	return token, nil
+}
+
+// Unlock releases the lock with the given key if it is still held with the given token.
+func (cache *RedisCache) Unlock(ctx context.Context, key string, token string) error {
+	return redisUnlockScript.Run(ctx, cache.redisRemoteCache, []string{fmt.Sprintf("%s%s", cache.keyPrefix, key)}, token).Err()
+}
@@ -38,6 +38,9 @@ type RemoteCache interface {
 	GetString(ctx context.Context, key string) (string, error)
 	GetUint64(ctx context.Context, key string) (uint64, error)
 	GetBool(ctx context.Context, key string) (bool, error)
+
+	TryLock(ctx context.Context, key string, ttl time.Duration) (string, error)
+	Unlock(ctx context.Context, key string, token string) error
 }
 
 func NewTieredCache(cacheSize int, redisAddress string, redisPrefix string) (*TieredCache, error) {
@@ -127,3 +130,36 @@ func (cache *TieredCache) Get(key string, returnValue interface{}) (interface{},
 	}
 	return returnValue, nil
 }
+
+// HasRemoteCache returns true if the tiered cache is backed by a remote cache shared with other instances.
+func (cache *TieredCache) HasRemoteCache() bool {
+	return cache.remoteCache != nil
+}
+
+// TryLock tries to acquire a lock on the remote cache, which is shared with other instances using the same remote cache.
+// returns a function to release the lock if the lock has been acquired. without remote cache the lock is always acquired.
+func (cache *TieredCache) TryLock(key string, ttl time.Duration) (func(), bool, error) {
+	if cache.remoteCache == nil {
+		return func() {}, true, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	token, err := cache.remoteCache.TryLock(ctx, key, ttl)
+	if err != nil {
+		return nil, false, err
+	}
+	if token == "" {
+		return nil, false, nil
+	}
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		if err := cache.remoteCache.Unlock(ctx, key, token); err != nil {
+			logrus.WithError(err).Warnf("error releasing remote cache lock %v", key)
+		}
+	}, true, nil
+}
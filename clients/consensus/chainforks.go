@@ -0,0 +1,148 @@
+package consensus
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ChainFork represents a consensus fork scheduled in the chain specs.
+type ChainFork struct {
+	Name    string
+	Version phase0.Version
+	Epoch   phase0.Epoch
+}
+
+// spec key prefixes of the forks known to ChainSpec, all other *_FORK_EPOCH specs are treated as custom (devnet) forks
+var knownForkSpecPrefixes = map[string]bool{
+	"GENESIS":   true,
+	"ALTAIR":    true,
+	"BELLATRIX": true,
+	"CAPELLA":   true,
+	"DENEB":     true,
+	"ELECTRA":   true,
+	"FULU":      true,
+	"EIP7594":   true,
+}
+
+// parseCustomForks collects the scheduled forks from the raw chain specs that are not known to ChainSpec.
+func parseCustomForks(specValues map[string]interface{}) []*ChainFork {
+	forks := []*ChainFork{}
+
+	for key, value := range specValues {
+		prefix, isForkEpoch := strings.CutSuffix(key, "_FORK_EPOCH")
+		if !isForkEpoch || knownForkSpecPrefixes[prefix] {
+			continue
+		}
+
+		epoch, err := strconv.ParseUint(fmt.Sprintf("%v", value), 10, 64)
+		if err != nil || epoch == math.MaxUint64 {
+			continue
+		}
+
+		fork := &ChainFork{
+			Name:  strings.ToLower(prefix),
+			Epoch: phase0.Epoch(epoch),
+		}
+		if versionStr, ok := specValues[prefix+"_FORK_VERSION"].(string); ok {
+			versionBytes, err := hex.DecodeString(strings.TrimPrefix(versionStr, "0x"))
+			if err == nil && len(versionBytes) == len(fork.Version) {
+				copy(fork.Version[:], versionBytes)
+			}
+		}
+
+		forks = append(forks, fork)
+	}
+
+	sort.Slice(forks, func(a, b int) bool {
+		if forks[a].Epoch != forks[b].Epoch {
+			return forks[a].Epoch < forks[b].Epoch
+		}
+		return forks[a].Name < forks[b].Name
+	})
+
+	return forks
+}
+
+// GetForkSchedule returns all scheduled forks ordered by activation epoch, starting with the genesis fork (phase0).
+// forks activating at the same epoch are returned in the order of the consensus specs.
+func (cs *ChainState) GetForkSchedule() []*ChainFork {
+	cs.specMutex.RLock()
+	defer cs.specMutex.RUnlock()
+
+	if cs.specs == nil {
+		return []*ChainFork{}
+	}
+
+	forks := []*ChainFork{
+		{Name: "phase0", Version: cs.specs.GenesisForkVersion, Epoch: 0},
+	}
+	knownForks := []struct {
+		name    string
+		epoch   *uint64
+		version phase0.Version
+	}{
+		{"altair", cs.specs.AltairForkEpoch, cs.specs.AltairForkVersion},
+		{"bellatrix", cs.specs.BellatrixForkEpoch, cs.specs.BellatrixForkVersion},
+		{"capella", cs.specs.CapellaForkEpoch, cs.specs.CapellaForkVersion},
+		{"deneb", cs.specs.DenebForkEpoch, cs.specs.DenebForkVersion},
+		{"electra", cs.specs.ElectraForkEpoch, cs.specs.ElectraForkVersion},
+		{"eip7594", cs.specs.Eip7594ForkEpoch, cs.specs.Eip7594ForkVersion},
+		{"fulu", cs.specs.FuluForkEpoch, cs.specs.FuluForkVersion},
+	}
+	for _, fork := range knownForks {
+		if fork.epoch == nil || *fork.epoch == math.MaxUint64 {
+			continue
+		}
+		forks = append(forks, &ChainFork{
+			Name:    fork.name,
+			Version: fork.version,
+			Epoch:   phase0.Epoch(*fork.epoch),
+		})
+	}
+	forks = append(forks, cs.customForks...)
+
+	sort.SliceStable(forks, func(a, b int) bool {
+		return forks[a].Epoch < forks[b].Epoch
+	})
+
+	return forks
+}
+
+// GetForkAtEpoch returns the latest fork that is active at the given epoch.
+func (cs *ChainState) GetForkAtEpoch(epoch phase0.Epoch) *ChainFork {
+	var activeFork *ChainFork
+	for _, fork := range cs.GetForkSchedule() {
+		if fork.Epoch > epoch {
+			break
+		}
+		activeFork = fork
+	}
+	return activeFork
+}
+
+// GetForkEpochRange returns the first and last epoch during which the fork with the given name is the latest active fork.
+// the last epoch is math.MaxUint64 for the latest scheduled fork. returns false if the fork is unknown or superseded at its activation epoch.
+func (cs *ChainState) GetForkEpochRange(name string) (phase0.Epoch, phase0.Epoch, bool) {
+	forks := cs.GetForkSchedule()
+	for idx, fork := range forks {
+		if fork.Name != name {
+			continue
+		}
+
+		lastEpoch := phase0.Epoch(math.MaxUint64)
+		if idx+1 < len(forks) {
+			if forks[idx+1].Epoch == fork.Epoch {
+				return 0, 0, false
+			}
+			lastEpoch = forks[idx+1].Epoch - 1
+		}
+		return fork.Epoch, lastEpoch, true
+	}
+	return 0, 0, false
+}
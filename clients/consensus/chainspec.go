@@ -36,15 +36,20 @@ type ChainSpec struct {
 	ElectraForkEpoch                   *uint64           `yaml:"ELECTRA_FORK_EPOCH"`
 	Eip7594ForkVersion                 phase0.Version    `yaml:"EIP7594_FORK_VERSION" check-if-fork:"Eip7594ForkEpoch"`
 	Eip7594ForkEpoch                   *uint64           `yaml:"EIP7594_FORK_EPOCH"`
+	FuluForkVersion                    phase0.Version    `yaml:"FULU_FORK_VERSION" check-if-fork:"FuluForkEpoch"`
+	FuluForkEpoch                      *uint64           `yaml:"FULU_FORK_EPOCH"`
 	SecondsPerSlot                     time.Duration     `yaml:"SECONDS_PER_SLOT"`
 	SlotsPerEpoch                      uint64            `yaml:"SLOTS_PER_EPOCH"`
 	EpochsPerHistoricalVector          uint64            `yaml:"EPOCHS_PER_HISTORICAL_VECTOR"`
 	EpochsPerSlashingVector            uint64            `yaml:"EPOCHS_PER_SLASHINGS_VECTOR"`
 	EpochsPerSyncCommitteePeriod       uint64            `yaml:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+	EpochsPerEth1VotingPeriod          uint64            `yaml:"EPOCHS_PER_ETH1_VOTING_PERIOD"`
 	MinSeedLookahead                   uint64            `yaml:"MIN_SEED_LOOKAHEAD"`
 	ShuffleRoundCount                  uint64            `yaml:"SHUFFLE_ROUND_COUNT"`
 	MaxEffectiveBalance                uint64            `yaml:"MAX_EFFECTIVE_BALANCE"`
 	MaxEffectiveBalanceElectra         uint64            `yaml:"MAX_EFFECTIVE_BALANCE_ELECTRA" check-if-fork:"ElectraForkEpoch"`
+	EffectiveBalanceIncrement          uint64            `yaml:"EFFECTIVE_BALANCE_INCREMENT"`
+	BaseRewardFactor                   uint64            `yaml:"BASE_REWARD_FACTOR"`
 	TargetCommitteeSize                uint64            `yaml:"TARGET_COMMITTEE_SIZE"`
 	MaxCommitteesPerSlot               uint64            `yaml:"MAX_COMMITTEES_PER_SLOT"`
 	MinPerEpochChurnLimit              uint64            `yaml:"MIN_PER_EPOCH_CHURN_LIMIT"`
@@ -58,6 +63,11 @@ type ChainSpec struct {
 	MaxWithdrawalRequestsPerPayload    uint64            `yaml:"MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD"    check-if-fork:"ElectraForkEpoch"`
 	DepositChainId                     uint64            `yaml:"DEPOSIT_CHAIN_ID"`
 	MinActivationBalance               uint64            `yaml:"MIN_ACTIVATION_BALANCE"`
+	MaxWithdrawalsPerPayload           uint64            `yaml:"MAX_WITHDRAWALS_PER_PAYLOAD"            check-if-fork:"CapellaForkEpoch"`
+	MaxValidatorsPerWithdrawalsSweep   uint64            `yaml:"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP"   check-if-fork:"CapellaForkEpoch"`
+	PendingDepositsLimit               uint64            `yaml:"PENDING_DEPOSITS_LIMIT"                 check-if-fork:"ElectraForkEpoch"`
+	PendingPartialWithdrawalsLimit     uint64            `yaml:"PENDING_PARTIAL_WITHDRAWALS_LIMIT"      check-if-fork:"ElectraForkEpoch"`
+	PendingConsolidationsLimit         uint64            `yaml:"PENDING_CONSOLIDATIONS_LIMIT"           check-if-fork:"ElectraForkEpoch"`
 
 	// EIP7594: PeerDAS
 	NumberOfColumns              *uint64 `yaml:"NUMBER_OF_COLUMNS"                check-if-fork:"Eip7594ForkEpoch"`
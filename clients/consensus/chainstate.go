@@ -14,11 +14,13 @@ import (
 )
 
 type ChainState struct {
-	specMutex sync.RWMutex
-	specs     *ChainSpec
+	specMutex   sync.RWMutex
+	specs       *ChainSpec
+	customForks []*ChainFork
 
-	genesisMutex sync.Mutex
-	genesis      *v1.Genesis
+	genesisMutex    sync.Mutex
+	genesis         *v1.Genesis
+	mismatchGenesis *v1.Genesis
 
 	wallclockMutex sync.Mutex
 	wallclock      *ethwallclock.EthereumBeaconChain
@@ -41,10 +43,12 @@ func (cs *ChainState) setGenesis(genesis *v1.Genesis) error {
 
 	if cs.genesis != nil {
 		if cs.genesis.GenesisTime != genesis.GenesisTime {
+			cs.mismatchGenesis = genesis
 			return fmt.Errorf("genesis mismatch: GenesisTime")
 		}
 
 		if !bytes.Equal(cs.genesis.GenesisValidatorsRoot[:], genesis.GenesisValidatorsRoot[:]) {
+			cs.mismatchGenesis = genesis
 			return fmt.Errorf("genesis mismatch: GenesisValidatorsRoot")
 		}
 	} else {
@@ -97,6 +101,9 @@ func (cs *ChainState) setClientSpecs(specValues map[string]interface{}) (error,
 	}
 
 	cs.specs = specs
+	if customForks := parseCustomForks(specValues); len(customForks) > 0 || cs.customForks == nil {
+		cs.customForks = customForks
+	}
 
 	return warning, nil
 }
@@ -135,6 +142,14 @@ func (cs *ChainState) setFinalizedCheckpoint(finality *v1.Finality) {
 	cs.checkpointDispatcher.Fire(finality)
 }
 
+// GetMismatchingGenesis returns the last genesis reported by a client that did not match the pool genesis.
+func (cs *ChainState) GetMismatchingGenesis() *v1.Genesis {
+	cs.genesisMutex.Lock()
+	defer cs.genesisMutex.Unlock()
+
+	return cs.mismatchGenesis
+}
+
 func (cs *ChainState) GetSpecs() *ChainSpec {
 	return cs.specs
 }
@@ -240,6 +255,33 @@ func (cs *ChainState) SlotToSlotIndex(slot phase0.Slot) phase0.Slot {
 	return slot % phase0.Slot(cs.specs.SlotsPerEpoch)
 }
 
+// GetForkVersionAtEpoch returns the fork version that is active at the given epoch.
+func (cs *ChainState) GetForkVersionAtEpoch(epoch phase0.Epoch) phase0.Version {
+	if cs.specs == nil {
+		return phase0.Version{}
+	}
+
+	version := cs.specs.GenesisForkVersion
+	forks := []struct {
+		epoch   *uint64
+		version phase0.Version
+	}{
+		{cs.specs.AltairForkEpoch, cs.specs.AltairForkVersion},
+		{cs.specs.BellatrixForkEpoch, cs.specs.BellatrixForkVersion},
+		{cs.specs.CapellaForkEpoch, cs.specs.CapellaForkVersion},
+		{cs.specs.DenebForkEpoch, cs.specs.DenebForkVersion},
+		{cs.specs.ElectraForkEpoch, cs.specs.ElectraForkVersion},
+		{cs.specs.FuluForkEpoch, cs.specs.FuluForkVersion},
+	}
+	for _, fork := range forks {
+		if fork.epoch != nil && uint64(epoch) >= *fork.epoch {
+			version = fork.version
+		}
+	}
+
+	return version
+}
+
 func (cs *ChainState) EpochStartSlot(epoch phase0.Epoch) phase0.Slot {
 	if cs.specs == nil {
 		return 0
@@ -48,10 +48,23 @@ type Client struct {
 	lastFinalityUpdateEpoch phase0.Epoch
 	lastPeerUpdateEpoch     phase0.Epoch
 	lastSyncUpdateEpoch     phase0.Epoch
+	syncMutex               sync.RWMutex
+	syncProgress            *SyncProgress
 	peers                   []*v1.Peer
 	blockDispatcher         Dispatcher[*v1.BlockEvent]
 	headDispatcher          Dispatcher[*v1.HeadEvent]
 	checkpointDispatcher    Dispatcher[*v1.Finality]
+	streamMutex             sync.Mutex
+	streamConnected         bool
+	streamConnectedSince    time.Time
+	streamResubscribes      uint64
+	streamTopics            map[uint16]*StreamTopicHealth
+	latencyMutex            sync.RWMutex
+	headLatencies           []HeadLatencySample
+	featureMutex            sync.RWMutex
+	specValues              map[string]interface{}
+	featureState            *ClientFeatureState
+	featureCheckRunning     bool
 }
 
 func (pool *Pool) newPoolClient(clientIdx uint16, endpoint *ClientConfig) (*Client, error) {
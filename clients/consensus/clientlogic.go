@@ -3,6 +3,7 @@ package consensus
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"runtime/debug"
 	"time"
@@ -14,6 +15,9 @@ import (
 	"github.com/ethpandaops/dora/clients/consensus/rpc"
 )
 
+// ErrClientSynchronizing is returned by the client logic while the beacon node is synchronizing.
+var ErrClientSynchronizing = errors.New("beacon node is synchronizing")
+
 func (client *Client) runClientLoop() {
 	defer func() {
 		if err := recover(); err != nil {
@@ -38,6 +42,18 @@ func (client *Client) runClientLoop() {
 		}
 
 		client.isOnline = false
+
+		if errors.Is(err, ErrClientSynchronizing) {
+			// a syncing node is not faulty, so poll the sync progress without backing off
+			client.retryCounter = 0
+			client.lastError = nil
+
+			err = client.awaitSynchronization()
+			if err == nil {
+				continue
+			}
+		}
+
 		client.lastError = err
 		client.lastEvent = time.Now()
 		client.retryCounter++
@@ -102,6 +118,10 @@ func (client *Client) checkClient() error {
 		client.logger.Warnf("incomplete chain specs: %v", warning)
 	}
 
+	client.featureMutex.Lock()
+	client.specValues = specs
+	client.featureMutex.Unlock()
+
 	// init wallclock
 	client.pool.chainState.initWallclock()
 
@@ -117,6 +137,9 @@ func (client *Client) checkClient() error {
 		return err
 	}
 
+	// probe optional apis in the background
+	client.checkFeatures(false)
+
 	return nil
 }
 
@@ -129,12 +152,24 @@ func (client *Client) runClientLogic() error {
 
 	// check latest header / sync status
 	if client.isSyncing {
-		return fmt.Errorf("beacon node is synchronizing")
+		return ErrClientSynchronizing
 	}
 
 	// start event stream
-	blockStream := client.rpcClient.NewBlockStream(client.clientCtx, client.logger, rpc.StreamBlockEvent|rpc.StreamHeadEvent|rpc.StreamFinalizedEvent)
-	defer blockStream.Close()
+	streamEvents := rpc.StreamBlockEvent | rpc.StreamHeadEvent | rpc.StreamFinalizedEvent | rpc.StreamReorgEvent
+	blockStream := client.rpcClient.NewBlockStream(client.clientCtx, client.logger, streamEvents)
+	defer func() {
+		blockStream.Close()
+		client.setStreamConnected(false)
+	}()
+
+	// check stream health once per slot
+	streamCheckInterval := 12 * time.Second
+	if specs := client.pool.chainState.GetSpecs(); specs != nil {
+		streamCheckInterval = specs.SecondsPerSlot
+	}
+	streamCheckTicker := time.NewTicker(streamCheckInterval)
+	defer streamCheckTicker.Stop()
 
 	// process events
 	client.lastEvent = time.Now()
@@ -171,11 +206,17 @@ func (client *Client) runClientLogic() error {
 				if err != nil {
 					client.logger.Warnf("failed processing finalized event: %v", err)
 				}
+
+			case rpc.StreamReorgEvent:
+				client.processReorgEvent(evt.Data.(*v1.ChainReorgEvent))
 			}
 
+			client.recordStreamEvent(evt.Event)
+
 			client.logger.Tracef("event (%v) processing time: %v ms", evt.Event, time.Since(now).Milliseconds())
 			client.lastEvent = time.Now()
 		case streamStatus := <-blockStream.ReadyChan:
+			client.setStreamConnected(streamStatus.Ready)
 			if client.isOnline != streamStatus.Ready {
 				client.isOnline = streamStatus.Ready
 				if streamStatus.Ready {
@@ -186,6 +227,13 @@ func (client *Client) runClientLogic() error {
 					client.lastError = streamStatus.Error
 				}
 			}
+		case <-streamCheckTicker.C:
+			if client.checkStreamStall() {
+				// resubscribe silently stalled event stream
+				blockStream.Close()
+				client.setStreamConnected(false)
+				blockStream = client.rpcClient.NewBlockStream(client.clientCtx, client.logger, streamEvents)
+			}
 		case <-time.After(eventTimeout):
 			client.logger.Debug("no head event since 30 secs, polling chain head")
 
@@ -209,7 +257,7 @@ func (client *Client) runClientLogic() error {
 			}
 
 			if client.isSyncing {
-				return fmt.Errorf("beacon node is synchronizing")
+				return ErrClientSynchronizing
 			}
 		}
 
@@ -234,7 +282,46 @@ func (client *Client) runClientLogic() error {
 				}
 			}()
 		}
+
+		// re-probe the optional apis from time to time, they might change with client upgrades
+		client.checkFeatures(false)
+	}
+}
+
+// awaitSynchronization polls the synchronization status once per slot until the beacon node is synchronized.
+func (client *Client) awaitSynchronization() error {
+	pollInterval := 12 * time.Second
+	if specs := client.pool.chainState.GetSpecs(); specs != nil {
+		pollInterval = specs.SecondsPerSlot
+	}
+
+	var lastLog time.Time
+	for client.isSyncing {
+		if time.Since(lastLog) >= 1*time.Minute {
+			lastLog = time.Now()
+			if progress := client.GetSyncProgress(); progress != nil {
+				client.logger.WithFields(logrus.Fields{
+					"head":     progress.HeadSlot,
+					"distance": progress.SyncDistance,
+					"speed":    fmt.Sprintf("%.2f slots/s", progress.SlotsPerSecond),
+					"eta":      progress.Eta.Round(time.Second),
+				}).Infof("beacon node is synchronizing (%.2f%%)", progress.Percent())
+			}
+		}
+
+		select {
+		case <-client.clientCtx.Done():
+			return client.clientCtx.Err()
+		case <-time.After(pollInterval):
+		}
+
+		if err := client.updateSynchronizationStatus(client.clientCtx); err != nil {
+			return err
+		}
 	}
+
+	client.logger.Info("beacon node is synchronized")
+	return nil
 }
 
 func (client *Client) updateSynchronizationStatus(ctx context.Context) error {
@@ -254,6 +341,7 @@ func (client *Client) updateSynchronizationStatus(ctx context.Context) error {
 	client.isSyncing = syncStatus.IsSyncing
 	client.isOptimistic = syncStatus.IsOptimistic
 	client.lastSyncUpdateEpoch = client.pool.chainState.CurrentEpoch()
+	client.updateSyncProgress(syncStatus)
 
 	return nil
 }
@@ -323,6 +411,7 @@ func (client *Client) processHeadEvent(evt *v1.HeadEvent) error {
 	client.headRoot = evt.Block
 	client.headMutex.Unlock()
 
+	client.recordHeadLatency(evt.Slot)
 	client.headDispatcher.Fire(evt)
 
 	//client.logger.Infof("HEAD: %v %v %v", evt.Slot, evt.Block.String(), evt.EpochTransition)
@@ -350,6 +439,10 @@ func (client *Client) processFinalizedEvent(evt *v1.FinalizedCheckpointEvent) er
 	return nil
 }
 
+func (client *Client) processReorgEvent(evt *v1.ChainReorgEvent) {
+	client.logger.Debugf("chain reorg event: slot %v, depth %v, old head 0x%x, new head 0x%x", evt.Slot, evt.Depth, evt.OldHeadBlock, evt.NewHeadBlock)
+}
+
 func (client *Client) pollClientHead() error {
 	ctx, cancel := context.WithTimeout(client.clientCtx, 10*time.Second)
 	defer cancel()
@@ -0,0 +1,224 @@
+package consensus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mashingan/smapping"
+)
+
+// clientFeatureCheckInterval is the time after which the optional apis of a client are probed again.
+const clientFeatureCheckInterval = 6 * time.Hour
+
+// ClientFeatureProbe describes an optional beacon api that is probed on each client.
+type ClientFeatureProbe struct {
+	Name     string
+	Title    string
+	Method   string
+	Path     string
+	Body     string
+	Degrades string // dora functionality that is degraded if the api is not supported
+}
+
+// ClientFeatureProbes are the optional apis probed on each client.
+var ClientFeatureProbes = []*ClientFeatureProbe{
+	{
+		Name:     "debug",
+		Title:    "Debug API",
+		Method:   http.MethodGet,
+		Path:     "/eth/v2/debug/beacon/heads",
+		Degrades: "epoch duties & validator set (beacon states are loaded via the debug api)",
+	},
+	{
+		Name:     "validators_post",
+		Title:    "Validators (POST)",
+		Method:   http.MethodPost,
+		Path:     "/eth/v1/beacon/states/head/validators",
+		Body:     `{"ids":["0"]}`,
+		Degrades: "fast validator lookups (falls back to slower GET requests)",
+	},
+	{
+		Name:     "blob_sidecars",
+		Title:    "Blob Sidecars",
+		Method:   http.MethodGet,
+		Path:     "/eth/v1/beacon/blob_sidecars/head",
+		Degrades: "blob details on the slot page",
+	},
+	{
+		Name:     "rewards_blocks",
+		Title:    "Block Rewards",
+		Method:   http.MethodGet,
+		Path:     "/eth/v1/beacon/rewards/blocks/head",
+		Degrades: "block reward breakdowns",
+	},
+	{
+		Name:     "rewards_sync",
+		Title:    "Sync Rewards",
+		Method:   http.MethodPost,
+		Path:     "/eth/v1/beacon/rewards/sync_committee/head",
+		Body:     `[]`,
+		Degrades: "sync committee reward breakdowns",
+	},
+	{
+		Name:     "light_client",
+		Title:    "Light Client",
+		Method:   http.MethodGet,
+		Path:     "/eth/v1/beacon/light_client/finality_update",
+		Degrades: "light client data for external tooling",
+	},
+	{
+		Name:     "sync_committees",
+		Title:    "Sync Committees",
+		Method:   http.MethodGet,
+		Path:     "/eth/v1/beacon/states/head/sync_committees",
+		Degrades: "sync committee lookups",
+	},
+	{
+		Name:     "peers",
+		Title:    "Node Peers",
+		Method:   http.MethodGet,
+		Path:     "/eth/v1/node/peers",
+		Degrades: "peer lists & network graph on the clients pages",
+	},
+	{
+		Name:     "identity",
+		Title:    "Node Identity",
+		Method:   http.MethodGet,
+		Path:     "/eth/v1/node/identity",
+		Degrades: "peer id & enr on the clients pages",
+	},
+}
+
+type ClientFeatureSupport uint8
+
+const (
+	ClientFeatureUnknown     ClientFeatureSupport = 0 // probe failed (timeout, server error)
+	ClientFeatureSupported   ClientFeatureSupport = 1
+	ClientFeatureUnsupported ClientFeatureSupport = 2
+)
+
+// ClientFeatureResult is the result of a single api probe.
+type ClientFeatureResult struct {
+	Support    ClientFeatureSupport
+	StatusCode int
+	Error      string
+}
+
+// ClientFeatureState holds the results of the latest api probes of a client.
+type ClientFeatureState struct {
+	CheckedAt    time.Time
+	Version      string
+	Features     map[string]*ClientFeatureResult
+	PresetBase   string
+	ConfigName   string
+	SpecCount    int
+	SpecMissing  []string // spec values known to dora, but not returned by the client
+	SpecCheckErr string
+}
+
+// GetFeatureState returns the results of the latest api probes, nil if the client has not been probed yet.
+func (client *Client) GetFeatureState() *ClientFeatureState {
+	client.featureMutex.RLock()
+	defer client.featureMutex.RUnlock()
+
+	return client.featureState
+}
+
+// checkFeatures starts probing the optional apis in the background, if the last probe is outdated or force is set.
+func (client *Client) checkFeatures(force bool) {
+	client.featureMutex.Lock()
+	defer client.featureMutex.Unlock()
+
+	if client.featureCheckRunning {
+		return
+	}
+	if !force && client.featureState != nil && time.Since(client.featureState.CheckedAt) < clientFeatureCheckInterval {
+		return
+	}
+
+	client.featureCheckRunning = true
+	go func() {
+		defer func() {
+			if err := recover(); err != nil {
+				client.logger.Errorf("uncaught panic in clients.consensus.Client.checkFeatures subroutine: %v", err)
+			}
+
+			client.featureMutex.Lock()
+			client.featureCheckRunning = false
+			client.featureMutex.Unlock()
+		}()
+
+		state := client.probeFeatures()
+
+		client.featureMutex.Lock()
+		client.featureState = state
+		client.featureMutex.Unlock()
+	}()
+}
+
+func (client *Client) probeFeatures() *ClientFeatureState {
+	state := &ClientFeatureState{
+		CheckedAt: time.Now(),
+		Version:   client.versionStr,
+		Features:  map[string]*ClientFeatureResult{},
+	}
+
+	for _, probe := range ClientFeatureProbes {
+		ctx, cancel := context.WithTimeout(client.clientCtx, 30*time.Second)
+
+		var body []byte
+		if probe.Body != "" {
+			body = []byte(probe.Body)
+		}
+
+		result := &ClientFeatureResult{}
+		statusCode, err := client.rpcClient.ProbeEndpoint(ctx, probe.Method, probe.Path, body)
+		cancel()
+
+		result.StatusCode = statusCode
+		switch {
+		case err != nil:
+			result.Support = ClientFeatureUnknown
+			result.Error = err.Error()
+		case statusCode >= 200 && statusCode < 300:
+			result.Support = ClientFeatureSupported
+		case statusCode == http.StatusNotFound || statusCode == http.StatusMethodNotAllowed || statusCode == http.StatusNotImplemented:
+			result.Support = ClientFeatureUnsupported
+		default:
+			result.Support = ClientFeatureUnknown
+			result.Error = fmt.Sprintf("unexpected status %v", statusCode)
+		}
+
+		state.Features[probe.Name] = result
+	}
+
+	// compare the spec values returned by the client with the spec values known to dora
+	client.featureMutex.RLock()
+	specValues := client.specValues
+	client.featureMutex.RUnlock()
+
+	if specValues != nil {
+		state.SpecCount = len(specValues)
+		if presetBase, ok := specValues["PRESET_BASE"].(string); ok {
+			state.PresetBase = presetBase
+		}
+		if configName, ok := specValues["CONFIG_NAME"].(string); ok {
+			state.ConfigName = configName
+		}
+
+		if poolSpecs := client.pool.chainState.GetSpecs(); poolSpecs != nil {
+			clientSpecs := &ChainSpec{}
+			err := smapping.FillStructByTags(clientSpecs, specValues, "yaml")
+			if err == nil {
+				state.SpecMissing, err = poolSpecs.CheckMismatch(clientSpecs)
+			}
+			if err != nil {
+				state.SpecCheckErr = err.Error()
+			}
+		}
+	}
+
+	return state
+}
@@ -0,0 +1,56 @@
+package consensus
+
+import (
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// number of head latency samples kept per client (~50min with 12s slots)
+const headLatencySampleLimit = 256
+
+// HeadLatencySample holds the time between the slot start and the client reporting the block of the slot as head.
+type HeadLatencySample struct {
+	Slot    phase0.Slot
+	Latency time.Duration
+}
+
+// recordHeadLatency records the import latency for the first head event of a slot.
+// head events for older slots (reorgs, catching up clients) are not a measure of the block import time and are skipped.
+func (client *Client) recordHeadLatency(slot phase0.Slot) {
+	chainState := client.pool.chainState
+	specs := chainState.GetSpecs()
+	if specs == nil {
+		return
+	}
+
+	latency := time.Since(chainState.SlotToTime(slot))
+	if latency < 0 || latency > specs.SecondsPerSlot*2 {
+		return
+	}
+
+	client.latencyMutex.Lock()
+	defer client.latencyMutex.Unlock()
+
+	if sampleCount := len(client.headLatencies); sampleCount > 0 && client.headLatencies[sampleCount-1].Slot >= slot {
+		return
+	}
+
+	client.headLatencies = append(client.headLatencies, HeadLatencySample{
+		Slot:    slot,
+		Latency: latency,
+	})
+	if len(client.headLatencies) > headLatencySampleLimit {
+		client.headLatencies = client.headLatencies[len(client.headLatencies)-headLatencySampleLimit:]
+	}
+}
+
+// GetHeadLatencySamples returns the recorded head latency samples in ascending slot order.
+func (client *Client) GetHeadLatencySamples() []HeadLatencySample {
+	client.latencyMutex.RLock()
+	defer client.latencyMutex.RUnlock()
+
+	samples := make([]HeadLatencySample, len(client.headLatencies))
+	copy(samples, client.headLatencies)
+	return samples
+}
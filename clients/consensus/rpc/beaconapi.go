@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	eth2client "github.com/attestantio/go-eth2-client"
@@ -35,6 +36,8 @@ type BeaconClient struct {
 	disableSSZ bool
 	clientSvc  eth2client.Service
 	logger     logrus.FieldLogger
+
+	validatorsPostUnsupported atomic.Bool
 }
 
 // NewBeaconClient is used to create a new beacon client
@@ -170,6 +173,38 @@ func (bc *BeaconClient) getJSON(ctx context.Context, requrl string, returnValue
 	return nil
 }
 
+// ProbeEndpoint sends a request to the given api path and returns the http status code, the response body is discarded.
+// used to check whether the beacon node supports an optional api.
+func (bc *BeaconClient) ProbeEndpoint(ctx context.Context, method string, path string, body []byte) (int, error) {
+	var reqBody io.Reader = nethttp.NoBody
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := nethttp.NewRequestWithContext(ctx, method, bc.endpoint+path, reqBody)
+	if err != nil {
+		return 0, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for headerKey, headerVal := range bc.headers {
+		req.Header.Set(headerKey, headerVal)
+	}
+
+	client := &nethttp.Client{Timeout: time.Second * 30}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 10*1024*1024))
+
+	return resp.StatusCode, nil
+}
+
 func (bc *BeaconClient) postJSON(ctx context.Context, requrl string, postData, returnValue interface{}) error {
 	logurl := getRedactedURL(requrl)
 
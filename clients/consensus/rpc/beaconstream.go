@@ -20,6 +20,7 @@ const (
 	StreamBlockEvent     uint16 = 0x01
 	StreamHeadEvent      uint16 = 0x02
 	StreamFinalizedEvent uint16 = 0x04
+	StreamReorgEvent     uint16 = 0x08
 )
 
 type BeaconStreamEvent struct {
@@ -87,6 +88,8 @@ func (bs *BeaconStream) startStream() {
 					bs.processHeadEvent(evt)
 				case "finalized_checkpoint":
 					bs.processFinalizedEvent(evt)
+				case "chain_reorg":
+					bs.processReorgEvent(evt)
 				}
 			case <-stream.Ready:
 				bs.ReadyChan <- &BeaconStreamStatus{
@@ -148,6 +151,16 @@ func (bs *BeaconStream) subscribeStream(endpoint string, events uint16) *eventst
 		topicsCount++
 	}
 
+	if events&StreamReorgEvent > 0 {
+		if topicsCount > 0 {
+			fmt.Fprintf(&topics, ",")
+		}
+
+		fmt.Fprintf(&topics, "chain_reorg")
+
+		topicsCount++
+	}
+
 	if topicsCount == 0 {
 		return nil
 	}
@@ -188,10 +201,10 @@ func (bs *BeaconStream) processBlockEvent(evt eventsource.Event) {
 		bs.logger.Warnf("beacon block stream failed to decode block event: %v", err)
 		return
 	}
-	bs.EventChan <- &BeaconStreamEvent{
+	bs.sendEvent(&BeaconStreamEvent{
 		Event: StreamBlockEvent,
 		Data:  &parsed,
-	}
+	})
 }
 
 func (bs *BeaconStream) processHeadEvent(evt eventsource.Event) {
@@ -204,10 +217,10 @@ func (bs *BeaconStream) processHeadEvent(evt eventsource.Event) {
 	}
 
 	bs.lastHeadSeen = time.Now()
-	bs.EventChan <- &BeaconStreamEvent{
+	bs.sendEvent(&BeaconStreamEvent{
 		Event: StreamHeadEvent,
 		Data:  &parsed,
-	}
+	})
 }
 
 func (bs *BeaconStream) processFinalizedEvent(evt eventsource.Event) {
@@ -219,9 +232,32 @@ func (bs *BeaconStream) processFinalizedEvent(evt eventsource.Event) {
 		return
 	}
 
-	bs.EventChan <- &BeaconStreamEvent{
+	bs.sendEvent(&BeaconStreamEvent{
 		Event: StreamFinalizedEvent,
 		Data:  &parsed,
+	})
+}
+
+func (bs *BeaconStream) processReorgEvent(evt eventsource.Event) {
+	var parsed v1.ChainReorgEvent
+
+	err := json.Unmarshal([]byte(evt.Data()), &parsed)
+	if err != nil {
+		bs.logger.Warnf("beacon block stream failed to decode chain_reorg event: %v", err)
+		return
+	}
+
+	bs.sendEvent(&BeaconStreamEvent{
+		Event: StreamReorgEvent,
+		Data:  &parsed,
+	})
+}
+
+// sendEvent forwards a parsed event to the event channel, unless the stream has been closed.
+func (bs *BeaconStream) sendEvent(evt *BeaconStreamEvent) {
+	select {
+	case bs.EventChan <- evt:
+	case <-bs.ctx.Done():
 	}
 }
 
@@ -0,0 +1,73 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AttestationReward holds the attestation rewards & penalties of a validator for an epoch (values in gwei, penalties are negative).
+type AttestationReward struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Head           int64  `json:"head,string"`
+	Target         int64  `json:"target,string"`
+	Source         int64  `json:"source,string"`
+	InclusionDelay int64  `json:"inclusion_delay,string,omitempty"`
+	Inactivity     int64  `json:"inactivity,string"`
+}
+
+// SyncCommitteeReward holds the sync committee reward of a validator for a block (negative for missed participation).
+type SyncCommitteeReward struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Reward         int64  `json:"reward,string"`
+}
+
+// BlockReward holds the consensus layer rewards of the proposer of a block.
+type BlockReward struct {
+	ProposerIndex     uint64 `json:"proposer_index,string"`
+	Total             int64  `json:"total,string"`
+	Attestations      int64  `json:"attestations,string"`
+	SyncAggregate     int64  `json:"sync_aggregate,string"`
+	ProposerSlashings int64  `json:"proposer_slashings,string"`
+	AttesterSlashings int64  `json:"attester_slashings,string"`
+}
+
+// GetAttestationRewards returns the attestation rewards of all validators for the given epoch.
+func (bc *BeaconClient) GetAttestationRewards(ctx context.Context, epoch uint64) ([]*AttestationReward, error) {
+	response := struct {
+		Data struct {
+			TotalRewards []*AttestationReward `json:"total_rewards"`
+		} `json:"data"`
+	}{}
+
+	err := bc.postJSON(ctx, fmt.Sprintf("%s/eth/v1/beacon/rewards/attestations/%v", bc.endpoint, epoch), []string{}, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving attestation rewards: %v", err)
+	}
+	return response.Data.TotalRewards, nil
+}
+
+// GetSyncCommitteeRewards returns the sync committee rewards of all sync committee members for the given block.
+func (bc *BeaconClient) GetSyncCommitteeRewards(ctx context.Context, blockroot []byte) ([]*SyncCommitteeReward, error) {
+	response := struct {
+		Data []*SyncCommitteeReward `json:"data"`
+	}{}
+
+	err := bc.postJSON(ctx, fmt.Sprintf("%s/eth/v1/beacon/rewards/sync_committee/0x%x", bc.endpoint, blockroot), []string{}, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving sync committee rewards: %v", err)
+	}
+	return response.Data, nil
+}
+
+// GetBlockRewards returns the proposer rewards of the given block.
+func (bc *BeaconClient) GetBlockRewards(ctx context.Context, blockroot []byte) (*BlockReward, error) {
+	response := struct {
+		Data *BlockReward `json:"data"`
+	}{}
+
+	err := bc.getJSON(ctx, fmt.Sprintf("%s/eth/v1/beacon/rewards/blocks/0x%x", bc.endpoint, blockroot), &response)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving block rewards: %v", err)
+	}
+	return response.Data, nil
+}
@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// validatorsPostBatchSize is the number of validator indices requested per POST based validator query.
+const validatorsPostBatchSize = 10000
+
+// validatorsGetBatchSize is the number of validator indices requested per GET based validator query.
+// the indices are passed as url parameters, so the batches need to be small enough to stay below common url length limits.
+const validatorsGetBatchSize = 250
+
+type validatorsResponse struct {
+	Data []*v1.Validator `json:"data"`
+}
+
+// GetStateValidators loads the full validator set of the given state via batched validator queries.
+// the validators are requested via POST queries if supported by the client and via paged GET queries otherwise.
+// up to maxParallel batches are requested at the same time, the result is ordered by validator index.
+func (bc *BeaconClient) GetStateValidators(ctx context.Context, stateRef string, maxParallel int) ([]*v1.Validator, error) {
+	if maxParallel < 1 {
+		maxParallel = 1
+	}
+
+	validators := []*v1.Validator{}
+	usePost := !bc.validatorsPostUnsupported.Load()
+	postFailed := false
+
+	for {
+		batchSize := validatorsGetBatchSize
+		if usePost {
+			batchSize = validatorsPostBatchSize
+		}
+
+		startIndex := uint64(len(validators))
+		batches := make([][]*v1.Validator, maxParallel)
+		batchErrs := make([]error, maxParallel)
+		wg := sync.WaitGroup{}
+
+		for i := 0; i < maxParallel; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				batchStart := startIndex + uint64(i*batchSize)
+				batches[i], batchErrs[i] = bc.getValidatorsBatch(ctx, stateRef, batchStart, uint64(batchSize), usePost)
+			}(i)
+		}
+		wg.Wait()
+
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		if usePost && batchErrs[0] != nil {
+			// the client might not support POST based validator queries, continue with paged GET queries
+			bc.logger.Debugf("POST validators query failed, falling back to paged GET queries: %v", batchErrs[0])
+			usePost = false
+			postFailed = true
+			continue
+		}
+
+		complete := false
+		for i := 0; i < maxParallel && !complete; i++ {
+			if batchErrs[i] != nil {
+				return nil, fmt.Errorf("error loading validators %v-%v: %v", startIndex+uint64(i*batchSize), startIndex+uint64((i+1)*batchSize)-1, batchErrs[i])
+			}
+
+			sort.Slice(batches[i], func(a, b int) bool {
+				return batches[i][a].Index < batches[i][b].Index
+			})
+			for _, validator := range batches[i] {
+				if uint64(validator.Index) != uint64(len(validators)) {
+					return nil, fmt.Errorf("unexpected validator index %v in response (expected %v)", validator.Index, len(validators))
+				}
+				validators = append(validators, validator)
+			}
+
+			if len(batches[i]) < batchSize {
+				complete = true
+			}
+		}
+
+		if postFailed {
+			// GET queries succeeded, so skip the POST queries for this client from now on
+			bc.validatorsPostUnsupported.Store(true)
+			postFailed = false
+		}
+
+		if complete {
+			break
+		}
+	}
+
+	return validators, nil
+}
+
+func (bc *BeaconClient) getValidatorsBatch(ctx context.Context, stateRef string, startIndex, count uint64, usePost bool) ([]*v1.Validator, error) {
+	ids := make([]string, count)
+	for i := uint64(0); i < count; i++ {
+		ids[i] = fmt.Sprintf("%d", startIndex+i)
+	}
+
+	response := validatorsResponse{}
+	requrl := fmt.Sprintf("%s/eth/v1/beacon/states/%s/validators", bc.endpoint, stateRef)
+
+	var err error
+	if usePost {
+		err = bc.postJSON(ctx, requrl, map[string]interface{}{"ids": ids}, &response)
+	} else {
+		err = bc.getJSON(ctx, fmt.Sprintf("%s?id=%s", requrl, strings.Join(ids, ",")), &response)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
@@ -0,0 +1,166 @@
+package consensus
+
+import (
+	"time"
+
+	"github.com/ethpandaops/dora/clients/consensus/rpc"
+)
+
+// streamStallSlots is the number of slots a client's stream may lag behind the freshest stream of the other clients
+// before it's considered stalled.
+const streamStallSlots = 2
+
+// StreamTopicHealth holds the event statistics of a single event stream topic.
+type StreamTopicHealth struct {
+	Topic      string
+	EventCount uint64
+	LastEvent  time.Time
+	StallCount uint64
+	LastStall  time.Time
+}
+
+// StreamHealth holds the health state of a client's event stream subscription.
+type StreamHealth struct {
+	Connected      bool
+	ConnectedSince time.Time
+	Resubscribes   uint64
+	Topics         []*StreamTopicHealth
+}
+
+var streamTopics = []uint16{rpc.StreamBlockEvent, rpc.StreamHeadEvent, rpc.StreamFinalizedEvent, rpc.StreamReorgEvent}
+
+var streamTopicNames = map[uint16]string{
+	rpc.StreamBlockEvent:     "block",
+	rpc.StreamHeadEvent:      "head",
+	rpc.StreamFinalizedEvent: "finalized_checkpoint",
+	rpc.StreamReorgEvent:     "chain_reorg",
+}
+
+func (client *Client) getStreamTopic(topic uint16) *StreamTopicHealth {
+	if client.streamTopics == nil {
+		client.streamTopics = map[uint16]*StreamTopicHealth{}
+	}
+
+	topicHealth := client.streamTopics[topic]
+	if topicHealth == nil {
+		topicHealth = &StreamTopicHealth{
+			Topic: streamTopicNames[topic],
+		}
+		client.streamTopics[topic] = topicHealth
+	}
+
+	return topicHealth
+}
+
+func (client *Client) recordStreamEvent(topic uint16) {
+	client.streamMutex.Lock()
+	defer client.streamMutex.Unlock()
+
+	topicHealth := client.getStreamTopic(topic)
+	topicHealth.EventCount++
+	topicHealth.LastEvent = time.Now()
+}
+
+func (client *Client) setStreamConnected(connected bool) {
+	client.streamMutex.Lock()
+	defer client.streamMutex.Unlock()
+
+	if connected && !client.streamConnected {
+		client.streamConnectedSince = time.Now()
+	}
+	client.streamConnected = connected
+}
+
+func (client *Client) getLastStreamEvent(topic uint16) (time.Time, bool) {
+	client.streamMutex.Lock()
+	defer client.streamMutex.Unlock()
+
+	if !client.streamConnected || client.streamTopics[topic] == nil {
+		return time.Time{}, false
+	}
+
+	return client.streamTopics[topic].LastEvent, true
+}
+
+// checkStreamStall compares the last head & finalized events of the client with the other online clients.
+// returns true if the stream stopped delivering events that other clients still receive.
+func (client *Client) checkStreamStall() bool {
+	specs := client.pool.chainState.GetSpecs()
+	if specs == nil {
+		return false
+	}
+
+	now := time.Now()
+	stallThresholds := map[uint16]time.Duration{
+		rpc.StreamHeadEvent:      specs.SecondsPerSlot * streamStallSlots,
+		rpc.StreamFinalizedEvent: specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch+streamStallSlots),
+	}
+
+	// get the freshest event of each topic received by the other clients
+	referenceEvents := map[uint16]time.Time{}
+	for _, otherClient := range client.pool.clients {
+		if otherClient == client || !otherClient.isOnline {
+			continue
+		}
+
+		for topic := range stallThresholds {
+			if lastEvent, ok := otherClient.getLastStreamEvent(topic); ok && lastEvent.After(referenceEvents[topic]) {
+				referenceEvents[topic] = lastEvent
+			}
+		}
+	}
+
+	client.streamMutex.Lock()
+	defer client.streamMutex.Unlock()
+
+	if !client.streamConnected {
+		return false
+	}
+
+	isStalled := false
+	for topic, threshold := range stallThresholds {
+		referenceEvent := referenceEvents[topic]
+		if referenceEvent.IsZero() || now.Sub(referenceEvent) > threshold {
+			continue // other clients didn't receive recent events either
+		}
+
+		topicHealth := client.getStreamTopic(topic)
+		lastEvent := topicHealth.LastEvent
+		if lastEvent.Before(client.streamConnectedSince) {
+			lastEvent = client.streamConnectedSince
+		}
+
+		if referenceEvent.Sub(lastEvent) > threshold {
+			topicHealth.StallCount++
+			topicHealth.LastStall = now
+			client.logger.Warnf("event stream stalled: no %v event since %v, while other clients received one %v ago", topicHealth.Topic, lastEvent.Format(time.RFC3339), now.Sub(referenceEvent).Round(time.Second))
+			isStalled = true
+		}
+	}
+
+	if isStalled {
+		client.streamResubscribes++
+	}
+
+	return isStalled
+}
+
+// GetStreamHealth returns the health state of the client's event stream subscription.
+func (client *Client) GetStreamHealth() *StreamHealth {
+	client.streamMutex.Lock()
+	defer client.streamMutex.Unlock()
+
+	health := &StreamHealth{
+		Connected:      client.streamConnected,
+		ConnectedSince: client.streamConnectedSince,
+		Resubscribes:   client.streamResubscribes,
+		Topics:         make([]*StreamTopicHealth, 0, len(streamTopics)),
+	}
+
+	for _, topic := range streamTopics {
+		topicHealth := *client.getStreamTopic(topic)
+		health.Topics = append(health.Topics, &topicHealth)
+	}
+
+	return health
+}
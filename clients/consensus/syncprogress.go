@@ -0,0 +1,92 @@
+package consensus
+
+import (
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// weight of the latest sample in the smoothed sync speed
+const syncSpeedSmoothing = 0.3
+
+// SyncProgress holds the synchronization progress of a syncing client.
+type SyncProgress struct {
+	HeadSlot       phase0.Slot
+	SyncDistance   phase0.Slot
+	StartHeadSlot  phase0.Slot
+	SyncingSince   time.Time
+	LastUpdate     time.Time
+	SlotsPerSecond float64       // smoothed head progress
+	Eta            time.Duration // 0 if the client does not catch up with the chain head
+}
+
+// Percent returns the synchronization progress in percent.
+func (p *SyncProgress) Percent() float64 {
+	targetSlot := p.HeadSlot + p.SyncDistance
+	if targetSlot == 0 {
+		return 0
+	}
+	return float64(p.HeadSlot) * 100 / float64(targetSlot)
+}
+
+// updateSyncProgress tracks the sync speed between two synchronization status polls.
+func (client *Client) updateSyncProgress(syncState *v1.SyncState) {
+	client.syncMutex.Lock()
+	defer client.syncMutex.Unlock()
+
+	if !syncState.IsSyncing {
+		client.syncProgress = nil
+		return
+	}
+
+	now := time.Now()
+	lastProgress := client.syncProgress
+	progress := &SyncProgress{
+		HeadSlot:      syncState.HeadSlot,
+		SyncDistance:  syncState.SyncDistance,
+		StartHeadSlot: syncState.HeadSlot,
+		SyncingSince:  now,
+		LastUpdate:    now,
+	}
+
+	if lastProgress != nil {
+		progress.StartHeadSlot = lastProgress.StartHeadSlot
+		progress.SyncingSince = lastProgress.SyncingSince
+		progress.SlotsPerSecond = lastProgress.SlotsPerSecond
+
+		elapsed := now.Sub(lastProgress.LastUpdate).Seconds()
+		if elapsed > 0 && syncState.HeadSlot >= lastProgress.HeadSlot {
+			speed := float64(syncState.HeadSlot-lastProgress.HeadSlot) / elapsed
+			if lastProgress.SlotsPerSecond == 0 {
+				progress.SlotsPerSecond = speed
+			} else {
+				progress.SlotsPerSecond = syncSpeedSmoothing*speed + (1-syncSpeedSmoothing)*lastProgress.SlotsPerSecond
+			}
+		}
+	}
+
+	// the chain head keeps moving while syncing, so the distance only shrinks by the speed beyond the slot time
+	chainSpeed := float64(0)
+	if specs := client.pool.chainState.GetSpecs(); specs != nil && specs.SecondsPerSlot > 0 {
+		chainSpeed = 1 / specs.SecondsPerSlot.Seconds()
+	}
+	if catchUpSpeed := progress.SlotsPerSecond - chainSpeed; catchUpSpeed > 0 {
+		progress.Eta = time.Duration(float64(progress.SyncDistance) / catchUpSpeed * float64(time.Second))
+	}
+
+	client.syncProgress = progress
+}
+
+// GetSyncProgress returns the synchronization progress of the client, or nil if the client is not syncing.
+func (client *Client) GetSyncProgress() *SyncProgress {
+	client.syncMutex.RLock()
+	defer client.syncMutex.RUnlock()
+
+	if client.syncProgress == nil {
+		return nil
+	}
+
+	progress := *client.syncProgress
+	return &progress
+}
@@ -231,6 +231,66 @@ func (ec *ExecutionClient) GetBlockByHash(ctx context.Context, hash common.Hash)
 	return block, nil
 }
 
+// GetHeadersByHash fetches multiple block headers with a single json-rpc batch request.
+// the headers are returned in the order of the requested hashes.
+func (ec *ExecutionClient) GetHeadersByHash(ctx context.Context, hashes []common.Hash) ([]*types.Header, error) {
+	headers := make([]*types.Header, len(hashes))
+	batch := make([]rpc.BatchElem, len(hashes))
+	for idx, hash := range hashes {
+		batch[idx] = rpc.BatchElem{
+			Method: "eth_getBlockByHash",
+			Args:   []interface{}{hash, false},
+			Result: &headers[idx],
+		}
+	}
+
+	err := ec.rpcClient.BatchCallContext(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, elem := range batch {
+		if elem.Error != nil {
+			return nil, fmt.Errorf("error fetching header %v: %w", hashes[idx], elem.Error)
+		}
+		if headers[idx] == nil {
+			return nil, fmt.Errorf("header %v not found", hashes[idx])
+		}
+	}
+
+	return headers, nil
+}
+
+// GetTransactionsByHash fetches multiple transactions with a single json-rpc batch request.
+// the transactions are returned in the order of the requested hashes.
+func (ec *ExecutionClient) GetTransactionsByHash(ctx context.Context, hashes []common.Hash) ([]*types.Transaction, error) {
+	txs := make([]*types.Transaction, len(hashes))
+	batch := make([]rpc.BatchElem, len(hashes))
+	for idx, hash := range hashes {
+		batch[idx] = rpc.BatchElem{
+			Method: "eth_getTransactionByHash",
+			Args:   []interface{}{hash},
+			Result: &txs[idx],
+		}
+	}
+
+	err := ec.rpcClient.BatchCallContext(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, elem := range batch {
+		if elem.Error != nil {
+			return nil, fmt.Errorf("error fetching transaction %v: %w", hashes[idx], elem.Error)
+		}
+		if txs[idx] == nil {
+			return nil, fmt.Errorf("transaction %v not found", hashes[idx])
+		}
+	}
+
+	return txs, nil
+}
+
 func (ec *ExecutionClient) GetNonceAt(ctx context.Context, wallet common.Address, blockNumber *big.Int) (uint64, error) {
 	return ec.ethClient.NonceAt(ctx, wallet, blockNumber)
 }
@@ -239,6 +299,26 @@ func (ec *ExecutionClient) GetBalanceAt(ctx context.Context, wallet common.Addre
 	return ec.ethClient.BalanceAt(ctx, wallet, blockNumber)
 }
 
+func (ec *ExecutionClient) GetStorageAtHash(ctx context.Context, account common.Address, key common.Hash, blockHash common.Hash) ([]byte, error) {
+	return ec.ethClient.StorageAtHash(ctx, account, key, blockHash)
+}
+
+// GetProof returns the eth_getProof account & storage proofs of the given account at the given block.
+func (ec *ExecutionClient) GetProof(ctx context.Context, account common.Address, keys []common.Hash, blockHash common.Hash) (*AccountProof, error) {
+	storageKeys := make([]string, len(keys))
+	for i, key := range keys {
+		storageKeys[i] = key.Hex()
+	}
+
+	var result AccountProof
+	err := ec.rpcClient.CallContext(ctx, &result, "eth_getProof", account, storageKeys, rpc.BlockNumberOrHashWithHash(blockHash, true))
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 func (ec *ExecutionClient) GetTransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
 	return ec.ethClient.TransactionReceipt(ctx, txHash)
 }
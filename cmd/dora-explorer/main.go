@@ -14,6 +14,8 @@ import (
 	"github.com/urfave/negroni"
 
 	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/graphqlapi"
+	"github.com/ethpandaops/dora/grpcapi"
 	"github.com/ethpandaops/dora/handlers"
 	"github.com/ethpandaops/dora/services"
 	"github.com/ethpandaops/dora/static"
@@ -34,7 +36,7 @@ func main() {
 	if err != nil {
 		logrus.Fatalf("error reading config file: %v", err)
 	}
-	utils.Config = cfg
+	utils.SetConfig(cfg)
 	logWriter, logger := utils.InitLogger()
 	defer logWriter.Dispose()
 
@@ -76,6 +78,53 @@ func main() {
 		logger.Fatalf("error starting tx signature service: %v", err)
 	}
 
+	if cfg.GrpcApi.Enabled {
+		_, err = grpcapi.StartServer(logger.WithField("service", "grpc-api"))
+		if err != nil {
+			logger.Fatalf("error starting grpc api: %v", err)
+		}
+	}
+
+	err = services.StartGeoIpService()
+	if err != nil {
+		logger.Fatalf("error starting geoip service: %v", err)
+	}
+
+	err = services.StartValidatorMetrics(logger.WithField("service", "validator-metrics"))
+	if err != nil {
+		logger.Fatalf("error starting validator metrics: %v", err)
+	}
+
+	err = services.StartDatabaseMaintenance(logger.WithField("service", "db-maintenance"))
+	if err != nil {
+		logger.Fatalf("error starting database maintenance: %v", err)
+	}
+
+	err = services.StartAdminAuditLog(logger.WithField("service", "admin-audit"))
+	if err != nil {
+		logger.Fatalf("error starting admin audit log: %v", err)
+	}
+
+	err = services.StartStatusSnapshotPublisher(logger.WithField("service", "status-snapshot"))
+	if err != nil {
+		logger.Fatalf("error starting status snapshot publisher: %v", err)
+	}
+
+	err = services.StartShadowForkDetector(logger.WithField("service", "shadow-fork"))
+	if err != nil {
+		logger.Fatalf("error starting shadow fork detector: %v", err)
+	}
+
+	err = services.StartScreenshotService(logger.WithField("service", "screenshots"))
+	if err != nil {
+		logger.Fatalf("error starting screenshot service: %v", err)
+	}
+
+	err = services.StartNotificationEngine(logger.WithField("service", "notifications"))
+	if err != nil {
+		logger.Fatalf("error starting notification engine: %v", err)
+	}
+
 	if cfg.RateLimit.Enabled {
 		err = services.StartCallRateLimiter(cfg.RateLimit.ProxyCount, cfg.RateLimit.Rate, cfg.RateLimit.Burst)
 		if err != nil {
@@ -89,6 +138,7 @@ func main() {
 
 	utils.WaitForCtrlC()
 	logger.Println("exiting...")
+	services.GlobalBeaconService.StopService()
 	db.MustCloseDB()
 }
 
@@ -97,7 +147,13 @@ func startWebserver(logger logrus.FieldLogger) (*http.Server, error) {
 	// the frontend relies on a properly initialized chain service and will be served by the main router later
 	router := mux.NewRouter()
 
-	router.HandleFunc("/", handlers.ClientsCL).Methods("GET")
+	if utils.Config().Chain.GenesisTime > uint64(time.Now().Unix()) {
+		router.HandleFunc("/", handlers.Genesis).Methods("GET")
+	} else {
+		router.HandleFunc("/", handlers.ClientsCL).Methods("GET")
+	}
+	router.HandleFunc("/genesis", handlers.Genesis).Methods("GET")
+	router.HandleFunc("/clients/syncing", handlers.ClientsSyncing).Methods("GET")
 
 	fileSys := http.FS(static.Files)
 	router.PathPrefix("/").Handler(handlers.CustomFileServer(http.FileServer(fileSys), fileSys, handlers.NotFound))
@@ -106,20 +162,20 @@ func startWebserver(logger logrus.FieldLogger) (*http.Server, error) {
 	n.Use(negroni.NewRecovery())
 	n.UseHandler(router)
 
-	if utils.Config.Frontend.HttpWriteTimeout == 0 {
-		utils.Config.Frontend.HttpWriteTimeout = time.Second * 15
+	if utils.Config().Frontend.HttpWriteTimeout == 0 {
+		utils.Config().Frontend.HttpWriteTimeout = time.Second * 15
 	}
-	if utils.Config.Frontend.HttpReadTimeout == 0 {
-		utils.Config.Frontend.HttpReadTimeout = time.Second * 15
+	if utils.Config().Frontend.HttpReadTimeout == 0 {
+		utils.Config().Frontend.HttpReadTimeout = time.Second * 15
 	}
-	if utils.Config.Frontend.HttpIdleTimeout == 0 {
-		utils.Config.Frontend.HttpIdleTimeout = time.Second * 60
+	if utils.Config().Frontend.HttpIdleTimeout == 0 {
+		utils.Config().Frontend.HttpIdleTimeout = time.Second * 60
 	}
 	srv := &http.Server{
-		Addr:         utils.Config.Server.Host + ":" + utils.Config.Server.Port,
-		WriteTimeout: utils.Config.Frontend.HttpWriteTimeout,
-		ReadTimeout:  utils.Config.Frontend.HttpReadTimeout,
-		IdleTimeout:  utils.Config.Frontend.HttpIdleTimeout,
+		Addr:         utils.Config().Server.Host + ":" + utils.Config().Server.Port,
+		WriteTimeout: utils.Config().Frontend.HttpWriteTimeout,
+		ReadTimeout:  utils.Config().Frontend.HttpReadTimeout,
+		IdleTimeout:  utils.Config().Frontend.HttpIdleTimeout,
 		Handler:      n,
 	}
 
@@ -144,18 +200,36 @@ func startFrontend(webserver *http.Server) {
 	router.HandleFunc("/", handlers.Index).Methods("GET")
 	router.HandleFunc("/index", handlers.Index).Methods("GET")
 	router.HandleFunc("/index/data", handlers.IndexData).Methods("GET")
+	router.HandleFunc("/genesis", handlers.Genesis).Methods("GET")
+	router.HandleFunc("/clients", handlers.ClientDiversity).Methods("GET")
 	router.HandleFunc("/clients/consensus", handlers.ClientsCL).Methods("GET")
+	router.HandleFunc("/clients/consensus/geo", handlers.ClientsCLGeo).Methods("GET")
+	router.HandleFunc("/clients/syncing", handlers.ClientsSyncing).Methods("GET")
+	router.HandleFunc("/clients/latency", handlers.ClientsLatency).Methods("GET")
+	router.HandleFunc("/clients/features", handlers.ClientsFeatures).Methods("GET")
 	router.HandleFunc("/clients/execution", handlers.ClientsEl).Methods("GET")
 	router.HandleFunc("/forks", handlers.Forks).Methods("GET")
 	router.HandleFunc("/epochs", handlers.Epochs).Methods("GET")
+	router.HandleFunc("/epochs/head_votes", handlers.HeadVotes).Methods("GET")
 	router.HandleFunc("/epoch/{epoch}", handlers.Epoch).Methods("GET")
+	router.HandleFunc("/epoch/{epoch}/finality", handlers.EpochFinality).Methods("GET")
+	router.HandleFunc("/epoch/{epoch}/sync_rewards", handlers.EpochSyncRewards).Methods("GET")
 	router.HandleFunc("/slots", handlers.Slots).Methods("GET")
 	router.HandleFunc("/slots/filtered", handlers.SlotsFiltered).Methods("GET")
+	router.HandleFunc("/blobs", handlers.Blobs).Methods("GET")
+	router.HandleFunc("/blobs/stats", handlers.BlobStats).Methods("GET")
+	router.HandleFunc("/blob/{hash}", handlers.Blob).Methods("GET")
 	router.HandleFunc("/slot/{slotOrHash}", handlers.Slot).Methods("GET")
 	router.HandleFunc("/slot/{root}/blob/{commitment}", handlers.SlotBlob).Methods("GET")
+	router.HandleFunc("/slot/{slotOrHash}/packing", handlers.SlotPacking).Methods("GET")
+	router.HandleFunc("/slot/{slotOrHash}/mev", handlers.SlotMev).Methods("GET")
 	router.HandleFunc("/mev/blocks", handlers.MevBlocks).Methods("GET")
+	router.HandleFunc("/graffiti", handlers.Graffiti).Methods("GET")
+	router.HandleFunc("/contracts/logs", handlers.ContractLogs).Methods("GET")
+	router.HandleFunc("/page_build/{id}", handlers.PageBuildStatus).Methods("GET")
 
 	router.HandleFunc("/search", handlers.Search).Methods("GET")
+	router.HandleFunc("/search/suggest", handlers.SearchSuggest).Methods("GET")
 	router.HandleFunc("/search/{type}", handlers.SearchAhead).Methods("GET")
 	router.HandleFunc("/validators", handlers.Validators).Methods("GET")
 	router.HandleFunc("/validators/activity", handlers.ValidatorsActivity).Methods("GET")
@@ -165,20 +239,71 @@ func startFrontend(webserver *http.Server) {
 	router.HandleFunc("/validators/included_deposits", handlers.IncludedDeposits).Methods("GET")
 	router.HandleFunc("/validators/voluntary_exits", handlers.VoluntaryExits).Methods("GET")
 	router.HandleFunc("/validators/slashings", handlers.Slashings).Methods("GET")
+	router.HandleFunc("/validators/slashing_protection", handlers.SlashingProtection).Methods("GET", "POST")
 	router.HandleFunc("/validators/el_withdrawals", handlers.ElWithdrawals).Methods("GET")
 	router.HandleFunc("/validators/el_consolidations", handlers.ElConsolidations).Methods("GET")
+	router.HandleFunc("/validators/electra", handlers.ElectraStats).Methods("GET")
+	router.HandleFunc("/validators/request_queues", handlers.RequestQueues).Methods("GET")
 	router.HandleFunc("/validators/submit_consolidations", handlers.SubmitConsolidation).Methods("GET")
 	router.HandleFunc("/validators/submit_withdrawals", handlers.SubmitWithdrawal).Methods("GET")
+	router.HandleFunc("/validators/ownership", handlers.ValidatorOwnership).Methods("GET", "POST")
+	router.HandleFunc("/watchlist", handlers.ValidatorWatchlist).Methods("GET", "POST")
 	router.HandleFunc("/validator/{idxOrPubKey}", handlers.Validator).Methods("GET")
 	router.HandleFunc("/validator/{index}/slots", handlers.ValidatorSlots).Methods("GET")
+	router.HandleFunc("/api/v1/validators/changes", handlers.ApiValidatorChanges).Methods("GET")
+	router.HandleFunc("/api/v1/validators/filtered", handlers.ApiValidatorsFiltered).Methods("GET")
+	router.HandleFunc("/api/v1/validator/{idxOrPubKey}", handlers.ApiValidator).Methods("GET")
+	router.HandleFunc("/api/v1/validator/{idxOrPubKey}/income", handlers.ApiValidatorIncome).Methods("GET")
+	router.HandleFunc("/api/v1/slots", handlers.ApiSlots).Methods("GET")
+	router.HandleFunc("/api/v1/slots/recent", handlers.ApiRecentSlots).Methods("GET")
+	router.HandleFunc("/api/v1/slot/{slotOrHash}", handlers.ApiSlot).Methods("GET")
+	router.HandleFunc("/api/v1/epochs", handlers.ApiEpochs).Methods("GET")
+	router.HandleFunc("/api/v1/epoch/{epoch}", handlers.ApiEpoch).Methods("GET")
+	router.HandleFunc("/api/v1/deposits/included", handlers.ApiIncludedDeposits).Methods("GET")
+	router.HandleFunc("/api/v1/withdrawal_requests", handlers.ApiWithdrawalRequests).Methods("GET")
+	router.HandleFunc("/api/v1/validator_labels", handlers.ApiValidatorLabelsExport).Methods("GET")
+	router.HandleFunc("/api/v1/validator_labels", handlers.ApiValidatorLabelsImport).Methods("POST")
+	router.HandleFunc("/api/v1/validator_labels/changes", handlers.ApiValidatorLabelsChanges).Methods("GET")
+	router.HandleFunc("/api/v1/overview", handlers.ApiOverview).Methods("GET")
+	router.HandleFunc("/api/v1/stats/rolling", handlers.ApiStatsRolling).Methods("GET")
+	router.HandleFunc("/api/v1/stats/render", handlers.ApiStatsRender).Methods("GET")
+	router.HandleFunc("/api/v1/events", handlers.ApiEvents).Methods("GET")
+	router.HandleFunc("/api/v1/stream", handlers.ApiStream).Methods("GET")
+	router.HandleFunc("/api/v1/state_proof", handlers.ApiStateProof).Methods("GET")
+	router.HandleFunc("/api/v1/execution_proof", handlers.ApiExecutionProof).Methods("GET")
+	router.HandleFunc("/api/v1/admin/status", handlers.ApiAdminStatus).Methods("GET")
+	router.HandleFunc("/api/v1/admin/audit", handlers.ApiAdminAudit).Methods("GET")
+	router.HandleFunc("/api/v1/admin/synchronizer/pause", handlers.ApiAdminSynchronizerPause).Methods("POST")
+	router.HandleFunc("/api/v1/admin/synchronizer/resume", handlers.ApiAdminSynchronizerResume).Methods("POST")
+	router.HandleFunc("/api/v1/admin/config/reload", handlers.ApiAdminConfigReload).Methods("POST")
+	router.HandleFunc("/api/v1/admin/validator_notes", handlers.ApiAdminValidatorNoteAdd).Methods("POST")
+	router.HandleFunc("/api/v1/admin/validator_notes/{id}", handlers.ApiAdminValidatorNoteDelete).Methods("DELETE")
+	router.HandleFunc("/api/v1/validator_notes", handlers.ApiValidatorNotes).Methods("GET")
+	router.HandleFunc("/api/v1/watchlists", handlers.ApiValidatorWatchlists).Methods("GET")
+	router.HandleFunc("/api/v1/watchlists/{list}", handlers.ApiValidatorWatchlistAdd).Methods("POST")
+	router.HandleFunc("/api/v1/watchlists/{list}", handlers.ApiValidatorWatchlistDelete).Methods("DELETE")
+	router.HandleFunc("/api/v1/watchlists/{list}/webhooks", handlers.ApiValidatorWatchlistWebhookAdd).Methods("POST")
+	router.HandleFunc("/api/v1/watchlists/{list}/webhooks", handlers.ApiValidatorWatchlistWebhookDelete).Methods("DELETE")
+
+	if utils.Config().GraphqlApi.Enabled {
+		router.HandleFunc("/graphql", graphqlapi.Handler).Methods("GET", "POST")
+	}
 
-	if utils.Config.Frontend.Pprof {
+	router.HandleFunc("/metrics", handlers.Metrics).Methods("GET")
+	router.HandleFunc("/metrics/validators", handlers.MetricsValidators).Methods("GET")
+
+	if utils.Config().Frontend.Pprof {
 		// add pprof handler
 		router.PathPrefix("/debug/pprof/").Handler(http.DefaultServeMux)
 		router.HandleFunc("/debug/cache", handlers.DebugCache).Methods("GET")
+		router.HandleFunc("/debug/anomalies", handlers.DebugAnomalies).Methods("GET")
+		router.HandleFunc("/debug/epochtimings", handlers.DebugEpochTimings).Methods("GET")
+		router.HandleFunc("/debug/consistency", handlers.DebugConsistency).Methods("GET")
+		router.HandleFunc("/debug/maintenance", handlers.DebugMaintenance).Methods("GET")
+		router.HandleFunc("/debug/bundle", handlers.DebugBundle).Methods("GET")
 	}
 
-	if utils.Config.Frontend.Debug {
+	if utils.Config().Frontend.Debug {
 		// serve files from local directory when debugging, instead of from go embed file
 		templatesHandler := http.FileServer(http.Dir("templates"))
 		router.PathPrefix("/templates").Handler(http.StripPrefix("/templates/", templatesHandler))
@@ -197,6 +322,14 @@ func startFrontend(webserver *http.Server) {
 		uiFileSys := http.FS(uiEmbedFS)
 		uiHandler := handlers.CustomFileServer(http.FileServer(uiFileSys), uiFileSys, handlers.NotFound)
 		router.PathPrefix("/ui-package").Handler(http.StripPrefix("/ui-package/", uiHandler))
+
+		// fingerprint embedded assets, the files served from disk in debug mode are referenced without fingerprint
+		if err := utils.RegisterAssetFS("/", static.Files); err != nil {
+			logrus.Errorf("failed fingerprinting static assets: %v", err)
+		}
+		if err := utils.RegisterAssetFS("/ui-package", uiEmbedFS); err != nil {
+			logrus.Errorf("failed fingerprinting ui package assets: %v", err)
+		}
 	}
 
 	// serve static files from go embed
@@ -205,6 +338,8 @@ func startFrontend(webserver *http.Server) {
 
 	n := negroni.New()
 	n.Use(negroni.NewRecovery())
+	n.Use(negroni.HandlerFunc(handlers.PageRenderTiming))
+	n.Use(negroni.HandlerFunc(handlers.StaticAssetCaching))
 	//n.Use(gzip.Gzip(gzip.DefaultCompression))
 	n.UseHandler(router)
 
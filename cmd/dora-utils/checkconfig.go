@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+func runCheckConfig(args []string) error {
+	flags := flag.NewFlagSet("check-config", flag.ExitOnError)
+	configPath := flags.String("config", "", "Path to the config file, if empty string defaults will be used")
+	strict := flags.Bool("strict", false, "Fail on unknown settings instead of printing a warning")
+	flags.Parse(args)
+
+	unknownKeys, err := utils.GetUnknownConfigKeys(*configPath)
+	if err != nil {
+		return err
+	}
+	for _, unknownKey := range unknownKeys {
+		fmt.Printf("warning: %v\n", unknownKey)
+	}
+
+	cfg := &types.Config{}
+	err = utils.ReadConfig(cfg, *configPath)
+	if err != nil {
+		return err
+	}
+
+	if *strict && len(unknownKeys) > 0 {
+		return fmt.Errorf("config contains %v unknown settings", len(unknownKeys))
+	}
+
+	fmt.Printf("config ok: %v beacon endpoints, %v execution endpoints, %v database\n", len(cfg.BeaconApi.Endpoints), len(cfg.ExecutionApi.Endpoints), cfg.Database.Engine)
+	return nil
+}
@@ -0,0 +1,419 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"math/rand"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// fixtureInsertBatchSize is the max. number of rows written per multi row insert, to stay below the engines' bind parameter limits.
+const fixtureInsertBatchSize = 1000
+
+const fixtureDepositAmount = 32 * 1000000000
+
+var fixtureGraffitis = []string{
+	"dora fixture",
+	"synthetic block",
+	"lighthouse/v5.3.0",
+	"teku/v24.10.0",
+	"prysm/v5.1.2",
+	"nimbus/v24.10.0",
+	"lodestar/v1.22.0",
+	"grandine/v1.0.0",
+}
+
+type fixtureGenerator struct {
+	logger         logrus.FieldLogger
+	rng            *rand.Rand
+	seed           int64
+	epochs         uint64
+	slotsPerEpoch  uint64
+	validatorCount uint64
+	missedRate     float64
+	orphanedRate   float64
+	depositRate    float64
+	exitRate       float64
+
+	parentRoot     []byte
+	blockNumber    uint64
+	depositIndex   uint64
+	exitedIndices  map[uint64]bool
+	slotCount      uint64
+	missedCount    uint64
+	orphanedCount  uint64
+	depositCount   uint64
+	exitCount      uint64
+	withdrawAmount uint64
+}
+
+func runGenFixtures(args []string) error {
+	flags := flag.NewFlagSet("gen-fixtures", flag.ExitOnError)
+	configPath := flags.String("config", "", "Path to the config file, if empty string defaults will be used")
+	seed := flags.Int64("seed", 1, "Seed for the generated dataset, the same seed always produces the same dataset")
+	epochs := flags.Uint64("epochs", 100, "Number of epochs to generate")
+	slotsPerEpoch := flags.Uint64("slots-per-epoch", 32, "Number of slots per epoch")
+	validatorCount := flags.Uint64("validators", 10000, "Number of validators in the generated genesis set")
+	missedRate := flags.Float64("missed-rate", 0.03, "Share of missed slots")
+	orphanedRate := flags.Float64("orphaned-rate", 0.01, "Share of slots with an additional orphaned block")
+	depositRate := flags.Float64("deposit-rate", 0.05, "Share of blocks including a deposit")
+	exitRate := flags.Float64("exit-rate", 0.01, "Share of blocks including a voluntary exit")
+	flags.Parse(args)
+
+	if *slotsPerEpoch == 0 || *validatorCount == 0 {
+		return fmt.Errorf("slots-per-epoch and validators must be greater than 0")
+	}
+
+	cfg := &types.Config{}
+	err := utils.ReadConfig(cfg, *configPath)
+	if err != nil {
+		return fmt.Errorf("error reading config file: %v", err)
+	}
+	utils.SetConfig(cfg)
+	logWriter, logger := utils.InitLogger()
+	defer logWriter.Dispose()
+
+	db.MustInitDB()
+	err = db.ApplyEmbeddedDbSchema(-2)
+	if err != nil {
+		return fmt.Errorf("error initializing db schema: %v", err)
+	}
+
+	generator := &fixtureGenerator{
+		logger:         logger,
+		rng:            rand.New(rand.NewSource(*seed)),
+		seed:           *seed,
+		epochs:         *epochs,
+		slotsPerEpoch:  *slotsPerEpoch,
+		validatorCount: *validatorCount,
+		missedRate:     *missedRate,
+		orphanedRate:   *orphanedRate,
+		depositRate:    *depositRate,
+		exitRate:       *exitRate,
+		exitedIndices:  map[uint64]bool{},
+	}
+
+	return generator.run()
+}
+
+// fixtureHash derives a deterministic 32 byte value for the given kind & number from the generator seed.
+func (gen *fixtureGenerator) fixtureHash(kind string, number uint64) []byte {
+	buf := make([]byte, 16, 16+len(kind))
+	binary.BigEndian.PutUint64(buf[0:8], uint64(gen.seed))
+	binary.BigEndian.PutUint64(buf[8:16], number)
+	buf = append(buf, kind...)
+	hash := sha256.Sum256(buf)
+	return hash[:]
+}
+
+func (gen *fixtureGenerator) validatorPubkey(index uint64) []byte {
+	pubkey := make([]byte, 0, 64)
+	pubkey = append(pubkey, gen.fixtureHash("pubkey-a", index)...)
+	pubkey = append(pubkey, gen.fixtureHash("pubkey-b", index)...)
+	return pubkey[:48]
+}
+
+func (gen *fixtureGenerator) validatorWithdrawalCredentials(index uint64) []byte {
+	credentials := gen.fixtureHash("withdrawal", index)
+	if index%2 == 0 {
+		credentials[0] = 0x00
+	} else {
+		credentials[0] = 0x01
+		copy(credentials[1:12], make([]byte, 11))
+	}
+	return credentials
+}
+
+func (gen *fixtureGenerator) run() error {
+	gen.logger.Infof("generating fixtures: %v epochs, %v validators, seed %v", gen.epochs, gen.validatorCount, gen.seed)
+
+	genesisRoot := gen.fixtureHash("block", 0)
+	err := gen.generateGenesis(genesisRoot)
+	if err != nil {
+		return fmt.Errorf("failed generating genesis: %w", err)
+	}
+	gen.parentRoot = genesisRoot
+
+	for epoch := uint64(0); epoch < gen.epochs; epoch++ {
+		err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			return gen.generateEpoch(epoch, tx)
+		})
+		if err != nil {
+			return fmt.Errorf("failed generating epoch %v: %w", epoch, err)
+		}
+
+		if (epoch+1)%10 == 0 || epoch+1 == gen.epochs {
+			gen.logger.Infof("generated epoch %v/%v", epoch+1, gen.epochs)
+		}
+	}
+
+	gen.logger.WithFields(logrus.Fields{
+		"slots":    gen.slotCount,
+		"missed":   gen.missedCount,
+		"orphaned": gen.orphanedCount,
+		"deposits": gen.depositCount,
+		"exits":    gen.exitCount,
+	}).Infof("fixture generation complete")
+
+	return nil
+}
+
+// generateGenesis writes the genesis block together with the genesis deposits & names of all validators.
+func (gen *fixtureGenerator) generateGenesis(genesisRoot []byte) error {
+	deposits := make([]*dbtypes.Deposit, 0, gen.validatorCount)
+	depositTxs := make([]*dbtypes.DepositTx, 0, gen.validatorCount)
+	validatorNames := make([]*dbtypes.ValidatorName, 0, gen.validatorCount)
+	operatorSize := gen.validatorCount/16 + 1
+
+	for index := uint64(0); index < gen.validatorCount; index++ {
+		depositIndex := index
+		pubkey := gen.validatorPubkey(index)
+		credentials := gen.validatorWithdrawalCredentials(index)
+
+		deposits = append(deposits, &dbtypes.Deposit{
+			Index:                 &depositIndex,
+			SlotNumber:            0,
+			SlotIndex:             index,
+			SlotRoot:              genesisRoot,
+			PublicKey:             pubkey,
+			WithdrawalCredentials: credentials,
+			Amount:                fixtureDepositAmount,
+		})
+		depositTxs = append(depositTxs, &dbtypes.DepositTx{
+			Index:                 depositIndex,
+			BlockRoot:             gen.fixtureHash("el-block", 0),
+			PublicKey:             pubkey,
+			WithdrawalCredentials: credentials,
+			Amount:                fixtureDepositAmount,
+			Signature:             append(gen.validatorPubkey(index+gen.validatorCount), gen.fixtureHash("signature", index)...),
+			ValidSignature:        true,
+			TxHash:                gen.fixtureHash("deposit-tx", index),
+			TxSender:              gen.fixtureHash("deposit-sender", index/operatorSize)[:20],
+			TxTarget:              gen.fixtureHash("deposit-contract", 0)[:20],
+		})
+		validatorNames = append(validatorNames, &dbtypes.ValidatorName{
+			Index: index,
+			Name:  fmt.Sprintf("fixture-operator-%02d", index/operatorSize),
+		})
+	}
+	gen.depositIndex = gen.validatorCount
+
+	return db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		err := db.InsertSlot(&dbtypes.Slot{
+			Slot:         0,
+			Status:       dbtypes.Canonical,
+			Root:         genesisRoot,
+			ParentRoot:   make([]byte, 32),
+			StateRoot:    gen.fixtureHash("state", 0),
+			Graffiti:     make([]byte, 32),
+			DepositCount: gen.validatorCount,
+		}, tx)
+		if err != nil {
+			return err
+		}
+
+		for start := 0; start < len(deposits); start += fixtureInsertBatchSize {
+			end := start + fixtureInsertBatchSize
+			if end > len(deposits) {
+				end = len(deposits)
+			}
+
+			if err := db.InsertDeposits(deposits[start:end], tx); err != nil {
+				return err
+			}
+			if err := db.InsertDepositTxs(depositTxs[start:end], tx); err != nil {
+				return err
+			}
+			if err := db.InsertValidatorNames(validatorNames[start:end], tx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (gen *fixtureGenerator) generateEpoch(epoch uint64, tx *sqlx.Tx) error {
+	epochStats := &dbtypes.Epoch{
+		Epoch:            epoch,
+		ValidatorCount:   gen.validatorCount - uint64(len(gen.exitedIndices)),
+		ValidatorBalance: (gen.validatorCount - uint64(len(gen.exitedIndices))) * fixtureDepositAmount,
+	}
+	epochStats.Eligible = epochStats.ValidatorBalance
+	epochStats.VotedTarget = uint64(float64(epochStats.Eligible) * (0.9 + gen.rng.Float64()*0.1))
+	epochStats.VotedHead = uint64(float64(epochStats.VotedTarget) * (0.9 + gen.rng.Float64()*0.1))
+	epochStats.VotedTotal = epochStats.VotedTarget
+
+	syncParticipation := float32(0)
+	for slot := epoch * gen.slotsPerEpoch; slot < (epoch+1)*gen.slotsPerEpoch; slot++ {
+		if slot == 0 {
+			epochStats.BlockCount++
+			epochStats.DepositCount += gen.validatorCount
+			continue
+		}
+
+		proposer := uint64(gen.rng.Int63n(int64(gen.validatorCount)))
+		gen.slotCount++
+
+		if gen.rng.Float64() < gen.orphanedRate {
+			orphanedBlock := gen.generateBlock(slot, proposer, dbtypes.Orphaned)
+			if err := db.InsertSlot(orphanedBlock, tx); err != nil {
+				return err
+			}
+			epochStats.OrphanedCount++
+			gen.orphanedCount++
+		}
+
+		if gen.rng.Float64() < gen.missedRate {
+			err := db.InsertMissingSlot(&dbtypes.SlotHeader{
+				Slot:     slot,
+				Proposer: proposer,
+				Status:   dbtypes.Missing,
+			}, tx)
+			if err != nil {
+				return err
+			}
+			gen.missedCount++
+			continue
+		}
+
+		block := gen.generateBlock(slot, proposer, dbtypes.Canonical)
+		if err := gen.generateBlockOperations(block, tx); err != nil {
+			return err
+		}
+		if err := db.InsertSlot(block, tx); err != nil {
+			return err
+		}
+		gen.parentRoot = block.Root
+
+		epochStats.BlockCount++
+		epochStats.AttestationCount += block.AttestationCount
+		epochStats.DepositCount += block.DepositCount
+		epochStats.ExitCount += block.ExitCount
+		epochStats.WithdrawCount += block.WithdrawCount
+		epochStats.WithdrawAmount += block.WithdrawAmount
+		epochStats.EthTransactionCount += block.EthTransactionCount
+		syncParticipation += block.SyncParticipation
+	}
+
+	if epochStats.BlockCount > 0 {
+		epochStats.SyncParticipation = syncParticipation / float32(epochStats.BlockCount)
+	}
+
+	return db.InsertEpoch(epochStats, tx)
+}
+
+// generateBlock builds a synthetic block for the given slot. orphaned blocks don't advance the parent or the execution block number.
+func (gen *fixtureGenerator) generateBlock(slot uint64, proposer uint64, status dbtypes.SlotStatus) *dbtypes.Slot {
+	rootKind := "block"
+	if status == dbtypes.Orphaned {
+		rootKind = "orphaned-block"
+	}
+
+	graffitiText := fixtureGraffitis[gen.rng.Intn(len(fixtureGraffitis))]
+	graffiti := make([]byte, 32)
+	copy(graffiti, graffitiText)
+
+	blockNumber := gen.blockNumber + 1
+	if status == dbtypes.Canonical {
+		gen.blockNumber = blockNumber
+	}
+
+	withdrawCount := uint64(gen.rng.Intn(17))
+
+	return &dbtypes.Slot{
+		Slot:                slot,
+		Proposer:            proposer,
+		Status:              status,
+		Root:                gen.fixtureHash(rootKind, slot),
+		ParentRoot:          gen.parentRoot,
+		StateRoot:           gen.fixtureHash("state", slot),
+		Graffiti:            graffiti,
+		GraffitiText:        graffitiText,
+		AttestationCount:    uint64(64 + gen.rng.Intn(65)),
+		WithdrawCount:       withdrawCount,
+		WithdrawAmount:      withdrawCount * uint64(1000000+gen.rng.Intn(20000000)),
+		EthTransactionCount: uint64(gen.rng.Intn(200)),
+		EthBlockNumber:      &blockNumber,
+		EthBlockHash:        gen.fixtureHash(rootKind+"-el", slot),
+		EthBlockExtra:       []byte(graffitiText),
+		EthBlockExtraText:   graffitiText,
+		SyncParticipation:   float32(0.85 + gen.rng.Float64()*0.15),
+	}
+}
+
+// generateBlockOperations adds the synthetic deposits & voluntary exits of a canonical block.
+func (gen *fixtureGenerator) generateBlockOperations(block *dbtypes.Slot, tx *sqlx.Tx) error {
+	if gen.rng.Float64() < gen.depositRate {
+		// top-up deposit for an existing validator
+		validatorIndex := uint64(gen.rng.Int63n(int64(gen.validatorCount)))
+		depositIndex := gen.depositIndex
+		amount := uint64(1+gen.rng.Intn(32)) * 1000000000
+		pubkey := gen.validatorPubkey(validatorIndex)
+		credentials := gen.validatorWithdrawalCredentials(validatorIndex)
+
+		err := db.InsertDeposits([]*dbtypes.Deposit{{
+			Index:                 &depositIndex,
+			SlotNumber:            block.Slot,
+			SlotIndex:             0,
+			SlotRoot:              block.Root,
+			PublicKey:             pubkey,
+			WithdrawalCredentials: credentials,
+			Amount:                amount,
+		}}, tx)
+		if err != nil {
+			return err
+		}
+
+		err = db.InsertDepositTxs([]*dbtypes.DepositTx{{
+			Index:                 depositIndex,
+			BlockNumber:           *block.EthBlockNumber,
+			BlockRoot:             block.EthBlockHash,
+			PublicKey:             pubkey,
+			WithdrawalCredentials: credentials,
+			Amount:                amount,
+			Signature:             append(gen.validatorPubkey(depositIndex+gen.validatorCount), gen.fixtureHash("signature", depositIndex)...),
+			ValidSignature:        true,
+			TxHash:                gen.fixtureHash("deposit-tx", depositIndex),
+			TxSender:              gen.fixtureHash("deposit-sender", validatorIndex)[:20],
+			TxTarget:              gen.fixtureHash("deposit-contract", 0)[:20],
+		}}, tx)
+		if err != nil {
+			return err
+		}
+
+		gen.depositIndex++
+		gen.depositCount++
+		block.DepositCount = 1
+	}
+
+	if gen.rng.Float64() < gen.exitRate && uint64(len(gen.exitedIndices)) < gen.validatorCount/2 {
+		validatorIndex := uint64(gen.rng.Int63n(int64(gen.validatorCount)))
+		if !gen.exitedIndices[validatorIndex] {
+			err := db.InsertVoluntaryExits([]*dbtypes.VoluntaryExit{{
+				SlotNumber:     block.Slot,
+				SlotIndex:      0,
+				SlotRoot:       block.Root,
+				ValidatorIndex: validatorIndex,
+			}}, tx)
+			if err != nil {
+				return err
+			}
+
+			gen.exitedIndices[validatorIndex] = true
+			gen.exitCount++
+			block.ExitCount = 1
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// loadTestPage describes a page of the request mix. weight is the relative share of requests sent to the page.
+type loadTestPage struct {
+	name   string
+	weight int
+	path   func(rng *rand.Rand, chain *loadTestChainInfo) string
+}
+
+type loadTestChainInfo struct {
+	CurrentEpoch         uint64 `json:"cur_epoch"`
+	CurrentSlot          uint64 `json:"cur_slot"`
+	ActiveValidatorCount uint64 `json:"active_val"`
+}
+
+type loadTestPageStats struct {
+	name      string
+	latencies []time.Duration
+	errors    uint64
+	bytes     uint64
+}
+
+// loadTestPages is the default request mix, roughly following the page views of public explorer instances.
+var loadTestPages = []*loadTestPage{
+	{name: "/", weight: 20, path: func(_ *rand.Rand, _ *loadTestChainInfo) string { return "/" }},
+	{name: "/index/data", weight: 15, path: func(_ *rand.Rand, _ *loadTestChainInfo) string { return "/index/data" }},
+	{name: "/slots", weight: 10, path: func(_ *rand.Rand, _ *loadTestChainInfo) string { return "/slots" }},
+	{name: "/slot/{slot}", weight: 15, path: func(rng *rand.Rand, chain *loadTestChainInfo) string {
+		return fmt.Sprintf("/slot/%v", randomRecentNumber(rng, chain.CurrentSlot))
+	}},
+	{name: "/epochs", weight: 5, path: func(_ *rand.Rand, _ *loadTestChainInfo) string { return "/epochs" }},
+	{name: "/epoch/{epoch}", weight: 8, path: func(rng *rand.Rand, chain *loadTestChainInfo) string {
+		return fmt.Sprintf("/epoch/%v", randomRecentNumber(rng, chain.CurrentEpoch))
+	}},
+	{name: "/validators", weight: 5, path: func(_ *rand.Rand, _ *loadTestChainInfo) string { return "/validators" }},
+	{name: "/validator/{index}", weight: 12, path: func(rng *rand.Rand, chain *loadTestChainInfo) string {
+		return fmt.Sprintf("/validator/%v", randomNumber(rng, chain.ActiveValidatorCount))
+	}},
+	{name: "/validators/activity", weight: 2, path: func(_ *rand.Rand, _ *loadTestChainInfo) string { return "/validators/activity" }},
+	{name: "/validators/deposits", weight: 3, path: func(_ *rand.Rand, _ *loadTestChainInfo) string { return "/validators/deposits" }},
+	{name: "/validators/voluntary_exits", weight: 1, path: func(_ *rand.Rand, _ *loadTestChainInfo) string { return "/validators/voluntary_exits" }},
+	{name: "/validators/slashings", weight: 1, path: func(_ *rand.Rand, _ *loadTestChainInfo) string { return "/validators/slashings" }},
+	{name: "/clients/consensus", weight: 2, path: func(_ *rand.Rand, _ *loadTestChainInfo) string { return "/clients/consensus" }},
+	{name: "/search/suggest", weight: 1, path: func(rng *rand.Rand, chain *loadTestChainInfo) string {
+		return fmt.Sprintf("/search/suggest?q=%v", randomRecentNumber(rng, chain.CurrentSlot))
+	}},
+}
+
+func randomNumber(rng *rand.Rand, max uint64) uint64 {
+	if max == 0 {
+		return 0
+	}
+	return uint64(rng.Int63n(int64(max)))
+}
+
+// randomRecentNumber returns a random number below max, biased towards max as most page views target recent slots & epochs.
+func randomRecentNumber(rng *rand.Rand, max uint64) uint64 {
+	if rng.Intn(4) == 0 {
+		return randomNumber(rng, max)
+	}
+
+	recent := randomNumber(rng, 64)
+	if recent > max {
+		return 0
+	}
+	return max - recent
+}
+
+func runLoadTest(args []string) error {
+	flags := flag.NewFlagSet("loadtest", flag.ExitOnError)
+	target := flags.String("target", "", "Base url of the dora instance to test (e.g. http://127.0.0.1:8080)")
+	duration := flags.Duration("duration", 60*time.Second, "Duration of the load test")
+	concurrency := flags.Int("concurrency", 8, "Number of parallel simulated users")
+	rate := flags.Float64("rate", 0, "Max. number of requests per second over all users (0 for unlimited)")
+	timeout := flags.Duration("timeout", 30*time.Second, "Timeout per request")
+	seed := flags.Int64("seed", 1, "Seed for the request mix")
+	flags.Parse(args)
+
+	if *target == "" {
+		return fmt.Errorf("missing target url")
+	}
+	if *concurrency < 1 {
+		*concurrency = 1
+	}
+	baseUrl := strings.TrimRight(*target, "/")
+
+	httpClient := &http.Client{Timeout: *timeout}
+	chainInfo, err := loadTestGetChainInfo(httpClient, baseUrl)
+	if err != nil {
+		return fmt.Errorf("failed loading chain info from target: %v", err)
+	}
+
+	fmt.Printf("load testing %v for %v with %v users (current slot %v, %v active validators)\n", baseUrl, *duration, *concurrency, chainInfo.CurrentSlot, chainInfo.ActiveValidatorCount)
+
+	totalWeight := 0
+	for _, page := range loadTestPages {
+		totalWeight += page.weight
+	}
+
+	var ticker *time.Ticker
+	if *rate > 0 {
+		ticker = time.NewTicker(time.Duration(float64(time.Second) / *rate))
+		defer ticker.Stop()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *duration)
+	defer cancel()
+
+	statsMutex := sync.Mutex{}
+	pageStats := map[string]*loadTestPageStats{}
+	wg := sync.WaitGroup{}
+	startTime := time.Now()
+
+	for i := 0; i < *concurrency; i++ {
+		wg.Add(1)
+		go func(rng *rand.Rand) {
+			defer wg.Done()
+
+			for ctx.Err() == nil {
+				if ticker != nil {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+					}
+				}
+
+				pick := rng.Intn(totalWeight)
+				var page *loadTestPage
+				for _, page = range loadTestPages {
+					if pick < page.weight {
+						break
+					}
+					pick -= page.weight
+				}
+
+				latency, size, err := loadTestRequest(ctx, httpClient, baseUrl+page.path(rng, chainInfo))
+				if ctx.Err() != nil {
+					return // don't count requests aborted by the end of the test
+				}
+
+				statsMutex.Lock()
+				stats := pageStats[page.name]
+				if stats == nil {
+					stats = &loadTestPageStats{name: page.name}
+					pageStats[page.name] = stats
+				}
+				if err != nil {
+					stats.errors++
+				} else {
+					stats.latencies = append(stats.latencies, latency)
+					stats.bytes += size
+				}
+				statsMutex.Unlock()
+			}
+		}(rand.New(rand.NewSource(*seed + int64(i))))
+	}
+
+	wg.Wait()
+	printLoadTestReport(pageStats, time.Since(startTime))
+	return nil
+}
+
+func loadTestGetChainInfo(httpClient *http.Client, baseUrl string) (*loadTestChainInfo, error) {
+	rsp, err := httpClient.Get(baseUrl + "/index/data")
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %v", rsp.StatusCode)
+	}
+
+	chainInfo := &loadTestChainInfo{}
+	if err := json.NewDecoder(rsp.Body).Decode(chainInfo); err != nil {
+		return nil, fmt.Errorf("failed parsing response: %v", err)
+	}
+	return chainInfo, nil
+}
+
+func loadTestRequest(ctx context.Context, httpClient *http.Client, url string) (time.Duration, uint64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	t1 := time.Now()
+	rsp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer rsp.Body.Close()
+
+	size, err := io.Copy(io.Discard, rsp.Body)
+	if err != nil {
+		return 0, 0, err
+	}
+	latency := time.Since(t1)
+
+	if rsp.StatusCode >= 400 {
+		return latency, uint64(size), fmt.Errorf("status code %v", rsp.StatusCode)
+	}
+	return latency, uint64(size), nil
+}
+
+func loadTestPercentile(latencies []time.Duration, percentile float64) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+	idx := int(float64(len(latencies)-1) * percentile)
+	return latencies[idx]
+}
+
+func printLoadTestReport(pageStats map[string]*loadTestPageStats, elapsed time.Duration) {
+	pages := make([]*loadTestPageStats, 0, len(pageStats))
+	for _, stats := range pageStats {
+		sort.Slice(stats.latencies, func(a, b int) bool {
+			return stats.latencies[a] < stats.latencies[b]
+		})
+		pages = append(pages, stats)
+	}
+	sort.Slice(pages, func(a, b int) bool {
+		return loadTestPercentile(pages[a].latencies, 0.9) > loadTestPercentile(pages[b].latencies, 0.9)
+	})
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(writer, "page\trequests\terrors\tavg kB\tp50\tp90\tp99\tmax\t")
+
+	totalRequests := uint64(0)
+	totalErrors := uint64(0)
+	for _, stats := range pages {
+		count := uint64(len(stats.latencies))
+		totalRequests += count + stats.errors
+		totalErrors += stats.errors
+
+		avgSize := uint64(0)
+		if count > 0 {
+			avgSize = stats.bytes / count / 1024
+		}
+
+		fmt.Fprintf(writer, "%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\t\n",
+			stats.name, count+stats.errors, stats.errors, avgSize,
+			loadTestPercentile(stats.latencies, 0.5).Round(time.Millisecond),
+			loadTestPercentile(stats.latencies, 0.9).Round(time.Millisecond),
+			loadTestPercentile(stats.latencies, 0.99).Round(time.Millisecond),
+			loadTestPercentile(stats.latencies, 1).Round(time.Millisecond),
+		)
+	}
+	writer.Flush()
+
+	fmt.Printf("\n%v requests (%v errors) in %.1f sec, %.1f req/s\n", totalRequests, totalErrors, elapsed.Seconds(), float64(totalRequests)/elapsed.Seconds())
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+type utilCommand struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+var utilCommands = []*utilCommand{
+	{
+		name:    "gen-fixtures",
+		summary: "generate a deterministic synthetic indexed dataset for UI development & load testing",
+		run:     runGenFixtures,
+	},
+	{
+		name:    "loadtest",
+		summary: "replay a realistic request mix against a dora instance and report per-page latencies",
+		run:     runLoadTest,
+	},
+	{
+		name:    "check-config",
+		summary: "validate a config file (incl. env overrides) without starting any services",
+		run:     runCheckConfig,
+	},
+	{
+		name:    "query",
+		summary: "look up a validator, slot or epoch in the database or via the api of a dora instance",
+		run:     runQuery,
+	},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(1)
+	}
+
+	for _, command := range utilCommands {
+		if command.name != os.Args[1] {
+			continue
+		}
+
+		if err := command.run(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "%v failed: %v\n", command.name, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "unknown command: %v\n\n", os.Args[1])
+	printUsage()
+	os.Exit(1)
+}
+
+func printUsage() {
+	fmt.Fprintf(os.Stderr, "usage: %v <command> [flags]\n\ncommands:\n", os.Args[0])
+	for _, command := range utilCommands {
+		fmt.Fprintf(os.Stderr, "  %-16v%v\n", command.name, command.summary)
+	}
+}
@@ -0,0 +1,466 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// queryObject describes an object type of the query command. apiPath returns the path of the object on the dora api,
+// loadDb loads the object from the explorer database.
+type queryObject struct {
+	name    string
+	usage   string
+	apiPath func(id string) string
+	loadDb  func(ctx context.Context, id string, limit uint64) (any, error)
+}
+
+var queryObjects = []*queryObject{
+	{
+		name:    "validator",
+		usage:   "<index>",
+		apiPath: func(id string) string { return "/api/v1/validator/" + url.PathEscape(id) },
+		loadDb:  queryDbValidator,
+	},
+	{
+		name:    "slot",
+		usage:   "<slot|blockroot>",
+		apiPath: func(id string) string { return "/api/v1/slot/" + url.PathEscape(id) },
+		loadDb:  queryDbSlot,
+	},
+	{
+		name:    "epoch",
+		usage:   "<epoch>",
+		apiPath: func(id string) string { return "/api/v1/epoch/" + url.PathEscape(id) },
+		loadDb:  queryDbEpoch,
+	},
+}
+
+type queryValidatorResult struct {
+	Index         uint64                  `json:"index"`
+	Name          string                  `json:"name"`
+	Slashing      *queryValidatorEvent    `json:"slashing"`
+	VoluntaryExit *queryValidatorEvent    `json:"voluntary_exit"`
+	Blocks        []*querySlotResult      `json:"blocks"`
+	Income        []*queryValidatorIncome `json:"income"`
+}
+
+type queryValidatorEvent struct {
+	Slot     uint64 `json:"slot"`
+	Root     string `json:"root"`
+	Orphaned bool   `json:"orphaned"`
+	Reason   string `json:"reason,omitempty"`
+	Slasher  uint64 `json:"slasher,omitempty"`
+}
+
+type queryValidatorIncome struct {
+	Epoch           uint64 `json:"epoch"`
+	AttestationGwei int64  `json:"attestation_gwei"`
+	ProposalGwei    int64  `json:"proposal_gwei"`
+	SyncGwei        int64  `json:"sync_gwei"`
+	TotalGwei       int64  `json:"total_gwei"`
+}
+
+type querySlotResult struct {
+	Slot              uint64  `json:"slot"`
+	Proposer          uint64  `json:"proposer"`
+	Status            string  `json:"status"`
+	Root              string  `json:"root"`
+	ParentRoot        string  `json:"parent_root"`
+	StateRoot         string  `json:"state_root"`
+	Graffiti          string  `json:"graffiti"`
+	Attestations      uint64  `json:"attestations"`
+	Deposits          uint64  `json:"deposits"`
+	Exits             uint64  `json:"exits"`
+	Withdrawals       uint64  `json:"withdrawals"`
+	WithdrawalsGwei   uint64  `json:"withdrawals_gwei"`
+	AttesterSlashings uint64  `json:"attester_slashings"`
+	ProposerSlashings uint64  `json:"proposer_slashings"`
+	BLSChanges        uint64  `json:"bls_changes"`
+	Transactions      uint64  `json:"transactions"`
+	BlobTransactions  uint64  `json:"blob_transactions"`
+	BlockNumber       *uint64 `json:"block_number"`
+	BlockHash         string  `json:"block_hash"`
+	ExtraData         string  `json:"extra_data"`
+	SyncParticipation float32 `json:"sync_participation"`
+}
+
+type queryEpochResult struct {
+	Epoch             uint64  `json:"epoch"`
+	Validators        uint64  `json:"validators"`
+	ValidatorBalance  uint64  `json:"validator_balance"`
+	Eligible          uint64  `json:"eligible"`
+	VotedTarget       uint64  `json:"voted_target"`
+	VotedHead         uint64  `json:"voted_head"`
+	VotedTotal        uint64  `json:"voted_total"`
+	Participation     float64 `json:"participation"`
+	Blocks            uint16  `json:"blocks"`
+	OrphanedBlocks    uint16  `json:"orphaned_blocks"`
+	Attestations      uint64  `json:"attestations"`
+	Deposits          uint64  `json:"deposits"`
+	Exits             uint64  `json:"exits"`
+	Withdrawals       uint64  `json:"withdrawals"`
+	WithdrawalsGwei   uint64  `json:"withdrawals_gwei"`
+	AttesterSlashings uint64  `json:"attester_slashings"`
+	ProposerSlashings uint64  `json:"proposer_slashings"`
+}
+
+func runQuery(args []string) error {
+	flags := flag.NewFlagSet("query", flag.ExitOnError)
+	configPath := flags.String("config", "", "Path to the config file of the explorer whose database is queried, if empty string defaults will be used")
+	apiUrl := flags.String("api", "", "Base url of a dora instance to query via api instead of the database (e.g. https://dora.example.com)")
+	asJson := flags.Bool("json", false, "Print the raw json result instead of a flat key/value listing")
+	limit := flags.Uint64("limit", 10, "Max. number of recent blocks & income epochs listed for validators (database only)")
+	timeout := flags.Duration("timeout", 30*time.Second, "Timeout for the query")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: %v query [flags] <object> <id>\n\nobjects:\n", os.Args[0])
+		for _, object := range queryObjects {
+			fmt.Fprintf(os.Stderr, "  %v %v\n", object.name, object.usage)
+		}
+		fmt.Fprintf(os.Stderr, "\nflags:\n")
+		flags.PrintDefaults()
+	}
+
+	// allow flags before & after the positional arguments (e.g. "query slot 123 --json")
+	positional := []string{}
+	for {
+		flags.Parse(args)
+		args = flags.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+	if len(positional) != 2 {
+		flags.Usage()
+		return fmt.Errorf("expected an object and an id")
+	}
+
+	var object *queryObject
+	for _, queryObject := range queryObjects {
+		if queryObject.name == positional[0] {
+			object = queryObject
+		}
+	}
+	if object == nil {
+		return fmt.Errorf("unknown object: %v", positional[0])
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	var resultJson []byte
+	if *apiUrl != "" {
+		result, err := queryApi(ctx, strings.TrimRight(*apiUrl, "/")+object.apiPath(positional[1]))
+		if err != nil {
+			return err
+		}
+		resultJson = result
+	} else {
+		cfg := &types.Config{}
+		err := utils.ReadConfig(cfg, *configPath)
+		if err != nil {
+			return fmt.Errorf("error reading config file: %v", err)
+		}
+		utils.SetConfig(cfg)
+		logWriter, _ := utils.InitLogger()
+		defer logWriter.Dispose()
+
+		db.MustInitDB()
+
+		result, err := object.loadDb(ctx, positional[1], *limit)
+		if err != nil {
+			return err
+		}
+		resultJson, err = json.Marshal(result)
+		if err != nil {
+			return err
+		}
+	}
+
+	if *asJson {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, resultJson, "", "  "); err != nil {
+			return err
+		}
+		fmt.Println(indented.String())
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	dec := json.NewDecoder(bytes.NewReader(resultJson))
+	dec.UseNumber()
+	if err := printQueryValue(dec, "", writer); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+func queryApi(ctx context.Context, apiUrl string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not query %v: %v", utils.GetRedactedUrl(apiUrl), err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("url: %v, error-response: %v", utils.GetRedactedUrl(apiUrl), strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// printQueryValue prints the next json value of the decoder as flat "path: value" lines, preserving the field order.
+func printQueryValue(dec *json.Decoder, path string, writer io.Writer) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	switch value := token.(type) {
+	case json.Delim:
+		itemCount := 0
+		for dec.More() {
+			var itemPath string
+			if value == '{' {
+				keyToken, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				itemPath = fmt.Sprintf("%v", keyToken)
+				if path != "" {
+					itemPath = path + "." + itemPath
+				}
+			} else {
+				itemPath = fmt.Sprintf("%v[%v]", path, itemCount)
+			}
+
+			if err := printQueryValue(dec, itemPath, writer); err != nil {
+				return err
+			}
+			itemCount++
+		}
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+
+		if itemCount == 0 && path != "" {
+			fmt.Fprintf(writer, "%v:\t-\n", path)
+		}
+	case nil:
+		fmt.Fprintf(writer, "%v:\t-\n", path)
+	default:
+		fmt.Fprintf(writer, "%v:\t%v\n", path, value)
+	}
+	return nil
+}
+
+func queryDbValidator(ctx context.Context, id string, limit uint64) (any, error) {
+	index, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator index: %v", id)
+	}
+
+	result := &queryValidatorResult{
+		Index:  index,
+		Blocks: []*querySlotResult{},
+		Income: []*queryValidatorIncome{},
+	}
+	if names := db.GetValidatorNames(index, index); len(names) > 0 {
+		result.Name = names[0].Name
+	}
+
+	if slashing := db.GetSlashingForValidator(index); slashing != nil {
+		result.Slashing = &queryValidatorEvent{
+			Slot:     slashing.SlotNumber,
+			Root:     queryHex(slashing.SlotRoot),
+			Orphaned: slashing.Orphaned,
+			Reason:   queryGetSlashingReason(slashing.Reason),
+			Slasher:  slashing.SlasherIndex,
+		}
+	}
+	if exit := db.GetVoluntaryExitForValidator(index); exit != nil {
+		result.VoluntaryExit = &queryValidatorEvent{
+			Slot:     exit.SlotNumber,
+			Root:     queryHex(exit.SlotRoot),
+			Orphaned: exit.Orphaned,
+		}
+	}
+
+	blocks := db.GetFilteredSlots(ctx, &dbtypes.BlockFilter{
+		ProposerIndex: &index,
+		WithOrphaned:  1,
+		WithMissing:   1,
+	}, math.MaxInt64, 0, uint32(limit))
+	for _, block := range blocks {
+		result.Blocks = append(result.Blocks, getQuerySlotResult(block))
+	}
+
+	for _, income := range db.GetValidatorIncome(ctx, index, limit) {
+		result.Income = append(result.Income, &queryValidatorIncome{
+			Epoch:           income.Epoch,
+			AttestationGwei: income.AttestationReward,
+			ProposalGwei:    income.ProposalReward,
+			SyncGwei:        income.SyncReward,
+			TotalGwei:       income.AttestationReward + income.ProposalReward + income.SyncReward,
+		})
+	}
+
+	return result, nil
+}
+
+func queryDbSlot(ctx context.Context, id string, _ uint64) (any, error) {
+	blocks := []*querySlotResult{}
+	if rootHex := strings.TrimPrefix(id, "0x"); len(rootHex) == 64 {
+		root, err := hex.DecodeString(rootHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid block root: %v", id)
+		}
+		block := db.GetSlotByRoot(ctx, root)
+		if block == nil {
+			return nil, fmt.Errorf("block not found: %v", id)
+		}
+		blocks = append(blocks, getQuerySlotResult(&dbtypes.AssignedSlot{
+			Slot:     block.Slot,
+			Proposer: block.Proposer,
+			Block:    block,
+		}))
+	} else {
+		slot, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid slot or block root: %v", id)
+		}
+		for _, block := range db.GetSlotsRange(ctx, slot, slot, true, true) {
+			blocks = append(blocks, getQuerySlotResult(block))
+		}
+		if len(blocks) == 0 {
+			return nil, fmt.Errorf("slot not found in database: %v", id)
+		}
+	}
+
+	if len(blocks) == 1 {
+		return blocks[0], nil
+	}
+	return blocks, nil
+}
+
+func queryDbEpoch(ctx context.Context, id string, _ uint64) (any, error) {
+	epochNumber, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid epoch: %v", id)
+	}
+
+	for _, epoch := range db.GetEpochs(ctx, epochNumber, 1) {
+		if epoch.Epoch != epochNumber {
+			continue
+		}
+
+		result := &queryEpochResult{
+			Epoch:             epoch.Epoch,
+			Validators:        epoch.ValidatorCount,
+			ValidatorBalance:  epoch.ValidatorBalance,
+			Eligible:          epoch.Eligible,
+			VotedTarget:       epoch.VotedTarget,
+			VotedHead:         epoch.VotedHead,
+			VotedTotal:        epoch.VotedTotal,
+			Blocks:            epoch.BlockCount,
+			OrphanedBlocks:    epoch.OrphanedCount,
+			Attestations:      epoch.AttestationCount,
+			Deposits:          epoch.DepositCount,
+			Exits:             epoch.ExitCount,
+			Withdrawals:       epoch.WithdrawCount,
+			WithdrawalsGwei:   epoch.WithdrawAmount,
+			AttesterSlashings: epoch.AttesterSlashingCount,
+			ProposerSlashings: epoch.ProposerSlashingCount,
+		}
+		if epoch.Eligible > 0 {
+			result.Participation = float64(epoch.VotedTarget) * 100 / float64(epoch.Eligible)
+		}
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("epoch not found in database (only finalized epochs are stored): %v", id)
+}
+
+func getQuerySlotResult(assignedSlot *dbtypes.AssignedSlot) *querySlotResult {
+	result := &querySlotResult{
+		Slot:     assignedSlot.Slot,
+		Proposer: assignedSlot.Proposer,
+		Status:   "missed",
+	}
+
+	block := assignedSlot.Block
+	if block == nil {
+		return result
+	}
+
+	switch block.Status {
+	case dbtypes.Canonical:
+		result.Status = "canonical"
+	case dbtypes.Orphaned:
+		result.Status = "orphaned"
+	}
+	result.Root = queryHex(block.Root)
+	result.ParentRoot = queryHex(block.ParentRoot)
+	result.StateRoot = queryHex(block.StateRoot)
+	result.Graffiti = block.GraffitiText
+	result.Attestations = block.AttestationCount
+	result.Deposits = block.DepositCount
+	result.Exits = block.ExitCount
+	result.Withdrawals = block.WithdrawCount
+	result.WithdrawalsGwei = block.WithdrawAmount
+	result.AttesterSlashings = block.AttesterSlashingCount
+	result.ProposerSlashings = block.ProposerSlashingCount
+	result.BLSChanges = block.BLSChangeCount
+	result.Transactions = block.EthTransactionCount
+	result.BlobTransactions = block.EthBlobTxCount
+	result.BlockNumber = block.EthBlockNumber
+	result.BlockHash = queryHex(block.EthBlockHash)
+	result.ExtraData = block.EthBlockExtraText
+	result.SyncParticipation = block.SyncParticipation
+	return result
+}
+
+func queryGetSlashingReason(reason dbtypes.SlashingReason) string {
+	switch reason {
+	case dbtypes.ProposerSlashing:
+		return "proposer"
+	case dbtypes.AttesterSlashing:
+		return "attester"
+	default:
+		return "unspecified"
+	}
+}
+
+func queryHex(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	return "0x" + hex.EncodeToString(data)
+}
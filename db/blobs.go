@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -8,66 +9,160 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
-func InsertBlob(blob *dbtypes.Blob, tx *sqlx.Tx) error {
-	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
-		dbtypes.DBEnginePgsql: `
-			INSERT INTO blobs (
-				commitment, proof, size, blob
-			) VALUES ($1, $2, $3, $4)
-			ON CONFLICT (commitment) DO UPDATE SET
-				size = excluded.size,
-				blob = excluded.blob`,
-		dbtypes.DBEngineSqlite: `
-			INSERT OR REPLACE INTO blobs (
-				commitment, proof, size, blob
-			) VALUES ($1, $2, $3, $4)`,
-	}),
-		blob.Commitment, blob.Proof, blob.Size, blob.Blob)
+func InsertBlobSidecars(sidecars []*dbtypes.BlobSidecar, tx *sqlx.Tx) error {
+	if len(sidecars) == 0 {
+		return nil
+	}
+
+	var sql strings.Builder
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  `INSERT INTO blob_sidecars `,
+		dbtypes.DBEngineSqlite: `INSERT OR REPLACE INTO blob_sidecars `,
+	}))
+	fmt.Fprint(&sql, `("block_root", "blob_index", "slot", "proposer", "commitment", "proof", "versioned_hash", "size", "stored") VALUES `)
+	argIdx := 0
+	fieldCount := 9
+	args := make([]any, len(sidecars)*fieldCount)
+	for i, sidecar := range sidecars {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "(")
+		for f := 0; f < fieldCount; f++ {
+			if f > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "$%v", argIdx+f+1)
+		}
+		fmt.Fprintf(&sql, ")")
+
+		args[argIdx+0] = sidecar.BlockRoot
+		args[argIdx+1] = sidecar.BlobIndex
+		args[argIdx+2] = sidecar.Slot
+		args[argIdx+3] = sidecar.Proposer
+		args[argIdx+4] = sidecar.Commitment
+		args[argIdx+5] = sidecar.Proof
+		args[argIdx+6] = sidecar.VersionedHash
+		args[argIdx+7] = sidecar.Size
+		args[argIdx+8] = sidecar.Stored
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  ` ON CONFLICT ("block_root", "blob_index") DO UPDATE SET stored = excluded.stored`,
+		dbtypes.DBEngineSqlite: "",
+	}))
+	_, err := tx.Exec(sql.String(), args...)
+	return err
+}
+
+func GetBlobSidecarsByBlockRoot(blockRoot []byte) []*dbtypes.BlobSidecar {
+	sidecars := []*dbtypes.BlobSidecar{}
+	err := ReaderDb.Select(&sidecars, `
+	SELECT "block_root", "blob_index", "slot", "proposer", "commitment", "proof", "versioned_hash", "size", "stored"
+	FROM blob_sidecars
+	WHERE "block_root" = $1
+	ORDER BY "blob_index" ASC`, blockRoot)
 	if err != nil {
-		return err
+		logger.Errorf("Error while fetching blob sidecars by block root: %v", err)
+		return nil
 	}
-	return nil
+	return sidecars
 }
 
-func InsertBlobAssignment(blobAssignment *dbtypes.BlobAssignment, tx *sqlx.Tx) error {
-	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
-		dbtypes.DBEnginePgsql: `
-			INSERT INTO blob_assignments (
-				root, commitment, slot
-			) VALUES ($1, $2, $3)
-			ON CONFLICT (root, commitment) DO NOTHING`,
-		dbtypes.DBEngineSqlite: `
-			INSERT OR REPLACE INTO blob_assignments (
-				root, commitment, slot
-			) VALUES ($1, $2, $3)`,
-	}),
-		blobAssignment.Root, blobAssignment.Commitment, blobAssignment.Slot)
+// GetBlobSidecarsByHash returns the sidecars with the given kzg commitment or versioned hash.
+// the same blob can be included in multiple (orphaned) blocks, the most recent sidecar is returned first.
+func GetBlobSidecarsByHash(ctx context.Context, hash []byte) []*dbtypes.BlobSidecar {
+	sidecars := []*dbtypes.BlobSidecar{}
+	err := ReaderDb.SelectContext(ctx, &sidecars, `
+	SELECT "block_root", "blob_index", "slot", "proposer", "commitment", "proof", "versioned_hash", "size", "stored"
+	FROM blob_sidecars
+	WHERE "commitment" = $1 OR "versioned_hash" = $1
+	ORDER BY "slot" DESC`, hash)
 	if err != nil {
-		return err
+		logger.Errorf("Error while fetching blob sidecars by hash: %v", err)
+		return nil
 	}
-	return nil
+	return sidecars
 }
 
-func GetBlob(commitment []byte, withData bool) *dbtypes.Blob {
-	blob := dbtypes.Blob{}
+func GetBlobSidecarsFiltered(ctx context.Context, offset uint64, limit uint32, filter *dbtypes.BlobSidecarFilter) ([]*dbtypes.BlobSidecar, uint64, error) {
 	var sql strings.Builder
-	fmt.Fprintf(&sql, `SELECT commitment, proof, size`)
-	if withData {
-		fmt.Fprintf(&sql, `, blob`)
+	args := []any{}
+	fmt.Fprint(&sql, `
+	WITH cte AS (
+		SELECT
+			"block_root", "blob_index", "slot", "proposer", "commitment", "proof", "versioned_hash", "size", "stored"
+		FROM blob_sidecars
+	`)
+
+	filterOp := "WHERE"
+	if filter.MinSlot > 0 {
+		args = append(args, filter.MinSlot)
+		fmt.Fprintf(&sql, " %v slot >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxSlot > 0 {
+		args = append(args, filter.MaxSlot)
+		fmt.Fprintf(&sql, " %v slot <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.Proposer != nil {
+		args = append(args, *filter.Proposer)
+		fmt.Fprintf(&sql, " %v proposer = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+
+	args = append(args, limit)
+	fmt.Fprintf(&sql, `)
+	SELECT
+		null AS block_root,
+		0 AS blob_index,
+		count(*) AS slot,
+		0 AS proposer,
+		null AS commitment,
+		null AS proof,
+		null AS versioned_hash,
+		0 AS size,
+		false AS stored
+	FROM cte
+	UNION ALL SELECT * FROM (
+	SELECT * FROM cte
+	ORDER BY slot DESC, blob_index ASC
+	LIMIT $%v
+	`, len(args))
+
+	if offset > 0 {
+		args = append(args, offset)
+		fmt.Fprintf(&sql, " OFFSET $%v ", len(args))
 	}
-	fmt.Fprintf(&sql, ` FROM blobs WHERE commitment = $1`)
-	err := ReaderDb.Get(&blob, sql.String(), commitment)
+	fmt.Fprintf(&sql, ") AS t1")
+
+	sidecars := []*dbtypes.BlobSidecar{}
+	err := ReaderDb.SelectContext(ctx, &sidecars, sql.String(), args...)
 	if err != nil {
-		return nil
+		logger.Errorf("Error while fetching filtered blob sidecars: %v", err)
+		return nil, 0, err
 	}
-	return &blob
+
+	return sidecars[1:], sidecars[0].Slot, nil
 }
 
-func GetLatestBlobAssignment(commitment []byte) *dbtypes.BlobAssignment {
-	blobAssignment := dbtypes.BlobAssignment{}
-	err := ReaderDb.Get(&blobAssignment, "SELECT root, commitment, slot FROM blob_assignments WHERE commitment = $1 ORDER BY slot DESC LIMIT 1", commitment)
+func InsertBlobContents(commitment []byte, data []byte, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql: `
+			INSERT INTO blob_contents ("commitment", "data") VALUES ($1, $2)
+			ON CONFLICT ("commitment") DO NOTHING`,
+		dbtypes.DBEngineSqlite: `
+			INSERT OR IGNORE INTO blob_contents ("commitment", "data") VALUES ($1, $2)`,
+	}), commitment, data)
+	return err
+}
+
+func GetBlobContents(commitment []byte) ([]byte, error) {
+	data := []byte{}
+	err := ReaderDb.Get(&data, `SELECT "data" FROM blob_contents WHERE "commitment" = $1`, commitment)
 	if err != nil {
-		return nil
+		return nil, err
 	}
-	return &blobAssignment
+	return data, nil
 }
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertBlockCompressionDict(dict *dbtypes.BlockCompressionDict, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql: `
+			INSERT INTO block_compression_dicts (
+				dict_id, create_time, dict
+			) VALUES ($1, $2, $3)
+			ON CONFLICT (dict_id) DO NOTHING`,
+		dbtypes.DBEngineSqlite: `
+			INSERT OR IGNORE INTO block_compression_dicts (
+				dict_id, create_time, dict
+			) VALUES ($1, $2, $3)`,
+	}),
+		dict.DictId, dict.CreateTime, dict.Dict)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetBlockCompressionDicts returns all stored block compression dictionaries, the most recent dictionary first.
+func GetBlockCompressionDicts() []*dbtypes.BlockCompressionDict {
+	dicts := []*dbtypes.BlockCompressionDict{}
+	err := ReaderDb.Select(&dicts, `
+	SELECT dict_id, create_time, dict
+	FROM block_compression_dicts
+	ORDER BY dict_id DESC
+	`)
+	if err != nil {
+		logger.Errorf("Error while fetching block compression dicts: %v", err)
+		return nil
+	}
+	return dicts
+}
+
+// GetBlockBodySamples returns the most recent block bodies from the unfinalized & orphaned block tables.
+func GetBlockBodySamples(limit uint32) []*dbtypes.BlockBody {
+	bodies := []*dbtypes.BlockBody{}
+	err := ReaderDb.Select(&bodies, `
+	SELECT root, block_ver, block_ssz FROM (
+		SELECT root, block_ver, block_ssz
+		FROM unfinalized_blocks
+		ORDER BY slot DESC
+		LIMIT $1
+	) AS unfinalized
+	UNION ALL
+	SELECT root, block_ver, block_ssz FROM (
+		SELECT root, block_ver, block_ssz
+		FROM orphaned_blocks
+		LIMIT $1
+	) AS orphaned
+	`, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching block body samples: %v", err)
+		return nil
+	}
+	return bodies
+}
+
+// GetBlockBodiesWithoutFlag returns the block bodies from the given block table (unfinalized_blocks or orphaned_blocks)
+// whose version does not contain the given flag, ordered by root and starting after the given root.
+func GetBlockBodiesWithoutFlag(table string, flag uint64, afterRoot []byte, limit uint32) []*dbtypes.BlockBody {
+	if table != "unfinalized_blocks" && table != "orphaned_blocks" {
+		logger.Errorf("Error while fetching block bodies: unknown block table %v", table)
+		return nil
+	}
+
+	if afterRoot == nil {
+		afterRoot = []byte{}
+	}
+
+	bodies := []*dbtypes.BlockBody{}
+	err := ReaderDb.Select(&bodies, fmt.Sprintf(`
+	SELECT root, block_ver, block_ssz
+	FROM %v
+	WHERE (block_ver & $1) = 0 AND root > $2
+	ORDER BY root ASC
+	LIMIT $3
+	`, table), flag, afterRoot, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching block bodies from %v: %v", table, err)
+		return nil
+	}
+	return bodies
+}
+
+func UpdateBlockBody(table string, body *dbtypes.BlockBody, tx *sqlx.Tx) error {
+	if table != "unfinalized_blocks" && table != "orphaned_blocks" {
+		return fmt.Errorf("unknown block table %v", table)
+	}
+
+	_, err := tx.Exec(fmt.Sprintf(`UPDATE %v SET block_ver = $1, block_ssz = $2 WHERE root = $3`, table), body.BlockVer, body.BlockSSZ, body.Root)
+	if err != nil {
+		return err
+	}
+	return nil
+}
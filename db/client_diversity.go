@@ -0,0 +1,71 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// GetSlotClientFingerprints returns the graffiti, execution extra data, attestation delay & payload gas limits of the canonical blocks in the given slot range.
+// the gas limits are taken from the tx summaries of the block and its parent, so they're only available when the transaction indexer is enabled.
+func GetSlotClientFingerprints(minSlot uint64, maxSlot uint64) ([]*dbtypes.SlotClientFingerprint, error) {
+	fingerprints := []*dbtypes.SlotClientFingerprint{}
+	err := ReaderDb.Select(&fingerprints, `
+	SELECT slots.slot, COALESCE(slots.graffiti_text, '') AS graffiti_text, slots.eth_block_extra, slots.attestation_delay,
+		COALESCE(summary.gas_limit, 0) AS gas_limit, COALESCE(parent_summary.gas_limit, 0) AS parent_gas_limit
+	FROM slots
+	LEFT JOIN el_tx_summaries AS summary ON summary.block_root = slots.root
+	LEFT JOIN el_tx_summaries AS parent_summary ON parent_summary.block_root = slots.parent_root
+	WHERE slots.slot >= $1 AND slots.slot <= $2 AND slots.status = 1
+	ORDER BY slots.slot ASC`, minSlot, maxSlot)
+	if err != nil {
+		return nil, err
+	}
+	return fingerprints, nil
+}
+
+// InsertClientDiversity adds the block counts of the given entries to the client diversity buckets.
+func InsertClientDiversity(entries []*dbtypes.ClientDiversityEntry, tx *sqlx.Tx) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var sql strings.Builder
+	fmt.Fprint(&sql, `INSERT INTO client_diversity (bucket_epoch, layer, client, block_count) VALUES `)
+	argIdx := 0
+	fieldCount := 4
+	args := make([]any, len(entries)*fieldCount)
+	for idx, entry := range entries {
+		if idx > 0 {
+			fmt.Fprint(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "($%v, $%v, $%v, $%v)", argIdx+1, argIdx+2, argIdx+3, argIdx+4)
+		args[argIdx] = entry.BucketEpoch
+		args[argIdx+1] = entry.Layer
+		args[argIdx+2] = entry.Client
+		args[argIdx+3] = entry.BlockCount
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, ` ON CONFLICT (bucket_epoch, layer, client) DO UPDATE SET block_count = client_diversity.block_count + excluded.block_count`)
+
+	_, err := tx.Exec(sql.String(), args...)
+	return err
+}
+
+// GetClientDiversity returns the client diversity buckets starting at or after the given epoch in ascending order.
+func GetClientDiversity(ctx context.Context, fromEpoch uint64) ([]*dbtypes.ClientDiversityEntry, error) {
+	entries := []*dbtypes.ClientDiversityEntry{}
+	err := ReaderDb.SelectContext(ctx, &entries, `
+	SELECT bucket_epoch, layer, client, block_count
+	FROM client_diversity
+	WHERE bucket_epoch >= $1
+	ORDER BY bucket_epoch ASC, layer ASC, block_count DESC`, fromEpoch)
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -1,12 +1,15 @@
 package db
 
 import (
+	"context"
+	"database/sql/driver"
 	"embed"
 	"fmt"
+	"regexp"
 	"sync"
 	"time"
 
-	_ "github.com/glebarez/go-sqlite"
+	sqlite "github.com/glebarez/go-sqlite"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	"github.com/pressly/goose/v3"
@@ -33,6 +36,9 @@ var DbEngine dbtypes.DBEngineType
 var ReaderDb *sqlx.DB
 var writerDb *sqlx.DB
 var writerMutex sync.Mutex
+var registerSqliteRegexp sync.Once
+var sqliteRegexpMutex sync.Mutex
+var sqliteRegexpCache *regexp.Regexp
 
 var logger = logrus.StandardLogger().WithField("module", "db")
 
@@ -65,6 +71,11 @@ func mustInitSqlite(config *types.SqliteDatabaseConfig) (*sqlx.DB, *sqlx.DB) {
 		config.MaxIdleConns = config.MaxOpenConns
 	}
 
+	registerSqliteRegexp.Do(func() {
+		// sqlite has no builtin implementation for the REGEXP operator
+		sqlite.MustRegisterDeterministicScalarFunction("regexp", 2, sqliteRegexp)
+	})
+
 	logger.Infof("initializing sqlite connection to %v with %v/%v conn limit", config.File, config.MaxIdleConns, config.MaxOpenConns)
 	dbConn, err := sqlx.Open("sqlite", fmt.Sprintf("%s?_pragma=journal_mode(WAL)", config.File))
 	if err != nil {
@@ -82,6 +93,32 @@ func mustInitSqlite(config *types.SqliteDatabaseConfig) (*sqlx.DB, *sqlx.DB) {
 	return dbConn, dbConn
 }
 
+// sqliteRegexp implements "value REGEXP pattern" for sqlite, the last compiled pattern is reused for the following rows.
+func sqliteRegexp(ctx *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	pattern, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("regexp pattern must be a string")
+	}
+	value, ok := args[1].(string)
+	if !ok {
+		return false, nil
+	}
+
+	sqliteRegexpMutex.Lock()
+	if sqliteRegexpCache == nil || sqliteRegexpCache.String() != pattern {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			sqliteRegexpMutex.Unlock()
+			return nil, err
+		}
+		sqliteRegexpCache = compiled
+	}
+	compiled := sqliteRegexpCache
+	sqliteRegexpMutex.Unlock()
+
+	return compiled.MatchString(value), nil
+}
+
 func mustInitPgsql(writer *types.PgsqlDatabaseConfig, reader *types.PgsqlDatabaseConfig) (*sqlx.DB, *sqlx.DB) {
 	if writer.MaxOpenConns == 0 {
 		writer.MaxOpenConns = 50
@@ -130,20 +167,20 @@ func mustInitPgsql(writer *types.PgsqlDatabaseConfig, reader *types.PgsqlDatabas
 }
 
 func MustInitDB() {
-	if utils.Config.Database.Engine == "sqlite" {
-		sqliteConfig := (*types.SqliteDatabaseConfig)(&utils.Config.Database.Sqlite)
+	if utils.Config().Database.Engine == "sqlite" {
+		sqliteConfig := (*types.SqliteDatabaseConfig)(&utils.Config().Database.Sqlite)
 		DbEngine = dbtypes.DBEngineSqlite
 		writerDb, ReaderDb = mustInitSqlite(sqliteConfig)
-	} else if utils.Config.Database.Engine == "pgsql" {
-		readerConfig := (*types.PgsqlDatabaseConfig)(&utils.Config.Database.Pgsql)
-		writerConfig := (*types.PgsqlDatabaseConfig)(&utils.Config.Database.PgsqlWriter)
+	} else if utils.Config().Database.Engine == "pgsql" {
+		readerConfig := (*types.PgsqlDatabaseConfig)(&utils.Config().Database.Pgsql)
+		writerConfig := (*types.PgsqlDatabaseConfig)(&utils.Config().Database.PgsqlWriter)
 		if writerConfig.Host == "" {
 			writerConfig = readerConfig
 		}
 		DbEngine = dbtypes.DBEnginePgsql
 		writerDb, ReaderDb = mustInitPgsql(writerConfig, readerConfig)
 	} else {
-		logger.Fatalf("unknown database engine type: %s", utils.Config.Database.Engine)
+		logger.Fatalf("unknown database engine type: %s", utils.Config().Database.Engine)
 	}
 }
 
@@ -158,13 +195,23 @@ func MustCloseDB() {
 	}
 }
 
-func RunDBTransaction(handler func(tx *sqlx.Tx) error) error {
+func RunDBTransaction(handler func(tx *sqlx.Tx) error) (err error) {
+	return RunDBTransactionContext(context.Background(), handler)
+}
+
+// RunDBTransactionContext runs the handler in a db transaction that is rolled back if the context gets cancelled before the commit.
+func RunDBTransactionContext(ctx context.Context, handler func(tx *sqlx.Tx) error) (err error) {
 	if DbEngine == dbtypes.DBEngineSqlite {
 		writerMutex.Lock()
 		defer writerMutex.Unlock()
 	}
 
-	tx, err := writerDb.Beginx()
+	txStart := time.Now()
+	defer func() {
+		trackTransaction(time.Since(txStart), err)
+	}()
+
+	tx, err := writerDb.BeginTxx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("error starting db transactions: %v", err)
 	}
@@ -226,3 +273,33 @@ func EngineQuery(queryMap map[dbtypes.DBEngineType]string) string {
 	}
 	return queryMap[dbtypes.DBEngineAny]
 }
+
+// ResetDatabase deletes all indexed data from the database, keeping the schema and migration state.
+func ResetDatabase() error {
+	tables := []string{}
+	err := writerDb.Select(&tables, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  `SELECT tablename FROM pg_tables WHERE schemaname = current_schema()`,
+		dbtypes.DBEngineSqlite: `SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'`,
+	}))
+	if err != nil {
+		return fmt.Errorf("error loading table list: %v", err)
+	}
+
+	return RunDBTransaction(func(tx *sqlx.Tx) error {
+		for _, table := range tables {
+			if table == goose.TableName() {
+				continue
+			}
+
+			_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+				dbtypes.DBEnginePgsql:  fmt.Sprintf(`TRUNCATE TABLE "%v"`, table),
+				dbtypes.DBEngineSqlite: fmt.Sprintf(`DELETE FROM "%v"`, table),
+			}))
+			if err != nil {
+				return fmt.Errorf("error clearing table %v: %v", table, err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -15,11 +16,11 @@ func InsertConsolidationRequestTxs(consolidationTxs []*dbtypes.ConsolidationRequ
 			dbtypes.DBEnginePgsql:  "INSERT INTO consolidation_request_txs ",
 			dbtypes.DBEngineSqlite: "INSERT OR REPLACE INTO consolidation_request_txs ",
 		}),
-		"(block_number, block_index, block_time, block_root, fork_id, source_address, source_pubkey, source_index, target_pubkey, target_index, tx_hash, tx_sender, tx_target, dequeue_block)",
+		"(block_number, block_index, block_time, block_root, fork_id, source_address, source_pubkey, source_index, target_pubkey, target_index, tx_hash, tx_sender, tx_target, dequeue_block, tx_fee)",
 		" VALUES ",
 	)
 	argIdx := 0
-	fieldCount := 14
+	fieldCount := 15
 
 	args := make([]any, len(consolidationTxs)*fieldCount)
 	for i, consolidationTx := range consolidationTxs {
@@ -50,10 +51,11 @@ func InsertConsolidationRequestTxs(consolidationTxs []*dbtypes.ConsolidationRequ
 		args[argIdx+11] = consolidationTx.TxSender
 		args[argIdx+12] = consolidationTx.TxTarget
 		args[argIdx+13] = consolidationTx.DequeueBlock
+		args[argIdx+14] = consolidationTx.TxFee
 		argIdx += fieldCount
 	}
 	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
-		dbtypes.DBEnginePgsql:  " ON CONFLICT (block_root, block_index) DO UPDATE SET source_index = excluded.source_index, target_index = excluded.target_index, fork_id = excluded.fork_id",
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (block_root, block_index) DO UPDATE SET source_index = excluded.source_index, target_index = excluded.target_index, fork_id = excluded.fork_id, tx_fee = excluded.tx_fee",
 		dbtypes.DBEngineSqlite: "",
 	}))
 
@@ -64,10 +66,10 @@ func InsertConsolidationRequestTxs(consolidationTxs []*dbtypes.ConsolidationRequ
 	return nil
 }
 
-func GetConsolidationRequestTxsByDequeueRange(dequeueFirst uint64, dequeueLast uint64) []*dbtypes.ConsolidationRequestTx {
+func GetConsolidationRequestTxsByDequeueRange(ctx context.Context, dequeueFirst uint64, dequeueLast uint64) []*dbtypes.ConsolidationRequestTx {
 	consolidationTxs := []*dbtypes.ConsolidationRequestTx{}
 
-	err := ReaderDb.Select(&consolidationTxs, `SELECT consolidation_request_txs.*
+	err := ReaderDb.SelectContext(ctx, &consolidationTxs, `SELECT consolidation_request_txs.*
 		FROM consolidation_request_txs
 		WHERE dequeue_block >= $1 AND dequeue_block <= $2
 		ORDER BY dequeue_block ASC, block_number ASC, block_index ASC
@@ -80,7 +82,7 @@ func GetConsolidationRequestTxsByDequeueRange(dequeueFirst uint64, dequeueLast u
 	return consolidationTxs
 }
 
-func GetConsolidationRequestTxsByTxHashes(txHashes [][]byte) []*dbtypes.ConsolidationRequestTx {
+func GetConsolidationRequestTxsByTxHashes(ctx context.Context, txHashes [][]byte) []*dbtypes.ConsolidationRequestTx {
 	var sql strings.Builder
 	args := []interface{}{}
 
@@ -99,7 +101,7 @@ func GetConsolidationRequestTxsByTxHashes(txHashes [][]byte) []*dbtypes.Consolid
 	fmt.Fprintf(&sql, ")")
 
 	consolidationTxs := []*dbtypes.ConsolidationRequestTx{}
-	err := ReaderDb.Select(&consolidationTxs, sql.String(), args...)
+	err := ReaderDb.SelectContext(ctx, &consolidationTxs, sql.String(), args...)
 	if err != nil {
 		logger.Errorf("Error while fetching consolidation request txs: %v", err)
 		return nil
@@ -108,13 +110,13 @@ func GetConsolidationRequestTxsByTxHashes(txHashes [][]byte) []*dbtypes.Consolid
 	return consolidationTxs
 }
 
-func GetConsolidationRequestTxsFiltered(offset uint64, limit uint32, canonicalForkIds []uint64, filter *dbtypes.ConsolidationRequestTxFilter) ([]*dbtypes.ConsolidationRequestTx, uint64, error) {
+func GetConsolidationRequestTxsFiltered(ctx context.Context, offset uint64, limit uint32, canonicalForkIds []uint64, filter *dbtypes.ConsolidationRequestTxFilter) ([]*dbtypes.ConsolidationRequestTx, uint64, error) {
 	var sql strings.Builder
 	args := []interface{}{}
 	fmt.Fprint(&sql, `
 	WITH cte AS (
 		SELECT
-			block_number, block_index, block_time, block_root, fork_id, source_address, source_pubkey, source_index, target_pubkey, target_index, tx_hash, tx_sender, tx_target, dequeue_block
+			block_number, block_index, block_time, block_root, fork_id, source_address, source_pubkey, source_index, target_pubkey, target_index, tx_hash, tx_sender, tx_target, dequeue_block, tx_fee
 		FROM consolidation_request_txs
 	`)
 
@@ -223,7 +225,8 @@ func GetConsolidationRequestTxsFiltered(offset uint64, limit uint32, canonicalFo
 		null AS tx_hash,
 		null AS tx_sender,
 		null AS tx_target,
-		0 AS dequeue_block
+		0 AS dequeue_block,
+		null AS tx_fee
 	FROM cte
 	UNION ALL SELECT * FROM (
 	SELECT * FROM cte
@@ -238,7 +241,7 @@ func GetConsolidationRequestTxsFiltered(offset uint64, limit uint32, canonicalFo
 	fmt.Fprintf(&sql, ") AS t1")
 
 	consolidationRequestTxs := []*dbtypes.ConsolidationRequestTx{}
-	err := ReaderDb.Select(&consolidationRequestTxs, sql.String(), args...)
+	err := ReaderDb.SelectContext(ctx, &consolidationRequestTxs, sql.String(), args...)
 	if err != nil {
 		logger.Errorf("Error while fetching filtered consolidation request txs: %v", err)
 		return nil, 0, err
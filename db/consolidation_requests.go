@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -60,7 +61,7 @@ func InsertConsolidationRequests(consolidations []*dbtypes.ConsolidationRequest,
 	return nil
 }
 
-func GetConsolidationRequestsFiltered(offset uint64, limit uint32, canonicalForkIds []uint64, filter *dbtypes.ConsolidationRequestFilter) ([]*dbtypes.ConsolidationRequest, uint64, error) {
+func GetConsolidationRequestsFiltered(ctx context.Context, offset uint64, limit uint32, canonicalForkIds []uint64, filter *dbtypes.ConsolidationRequestFilter) ([]*dbtypes.ConsolidationRequest, uint64, error) {
 	var sql strings.Builder
 	args := []interface{}{}
 	fmt.Fprint(&sql, `
@@ -188,7 +189,7 @@ func GetConsolidationRequestsFiltered(offset uint64, limit uint32, canonicalFork
 	fmt.Fprintf(&sql, ") AS t1")
 
 	consolidationRequests := []*dbtypes.ConsolidationRequest{}
-	err := ReaderDb.Select(&consolidationRequests, sql.String(), args...)
+	err := ReaderDb.SelectContext(ctx, &consolidationRequests, sql.String(), args...)
 	if err != nil {
 		logger.Errorf("Error while fetching filtered consolidation requests: %v", err)
 		return nil, 0, err
@@ -221,3 +222,26 @@ func UpdateConsolidationRequestTxHash(slotRoot []byte, slotIndex uint64, txHash
 	}
 	return nil
 }
+
+// GetConsolidationRequestCounts returns the number of included consolidation requests up to the given slot.
+// requests with the same source & target validator are counted as credential switches (0x01 -> 0x02).
+func GetConsolidationRequestCounts(ctx context.Context, maxSlot uint64) (consolidations uint64, credentialSwitches uint64) {
+	counts := struct {
+		Consolidations     uint64 `db:"consolidations"`
+		CredentialSwitches uint64 `db:"credential_switches"`
+	}{}
+
+	err := ReaderDb.GetContext(ctx, &counts, `
+		SELECT
+			COALESCE(SUM(CASE WHEN source_index = target_index THEN 0 ELSE 1 END), 0) AS consolidations,
+			COALESCE(SUM(CASE WHEN source_index = target_index THEN 1 ELSE 0 END), 0) AS credential_switches
+		FROM consolidation_requests
+		WHERE orphaned = false AND slot_number <= $1
+	`, maxSlot)
+	if err != nil {
+		logger.Errorf("Error while fetching consolidation request counts: %v", err)
+		return 0, 0
+	}
+
+	return counts.Consolidations, counts.CredentialSwitches
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -9,6 +10,15 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+// depositContractArg returns the contract address of deposit_txs rows for the given deposit contract.
+// deposit txs from the deposit contract of the chain specs are stored with an empty contract address.
+func depositContractArg(contract []byte) []byte {
+	if len(contract) == 0 {
+		return []byte{}
+	}
+	return contract
+}
+
 func InsertDepositTxs(depositTxs []*dbtypes.DepositTx, tx *sqlx.Tx) error {
 	var sql strings.Builder
 	fmt.Fprint(&sql,
@@ -16,11 +26,11 @@ func InsertDepositTxs(depositTxs []*dbtypes.DepositTx, tx *sqlx.Tx) error {
 			dbtypes.DBEnginePgsql:  "INSERT INTO deposit_txs ",
 			dbtypes.DBEngineSqlite: "INSERT OR REPLACE INTO deposit_txs ",
 		}),
-		"(deposit_index, block_number, block_time, block_root, publickey, withdrawalcredentials, amount, signature, valid_signature, orphaned, tx_hash, tx_sender, tx_target, fork_id)",
+		"(deposit_index, block_number, block_time, block_root, publickey, withdrawalcredentials, amount, signature, valid_signature, orphaned, tx_hash, tx_sender, tx_target, fork_id, contract_address)",
 		" VALUES ",
 	)
 	argIdx := 0
-	fieldCount := 14
+	fieldCount := 15
 
 	args := make([]any, len(depositTxs)*fieldCount)
 	for i, depositTx := range depositTxs {
@@ -51,10 +61,11 @@ func InsertDepositTxs(depositTxs []*dbtypes.DepositTx, tx *sqlx.Tx) error {
 		args[argIdx+11] = depositTx.TxSender
 		args[argIdx+12] = depositTx.TxTarget
 		args[argIdx+13] = depositTx.ForkId
+		args[argIdx+14] = depositContractArg(depositTx.ContractAddress)
 		argIdx += fieldCount
 	}
 	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
-		dbtypes.DBEnginePgsql:  " ON CONFLICT (deposit_index, block_root) DO UPDATE SET orphaned = excluded.orphaned",
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (contract_address, deposit_index, block_root) DO UPDATE SET orphaned = excluded.orphaned",
 		dbtypes.DBEngineSqlite: "",
 	}))
 
@@ -115,17 +126,19 @@ func InsertDeposits(deposits []*dbtypes.Deposit, tx *sqlx.Tx) error {
 	return nil
 }
 
-func GetDepositTxs(firstIndex uint64, limit uint32) []*dbtypes.DepositTx {
+// GetDepositTxs returns the latest deposit txs of the deposit contract from the chain specs.
+func GetDepositTxs(ctx context.Context, firstIndex uint64, limit uint32) []*dbtypes.DepositTx {
 	var sql strings.Builder
-	args := []any{}
+	args := []any{depositContractArg(nil)}
 	fmt.Fprint(&sql, `
 	SELECT
-		deposit_index, block_number, block_time, block_root, publickey, withdrawalcredentials, amount, signature, valid_signature, orphaned, tx_hash, tx_sender, tx_target, fork_id
+		deposit_index, block_number, block_time, block_root, publickey, withdrawalcredentials, amount, signature, valid_signature, orphaned, tx_hash, tx_sender, tx_target, fork_id, contract_address
 	FROM deposit_txs
+	WHERE contract_address = $1
 	`)
 	if firstIndex > 0 {
 		args = append(args, firstIndex)
-		fmt.Fprintf(&sql, " WHERE deposit_index <= $%v ", len(args))
+		fmt.Fprintf(&sql, " AND deposit_index <= $%v ", len(args))
 	}
 
 	args = append(args, limit)
@@ -135,7 +148,7 @@ func GetDepositTxs(firstIndex uint64, limit uint32) []*dbtypes.DepositTx {
 	`, len(args))
 
 	depositTxs := []*dbtypes.DepositTx{}
-	err := ReaderDb.Select(&depositTxs, sql.String(), args...)
+	err := ReaderDb.SelectContext(ctx, &depositTxs, sql.String(), args...)
 	if err != nil {
 		logger.Errorf("Error while fetching deposit txs: %v", err)
 		return nil
@@ -143,17 +156,37 @@ func GetDepositTxs(firstIndex uint64, limit uint32) []*dbtypes.DepositTx {
 	return depositTxs
 }
 
-func GetDepositTxsFiltered(offset uint64, limit uint32, finalizedBlock uint64, filter *dbtypes.DepositTxFilter) ([]*dbtypes.DepositTx, uint64, error) {
+// GetCanonicalDepositTxs returns the non-orphaned deposit txs of the given deposit contract included up to the given block number,
+// ordered by deposit index and starting at the given index.
+func GetCanonicalDepositTxs(contract []byte, firstIndex uint64, maxBlock uint64, limit uint32) []*dbtypes.DepositTx {
+	depositTxs := []*dbtypes.DepositTx{}
+	err := ReaderDb.Select(&depositTxs, `
+	SELECT
+		deposit_index, block_number, block_time, block_root, publickey, withdrawalcredentials, amount, signature, valid_signature, orphaned, tx_hash, tx_sender, tx_target, fork_id, contract_address
+	FROM deposit_txs
+	WHERE contract_address = $1 AND deposit_index >= $2 AND block_number <= $3 AND orphaned = false
+	ORDER BY deposit_index ASC, block_number ASC
+	LIMIT $4
+	`, depositContractArg(contract), firstIndex, maxBlock, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching canonical deposit txs: %v", err)
+		return nil
+	}
+	return depositTxs
+}
+
+func GetDepositTxsFiltered(ctx context.Context, offset uint64, limit uint32, finalizedBlock uint64, filter *dbtypes.DepositTxFilter) ([]*dbtypes.DepositTx, uint64, error) {
 	var sql strings.Builder
-	args := []any{}
+	args := []any{depositContractArg(filter.Contract)}
 	fmt.Fprint(&sql, `
 	WITH cte AS (
 		SELECT
-			deposit_index, block_number, block_time, block_root, publickey, withdrawalcredentials, amount, signature, valid_signature, orphaned, tx_hash, tx_sender, tx_target, fork_id
+			deposit_index, block_number, block_time, block_root, publickey, withdrawalcredentials, amount, signature, valid_signature, orphaned, tx_hash, tx_sender, tx_target, fork_id, contract_address
 		FROM deposit_txs
+		WHERE contract_address = $1
 	`)
 
-	filterOp := "WHERE"
+	filterOp := "AND"
 	if filter.MinIndex > 0 {
 		args = append(args, filter.MinIndex)
 		fmt.Fprintf(&sql, " %v deposit_index >= $%v", filterOp, len(args))
@@ -217,6 +250,21 @@ func GetDepositTxsFiltered(offset uint64, limit uint32, finalizedBlock uint64, f
 		fmt.Fprintf(&sql, " %v valid_signature = false", filterOp)
 		filterOp = "AND"
 	}
+	if filter.DepositType > 0 {
+		// a top-up is any deposit following a valid initial deposit for the same pubkey
+		existsOp := "NOT EXISTS"
+		if filter.DepositType == 2 {
+			existsOp = "EXISTS"
+		}
+		fmt.Fprintf(&sql, ` %v %v (
+			SELECT 1 FROM deposit_txs AS initial_txs 
+			WHERE initial_txs.contract_address = deposit_txs.contract_address AND initial_txs.publickey = deposit_txs.publickey AND initial_txs.deposit_index < deposit_txs.deposit_index AND initial_txs.valid_signature = true AND initial_txs.orphaned = false
+		)`, filterOp, existsOp)
+		if filter.DepositType == 1 {
+			fmt.Fprintf(&sql, " AND valid_signature = true")
+		}
+		filterOp = "AND"
+	}
 
 	args = append(args, limit)
 	fmt.Fprintf(&sql, `) 
@@ -234,7 +282,8 @@ func GetDepositTxsFiltered(offset uint64, limit uint32, finalizedBlock uint64, f
 		null AS tx_hash, 
 		null AS tx_sender, 
 		null AS tx_target,
-		0 AS fork_id
+		0 AS fork_id,
+		null AS contract_address
 	FROM cte
 	UNION ALL SELECT * FROM (
 	SELECT * FROM cte
@@ -249,7 +298,7 @@ func GetDepositTxsFiltered(offset uint64, limit uint32, finalizedBlock uint64, f
 	fmt.Fprintf(&sql, ") AS t1")
 
 	depositTxs := []*dbtypes.DepositTx{}
-	err := ReaderDb.Select(&depositTxs, sql.String(), args...)
+	err := ReaderDb.SelectContext(ctx, &depositTxs, sql.String(), args...)
 	if err != nil {
 		logger.Errorf("Error while fetching filtered deposit txs: %v", err)
 		return nil, 0, err
@@ -258,7 +307,7 @@ func GetDepositTxsFiltered(offset uint64, limit uint32, finalizedBlock uint64, f
 	return depositTxs[1:], depositTxs[0].Index, nil
 }
 
-func GetDepositsFiltered(offset uint64, limit uint32, finalizedBlock uint64, filter *dbtypes.DepositFilter) ([]*dbtypes.Deposit, uint64, error) {
+func GetDepositsFiltered(ctx context.Context, offset uint64, limit uint32, finalizedBlock uint64, filter *dbtypes.DepositFilter) ([]*dbtypes.Deposit, uint64, error) {
 	var sql strings.Builder
 	args := []any{}
 	fmt.Fprint(&sql, `
@@ -330,7 +379,7 @@ func GetDepositsFiltered(offset uint64, limit uint32, finalizedBlock uint64, fil
 	fmt.Fprintf(&sql, ") AS t1")
 
 	deposits := []*dbtypes.Deposit{}
-	err := ReaderDb.Select(&deposits, sql.String(), args...)
+	err := ReaderDb.SelectContext(ctx, &deposits, sql.String(), args...)
 	if err != nil {
 		logger.Errorf("Error while fetching filtered deposits: %v", err)
 		return nil, 0, err
@@ -338,3 +387,185 @@ func GetDepositsFiltered(offset uint64, limit uint32, finalizedBlock uint64, fil
 
 	return deposits[1:], deposits[0].SlotNumber, nil
 }
+
+// GetDepositTxPubkeyStats returns the aggregated deposits to the deposit contract from the chain specs for the given pubkeys.
+// only deposits from the initial deposit onwards are counted, as deposits before a valid initial deposit are ignored by the beacon chain.
+func GetDepositTxPubkeyStats(ctx context.Context, pubkeys [][]byte) []*dbtypes.DepositTxPubkeyStats {
+	stats := []*dbtypes.DepositTxPubkeyStats{}
+	if len(pubkeys) == 0 {
+		return stats
+	}
+
+	var sql strings.Builder
+	args := make([]any, len(pubkeys)+1)
+	args[0] = depositContractArg(nil)
+	fmt.Fprint(&sql, `
+	WITH initial_idx AS (
+		SELECT publickey, MIN(deposit_index) AS first_index
+		FROM deposit_txs
+		WHERE contract_address = $1 AND valid_signature = true AND orphaned = false AND publickey IN (`)
+	for i, pubkey := range pubkeys {
+		if i > 0 {
+			fmt.Fprint(&sql, ", ")
+		}
+		args[i+1] = pubkey
+		fmt.Fprintf(&sql, "$%v", i+2)
+	}
+	fmt.Fprint(&sql, `)
+		GROUP BY publickey
+	), initial_txs AS (
+		SELECT
+			initial_idx.publickey, initial_idx.first_index,
+			deposit_txs.withdrawalcredentials AS first_credentials, deposit_txs.tx_sender AS first_sender
+		FROM initial_idx
+		JOIN deposit_txs ON deposit_txs.contract_address = $1 AND deposit_txs.publickey = initial_idx.publickey AND deposit_txs.deposit_index = initial_idx.first_index AND deposit_txs.orphaned = false
+	)
+	SELECT
+		deposit_txs.publickey,
+		initial_txs.first_index,
+		initial_txs.first_credentials,
+		initial_txs.first_sender,
+		COUNT(*) AS deposit_count,
+		SUM(deposit_txs.amount) AS amount,
+		SUM(CASE WHEN deposit_txs.withdrawalcredentials != initial_txs.first_credentials AND deposit_txs.tx_sender != initial_txs.first_sender THEN 1 ELSE 0 END) AS frontrun_count
+	FROM deposit_txs
+	JOIN initial_txs ON initial_txs.publickey = deposit_txs.publickey
+	WHERE deposit_txs.contract_address = $1 AND deposit_txs.orphaned = false AND deposit_txs.deposit_index >= initial_txs.first_index
+	GROUP BY deposit_txs.publickey, initial_txs.first_index, initial_txs.first_credentials, initial_txs.first_sender
+	`)
+
+	err := ReaderDb.SelectContext(ctx, &stats, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching deposit pubkey stats: %v", err)
+		return nil
+	}
+
+	return stats
+}
+
+// GetDepositTxsBySlotRoot returns the deposit transactions matching the deposits included in the given beacon block.
+// deposits are joined by deposit index and pubkey with the deposit txs of the deposit contract from the chain specs,
+// non-orphaned deposit transactions are returned first.
+func GetDepositTxsBySlotRoot(ctx context.Context, slotRoot []byte) []*dbtypes.SlotDepositTx {
+	depositTxs := []*dbtypes.SlotDepositTx{}
+	err := ReaderDb.SelectContext(ctx, &depositTxs, `
+	SELECT
+		deposits.slot_index, deposit_txs.deposit_index, deposit_txs.block_number, deposit_txs.block_time, deposit_txs.block_root,
+		deposit_txs.publickey, deposit_txs.withdrawalcredentials, deposit_txs.amount, deposit_txs.signature, deposit_txs.valid_signature,
+		deposit_txs.orphaned, deposit_txs.tx_hash, deposit_txs.tx_sender, deposit_txs.tx_target, deposit_txs.fork_id, deposit_txs.contract_address
+	FROM deposits
+	JOIN deposit_txs ON deposit_txs.contract_address = $2 AND deposit_txs.deposit_index = deposits.deposit_index AND deposit_txs.publickey = deposits.publickey
+	WHERE deposits.slot_root = $1
+	ORDER BY deposits.slot_index ASC, deposit_txs.orphaned ASC
+	`, slotRoot, depositContractArg(nil))
+	if err != nil {
+		logger.Errorf("Error while fetching deposit txs by slot root: %v", err)
+		return nil
+	}
+	return depositTxs
+}
+
+// GetDepositTxsByPubkeys returns all deposit transactions to the deposit contract from the chain specs for the given pubkeys.
+func GetDepositTxsByPubkeys(ctx context.Context, pubkeys [][]byte) []*dbtypes.DepositTx {
+	depositTxs := []*dbtypes.DepositTx{}
+	if len(pubkeys) == 0 {
+		return depositTxs
+	}
+
+	var sql strings.Builder
+	args := make([]any, len(pubkeys)+1)
+	args[0] = depositContractArg(nil)
+	fmt.Fprint(&sql, `
+	SELECT
+		deposit_index, block_number, block_time, block_root, publickey, withdrawalcredentials, amount, signature, valid_signature, orphaned, tx_hash, tx_sender, tx_target, fork_id, contract_address
+	FROM deposit_txs
+	WHERE contract_address = $1 AND publickey IN (`)
+	for i, pubkey := range pubkeys {
+		if i > 0 {
+			fmt.Fprint(&sql, ", ")
+		}
+		args[i+1] = pubkey
+		fmt.Fprintf(&sql, "$%v", i+2)
+	}
+	fmt.Fprint(&sql, `)
+	ORDER BY deposit_index ASC, orphaned ASC
+	`)
+
+	err := ReaderDb.SelectContext(ctx, &depositTxs, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching deposit txs by pubkeys: %v", err)
+		return nil
+	}
+	return depositTxs
+}
+
+// GetGenesisDepositValidators returns the validators from all valid deposits to the deposit contract from the chain specs before the given block time, aggregated by pubkey.
+// the returned summary holds the total number of validators (DepositCount), the total deposit amount (Amount) and the number
+// of validators with at least minBalance deposited (FirstIndex).
+func GetGenesisDepositValidators(ctx context.Context, maxBlockTime uint64, minBalance uint64, limit uint32) ([]*dbtypes.GenesisDepositValidator, *dbtypes.GenesisDepositValidator, error) {
+	var sql strings.Builder
+	args := []any{maxBlockTime, minBalance, depositContractArg(nil)}
+	fmt.Fprint(&sql, `
+	WITH cte AS (
+		SELECT
+			publickey,
+			SUM(amount) AS amount,
+			COUNT(*) AS deposit_count,
+			MIN(deposit_index) AS first_index
+		FROM deposit_txs
+		WHERE contract_address = $3 AND block_time < $1 AND valid_signature = true AND orphaned = false
+		GROUP BY publickey
+	)
+	SELECT
+		null AS publickey,
+		null AS withdrawalcredentials,
+		COALESCE(SUM(amount), 0) AS amount,
+		count(*) AS deposit_count,
+		COALESCE(SUM(CASE WHEN amount >= $2 THEN 1 ELSE 0 END), 0) AS first_index
+	FROM cte
+	UNION ALL SELECT * FROM (
+	SELECT
+		cte.publickey,
+		deposit_txs.withdrawalcredentials,
+		cte.amount,
+		cte.deposit_count,
+		cte.first_index
+	FROM cte
+	LEFT JOIN deposit_txs ON deposit_txs.contract_address = $3 AND deposit_txs.deposit_index = cte.first_index AND deposit_txs.orphaned = false
+	`)
+
+	args = append(args, limit)
+	fmt.Fprintf(&sql, `
+	ORDER BY cte.first_index ASC
+	LIMIT $%v
+	) AS t1`, len(args))
+
+	validators := []*dbtypes.GenesisDepositValidator{}
+	err := ReaderDb.SelectContext(ctx, &validators, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching genesis deposit validators: %v", err)
+		return nil, nil, err
+	}
+
+	return validators[1:], validators[0], nil
+}
+
+// GetDepositAmountsByEpoch returns the aggregated canonical deposits included in the given epoch range.
+func GetDepositAmountsByEpoch(firstEpoch uint64, lastEpoch uint64, slotsPerEpoch uint64) []*dbtypes.DepositEpochAmount {
+	amounts := []*dbtypes.DepositEpochAmount{}
+	err := ReaderDb.Select(&amounts, `
+	SELECT
+		slot_number / $3 AS epoch,
+		COUNT(*) AS deposit_count,
+		SUM(amount) AS amount
+	FROM deposits
+	WHERE orphaned = false AND slot_number >= $1 AND slot_number < $2
+	GROUP BY slot_number / $3
+	`, firstEpoch*slotsPerEpoch, (lastEpoch+1)*slotsPerEpoch, slotsPerEpoch)
+	if err != nil {
+		logger.Errorf("Error while fetching deposit amounts by epoch: %v", err)
+		return nil
+	}
+
+	return amounts
+}
@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertElTxSummaries(summaries []*dbtypes.ElTxSummary, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  `INSERT INTO el_tx_summaries (block_root, slot, block_number, block_hash, tx_count, blob_tx_count, contract_creations, gas_used, gas_limit, base_fee, top_targets) VALUES `,
+		dbtypes.DBEngineSqlite: `INSERT OR REPLACE INTO el_tx_summaries (block_root, slot, block_number, block_hash, tx_count, blob_tx_count, contract_creations, gas_used, gas_limit, base_fee, top_targets) VALUES `,
+	}))
+	argIdx := 0
+	fieldCount := 11
+	args := make([]any, len(summaries)*fieldCount)
+	for i, summary := range summaries {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "(")
+		for f := 0; f < fieldCount; f++ {
+			if f > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "$%v", argIdx+f+1)
+		}
+		fmt.Fprintf(&sql, ")")
+
+		args[argIdx+0] = summary.BlockRoot
+		args[argIdx+1] = summary.Slot
+		args[argIdx+2] = summary.BlockNumber
+		args[argIdx+3] = summary.BlockHash
+		args[argIdx+4] = summary.TxCount
+		args[argIdx+5] = summary.BlobTxCount
+		args[argIdx+6] = summary.ContractCreations
+		args[argIdx+7] = summary.GasUsed
+		args[argIdx+8] = summary.GasLimit
+		args[argIdx+9] = summary.BaseFee
+		args[argIdx+10] = summary.TopTargets
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  ` ON CONFLICT (block_root) DO NOTHING`,
+		dbtypes.DBEngineSqlite: "",
+	}))
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetElTxSummary(ctx context.Context, blockRoot []byte) *dbtypes.ElTxSummary {
+	summary := dbtypes.ElTxSummary{}
+	err := ReaderDb.GetContext(ctx, &summary, `
+	SELECT
+		block_root, slot, block_number, block_hash, tx_count, blob_tx_count, contract_creations, gas_used, gas_limit, base_fee, top_targets
+	FROM el_tx_summaries
+	WHERE block_root = $1
+	`, blockRoot)
+	if err != nil {
+		return nil
+	}
+	return &summary
+}
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertElectraStats(stats *dbtypes.ElectraStats, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql: `
+			INSERT INTO electra_stats (
+				epoch, bls_validators, exec_validators, compounding_validators, compounding_balance, compounding_max_eb,
+				total_effective_balance, consolidations, credential_switches
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			ON CONFLICT (epoch) DO UPDATE SET
+				bls_validators = excluded.bls_validators,
+				exec_validators = excluded.exec_validators,
+				compounding_validators = excluded.compounding_validators,
+				compounding_balance = excluded.compounding_balance,
+				compounding_max_eb = excluded.compounding_max_eb,
+				total_effective_balance = excluded.total_effective_balance,
+				consolidations = excluded.consolidations,
+				credential_switches = excluded.credential_switches`,
+		dbtypes.DBEngineSqlite: `
+			INSERT OR REPLACE INTO electra_stats (
+				epoch, bls_validators, exec_validators, compounding_validators, compounding_balance, compounding_max_eb,
+				total_effective_balance, consolidations, credential_switches
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+	}),
+		stats.Epoch, stats.BlsValidators, stats.ExecValidators, stats.CompoundingValidators, stats.CompoundingBalance, stats.CompoundingMaxEb,
+		stats.TotalEffectiveBalance, stats.Consolidations, stats.CredentialSwitches)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetElectraStats(ctx context.Context, limit uint32) []*dbtypes.ElectraStats {
+	stats := []*dbtypes.ElectraStats{}
+	err := ReaderDb.SelectContext(ctx, &stats, `
+	SELECT
+		epoch, bls_validators, exec_validators, compounding_validators, compounding_balance, compounding_max_eb,
+		total_effective_balance, consolidations, credential_switches
+	FROM electra_stats
+	ORDER BY epoch DESC
+	LIMIT $1
+	`, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching electra stats: %v", err)
+		return nil
+	}
+	return stats
+}
+
+// GetElectraStatsAtEpoch returns the latest electra stats snapshot taken at or before the given epoch.
+func GetElectraStatsAtEpoch(ctx context.Context, epoch uint64) *dbtypes.ElectraStats {
+	stats := dbtypes.ElectraStats{}
+	err := ReaderDb.GetContext(ctx, &stats, `
+	SELECT
+		epoch, bls_validators, exec_validators, compounding_validators, compounding_balance, compounding_max_eb,
+		total_effective_balance, consolidations, credential_switches
+	FROM electra_stats
+	WHERE epoch <= $1
+	ORDER BY epoch DESC
+	LIMIT 1
+	`, epoch)
+	if err != nil {
+		return nil
+	}
+	return &stats
+}
@@ -1,15 +1,16 @@
 package db
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/ethpandaops/dora/dbtypes"
 	"github.com/jmoiron/sqlx"
 )
 
-func GetExplorerState(key string, returnValue interface{}) (interface{}, error) {
+func GetExplorerState(ctx context.Context, key string, returnValue interface{}) (interface{}, error) {
 	entry := dbtypes.ExplorerState{}
-	err := ReaderDb.Get(&entry, `SELECT key, value FROM explorer_state WHERE key = $1`, key)
+	err := ReaderDb.GetContext(ctx, &entry, `SELECT key, value FROM explorer_state WHERE key = $1`, key)
 	if err != nil {
 		return nil, err
 	}
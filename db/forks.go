@@ -151,3 +151,113 @@ func GetForkById(forkId uint64) *dbtypes.Fork {
 
 	return &fork
 }
+
+// GetOrphanedForksBefore returns forks that ended before the given slot, i.e. forks without any persisted block at or after the slot.
+// canonical forks are removed from the forks table on finalization, so all remaining forks before the finalized slot are orphaned.
+func GetOrphanedForksBefore(slot uint64, limit uint32) []*dbtypes.Fork {
+	forks := []*dbtypes.Fork{}
+
+	err := ReaderDb.Select(&forks, `SELECT fork_id, base_slot, base_root, leaf_slot, leaf_root, parent_fork
+		FROM forks
+		WHERE leaf_slot < $1 
+			AND NOT EXISTS (SELECT 1 FROM slots WHERE slots.fork_id = forks.fork_id AND slots.slot >= $1)
+			AND NOT EXISTS (SELECT 1 FROM unfinalized_blocks WHERE unfinalized_blocks.fork_id = forks.fork_id)
+		ORDER BY leaf_slot ASC
+		LIMIT $2
+	`, slot, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching orphaned forks: %v", err)
+		return nil
+	}
+
+	return forks
+}
+
+// OrphanedForkCleanupResult holds the number of deleted rows of an orphaned fork cleanup.
+type OrphanedForkCleanupResult struct {
+	Forks          uint64 // fork rows
+	Blocks         uint64 // orphaned slots & block bodies
+	Operations     uint64 // deposits, exits, slashings & el requests included in the orphaned blocks
+	ElTransactions uint64 // request transactions, watched contract logs & tx summaries indexed for the orphaned forks
+	Blobs          uint64 // blob sidecars of the orphaned blocks
+}
+
+// DeleteOrphanedForkData deletes the given orphaned forks and all orphaned blocks & operations that belong to them.
+func DeleteOrphanedForkData(forkIds []uint64, tx *sqlx.Tx) (*OrphanedForkCleanupResult, error) {
+	result := &OrphanedForkCleanupResult{}
+	if len(forkIds) == 0 {
+		return result, nil
+	}
+
+	forkIdStr := make([]string, len(forkIds))
+	for i, forkId := range forkIds {
+		forkIdStr[i] = fmt.Sprintf("%v", forkId)
+	}
+	forkIdList := strings.Join(forkIdStr, ",")
+	orphanedRoots := fmt.Sprintf("SELECT root FROM slots WHERE status = %d AND fork_id IN (%v)", dbtypes.Orphaned, forkIdList)
+
+	execDelete := func(sql string) (uint64, error) {
+		res, err := tx.Exec(sql)
+		if err != nil {
+			return 0, err
+		}
+		rows, _ := res.RowsAffected()
+		return uint64(rows), nil
+	}
+
+	// operations of the orphaned blocks, voluntary exits are persisted without fork id, so match them by block root
+	for _, table := range []string{"deposits", "voluntary_exits", "slashings", "withdrawal_requests", "consolidation_requests"} {
+		rows, err := execDelete(fmt.Sprintf("DELETE FROM %v WHERE orphaned = true AND slot_root IN (%v)", table, orphanedRoots))
+		if err != nil {
+			return nil, fmt.Errorf("error deleting orphaned %v: %v", table, err)
+		}
+		result.Operations += rows
+	}
+
+	for _, table := range []string{"withdrawal_request_txs", "consolidation_request_txs", "watched_contract_logs"} {
+		rows, err := execDelete(fmt.Sprintf("DELETE FROM %v WHERE fork_id IN (%v)", table, forkIdList))
+		if err != nil {
+			return nil, fmt.Errorf("error deleting orphaned %v: %v", table, err)
+		}
+		result.ElTransactions += rows
+	}
+
+	rows, err := execDelete(fmt.Sprintf("DELETE FROM el_tx_summaries WHERE block_root IN (%v)", orphanedRoots))
+	if err != nil {
+		return nil, fmt.Errorf("error deleting orphaned el_tx_summaries: %v", err)
+	}
+	result.ElTransactions += rows
+
+	// blob contents are stored by commitment, keep the ones that are still referenced by a sidecar of another block
+	orphanedSidecars := fmt.Sprintf("SELECT commitment FROM blob_sidecars WHERE block_root IN (%v)", orphanedRoots)
+	_, err = execDelete(fmt.Sprintf(`DELETE FROM blob_contents WHERE commitment IN (%v) AND NOT EXISTS (
+		SELECT 1 FROM blob_sidecars WHERE blob_sidecars.commitment = blob_contents.commitment AND blob_sidecars.block_root NOT IN (%v)
+	)`, orphanedSidecars, orphanedRoots))
+	if err != nil {
+		return nil, fmt.Errorf("error deleting orphaned blob_contents: %v", err)
+	}
+
+	rows, err = execDelete(fmt.Sprintf("DELETE FROM blob_sidecars WHERE block_root IN (%v)", orphanedRoots))
+	if err != nil {
+		return nil, fmt.Errorf("error deleting orphaned blob_sidecars: %v", err)
+	}
+	result.Blobs = rows
+
+	if _, err := execDelete(fmt.Sprintf("DELETE FROM orphaned_blocks WHERE root IN (%v)", orphanedRoots)); err != nil {
+		return nil, fmt.Errorf("error deleting orphaned block bodies: %v", err)
+	}
+
+	rows, err = execDelete(fmt.Sprintf("DELETE FROM slots WHERE status = %d AND fork_id IN (%v)", dbtypes.Orphaned, forkIdList))
+	if err != nil {
+		return nil, fmt.Errorf("error deleting orphaned slots: %v", err)
+	}
+	result.Blocks = rows
+
+	rows, err = execDelete(fmt.Sprintf("DELETE FROM forks WHERE fork_id IN (%v)", forkIdList))
+	if err != nil {
+		return nil, fmt.Errorf("error deleting orphaned forks: %v", err)
+	}
+	result.Forks = rows
+
+	return result, nil
+}
@@ -0,0 +1,131 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// GetSlotGraffitiProposers aggregates the graffitis of the canonical blocks in the given slot range per graffiti & proposer.
+func GetSlotGraffitiProposers(minSlot uint64, maxSlot uint64) ([]*dbtypes.GraffitiProposer, error) {
+	entries := []*dbtypes.GraffitiProposer{}
+	err := ReaderDb.Select(&entries, `
+	SELECT graffiti_text, proposer, count(*) AS block_count, MIN(slot) AS first_slot, MAX(slot) AS last_slot
+	FROM slots
+	WHERE slot >= $1 AND slot <= $2 AND status = 1 AND graffiti_text IS NOT NULL AND graffiti_text != ''
+	GROUP BY graffiti_text, proposer`, minSlot, maxSlot)
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// InsertGraffitiProposers adds the block counts of the given entries to the graffiti index.
+func InsertGraffitiProposers(entries []*dbtypes.GraffitiProposer, tx *sqlx.Tx) error {
+	for i := 0; i < len(entries); i += 1000 {
+		batch := entries[i:]
+		if len(batch) > 1000 {
+			batch = batch[:1000]
+		}
+
+		var sql strings.Builder
+		fmt.Fprint(&sql, `INSERT INTO graffiti_proposers (graffiti_text, proposer, block_count, first_slot, last_slot) VALUES `)
+		argIdx := 0
+		fieldCount := 5
+		args := make([]any, len(batch)*fieldCount)
+		for idx, entry := range batch {
+			if idx > 0 {
+				fmt.Fprint(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "($%v, $%v, $%v, $%v, $%v)", argIdx+1, argIdx+2, argIdx+3, argIdx+4, argIdx+5)
+			args[argIdx] = entry.GraffitiText
+			args[argIdx+1] = entry.Proposer
+			args[argIdx+2] = entry.BlockCount
+			args[argIdx+3] = entry.FirstSlot
+			args[argIdx+4] = entry.LastSlot
+			argIdx += fieldCount
+		}
+		fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+			dbtypes.DBEnginePgsql:  ` ON CONFLICT (graffiti_text, proposer) DO UPDATE SET block_count = graffiti_proposers.block_count + excluded.block_count, first_slot = LEAST(graffiti_proposers.first_slot, excluded.first_slot), last_slot = GREATEST(graffiti_proposers.last_slot, excluded.last_slot)`,
+			dbtypes.DBEngineSqlite: ` ON CONFLICT (graffiti_text, proposer) DO UPDATE SET block_count = graffiti_proposers.block_count + excluded.block_count, first_slot = MIN(graffiti_proposers.first_slot, excluded.first_slot), last_slot = MAX(graffiti_proposers.last_slot, excluded.last_slot)`,
+		}))
+
+		_, err := tx.Exec(sql.String(), args...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetGraffitiStats returns the indexed graffitis matching the filter, ordered by the number of blocks using them.
+func GetGraffitiStats(ctx context.Context, filter *dbtypes.GraffitiFilter, offset uint64, limit uint32) ([]*dbtypes.GraffitiStat, uint64, error) {
+	var sql strings.Builder
+	args := []any{}
+
+	fmt.Fprint(&sql, `
+	FROM graffiti_proposers
+	WHERE 1 = 1`)
+	if filter.Search != "" {
+		args = append(args, filter.Search)
+		if filter.Regex {
+			fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+				dbtypes.DBEnginePgsql:  ` AND graffiti_text ~* $1`,
+				dbtypes.DBEngineSqlite: ` AND graffiti_text REGEXP ('(?i)' || $1)`,
+			}))
+		} else {
+			args[0] = "%" + filter.Search + "%"
+			fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+				dbtypes.DBEnginePgsql:  ` AND graffiti_text ilike $1`,
+				dbtypes.DBEngineSqlite: ` AND graffiti_text LIKE $1`,
+			}))
+		}
+	}
+	if filter.Proposer != nil {
+		args = append(args, *filter.Proposer)
+		fmt.Fprintf(&sql, ` AND proposer = $%v`, len(args))
+	}
+
+	var totalCount uint64
+	err := ReaderDb.GetContext(ctx, &totalCount, `SELECT COUNT(DISTINCT graffiti_text)`+sql.String(), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	stats := []*dbtypes.GraffitiStat{}
+	err = ReaderDb.SelectContext(ctx, &stats, `
+	SELECT graffiti_text, SUM(block_count) AS block_count, COUNT(*) AS proposer_count, MIN(first_slot) AS first_slot, MAX(last_slot) AS last_slot`+sql.String()+fmt.Sprintf(`
+	GROUP BY graffiti_text
+	ORDER BY block_count DESC, graffiti_text ASC
+	LIMIT $%v OFFSET $%v`, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return stats, totalCount, nil
+}
+
+// GetGraffitiProposers returns the proposers that used a graffiti, ordered by the number of blocks.
+func GetGraffitiProposers(ctx context.Context, graffiti string, offset uint64, limit uint32) ([]*dbtypes.GraffitiProposer, uint64, error) {
+	var totalCount uint64
+	err := ReaderDb.GetContext(ctx, &totalCount, `SELECT COUNT(*) FROM graffiti_proposers WHERE graffiti_text = $1`, graffiti)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	entries := []*dbtypes.GraffitiProposer{}
+	err = ReaderDb.SelectContext(ctx, &entries, `
+	SELECT graffiti_text, proposer, block_count, first_slot, last_slot
+	FROM graffiti_proposers
+	WHERE graffiti_text = $1
+	ORDER BY block_count DESC, proposer ASC
+	LIMIT $2 OFFSET $3`, graffiti, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, totalCount, nil
+}
@@ -0,0 +1,148 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/jmoiron/sqlx"
+)
+
+// indexerEventsPruneInterval is the min. time between two deletions of expired events.
+const indexerEventsPruneInterval = 10 * time.Minute
+
+var indexerEventsMutex sync.Mutex
+var indexerEventsLastPrune time.Time
+
+// NewIndexerEvent creates an indexer event with json encoded data.
+func NewIndexerEvent(eventType string, eventKey string, data any) (*dbtypes.IndexerEvent, error) {
+	dataJson, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding event data: %v", err)
+	}
+
+	return &dbtypes.IndexerEvent{
+		EventType: eventType,
+		EventKey:  eventKey,
+		EventTime: time.Now().Unix(),
+		Data:      string(dataJson),
+	}, nil
+}
+
+// AppendIndexerEvent adds a single event to the indexer event log, see AppendIndexerEvents.
+func AppendIndexerEvent(eventType string, eventKey string, data any) error {
+	event, err := NewIndexerEvent(eventType, eventKey, data)
+	if err != nil {
+		return err
+	}
+
+	return AppendIndexerEvents([]*dbtypes.IndexerEvent{event})
+}
+
+// AppendIndexerEvents adds events to the indexer event log.
+// the events are written in a dedicated transaction and event ids are assigned & committed under a global lock,
+// so consumers polling for events after their last seen id never skip events of a slower transaction.
+// events with an already logged key are skipped, so the same event can be reported by multiple clients or indexer runs.
+// events older than the configured retention are deleted by the writer every indexerEventsPruneInterval,
+// the latest event is always kept so event ids keep increasing if the log expires completely.
+// must not be called from within another db transaction.
+func AppendIndexerEvents(events []*dbtypes.IndexerEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	indexerEventsMutex.Lock()
+	defer indexerEventsMutex.Unlock()
+
+	return RunDBTransaction(func(tx *sqlx.Tx) error {
+		var lastEventId uint64
+		err := tx.Get(&lastEventId, `SELECT COALESCE(MAX(event_id), 0) FROM indexer_events`)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			res, err := tx.Exec(`
+				INSERT INTO indexer_events (event_id, event_type, event_key, event_time, data)
+				VALUES ($1, $2, $3, $4, $5)
+				ON CONFLICT (event_key) DO NOTHING`,
+				lastEventId+1, event.EventType, event.EventKey, event.EventTime, event.Data)
+			if err != nil {
+				return err
+			}
+
+			if rows, _ := res.RowsAffected(); rows > 0 {
+				lastEventId++
+				event.EventId = lastEventId
+			}
+		}
+
+		if time.Since(indexerEventsLastPrune) >= indexerEventsPruneInterval {
+			minTime := time.Now().Add(-getIndexerEventsRetention()).Unix()
+			_, err = tx.Exec(`DELETE FROM indexer_events WHERE event_time < $1 AND event_id < $2`, minTime, lastEventId)
+			if err != nil {
+				return err
+			}
+
+			indexerEventsLastPrune = time.Now()
+		}
+
+		return nil
+	})
+}
+
+// getIndexerEventsRetention returns the time range events are kept in the event log (default 30 days).
+func getIndexerEventsRetention() time.Duration {
+	retention := utils.Config().Indexer.EventRetention
+	if retention <= 0 {
+		retention = 30 * 24 * time.Hour
+	}
+	return retention
+}
+
+func GetIndexerEventsSince(ctx context.Context, sinceEventId uint64, limit uint32) []*dbtypes.IndexerEvent {
+	events := []*dbtypes.IndexerEvent{}
+	err := ReaderDb.SelectContext(ctx, &events, `
+		SELECT event_id, event_type, event_key, event_time, data
+		FROM indexer_events
+		WHERE event_id > $1
+		ORDER BY event_id ASC
+		LIMIT $2`,
+		sinceEventId, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching indexer events: %v", err)
+		return nil
+	}
+	return events
+}
+
+func GetLastIndexerEventId(ctx context.Context) uint64 {
+	var lastEventId uint64
+	err := ReaderDb.GetContext(ctx, &lastEventId, `SELECT COALESCE(MAX(event_id), 0) FROM indexer_events`)
+	if err != nil {
+		logger.Errorf("Error while fetching last indexer event id: %v", err)
+		return 0
+	}
+	return lastEventId
+}
+
+// GetRecentIndexerEventsByType returns the latest events of the given type that were logged after minTime, newest first.
+func GetRecentIndexerEventsByType(ctx context.Context, eventType string, minTime int64, limit uint32) []*dbtypes.IndexerEvent {
+	events := []*dbtypes.IndexerEvent{}
+	err := ReaderDb.SelectContext(ctx, &events, `
+		SELECT event_id, event_type, event_key, event_time, data
+		FROM indexer_events
+		WHERE event_type = $1 AND event_time >= $2
+		ORDER BY event_id DESC
+		LIMIT $3`,
+		eventType, minTime, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching recent indexer events: %v", err)
+		return nil
+	}
+	return events
+}
@@ -0,0 +1,151 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// number of free pages released per incremental vacuum step, the writer lock is released between the steps
+const sqliteVacuumBatchPages = 1000
+
+// DatabaseMaintenanceResult holds the outcome of a database maintenance run.
+type DatabaseMaintenanceResult struct {
+	Tables     []string // vacuumed & analyzed tables (pgsql)
+	FreedPages uint64   // free pages released by incremental vacuum (sqlite)
+	FullVacuum bool     // a full vacuum was needed to enable incremental vacuum (sqlite)
+	Completed  bool     // false if the run was interrupted by the context (end of maintenance window)
+}
+
+// RunDatabaseMaintenance runs VACUUM & ANALYZE on all tables (pgsql) or an incremental vacuum & optimize (sqlite).
+// the run is stopped gracefully when the context is done, the partial result is returned without error.
+func RunDatabaseMaintenance(ctx context.Context) (*DatabaseMaintenanceResult, error) {
+	switch DbEngine {
+	case dbtypes.DBEnginePgsql:
+		return runPgsqlMaintenance(ctx)
+	case dbtypes.DBEngineSqlite:
+		return runSqliteMaintenance(ctx)
+	default:
+		return nil, fmt.Errorf("unknown database engine")
+	}
+}
+
+func runPgsqlMaintenance(ctx context.Context) (*DatabaseMaintenanceResult, error) {
+	result := &DatabaseMaintenanceResult{
+		Tables: []string{},
+	}
+
+	tables := []string{}
+	err := writerDb.SelectContext(ctx, &tables, `SELECT tablename FROM pg_tables WHERE schemaname = current_schema() ORDER BY tablename`)
+	if err != nil {
+		return nil, fmt.Errorf("error loading table list: %v", err)
+	}
+
+	for _, table := range tables {
+		if ctx.Err() != nil {
+			return result, nil
+		}
+
+		// VACUUM cannot run inside a transaction block
+		_, err := writerDb.ExecContext(ctx, fmt.Sprintf(`VACUUM (ANALYZE) "%v"`, table))
+		if err != nil {
+			if ctx.Err() != nil {
+				return result, nil
+			}
+			return result, fmt.Errorf("error vacuuming table %v: %v", table, err)
+		}
+		result.Tables = append(result.Tables, table)
+	}
+
+	result.Completed = true
+	return result, nil
+}
+
+func runSqliteMaintenance(ctx context.Context) (*DatabaseMaintenanceResult, error) {
+	result := &DatabaseMaintenanceResult{}
+
+	autoVacuum := 0
+	err := writerDb.GetContext(ctx, &autoVacuum, `PRAGMA auto_vacuum`)
+	if err != nil {
+		return nil, fmt.Errorf("error loading auto_vacuum mode: %v", err)
+	}
+
+	if autoVacuum != 2 {
+		// the incremental auto_vacuum mode only takes effect after a full vacuum
+		logger.Infof("switching sqlite database to incremental auto_vacuum mode (full vacuum)")
+		writerMutex.Lock()
+		_, err = writerDb.ExecContext(ctx, `PRAGMA auto_vacuum = INCREMENTAL`)
+		if err == nil {
+			_, err = writerDb.ExecContext(ctx, `VACUUM`)
+		}
+		writerMutex.Unlock()
+		if err != nil {
+			if ctx.Err() != nil {
+				return result, nil
+			}
+			return result, fmt.Errorf("error running full vacuum: %v", err)
+		}
+		result.FullVacuum = true
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return result, nil
+		}
+
+		freePages := uint64(0)
+		err := writerDb.GetContext(ctx, &freePages, `PRAGMA freelist_count`)
+		if err != nil {
+			return result, fmt.Errorf("error loading freelist count: %v", err)
+		}
+		if freePages == 0 {
+			break
+		}
+		if freePages > sqliteVacuumBatchPages {
+			freePages = sqliteVacuumBatchPages
+		}
+
+		writerMutex.Lock()
+		freedPages, err := runSqliteIncrementalVacuum(ctx, freePages)
+		writerMutex.Unlock()
+		if err != nil {
+			if ctx.Err() != nil {
+				return result, nil
+			}
+			return result, fmt.Errorf("error running incremental vacuum: %v", err)
+		}
+		result.FreedPages += freedPages
+		if freedPages == 0 {
+			break
+		}
+	}
+
+	// refresh the query planner statistics where needed
+	_, err = writerDb.ExecContext(ctx, `PRAGMA optimize`)
+	if err != nil {
+		if ctx.Err() != nil {
+			return result, nil
+		}
+		return result, fmt.Errorf("error optimizing database: %v", err)
+	}
+
+	result.Completed = true
+	return result, nil
+}
+
+// runSqliteIncrementalVacuum releases up to pageCount free pages and returns the number of released pages.
+// the pragma releases one page per step, so the result rows need to be consumed instead of using Exec.
+func runSqliteIncrementalVacuum(ctx context.Context, pageCount uint64) (uint64, error) {
+	rows, err := writerDb.QueryContext(ctx, fmt.Sprintf(`PRAGMA incremental_vacuum(%v)`, pageCount))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	freedPages := uint64(0)
+	for rows.Next() {
+		freedPages++
+	}
+	return freedPages, rows.Err()
+}
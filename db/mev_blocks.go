@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -119,9 +120,9 @@ func GetHighestMevBlockSlotByRelay(relayId uint8) (uint64, error) {
 	return highestSlot, nil
 }
 
-func GetMevBlockByBlockHash(blockHash []byte) *dbtypes.MevBlock {
+func GetMevBlockByBlockHash(ctx context.Context, blockHash []byte) *dbtypes.MevBlock {
 	mevBlock := dbtypes.MevBlock{}
-	err := ReaderDb.Get(&mevBlock, `
+	err := ReaderDb.GetContext(ctx, &mevBlock, `
 	SELECT
 		slot_number, block_hash, block_number, builder_pubkey, proposer_index, proposed, seenby_relays, fee_recipient, tx_count, gas_used, block_value, block_value_gwei
 	FROM mev_blocks
@@ -133,7 +134,38 @@ func GetMevBlockByBlockHash(blockHash []byte) *dbtypes.MevBlock {
 	return &mevBlock
 }
 
-func GetMevBlocksFiltered(offset uint64, limit uint32, filter *dbtypes.MevBlockFilter) ([]*dbtypes.MevBlock, uint64, error) {
+// GetMevBlocksByBlockHashes returns the relay delivered payloads for the given execution block hashes.
+func GetMevBlocksByBlockHashes(ctx context.Context, blockHashes [][]byte) []*dbtypes.MevBlock {
+	mevBlocks := []*dbtypes.MevBlock{}
+	if len(blockHashes) == 0 {
+		return mevBlocks
+	}
+
+	var sql strings.Builder
+	args := make([]any, len(blockHashes))
+	fmt.Fprint(&sql, `
+	SELECT
+		slot_number, block_hash, block_number, builder_pubkey, proposer_index, proposed, seenby_relays, fee_recipient, tx_count, gas_used, block_value, block_value_gwei
+	FROM mev_blocks
+	WHERE block_hash IN (`)
+	for i, blockHash := range blockHashes {
+		if i > 0 {
+			fmt.Fprint(&sql, ", ")
+		}
+		args[i] = blockHash
+		fmt.Fprintf(&sql, "$%v", i+1)
+	}
+	fmt.Fprint(&sql, ")")
+
+	err := ReaderDb.SelectContext(ctx, &mevBlocks, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching mev blocks by block hashes: %v", err)
+		return nil
+	}
+	return mevBlocks
+}
+
+func GetMevBlocksFiltered(ctx context.Context, offset uint64, limit uint32, filter *dbtypes.MevBlockFilter) ([]*dbtypes.MevBlock, uint64, error) {
 	var sql strings.Builder
 	args := []any{}
 	fmt.Fprint(&sql, `
@@ -239,7 +271,7 @@ func GetMevBlocksFiltered(offset uint64, limit uint32, filter *dbtypes.MevBlockF
 	fmt.Fprintf(&sql, ") AS t1")
 
 	mevBlocks := []*dbtypes.MevBlock{}
-	err := ReaderDb.Select(&mevBlocks, sql.String(), args...)
+	err := ReaderDb.SelectContext(ctx, &mevBlocks, sql.String(), args...)
 	if err != nil {
 		logger.Errorf("Error while fetching filtered mev blocks: %v", err)
 		return nil, 0, err
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// InsertNotificationLogEntry stores a notification before it's sent.
+// returns false if the notification has already been stored, so events are not sent twice when epochs are processed again.
+func InsertNotificationLogEntry(entry *dbtypes.NotificationLogEntry, tx *sqlx.Tx) (bool, error) {
+	res, err := tx.Exec(`
+	INSERT INTO notification_log ("rule_name", "event_type", "validator_index", "event_slot", "message", "created_at", "error")
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	ON CONFLICT ("rule_name", "event_type", "validator_index", "event_slot") DO NOTHING`,
+		entry.RuleName, entry.EventType, entry.ValidatorIndex, entry.EventSlot, entry.Message, entry.CreatedAt, entry.Error)
+	if err != nil {
+		return false, err
+	}
+	rows, _ := res.RowsAffected()
+	return rows > 0, nil
+}
+
+func UpdateNotificationLogError(entry *dbtypes.NotificationLogEntry, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+	UPDATE notification_log SET "error" = $5
+	WHERE "rule_name" = $1 AND "event_type" = $2 AND "validator_index" = $3 AND "event_slot" = $4`,
+		entry.RuleName, entry.EventType, entry.ValidatorIndex, entry.EventSlot, entry.Error)
+	return err
+}
+
+func GetNotificationValidatorStates(ruleName string) []*dbtypes.NotificationValidatorState {
+	states := []*dbtypes.NotificationValidatorState{}
+	err := ReaderDb.Select(&states, `
+	SELECT "rule_name", "validator_index", "missed_epochs", "offline"
+	FROM notification_validator_states
+	WHERE "rule_name" = $1`, ruleName)
+	if err != nil {
+		logger.Errorf("Error while fetching notification validator states: %v", err)
+		return nil
+	}
+	return states
+}
+
+func InsertNotificationValidatorStates(states []*dbtypes.NotificationValidatorState, tx *sqlx.Tx) error {
+	if len(states) == 0 {
+		return nil
+	}
+
+	var sql strings.Builder
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  `INSERT INTO notification_validator_states ("rule_name", "validator_index", "missed_epochs", "offline") VALUES `,
+		dbtypes.DBEngineSqlite: `INSERT OR REPLACE INTO notification_validator_states ("rule_name", "validator_index", "missed_epochs", "offline") VALUES `,
+	}))
+	argIdx := 0
+	fieldCount := 4
+	args := make([]any, len(states)*fieldCount)
+	for i, state := range states {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "($%v, $%v, $%v, $%v)", argIdx+1, argIdx+2, argIdx+3, argIdx+4)
+		args[argIdx] = state.RuleName
+		args[argIdx+1] = state.ValidatorIndex
+		args[argIdx+2] = state.MissedEpochs
+		args[argIdx+3] = state.Offline
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  ` ON CONFLICT ("rule_name", "validator_index") DO UPDATE SET missed_epochs = excluded.missed_epochs, offline = excluded.offline`,
+		dbtypes.DBEngineSqlite: "",
+	}))
+	_, err := tx.Exec(sql.String(), args...)
+	return err
+}
@@ -0,0 +1,64 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertParticipationRollups(rollups []*dbtypes.ParticipationRollup, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  `INSERT INTO participation_rollups (bucket_epoch, last_epoch, epoch_count, eligible, voted_target, voted_head, voted_total) VALUES `,
+		dbtypes.DBEngineSqlite: `INSERT OR REPLACE INTO participation_rollups (bucket_epoch, last_epoch, epoch_count, eligible, voted_target, voted_head, voted_total) VALUES `,
+	}))
+	argIdx := 0
+	args := make([]any, len(rollups)*7)
+	for i, rollup := range rollups {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "($%v, $%v, $%v, $%v, $%v, $%v, $%v)", argIdx+1, argIdx+2, argIdx+3, argIdx+4, argIdx+5, argIdx+6, argIdx+7)
+		args[argIdx] = rollup.BucketEpoch
+		args[argIdx+1] = rollup.LastEpoch
+		args[argIdx+2] = rollup.EpochCount
+		args[argIdx+3] = rollup.Eligible
+		args[argIdx+4] = rollup.VotedTarget
+		args[argIdx+5] = rollup.VotedHead
+		args[argIdx+6] = rollup.VotedTotal
+		argIdx += 7
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  ` ON CONFLICT (bucket_epoch) DO UPDATE SET last_epoch = excluded.last_epoch, epoch_count = excluded.epoch_count, eligible = excluded.eligible, voted_target = excluded.voted_target, voted_head = excluded.voted_head, voted_total = excluded.voted_total`,
+		dbtypes.DBEngineSqlite: "",
+	}))
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetParticipationRollups returns the participation rollups starting at or after the given epoch in ascending order.
+func GetParticipationRollups(fromEpoch uint64) []*dbtypes.ParticipationRollup {
+	rollups := []*dbtypes.ParticipationRollup{}
+	err := ReaderDb.Select(&rollups, `
+	SELECT
+		bucket_epoch, last_epoch, epoch_count, eligible, voted_target, voted_head, voted_total
+	FROM participation_rollups
+	WHERE bucket_epoch >= $1
+	ORDER BY bucket_epoch ASC
+	`, fromEpoch)
+	if err != nil {
+		logger.Errorf("Error while fetching participation rollups: %v", err)
+		return nil
+	}
+	return rollups
+}
+
+func DeleteParticipationRollupsBefore(epoch uint64, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`DELETE FROM participation_rollups WHERE bucket_epoch < $1`, epoch)
+	return err
+}
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."validator_labels"
+(
+    "index" bigint NOT NULL,
+    "name" character varying(250) NOT NULL,
+    "updated_at" bigint NOT NULL,
+    PRIMARY KEY ("index")
+);
+
+CREATE TABLE IF NOT EXISTS public."validator_label_changes"
+(
+    "index" bigint NOT NULL,
+    "change_time" bigint NOT NULL,
+    "old_name" character varying(250) NOT NULL,
+    "new_name" character varying(250) NOT NULL,
+    "source" character varying(250) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS "validator_label_changes_index_idx"
+    ON public."validator_label_changes"
+    ("index" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "validator_label_changes_time_idx"
+    ON public."validator_label_changes"
+    ("change_time" ASC NULLS FIRST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
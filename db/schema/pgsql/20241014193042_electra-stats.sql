@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."electra_stats"
+(
+    "epoch" bigint NOT NULL,
+    "bls_validators" bigint NOT NULL,
+    "exec_validators" bigint NOT NULL,
+    "compounding_validators" bigint NOT NULL,
+    "compounding_balance" bigint NOT NULL,
+    "compounding_max_eb" bigint NOT NULL,
+    "total_effective_balance" bigint NOT NULL,
+    "consolidations" bigint NOT NULL,
+    "credential_switches" bigint NOT NULL,
+    PRIMARY KEY ("epoch")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."validator_reward_snapshots"
+(
+    "epoch" bigint NOT NULL,
+    "base_epoch" bigint NOT NULL,
+    "validator_count" bigint NOT NULL,
+    "balances" bytea NOT NULL,
+    PRIMARY KEY ("epoch")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."block_compression_dicts"
+(
+    "dict_id" bigint NOT NULL,
+    "create_time" bigint NOT NULL,
+    "dict" bytea NOT NULL,
+    PRIMARY KEY ("dict_id")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."indexer_events"
+(
+    "event_id" bigint NOT NULL,
+    "event_type" character varying(50) NOT NULL,
+    "event_key" character varying(250) NOT NULL,
+    "event_time" bigint NOT NULL,
+    "data" text NOT NULL,
+    PRIMARY KEY ("event_id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "indexer_events_key_idx"
+    ON public."indexer_events"
+    ("event_key" ASC NULLS FIRST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
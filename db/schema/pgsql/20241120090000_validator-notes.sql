@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."validator_notes"
+(
+    "id" bigserial NOT NULL,
+    "validator_index" bigint NOT NULL,
+    "note" text NOT NULL,
+    "author" character varying(250) NOT NULL,
+    "created_at" bigint NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "validator_notes_validator_idx"
+    ON public."validator_notes"
+    ("validator_index" ASC NULLS FIRST);
+
+CREATE TABLE IF NOT EXISTS public."validator_note_tags"
+(
+    "note_id" bigint NOT NULL,
+    "validator_index" bigint NOT NULL,
+    "tag" character varying(50) NOT NULL,
+    PRIMARY KEY ("note_id", "tag")
+);
+
+CREATE INDEX IF NOT EXISTS "validator_note_tags_tag_idx"
+    ON public."validator_note_tags"
+    ("tag" ASC NULLS FIRST, "validator_index" ASC NULLS FIRST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE INDEX IF NOT EXISTS "indexer_events_type_idx"
+    ON public."indexer_events"
+    ("event_type" ASC NULLS FIRST, "event_id" DESC NULLS LAST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
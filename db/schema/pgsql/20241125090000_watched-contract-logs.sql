@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."watched_contract_logs" (
+    contract_address bytea NOT NULL,
+    block_number BIGINT NOT NULL,
+    block_index INT NOT NULL,
+    block_time BIGINT NOT NULL,
+    block_root bytea NOT NULL,
+    fork_id BIGINT NOT NULL DEFAULT 0,
+    topic0 bytea NULL,
+    topic1 bytea NULL,
+    topic2 bytea NULL,
+    topic3 bytea NULL,
+    data bytea NOT NULL,
+    tx_hash bytea NOT NULL,
+    tx_sender bytea NOT NULL,
+    tx_target bytea NULL,
+    CONSTRAINT watched_contract_logs_pkey PRIMARY KEY (block_root, block_index)
+);
+
+CREATE INDEX IF NOT EXISTS "watched_contract_logs_contract_idx"
+    ON public."watched_contract_logs"
+    ("contract_address" ASC, "block_number" DESC);
+
+CREATE INDEX IF NOT EXISTS "watched_contract_logs_block_number_idx"
+    ON public."watched_contract_logs"
+    ("block_number" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "watched_contract_logs_topic0_idx"
+    ON public."watched_contract_logs"
+    ("topic0" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "watched_contract_logs_tx_sender_idx"
+    ON public."watched_contract_logs"
+    ("tx_sender" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "watched_contract_logs_fork_idx"
+    ON public."watched_contract_logs"
+    ("fork_id" ASC NULLS FIRST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."validator_ownership_proofs"
+(
+    "session_hash" bytea NOT NULL,
+    "validator_index" bigint NOT NULL,
+    "proven_at" bigint NOT NULL,
+    "private_label" character varying(100) NOT NULL DEFAULT '',
+    PRIMARY KEY ("session_hash", "validator_index")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
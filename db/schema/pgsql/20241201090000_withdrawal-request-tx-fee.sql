@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE public."withdrawal_request_txs"
+    ADD COLUMN IF NOT EXISTS "tx_fee" bytea NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."validator_watchlists"
+(
+    "session_hash" bytea NOT NULL,
+    "list_name" character varying(50) NOT NULL,
+    "validator_index" bigint NOT NULL,
+    "added_at" bigint NOT NULL,
+    PRIMARY KEY ("session_hash", "list_name", "validator_index")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."notification_log"
+(
+    "rule_name" character varying(100) NOT NULL,
+    "event_type" character varying(50) NOT NULL,
+    "validator_index" bigint NOT NULL,
+    "event_slot" bigint NOT NULL,
+    "message" text NOT NULL,
+    "created_at" bigint NOT NULL,
+    "error" text NOT NULL DEFAULT '',
+    PRIMARY KEY ("rule_name", "event_type", "validator_index", "event_slot")
+);
+
+CREATE INDEX IF NOT EXISTS "notification_log_created_idx"
+    ON public."notification_log"
+    ("created_at" ASC NULLS LAST);
+
+CREATE TABLE IF NOT EXISTS public."notification_validator_states"
+(
+    "rule_name" character varying(100) NOT NULL,
+    "validator_index" bigint NOT NULL,
+    "missed_epochs" bigint NOT NULL,
+    "offline" boolean NOT NULL,
+    PRIMARY KEY ("rule_name", "validator_index")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."blob_sidecars"
+(
+    "block_root" bytea NOT NULL,
+    "blob_index" integer NOT NULL,
+    "slot" bigint NOT NULL,
+    "proposer" bigint NOT NULL,
+    "commitment" bytea NOT NULL,
+    "proof" bytea NOT NULL,
+    "versioned_hash" bytea NOT NULL,
+    "size" integer NOT NULL,
+    "stored" boolean NOT NULL DEFAULT false,
+    PRIMARY KEY ("block_root", "blob_index")
+);
+
+CREATE INDEX IF NOT EXISTS "blob_sidecars_slot_idx"
+    ON public."blob_sidecars"
+    ("slot" DESC, "blob_index" ASC);
+
+CREATE INDEX IF NOT EXISTS "blob_sidecars_commitment_idx"
+    ON public."blob_sidecars"
+    ("commitment" ASC);
+
+CREATE INDEX IF NOT EXISTS "blob_sidecars_versioned_hash_idx"
+    ON public."blob_sidecars"
+    ("versioned_hash" ASC);
+
+CREATE TABLE IF NOT EXISTS public."blob_contents"
+(
+    "commitment" bytea NOT NULL,
+    "data" bytea NOT NULL,
+    PRIMARY KEY ("commitment")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."validator_income"
+(
+    "validator_index" bigint NOT NULL,
+    "epoch" bigint NOT NULL,
+    "attestation_reward" bigint NOT NULL,
+    "sync_reward" bigint NOT NULL,
+    "proposal_reward" bigint NOT NULL,
+    "penalty" bigint NOT NULL,
+    PRIMARY KEY ("validator_index", "epoch")
+);
+
+CREATE INDEX IF NOT EXISTS "validator_income_epoch_idx"
+    ON public."validator_income"
+    ("epoch" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."el_tx_summaries"
+(
+    "block_root" bytea NOT NULL,
+    "slot" bigint NOT NULL,
+    "block_number" bigint NOT NULL,
+    "block_hash" bytea NOT NULL,
+    "tx_count" integer NOT NULL,
+    "blob_tx_count" integer NOT NULL,
+    "contract_creations" integer NOT NULL,
+    "gas_used" bigint NOT NULL,
+    "gas_limit" bigint NOT NULL,
+    "base_fee" bigint NOT NULL,
+    "top_targets" text NOT NULL,
+    PRIMARY KEY ("block_root")
+);
+
+CREATE INDEX IF NOT EXISTS "el_tx_summaries_slot_idx"
+    ON public."el_tx_summaries"
+    ("slot" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."participation_rollups"
+(
+    "bucket_epoch" bigint NOT NULL,
+    "last_epoch" bigint NOT NULL,
+    "epoch_count" integer NOT NULL,
+    "eligible" bigint NOT NULL,
+    "voted_target" bigint NOT NULL,
+    "voted_head" bigint NOT NULL,
+    "voted_total" bigint NOT NULL,
+    PRIMARY KEY ("bucket_epoch")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."validator_watchlist_webhooks"
+(
+    "session_hash" bytea NOT NULL,
+    "list_name" character varying(50) NOT NULL,
+    "url" character varying(500) NOT NULL,
+    "events" character varying(200) NOT NULL,
+    "offline_epochs" integer NOT NULL,
+    "created_at" bigint NOT NULL,
+    PRIMARY KEY ("session_hash", "list_name", "url")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."graffiti_proposers"
+(
+    "graffiti_text" text NOT NULL,
+    "proposer" bigint NOT NULL,
+    "block_count" bigint NOT NULL,
+    "first_slot" bigint NOT NULL,
+    "last_slot" bigint NOT NULL,
+    PRIMARY KEY ("graffiti_text", "proposer")
+);
+
+CREATE INDEX IF NOT EXISTS "graffiti_proposers_graffiti_idx"
+    ON public."graffiti_proposers" USING gin 
+    ("graffiti_text" gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS "graffiti_proposers_proposer_idx"
+    ON public."graffiti_proposers"
+    ("proposer" ASC NULLS FIRST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS public."client_diversity"
+(
+    "bucket_epoch" bigint NOT NULL,
+    "layer" smallint NOT NULL,
+    "client" character varying(20) NOT NULL,
+    "block_count" bigint NOT NULL,
+    PRIMARY KEY ("bucket_epoch", "layer", "client")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- deposit contract of the deposit tx, empty for the deposit contract from the chain specs
+ALTER TABLE public."deposit_txs"
+ADD "contract_address" bytea NOT NULL DEFAULT '';
+
+ALTER TABLE public."deposit_txs"
+DROP CONSTRAINT "deposit_txs_pkey";
+
+ALTER TABLE public."deposit_txs"
+ADD CONSTRAINT "deposit_txs_pkey" PRIMARY KEY ("contract_address", "deposit_index", "block_root");
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE INDEX IF NOT EXISTS "indexer_events_time_idx"
+    ON public."indexer_events"
+    ("event_time" ASC NULLS FIRST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
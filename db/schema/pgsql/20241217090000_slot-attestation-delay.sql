@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE public."slots"
+ADD "attestation_delay" INTEGER NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
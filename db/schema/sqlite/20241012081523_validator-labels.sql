@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "validator_labels"
+(
+    "index" BIGINT NOT NULL,
+    "name" TEXT NOT NULL,
+    "updated_at" BIGINT NOT NULL,
+    PRIMARY KEY ("index")
+);
+
+CREATE TABLE IF NOT EXISTS "validator_label_changes"
+(
+    "index" BIGINT NOT NULL,
+    "change_time" BIGINT NOT NULL,
+    "old_name" TEXT NOT NULL,
+    "new_name" TEXT NOT NULL,
+    "source" TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS "validator_label_changes_index_idx"
+    ON "validator_label_changes"
+    ("index" ASC);
+
+CREATE INDEX IF NOT EXISTS "validator_label_changes_time_idx"
+    ON "validator_label_changes"
+    ("change_time" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "electra_stats"
+(
+    "epoch" BIGINT NOT NULL,
+    "bls_validators" BIGINT NOT NULL,
+    "exec_validators" BIGINT NOT NULL,
+    "compounding_validators" BIGINT NOT NULL,
+    "compounding_balance" BIGINT NOT NULL,
+    "compounding_max_eb" BIGINT NOT NULL,
+    "total_effective_balance" BIGINT NOT NULL,
+    "consolidations" BIGINT NOT NULL,
+    "credential_switches" BIGINT NOT NULL,
+    PRIMARY KEY ("epoch")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "validator_reward_snapshots"
+(
+    "epoch" BIGINT NOT NULL,
+    "base_epoch" BIGINT NOT NULL,
+    "validator_count" BIGINT NOT NULL,
+    "balances" BLOB NOT NULL,
+    PRIMARY KEY ("epoch")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "block_compression_dicts"
+(
+    "dict_id" BIGINT NOT NULL,
+    "create_time" BIGINT NOT NULL,
+    "dict" BLOB NOT NULL,
+    PRIMARY KEY ("dict_id")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
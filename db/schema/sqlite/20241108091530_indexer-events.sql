@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "indexer_events"
+(
+    "event_id" BIGINT NOT NULL,
+    "event_type" TEXT NOT NULL,
+    "event_key" TEXT NOT NULL,
+    "event_time" BIGINT NOT NULL,
+    "data" TEXT NOT NULL,
+    PRIMARY KEY ("event_id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "indexer_events_key_idx"
+    ON "indexer_events"
+    ("event_key" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE "slots"
+ADD "eth_blob_tx_count" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "slots"
+ADD "eth_blob_tx_size" BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "slots"
+ADD "eth_blob_tx_dist" BLOB NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "validator_notes"
+(
+    "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+    "validator_index" BIGINT NOT NULL,
+    "note" TEXT NOT NULL,
+    "author" TEXT NOT NULL,
+    "created_at" BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS "validator_notes_validator_idx"
+    ON "validator_notes"
+    ("validator_index" ASC);
+
+CREATE TABLE IF NOT EXISTS "validator_note_tags"
+(
+    "note_id" BIGINT NOT NULL,
+    "validator_index" BIGINT NOT NULL,
+    "tag" TEXT NOT NULL,
+    PRIMARY KEY ("note_id", "tag")
+);
+
+CREATE INDEX IF NOT EXISTS "validator_note_tags_tag_idx"
+    ON "validator_note_tags"
+    ("tag" ASC, "validator_index" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE INDEX IF NOT EXISTS "indexer_events_type_idx"
+    ON "indexer_events"
+    ("event_type" ASC, "event_id" DESC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
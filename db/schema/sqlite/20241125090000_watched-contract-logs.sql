@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "watched_contract_logs" (
+    contract_address BLOB NOT NULL,
+    block_number BIGINT NOT NULL,
+    block_index INT NOT NULL,
+    block_time BIGINT NOT NULL,
+    block_root BLOB NOT NULL,
+    fork_id BIGINT NOT NULL DEFAULT 0,
+    topic0 BLOB NULL,
+    topic1 BLOB NULL,
+    topic2 BLOB NULL,
+    topic3 BLOB NULL,
+    data BLOB NOT NULL,
+    tx_hash BLOB NOT NULL,
+    tx_sender BLOB NOT NULL,
+    tx_target BLOB NULL,
+    CONSTRAINT watched_contract_logs_pkey PRIMARY KEY (block_root, block_index)
+);
+
+CREATE INDEX IF NOT EXISTS "watched_contract_logs_contract_idx"
+    ON "watched_contract_logs"
+    ("contract_address" ASC, "block_number" DESC);
+
+CREATE INDEX IF NOT EXISTS "watched_contract_logs_block_number_idx"
+    ON "watched_contract_logs"
+    ("block_number" ASC);
+
+CREATE INDEX IF NOT EXISTS "watched_contract_logs_topic0_idx"
+    ON "watched_contract_logs"
+    ("topic0" ASC);
+
+CREATE INDEX IF NOT EXISTS "watched_contract_logs_tx_sender_idx"
+    ON "watched_contract_logs"
+    ("tx_sender" ASC);
+
+CREATE INDEX IF NOT EXISTS "watched_contract_logs_fork_idx"
+    ON "watched_contract_logs"
+    ("fork_id" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
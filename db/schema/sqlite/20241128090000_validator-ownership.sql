@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "validator_ownership_proofs"
+(
+    "session_hash" BLOB NOT NULL,
+    "validator_index" BIGINT NOT NULL,
+    "proven_at" BIGINT NOT NULL,
+    "private_label" TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY ("session_hash", "validator_index")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "validator_watchlists"
+(
+    "session_hash" BLOB NOT NULL,
+    "list_name" TEXT NOT NULL,
+    "validator_index" BIGINT NOT NULL,
+    "added_at" BIGINT NOT NULL,
+    PRIMARY KEY ("session_hash", "list_name", "validator_index")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
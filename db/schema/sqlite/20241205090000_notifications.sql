@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "notification_log"
+(
+    "rule_name" TEXT NOT NULL,
+    "event_type" TEXT NOT NULL,
+    "validator_index" BIGINT NOT NULL,
+    "event_slot" BIGINT NOT NULL,
+    "message" TEXT NOT NULL,
+    "created_at" BIGINT NOT NULL,
+    "error" TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY ("rule_name", "event_type", "validator_index", "event_slot")
+);
+
+CREATE INDEX IF NOT EXISTS "notification_log_created_idx"
+    ON "notification_log"
+    ("created_at" ASC);
+
+CREATE TABLE IF NOT EXISTS "notification_validator_states"
+(
+    "rule_name" TEXT NOT NULL,
+    "validator_index" BIGINT NOT NULL,
+    "missed_epochs" BIGINT NOT NULL,
+    "offline" BOOLEAN NOT NULL,
+    PRIMARY KEY ("rule_name", "validator_index")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "blob_sidecars"
+(
+    "block_root" BLOB NOT NULL,
+    "blob_index" INTEGER NOT NULL,
+    "slot" BIGINT NOT NULL,
+    "proposer" BIGINT NOT NULL,
+    "commitment" BLOB NOT NULL,
+    "proof" BLOB NOT NULL,
+    "versioned_hash" BLOB NOT NULL,
+    "size" INTEGER NOT NULL,
+    "stored" BOOLEAN NOT NULL DEFAULT false,
+    PRIMARY KEY ("block_root", "blob_index")
+);
+
+CREATE INDEX IF NOT EXISTS "blob_sidecars_slot_idx"
+    ON "blob_sidecars"
+    ("slot" DESC, "blob_index" ASC);
+
+CREATE INDEX IF NOT EXISTS "blob_sidecars_commitment_idx"
+    ON "blob_sidecars"
+    ("commitment" ASC);
+
+CREATE INDEX IF NOT EXISTS "blob_sidecars_versioned_hash_idx"
+    ON "blob_sidecars"
+    ("versioned_hash" ASC);
+
+CREATE TABLE IF NOT EXISTS "blob_contents"
+(
+    "commitment" BLOB NOT NULL,
+    "data" BLOB NOT NULL,
+    PRIMARY KEY ("commitment")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "validator_income"
+(
+    "validator_index" BIGINT NOT NULL,
+    "epoch" BIGINT NOT NULL,
+    "attestation_reward" BIGINT NOT NULL,
+    "sync_reward" BIGINT NOT NULL,
+    "proposal_reward" BIGINT NOT NULL,
+    "penalty" BIGINT NOT NULL,
+    PRIMARY KEY ("validator_index", "epoch")
+);
+
+CREATE INDEX IF NOT EXISTS "validator_income_epoch_idx"
+    ON "validator_income"
+    ("epoch" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
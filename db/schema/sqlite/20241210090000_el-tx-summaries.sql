@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "el_tx_summaries"
+(
+    "block_root" BLOB NOT NULL,
+    "slot" BIGINT NOT NULL,
+    "block_number" BIGINT NOT NULL,
+    "block_hash" BLOB NOT NULL,
+    "tx_count" INTEGER NOT NULL,
+    "blob_tx_count" INTEGER NOT NULL,
+    "contract_creations" INTEGER NOT NULL,
+    "gas_used" BIGINT NOT NULL,
+    "gas_limit" BIGINT NOT NULL,
+    "base_fee" BIGINT NOT NULL,
+    "top_targets" TEXT NOT NULL,
+    PRIMARY KEY ("block_root")
+);
+
+CREATE INDEX IF NOT EXISTS "el_tx_summaries_slot_idx"
+    ON "el_tx_summaries"
+    ("slot" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
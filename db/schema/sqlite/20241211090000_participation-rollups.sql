@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "participation_rollups"
+(
+    "bucket_epoch" BIGINT NOT NULL,
+    "last_epoch" BIGINT NOT NULL,
+    "epoch_count" INTEGER NOT NULL,
+    "eligible" BIGINT NOT NULL,
+    "voted_target" BIGINT NOT NULL,
+    "voted_head" BIGINT NOT NULL,
+    "voted_total" BIGINT NOT NULL,
+    PRIMARY KEY ("bucket_epoch")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
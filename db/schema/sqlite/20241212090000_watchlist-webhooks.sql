@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "validator_watchlist_webhooks"
+(
+    "session_hash" BLOB NOT NULL,
+    "list_name" TEXT NOT NULL,
+    "url" TEXT NOT NULL,
+    "events" TEXT NOT NULL,
+    "offline_epochs" INTEGER NOT NULL,
+    "created_at" BIGINT NOT NULL,
+    PRIMARY KEY ("session_hash", "list_name", "url")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "graffiti_proposers"
+(
+    "graffiti_text" TEXT NOT NULL,
+    "proposer" BIGINT NOT NULL,
+    "block_count" BIGINT NOT NULL,
+    "first_slot" BIGINT NOT NULL,
+    "last_slot" BIGINT NOT NULL,
+    PRIMARY KEY ("graffiti_text", "proposer")
+);
+
+CREATE INDEX IF NOT EXISTS "graffiti_proposers_proposer_idx"
+    ON "graffiti_proposers"
+    ("proposer" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
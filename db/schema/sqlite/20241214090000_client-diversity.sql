@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS "client_diversity"
+(
+    "bucket_epoch" BIGINT NOT NULL,
+    "layer" INTEGER NOT NULL,
+    "client" TEXT NOT NULL,
+    "block_count" BIGINT NOT NULL,
+    PRIMARY KEY ("bucket_epoch", "layer", "client")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
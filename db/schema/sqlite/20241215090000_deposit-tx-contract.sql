@@ -0,0 +1,60 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- deposit contract of the deposit tx, empty for the deposit contract from the chain specs
+CREATE TABLE IF NOT EXISTS "new_deposit_txs" (
+    "contract_address" BLOB NOT NULL DEFAULT X'',
+    "deposit_index" INT NOT NULL,
+    "block_number" INT NOT NULL,
+    "block_time" BIGINT NOT NULL,
+    "block_root" BLOB NOT NULL,
+    "publickey" BLOB NOT NULL,
+    "withdrawalcredentials" BLOB NOT NULL,
+    "amount" BIGINT NOT NULL,
+    "signature" BLOB NOT NULL,
+    "valid_signature" bool NOT NULL DEFAULT TRUE,
+    "orphaned" bool NOT NULL DEFAULT FALSE,
+    "tx_hash" BLOB NOT NULL,
+    "tx_sender" BLOB NOT NULL,
+    "tx_target" BLOB NOT NULL,
+    "fork_id" BIGINT NOT NULL DEFAULT 0,
+    CONSTRAINT "deposit_txs_pkey" PRIMARY KEY ("contract_address", "deposit_index", "block_root")
+);
+
+INSERT INTO "new_deposit_txs" (
+    deposit_index, block_number, block_time, block_root, publickey, withdrawalcredentials, amount, signature,
+    valid_signature, orphaned, tx_hash, tx_sender, tx_target, fork_id
+)
+SELECT
+    deposit_index, block_number, block_time, block_root, publickey, withdrawalcredentials, amount, signature,
+    valid_signature, orphaned, tx_hash, tx_sender, tx_target, fork_id
+FROM "deposit_txs";
+
+DROP TABLE "deposit_txs";
+ALTER TABLE "new_deposit_txs" RENAME TO "deposit_txs";
+
+CREATE INDEX IF NOT EXISTS "deposit_txs_deposit_index_idx"
+    ON "deposit_txs"
+    ("deposit_index" ASC);
+
+CREATE INDEX IF NOT EXISTS "deposit_txs_block_number_idx"
+    ON "deposit_txs"
+    ("block_number" ASC);
+
+CREATE INDEX IF NOT EXISTS "deposit_txs_publickey_idx"
+    ON "deposit_txs"
+    ("publickey" ASC);
+
+CREATE INDEX IF NOT EXISTS "deposit_txs_tx_sender_idx"
+    ON "deposit_txs"
+    ("tx_sender" ASC);
+
+CREATE INDEX IF NOT EXISTS "deposit_txs_tx_target_idx"
+    ON "deposit_txs"
+    ("tx_target" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
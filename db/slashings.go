@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -78,7 +79,7 @@ func GetSlashingForValidator(validator uint64) *dbtypes.Slashing {
 	return slashing
 }
 
-func GetSlashingsFiltered(offset uint64, limit uint32, finalizedBlock uint64, filter *dbtypes.SlashingFilter) ([]*dbtypes.Slashing, uint64, error) {
+func GetSlashingsFiltered(ctx context.Context, offset uint64, limit uint32, finalizedBlock uint64, filter *dbtypes.SlashingFilter) ([]*dbtypes.Slashing, uint64, error) {
 	var sql strings.Builder
 	args := []any{}
 	fmt.Fprint(&sql, `
@@ -180,7 +181,7 @@ func GetSlashingsFiltered(offset uint64, limit uint32, finalizedBlock uint64, fi
 	fmt.Fprintf(&sql, ") AS t1")
 
 	slashings := []*dbtypes.Slashing{}
-	err := ReaderDb.Select(&slashings, sql.String(), args...)
+	err := ReaderDb.SelectContext(ctx, &slashings, sql.String(), args...)
 	if err != nil {
 		logger.Errorf("Error while fetching filtered slashings: %v", err)
 		return nil, 0, err
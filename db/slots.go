@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"math"
@@ -19,8 +20,9 @@ func InsertSlot(slot *dbtypes.Slot, tx *sqlx.Tx) error {
 				slot, proposer, status, root, parent_root, state_root, graffiti, graffiti_text,
 				attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 				proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash, 
-				eth_block_extra, eth_block_extra_text, sync_participation, fork_id
-			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)
+				eth_block_extra, eth_block_extra_text, sync_participation, fork_id,
+				eth_blob_tx_count, eth_blob_tx_size, eth_blob_tx_dist, attestation_delay
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27)
 			ON CONFLICT (slot, root) DO UPDATE SET
 				status = excluded.status,
 				eth_block_extra = excluded.eth_block_extra,
@@ -30,13 +32,15 @@ func InsertSlot(slot *dbtypes.Slot, tx *sqlx.Tx) error {
 				slot, proposer, status, root, parent_root, state_root, graffiti, graffiti_text,
 				attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 				proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash, 
-				eth_block_extra, eth_block_extra_text, sync_participation, fork_id
-			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)`,
+				eth_block_extra, eth_block_extra_text, sync_participation, fork_id,
+				eth_blob_tx_count, eth_blob_tx_size, eth_blob_tx_dist, attestation_delay
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27)`,
 	}),
 		slot.Slot, slot.Proposer, slot.Status, slot.Root, slot.ParentRoot, slot.StateRoot, slot.Graffiti, slot.GraffitiText,
 		slot.AttestationCount, slot.DepositCount, slot.ExitCount, slot.WithdrawCount, slot.WithdrawAmount, slot.AttesterSlashingCount,
 		slot.ProposerSlashingCount, slot.BLSChangeCount, slot.EthTransactionCount, slot.EthBlockNumber, slot.EthBlockHash,
-		slot.EthBlockExtra, slot.EthBlockExtraText, slot.SyncParticipation, slot.ForkId)
+		slot.EthBlockExtra, slot.EthBlockExtraText, slot.SyncParticipation, slot.ForkId, slot.EthBlobTxCount, slot.EthBlobTxSize,
+		slot.EthBlobTxDist, slot.AttestationDelay)
 	if err != nil {
 		return err
 	}
@@ -84,7 +88,7 @@ func InsertMissingSlot(block *dbtypes.SlotHeader, tx *sqlx.Tx) error {
 	return nil
 }
 
-func GetSlotsRange(firstSlot uint64, lastSlot uint64, withMissing bool, withOrphaned bool) []*dbtypes.AssignedSlot {
+func GetSlotsRange(ctx context.Context, firstSlot uint64, lastSlot uint64, withMissing bool, withOrphaned bool) []*dbtypes.AssignedSlot {
 	var sql strings.Builder
 	fmt.Fprintf(&sql, `SELECT slots.slot, slots.proposer`)
 	blockFields := []string{
@@ -92,6 +96,7 @@ func GetSlotsRange(firstSlot uint64, lastSlot uint64, withMissing bool, withOrph
 		"attestation_count", "deposit_count", "exit_count", "withdraw_count", "withdraw_amount", "attester_slashing_count",
 		"proposer_slashing_count", "bls_change_count", "eth_transaction_count", "eth_block_number", "eth_block_hash",
 		"eth_block_extra", "eth_block_extra_text", "sync_participation", "fork_id",
+		"eth_blob_tx_count", "eth_blob_tx_size", "eth_blob_tx_dist",
 	}
 	for _, blockField := range blockFields {
 		fmt.Fprintf(&sql, ", slots.%v AS \"block.%v\"", blockField, blockField)
@@ -107,7 +112,7 @@ func GetSlotsRange(firstSlot uint64, lastSlot uint64, withMissing bool, withOrph
 	}
 	fmt.Fprintf(&sql, ` ORDER BY slot DESC `)
 
-	rows, err := ReaderDb.Query(sql.String(), firstSlot, lastSlot)
+	rows, err := ReaderDb.QueryContext(ctx, sql.String(), firstSlot, lastSlot)
 	if err != nil {
 		logger.WithError(err).Errorf("Error while fetching slots range: %v", sql.String())
 		return nil
@@ -116,14 +121,15 @@ func GetSlotsRange(firstSlot uint64, lastSlot uint64, withMissing bool, withOrph
 	return parseAssignedSlots(rows, blockFields, 2)
 }
 
-func GetSlotsByParentRoot(parentRoot []byte) []*dbtypes.Slot {
+func GetSlotsByParentRoot(ctx context.Context, parentRoot []byte) []*dbtypes.Slot {
 	slots := []*dbtypes.Slot{}
-	err := ReaderDb.Select(&slots, `
+	err := ReaderDb.SelectContext(ctx, &slots, `
 	SELECT
 		slot, proposer, status, root, parent_root, state_root, graffiti, graffiti_text,
 		attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 		proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash, 
-		eth_block_extra, eth_block_extra_text, sync_participation, fork_id
+		eth_block_extra, eth_block_extra_text, sync_participation, fork_id,
+		eth_blob_tx_count, eth_blob_tx_size, eth_blob_tx_dist
 	FROM slots
 	WHERE parent_root = $1
 	ORDER BY slot DESC
@@ -135,14 +141,15 @@ func GetSlotsByParentRoot(parentRoot []byte) []*dbtypes.Slot {
 	return slots
 }
 
-func GetSlotByRoot(root []byte) *dbtypes.Slot {
+func GetSlotByRoot(ctx context.Context, root []byte) *dbtypes.Slot {
 	block := dbtypes.Slot{}
-	err := ReaderDb.Get(&block, `
+	err := ReaderDb.GetContext(ctx, &block, `
 	SELECT
 		root, slot, parent_root, state_root, status, proposer, graffiti, graffiti_text,
 		attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 		proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash,
-		eth_block_extra, eth_block_extra_text, sync_participation, fork_id
+		eth_block_extra, eth_block_extra_text, sync_participation, fork_id,
+		eth_blob_tx_count, eth_blob_tx_size, eth_blob_tx_dist
 	FROM slots
 	WHERE root = $1
 	`, root)
@@ -153,7 +160,7 @@ func GetSlotByRoot(root []byte) *dbtypes.Slot {
 	return &block
 }
 
-func GetSlotsByRoots(roots [][]byte) map[phase0.Root]*dbtypes.Slot {
+func GetSlotsByRoots(ctx context.Context, roots [][]byte) map[phase0.Root]*dbtypes.Slot {
 	argIdx := 0
 	args := make([]any, len(roots))
 	plcList := make([]string, len(roots))
@@ -168,7 +175,8 @@ func GetSlotsByRoots(roots [][]byte) map[phase0.Root]*dbtypes.Slot {
 			root, slot, parent_root, state_root, status, proposer, graffiti, graffiti_text,
 			attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 			proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash,
-			eth_block_extra, eth_block_extra_text, sync_participation, fork_id
+			eth_block_extra, eth_block_extra_text, sync_participation, fork_id,
+			eth_blob_tx_count, eth_blob_tx_size, eth_blob_tx_dist
 		FROM slots
 		WHERE root IN (%v)
 		ORDER BY slot DESC`,
@@ -176,7 +184,7 @@ func GetSlotsByRoots(roots [][]byte) map[phase0.Root]*dbtypes.Slot {
 	)
 
 	slots := []*dbtypes.Slot{}
-	err := ReaderDb.Select(&slots, sql, args...)
+	err := ReaderDb.SelectContext(ctx, &slots, sql, args...)
 	if err != nil {
 		logger.Errorf("Error while fetching block by roots: %v", err)
 		return nil
@@ -211,7 +219,8 @@ func GetSlotsByBlockHash(blockHash []byte) []*dbtypes.Slot {
 		slot, proposer, status, root, parent_root, state_root, graffiti, graffiti_text,
 		attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 		proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash, 
-		eth_block_extra, eth_block_extra_text, sync_participation, fork_id
+		eth_block_extra, eth_block_extra_text, sync_participation, fork_id,
+		eth_blob_tx_count, eth_blob_tx_size, eth_blob_tx_dist
 	FROM slots
 	WHERE eth_block_hash = $1
 	ORDER BY slot DESC
@@ -264,7 +273,7 @@ func parseAssignedSlots(rows *sql.Rows, fields []string, fieldsOffset int) []*db
 	return blockAssignments
 }
 
-func GetFilteredSlots(filter *dbtypes.BlockFilter, firstSlot uint64, offset uint64, limit uint32) []*dbtypes.AssignedSlot {
+func GetFilteredSlots(ctx context.Context, filter *dbtypes.BlockFilter, firstSlot uint64, offset uint64, limit uint32) []*dbtypes.AssignedSlot {
 	var sql strings.Builder
 	fmt.Fprintf(&sql, `SELECT slots.slot, slots.proposer`)
 	blockFields := []string{
@@ -272,6 +281,7 @@ func GetFilteredSlots(filter *dbtypes.BlockFilter, firstSlot uint64, offset uint
 		"attestation_count", "deposit_count", "exit_count", "withdraw_count", "withdraw_amount", "attester_slashing_count",
 		"proposer_slashing_count", "bls_change_count", "eth_transaction_count", "eth_block_number", "eth_block_hash",
 		"eth_block_extra", "eth_block_extra_text", "sync_participation", "fork_id",
+		"eth_blob_tx_count", "eth_blob_tx_size", "eth_blob_tx_dist",
 	}
 	for _, blockField := range blockFields {
 		fmt.Fprintf(&sql, ", slots.%v AS \"block.%v\"", blockField, blockField)
@@ -303,6 +313,16 @@ func GetFilteredSlots(filter *dbtypes.BlockFilter, firstSlot uint64, offset uint
 		fmt.Fprintf(&sql, ` AND slots.proposer = $%v `, argIdx)
 		args = append(args, *filter.ProposerIndex)
 	}
+	if filter.MinSlot != nil {
+		argIdx++
+		fmt.Fprintf(&sql, ` AND slots.slot >= $%v `, argIdx)
+		args = append(args, *filter.MinSlot)
+	}
+	if filter.MaxSlot != nil {
+		argIdx++
+		fmt.Fprintf(&sql, ` AND slots.slot <= $%v `, argIdx)
+		args = append(args, *filter.MaxSlot)
+	}
 	if filter.Graffiti != "" {
 		argIdx++
 		fmt.Fprintf(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
@@ -335,7 +355,7 @@ func GetFilteredSlots(filter *dbtypes.BlockFilter, firstSlot uint64, offset uint
 	args = append(args, offset)
 
 	//fmt.Printf("sql: %v, args: %v\n", sql.String(), args)
-	rows, err := ReaderDb.Query(sql.String(), args...)
+	rows, err := ReaderDb.QueryContext(ctx, sql.String(), args...)
 	if err != nil {
 		logger.WithError(err).Errorf("Error while fetching filtered slots: %v", sql.String())
 		return nil
@@ -344,7 +364,7 @@ func GetFilteredSlots(filter *dbtypes.BlockFilter, firstSlot uint64, offset uint
 	return parseAssignedSlots(rows, blockFields, 2)
 }
 
-func GetSlotStatus(blockRoots [][]byte) []*dbtypes.BlockStatus {
+func GetSlotStatus(ctx context.Context, blockRoots [][]byte) []*dbtypes.BlockStatus {
 	orphanedRefs := []*dbtypes.BlockStatus{}
 	if len(blockRoots) == 0 {
 		return orphanedRefs
@@ -366,7 +386,7 @@ func GetSlotStatus(blockRoots [][]byte) []*dbtypes.BlockStatus {
 		argIdx += 1
 	}
 	fmt.Fprintf(&sql, ")")
-	err := ReaderDb.Select(&orphanedRefs, sql.String(), args...)
+	err := ReaderDb.SelectContext(ctx, &orphanedRefs, sql.String(), args...)
 	if err != nil {
 		logger.Errorf("Error while fetching orphaned status: %v", err)
 		return nil
@@ -390,9 +410,9 @@ func GetHighestRootBeforeSlot(slot uint64, withOrphaned bool) []byte {
 	return result
 }
 
-func GetSlotAssignment(slot uint64) uint64 {
+func GetSlotAssignment(ctx context.Context, slot uint64) uint64 {
 	proposer := uint64(math.MaxInt64)
-	err := ReaderDb.Get(&proposer, `
+	err := ReaderDb.GetContext(ctx, &proposer, `
 	SELECT
 		proposer
 	FROM slots
@@ -403,3 +423,112 @@ func GetSlotAssignment(slot uint64) uint64 {
 	}
 	return proposer
 }
+
+// GetGraffitiCounts returns the most used block graffitis along with the number of blocks using them.
+func GetGraffitiCounts(limit uint32) []*dbtypes.GraffitiCount {
+	graffitis := []*dbtypes.GraffitiCount{}
+	err := ReaderDb.Select(&graffitis, `
+	SELECT graffiti_text, count(*) AS count
+	FROM slots
+	WHERE status != 0 AND graffiti_text != ''
+	GROUP BY graffiti_text
+	ORDER BY count DESC
+	LIMIT $1
+	`, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching graffiti counts: %v", err)
+		return nil
+	}
+	return graffitis
+}
+
+// GetProposerSlotCounts returns the number of assigned proposals (canonical & missed slots) per proposer in the given slot range.
+func GetProposerSlotCounts(firstSlot uint64, lastSlot uint64) []*dbtypes.ProposerSlotCount {
+	counts := []*dbtypes.ProposerSlotCount{}
+	err := ReaderDb.Select(&counts, `
+	SELECT proposer, count(*) AS count
+	FROM slots
+	WHERE slot >= $1 AND slot <= $2 AND status IN (0, 1)
+	GROUP BY proposer
+	`, firstSlot, lastSlot)
+	if err != nil {
+		logger.Errorf("Error while fetching proposer slot counts: %v", err)
+		return nil
+	}
+	return counts
+}
+
+// GetCanonicalEthBlockRange returns the lowest & highest execution block number of the canonical blocks in the db.
+func GetCanonicalEthBlockRange() (uint64, uint64, bool) {
+	var blockRange struct {
+		Min *uint64 `db:"min_number"`
+		Max *uint64 `db:"max_number"`
+	}
+	err := ReaderDb.Get(&blockRange, `
+	SELECT MIN(eth_block_number) AS min_number, MAX(eth_block_number) AS max_number
+	FROM slots
+	WHERE status = 1 AND eth_block_number IS NOT NULL
+	`)
+	if err != nil {
+		logger.Errorf("Error while fetching canonical eth block range: %v", err)
+		return 0, 0, false
+	}
+	if blockRange.Min == nil || blockRange.Max == nil {
+		return 0, 0, false
+	}
+	return *blockRange.Min, *blockRange.Max, true
+}
+
+// GetCanonicalEthBlockAtOrAfter returns the canonical block with the lowest execution block number >= number.
+func GetCanonicalEthBlockAtOrAfter(number uint64) *dbtypes.EthBlockRef {
+	blockRef := dbtypes.EthBlockRef{}
+	err := ReaderDb.Get(&blockRef, `
+	SELECT slot, eth_block_number, eth_block_hash
+	FROM slots
+	WHERE status = 1 AND eth_block_number >= $1
+	ORDER BY eth_block_number ASC
+	LIMIT 1
+	`, number)
+	if err != nil {
+		return nil
+	}
+	return &blockRef
+}
+
+// GetSlotChainEntries returns the canonical & missed slot entries in the given slot range, ordered by slot.
+func GetSlotChainEntries(firstSlot uint64, lastSlot uint64) []*dbtypes.SlotChainEntry {
+	entries := []*dbtypes.SlotChainEntry{}
+	err := ReaderDb.Select(&entries, `
+	SELECT
+		slot, root, COALESCE(parent_root, '') AS parent_root, status
+	FROM slots
+	WHERE slot >= $1 AND slot <= $2 AND status IN (0, 1)
+	ORDER BY slot ASC
+	`, firstSlot, lastSlot)
+	if err != nil {
+		logger.Errorf("Error while fetching slot chain entries: %v", err)
+		return nil
+	}
+	return entries
+}
+
+// OrphanCanonicalSlotsInRange marks all canonical blocks in the given slot range that are not part of canonicalRoots as orphaned.
+func OrphanCanonicalSlotsInRange(firstSlot uint64, lastSlot uint64, canonicalRoots [][]byte, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprintf(&sql, `UPDATE slots SET status = 2 WHERE slot >= $1 AND slot <= $2 AND status = 1`)
+	args := []any{firstSlot, lastSlot}
+	if len(canonicalRoots) > 0 {
+		fmt.Fprintf(&sql, ` AND root NOT IN (`)
+		for i, root := range canonicalRoots {
+			if i > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "$%v", len(args)+1)
+			args = append(args, root)
+		}
+		fmt.Fprintf(&sql, ")")
+	}
+
+	_, err := tx.Exec(sql.String(), args...)
+	return err
+}
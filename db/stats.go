@@ -0,0 +1,51 @@
+package db
+
+import (
+	"sync"
+	"time"
+)
+
+// TransactionDurationBuckets are the upper bounds (in seconds) of the transaction duration histogram
+var TransactionDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// TransactionStats holds the accumulated stats of all write transactions since startup
+type TransactionStats struct {
+	Count         uint64
+	ErrorCount    uint64
+	TotalDuration time.Duration
+	BucketCounts  []uint64 // cumulative counts per TransactionDurationBuckets entry
+}
+
+var transactionStatsMutex sync.Mutex
+var transactionStats = TransactionStats{
+	BucketCounts: make([]uint64, len(TransactionDurationBuckets)),
+}
+
+func trackTransaction(duration time.Duration, err error) {
+	transactionStatsMutex.Lock()
+	defer transactionStatsMutex.Unlock()
+
+	transactionStats.Count++
+	if err != nil {
+		transactionStats.ErrorCount++
+	}
+	transactionStats.TotalDuration += duration
+
+	seconds := duration.Seconds()
+	for i, bucket := range TransactionDurationBuckets {
+		if seconds <= bucket {
+			transactionStats.BucketCounts[i]++
+		}
+	}
+}
+
+// GetTransactionStats returns a copy of the write transaction stats
+func GetTransactionStats() TransactionStats {
+	transactionStatsMutex.Lock()
+	defer transactionStatsMutex.Unlock()
+
+	stats := transactionStats
+	stats.BucketCounts = make([]uint64, len(transactionStats.BucketCounts))
+	copy(stats.BucketCounts, transactionStats.BucketCounts)
+	return stats
+}
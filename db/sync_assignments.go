@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -46,9 +47,9 @@ func InsertSyncAssignments(syncAssignments []*dbtypes.SyncAssignment, tx *sqlx.T
 	return nil
 }
 
-func GetSyncAssignmentsForPeriod(period uint64) []uint64 {
+func GetSyncAssignmentsForPeriod(ctx context.Context, period uint64) []uint64 {
 	assignments := []uint64{}
-	err := ReaderDb.Select(&assignments, `
+	err := ReaderDb.SelectContext(ctx, &assignments, `
 	SELECT
 		validator
 	FROM sync_assignments
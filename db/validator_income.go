@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+// validatorIncomeBatchSize limits the number of rows inserted per statement (6 args per row).
+const validatorIncomeBatchSize = 1000
+
+func InsertValidatorIncome(incomes []*dbtypes.ValidatorIncome, tx *sqlx.Tx) error {
+	for start := 0; start < len(incomes); start += validatorIncomeBatchSize {
+		end := min(start+validatorIncomeBatchSize, len(incomes))
+		err := insertValidatorIncomeBatch(incomes[start:end], tx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertValidatorIncomeBatch(incomes []*dbtypes.ValidatorIncome, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  `INSERT INTO validator_income (validator_index, epoch, attestation_reward, sync_reward, proposal_reward, penalty) VALUES `,
+		dbtypes.DBEngineSqlite: `INSERT OR REPLACE INTO validator_income (validator_index, epoch, attestation_reward, sync_reward, proposal_reward, penalty) VALUES `,
+	}))
+	argIdx := 0
+	args := make([]any, len(incomes)*6)
+	for i, income := range incomes {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "($%v, $%v, $%v, $%v, $%v, $%v)", argIdx+1, argIdx+2, argIdx+3, argIdx+4, argIdx+5, argIdx+6)
+		args[argIdx] = income.ValidatorIndex
+		args[argIdx+1] = income.Epoch
+		args[argIdx+2] = income.AttestationReward
+		args[argIdx+3] = income.SyncReward
+		args[argIdx+4] = income.ProposalReward
+		args[argIdx+5] = income.Penalty
+		argIdx += 6
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  ` ON CONFLICT (validator_index, epoch) DO UPDATE SET attestation_reward = excluded.attestation_reward, sync_reward = excluded.sync_reward, proposal_reward = excluded.proposal_reward, penalty = excluded.penalty`,
+		dbtypes.DBEngineSqlite: "",
+	}))
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetValidatorIncome returns the latest income periods of a validator in descending order.
+func GetValidatorIncome(ctx context.Context, validatorIndex uint64, limit uint64) []*dbtypes.ValidatorIncome {
+	incomes := []*dbtypes.ValidatorIncome{}
+	err := ReaderDb.SelectContext(ctx, &incomes, `
+	SELECT
+		validator_index, epoch, attestation_reward, sync_reward, proposal_reward, penalty
+	FROM validator_income
+	WHERE validator_index = $1
+	ORDER BY epoch DESC
+	LIMIT $2
+	`, validatorIndex, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching validator income: %v", err)
+		return nil
+	}
+	return incomes
+}
+
+func DeleteValidatorIncomeBefore(epoch uint64, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`DELETE FROM validator_income WHERE epoch < $1`, epoch)
+	return err
+}
@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func GetValidatorLabels() []*dbtypes.ValidatorLabel {
+	labels := []*dbtypes.ValidatorLabel{}
+	err := ReaderDb.Select(&labels, `SELECT "index", "name", "updated_at" FROM validator_labels ORDER BY "index" ASC`)
+	if err != nil {
+		logger.Errorf("Error while fetching validator labels: %v", err)
+		return nil
+	}
+	return labels
+}
+
+func InsertValidatorLabels(labels []*dbtypes.ValidatorLabel, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  `INSERT INTO validator_labels ("index", "name", "updated_at") VALUES `,
+		dbtypes.DBEngineSqlite: `INSERT OR REPLACE INTO validator_labels ("index", "name", "updated_at") VALUES `,
+	}))
+	argIdx := 0
+	fieldCount := 3
+	args := make([]any, len(labels)*fieldCount)
+	for i, label := range labels {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "($%v, $%v, $%v)", argIdx+1, argIdx+2, argIdx+3)
+		args[argIdx] = label.Index
+		args[argIdx+1] = label.Name
+		args[argIdx+2] = label.UpdatedAt
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  ` ON CONFLICT ("index") DO UPDATE SET name = excluded.name, updated_at = excluded.updated_at`,
+		dbtypes.DBEngineSqlite: "",
+	}))
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func DeleteValidatorLabels(indexes []uint64, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql, `DELETE FROM validator_labels WHERE "index" IN (`)
+	args := make([]any, len(indexes))
+	for i, index := range indexes {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "$%v", i+1)
+		args[i] = index
+	}
+	fmt.Fprint(&sql, ")")
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func InsertValidatorLabelChanges(changes []*dbtypes.ValidatorLabelChange, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql, `INSERT INTO validator_label_changes ("index", "change_time", "old_name", "new_name", "source") VALUES `)
+	argIdx := 0
+	fieldCount := 5
+	args := make([]any, len(changes)*fieldCount)
+	for i, change := range changes {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "($%v, $%v, $%v, $%v, $%v)", argIdx+1, argIdx+2, argIdx+3, argIdx+4, argIdx+5)
+		args[argIdx] = change.Index
+		args[argIdx+1] = change.ChangeTime
+		args[argIdx+2] = change.OldName
+		args[argIdx+3] = change.NewName
+		args[argIdx+4] = change.Source
+		argIdx += fieldCount
+	}
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetValidatorLabelChanges(ctx context.Context, offset uint64, limit uint32) []*dbtypes.ValidatorLabelChange {
+	changes := []*dbtypes.ValidatorLabelChange{}
+	err := ReaderDb.SelectContext(ctx, &changes, `
+	SELECT "index", "change_time", "old_name", "new_name", "source"
+	FROM validator_label_changes
+	ORDER BY "change_time" DESC, "index" ASC
+	LIMIT $1 OFFSET $2`, limit, offset)
+	if err != nil {
+		logger.Errorf("Error while fetching validator label changes: %v", err)
+		return nil
+	}
+	return changes
+}
@@ -0,0 +1,128 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+func InsertValidatorNote(note *dbtypes.ValidatorNote, tags []string, tx *sqlx.Tx) (uint64, error) {
+	var noteId uint64
+	err := tx.QueryRow(`
+	INSERT INTO validator_notes ("validator_index", "note", "author", "created_at")
+	VALUES ($1, $2, $3, $4)
+	RETURNING "id"`, note.ValidatorIndex, note.Note, note.Author, note.CreatedAt).Scan(&noteId)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(tags) > 0 {
+		var sql strings.Builder
+		fmt.Fprint(&sql, `INSERT INTO validator_note_tags ("note_id", "validator_index", "tag") VALUES `)
+		argIdx := 0
+		fieldCount := 3
+		args := make([]any, len(tags)*fieldCount)
+		for i, tag := range tags {
+			if i > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "($%v, $%v, $%v)", argIdx+1, argIdx+2, argIdx+3)
+			args[argIdx] = noteId
+			args[argIdx+1] = note.ValidatorIndex
+			args[argIdx+2] = tag
+			argIdx += fieldCount
+		}
+		_, err = tx.Exec(sql.String(), args...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return noteId, nil
+}
+
+func DeleteValidatorNote(noteId uint64, tx *sqlx.Tx) (bool, error) {
+	_, err := tx.Exec(`DELETE FROM validator_note_tags WHERE "note_id" = $1`, noteId)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := tx.Exec(`DELETE FROM validator_notes WHERE "id" = $1`, noteId)
+	if err != nil {
+		return false, err
+	}
+	rows, _ := res.RowsAffected()
+	return rows > 0, nil
+}
+
+func GetValidatorNote(ctx context.Context, noteId uint64) *dbtypes.ValidatorNote {
+	note := dbtypes.ValidatorNote{}
+	err := ReaderDb.GetContext(ctx, &note, `
+	SELECT "id", "validator_index", "note", "author", "created_at"
+	FROM validator_notes
+	WHERE "id" = $1`, noteId)
+	if err != nil {
+		return nil
+	}
+	return &note
+}
+
+func GetValidatorNotes(ctx context.Context, validatorIndex uint64) []*dbtypes.ValidatorNote {
+	notes := []*dbtypes.ValidatorNote{}
+	err := ReaderDb.SelectContext(ctx, &notes, `
+	SELECT "id", "validator_index", "note", "author", "created_at"
+	FROM validator_notes
+	WHERE "validator_index" = $1
+	ORDER BY "created_at" DESC, "id" DESC`, validatorIndex)
+	if err != nil {
+		logger.Errorf("Error while fetching validator notes: %v", err)
+		return nil
+	}
+	return notes
+}
+
+func GetValidatorNoteTags(ctx context.Context, validatorIndex uint64) []*dbtypes.ValidatorNoteTag {
+	tags := []*dbtypes.ValidatorNoteTag{}
+	err := ReaderDb.SelectContext(ctx, &tags, `
+	SELECT "note_id", "validator_index", "tag"
+	FROM validator_note_tags
+	WHERE "validator_index" = $1
+	ORDER BY "tag" ASC`, validatorIndex)
+	if err != nil {
+		logger.Errorf("Error while fetching validator note tags: %v", err)
+		return nil
+	}
+	return tags
+}
+
+func GetValidatorIndexesByNoteTag(ctx context.Context, tag string) []uint64 {
+	indexes := []uint64{}
+	err := ReaderDb.SelectContext(ctx, &indexes, `
+	SELECT DISTINCT "validator_index"
+	FROM validator_note_tags
+	WHERE "tag" = $1
+	ORDER BY "validator_index" ASC`, tag)
+	if err != nil {
+		logger.Errorf("Error while fetching validators by note tag: %v", err)
+		return nil
+	}
+	return indexes
+}
+
+func GetValidatorNoteTagCounts(ctx context.Context) []*dbtypes.ValidatorNoteTagCount {
+	counts := []*dbtypes.ValidatorNoteTagCount{}
+	err := ReaderDb.SelectContext(ctx, &counts, `
+	SELECT "tag", COUNT(DISTINCT "validator_index") AS "count"
+	FROM validator_note_tags
+	GROUP BY "tag"
+	ORDER BY "tag" ASC`)
+	if err != nil {
+		logger.Errorf("Error while fetching validator note tag counts: %v", err)
+		return nil
+	}
+	return counts
+}
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+func InsertValidatorOwnershipProof(proof *dbtypes.ValidatorOwnershipProof, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+	INSERT INTO validator_ownership_proofs ("session_hash", "validator_index", "proven_at", "private_label")
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT ("session_hash", "validator_index") DO UPDATE SET proven_at = excluded.proven_at`,
+		proof.SessionHash, proof.ValidatorIndex, proof.ProvenAt, proof.PrivateLabel)
+	return err
+}
+
+func UpdateValidatorOwnershipLabel(sessionHash []byte, validatorIndex uint64, privateLabel string, tx *sqlx.Tx) (bool, error) {
+	res, err := tx.Exec(`
+	UPDATE validator_ownership_proofs SET "private_label" = $3
+	WHERE "session_hash" = $1 AND "validator_index" = $2`, sessionHash, validatorIndex, privateLabel)
+	if err != nil {
+		return false, err
+	}
+	rows, _ := res.RowsAffected()
+	return rows > 0, nil
+}
+
+func DeleteValidatorOwnershipProof(sessionHash []byte, validatorIndex uint64, tx *sqlx.Tx) (bool, error) {
+	res, err := tx.Exec(`
+	DELETE FROM validator_ownership_proofs
+	WHERE "session_hash" = $1 AND "validator_index" = $2`, sessionHash, validatorIndex)
+	if err != nil {
+		return false, err
+	}
+	rows, _ := res.RowsAffected()
+	return rows > 0, nil
+}
+
+func GetValidatorOwnershipProofs(ctx context.Context, sessionHash []byte) []*dbtypes.ValidatorOwnershipProof {
+	proofs := []*dbtypes.ValidatorOwnershipProof{}
+	err := ReaderDb.SelectContext(ctx, &proofs, `
+	SELECT "session_hash", "validator_index", "proven_at", "private_label"
+	FROM validator_ownership_proofs
+	WHERE "session_hash" = $1
+	ORDER BY "validator_index" ASC`, sessionHash)
+	if err != nil {
+		logger.Errorf("Error while fetching validator ownership proofs: %v", err)
+		return nil
+	}
+	return proofs
+}
+
+func GetValidatorOwnershipProof(ctx context.Context, sessionHash []byte, validatorIndex uint64) *dbtypes.ValidatorOwnershipProof {
+	proof := dbtypes.ValidatorOwnershipProof{}
+	err := ReaderDb.GetContext(ctx, &proof, `
+	SELECT "session_hash", "validator_index", "proven_at", "private_label"
+	FROM validator_ownership_proofs
+	WHERE "session_hash" = $1 AND "validator_index" = $2`, sessionHash, validatorIndex)
+	if err != nil {
+		return nil
+	}
+	return &proof
+}
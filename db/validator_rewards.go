@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertValidatorRewardSnapshot(snapshot *dbtypes.ValidatorRewardSnapshot, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql: `
+			INSERT INTO validator_reward_snapshots (
+				epoch, base_epoch, validator_count, balances
+			) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (epoch) DO UPDATE SET
+				base_epoch = excluded.base_epoch,
+				validator_count = excluded.validator_count,
+				balances = excluded.balances`,
+		dbtypes.DBEngineSqlite: `
+			INSERT OR REPLACE INTO validator_reward_snapshots (
+				epoch, base_epoch, validator_count, balances
+			) VALUES ($1, $2, $3, $4)`,
+	}),
+		snapshot.Epoch, snapshot.BaseEpoch, snapshot.ValidatorCount, snapshot.Balances)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetValidatorRewardSnapshotEpochs returns all stored reward snapshots in descending order, without the balances.
+func GetValidatorRewardSnapshotEpochs(ctx context.Context) []*dbtypes.ValidatorRewardSnapshot {
+	snapshots := []*dbtypes.ValidatorRewardSnapshot{}
+	err := ReaderDb.SelectContext(ctx, &snapshots, `
+	SELECT
+		epoch, base_epoch, validator_count
+	FROM validator_reward_snapshots
+	ORDER BY epoch DESC
+	`)
+	if err != nil {
+		logger.Errorf("Error while fetching validator reward snapshots: %v", err)
+		return nil
+	}
+	return snapshots
+}
+
+func GetValidatorRewardSnapshot(ctx context.Context, epoch uint64) *dbtypes.ValidatorRewardSnapshot {
+	snapshot := dbtypes.ValidatorRewardSnapshot{}
+	err := ReaderDb.GetContext(ctx, &snapshot, `
+	SELECT
+		epoch, base_epoch, validator_count, balances
+	FROM validator_reward_snapshots
+	WHERE epoch = $1
+	`, epoch)
+	if err != nil {
+		return nil
+	}
+	return &snapshot
+}
+
+func DeleteValidatorRewardSnapshotsBefore(epoch uint64, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`DELETE FROM validator_reward_snapshots WHERE epoch < $1`, epoch)
+	return err
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+func InsertValidatorWatchlistWebhook(webhook *dbtypes.ValidatorWatchlistWebhook, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql: `
+			INSERT INTO validator_watchlist_webhooks ("session_hash", "list_name", "url", "events", "offline_epochs", "created_at")
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT ("session_hash", "list_name", "url") DO UPDATE SET
+				"events" = excluded."events",
+				"offline_epochs" = excluded."offline_epochs"`,
+		dbtypes.DBEngineSqlite: `
+			INSERT OR REPLACE INTO validator_watchlist_webhooks ("session_hash", "list_name", "url", "events", "offline_epochs", "created_at")
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+	}), webhook.SessionHash, webhook.ListName, webhook.Url, webhook.Events, webhook.OfflineEpochs, webhook.CreatedAt)
+	return err
+}
+
+func DeleteValidatorWatchlistWebhook(sessionHash []byte, listName string, url string, tx *sqlx.Tx) (bool, error) {
+	res, err := tx.Exec(`
+	DELETE FROM validator_watchlist_webhooks
+	WHERE "session_hash" = $1 AND "list_name" = $2 AND "url" = $3`, sessionHash, listName, url)
+	if err != nil {
+		return false, err
+	}
+	rows, _ := res.RowsAffected()
+	return rows > 0, nil
+}
+
+func DeleteValidatorWatchlistWebhooks(sessionHash []byte, listName string, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+	DELETE FROM validator_watchlist_webhooks
+	WHERE "session_hash" = $1 AND "list_name" = $2`, sessionHash, listName)
+	return err
+}
+
+func GetValidatorWatchlistWebhooks(ctx context.Context, sessionHash []byte) []*dbtypes.ValidatorWatchlistWebhook {
+	webhooks := []*dbtypes.ValidatorWatchlistWebhook{}
+	err := ReaderDb.SelectContext(ctx, &webhooks, `
+	SELECT "session_hash", "list_name", "url", "events", "offline_epochs", "created_at"
+	FROM validator_watchlist_webhooks
+	WHERE "session_hash" = $1
+	ORDER BY "list_name" ASC, "created_at" ASC`, sessionHash)
+	if err != nil {
+		logger.Errorf("Error while fetching validator watchlist webhooks: %v", err)
+		return nil
+	}
+	return webhooks
+}
+
+func GetAllValidatorWatchlistWebhooks() []*dbtypes.ValidatorWatchlistWebhook {
+	webhooks := []*dbtypes.ValidatorWatchlistWebhook{}
+	err := ReaderDb.Select(&webhooks, `
+	SELECT "session_hash", "list_name", "url", "events", "offline_epochs", "created_at"
+	FROM validator_watchlist_webhooks
+	ORDER BY "session_hash" ASC, "list_name" ASC`)
+	if err != nil {
+		logger.Errorf("Error while fetching validator watchlist webhooks: %v", err)
+		return nil
+	}
+	return webhooks
+}
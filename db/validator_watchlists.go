@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+func InsertValidatorWatchlistEntries(entries []*dbtypes.ValidatorWatchlistEntry, tx *sqlx.Tx) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var sql strings.Builder
+	fmt.Fprint(&sql, `INSERT INTO validator_watchlists ("session_hash", "list_name", "validator_index", "added_at") VALUES `)
+	argIdx := 0
+	fieldCount := 4
+	args := make([]any, len(entries)*fieldCount)
+	for i, entry := range entries {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "($%v, $%v, $%v, $%v)", argIdx+1, argIdx+2, argIdx+3, argIdx+4)
+		args[argIdx] = entry.SessionHash
+		args[argIdx+1] = entry.ListName
+		args[argIdx+2] = entry.ValidatorIndex
+		args[argIdx+3] = entry.AddedAt
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, ` ON CONFLICT ("session_hash", "list_name", "validator_index") DO NOTHING`)
+
+	_, err := tx.Exec(sql.String(), args...)
+	return err
+}
+
+func DeleteValidatorWatchlistEntry(sessionHash []byte, listName string, validatorIndex uint64, tx *sqlx.Tx) (bool, error) {
+	res, err := tx.Exec(`
+	DELETE FROM validator_watchlists
+	WHERE "session_hash" = $1 AND "list_name" = $2 AND "validator_index" = $3`, sessionHash, listName, validatorIndex)
+	if err != nil {
+		return false, err
+	}
+	rows, _ := res.RowsAffected()
+	return rows > 0, nil
+}
+
+func DeleteValidatorWatchlist(sessionHash []byte, listName string, tx *sqlx.Tx) (bool, error) {
+	res, err := tx.Exec(`
+	DELETE FROM validator_watchlists
+	WHERE "session_hash" = $1 AND "list_name" = $2`, sessionHash, listName)
+	if err != nil {
+		return false, err
+	}
+	rows, _ := res.RowsAffected()
+	return rows > 0, nil
+}
+
+func GetValidatorWatchlistEntries(ctx context.Context, sessionHash []byte) []*dbtypes.ValidatorWatchlistEntry {
+	entries := []*dbtypes.ValidatorWatchlistEntry{}
+	err := ReaderDb.SelectContext(ctx, &entries, `
+	SELECT "session_hash", "list_name", "validator_index", "added_at"
+	FROM validator_watchlists
+	WHERE "session_hash" = $1
+	ORDER BY "list_name" ASC, "validator_index" ASC`, sessionHash)
+	if err != nil {
+		logger.Errorf("Error while fetching validator watchlists: %v", err)
+		return nil
+	}
+	return entries
+}
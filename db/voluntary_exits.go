@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -75,7 +76,7 @@ func GetVoluntaryExitForValidator(validator uint64) *dbtypes.VoluntaryExit {
 	return voluntaryExit
 }
 
-func GetVoluntaryExitsFiltered(offset uint64, limit uint32, finalizedBlock uint64, filter *dbtypes.VoluntaryExitFilter) ([]*dbtypes.VoluntaryExit, uint64, error) {
+func GetVoluntaryExitsFiltered(ctx context.Context, offset uint64, limit uint32, finalizedBlock uint64, filter *dbtypes.VoluntaryExitFilter) ([]*dbtypes.VoluntaryExit, uint64, error) {
 	var sql strings.Builder
 	args := []any{}
 	fmt.Fprint(&sql, `
@@ -154,7 +155,7 @@ func GetVoluntaryExitsFiltered(offset uint64, limit uint32, finalizedBlock uint6
 	fmt.Fprintf(&sql, ") AS t1")
 
 	voluntaryExits := []*dbtypes.VoluntaryExit{}
-	err := ReaderDb.Select(&voluntaryExits, sql.String(), args...)
+	err := ReaderDb.SelectContext(ctx, &voluntaryExits, sql.String(), args...)
 	if err != nil {
 		logger.Errorf("Error while fetching filtered voluntary exits: %v", err)
 		return nil, 0, err
@@ -0,0 +1,166 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertWatchedContractLogs(contractLogs []*dbtypes.WatchedContractLog, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql,
+		EngineQuery(map[dbtypes.DBEngineType]string{
+			dbtypes.DBEnginePgsql:  "INSERT INTO watched_contract_logs ",
+			dbtypes.DBEngineSqlite: "INSERT OR REPLACE INTO watched_contract_logs ",
+		}),
+		"(contract_address, block_number, block_index, block_time, block_root, fork_id, topic0, topic1, topic2, topic3, data, tx_hash, tx_sender, tx_target)",
+		" VALUES ",
+	)
+	argIdx := 0
+	fieldCount := 14
+
+	args := make([]any, len(contractLogs)*fieldCount)
+	for i, contractLog := range contractLogs {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "(")
+		for f := 0; f < fieldCount; f++ {
+			if f > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "$%v", argIdx+f+1)
+
+		}
+		fmt.Fprintf(&sql, ")")
+
+		args[argIdx+0] = contractLog.ContractAddress
+		args[argIdx+1] = contractLog.BlockNumber
+		args[argIdx+2] = contractLog.BlockIndex
+		args[argIdx+3] = contractLog.BlockTime
+		args[argIdx+4] = contractLog.BlockRoot
+		args[argIdx+5] = contractLog.ForkId
+		args[argIdx+6] = contractLog.Topic0
+		args[argIdx+7] = contractLog.Topic1
+		args[argIdx+8] = contractLog.Topic2
+		args[argIdx+9] = contractLog.Topic3
+		args[argIdx+10] = contractLog.Data
+		args[argIdx+11] = contractLog.TxHash
+		args[argIdx+12] = contractLog.TxSender
+		args[argIdx+13] = contractLog.TxTarget
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (block_root, block_index) DO UPDATE SET fork_id = excluded.fork_id",
+		dbtypes.DBEngineSqlite: "",
+	}))
+
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetWatchedContractLogsFiltered(ctx context.Context, offset uint64, limit uint32, canonicalForkIds []uint64, filter *dbtypes.WatchedContractLogFilter) ([]*dbtypes.WatchedContractLog, uint64, error) {
+	var sql strings.Builder
+	args := []interface{}{}
+	fmt.Fprint(&sql, `
+	WITH cte AS (
+		SELECT
+			contract_address, block_number, block_index, block_time, block_root, fork_id, topic0, topic1, topic2, topic3, data, tx_hash, tx_sender, tx_target
+		FROM watched_contract_logs
+	`)
+
+	filterOp := "WHERE"
+	if len(filter.ContractAddress) > 0 {
+		args = append(args, filter.ContractAddress)
+		fmt.Fprintf(&sql, " %v contract_address = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if len(filter.Topic0) > 0 {
+		args = append(args, filter.Topic0)
+		fmt.Fprintf(&sql, " %v topic0 = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if len(filter.TxSender) > 0 {
+		args = append(args, filter.TxSender)
+		fmt.Fprintf(&sql, " %v tx_sender = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if len(filter.TxHash) > 0 {
+		args = append(args, filter.TxHash)
+		fmt.Fprintf(&sql, " %v tx_hash = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MinBlock > 0 {
+		args = append(args, filter.MinBlock)
+		fmt.Fprintf(&sql, " %v block_number >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxBlock > 0 {
+		args = append(args, filter.MaxBlock)
+		fmt.Fprintf(&sql, " %v block_number <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+
+	if filter.WithOrphaned != 1 {
+		forkIdStr := make([]string, len(canonicalForkIds))
+		for i, forkId := range canonicalForkIds {
+			forkIdStr[i] = fmt.Sprintf("%v", forkId)
+		}
+		if len(forkIdStr) == 0 {
+			forkIdStr = append(forkIdStr, "0")
+		}
+
+		if filter.WithOrphaned == 0 {
+			fmt.Fprintf(&sql, " %v fork_id IN (%v)", filterOp, strings.Join(forkIdStr, ","))
+			filterOp = "AND"
+		} else if filter.WithOrphaned == 2 {
+			fmt.Fprintf(&sql, " %v fork_id NOT IN (%v)", filterOp, strings.Join(forkIdStr, ","))
+			filterOp = "AND"
+		}
+	}
+
+	args = append(args, limit)
+	fmt.Fprintf(&sql, `)
+	SELECT
+		null AS contract_address,
+		count(*) AS block_number,
+		0 AS block_index,
+		0 AS block_time,
+		null AS block_root,
+		0 AS fork_id,
+		null AS topic0,
+		null AS topic1,
+		null AS topic2,
+		null AS topic3,
+		null AS data,
+		null AS tx_hash,
+		null AS tx_sender,
+		null AS tx_target
+	FROM cte
+	UNION ALL SELECT * FROM (
+	SELECT * FROM cte
+	ORDER BY block_number DESC, block_index DESC
+	LIMIT $%v
+	`, len(args))
+
+	if offset > 0 {
+		args = append(args, offset)
+		fmt.Fprintf(&sql, " OFFSET $%v ", len(args))
+	}
+	fmt.Fprintf(&sql, ") AS t1")
+
+	contractLogs := []*dbtypes.WatchedContractLog{}
+	err := ReaderDb.SelectContext(ctx, &contractLogs, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching filtered watched contract logs: %v", err)
+		return nil, 0, err
+	}
+
+	return contractLogs[1:], contractLogs[0].BlockNumber, nil
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -15,11 +16,11 @@ func InsertWithdrawalRequestTxs(withdrawalTxs []*dbtypes.WithdrawalRequestTx, tx
 			dbtypes.DBEnginePgsql:  "INSERT INTO withdrawal_request_txs ",
 			dbtypes.DBEngineSqlite: "INSERT OR REPLACE INTO withdrawal_request_txs ",
 		}),
-		"(block_number, block_index, block_time, block_root, fork_id, source_address, validator_pubkey, validator_index, amount, tx_hash, tx_sender, tx_target, dequeue_block)",
+		"(block_number, block_index, block_time, block_root, fork_id, source_address, validator_pubkey, validator_index, amount, tx_hash, tx_sender, tx_target, dequeue_block, tx_fee)",
 		" VALUES ",
 	)
 	argIdx := 0
-	fieldCount := 13
+	fieldCount := 14
 
 	args := make([]any, len(withdrawalTxs)*fieldCount)
 	for i, withdrawalTx := range withdrawalTxs {
@@ -49,10 +50,11 @@ func InsertWithdrawalRequestTxs(withdrawalTxs []*dbtypes.WithdrawalRequestTx, tx
 		args[argIdx+10] = withdrawalTx.TxSender
 		args[argIdx+11] = withdrawalTx.TxTarget
 		args[argIdx+12] = withdrawalTx.DequeueBlock
+		args[argIdx+13] = withdrawalTx.TxFee
 		argIdx += fieldCount
 	}
 	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
-		dbtypes.DBEnginePgsql:  " ON CONFLICT (block_root, block_index) DO UPDATE SET fork_id = excluded.fork_id",
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (block_root, block_index) DO UPDATE SET fork_id = excluded.fork_id, tx_fee = excluded.tx_fee",
 		dbtypes.DBEngineSqlite: "",
 	}))
 
@@ -63,10 +65,10 @@ func InsertWithdrawalRequestTxs(withdrawalTxs []*dbtypes.WithdrawalRequestTx, tx
 	return nil
 }
 
-func GetWithdrawalRequestTxsByDequeueRange(dequeueFirst uint64, dequeueLast uint64) []*dbtypes.WithdrawalRequestTx {
+func GetWithdrawalRequestTxsByDequeueRange(ctx context.Context, dequeueFirst uint64, dequeueLast uint64) []*dbtypes.WithdrawalRequestTx {
 	withdrawalTxs := []*dbtypes.WithdrawalRequestTx{}
 
-	err := ReaderDb.Select(&withdrawalTxs, `SELECT withdrawal_request_txs.*
+	err := ReaderDb.SelectContext(ctx, &withdrawalTxs, `SELECT withdrawal_request_txs.*
 		FROM withdrawal_request_txs
 		WHERE dequeue_block >= $1 AND dequeue_block <= $2
 		ORDER BY dequeue_block ASC, block_number ASC, block_index ASC
@@ -79,7 +81,7 @@ func GetWithdrawalRequestTxsByDequeueRange(dequeueFirst uint64, dequeueLast uint
 	return withdrawalTxs
 }
 
-func GetWithdrawalRequestTxsByTxHashes(txHashes [][]byte) []*dbtypes.WithdrawalRequestTx {
+func GetWithdrawalRequestTxsByTxHashes(ctx context.Context, txHashes [][]byte) []*dbtypes.WithdrawalRequestTx {
 	var sql strings.Builder
 	args := []interface{}{}
 
@@ -98,7 +100,7 @@ func GetWithdrawalRequestTxsByTxHashes(txHashes [][]byte) []*dbtypes.WithdrawalR
 	fmt.Fprintf(&sql, ")")
 
 	withdrawalTxs := []*dbtypes.WithdrawalRequestTx{}
-	err := ReaderDb.Select(&withdrawalTxs, sql.String(), args...)
+	err := ReaderDb.SelectContext(ctx, &withdrawalTxs, sql.String(), args...)
 	if err != nil {
 		logger.Errorf("Error while fetching withdrawal request txs: %v", err)
 		return nil
@@ -107,13 +109,13 @@ func GetWithdrawalRequestTxsByTxHashes(txHashes [][]byte) []*dbtypes.WithdrawalR
 	return withdrawalTxs
 }
 
-func GetWithdrawalRequestTxsFiltered(offset uint64, limit uint32, canonicalForkIds []uint64, filter *dbtypes.WithdrawalRequestTxFilter) ([]*dbtypes.WithdrawalRequestTx, uint64, error) {
+func GetWithdrawalRequestTxsFiltered(ctx context.Context, offset uint64, limit uint32, canonicalForkIds []uint64, filter *dbtypes.WithdrawalRequestTxFilter) ([]*dbtypes.WithdrawalRequestTx, uint64, error) {
 	var sql strings.Builder
 	args := []interface{}{}
 	fmt.Fprint(&sql, `
 	WITH cte AS (
 		SELECT
-			block_number, block_index, block_time, block_root, fork_id, source_address, validator_pubkey, validator_index, amount, tx_hash, tx_sender, tx_target, dequeue_block
+			block_number, block_index, block_time, block_root, fork_id, source_address, validator_pubkey, validator_index, amount, tx_hash, tx_sender, tx_target, dequeue_block, tx_fee
 		FROM withdrawal_request_txs
 	`)
 
@@ -207,7 +209,8 @@ func GetWithdrawalRequestTxsFiltered(offset uint64, limit uint32, canonicalForkI
 		null AS tx_hash,
 		null AS tx_sender,
 		null AS tx_target,
-		0 AS dequeue_block
+		0 AS dequeue_block,
+		null AS tx_fee
 	FROM cte
 	UNION ALL SELECT * FROM (
 	SELECT * FROM cte
@@ -222,7 +225,7 @@ func GetWithdrawalRequestTxsFiltered(offset uint64, limit uint32, canonicalForkI
 	fmt.Fprintf(&sql, ") AS t1")
 
 	withdrawalRequestTxs := []*dbtypes.WithdrawalRequestTx{}
-	err := ReaderDb.Select(&withdrawalRequestTxs, sql.String(), args...)
+	err := ReaderDb.SelectContext(ctx, &withdrawalRequestTxs, sql.String(), args...)
 	if err != nil {
 		logger.Errorf("Error while fetching filtered withdrawal request txs: %v", err)
 		return nil, 0, err
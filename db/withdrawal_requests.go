@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -61,7 +62,7 @@ func InsertWithdrawalRequests(elRequests []*dbtypes.WithdrawalRequest, tx *sqlx.
 	return nil
 }
 
-func GetWithdrawalRequestsFiltered(offset uint64, limit uint32, canonicalForkIds []uint64, filter *dbtypes.WithdrawalRequestFilter) ([]*dbtypes.WithdrawalRequest, uint64, error) {
+func GetWithdrawalRequestsFiltered(ctx context.Context, offset uint64, limit uint32, canonicalForkIds []uint64, filter *dbtypes.WithdrawalRequestFilter) ([]*dbtypes.WithdrawalRequest, uint64, error) {
 	var sql strings.Builder
 	args := []interface{}{}
 	fmt.Fprint(&sql, `
@@ -174,7 +175,7 @@ func GetWithdrawalRequestsFiltered(offset uint64, limit uint32, canonicalForkIds
 	fmt.Fprintf(&sql, ") AS t1")
 
 	withdrawalRequests := []*dbtypes.WithdrawalRequest{}
-	err := ReaderDb.Select(&withdrawalRequests, sql.String(), args...)
+	err := ReaderDb.SelectContext(ctx, &withdrawalRequests, sql.String(), args...)
 	if err != nil {
 		logger.Errorf("Error while fetching filtered withdrawal requests: %v", err)
 		return nil, 0, err
@@ -10,6 +10,197 @@ type ValidatorName struct {
 	Name  string `db:"name"`
 }
 
+type ValidatorLabel struct {
+	Index     uint64 `db:"index"`
+	Name      string `db:"name"`
+	UpdatedAt int64  `db:"updated_at"`
+}
+
+type ValidatorNote struct {
+	Id             uint64 `db:"id"`
+	ValidatorIndex uint64 `db:"validator_index"`
+	Note           string `db:"note"`
+	Author         string `db:"author"`
+	CreatedAt      int64  `db:"created_at"`
+}
+
+type ValidatorOwnershipProof struct {
+	SessionHash    []byte `db:"session_hash"`
+	ValidatorIndex uint64 `db:"validator_index"`
+	ProvenAt       int64  `db:"proven_at"`
+	PrivateLabel   string `db:"private_label"`
+}
+
+type ValidatorWatchlistEntry struct {
+	SessionHash    []byte `db:"session_hash"`
+	ListName       string `db:"list_name"`
+	ValidatorIndex uint64 `db:"validator_index"`
+	AddedAt        int64  `db:"added_at"`
+}
+
+type ValidatorWatchlistWebhook struct {
+	SessionHash   []byte `db:"session_hash"`
+	ListName      string `db:"list_name"`
+	Url           string `db:"url"`
+	Events        string `db:"events"`
+	OfflineEpochs uint64 `db:"offline_epochs"`
+	CreatedAt     int64  `db:"created_at"`
+}
+
+type NotificationLogEntry struct {
+	RuleName       string `db:"rule_name"`
+	EventType      string `db:"event_type"`
+	ValidatorIndex uint64 `db:"validator_index"`
+	EventSlot      uint64 `db:"event_slot"`
+	Message        string `db:"message"`
+	CreatedAt      int64  `db:"created_at"`
+	Error          string `db:"error"`
+}
+
+type NotificationValidatorState struct {
+	RuleName       string `db:"rule_name"`
+	ValidatorIndex uint64 `db:"validator_index"`
+	MissedEpochs   uint64 `db:"missed_epochs"`
+	Offline        bool   `db:"offline"`
+}
+
+type ValidatorNoteTag struct {
+	NoteId         uint64 `db:"note_id"`
+	ValidatorIndex uint64 `db:"validator_index"`
+	Tag            string `db:"tag"`
+}
+
+type ValidatorNoteTagCount struct {
+	Tag   string `db:"tag"`
+	Count uint64 `db:"count"`
+}
+
+type ValidatorLabelChange struct {
+	Index      uint64 `db:"index"`
+	ChangeTime int64  `db:"change_time"`
+	OldName    string `db:"old_name"`
+	NewName    string `db:"new_name"`
+	Source     string `db:"source"`
+}
+
+type ElectraStats struct {
+	Epoch                 uint64 `db:"epoch"`
+	BlsValidators         uint64 `db:"bls_validators"`
+	ExecValidators        uint64 `db:"exec_validators"`
+	CompoundingValidators uint64 `db:"compounding_validators"`
+	CompoundingBalance    uint64 `db:"compounding_balance"`
+	CompoundingMaxEb      uint64 `db:"compounding_max_eb"`
+	TotalEffectiveBalance uint64 `db:"total_effective_balance"`
+	Consolidations        uint64 `db:"consolidations"`
+	CredentialSwitches    uint64 `db:"credential_switches"`
+}
+
+// ValidatorRewardSnapshot holds the balances & cumulative balance flows of all validators at an epoch.
+// Balances are encoded as 16 bytes per validator (balance & cumulative flow, little endian).
+type ValidatorRewardSnapshot struct {
+	Epoch          uint64 `db:"epoch"`
+	BaseEpoch      uint64 `db:"base_epoch"`
+	ValidatorCount uint64 `db:"validator_count"`
+	Balances       []byte `db:"balances"`
+}
+
+// ValidatorIncome holds the consensus layer income of a validator aggregated over an income period starting at Epoch (values in gwei).
+type ValidatorIncome struct {
+	ValidatorIndex    uint64 `db:"validator_index"`
+	Epoch             uint64 `db:"epoch"`
+	AttestationReward int64  `db:"attestation_reward"`
+	SyncReward        int64  `db:"sync_reward"`
+	ProposalReward    int64  `db:"proposal_reward"`
+	Penalty           int64  `db:"penalty"`
+}
+
+// ParticipationRollup holds the summed attestation participation of the finalized epochs within a rollup bucket starting at BucketEpoch.
+type ParticipationRollup struct {
+	BucketEpoch uint64 `db:"bucket_epoch"`
+	LastEpoch   uint64 `db:"last_epoch"`
+	EpochCount  uint64 `db:"epoch_count"`
+	Eligible    uint64 `db:"eligible"`
+	VotedTarget uint64 `db:"voted_target"`
+	VotedHead   uint64 `db:"voted_head"`
+	VotedTotal  uint64 `db:"voted_total"`
+}
+
+type GraffitiProposer struct {
+	GraffitiText string `db:"graffiti_text"`
+	Proposer     uint64 `db:"proposer"`
+	BlockCount   uint64 `db:"block_count"`
+	FirstSlot    uint64 `db:"first_slot"`
+	LastSlot     uint64 `db:"last_slot"`
+}
+
+type GraffitiStat struct {
+	GraffitiText  string `db:"graffiti_text"`
+	BlockCount    uint64 `db:"block_count"`
+	ProposerCount uint64 `db:"proposer_count"`
+	FirstSlot     uint64 `db:"first_slot"`
+	LastSlot      uint64 `db:"last_slot"`
+}
+
+type ClientLayer uint8
+
+const (
+	ClientLayerConsensus ClientLayer = iota
+	ClientLayerExecution
+)
+
+type ClientDiversityEntry struct {
+	BucketEpoch uint64      `db:"bucket_epoch"`
+	Layer       ClientLayer `db:"layer"`
+	Client      string      `db:"client"`
+	BlockCount  uint64      `db:"block_count"`
+}
+
+type SlotClientFingerprint struct {
+	Slot             uint64 `db:"slot"`
+	GraffitiText     string `db:"graffiti_text"`
+	ExtraData        []byte `db:"eth_block_extra"`
+	AttestationDelay uint32 `db:"attestation_delay"`
+	GasLimit         uint64 `db:"gas_limit"`        // 0 if the block has no tx summary
+	ParentGasLimit   uint64 `db:"parent_gas_limit"` // 0 if the parent block has no tx summary
+}
+
+// ElTxSummary holds the summary of the execution payload transactions of a beacon block.
+// TopTargets is the json encoded list of the most called contracts (ElTxTarget).
+type ElTxSummary struct {
+	BlockRoot         []byte `db:"block_root"`
+	Slot              uint64 `db:"slot"`
+	BlockNumber       uint64 `db:"block_number"`
+	BlockHash         []byte `db:"block_hash"`
+	TxCount           uint64 `db:"tx_count"`
+	BlobTxCount       uint64 `db:"blob_tx_count"`
+	ContractCreations uint64 `db:"contract_creations"`
+	GasUsed           uint64 `db:"gas_used"`
+	GasLimit          uint64 `db:"gas_limit"`
+	BaseFee           uint64 `db:"base_fee"`
+	TopTargets        string `db:"top_targets"`
+}
+
+// ElTxTarget is a contract called by the transactions of a block.
+type ElTxTarget struct {
+	Address  []byte `json:"address"`
+	TxCount  uint64 `json:"tx_count"`
+	GasLimit uint64 `json:"gas_limit"`
+}
+
+// BlockCompressionDict holds a zstd dictionary used to compress the block bodies in the unfinalized & orphaned block tables.
+type BlockCompressionDict struct {
+	DictId     uint32 `db:"dict_id"`
+	CreateTime int64  `db:"create_time"`
+	Dict       []byte `db:"dict"`
+}
+
+// BlockBody holds the encoded body of a block in the unfinalized or orphaned block tables.
+type BlockBody struct {
+	Root     []byte `db:"root"`
+	BlockVer uint64 `db:"block_ver"`
+	BlockSSZ []byte `db:"block_ssz"`
+}
+
 type SlotStatus uint8
 
 const (
@@ -48,6 +239,10 @@ type Slot struct {
 	EthBlockExtraText     string     `db:"eth_block_extra_text"`
 	SyncParticipation     float32    `db:"sync_participation"`
 	ForkId                uint64     `db:"fork_id"`
+	EthBlobTxCount        uint64     `db:"eth_blob_tx_count"`
+	EthBlobTxSize         uint64     `db:"eth_blob_tx_size"`
+	EthBlobTxDist         []byte     `db:"eth_blob_tx_dist"`
+	AttestationDelay      uint32     `db:"attestation_delay"` // avg. inclusion delay of the included attestations in 1/100 slots
 }
 
 type Epoch struct {
@@ -150,17 +345,16 @@ type UnfinalizedDuty struct {
 	DutiesSSZ     []byte `db:"duties"`
 }
 
-type Blob struct {
-	Commitment []byte  `db:"commitment"`
-	Proof      []byte  `db:"proof"`
-	Size       uint32  `db:"size"`
-	Blob       *[]byte `db:"blob"`
-}
-
-type BlobAssignment struct {
-	Root       []byte `db:"root"`
-	Commitment []byte `db:"commitment"`
-	Slot       uint64 `db:"slot"`
+type BlobSidecar struct {
+	BlockRoot     []byte `db:"block_root"`
+	BlobIndex     uint64 `db:"blob_index"`
+	Slot          uint64 `db:"slot"`
+	Proposer      uint64 `db:"proposer"`
+	Commitment    []byte `db:"commitment"`
+	Proof         []byte `db:"proof"`
+	VersionedHash []byte `db:"versioned_hash"`
+	Size          uint32 `db:"size"`   // blob size without trailing zero bytes
+	Stored        bool   `db:"stored"` // blob contents are available in the blob storage
 }
 
 type TxFunctionSignature struct {
@@ -209,6 +403,7 @@ type DepositTx struct {
 	TxSender              []byte `db:"tx_sender"`
 	TxTarget              []byte `db:"tx_target"`
 	ForkId                uint64 `db:"fork_id"`
+	ContractAddress       []byte `db:"contract_address"` // empty for the deposit contract from the chain specs
 }
 
 type Deposit struct {
@@ -281,6 +476,7 @@ type ConsolidationRequestTx struct {
 	TxSender      []byte  `db:"tx_sender"`
 	TxTarget      []byte  `db:"tx_target"`
 	DequeueBlock  uint64  `db:"dequeue_block"`
+	TxFee         []byte  `db:"tx_fee"` // fee sent to the system contract in wei (big endian), nil if unknown
 }
 
 type WithdrawalRequest struct {
@@ -311,4 +507,22 @@ type WithdrawalRequestTx struct {
 	TxSender        []byte  `db:"tx_sender"`
 	TxTarget        []byte  `db:"tx_target"`
 	DequeueBlock    uint64  `db:"dequeue_block"`
+	TxFee           []byte  `db:"tx_fee"` // fee sent to the system contract in wei (big endian), nil if unknown
+}
+
+type WatchedContractLog struct {
+	ContractAddress []byte `db:"contract_address"`
+	BlockNumber     uint64 `db:"block_number"`
+	BlockIndex      uint64 `db:"block_index"`
+	BlockTime       uint64 `db:"block_time"`
+	BlockRoot       []byte `db:"block_root"`
+	ForkId          uint64 `db:"fork_id"`
+	Topic0          []byte `db:"topic0"`
+	Topic1          []byte `db:"topic1"`
+	Topic2          []byte `db:"topic2"`
+	Topic3          []byte `db:"topic3"`
+	Data            []byte `db:"data"`
+	TxHash          []byte `db:"tx_hash"`
+	TxSender        []byte `db:"tx_sender"`
+	TxTarget        []byte `db:"tx_target"`
 }
@@ -18,13 +18,6 @@ type BlockHead struct {
 	ForkId     uint64 `db:"fork_id"`
 }
 
-type AssignedBlob struct {
-	Root       []byte `db:"root"`
-	Commitment []byte `db:"commitment"`
-	Slot       uint64 `db:"slot"`
-	Blob       *Blob  `db:"blob"`
-}
-
 type UnfinalizedBlockFilter struct {
 	MinSlot  uint64
 	MaxSlot  uint64
@@ -38,6 +31,8 @@ type BlockFilter struct {
 	ProposerName  string
 	WithOrphaned  uint8
 	WithMissing   uint8
+	MinSlot       *uint64
+	MaxSlot       *uint64
 }
 
 type MevBlockFilter struct {
@@ -51,6 +46,12 @@ type MevBlockFilter struct {
 	MevRelay      []uint8
 }
 
+type GraffitiFilter struct {
+	Search   string
+	Regex    bool
+	Proposer *uint64
+}
+
 type DepositTxFilter struct {
 	MinIndex      uint64
 	MaxIndex      uint64
@@ -63,6 +64,8 @@ type DepositTxFilter struct {
 	MaxAmount     uint64
 	WithOrphaned  uint8
 	WithValid     uint8
+	DepositType   uint8  // 0: all deposits, 1: initial deposits only, 2: top-up deposits only
+	Contract      []byte // deposit contract, empty for the deposit contract from the chain specs
 }
 
 type DepositFilter struct {
@@ -148,3 +151,120 @@ type ConsolidationRequestTxFilter struct {
 	TgtValidatorName string
 	WithOrphaned     uint8
 }
+
+type WatchedContractLogFilter struct {
+	ContractAddress []byte
+	Topic0          []byte
+	TxSender        []byte
+	TxHash          []byte
+	MinBlock        uint64
+	MaxBlock        uint64
+	WithOrphaned    uint8
+}
+
+type BlobSidecarFilter struct {
+	MinSlot  uint64
+	MaxSlot  uint64
+	Proposer *uint64
+}
+
+// DepositTxPubkeyStats holds the aggregated deposits for a validator pubkey.
+// FirstIndex is the index of the initial deposit (first deposit with a valid signature), all later deposits are top-ups.
+// FrontrunCount is the number of top-ups from other senders with other withdrawal credentials than the initial deposit,
+// which is the pattern of a frontrun deposit (the initial deposit locks in the withdrawal credentials of its sender).
+type DepositTxPubkeyStats struct {
+	PublicKey        []byte `db:"publickey"`
+	FirstIndex       uint64 `db:"first_index"`
+	FirstCredentials []byte `db:"first_credentials"`
+	FirstSender      []byte `db:"first_sender"`
+	DepositCount     uint64 `db:"deposit_count"`
+	Amount           uint64 `db:"amount"`
+	FrontrunCount    uint64 `db:"frontrun_count"`
+}
+
+// SlotDepositTx links a deposit included in a beacon block (by position in the block body) to its deposit transaction.
+type SlotDepositTx struct {
+	SlotIndex uint64 `db:"slot_index"`
+	DepositTx
+}
+
+// DepositEpochAmount holds the aggregated included deposits of an epoch.
+type DepositEpochAmount struct {
+	Epoch        uint64 `db:"epoch"`
+	DepositCount uint64 `db:"deposit_count"`
+	Amount       uint64 `db:"amount"`
+}
+
+type GenesisDepositValidator struct {
+	PublicKey             []byte `db:"publickey"`
+	WithdrawalCredentials []byte `db:"withdrawalcredentials"`
+	Amount                uint64 `db:"amount"`
+	DepositCount          uint64 `db:"deposit_count"`
+	FirstIndex            uint64 `db:"first_index"`
+}
+
+type SlotChainEntry struct {
+	Slot       uint64     `db:"slot"`
+	Root       []byte     `db:"root"`
+	ParentRoot []byte     `db:"parent_root"`
+	Status     SlotStatus `db:"status"`
+}
+
+const (
+	IndexerEventBlockAdded     = "block_added"
+	IndexerEventEpochFinalized = "epoch_finalized"
+	IndexerEventChainReorg     = "chain_reorg"
+	IndexerEventDepositSeen    = "deposit_seen"
+)
+
+type IndexerEvent struct {
+	EventId   uint64 `db:"event_id"`
+	EventType string `db:"event_type"`
+	EventKey  string `db:"event_key"`
+	EventTime int64  `db:"event_time"`
+	Data      string `db:"data"`
+}
+
+type IndexerEventBlockAddedData struct {
+	Slot       uint64 `json:"slot"`
+	Root       string `json:"root"`
+	ParentRoot string `json:"parent_root"`
+	Proposer   uint64 `json:"proposer"`
+}
+
+type IndexerEventEpochFinalizedData struct {
+	Epoch      uint64 `json:"epoch"`
+	BlockCount uint64 `json:"block_count"`
+	Orphaned   uint64 `json:"orphaned"`
+}
+
+type IndexerEventChainReorgData struct {
+	OldHeadSlot uint64 `json:"old_head_slot"`
+	OldHeadRoot string `json:"old_head_root"`
+	NewHeadSlot uint64 `json:"new_head_slot"`
+	NewHeadRoot string `json:"new_head_root"`
+	BaseSlot    uint64 `json:"base_slot"`
+	BaseRoot    string `json:"base_root"`
+}
+
+type IndexerEventDepositSeenData struct {
+	Index       uint64 `json:"index"`
+	BlockNumber uint64 `json:"block_number"`
+	BlockHash   string `json:"block_hash"`
+	TxHash      string `json:"tx_hash"`
+	PublicKey   string `json:"pubkey"`
+	Amount      uint64 `json:"amount"`
+	Valid       bool   `json:"valid_signature"`
+	Orphaned    bool   `json:"orphaned"`
+}
+
+type ProposerSlotCount struct {
+	Proposer uint64 `db:"proposer"`
+	Count    uint64 `db:"count"`
+}
+
+type EthBlockRef struct {
+	Slot   uint64 `db:"slot"`
+	Number uint64 `db:"eth_block_number"`
+	Hash   []byte `db:"eth_block_hash"`
+}
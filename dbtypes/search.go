@@ -41,3 +41,8 @@ type SearchAheadValidatorNameResult []struct {
 	Name  string `db:"name"`
 	Count uint64 `db:"count"`
 }
+
+type GraffitiCount struct {
+	Graffiti string `db:"graffiti_text"`
+	Count    uint64 `db:"count"`
+}
@@ -13,8 +13,43 @@ type IndexerForkState struct {
 	Finalized uint64 `json:"finalized"`
 }
 
+type IndexerCacheState struct {
+	FinalizedEpoch uint64 `json:"finalized_epoch"`
+	PrunedEpoch    uint64 `json:"pruned_epoch"`
+	HeadSlot       uint64 `json:"head_slot"`
+	BlockCount     uint64 `json:"block_count"`
+	SnapshotTime   int64  `json:"snapshot_time"`
+}
+
+type IndexerGenesisState struct {
+	GenesisTime           int64  `json:"genesis_time"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+	GenesisForkVersion    string `json:"genesis_fork_version"`
+}
+
 type DepositIndexerState struct {
 	FinalBlock   uint64 `json:"final_block"`
 	HeadBlock    uint64 `json:"head_block"`
 	DepositIndex uint64 `json:"deposit_index"`
 }
+
+type IndexerConsistencyState struct {
+	Epoch uint64 `json:"epoch"`
+}
+
+type ValidatorIncomeIndexerState struct {
+	NextEpoch uint64 `json:"next_epoch"`
+}
+
+type GraffitiIndexerState struct {
+	NextSlot uint64 `json:"next_slot"`
+}
+
+type ClientDiversityIndexerState struct {
+	NextSlot uint64 `json:"next_slot"`
+}
+
+type DatabaseMaintenanceState struct {
+	LastRun     int64 `json:"last_run"`
+	LastSuccess bool  `json:"last_success"`
+}
@@ -0,0 +1,143 @@
+package graphqlapi
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+)
+
+type blockResolver struct {
+	block *dbtypes.Slot
+}
+
+func newBlockResolver(block *dbtypes.Slot) *blockResolver {
+	return &blockResolver{block: block}
+}
+
+func (b *blockResolver) isMissed() bool {
+	return b.block.Status == dbtypes.Missing
+}
+
+func (b *blockResolver) optionalBytes(value []byte) *Bytes {
+	if b.isMissed() || value == nil {
+		return nil
+	}
+	bytes := Bytes(value)
+	return &bytes
+}
+
+func (b *blockResolver) Slot() Long {
+	return Long(b.block.Slot)
+}
+
+func (b *blockResolver) Epoch() Long {
+	return Long(services.GlobalBeaconService.GetChainState().EpochOfSlot(phase0.Slot(b.block.Slot)))
+}
+
+func (b *blockResolver) Status() string {
+	switch b.block.Status {
+	case dbtypes.Canonical:
+		return "canonical"
+	case dbtypes.Orphaned:
+		return "orphaned"
+	default:
+		return "missed"
+	}
+}
+
+func (b *blockResolver) ProposerIndex() Long {
+	return Long(b.block.Proposer)
+}
+
+func (b *blockResolver) Proposer() *validatorResolver {
+	record, found := services.GlobalBeaconService.GetCachedValidatorSet(true).GetRecord(phase0.ValidatorIndex(b.block.Proposer))
+	if !found {
+		return nil
+	}
+	return &validatorResolver{record: record}
+}
+
+func (b *blockResolver) Root() *Bytes       { return b.optionalBytes(b.block.Root) }
+func (b *blockResolver) ParentRoot() *Bytes { return b.optionalBytes(b.block.ParentRoot) }
+func (b *blockResolver) StateRoot() *Bytes  { return b.optionalBytes(b.block.StateRoot) }
+func (b *blockResolver) Graffiti() *Bytes   { return b.optionalBytes(b.block.Graffiti) }
+
+func (b *blockResolver) GraffitiText() *string {
+	if b.isMissed() {
+		return nil
+	}
+	return &b.block.GraffitiText
+}
+
+func (b *blockResolver) AttestationCount() int32      { return int32(b.block.AttestationCount) }
+func (b *blockResolver) DepositCount() int32          { return int32(b.block.DepositCount) }
+func (b *blockResolver) ExitCount() int32             { return int32(b.block.ExitCount) }
+func (b *blockResolver) WithdrawalCount() int32       { return int32(b.block.WithdrawCount) }
+func (b *blockResolver) WithdrawalAmount() Long       { return Long(b.block.WithdrawAmount) }
+func (b *blockResolver) AttesterSlashingCount() int32 { return int32(b.block.AttesterSlashingCount) }
+func (b *blockResolver) ProposerSlashingCount() int32 { return int32(b.block.ProposerSlashingCount) }
+func (b *blockResolver) BlsChangeCount() int32        { return int32(b.block.BLSChangeCount) }
+func (b *blockResolver) SyncParticipation() float64   { return float64(b.block.SyncParticipation) }
+
+func (b *blockResolver) Execution() *executionPayloadResolver {
+	if b.isMissed() || b.block.EthBlockNumber == nil {
+		return nil
+	}
+	return &executionPayloadResolver{block: b.block}
+}
+
+// executionPayloadResolver resolves the indexed execution payload summary of a block.
+type executionPayloadResolver struct {
+	block *dbtypes.Slot
+}
+
+func (e *executionPayloadResolver) BlockNumber() Long {
+	return Long(*e.block.EthBlockNumber)
+}
+
+func (e *executionPayloadResolver) BlockHash() Bytes {
+	return Bytes(e.block.EthBlockHash)
+}
+
+func (e *executionPayloadResolver) ExtraData() *Bytes {
+	if e.block.EthBlockExtra == nil {
+		return nil
+	}
+	extra := Bytes(e.block.EthBlockExtra)
+	return &extra
+}
+
+func (e *executionPayloadResolver) ExtraDataText() *string {
+	if e.block.EthBlockExtra == nil {
+		return nil
+	}
+	return &e.block.EthBlockExtraText
+}
+
+func (e *executionPayloadResolver) TransactionCount() int32 {
+	return int32(e.block.EthTransactionCount)
+}
+
+func (e *executionPayloadResolver) BlobTransactionCount() int32 {
+	return int32(e.block.EthBlobTxCount)
+}
+
+// loadEpochBlocks returns the blocks of an epoch in ascending slot order.
+func loadEpochBlocks(ctx context.Context, epoch phase0.Epoch, withMissed bool, withOrphaned bool) []*blockResolver {
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+	lastSlot := chainState.EpochToSlot(epoch+1) - 1
+
+	dbBlocks := services.GlobalBeaconService.GetDbBlocksForSlots(ctx, uint64(lastSlot), uint32(specs.SlotsPerEpoch), withMissed, withOrphaned)
+	results := make([]*blockResolver, 0, len(dbBlocks))
+	for idx := len(dbBlocks) - 1; idx >= 0; idx-- {
+		if dbBlocks[idx].Status == dbtypes.Missing && phase0.Slot(dbBlocks[idx].Slot) >= chainState.CurrentSlot() {
+			continue
+		}
+		results = append(results, newBlockResolver(dbBlocks[idx]))
+	}
+	return results
+}
@@ -0,0 +1,36 @@
+package graphqlapi
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+)
+
+// defaultMaxQueryCost is the default cost budget of a single graphql request.
+const defaultMaxQueryCost = 5000
+
+// queryCost tracks the database work of a single graphql request.
+// fields that load from the database charge one per query plus the number of requested items, so nested lists
+// (e.g. the proposals of many validators) can't fan out into an unbounded number of db queries.
+type queryCost struct {
+	limit int64
+	used  atomic.Int64
+}
+
+type queryCostKey struct{}
+
+func withQueryCost(ctx context.Context, limit int64) context.Context {
+	return context.WithValue(ctx, queryCostKey{}, &queryCost{limit: limit})
+}
+
+// chargeQueryCost adds the cost of a database backed field to the request budget and returns an error once the budget is exceeded.
+func chargeQueryCost(ctx context.Context, items uint64) error {
+	cost, ok := ctx.Value(queryCostKey{}).(*queryCost)
+	if !ok {
+		return nil
+	}
+	if used := cost.used.Add(int64(items) + 1); used > cost.limit {
+		return fmt.Errorf("query cost limit of %v exceeded", cost.limit)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package graphqlapi
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+)
+
+type epochResolver struct {
+	epoch *dbtypes.Epoch
+}
+
+func newEpochResolver(epoch *dbtypes.Epoch) *epochResolver {
+	return &epochResolver{epoch: epoch}
+}
+
+func (e *epochResolver) Epoch() Long {
+	return Long(e.epoch.Epoch)
+}
+
+func (e *epochResolver) Finalized() bool {
+	finalizedEpoch, _ := services.GlobalBeaconService.GetFinalizedEpoch()
+	return e.epoch.Epoch < uint64(finalizedEpoch)
+}
+
+func (e *epochResolver) ValidatorCount() Long         { return Long(e.epoch.ValidatorCount) }
+func (e *epochResolver) ValidatorBalance() Long       { return Long(e.epoch.ValidatorBalance) }
+func (e *epochResolver) Eligible() Long               { return Long(e.epoch.Eligible) }
+func (e *epochResolver) VotedTarget() Long            { return Long(e.epoch.VotedTarget) }
+func (e *epochResolver) VotedHead() Long              { return Long(e.epoch.VotedHead) }
+func (e *epochResolver) VotedTotal() Long             { return Long(e.epoch.VotedTotal) }
+func (e *epochResolver) BlockCount() int32            { return int32(e.epoch.BlockCount) }
+func (e *epochResolver) OrphanedCount() int32         { return int32(e.epoch.OrphanedCount) }
+func (e *epochResolver) AttestationCount() int32      { return int32(e.epoch.AttestationCount) }
+func (e *epochResolver) DepositCount() int32          { return int32(e.epoch.DepositCount) }
+func (e *epochResolver) ExitCount() int32             { return int32(e.epoch.ExitCount) }
+func (e *epochResolver) WithdrawalCount() int32       { return int32(e.epoch.WithdrawCount) }
+func (e *epochResolver) WithdrawalAmount() Long       { return Long(e.epoch.WithdrawAmount) }
+func (e *epochResolver) AttesterSlashingCount() int32 { return int32(e.epoch.AttesterSlashingCount) }
+func (e *epochResolver) ProposerSlashingCount() int32 { return int32(e.epoch.ProposerSlashingCount) }
+func (e *epochResolver) BlsChangeCount() int32        { return int32(e.epoch.BLSChangeCount) }
+func (e *epochResolver) TransactionCount() int32      { return int32(e.epoch.EthTransactionCount) }
+func (e *epochResolver) SyncParticipation() float64   { return float64(e.epoch.SyncParticipation) }
+
+func (e *epochResolver) Blocks(ctx context.Context, args struct {
+	WithMissed   bool
+	WithOrphaned bool
+}) ([]*blockResolver, error) {
+	specs := services.GlobalBeaconService.GetChainState().GetSpecs()
+	if err := chargeQueryCost(ctx, specs.SlotsPerEpoch); err != nil {
+		return nil, err
+	}
+	return loadEpochBlocks(ctx, phase0.Epoch(e.epoch.Epoch), args.WithMissed, args.WithOrphaned), nil
+}
@@ -0,0 +1,98 @@
+package graphqlapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/utils"
+)
+
+//go:embed schema.graphql
+var schemaString string
+
+// maxRequestSize limits the size of a graphql request body.
+const maxRequestSize = 64 * 1024
+
+var (
+	schemaOnce sync.Once
+	schema     *graphql.Schema
+)
+
+type queryRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func getSchema() *graphql.Schema {
+	schemaOnce.Do(func() {
+		maxDepth := utils.Config().GraphqlApi.MaxDepth
+		if maxDepth == 0 {
+			maxDepth = 8
+		}
+		schema = graphql.MustParseSchema(schemaString, &queryResolver{},
+			graphql.MaxDepth(maxDepth),
+			graphql.MaxQueryLength(maxRequestSize),
+		)
+	})
+	return schema
+}
+
+// Handler serves graphql queries over the indexed beacon & execution data.
+// queries are accepted as json body ({"query", "operationName", "variables"}) via POST, or via the query parameter of GET requests.
+func Handler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	request := &queryRequest{}
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		request.Query = query.Get("query")
+		request.OperationName = query.Get("operationName")
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				http.Error(w, "invalid variables: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestSize)).Decode(request); err != nil {
+			http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if request.Query == "" {
+		http.Error(w, "missing query", http.StatusBadRequest)
+		return
+	}
+
+	err := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	maxCost := int64(utils.Config().GraphqlApi.MaxCost)
+	if maxCost == 0 {
+		maxCost = defaultMaxQueryCost
+	}
+	ctx := withQueryCost(r.Context(), maxCost)
+
+	response := getSchema().Exec(ctx, request.Query, request.OperationName, request.Variables)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding graphql response")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
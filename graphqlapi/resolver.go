@@ -0,0 +1,372 @@
+package graphqlapi
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+)
+
+// maxListResults limits the number of items returned by a single list field.
+const maxListResults = 1000
+
+// maxNestedListResults limits the number of items of list fields that are resolved per item of another list (e.g. validator proposals).
+const maxNestedListResults = 20
+
+type queryResolver struct{}
+
+func clampListSize(first int32) int32 {
+	if first < 0 {
+		return 0
+	}
+	if first > maxListResults {
+		return maxListResults
+	}
+	return first
+}
+
+type headResolver struct {
+	slot           phase0.Slot
+	epoch          phase0.Epoch
+	finalizedEpoch phase0.Epoch
+	finalizedRoot  phase0.Root
+}
+
+func (q *queryResolver) Head() *headResolver {
+	chainState := services.GlobalBeaconService.GetChainState()
+	finalizedEpoch, finalizedRoot := services.GlobalBeaconService.GetFinalizedEpoch()
+	return &headResolver{
+		slot:           chainState.CurrentSlot(),
+		epoch:          chainState.CurrentEpoch(),
+		finalizedEpoch: finalizedEpoch,
+		finalizedRoot:  finalizedRoot,
+	}
+}
+
+func (h *headResolver) Slot() Long           { return Long(h.slot) }
+func (h *headResolver) Epoch() Long          { return Long(h.epoch) }
+func (h *headResolver) FinalizedEpoch() Long { return Long(h.finalizedEpoch) }
+func (h *headResolver) FinalizedRoot() Bytes { return Bytes(h.finalizedRoot[:]) }
+
+func (q *queryResolver) Validator(args struct {
+	Index  *Long
+	Pubkey *Bytes
+}) (*validatorResolver, error) {
+	var index phase0.ValidatorIndex
+	switch {
+	case args.Index != nil:
+		index = phase0.ValidatorIndex(*args.Index)
+	case args.Pubkey != nil:
+		if len(*args.Pubkey) != 48 {
+			return nil, errors.New("invalid validator pubkey")
+		}
+		var found bool
+		index, found = services.GlobalBeaconService.GetValidatorIndexByPubkey(phase0.BLSPubKey(*args.Pubkey))
+		if !found {
+			return nil, nil
+		}
+	default:
+		return nil, errors.New("missing index or pubkey")
+	}
+
+	record, found := services.GlobalBeaconService.GetCachedValidatorSet(true).GetRecord(index)
+	if !found {
+		return nil, nil
+	}
+	return &validatorResolver{record: record}, nil
+}
+
+type validatorFilterInput struct {
+	Indexes    *[]Long
+	Status     *[]string
+	MinBalance *Long
+	MaxBalance *Long
+	Name       *string
+}
+
+func (q *queryResolver) Validators(args struct {
+	Filter *validatorFilterInput
+	First  int32
+	After  *Long
+}) ([]*validatorResolver, error) {
+	limit := int(clampListSize(args.First))
+	validatorSet := services.GlobalBeaconService.GetCachedValidatorSet(true)
+	if validatorSet == nil {
+		return nil, errors.New("validator set not available")
+	}
+
+	filter := args.Filter
+	if filter == nil {
+		filter = &validatorFilterInput{}
+	}
+	nameFilter := ""
+	if filter.Name != nil {
+		nameFilter = strings.ToLower(*filter.Name)
+	}
+
+	matches := func(record *beacon.ValidatorRecord) bool {
+		if filter.Status != nil && !matchValidatorStatus(record.Status, *filter.Status) {
+			return false
+		}
+		balance := uint64(record.Balance)
+		if filter.MinBalance != nil && balance < uint64(*filter.MinBalance) {
+			return false
+		}
+		if filter.MaxBalance != nil && balance > uint64(*filter.MaxBalance) {
+			return false
+		}
+		if nameFilter != "" && !strings.Contains(strings.ToLower(services.GlobalBeaconService.GetValidatorName(uint64(record.Index))), nameFilter) {
+			return false
+		}
+		return true
+	}
+
+	results := make([]*validatorResolver, 0, limit)
+	if filter.Indexes != nil {
+		for _, index := range *filter.Indexes {
+			if len(results) >= limit {
+				break
+			}
+			if args.After != nil && index <= *args.After {
+				continue
+			}
+			if record, found := validatorSet.GetRecord(phase0.ValidatorIndex(index)); found && matches(&record) {
+				results = append(results, &validatorResolver{record: record})
+			}
+		}
+		return results, nil
+	}
+
+	startIndex := 0
+	if args.After != nil {
+		if uint64(*args.After) >= uint64(validatorSet.Len()) {
+			return results, nil
+		}
+		startIndex = int(*args.After) + 1
+	}
+	for index := startIndex; index < validatorSet.Len() && len(results) < limit; index++ {
+		if record, found := validatorSet.GetRecord(phase0.ValidatorIndex(index)); found && matches(&record) {
+			results = append(results, &validatorResolver{record: record})
+		}
+	}
+	return results, nil
+}
+
+// matchValidatorStatus checks the validator state against the filter states, which may also be state group prefixes (e.g. "active").
+func matchValidatorStatus(status v1.ValidatorState, filter []string) bool {
+	statusStr := status.String()
+	for _, filterStatus := range filter {
+		if strings.HasPrefix(statusStr, strings.ToLower(filterStatus)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *queryResolver) Block(ctx context.Context, args struct {
+	Slot *Long
+	Root *Bytes
+}) (*blockResolver, error) {
+	if err := chargeQueryCost(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case args.Slot != nil:
+		var dbBlock *dbtypes.Slot
+		for _, block := range services.GlobalBeaconService.GetDbBlocksForSlots(ctx, uint64(*args.Slot), 1, true, true) {
+			if dbBlock == nil || block.Status == dbtypes.Canonical {
+				dbBlock = block
+			}
+		}
+		if dbBlock == nil || dbBlock.Slot != uint64(*args.Slot) {
+			return nil, nil
+		}
+		return newBlockResolver(dbBlock), nil
+	case args.Root != nil:
+		if len(*args.Root) != 32 {
+			return nil, errors.New("invalid block root")
+		}
+		dbBlock := getDbBlockByRoot(ctx, phase0.Root(*args.Root))
+		if dbBlock == nil {
+			return nil, nil
+		}
+		return newBlockResolver(dbBlock), nil
+	default:
+		return nil, errors.New("missing slot or root")
+	}
+}
+
+type blockFilterInput struct {
+	MinSlot      *Long
+	MaxSlot      *Long
+	Proposer     *Long
+	ProposerName *string
+	Graffiti     *string
+	WithMissed   bool
+	WithOrphaned bool
+}
+
+func (q *queryResolver) Blocks(ctx context.Context, args struct {
+	Filter *blockFilterInput
+	First  int32
+	Skip   int32
+}) ([]*blockResolver, error) {
+	limit := clampListSize(args.First)
+	skip := max(args.Skip, 0)
+	if err := chargeQueryCost(ctx, uint64(limit)); err != nil {
+		return nil, err
+	}
+
+	blockFilter := &dbtypes.BlockFilter{}
+	if filter := args.Filter; filter != nil {
+		if filter.MinSlot != nil {
+			minSlot := uint64(*filter.MinSlot)
+			blockFilter.MinSlot = &minSlot
+		}
+		if filter.MaxSlot != nil {
+			maxSlot := uint64(*filter.MaxSlot)
+			blockFilter.MaxSlot = &maxSlot
+		}
+		if filter.Proposer != nil {
+			proposer := uint64(*filter.Proposer)
+			blockFilter.ProposerIndex = &proposer
+		}
+		if filter.ProposerName != nil {
+			blockFilter.ProposerName = *filter.ProposerName
+		}
+		if filter.Graffiti != nil {
+			blockFilter.Graffiti = *filter.Graffiti
+		}
+		if filter.WithMissed {
+			blockFilter.WithMissing = 1
+		}
+		if filter.WithOrphaned {
+			blockFilter.WithOrphaned = 1
+		}
+	}
+
+	return loadFilteredBlocks(ctx, blockFilter, uint64(skip), uint64(limit)), nil
+}
+
+// loadFilteredBlocks loads the given range of the filtered block list. the block filter is applied page wise, so skip
+// is split into full pages of the limit size & a remainder that is dropped from the first loaded page.
+func loadFilteredBlocks(ctx context.Context, blockFilter *dbtypes.BlockFilter, skip uint64, limit uint64) []*blockResolver {
+	results := make([]*blockResolver, 0, limit)
+	if limit == 0 {
+		return results
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	pageIdx := skip / limit
+	offset := skip % limit
+	for uint64(len(results)) < limit {
+		dbBlocks := services.GlobalBeaconService.GetDbBlocksByFilter(ctx, blockFilter, pageIdx, uint32(limit), 0)
+		for _, dbBlock := range dbBlocks {
+			if offset > 0 {
+				offset--
+				continue
+			}
+			if uint64(len(results)) >= limit {
+				break
+			}
+			if dbBlock.Block == nil {
+				// missed slots are only returned for past slots
+				if phase0.Slot(dbBlock.Slot) >= chainState.CurrentSlot() {
+					continue
+				}
+				results = append(results, newBlockResolver(&dbtypes.Slot{
+					Slot:     dbBlock.Slot,
+					Proposer: dbBlock.Proposer,
+					Status:   dbtypes.Missing,
+				}))
+				continue
+			}
+			results = append(results, newBlockResolver(dbBlock.Block))
+		}
+		if uint64(len(dbBlocks)) < limit {
+			break
+		}
+		pageIdx++
+	}
+	return results
+}
+
+func (q *queryResolver) Epoch(ctx context.Context, args struct {
+	Epoch Long
+}) (*epochResolver, error) {
+	chainState := services.GlobalBeaconService.GetChainState()
+	if phase0.Epoch(args.Epoch) > chainState.CurrentEpoch() {
+		return nil, nil
+	}
+	if err := chargeQueryCost(ctx, 1); err != nil {
+		return nil, err
+	}
+	epochs := services.GlobalBeaconService.GetDbEpochs(ctx, uint64(args.Epoch), 1)
+	if len(epochs) == 0 || epochs[0] == nil {
+		return nil, nil
+	}
+	return newEpochResolver(epochs[0]), nil
+}
+
+func (q *queryResolver) Epochs(ctx context.Context, args struct {
+	Before *Long
+	First  int32
+}) ([]*epochResolver, error) {
+	limit := clampListSize(args.First)
+	chainState := services.GlobalBeaconService.GetChainState()
+	firstEpoch := uint64(chainState.CurrentEpoch())
+	if args.Before != nil && uint64(*args.Before) < firstEpoch {
+		firstEpoch = uint64(*args.Before)
+	}
+
+	results := make([]*epochResolver, 0, limit)
+	if limit == 0 {
+		return results, nil
+	}
+	if err := chargeQueryCost(ctx, uint64(limit)); err != nil {
+		return nil, err
+	}
+	for _, dbEpoch := range services.GlobalBeaconService.GetDbEpochs(ctx, firstEpoch, uint32(limit)) {
+		if dbEpoch == nil {
+			continue
+		}
+		results = append(results, newEpochResolver(dbEpoch))
+	}
+	return results, nil
+}
+
+func getDbBlockByRoot(ctx context.Context, root phase0.Root) *dbtypes.Slot {
+	indexer := services.GlobalBeaconService.GetBeaconIndexer()
+	if block := indexer.GetBlockByRoot(root); block != nil {
+		return indexer.GetDbBlock(block)
+	}
+
+	blockHead := db.GetBlockHeadByRoot(root[:])
+	if blockHead == nil {
+		return nil
+	}
+
+	for _, block := range services.GlobalBeaconService.GetDbBlocksForSlots(ctx, blockHead.Slot, 1, false, true) {
+		if phase0.Root(block.Root) == root {
+			return block
+		}
+	}
+	return nil
+}
+
+// epochOrNull returns nil for the far future epoch, which is used for unset epochs in the validator set.
+func epochOrNull(epoch phase0.Epoch) *Long {
+	if uint64(epoch) == math.MaxUint64 {
+		return nil
+	}
+	return longPtr(uint64(epoch))
+}
@@ -0,0 +1,83 @@
+package graphqlapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Long is the 64 bit unsigned integer scalar of the schema.
+type Long uint64
+
+func (Long) ImplementsGraphQLType(name string) bool {
+	return name == "Long"
+}
+
+func (l *Long) UnmarshalGraphQL(input interface{}) error {
+	switch value := input.(type) {
+	case int32:
+		if value < 0 {
+			return fmt.Errorf("negative value for Long: %v", value)
+		}
+		*l = Long(value)
+	case int64:
+		if value < 0 {
+			return fmt.Errorf("negative value for Long: %v", value)
+		}
+		*l = Long(value)
+	case float64:
+		if value < 0 || value > math.MaxUint64 || value != math.Trunc(value) {
+			return fmt.Errorf("invalid value for Long: %v", value)
+		}
+		*l = Long(value)
+	case string:
+		parsed, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid value for Long: %v", value)
+		}
+		*l = Long(parsed)
+	default:
+		return fmt.Errorf("unsupported type for Long: %T", input)
+	}
+	return nil
+}
+
+func (l Long) MarshalJSON() ([]byte, error) {
+	return json.Marshal(uint64(l))
+}
+
+// Bytes is the hex encoded binary scalar of the schema.
+type Bytes []byte
+
+func (Bytes) ImplementsGraphQLType(name string) bool {
+	return name == "Bytes"
+}
+
+func (b *Bytes) UnmarshalGraphQL(input interface{}) error {
+	value, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("unsupported type for Bytes: %T", input)
+	}
+	if !strings.HasPrefix(value, "0x") {
+		value = "0x" + value
+	}
+	decoded, err := hexutil.Decode(value)
+	if err != nil {
+		return fmt.Errorf("invalid value for Bytes: %v", err)
+	}
+	*b = decoded
+	return nil
+}
+
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hexutil.Encode(b))
+}
+
+func longPtr(value uint64) *Long {
+	l := Long(value)
+	return &l
+}
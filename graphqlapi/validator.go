@@ -0,0 +1,89 @@
+package graphqlapi
+
+import (
+	"context"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+)
+
+// validatorResolver resolves the fields of a validator from its record in the cached validator set.
+type validatorResolver struct {
+	record beacon.ValidatorRecord
+}
+
+func (v *validatorResolver) Index() Long {
+	return Long(v.record.Index)
+}
+
+func (v *validatorResolver) Pubkey() Bytes {
+	return Bytes(v.record.Pubkey[:])
+}
+
+func (v *validatorResolver) Name() *string {
+	name := services.GlobalBeaconService.GetValidatorName(uint64(v.record.Index))
+	if name == "" {
+		return nil
+	}
+	return &name
+}
+
+func (v *validatorResolver) Status() string {
+	return v.record.Status.String()
+}
+
+func (v *validatorResolver) Balance() Long {
+	return Long(v.record.Balance)
+}
+
+func (v *validatorResolver) EffectiveBalance() Long {
+	return Long(v.record.EffectiveBalance)
+}
+
+func (v *validatorResolver) WithdrawalCredentials() Bytes {
+	return Bytes(v.record.WithdrawalCredentials[:])
+}
+
+func (v *validatorResolver) Slashed() bool {
+	return v.record.Slashed
+}
+
+func (v *validatorResolver) ActivationEligibilityEpoch() *Long {
+	return epochOrNull(v.record.ActivationEligibilityEpoch)
+}
+
+func (v *validatorResolver) ActivationEpoch() *Long {
+	return epochOrNull(v.record.ActivationEpoch)
+}
+
+func (v *validatorResolver) ExitEpoch() *Long {
+	return epochOrNull(v.record.ExitEpoch)
+}
+
+func (v *validatorResolver) WithdrawableEpoch() *Long {
+	return epochOrNull(v.record.WithdrawableEpoch)
+}
+
+func (v *validatorResolver) Proposals(ctx context.Context, args struct {
+	Last         int32
+	WithMissed   bool
+	WithOrphaned bool
+}) ([]*blockResolver, error) {
+	limit := min(clampListSize(args.Last), maxNestedListResults)
+	if err := chargeQueryCost(ctx, uint64(limit)); err != nil {
+		return nil, err
+	}
+
+	proposer := uint64(v.record.Index)
+	blockFilter := &dbtypes.BlockFilter{
+		ProposerIndex: &proposer,
+	}
+	if args.WithMissed {
+		blockFilter.WithMissing = 1
+	}
+	if args.WithOrphaned {
+		blockFilter.WithOrphaned = 1
+	}
+	return loadFilteredBlocks(ctx, blockFilter, 0, uint64(limit)), nil
+}
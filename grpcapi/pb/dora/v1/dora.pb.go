@@ -0,0 +1,1093 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: dora/v1/dora.proto
+
+package dorav1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BlockStatus int32
+
+const (
+	BlockStatus_BLOCK_STATUS_UNSPECIFIED BlockStatus = 0
+	BlockStatus_BLOCK_STATUS_CANONICAL   BlockStatus = 1
+	BlockStatus_BLOCK_STATUS_ORPHANED    BlockStatus = 2
+	BlockStatus_BLOCK_STATUS_MISSED      BlockStatus = 3
+)
+
+// Enum value maps for BlockStatus.
+var (
+	BlockStatus_name = map[int32]string{
+		0: "BLOCK_STATUS_UNSPECIFIED",
+		1: "BLOCK_STATUS_CANONICAL",
+		2: "BLOCK_STATUS_ORPHANED",
+		3: "BLOCK_STATUS_MISSED",
+	}
+	BlockStatus_value = map[string]int32{
+		"BLOCK_STATUS_UNSPECIFIED": 0,
+		"BLOCK_STATUS_CANONICAL":   1,
+		"BLOCK_STATUS_ORPHANED":    2,
+		"BLOCK_STATUS_MISSED":      3,
+	}
+)
+
+func (x BlockStatus) Enum() *BlockStatus {
+	p := new(BlockStatus)
+	*p = x
+	return p
+}
+
+func (x BlockStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlockStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_dora_v1_dora_proto_enumTypes[0].Descriptor()
+}
+
+func (BlockStatus) Type() protoreflect.EnumType {
+	return &file_dora_v1_dora_proto_enumTypes[0]
+}
+
+func (x BlockStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlockStatus.Descriptor instead.
+func (BlockStatus) EnumDescriptor() ([]byte, []int) {
+	return file_dora_v1_dora_proto_rawDescGZIP(), []int{0}
+}
+
+type DutyType int32
+
+const (
+	DutyType_DUTY_TYPE_UNSPECIFIED    DutyType = 0
+	DutyType_DUTY_TYPE_PROPOSER       DutyType = 1
+	DutyType_DUTY_TYPE_ATTESTER       DutyType = 2
+	DutyType_DUTY_TYPE_SYNC_COMMITTEE DutyType = 3
+)
+
+// Enum value maps for DutyType.
+var (
+	DutyType_name = map[int32]string{
+		0: "DUTY_TYPE_UNSPECIFIED",
+		1: "DUTY_TYPE_PROPOSER",
+		2: "DUTY_TYPE_ATTESTER",
+		3: "DUTY_TYPE_SYNC_COMMITTEE",
+	}
+	DutyType_value = map[string]int32{
+		"DUTY_TYPE_UNSPECIFIED":    0,
+		"DUTY_TYPE_PROPOSER":       1,
+		"DUTY_TYPE_ATTESTER":       2,
+		"DUTY_TYPE_SYNC_COMMITTEE": 3,
+	}
+)
+
+func (x DutyType) Enum() *DutyType {
+	p := new(DutyType)
+	*p = x
+	return p
+}
+
+func (x DutyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DutyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_dora_v1_dora_proto_enumTypes[1].Descriptor()
+}
+
+func (DutyType) Type() protoreflect.EnumType {
+	return &file_dora_v1_dora_proto_enumTypes[1]
+}
+
+func (x DutyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DutyType.Descriptor instead.
+func (DutyType) EnumDescriptor() ([]byte, []int) {
+	return file_dora_v1_dora_proto_rawDescGZIP(), []int{1}
+}
+
+type Block struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot                  uint64      `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Root                  []byte      `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	ParentRoot            []byte      `protobuf:"bytes,3,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	StateRoot             []byte      `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	ProposerIndex         uint64      `protobuf:"varint,5,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	Status                BlockStatus `protobuf:"varint,6,opt,name=status,proto3,enum=dora.v1.BlockStatus" json:"status,omitempty"`
+	Graffiti              []byte      `protobuf:"bytes,7,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
+	AttestationCount      uint64      `protobuf:"varint,8,opt,name=attestation_count,json=attestationCount,proto3" json:"attestation_count,omitempty"`
+	DepositCount          uint64      `protobuf:"varint,9,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	ExitCount             uint64      `protobuf:"varint,10,opt,name=exit_count,json=exitCount,proto3" json:"exit_count,omitempty"`
+	WithdrawCount         uint64      `protobuf:"varint,11,opt,name=withdraw_count,json=withdrawCount,proto3" json:"withdraw_count,omitempty"`
+	WithdrawAmount        uint64      `protobuf:"varint,12,opt,name=withdraw_amount,json=withdrawAmount,proto3" json:"withdraw_amount,omitempty"`
+	AttesterSlashingCount uint64      `protobuf:"varint,13,opt,name=attester_slashing_count,json=attesterSlashingCount,proto3" json:"attester_slashing_count,omitempty"`
+	ProposerSlashingCount uint64      `protobuf:"varint,14,opt,name=proposer_slashing_count,json=proposerSlashingCount,proto3" json:"proposer_slashing_count,omitempty"`
+	BlsChangeCount        uint64      `protobuf:"varint,15,opt,name=bls_change_count,json=blsChangeCount,proto3" json:"bls_change_count,omitempty"`
+	EthTransactionCount   uint64      `protobuf:"varint,16,opt,name=eth_transaction_count,json=ethTransactionCount,proto3" json:"eth_transaction_count,omitempty"`
+	EthBlockNumber        *uint64     `protobuf:"varint,17,opt,name=eth_block_number,json=ethBlockNumber,proto3,oneof" json:"eth_block_number,omitempty"`
+	EthBlockHash          []byte      `protobuf:"bytes,18,opt,name=eth_block_hash,json=ethBlockHash,proto3" json:"eth_block_hash,omitempty"`
+	SyncParticipation     float32     `protobuf:"fixed32,19,opt,name=sync_participation,json=syncParticipation,proto3" json:"sync_participation,omitempty"`
+	ForkId                uint64      `protobuf:"varint,20,opt,name=fork_id,json=forkId,proto3" json:"fork_id,omitempty"`
+}
+
+func (x *Block) Reset() {
+	*x = Block{}
+	mi := &file_dora_v1_dora_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Block) ProtoMessage() {}
+
+func (x *Block) ProtoReflect() protoreflect.Message {
+	mi := &file_dora_v1_dora_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Block.ProtoReflect.Descriptor instead.
+func (*Block) Descriptor() ([]byte, []int) {
+	return file_dora_v1_dora_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Block) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *Block) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *Block) GetParentRoot() []byte {
+	if x != nil {
+		return x.ParentRoot
+	}
+	return nil
+}
+
+func (x *Block) GetStateRoot() []byte {
+	if x != nil {
+		return x.StateRoot
+	}
+	return nil
+}
+
+func (x *Block) GetProposerIndex() uint64 {
+	if x != nil {
+		return x.ProposerIndex
+	}
+	return 0
+}
+
+func (x *Block) GetStatus() BlockStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BlockStatus_BLOCK_STATUS_UNSPECIFIED
+}
+
+func (x *Block) GetGraffiti() []byte {
+	if x != nil {
+		return x.Graffiti
+	}
+	return nil
+}
+
+func (x *Block) GetAttestationCount() uint64 {
+	if x != nil {
+		return x.AttestationCount
+	}
+	return 0
+}
+
+func (x *Block) GetDepositCount() uint64 {
+	if x != nil {
+		return x.DepositCount
+	}
+	return 0
+}
+
+func (x *Block) GetExitCount() uint64 {
+	if x != nil {
+		return x.ExitCount
+	}
+	return 0
+}
+
+func (x *Block) GetWithdrawCount() uint64 {
+	if x != nil {
+		return x.WithdrawCount
+	}
+	return 0
+}
+
+func (x *Block) GetWithdrawAmount() uint64 {
+	if x != nil {
+		return x.WithdrawAmount
+	}
+	return 0
+}
+
+func (x *Block) GetAttesterSlashingCount() uint64 {
+	if x != nil {
+		return x.AttesterSlashingCount
+	}
+	return 0
+}
+
+func (x *Block) GetProposerSlashingCount() uint64 {
+	if x != nil {
+		return x.ProposerSlashingCount
+	}
+	return 0
+}
+
+func (x *Block) GetBlsChangeCount() uint64 {
+	if x != nil {
+		return x.BlsChangeCount
+	}
+	return 0
+}
+
+func (x *Block) GetEthTransactionCount() uint64 {
+	if x != nil {
+		return x.EthTransactionCount
+	}
+	return 0
+}
+
+func (x *Block) GetEthBlockNumber() uint64 {
+	if x != nil && x.EthBlockNumber != nil {
+		return *x.EthBlockNumber
+	}
+	return 0
+}
+
+func (x *Block) GetEthBlockHash() []byte {
+	if x != nil {
+		return x.EthBlockHash
+	}
+	return nil
+}
+
+func (x *Block) GetSyncParticipation() float32 {
+	if x != nil {
+		return x.SyncParticipation
+	}
+	return 0
+}
+
+func (x *Block) GetForkId() uint64 {
+	if x != nil {
+		return x.ForkId
+	}
+	return 0
+}
+
+type GetBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to BlockId:
+	//	*GetBlockRequest_Slot
+	//	*GetBlockRequest_Root
+	BlockId isGetBlockRequest_BlockId `protobuf_oneof:"block_id"`
+}
+
+func (x *GetBlockRequest) Reset() {
+	*x = GetBlockRequest{}
+	mi := &file_dora_v1_dora_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockRequest) ProtoMessage() {}
+
+func (x *GetBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dora_v1_dora_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockRequest) Descriptor() ([]byte, []int) {
+	return file_dora_v1_dora_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *GetBlockRequest) GetBlockId() isGetBlockRequest_BlockId {
+	if m != nil {
+		return m.BlockId
+	}
+	return nil
+}
+
+func (x *GetBlockRequest) GetSlot() uint64 {
+	if x, ok := x.GetBlockId().(*GetBlockRequest_Slot); ok {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *GetBlockRequest) GetRoot() []byte {
+	if x, ok := x.GetBlockId().(*GetBlockRequest_Root); ok {
+		return x.Root
+	}
+	return nil
+}
+
+type isGetBlockRequest_BlockId interface {
+	isGetBlockRequest_BlockId()
+}
+
+type GetBlockRequest_Slot struct {
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3,oneof"`
+}
+
+type GetBlockRequest_Root struct {
+	Root []byte `protobuf:"bytes,2,opt,name=root,proto3,oneof"`
+}
+
+func (*GetBlockRequest_Slot) isGetBlockRequest_BlockId() {}
+
+func (*GetBlockRequest_Root) isGetBlockRequest_BlockId() {}
+
+type GetBlocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartSlot    uint64 `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	Limit        uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	WithOrphaned bool   `protobuf:"varint,3,opt,name=with_orphaned,json=withOrphaned,proto3" json:"with_orphaned,omitempty"`
+	WithMissed   bool   `protobuf:"varint,4,opt,name=with_missed,json=withMissed,proto3" json:"with_missed,omitempty"`
+}
+
+func (x *GetBlocksRequest) Reset() {
+	*x = GetBlocksRequest{}
+	mi := &file_dora_v1_dora_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlocksRequest) ProtoMessage() {}
+
+func (x *GetBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dora_v1_dora_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlocksRequest.ProtoReflect.Descriptor instead.
+func (*GetBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_dora_v1_dora_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetBlocksRequest) GetStartSlot() uint64 {
+	if x != nil {
+		return x.StartSlot
+	}
+	return 0
+}
+
+func (x *GetBlocksRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetBlocksRequest) GetWithOrphaned() bool {
+	if x != nil {
+		return x.WithOrphaned
+	}
+	return false
+}
+
+func (x *GetBlocksRequest) GetWithMissed() bool {
+	if x != nil {
+		return x.WithMissed
+	}
+	return false
+}
+
+type StreamBlocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamBlocksRequest) Reset() {
+	*x = StreamBlocksRequest{}
+	mi := &file_dora_v1_dora_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBlocksRequest) ProtoMessage() {}
+
+func (x *StreamBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dora_v1_dora_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBlocksRequest.ProtoReflect.Descriptor instead.
+func (*StreamBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_dora_v1_dora_proto_rawDescGZIP(), []int{3}
+}
+
+type Validator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index                      uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Pubkey                     []byte `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Status                     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Balance                    uint64 `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	EffectiveBalance           uint64 `protobuf:"varint,5,opt,name=effective_balance,json=effectiveBalance,proto3" json:"effective_balance,omitempty"`
+	WithdrawalCredentials      []byte `protobuf:"bytes,6,opt,name=withdrawal_credentials,json=withdrawalCredentials,proto3" json:"withdrawal_credentials,omitempty"`
+	Slashed                    bool   `protobuf:"varint,7,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	ActivationEligibilityEpoch uint64 `protobuf:"varint,8,opt,name=activation_eligibility_epoch,json=activationEligibilityEpoch,proto3" json:"activation_eligibility_epoch,omitempty"`
+	ActivationEpoch            uint64 `protobuf:"varint,9,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	ExitEpoch                  uint64 `protobuf:"varint,10,opt,name=exit_epoch,json=exitEpoch,proto3" json:"exit_epoch,omitempty"`
+	WithdrawableEpoch          uint64 `protobuf:"varint,11,opt,name=withdrawable_epoch,json=withdrawableEpoch,proto3" json:"withdrawable_epoch,omitempty"`
+	Name                       string `protobuf:"bytes,12,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Validator) Reset() {
+	*x = Validator{}
+	mi := &file_dora_v1_dora_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Validator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Validator) ProtoMessage() {}
+
+func (x *Validator) ProtoReflect() protoreflect.Message {
+	mi := &file_dora_v1_dora_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Validator.ProtoReflect.Descriptor instead.
+func (*Validator) Descriptor() ([]byte, []int) {
+	return file_dora_v1_dora_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Validator) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Validator) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *Validator) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Validator) GetBalance() uint64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Validator) GetEffectiveBalance() uint64 {
+	if x != nil {
+		return x.EffectiveBalance
+	}
+	return 0
+}
+
+func (x *Validator) GetWithdrawalCredentials() []byte {
+	if x != nil {
+		return x.WithdrawalCredentials
+	}
+	return nil
+}
+
+func (x *Validator) GetSlashed() bool {
+	if x != nil {
+		return x.Slashed
+	}
+	return false
+}
+
+func (x *Validator) GetActivationEligibilityEpoch() uint64 {
+	if x != nil {
+		return x.ActivationEligibilityEpoch
+	}
+	return 0
+}
+
+func (x *Validator) GetActivationEpoch() uint64 {
+	if x != nil {
+		return x.ActivationEpoch
+	}
+	return 0
+}
+
+func (x *Validator) GetExitEpoch() uint64 {
+	if x != nil {
+		return x.ExitEpoch
+	}
+	return 0
+}
+
+func (x *Validator) GetWithdrawableEpoch() uint64 {
+	if x != nil {
+		return x.WithdrawableEpoch
+	}
+	return 0
+}
+
+func (x *Validator) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetValidatorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indices     []uint64 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	WithBalance bool     `protobuf:"varint,2,opt,name=with_balance,json=withBalance,proto3" json:"with_balance,omitempty"`
+}
+
+func (x *GetValidatorsRequest) Reset() {
+	*x = GetValidatorsRequest{}
+	mi := &file_dora_v1_dora_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorsRequest) ProtoMessage() {}
+
+func (x *GetValidatorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dora_v1_dora_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorsRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorsRequest) Descriptor() ([]byte, []int) {
+	return file_dora_v1_dora_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetValidatorsRequest) GetIndices() []uint64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *GetValidatorsRequest) GetWithBalance() bool {
+	if x != nil {
+		return x.WithBalance
+	}
+	return false
+}
+
+type Duty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type              DutyType `protobuf:"varint,1,opt,name=type,proto3,enum=dora.v1.DutyType" json:"type,omitempty"`
+	Epoch             uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Slot              uint64   `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	ValidatorIndex    uint64   `protobuf:"varint,4,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	CommitteeIndex    uint64   `protobuf:"varint,5,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	CommitteePosition uint64   `protobuf:"varint,6,opt,name=committee_position,json=committeePosition,proto3" json:"committee_position,omitempty"`
+}
+
+func (x *Duty) Reset() {
+	*x = Duty{}
+	mi := &file_dora_v1_dora_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Duty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Duty) ProtoMessage() {}
+
+func (x *Duty) ProtoReflect() protoreflect.Message {
+	mi := &file_dora_v1_dora_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Duty.ProtoReflect.Descriptor instead.
+func (*Duty) Descriptor() ([]byte, []int) {
+	return file_dora_v1_dora_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Duty) GetType() DutyType {
+	if x != nil {
+		return x.Type
+	}
+	return DutyType_DUTY_TYPE_UNSPECIFIED
+}
+
+func (x *Duty) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *Duty) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *Duty) GetValidatorIndex() uint64 {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return 0
+}
+
+func (x *Duty) GetCommitteeIndex() uint64 {
+	if x != nil {
+		return x.CommitteeIndex
+	}
+	return 0
+}
+
+func (x *Duty) GetCommitteePosition() uint64 {
+	if x != nil {
+		return x.CommitteePosition
+	}
+	return 0
+}
+
+type GetDutiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartEpoch uint64     `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch   *uint64    `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3,oneof" json:"end_epoch,omitempty"`
+	Follow     bool       `protobuf:"varint,3,opt,name=follow,proto3" json:"follow,omitempty"`
+	Types      []DutyType `protobuf:"varint,4,rep,packed,name=types,proto3,enum=dora.v1.DutyType" json:"types,omitempty"`
+}
+
+func (x *GetDutiesRequest) Reset() {
+	*x = GetDutiesRequest{}
+	mi := &file_dora_v1_dora_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDutiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDutiesRequest) ProtoMessage() {}
+
+func (x *GetDutiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dora_v1_dora_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDutiesRequest.ProtoReflect.Descriptor instead.
+func (*GetDutiesRequest) Descriptor() ([]byte, []int) {
+	return file_dora_v1_dora_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetDutiesRequest) GetStartEpoch() uint64 {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return 0
+}
+
+func (x *GetDutiesRequest) GetEndEpoch() uint64 {
+	if x != nil && x.EndEpoch != nil {
+		return *x.EndEpoch
+	}
+	return 0
+}
+
+func (x *GetDutiesRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *GetDutiesRequest) GetTypes() []DutyType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+var File_dora_v1_dora_proto protoreflect.FileDescriptor
+
+var file_dora_v1_dora_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x64, 0x6f, 0x72, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x72, 0x61, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x64, 0x6f, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x22, 0xa1, 0x06,
+	0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x64, 0x6f, 0x72, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x66, 0x66, 0x69, 0x74, 0x69,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x61, 0x66, 0x66, 0x69, 0x74, 0x69,
+	0x12, 0x2b, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x61, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x15, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6c, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x65,
+	0x74, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x65, 0x74, 0x68, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2d, 0x0a, 0x10, 0x65, 0x74, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0e, 0x65, 0x74, 0x68,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x24,
+	0x0a, 0x0e, 0x65, 0x74, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x74, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x11, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x42, 0x13, 0x0a, 0x11,
+	0x5f, 0x65, 0x74, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x00, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x42, 0x0a, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x22, 0x8d, 0x01, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x6c, 0x6f, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6f,
+	0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77,
+	0x69, 0x74, 0x68, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x69, 0x74, 0x68, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x22, 0x15, 0x0a, 0x13,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xb8, 0x03, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x35,
+	0x0a, 0x16, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12,
+	0x40, 0x0a, 0x1c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6c,
+	0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x78, 0x69, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x65, 0x78, 0x69, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2d, 0x0a, 0x12, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x53,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x04, 0x44, 0x75, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x64, 0x6f, 0x72,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa4,
+	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x75, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x27,
+	0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x64, 0x6f, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x2a, 0x7b, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x4f, 0x4e, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x19,
+	0x0a, 0x15, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f,
+	0x52, 0x50, 0x48, 0x41, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x45, 0x44,
+	0x10, 0x03, 0x2a, 0x73, 0x0a, 0x08, 0x44, 0x75, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19,
+	0x0a, 0x15, 0x44, 0x55, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x55, 0x54,
+	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45, 0x52, 0x10,
+	0x01, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x55, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x54, 0x54, 0x45, 0x53, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x55, 0x54,
+	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x43, 0x4f, 0x4d, 0x4d,
+	0x49, 0x54, 0x54, 0x45, 0x45, 0x10, 0x03, 0x32, 0xbc, 0x02, 0x0a, 0x0b, 0x44, 0x6f, 0x72, 0x61,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x64, 0x6f, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x64, 0x6f, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x38, 0x0a,
+	0x09, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x19, 0x2e, 0x64, 0x6f, 0x72,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x64, 0x6f, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x2e, 0x64, 0x6f, 0x72, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x64, 0x6f, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x64, 0x6f, 0x72, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x64, 0x6f, 0x72, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x30, 0x01, 0x12, 0x37, 0x0a,
+	0x09, 0x47, 0x65, 0x74, 0x44, 0x75, 0x74, 0x69, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x64, 0x6f, 0x72,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x75, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x64, 0x6f, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x75, 0x74, 0x79, 0x30, 0x01, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x74, 0x68, 0x70, 0x61, 0x6e, 0x64, 0x61, 0x6f, 0x70, 0x73,
+	0x2f, 0x64, 0x6f, 0x72, 0x61, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62,
+	0x2f, 0x64, 0x6f, 0x72, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x6f, 0x72, 0x61, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dora_v1_dora_proto_rawDescOnce sync.Once
+	file_dora_v1_dora_proto_rawDescData = file_dora_v1_dora_proto_rawDesc
+)
+
+func file_dora_v1_dora_proto_rawDescGZIP() []byte {
+	file_dora_v1_dora_proto_rawDescOnce.Do(func() {
+		file_dora_v1_dora_proto_rawDescData = protoimpl.X.CompressGZIP(file_dora_v1_dora_proto_rawDescData)
+	})
+	return file_dora_v1_dora_proto_rawDescData
+}
+
+var file_dora_v1_dora_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_dora_v1_dora_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_dora_v1_dora_proto_goTypes = []any{
+	(BlockStatus)(0),             // 0: dora.v1.BlockStatus
+	(DutyType)(0),                // 1: dora.v1.DutyType
+	(*Block)(nil),                // 2: dora.v1.Block
+	(*GetBlockRequest)(nil),      // 3: dora.v1.GetBlockRequest
+	(*GetBlocksRequest)(nil),     // 4: dora.v1.GetBlocksRequest
+	(*StreamBlocksRequest)(nil),  // 5: dora.v1.StreamBlocksRequest
+	(*Validator)(nil),            // 6: dora.v1.Validator
+	(*GetValidatorsRequest)(nil), // 7: dora.v1.GetValidatorsRequest
+	(*Duty)(nil),                 // 8: dora.v1.Duty
+	(*GetDutiesRequest)(nil),     // 9: dora.v1.GetDutiesRequest
+}
+var file_dora_v1_dora_proto_depIdxs = []int32{
+	0, // 0: dora.v1.Block.status:type_name -> dora.v1.BlockStatus
+	1, // 1: dora.v1.Duty.type:type_name -> dora.v1.DutyType
+	1, // 2: dora.v1.GetDutiesRequest.types:type_name -> dora.v1.DutyType
+	3, // 3: dora.v1.DoraService.GetBlock:input_type -> dora.v1.GetBlockRequest
+	4, // 4: dora.v1.DoraService.GetBlocks:input_type -> dora.v1.GetBlocksRequest
+	5, // 5: dora.v1.DoraService.StreamBlocks:input_type -> dora.v1.StreamBlocksRequest
+	7, // 6: dora.v1.DoraService.GetValidators:input_type -> dora.v1.GetValidatorsRequest
+	9, // 7: dora.v1.DoraService.GetDuties:input_type -> dora.v1.GetDutiesRequest
+	2, // 8: dora.v1.DoraService.GetBlock:output_type -> dora.v1.Block
+	2, // 9: dora.v1.DoraService.GetBlocks:output_type -> dora.v1.Block
+	2, // 10: dora.v1.DoraService.StreamBlocks:output_type -> dora.v1.Block
+	6, // 11: dora.v1.DoraService.GetValidators:output_type -> dora.v1.Validator
+	8, // 12: dora.v1.DoraService.GetDuties:output_type -> dora.v1.Duty
+	8, // [8:13] is the sub-list for method output_type
+	3, // [3:8] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_dora_v1_dora_proto_init() }
+func file_dora_v1_dora_proto_init() {
+	if File_dora_v1_dora_proto != nil {
+		return
+	}
+	file_dora_v1_dora_proto_msgTypes[0].OneofWrappers = []any{}
+	file_dora_v1_dora_proto_msgTypes[1].OneofWrappers = []any{
+		(*GetBlockRequest_Slot)(nil),
+		(*GetBlockRequest_Root)(nil),
+	}
+	file_dora_v1_dora_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dora_v1_dora_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dora_v1_dora_proto_goTypes,
+		DependencyIndexes: file_dora_v1_dora_proto_depIdxs,
+		EnumInfos:         file_dora_v1_dora_proto_enumTypes,
+		MessageInfos:      file_dora_v1_dora_proto_msgTypes,
+	}.Build()
+	File_dora_v1_dora_proto = out.File
+	file_dora_v1_dora_proto_rawDesc = nil
+	file_dora_v1_dora_proto_goTypes = nil
+	file_dora_v1_dora_proto_depIdxs = nil
+}
@@ -0,0 +1,302 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: dora/v1/dora.proto
+
+package dorav1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DoraService_GetBlock_FullMethodName      = "/dora.v1.DoraService/GetBlock"
+	DoraService_GetBlocks_FullMethodName     = "/dora.v1.DoraService/GetBlocks"
+	DoraService_StreamBlocks_FullMethodName  = "/dora.v1.DoraService/StreamBlocks"
+	DoraService_GetValidators_FullMethodName = "/dora.v1.DoraService/GetValidators"
+	DoraService_GetDuties_FullMethodName     = "/dora.v1.DoraService/GetDuties"
+)
+
+// DoraServiceClient is the client API for DoraService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DoraService exposes indexed chain data for internal tools that need higher throughput than the json api.
+type DoraServiceClient interface {
+	// GetBlock returns a single block by slot or root.
+	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*Block, error)
+	// GetBlocks streams the blocks of a slot range in ascending order.
+	GetBlocks(ctx context.Context, in *GetBlocksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Block], error)
+	// StreamBlocks streams new blocks as they are received by the indexer.
+	StreamBlocks(ctx context.Context, in *StreamBlocksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Block], error)
+	// GetValidators streams the current validator set or the requested validators.
+	GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Validator], error)
+	// GetDuties streams the proposer, attester & sync committee duties of an epoch range.
+	// with follow set, the duties of upcoming epochs are streamed as soon as they are known.
+	GetDuties(ctx context.Context, in *GetDutiesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Duty], error)
+}
+
+type doraServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDoraServiceClient(cc grpc.ClientConnInterface) DoraServiceClient {
+	return &doraServiceClient{cc}
+}
+
+func (c *doraServiceClient) GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*Block, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Block)
+	err := c.cc.Invoke(ctx, DoraService_GetBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doraServiceClient) GetBlocks(ctx context.Context, in *GetBlocksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Block], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DoraService_ServiceDesc.Streams[0], DoraService_GetBlocks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetBlocksRequest, Block]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DoraService_GetBlocksClient = grpc.ServerStreamingClient[Block]
+
+func (c *doraServiceClient) StreamBlocks(ctx context.Context, in *StreamBlocksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Block], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DoraService_ServiceDesc.Streams[1], DoraService_StreamBlocks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamBlocksRequest, Block]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DoraService_StreamBlocksClient = grpc.ServerStreamingClient[Block]
+
+func (c *doraServiceClient) GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Validator], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DoraService_ServiceDesc.Streams[2], DoraService_GetValidators_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetValidatorsRequest, Validator]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DoraService_GetValidatorsClient = grpc.ServerStreamingClient[Validator]
+
+func (c *doraServiceClient) GetDuties(ctx context.Context, in *GetDutiesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Duty], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DoraService_ServiceDesc.Streams[3], DoraService_GetDuties_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetDutiesRequest, Duty]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DoraService_GetDutiesClient = grpc.ServerStreamingClient[Duty]
+
+// DoraServiceServer is the server API for DoraService service.
+// All implementations must embed UnimplementedDoraServiceServer
+// for forward compatibility.
+//
+// DoraService exposes indexed chain data for internal tools that need higher throughput than the json api.
+type DoraServiceServer interface {
+	// GetBlock returns a single block by slot or root.
+	GetBlock(context.Context, *GetBlockRequest) (*Block, error)
+	// GetBlocks streams the blocks of a slot range in ascending order.
+	GetBlocks(*GetBlocksRequest, grpc.ServerStreamingServer[Block]) error
+	// StreamBlocks streams new blocks as they are received by the indexer.
+	StreamBlocks(*StreamBlocksRequest, grpc.ServerStreamingServer[Block]) error
+	// GetValidators streams the current validator set or the requested validators.
+	GetValidators(*GetValidatorsRequest, grpc.ServerStreamingServer[Validator]) error
+	// GetDuties streams the proposer, attester & sync committee duties of an epoch range.
+	// with follow set, the duties of upcoming epochs are streamed as soon as they are known.
+	GetDuties(*GetDutiesRequest, grpc.ServerStreamingServer[Duty]) error
+	mustEmbedUnimplementedDoraServiceServer()
+}
+
+// UnimplementedDoraServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDoraServiceServer struct{}
+
+func (UnimplementedDoraServiceServer) GetBlock(context.Context, *GetBlockRequest) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (UnimplementedDoraServiceServer) GetBlocks(*GetBlocksRequest, grpc.ServerStreamingServer[Block]) error {
+	return status.Errorf(codes.Unimplemented, "method GetBlocks not implemented")
+}
+func (UnimplementedDoraServiceServer) StreamBlocks(*StreamBlocksRequest, grpc.ServerStreamingServer[Block]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlocks not implemented")
+}
+func (UnimplementedDoraServiceServer) GetValidators(*GetValidatorsRequest, grpc.ServerStreamingServer[Validator]) error {
+	return status.Errorf(codes.Unimplemented, "method GetValidators not implemented")
+}
+func (UnimplementedDoraServiceServer) GetDuties(*GetDutiesRequest, grpc.ServerStreamingServer[Duty]) error {
+	return status.Errorf(codes.Unimplemented, "method GetDuties not implemented")
+}
+func (UnimplementedDoraServiceServer) mustEmbedUnimplementedDoraServiceServer() {}
+func (UnimplementedDoraServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeDoraServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DoraServiceServer will
+// result in compilation errors.
+type UnsafeDoraServiceServer interface {
+	mustEmbedUnimplementedDoraServiceServer()
+}
+
+func RegisterDoraServiceServer(s grpc.ServiceRegistrar, srv DoraServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDoraServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DoraService_ServiceDesc, srv)
+}
+
+func _DoraService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoraServiceServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoraService_GetBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoraServiceServer).GetBlock(ctx, req.(*GetBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoraService_GetBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DoraServiceServer).GetBlocks(m, &grpc.GenericServerStream[GetBlocksRequest, Block]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DoraService_GetBlocksServer = grpc.ServerStreamingServer[Block]
+
+func _DoraService_StreamBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DoraServiceServer).StreamBlocks(m, &grpc.GenericServerStream[StreamBlocksRequest, Block]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DoraService_StreamBlocksServer = grpc.ServerStreamingServer[Block]
+
+func _DoraService_GetValidators_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetValidatorsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DoraServiceServer).GetValidators(m, &grpc.GenericServerStream[GetValidatorsRequest, Validator]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DoraService_GetValidatorsServer = grpc.ServerStreamingServer[Validator]
+
+func _DoraService_GetDuties_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDutiesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DoraServiceServer).GetDuties(m, &grpc.GenericServerStream[GetDutiesRequest, Duty]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DoraService_GetDutiesServer = grpc.ServerStreamingServer[Duty]
+
+// DoraService_ServiceDesc is the grpc.ServiceDesc for DoraService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DoraService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dora.v1.DoraService",
+	HandlerType: (*DoraServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBlock",
+			Handler:    _DoraService_GetBlock_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetBlocks",
+			Handler:       _DoraService_GetBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBlocks",
+			Handler:       _DoraService_StreamBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetValidators",
+			Handler:       _DoraService_GetValidators_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetDuties",
+			Handler:       _DoraService_GetDuties_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dora/v1/dora.proto",
+}
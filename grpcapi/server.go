@@ -0,0 +1,84 @@
+package grpcapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	dorav1 "github.com/ethpandaops/dora/grpcapi/pb/dora/v1"
+	"github.com/ethpandaops/dora/utils"
+)
+
+//go:generate buf generate
+
+// StartServer starts the grpc api server in background.
+func StartServer(logger logrus.FieldLogger) (*grpc.Server, error) {
+	config := &utils.Config().GrpcApi
+	host := config.Host
+	if host == "" {
+		host = "localhost"
+	}
+	port := config.Port
+	if port == "" {
+		port = "9090"
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("%v:%v", host, port))
+	if err != nil {
+		return nil, fmt.Errorf("failed listening on %v:%v: %v", host, port, err)
+	}
+
+	serverOpts := []grpc.ServerOption{}
+	if config.ApiKey != "" {
+		serverOpts = append(serverOpts,
+			grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+				if err := checkApiKey(ctx, config.ApiKey); err != nil {
+					return nil, err
+				}
+				return handler(ctx, req)
+			}),
+			grpc.StreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				if err := checkApiKey(ss.Context(), config.ApiKey); err != nil {
+					return err
+				}
+				return handler(srv, ss)
+			}),
+		)
+	}
+
+	server := grpc.NewServer(serverOpts...)
+	dorav1.RegisterDoraServiceServer(server, &doraService{
+		logger: logger,
+	})
+
+	go func() {
+		defer utils.HandleSubroutinePanic("grpcapi.serve")
+
+		logger.Infof("grpc api listening on %v", listener.Addr())
+		if err := server.Serve(listener); err != nil {
+			logger.Errorf("grpc api server stopped: %v", err)
+		}
+	}()
+
+	return server, nil
+}
+
+func checkApiKey(ctx context.Context, apiKey string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, authHeader := range md.Get("authorization") {
+		key := strings.TrimPrefix(authHeader, "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(key), []byte(apiKey)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "invalid or missing api key")
+}
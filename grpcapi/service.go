@@ -0,0 +1,298 @@
+package grpcapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	dorav1 "github.com/ethpandaops/dora/grpcapi/pb/dora/v1"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+)
+
+// maxBlocksPerRequest limits the slot range of a single GetBlocks call.
+const maxBlocksPerRequest = 10000
+
+// blockBatchSize is the number of slots loaded per db query when streaming block ranges.
+const blockBatchSize = 100
+
+type doraService struct {
+	dorav1.UnimplementedDoraServiceServer
+	logger logrus.FieldLogger
+}
+
+func (s *doraService) GetBlock(ctx context.Context, req *dorav1.GetBlockRequest) (*dorav1.Block, error) {
+	var dbBlock *dbtypes.Slot
+
+	switch blockId := req.BlockId.(type) {
+	case *dorav1.GetBlockRequest_Slot:
+		for _, block := range services.GlobalBeaconService.GetDbBlocksForSlots(ctx, blockId.Slot, 1, false, true) {
+			if dbBlock == nil || block.Status == dbtypes.Canonical {
+				dbBlock = block
+			}
+		}
+	case *dorav1.GetBlockRequest_Root:
+		if len(blockId.Root) != 32 {
+			return nil, status.Error(codes.InvalidArgument, "invalid block root")
+		}
+		dbBlock = getDbBlockByRoot(ctx, phase0.Root(blockId.Root))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "missing slot or root")
+	}
+
+	if dbBlock == nil {
+		return nil, status.Error(codes.NotFound, "block not found")
+	}
+
+	return buildBlock(dbBlock), nil
+}
+
+func (s *doraService) GetBlocks(req *dorav1.GetBlocksRequest, stream grpc.ServerStreamingServer[dorav1.Block]) error {
+	limit := uint64(req.Limit)
+	if limit == 0 || limit > maxBlocksPerRequest {
+		limit = maxBlocksPerRequest
+	}
+
+	for batchStart := req.StartSlot; batchStart < req.StartSlot+limit; batchStart += blockBatchSize {
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+
+		batchSize := uint64(blockBatchSize)
+		if batchStart+batchSize > req.StartSlot+limit {
+			batchSize = req.StartSlot + limit - batchStart
+		}
+
+		// GetDbBlocksForSlots returns the blocks in descending order, starting with the given slot
+		blocks := services.GlobalBeaconService.GetDbBlocksForSlots(stream.Context(), batchStart+batchSize-1, uint32(batchSize), req.WithMissed, req.WithOrphaned)
+		for idx := len(blocks) - 1; idx >= 0; idx-- {
+			if blocks[idx].Slot < batchStart {
+				continue
+			}
+			if err := stream.Send(buildBlock(blocks[idx])); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *doraService) StreamBlocks(req *dorav1.StreamBlocksRequest, stream grpc.ServerStreamingServer[dorav1.Block]) error {
+	indexer := services.GlobalBeaconService.GetBeaconIndexer()
+	subscription := indexer.SubscribeBlockEvent(100)
+	defer subscription.Unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case block := <-subscription.Channel():
+			dbBlock := indexer.GetDbBlock(block)
+			if dbBlock == nil {
+				continue
+			}
+			if err := stream.Send(buildBlock(dbBlock)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *doraService) GetValidators(req *dorav1.GetValidatorsRequest, stream grpc.ServerStreamingServer[dorav1.Validator]) error {
+	validatorSet := services.GlobalBeaconService.GetCachedValidatorSet(req.WithBalance)
+
+	sendValidator := func(index uint64) error {
+		validator, found := validatorSet.GetRecord(phase0.ValidatorIndex(index))
+		if !found {
+			return nil
+		}
+
+		var balance phase0.Gwei
+		if validatorSet.HasBalances() {
+			balance = validator.Balance
+		}
+
+		return stream.Send(&dorav1.Validator{
+			Index:                      index,
+			Pubkey:                     validator.Pubkey[:],
+			Status:                     validator.Status.String(),
+			Balance:                    uint64(balance),
+			EffectiveBalance:           uint64(validator.EffectiveBalance),
+			WithdrawalCredentials:      validator.WithdrawalCredentials[:],
+			Slashed:                    validator.Slashed,
+			ActivationEligibilityEpoch: uint64(validator.ActivationEligibilityEpoch),
+			ActivationEpoch:            uint64(validator.ActivationEpoch),
+			ExitEpoch:                  uint64(validator.ExitEpoch),
+			WithdrawableEpoch:          uint64(validator.WithdrawableEpoch),
+			Name:                       services.GlobalBeaconService.GetValidatorName(index),
+		})
+	}
+
+	if len(req.Indices) > 0 {
+		for _, index := range req.Indices {
+			if err := sendValidator(index); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for index := 0; index < validatorSet.Len(); index++ {
+		if err := sendValidator(uint64(index)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *doraService) GetDuties(req *dorav1.GetDutiesRequest, stream grpc.ServerStreamingServer[dorav1.Duty]) error {
+	chainState := services.GlobalBeaconService.GetChainState()
+	indexer := services.GlobalBeaconService.GetBeaconIndexer()
+
+	dutyTypes := map[dorav1.DutyType]bool{}
+	for _, dutyType := range req.Types {
+		dutyTypes[dutyType] = true
+	}
+	withType := func(dutyType dorav1.DutyType) bool {
+		return len(dutyTypes) == 0 || dutyTypes[dutyType]
+	}
+
+	for epoch := req.StartEpoch; req.EndEpoch == nil || epoch <= *req.EndEpoch; epoch++ {
+		var epochStatsValues *beacon.EpochStatsValues
+
+		for {
+			if err := stream.Context().Err(); err != nil {
+				return err
+			}
+
+			// duties of the next epoch are known once the dependent state is loaded
+			if epoch <= uint64(chainState.CurrentEpoch())+1 {
+				epochStatsValues = indexer.GetEpochStatsValues(phase0.Epoch(epoch), nil)
+			}
+			if epochStatsValues != nil {
+				break
+			}
+			if !req.Follow {
+				return status.Errorf(codes.Unavailable, "duties for epoch %v not available", epoch)
+			}
+
+			select {
+			case <-stream.Context().Done():
+				return stream.Context().Err()
+			case <-time.After(chainState.GetSpecs().SecondsPerSlot):
+			}
+		}
+
+		firstSlot := uint64(chainState.EpochToSlot(phase0.Epoch(epoch)))
+
+		if withType(dorav1.DutyType_DUTY_TYPE_PROPOSER) {
+			for slotIndex, proposer := range epochStatsValues.ProposerDuties {
+				if err := stream.Send(&dorav1.Duty{
+					Type:           dorav1.DutyType_DUTY_TYPE_PROPOSER,
+					Epoch:          epoch,
+					Slot:           firstSlot + uint64(slotIndex),
+					ValidatorIndex: uint64(proposer),
+				}); err != nil {
+					return err
+				}
+			}
+		}
+
+		if withType(dorav1.DutyType_DUTY_TYPE_ATTESTER) {
+			for slotIndex, committees := range epochStatsValues.AttesterDuties {
+				for committeeIndex, committee := range committees {
+					for position, validatorIndice := range committee {
+						if err := stream.Send(&dorav1.Duty{
+							Type:              dorav1.DutyType_DUTY_TYPE_ATTESTER,
+							Epoch:             epoch,
+							Slot:              firstSlot + uint64(slotIndex),
+							ValidatorIndex:    uint64(epochStatsValues.ActiveIndices[validatorIndice]),
+							CommitteeIndex:    uint64(committeeIndex),
+							CommitteePosition: uint64(position),
+						}); err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+
+		if withType(dorav1.DutyType_DUTY_TYPE_SYNC_COMMITTEE) {
+			for position, validatorIndex := range epochStatsValues.SyncCommitteeDuties {
+				if err := stream.Send(&dorav1.Duty{
+					Type:              dorav1.DutyType_DUTY_TYPE_SYNC_COMMITTEE,
+					Epoch:             epoch,
+					Slot:              firstSlot,
+					ValidatorIndex:    uint64(validatorIndex),
+					CommitteePosition: uint64(position),
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func getDbBlockByRoot(ctx context.Context, root phase0.Root) *dbtypes.Slot {
+	indexer := services.GlobalBeaconService.GetBeaconIndexer()
+	if block := indexer.GetBlockByRoot(root); block != nil {
+		return indexer.GetDbBlock(block)
+	}
+
+	blockHead := db.GetBlockHeadByRoot(root[:])
+	if blockHead == nil {
+		return nil
+	}
+
+	for _, block := range services.GlobalBeaconService.GetDbBlocksForSlots(ctx, blockHead.Slot, 1, false, true) {
+		if phase0.Root(block.Root) == root {
+			return block
+		}
+	}
+	return nil
+}
+
+func buildBlock(dbBlock *dbtypes.Slot) *dorav1.Block {
+	block := &dorav1.Block{
+		Slot:                  dbBlock.Slot,
+		Root:                  dbBlock.Root,
+		ParentRoot:            dbBlock.ParentRoot,
+		StateRoot:             dbBlock.StateRoot,
+		ProposerIndex:         dbBlock.Proposer,
+		Graffiti:              dbBlock.Graffiti,
+		AttestationCount:      dbBlock.AttestationCount,
+		DepositCount:          dbBlock.DepositCount,
+		ExitCount:             dbBlock.ExitCount,
+		WithdrawCount:         dbBlock.WithdrawCount,
+		WithdrawAmount:        dbBlock.WithdrawAmount,
+		AttesterSlashingCount: dbBlock.AttesterSlashingCount,
+		ProposerSlashingCount: dbBlock.ProposerSlashingCount,
+		BlsChangeCount:        dbBlock.BLSChangeCount,
+		EthTransactionCount:   dbBlock.EthTransactionCount,
+		EthBlockNumber:        dbBlock.EthBlockNumber,
+		EthBlockHash:          dbBlock.EthBlockHash,
+		SyncParticipation:     dbBlock.SyncParticipation,
+		ForkId:                dbBlock.ForkId,
+	}
+
+	switch dbBlock.Status {
+	case dbtypes.Canonical:
+		block.Status = dorav1.BlockStatus_BLOCK_STATUS_CANONICAL
+	case dbtypes.Orphaned:
+		block.Status = dorav1.BlockStatus_BLOCK_STATUS_ORPHANED
+	case dbtypes.Missing:
+		block.Status = dorav1.BlockStatus_BLOCK_STATUS_MISSED
+	}
+
+	return block
+}
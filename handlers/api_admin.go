@@ -0,0 +1,239 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// admin api token scopes
+const (
+	adminScopeRead    = "read"    // read-only access to the indexer state & audit log
+	adminScopeIndexer = "indexer" // pause & resume the synchronizer
+	adminScopeReload  = "reload"  // reload the runtime adjustable config settings
+	adminScopeNotes   = "notes"   // add & remove validator notes
+)
+
+// ApiAdminStatus returns the indexer & client state, requires a token with the read scope.
+func ApiAdminStatus(w http.ResponseWriter, r *http.Request) {
+	token := checkAdminApiToken(w, r, adminScopeRead, "status")
+	if token == nil {
+		return
+	}
+
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+	finalizedEpoch, prunedEpoch := beaconIndexer.GetBlockCacheState()
+	syncRunning, syncHead := beaconIndexer.GetSynchronizerState()
+
+	response := &models.ApiAdminStatusResponse{
+		Version:        utils.GetExplorerVersion(),
+		Token:          token.Name,
+		Scopes:         token.Scopes,
+		FinalizedEpoch: uint64(finalizedEpoch),
+		PrunedEpoch:    uint64(prunedEpoch),
+		Synchronizer: &models.ApiAdminSynchronizer{
+			Enabled:   !utils.Config().Indexer.DisableSynchronizer,
+			Running:   syncRunning,
+			Paused:    beaconIndexer.IsSynchronizerPaused(),
+			HeadEpoch: uint64(syncHead),
+		},
+		Clients: []*models.ApiAdminStatusClient{},
+	}
+
+	if services.GlobalDatabaseMaintenance != nil {
+		maintenanceStatus := services.GlobalDatabaseMaintenance.GetStatus()
+		response.DbMaintenance = &models.ApiAdminDbMaintenance{
+			Running:     maintenanceStatus.Running,
+			Windows:     maintenanceStatus.Windows,
+			LastRun:     maintenanceStatus.LastRun,
+			LastSuccess: maintenanceStatus.LastSuccess,
+			LastError:   maintenanceStatus.LastError,
+			NextRun:     maintenanceStatus.NextRun,
+		}
+	}
+
+	if cleanupStats := beaconIndexer.GetForkCleanupStats(); cleanupStats != nil {
+		response.ForkCleanup = &models.ApiAdminForkCleanup{
+			RetentionEpochs:     utils.Config().Indexer.OrphanedForkRetention,
+			LastRun:             cleanupStats.LastRun,
+			CutoffEpoch:         uint64(cleanupStats.CutoffEpoch),
+			LastError:           cleanupStats.LastError,
+			DeletedForks:        cleanupStats.TotalResult.Forks,
+			DeletedBlocks:       cleanupStats.TotalResult.Blocks,
+			DeletedOperations:   cleanupStats.TotalResult.Operations,
+			DeletedTransactions: cleanupStats.TotalResult.ElTransactions,
+			DeletedBlobs:        cleanupStats.TotalResult.Blobs,
+		}
+	}
+
+	for _, client := range services.GlobalBeaconService.GetConsensusClients() {
+		headSlot, _ := client.GetLastHead()
+		response.Clients = append(response.Clients, &models.ApiAdminStatusClient{
+			Name:     client.GetName(),
+			Status:   client.GetStatus().String(),
+			HeadSlot: uint64(headSlot),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err := encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding admin status")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
+
+// ApiAdminAudit returns the recorded admin actions (newest first), requires a token with the read scope.
+func ApiAdminAudit(w http.ResponseWriter, r *http.Request) {
+	if checkAdminApiToken(w, r, adminScopeRead, "audit") == nil {
+		return
+	}
+
+	urlArgs := r.URL.Query()
+	var offset uint64
+	if urlArgs.Has("s") {
+		offset, _ = strconv.ParseUint(urlArgs.Get("s"), 10, 64)
+	}
+	var limit uint64 = 100
+	if urlArgs.Has("c") {
+		limit, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+
+	response := &models.ApiAdminAuditResponse{
+		Entries: []*models.ApiAdminAuditEntry{},
+	}
+	for _, entry := range services.GlobalAdminAuditLog.GetEntries(offset, limit) {
+		response.Entries = append(response.Entries, &models.ApiAdminAuditEntry{
+			Time:    entry.Time,
+			Token:   entry.Token,
+			Remote:  entry.Remote,
+			Action:  entry.Action,
+			Success: entry.Success,
+			Message: entry.Message,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	encodeApiResponse(w, r, response)
+}
+
+// ApiAdminSynchronizerPause pauses the synchronizer, requires a token with the indexer scope.
+func ApiAdminSynchronizerPause(w http.ResponseWriter, r *http.Request) {
+	token := checkAdminApiToken(w, r, adminScopeIndexer, "synchronizer.pause")
+	if token == nil {
+		return
+	}
+
+	err := services.GlobalBeaconService.GetBeaconIndexer().PauseSynchronizer()
+	writeAdminActionResponse(w, r, token, "synchronizer.pause", "synchronizer paused", err)
+}
+
+// ApiAdminSynchronizerResume resumes a paused synchronizer, requires a token with the indexer scope.
+func ApiAdminSynchronizerResume(w http.ResponseWriter, r *http.Request) {
+	token := checkAdminApiToken(w, r, adminScopeIndexer, "synchronizer.resume")
+	if token == nil {
+		return
+	}
+
+	err := services.GlobalBeaconService.GetBeaconIndexer().ResumeSynchronizer()
+	writeAdminActionResponse(w, r, token, "synchronizer.resume", "synchronizer resumed", err)
+}
+
+// ApiAdminConfigReload reloads the runtime adjustable settings from the config file, requires a token with the reload scope.
+func ApiAdminConfigReload(w http.ResponseWriter, r *http.Request) {
+	token := checkAdminApiToken(w, r, adminScopeReload, "config.reload")
+	if token == nil {
+		return
+	}
+
+	err := utils.ReloadConfig()
+	if err == nil {
+		// names sources might have changed
+		services.GlobalBeaconService.ReloadValidatorNames()
+	}
+	writeAdminActionResponse(w, r, token, "config.reload", "config reloaded", err)
+}
+
+// checkAdminApiToken authenticates the request against the configured admin tokens and checks the required scope.
+// writes the error response and returns nil if the request is not permitted. denied requests are recorded in the audit log.
+func checkAdminApiToken(w http.ResponseWriter, r *http.Request, scope string, action string) *types.AdminApiTokenConfig {
+	if services.GlobalAdminAuditLog == nil {
+		http.Error(w, "Admin api is not enabled", http.StatusNotFound)
+		return nil
+	}
+
+	authToken, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	var token *types.AdminApiTokenConfig
+	if found && authToken != "" {
+		tokens := utils.Config().AdminApi.Tokens
+		for idx := range tokens {
+			tokenConfig := &tokens[idx]
+			if tokenConfig.Token != "" && subtle.ConstantTimeCompare([]byte(authToken), []byte(tokenConfig.Token)) == 1 {
+				token = tokenConfig
+				break
+			}
+		}
+	}
+
+	if token == nil {
+		services.GlobalAdminAuditLog.AddEntry(&services.AdminAuditEntry{
+			Remote:  r.RemoteAddr,
+			Action:  action,
+			Message: "invalid or missing token",
+		})
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return nil
+	}
+
+	if !slices.Contains(token.Scopes, scope) {
+		services.GlobalAdminAuditLog.AddEntry(&services.AdminAuditEntry{
+			Token:   token.Name,
+			Remote:  r.RemoteAddr,
+			Action:  action,
+			Message: fmt.Sprintf("token is missing the %v scope", scope),
+		})
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return nil
+	}
+
+	return token
+}
+
+// writeAdminActionResponse records the result of an admin action in the audit log and writes the api response.
+func writeAdminActionResponse(w http.ResponseWriter, r *http.Request, token *types.AdminApiTokenConfig, action string, message string, err error) {
+	response := &models.ApiAdminActionResponse{
+		Action:  action,
+		Success: err == nil,
+		Message: message,
+	}
+	if err != nil {
+		response.Message = err.Error()
+	}
+
+	services.GlobalAdminAuditLog.AddEntry(&services.AdminAuditEntry{
+		Token:   token.Name,
+		Remote:  r.RemoteAddr,
+		Action:  action,
+		Success: response.Success,
+		Message: response.Message,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}
+	encodeApiResponse(w, r, response)
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ApiIncludedDeposits returns the filtered list of included deposits as json.
+// it accepts the same query parameters as /validators/included_deposits.
+func ApiIncludedDeposits(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	pageArgs := parseIncludedDepositsPageArgs(r.URL.Query())
+
+	err := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	pageData, err := getFilteredIncludedDepositsPageData(r.Context(), pageArgs.PageIdx, pageArgs.PageSize, pageArgs.MinIndex, pageArgs.MaxIndex, pageArgs.PubKey, pageArgs.ValidatorName, pageArgs.MinAmount, pageArgs.MaxAmount, pageArgs.WithOrphaned)
+	if err != nil {
+		logrus.WithError(err).Error("error loading included deposits")
+		http.Error(w, "failed loading included deposits", http.StatusServiceUnavailable)
+		return
+	}
+
+	response := &models.ApiIncludedDepositsResponse{
+		Filter: models.ApiIncludedDepositsFilter{
+			MinIndex:      pageArgs.MinIndex,
+			MaxIndex:      pageArgs.MaxIndex,
+			PubKey:        pageArgs.PubKey,
+			ValidatorName: pageArgs.ValidatorName,
+			MinAmount:     pageArgs.MinAmount,
+			MaxAmount:     pageArgs.MaxAmount,
+			WithOrphaned:  pageArgs.WithOrphaned,
+		},
+		PageIndex:  pageData.CurrentPageIndex,
+		PageSize:   pageData.PageSize,
+		TotalPages: pageData.TotalPages,
+		Deposits:   make([]*models.ApiIncludedDepositsEntry, 0, len(pageData.Deposits)),
+	}
+	if pageData.NextPageIndex > 0 {
+		response.NextPageApi = strings.Replace(pageData.NextPageLink, "/validators/included_deposits?", "/api/v1/deposits/included?", 1)
+	}
+
+	for _, deposit := range pageData.Deposits {
+		entry := &models.ApiIncludedDepositsEntry{
+			PublicKey:             deposit.PublicKey,
+			WithdrawalCredentials: deposit.Withdrawalcredentials,
+			Amount:                deposit.Amount,
+			Slot:                  deposit.SlotNumber,
+			SlotRoot:              deposit.SlotRoot,
+			Time:                  deposit.Time,
+			Orphaned:              deposit.Orphaned,
+			ValidatorStatus:       deposit.ValidatorStatus,
+		}
+		if deposit.HasIndex {
+			depositIndex := deposit.Index
+			entry.Index = &depositIndex
+		}
+		response.Deposits = append(response.Deposits, entry)
+	}
+	response.Count = uint64(len(response.Deposits))
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding included deposits")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ApiEpochs returns the epochs of the epochs page as json.
+// it accepts the same query parameters as /epochs (epoch, count & fork), the epochs are returned in descending order.
+func ApiEpochs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	urlArgs := r.URL.Query()
+	var firstEpoch uint64 = math.MaxUint64
+	if urlArgs.Has("epoch") {
+		firstEpoch, _ = strconv.ParseUint(urlArgs.Get("epoch"), 10, 64)
+	}
+	var pageSize uint64 = 50
+	if urlArgs.Has("count") {
+		pageSize, _ = strconv.ParseUint(urlArgs.Get("count"), 10, 64)
+	}
+	forkName := urlArgs.Get("fork")
+
+	err := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	pageData, err := getEpochsPageData(r.Context(), firstEpoch, pageSize, forkName)
+	if err != nil {
+		logrus.WithError(err).Error("error loading epochs")
+		http.Error(w, "failed loading epochs", http.StatusServiceUnavailable)
+		return
+	}
+
+	response := &models.ApiEpochsResponse{
+		FirstEpoch: pageData.FirstEpoch,
+		LastEpoch:  pageData.LastEpoch,
+		PageSize:   pageData.PageSize,
+		Epochs:     make([]*models.ApiEpochEntry, 0, len(pageData.Epochs)),
+	}
+	if pageData.NextPageIndex > 0 {
+		response.NextPageApi = fmt.Sprintf("/api/v1/epochs?epoch=%v&count=%v", pageData.NextPageEpoch, pageData.PageSize)
+		if pageData.FilterFork != "" {
+			response.NextPageApi += "&fork=" + url.QueryEscape(pageData.FilterFork)
+		}
+	}
+
+	for _, epoch := range pageData.Epochs {
+		response.Epochs = append(response.Epochs, &models.ApiEpochEntry{
+			Epoch:                   epoch.Epoch,
+			Time:                    epoch.Ts,
+			Finalized:               epoch.Finalized,
+			Justified:               epoch.Justified,
+			CanonicalBlockCount:     epoch.CanonicalBlockCount,
+			OrphanedBlockCount:      epoch.OrphanedBlockCount,
+			AttestationCount:        epoch.AttestationCount,
+			DepositCount:            epoch.DepositCount,
+			ExitCount:               epoch.ExitCount,
+			ProposerSlashingCount:   epoch.ProposerSlashingCount,
+			AttesterSlashingCount:   epoch.AttesterSlashingCount,
+			EligibleEther:           epoch.EligibleEther,
+			TargetVoted:             epoch.TargetVoted,
+			HeadVoted:               epoch.HeadVoted,
+			TotalVoted:              epoch.TotalVoted,
+			TargetVoteParticipation: epoch.TargetVoteParticipation,
+			HeadVoteParticipation:   epoch.HeadVoteParticipation,
+			TotalVoteParticipation:  epoch.TotalVoteParticipation,
+			EthTransactionCount:     epoch.EthTransactionCount,
+		})
+	}
+	response.Count = uint64(len(response.Epochs))
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding epochs")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
+
+// ApiEpoch returns the details of an epoch including its slots as json.
+func ApiEpoch(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	vars := mux.Vars(r)
+	epoch, err := strconv.ParseUint(vars["epoch"], 10, 64)
+	if err != nil {
+		http.Error(w, "invalid epoch", http.StatusBadRequest)
+		return
+	}
+
+	err = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	pageData, err := getEpochPageData(r.Context(), epoch)
+	if err != nil {
+		logrus.WithError(err).Error("error loading epoch")
+		http.Error(w, "failed loading epoch", http.StatusServiceUnavailable)
+		return
+	}
+	if pageData == nil {
+		http.Error(w, "epoch not found", http.StatusNotFound)
+		return
+	}
+
+	response := &models.ApiEpochResponse{
+		Epoch:                   pageData.Epoch,
+		Time:                    pageData.Ts,
+		Finalized:               pageData.Finalized,
+		ForkName:                pageData.ForkName,
+		ValidatorCount:          pageData.ValidatorCount,
+		AverageValidatorBalance: pageData.AverageValidatorBalance,
+		EligibleEther:           pageData.EligibleEther,
+		TargetVoted:             pageData.TargetVoted,
+		HeadVoted:               pageData.HeadVoted,
+		TotalVoted:              pageData.TotalVoted,
+		TargetVoteParticipation: pageData.TargetVoteParticipation,
+		HeadVoteParticipation:   pageData.HeadVoteParticipation,
+		TotalVoteParticipation:  pageData.TotalVoteParticipation,
+		SyncParticipation:       pageData.SyncParticipation,
+		AttestationCount:        pageData.AttestationCount,
+		DepositCount:            pageData.DepositCount,
+		ExitCount:               pageData.ExitCount,
+		WithdrawalCount:         pageData.WithdrawalCount,
+		WithdrawalAmount:        pageData.WithdrawalAmount,
+		ProposerSlashingCount:   pageData.ProposerSlashingCount,
+		AttesterSlashingCount:   pageData.AttesterSlashingCount,
+		CanonicalCount:          pageData.CanonicalCount,
+		MissedCount:             pageData.MissedCount,
+		ScheduledCount:          pageData.ScheduledCount,
+		OrphanedCount:           pageData.OrphanedCount,
+		EthTransactionCount:     pageData.EthTransactionCount,
+		Slots:                   make([]*models.ApiSlotEntry, 0, len(pageData.Slots)),
+	}
+
+	for _, slot := range pageData.Slots {
+		entry := &models.ApiSlotEntry{
+			Slot:                  slot.Slot,
+			Epoch:                 slot.Epoch,
+			Time:                  slot.Ts,
+			Status:                getApiSlotStatus(slot.Status, slot.Scheduled),
+			Finalized:             pageData.Finalized,
+			Proposer:              slot.Proposer,
+			ProposerName:          slot.ProposerName,
+			BlockRoot:             slot.BlockRoot,
+			Graffiti:              slot.Graffiti,
+			AttestationCount:      slot.AttestationCount,
+			DepositCount:          slot.DepositCount,
+			ExitCount:             slot.ExitCount,
+			ProposerSlashingCount: slot.ProposerSlashingCount,
+			AttesterSlashingCount: slot.AttesterSlashingCount,
+			SyncParticipation:     slot.SyncParticipation,
+			EthTransactionCount:   slot.EthTransactionCount,
+		}
+		if slot.WithEthBlock {
+			ethBlockNumber := slot.EthBlockNumber
+			entry.EthBlockNumber = &ethBlockNumber
+		}
+		response.Slots = append(response.Slots, entry)
+	}
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding epoch")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
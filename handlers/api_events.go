@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ApiEvents returns the indexer events (block_added, epoch_finalized, chain_reorg & deposit_seen) after the event id given by ?since=.
+// event ids are strictly increasing, consumers catch up by polling with the id of the last received event until more is false.
+// a last_event_id lower than since indicates that the event log has been reset.
+func ApiEvents(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	urlArgs := r.URL.Query()
+	var since uint64
+	if urlArgs.Has("since") {
+		var err error
+		since, err = strconv.ParseUint(urlArgs.Get("since"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid since parameter", http.StatusBadRequest)
+			return
+		}
+	}
+	var limit uint64 = 100
+	if urlArgs.Has("limit") {
+		limit, _ = strconv.ParseUint(urlArgs.Get("limit"), 10, 64)
+	}
+	if limit == 0 || limit > 1000 {
+		limit = 1000
+	}
+
+	err := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	// load one more event than requested to see if there are more events
+	events := db.GetIndexerEventsSince(r.Context(), since, uint32(limit+1))
+	if events == nil {
+		http.Error(w, "failed loading events", http.StatusServiceUnavailable)
+		return
+	}
+
+	response := &models.ApiEventsResponse{
+		Since:  since,
+		Events: make([]*models.ApiEventsEntry, 0, len(events)),
+	}
+	if uint64(len(events)) > limit {
+		response.More = true
+		events = events[:limit]
+	}
+	for _, event := range events {
+		response.Events = append(response.Events, &models.ApiEventsEntry{
+			Id:   event.EventId,
+			Type: event.EventType,
+			Time: event.EventTime,
+			Data: json.RawMessage(event.Data),
+		})
+	}
+	response.Count = uint64(len(response.Events))
+	if response.Count > 0 {
+		response.LastEventId = response.Events[response.Count-1].Id
+	} else {
+		response.LastEventId = db.GetLastIndexerEventId(r.Context())
+	}
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding indexer events")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/indexer/execution"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// max number of storage slots per execution proof request
+const apiExecutionProofMaxKeys = 16
+
+// ApiExecutionProof returns the eth_getProof result of the deposit or a system contract at the execution payload of a beacon block,
+// packaged with the beacon block & state root for cross-layer proof testing.
+func ApiExecutionProof(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	urlArgs := r.URL.Query()
+	slot, err := strconv.ParseUint(urlArgs.Get("slot"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid slot parameter", http.StatusBadRequest)
+		return
+	}
+
+	contract := urlArgs.Get("contract")
+	var contractAddr common.Address
+	switch contract {
+	case "deposit":
+		contractAddr = common.BytesToAddress(services.GlobalBeaconService.GetChainState().GetSpecs().DepositContractAddress)
+	case "withdrawal":
+		contractAddr = common.HexToAddress(execution.WithdrawalContractAddr)
+	case "consolidation":
+		contractAddr = common.HexToAddress(execution.ConsolidationContractAddr)
+	default:
+		http.Error(w, "invalid contract parameter (deposit, withdrawal or consolidation)", http.StatusBadRequest)
+		return
+	}
+
+	storageKeys := []common.Hash{}
+	if keysArg := urlArgs.Get("keys"); keysArg != "" {
+		for _, keyStr := range strings.Split(keysArg, ",") {
+			key, ok := parseApiStorageKey(strings.TrimSpace(keyStr))
+			if !ok {
+				http.Error(w, "invalid keys parameter", http.StatusBadRequest)
+				return
+			}
+			storageKeys = append(storageKeys, key)
+		}
+	}
+	if len(storageKeys) > apiExecutionProofMaxKeys {
+		http.Error(w, "too many storage keys", http.StatusBadRequest)
+		return
+	}
+
+	err = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	proof, err := services.GlobalBeaconService.GetExecutionStorageProof(r.Context(), phase0.Slot(slot), contractAddr, storageKeys)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	response := &models.ApiExecutionProofResponse{
+		Slot:                 uint64(proof.Slot),
+		BlockRoot:            proof.BlockRoot[:],
+		StateRoot:            proof.StateRoot[:],
+		ExecutionBlockNumber: proof.ExecutionBlockNumber,
+		ExecutionBlockHash:   proof.ExecutionBlockHash[:],
+		ExecutionStateRoot:   proof.ExecutionStateRoot[:],
+		Contract:             contract,
+		Client:               proof.ClientName,
+		Proof: &models.ApiExecutionProofResult{
+			Address:      proof.Proof.Address[:],
+			AccountProof: proof.Proof.AccountProof,
+			Balance:      proof.Proof.Balance,
+			CodeHash:     proof.Proof.CodeHash[:],
+			Nonce:        uint64(proof.Proof.Nonce),
+			StorageHash:  proof.Proof.StorageHash[:],
+			StorageProof: make([]*models.ApiExecutionProofStorage, len(proof.Proof.StorageProof)),
+		},
+	}
+	for i, storageProof := range proof.Proof.StorageProof {
+		response.Proof.StorageProof[i] = &models.ApiExecutionProofStorage{
+			Key:   storageProof.Key,
+			Value: storageProof.Value,
+			Proof: storageProof.Proof,
+		}
+	}
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding execution proof")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
+
+// parseApiStorageKey parses a storage slot given as 0x-prefixed hash or decimal slot number
+func parseApiStorageKey(keyStr string) (common.Hash, bool) {
+	if strings.HasPrefix(keyStr, "0x") {
+		keyBytes, err := hexutil.Decode(keyStr)
+		if err != nil || len(keyBytes) > common.HashLength {
+			return common.Hash{}, false
+		}
+		return common.BytesToHash(keyBytes), true
+	}
+
+	keyNum, ok := new(big.Int).SetString(keyStr, 10)
+	if !ok || keyNum.Sign() < 0 || keyNum.BitLen() > 256 {
+		return common.Hash{}, false
+	}
+	return common.BigToHash(keyNum), true
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// encodeApiResponse writes the json encoded api response.
+// if a field selection is requested via the `fields` url parameter (eg. ?fields=index,balance), the objects in the
+// record lists of the response are reduced to the selected fields. other top level fields of the response are kept as is.
+func encodeApiResponse(w http.ResponseWriter, r *http.Request, response interface{}) error {
+	fields := parseApiFields(r)
+	if len(fields) == 0 {
+		return json.NewEncoder(w).Encode(response)
+	}
+
+	responseJson, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	responseObj := map[string]json.RawMessage{}
+	if err := json.Unmarshal(responseJson, &responseObj); err != nil {
+		// not an object, nothing to project
+		_, err = w.Write(append(responseJson, '\n'))
+		return err
+	}
+
+	for key, value := range responseObj {
+		if len(value) == 0 || value[0] != '[' {
+			continue
+		}
+
+		records := []map[string]json.RawMessage{}
+		if err := json.Unmarshal(value, &records); err != nil {
+			continue // not a list of objects
+		}
+
+		for _, record := range records {
+			for field := range record {
+				if !fields[field] {
+					delete(record, field)
+				}
+			}
+		}
+
+		projected, err := json.Marshal(records)
+		if err != nil {
+			return err
+		}
+		responseObj[key] = projected
+	}
+
+	return json.NewEncoder(w).Encode(responseObj)
+}
+
+// parseApiFields returns the set of record fields requested via the `fields` url parameter, or nil if all fields are requested.
+func parseApiFields(r *http.Request) map[string]bool {
+	fieldsArg := strings.TrimSpace(r.URL.Query().Get("fields"))
+	if fieldsArg == "" {
+		return nil
+	}
+
+	fields := map[string]bool{}
+	for _, field := range strings.Split(fieldsArg, ",") {
+		field = strings.TrimSpace(field)
+		if field != "" {
+			fields[field] = true
+		}
+	}
+	return fields
+}
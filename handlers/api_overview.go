@@ -0,0 +1,324 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/clients/execution"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// number of recent epochs included in the participation overview
+const overviewParticipationEpochs = 4
+
+// maximum number of incidents in the overview, reorgs are reported for the last 24h
+const overviewIncidentLimit = 25
+
+// ApiOverview returns a compact aggregate of the network state (heads, finality, participation, client diversity,
+// validator counts & recent incidents) for dashboards that would otherwise scrape multiple pages.
+func ApiOverview(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	err := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	pageData, err := getApiOverviewData(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	err = encodeApiResponse(w, r, pageData)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding network overview")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
+
+func getApiOverviewData(ctx context.Context) (*models.ApiOverviewResponse, error) {
+	pageData := &models.ApiOverviewResponse{}
+	pageCacheKey := "api:overview"
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildApiOverviewData(pageCall.CallCtx)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ApiOverviewResponse)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildApiOverviewData(ctx context.Context) (*models.ApiOverviewResponse, time.Duration) {
+	logrus.Debugf("network overview api called")
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+	currentEpoch := chainState.CurrentEpoch()
+	finalizedEpoch, finalizedRoot := chainState.GetFinalizedCheckpoint()
+	justifiedEpoch, justifiedRoot := chainState.GetJustifiedCheckpoint()
+
+	syncState := dbtypes.IndexerSyncState{}
+	db.GetExplorerState(ctx, "indexer.syncstate", &syncState)
+
+	pageData := &models.ApiOverviewResponse{
+		Network:      specs.ConfigName,
+		CurrentSlot:  uint64(chainState.CurrentSlot()),
+		CurrentEpoch: uint64(currentEpoch),
+		Synced:       finalizedEpoch < 1 || syncState.Epoch >= uint64(finalizedEpoch-1),
+		Finality: &models.ApiOverviewFinality{
+			FinalizedEpoch: uint64(finalizedEpoch),
+			FinalizedRoot:  finalizedRoot.String(),
+			JustifiedEpoch: uint64(justifiedEpoch),
+			JustifiedRoot:  justifiedRoot.String(),
+		},
+		Incidents: []*models.ApiOverviewIncident{},
+	}
+	if utils.Config().Chain.DisplayName != "" {
+		pageData.Network = utils.Config().Chain.DisplayName
+	}
+	if currentEpoch > finalizedEpoch {
+		pageData.Finality.FinalityDelay = uint64(currentEpoch - finalizedEpoch)
+	}
+
+	buildApiOverviewHeads(pageData)
+	buildApiOverviewParticipation(ctx, pageData, currentEpoch, finalizedEpoch)
+	buildApiOverviewClientDiversity(pageData)
+	buildApiOverviewValidators(pageData)
+	buildApiOverviewIncidents(ctx, pageData)
+
+	return pageData, 12 * time.Second
+}
+
+func buildApiOverviewHeads(pageData *models.ApiOverviewResponse) {
+	canonicalHead := services.GlobalBeaconService.GetBeaconIndexer().GetCanonicalHead(nil)
+
+	pageData.Heads = []*models.ApiOverviewHead{}
+	for _, fork := range services.GlobalBeaconService.GetConsensusClientForks() {
+		pageData.Heads = append(pageData.Heads, &models.ApiOverviewHead{
+			Slot:         uint64(fork.Slot),
+			Root:         fork.Root.String(),
+			Canonical:    canonicalHead != nil && canonicalHead.Root == fork.Root,
+			ClientCount:  uint64(len(fork.AllClients)),
+			ReadyClients: uint64(len(fork.ReadyClients)),
+		})
+	}
+}
+
+func buildApiOverviewParticipation(ctx context.Context, pageData *models.ApiOverviewResponse, currentEpoch phase0.Epoch, finalizedEpoch phase0.Epoch) {
+	pageData.Participation = []*models.ApiOverviewParticipation{}
+	if currentEpoch == 0 {
+		return
+	}
+
+	// the current epoch is still in progress, start with the previous one
+	slotsPerEpoch := services.GlobalBeaconService.GetChainState().GetSpecs().SlotsPerEpoch
+	for _, epochData := range services.GlobalBeaconService.GetDbEpochs(ctx, uint64(currentEpoch-1), overviewParticipationEpochs) {
+		if epochData == nil {
+			continue
+		}
+
+		participation := &models.ApiOverviewParticipation{
+			Epoch:          epochData.Epoch,
+			Finalized:      uint64(finalizedEpoch) > epochData.Epoch,
+			OrphanedBlocks: uint64(epochData.OrphanedCount),
+		}
+		if epochData.Eligible > 0 {
+			participation.TargetParticipation = float64(epochData.VotedTarget) * 100 / float64(epochData.Eligible)
+			participation.HeadParticipation = float64(epochData.VotedHead) * 100 / float64(epochData.Eligible)
+			participation.TotalParticipation = float64(epochData.VotedTotal) * 100 / float64(epochData.Eligible)
+		}
+		if uint64(epochData.BlockCount) < slotsPerEpoch {
+			participation.MissedBlocks = slotsPerEpoch - uint64(epochData.BlockCount)
+		}
+		pageData.Participation = append(pageData.Participation, participation)
+	}
+}
+
+func buildApiOverviewClientDiversity(pageData *models.ApiOverviewResponse) {
+	pageData.ClientDiversity = &models.ApiOverviewClientDiversity{
+		Consensus: []*models.ApiOverviewClientType{},
+		Execution: []*models.ApiOverviewClientType{},
+	}
+
+	consensusTypes := map[string]*models.ApiOverviewClientType{}
+	for _, client := range services.GlobalBeaconService.GetConsensusClients() {
+		typeName := "unknown"
+		if clientType := client.GetClientType(); clientType != consensus.UnknownClient {
+			typeName = clientType.String()
+		}
+
+		clientTypeData := consensusTypes[typeName]
+		if clientTypeData == nil {
+			clientTypeData = &models.ApiOverviewClientType{Type: typeName}
+			consensusTypes[typeName] = clientTypeData
+			pageData.ClientDiversity.Consensus = append(pageData.ClientDiversity.Consensus, clientTypeData)
+		}
+		clientTypeData.Count++
+		if client.GetStatus() == consensus.ClientStatusOnline {
+			clientTypeData.Online++
+		}
+	}
+
+	executionTypes := map[string]*models.ApiOverviewClientType{}
+	for _, client := range services.GlobalBeaconService.GetExecutionClients() {
+		typeName := "unknown"
+		if clientType := client.GetClientType(); clientType != execution.UnknownClient {
+			typeName = clientType.String()
+		}
+
+		clientTypeData := executionTypes[typeName]
+		if clientTypeData == nil {
+			clientTypeData = &models.ApiOverviewClientType{Type: typeName}
+			executionTypes[typeName] = clientTypeData
+			pageData.ClientDiversity.Execution = append(pageData.ClientDiversity.Execution, clientTypeData)
+		}
+		clientTypeData.Count++
+		if client.GetStatus() == execution.ClientStatusOnline {
+			clientTypeData.Online++
+		}
+	}
+
+	sortClientTypes := func(clientTypes []*models.ApiOverviewClientType) {
+		sort.Slice(clientTypes, func(a, b int) bool {
+			if clientTypes[a].Count != clientTypes[b].Count {
+				return clientTypes[a].Count > clientTypes[b].Count
+			}
+			return clientTypes[a].Type < clientTypes[b].Type
+		})
+	}
+	sortClientTypes(pageData.ClientDiversity.Consensus)
+	sortClientTypes(pageData.ClientDiversity.Execution)
+}
+
+func buildApiOverviewValidators(pageData *models.ApiOverviewResponse) {
+	pageData.Validators = &models.ApiOverviewValidators{
+		Statuses: map[string]uint64{},
+	}
+
+	validatorSet := services.GlobalBeaconService.GetCachedValidatorSet(true)
+	if validatorSet == nil {
+		return
+	}
+
+	for index := 0; index < validatorSet.Len(); index++ {
+		record, found := validatorSet.GetRecord(phase0.ValidatorIndex(index))
+		if !found {
+			continue
+		}
+
+		statusName := record.Status.String()
+		pageData.Validators.Total++
+		pageData.Validators.Statuses[statusName]++
+
+		switch {
+		case strings.HasPrefix(statusName, "active"):
+			pageData.Validators.Active++
+			pageData.Validators.EffectiveEther += uint64(record.EffectiveBalance)
+		case strings.HasPrefix(statusName, "pending"):
+			pageData.Validators.Pending++
+		default:
+			pageData.Validators.Exited++
+		}
+		if strings.HasSuffix(statusName, "slashed") {
+			pageData.Validators.Slashed++
+		}
+	}
+}
+
+func buildApiOverviewIncidents(ctx context.Context, pageData *models.ApiOverviewResponse) {
+	chainState := services.GlobalBeaconService.GetChainState()
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+	now := time.Now()
+
+	// ongoing incidents
+	readyForks := uint64(0)
+	for _, head := range pageData.Heads {
+		if head.ReadyClients > 0 {
+			readyForks++
+		}
+	}
+	if readyForks > 1 {
+		pageData.Incidents = append(pageData.Incidents, &models.ApiOverviewIncident{
+			Type:        "chain_split",
+			Time:        now,
+			Ongoing:     true,
+			Description: fmt.Sprintf("clients are split across %v chain heads", readyForks),
+		})
+	}
+	if pageData.Finality.FinalityDelay > 3 {
+		pageData.Incidents = append(pageData.Incidents, &models.ApiOverviewIncident{
+			Type:        "finality_delay",
+			Time:        chainState.EpochToTime(phase0.Epoch(pageData.Finality.FinalizedEpoch + 2)),
+			Ongoing:     true,
+			Slot:        uint64(chainState.EpochToSlot(phase0.Epoch(pageData.Finality.FinalizedEpoch))),
+			Description: fmt.Sprintf("no finality for %v epochs (last finalized epoch %v)", pageData.Finality.FinalityDelay, pageData.Finality.FinalizedEpoch),
+		})
+	}
+
+	// recent incidents
+	incidents := []*models.ApiOverviewIncident{}
+	for _, event := range db.GetRecentIndexerEventsByType(ctx, dbtypes.IndexerEventChainReorg, now.Add(-24*time.Hour).Unix(), overviewIncidentLimit) {
+		eventData := &dbtypes.IndexerEventChainReorgData{}
+		if err := json.Unmarshal([]byte(event.Data), eventData); err != nil {
+			continue
+		}
+
+		depth := uint64(0)
+		if eventData.OldHeadSlot > eventData.BaseSlot {
+			depth = eventData.OldHeadSlot - eventData.BaseSlot
+		}
+		incidents = append(incidents, &models.ApiOverviewIncident{
+			Type:        "chain_reorg",
+			Time:        time.Unix(event.EventTime, 0),
+			Slot:        eventData.NewHeadSlot,
+			Description: fmt.Sprintf("reorg of depth %v from slot %v to slot %v (base slot %v)", depth, eventData.OldHeadSlot, eventData.NewHeadSlot, eventData.BaseSlot),
+		})
+	}
+	for _, anomaly := range beaconIndexer.GetSlotAnomalies() {
+		incidents = append(incidents, &models.ApiOverviewIncident{
+			Type:        "slot_anomaly",
+			Time:        anomaly.DetectedAt,
+			Slot:        uint64(anomaly.Slot),
+			Description: anomaly.Description,
+		})
+	}
+	for _, anomaly := range beaconIndexer.GetCommitteeAnomalies() {
+		incidents = append(incidents, &models.ApiOverviewIncident{
+			Type:        "committee_anomaly",
+			Time:        anomaly.DetectedAt,
+			Slot:        uint64(anomaly.Slot),
+			Description: anomaly.Description,
+		})
+	}
+
+	sort.Slice(incidents, func(a, b int) bool {
+		return incidents[a].Time.After(incidents[b].Time)
+	})
+	for _, incident := range incidents {
+		if len(pageData.Incidents) >= overviewIncidentLimit {
+			break
+		}
+		pageData.Incidents = append(pageData.Incidents, incident)
+	}
+}
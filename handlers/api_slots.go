@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+const maxRecentSlotsLimit = 100
+
+// getApiSlotStatus returns the stable api representation of a slot status
+func getApiSlotStatus(status uint8, scheduled bool) string {
+	switch {
+	case scheduled:
+		return "scheduled"
+	case status == uint8(dbtypes.Missing):
+		return "missed"
+	case status == uint8(dbtypes.Orphaned):
+		return "orphaned"
+	default:
+		return "canonical"
+	}
+}
+
+// ApiSlots returns the slots of the slots page as json.
+// it accepts the same query parameters as /slots (s & c), the slots are returned in descending order.
+func ApiSlots(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	urlArgs := r.URL.Query()
+	var pageSize uint64 = 50
+	if urlArgs.Has("c") {
+		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	var firstSlot uint64 = math.MaxUint64
+	if urlArgs.Has("s") {
+		firstSlot, _ = strconv.ParseUint(urlArgs.Get("s"), 10, 64)
+	}
+
+	err := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	pageData, err := getSlotsPageData(r.Context(), firstSlot, pageSize)
+	if err != nil {
+		logrus.WithError(err).Error("error loading slots")
+		http.Error(w, "failed loading slots", http.StatusServiceUnavailable)
+		return
+	}
+
+	response := &models.ApiSlotsResponse{
+		FirstSlot: pageData.FirstSlot,
+		LastSlot:  pageData.LastSlot,
+		PageSize:  pageData.PageSize,
+		Slots:     make([]*models.ApiSlotEntry, 0, len(pageData.Slots)),
+	}
+	if pageData.NextPageIndex > 0 {
+		response.NextPageApi = fmt.Sprintf("/api/v1/slots?s=%v&c=%v", pageData.NextPageSlot, pageData.PageSize)
+	}
+
+	for _, slot := range pageData.Slots {
+		entry := &models.ApiSlotEntry{
+			Slot:                  slot.Slot,
+			Epoch:                 slot.Epoch,
+			Time:                  slot.Ts,
+			Status:                getApiSlotStatus(slot.Status, slot.Scheduled),
+			Finalized:             slot.Finalized,
+			Proposer:              slot.Proposer,
+			ProposerName:          slot.ProposerName,
+			BlockRoot:             slot.BlockRoot,
+			ParentRoot:            slot.ParentRoot,
+			Graffiti:              slot.Graffiti,
+			AttestationCount:      slot.AttestationCount,
+			DepositCount:          slot.DepositCount,
+			ExitCount:             slot.ExitCount,
+			ProposerSlashingCount: slot.ProposerSlashingCount,
+			AttesterSlashingCount: slot.AttesterSlashingCount,
+			SyncParticipation:     slot.SyncParticipation,
+			EthTransactionCount:   slot.EthTransactionCount,
+		}
+		if slot.WithEthBlock {
+			ethBlockNumber := slot.EthBlockNumber
+			entry.EthBlockNumber = &ethBlockNumber
+		}
+		response.Slots = append(response.Slots, entry)
+	}
+	response.Count = uint64(len(response.Slots))
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding slots")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
+
+// ApiSlot returns the details of a slot as json. the slot can be referenced by slot number or block root.
+func ApiSlot(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	vars := mux.Vars(r)
+	slotOrHash := strings.Replace(vars["slotOrHash"], "0x", "", -1)
+	blockSlot := int64(-1)
+	blockRootHash, err := hex.DecodeString(slotOrHash)
+	if err != nil || len(slotOrHash) != 64 {
+		blockRootHash = []byte{}
+		blockSlot, err = strconv.ParseInt(vars["slotOrHash"], 10, 64)
+		if err != nil || blockSlot < 0 || blockSlot >= 2147483648 {
+			http.Error(w, "invalid slot or block root", http.StatusBadRequest)
+			return
+		}
+	}
+
+	err = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	pageData, err := getSlotPageData(r.Context(), blockSlot, blockRootHash)
+	if err != nil {
+		logrus.WithError(err).Error("error loading slot")
+		http.Error(w, "failed loading slot", http.StatusServiceUnavailable)
+		return
+	}
+	if pageData == nil {
+		http.Error(w, "slot not found", http.StatusNotFound)
+		return
+	}
+
+	response := &models.ApiSlotResponse{
+		Slot:         pageData.Slot,
+		Epoch:        pageData.Epoch,
+		Time:         pageData.Ts,
+		Status:       getApiSlotStatus(uint8(pageData.Status), pageData.Future),
+		Finalized:    pageData.EpochFinalized,
+		ForkName:     pageData.ForkName,
+		Proposer:     pageData.Proposer,
+		ProposerName: pageData.ProposerName,
+	}
+
+	if block := pageData.Block; block != nil {
+		response.BlockRoot = block.BlockRoot
+		response.ParentRoot = block.ParentRoot
+		response.StateRoot = block.StateRoot
+		response.Graffiti = block.Graffiti
+		response.AttestationCount = block.AttestationsCount
+		response.DepositCount = block.DepositsCount
+		response.ExitCount = block.VoluntaryExitsCount
+		response.ProposerSlashingCount = block.ProposerSlashingsCount
+		response.AttesterSlashingCount = block.AttesterSlashingsCount
+		response.BLSChangeCount = block.BLSChangesCount
+		response.WithdrawalCount = block.WithdrawalsCount
+		response.BlobCount = block.BlobsCount
+		response.DepositRequestCount = block.DepositRequestsCount
+		response.WithdrawalRequestCount = block.WithdrawalRequestsCount
+		response.ConsolidationRequestCount = block.ConsolidationRequestsCount
+		response.SyncParticipation = block.SyncAggParticipation
+
+		if executionData := block.ExecutionData; executionData != nil {
+			response.Execution = &models.ApiSlotExecutionData{
+				BlockNumber:      executionData.BlockNumber,
+				BlockHash:        executionData.BlockHash,
+				ParentHash:       executionData.ParentHash,
+				FeeRecipient:     executionData.FeeRecipient,
+				GasLimit:         executionData.GasLimit,
+				GasUsed:          executionData.GasUsed,
+				BaseFeePerGas:    executionData.BaseFeePerGas,
+				Timestamp:        executionData.Timestamp,
+				ExtraData:        executionData.ExtraData,
+				TransactionCount: block.TransactionsCount,
+			}
+		}
+	}
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding slot")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
+
+// ApiRecentSlots returns the latest canonical blocks with a minimal set of fields (limit=N, max. 100).
+// the blocks are taken from the unfinalized block cache without any database lookups, so the endpoint is cheap enough to be polled by bots & status badges.
+func ApiRecentSlots(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var limit uint64 = 10
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.ParseUint(limitStr, 10, 64)
+		if err != nil || limit == 0 {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		if limit > maxRecentSlotsLimit {
+			limit = maxRecentSlotsLimit
+		}
+	}
+
+	err := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+	chainState := services.GlobalBeaconService.GetChainState()
+	finalizedEpoch, _ := chainState.GetFinalizedCheckpoint()
+
+	response := &models.ApiRecentSlotsResponse{
+		FinalizedEpoch: uint64(finalizedEpoch),
+		Slots:          make([]*models.ApiRecentSlotEntry, 0, limit),
+	}
+
+	block := beaconIndexer.GetCanonicalHead(nil)
+	if block != nil {
+		response.HeadSlot = uint64(block.Slot)
+	}
+	for block != nil && uint64(len(response.Slots)) < limit {
+		blockHeader := block.GetHeader()
+		if blockHeader == nil {
+			break
+		}
+
+		entry := &models.ApiRecentSlotEntry{
+			Slot:         uint64(block.Slot),
+			Epoch:        uint64(chainState.EpochOfSlot(block.Slot)),
+			Time:         chainState.SlotToTime(block.Slot),
+			BlockRoot:    block.Root.String(),
+			Proposer:     uint64(blockHeader.Message.ProposerIndex),
+			ProposerName: services.GlobalBeaconService.GetValidatorName(uint64(blockHeader.Message.ProposerIndex)),
+		}
+		if blockIndex := block.GetBlockIndex(); blockIndex != nil && blockIndex.ExecutionNumber > 0 {
+			ethBlockNumber := blockIndex.ExecutionNumber
+			entry.EthBlockNumber = &ethBlockNumber
+		}
+		response.Slots = append(response.Slots, entry)
+
+		// the walk ends at the first block that has already been pruned from the cache
+		block = beaconIndexer.GetBlockByRoot(blockHeader.Message.ParentRoot)
+	}
+	response.Count = uint64(len(response.Slots))
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding recent slots")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
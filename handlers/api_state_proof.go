@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ApiStateProof returns a SSZ merkle proof for a beacon state field at a finalized slot.
+// the state is loaded on demand from a ready client, so this call is rate limited heavily.
+func ApiStateProof(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	urlArgs := r.URL.Query()
+	slot, err := strconv.ParseUint(urlArgs.Get("slot"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid slot parameter", http.StatusBadRequest)
+		return
+	}
+
+	field := urlArgs.Get("field")
+	if !services.IsStateProofField(field) {
+		http.Error(w, "invalid field parameter", http.StatusBadRequest)
+		return
+	}
+
+	var index *uint64
+	if field == "validator" || field == "balance" {
+		indexVal, err := strconv.ParseUint(urlArgs.Get("index"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid index parameter", http.StatusBadRequest)
+			return
+		}
+		index = &indexVal
+	}
+
+	err = services.GlobalCallRateLimiter.CheckCallLimit(r, 10)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	proofIndex := uint64(0)
+	if index != nil {
+		proofIndex = *index
+	}
+	proof, err := services.GlobalBeaconService.GetStateProof(r.Context(), phase0.Slot(slot), field, proofIndex)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	response := &models.ApiStateProofResponse{
+		Slot:           uint64(proof.Slot),
+		BlockRoot:      proof.BlockRoot[:],
+		StateRoot:      proof.StateRoot[:],
+		Version:        proof.Version.String(),
+		Field:          field,
+		Index:          index,
+		GeneralizedIdx: proof.GeneralizedIdx,
+		Leaf:           proof.Leaf,
+		Branch:         make([]hexutil.Bytes, len(proof.Branch)),
+	}
+	for i, hash := range proof.Branch {
+		response.Branch[i] = hash
+	}
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding state proof")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ApiStatsRender returns the template render duration percentiles of all pages rendered since startup.
+// the percentiles refer to the most recent renders of each page.
+func ApiStatsRender(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	err := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	toMs := func(duration time.Duration) float64 {
+		return float64(duration) / float64(time.Millisecond)
+	}
+
+	pages := services.GlobalRenderStats.GetRenderStats()
+	response := &models.ApiStatsRenderResponse{
+		Pages: make([]*models.ApiStatsRenderPage, 0, len(pages)),
+	}
+	for _, page := range pages {
+		response.Pages = append(response.Pages, &models.ApiStatsRenderPage{
+			Page:    page.Page,
+			Count:   page.Count,
+			Average: toMs(page.Average),
+			Max:     toMs(page.Max),
+			P50:     toMs(page.P50),
+			P90:     toMs(page.P90),
+			P99:     toMs(page.P99),
+		})
+	}
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding render stats")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
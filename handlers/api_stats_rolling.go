@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ApiStatsRolling returns the network stats aggregated over the rolling 1d, 7d & 31d windows of finalized epochs.
+// the average block time delay is the average time between two canonical blocks in excess of the slot time.
+func ApiStatsRolling(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	err := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	windows := services.GlobalBeaconService.GetRollingStats()
+	if windows == nil {
+		http.Error(w, "rolling stats not aggregated yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	specs := services.GlobalBeaconService.GetChainState().GetSpecs()
+	finalizedEpoch, _ := services.GlobalBeaconService.GetFinalizedEpoch()
+	response := &models.ApiStatsRollingResponse{
+		FinalizedEpoch: uint64(finalizedEpoch),
+		Windows:        make([]*models.ApiStatsRollingWindow, 0, len(windows)),
+	}
+
+	for _, window := range windows {
+		windowData := &models.ApiStatsRollingWindow{
+			Window:        window.Name,
+			FirstEpoch:    window.FirstEpoch,
+			LastEpoch:     window.LastEpoch,
+			EpochCount:    window.EpochCount,
+			Complete:      window.LastEpoch-window.FirstEpoch+1 >= window.WindowEpochs,
+			SlotCount:     window.SlotCount,
+			BlockCount:    window.BlockCount,
+			MissedSlots:   window.MissedCount,
+			ReorgCount:    window.OrphanedCount,
+			DepositCount:  window.DepositCount,
+			DepositAmount: window.DepositAmount,
+		}
+		if window.Eligible > 0 {
+			windowData.TargetParticipation = float64(window.VotedTarget) * 100 / float64(window.Eligible)
+			windowData.HeadParticipation = float64(window.VotedHead) * 100 / float64(window.Eligible)
+			windowData.TotalParticipation = float64(window.VotedTotal) * 100 / float64(window.Eligible)
+		}
+		if window.SlotCount > 0 {
+			windowData.MissedSlotsPercent = float64(window.MissedCount) * 100 / float64(window.SlotCount)
+		}
+		if window.BlockCount > 0 {
+			windowData.AvgBlockTime = float64(window.SlotCount) * specs.SecondsPerSlot.Seconds() / float64(window.BlockCount)
+			windowData.AvgBlockTimeDelay = windowData.AvgBlockTime - specs.SecondsPerSlot.Seconds()
+		}
+		response.Windows = append(response.Windows, windowData)
+	}
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding rolling stats")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	maxStreamConnections    = 500
+	streamHeartbeatInterval = 10 * time.Second
+	streamMaxDuration       = 30 * time.Minute
+)
+
+var streamConnections atomic.Int64
+
+// ApiStream streams live updates as server-sent events: new blocks ("block"), canonical head changes & reorgs ("head") and finality updates ("finalized").
+// the topics can be restricted with ?topics=block,head,finalized. the current head & finality are sent on connect, so clients can resync after reconnecting.
+func ApiStream(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	topics := map[string]bool{"block": true, "head": true, "finalized": true}
+	if topicsArg := r.URL.Query().Get("topics"); topicsArg != "" {
+		topics = map[string]bool{}
+		for _, topic := range strings.Split(topicsArg, ",") {
+			topic = strings.TrimSpace(topic)
+			if topic != "block" && topic != "head" && topic != "finalized" {
+				http.Error(w, fmt.Sprintf("invalid topic: %v", topic), http.StatusBadRequest)
+				return
+			}
+			topics[topic] = true
+		}
+	}
+
+	err := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	if streamConnections.Add(1) > maxStreamConnections {
+		streamConnections.Add(-1)
+		http.Error(w, "too many stream connections", http.StatusServiceUnavailable)
+		return
+	}
+	defer streamConnections.Add(-1)
+
+	// the write timeout of the http server applies to the whole stream. it can't be lifted through the middleware wrappers,
+	// so the stream ends right before the timeout hits and the client reconnects (EventSource does that automatically)
+	streamDuration := streamMaxDuration
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil && utils.Config().Frontend.HttpWriteTimeout > 0 {
+		streamDuration = utils.Config().Frontend.HttpWriteTimeout - 2*time.Second
+		if streamDuration < time.Second {
+			streamDuration = time.Second
+		}
+	}
+
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	blockSubscription := beaconIndexer.SubscribeBlockEvent(100)
+	defer blockSubscription.Unsubscribe()
+	headSubscription := beaconIndexer.SubscribeHeadEvent(10)
+	defer headSubscription.Unsubscribe()
+	finalitySubscription := services.GlobalBeaconService.SubscribeFinalityEvent(10)
+	defer finalitySubscription.Unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	writeEvent := func(event string, data any) bool {
+		if !topics[event] {
+			return true
+		}
+		eventData, err := json.Marshal(data)
+		if err != nil {
+			logrus.WithError(err).Errorf("error encoding %v stream event", event)
+			return true
+		}
+		if _, err := fmt.Fprintf(w, "event: %v\ndata: %s\n\n", event, eventData); err != nil {
+			return false
+		}
+		flusher.Flush()
+		return true
+	}
+
+	fmt.Fprintf(w, "retry: 1000\n\n")
+
+	if headBlock := beaconIndexer.GetCanonicalHead(nil); headBlock != nil {
+		writeEvent("head", &models.ApiStreamHeadEvent{
+			Slot:  uint64(headBlock.Slot),
+			Epoch: uint64(chainState.EpochOfSlot(headBlock.Slot)),
+			Root:  headBlock.Root.String(),
+		})
+	}
+	justifiedEpoch, justifiedRoot := chainState.GetJustifiedCheckpoint()
+	finalizedEpoch, finalizedRoot := chainState.GetFinalizedCheckpoint()
+	writeEvent("finalized", &models.ApiStreamFinalityEvent{
+		JustifiedEpoch: uint64(justifiedEpoch),
+		JustifiedRoot:  justifiedRoot.String(),
+		FinalizedEpoch: uint64(finalizedEpoch),
+		FinalizedRoot:  finalizedRoot.String(),
+	})
+	flusher.Flush()
+
+	streamTimer := time.NewTimer(streamDuration)
+	defer streamTimer.Stop()
+	heartbeatTicker := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeatTicker.Stop()
+
+	for {
+		written := true
+		select {
+		case <-r.Context().Done():
+			return
+		case <-streamTimer.C:
+			return
+		case <-heartbeatTicker.C:
+			if _, err := fmt.Fprintf(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case block := <-blockSubscription.Channel():
+			blockHeader := block.GetHeader()
+			if blockHeader == nil {
+				continue
+			}
+			written = writeEvent("block", &models.ApiStreamBlockEvent{
+				Slot:       uint64(block.Slot),
+				Epoch:      uint64(chainState.EpochOfSlot(block.Slot)),
+				Root:       block.Root.String(),
+				ParentRoot: blockHeader.Message.ParentRoot.String(),
+				Proposer:   uint64(blockHeader.Message.ProposerIndex),
+			})
+		case headChange := <-headSubscription.Channel():
+			headEvent := &models.ApiStreamHeadEvent{
+				Slot:  uint64(headChange.NewHead.Slot),
+				Epoch: uint64(chainState.EpochOfSlot(headChange.NewHead.Slot)),
+				Root:  headChange.NewHead.Root.String(),
+				Reorg: headChange.IsReorg,
+			}
+			if headChange.OldHead != nil {
+				headEvent.OldHeadSlot = uint64(headChange.OldHead.Slot)
+				headEvent.OldHeadRoot = headChange.OldHead.Root.String()
+			}
+			written = writeEvent("head", headEvent)
+		case finality := <-finalitySubscription.Channel():
+			written = writeEvent("finalized", &models.ApiStreamFinalityEvent{
+				JustifiedEpoch: uint64(finality.Justified.Epoch),
+				JustifiedRoot:  finality.Justified.Root.String(),
+				FinalizedEpoch: uint64(finality.Finalized.Epoch),
+				FinalizedRoot:  finality.Finalized.Root.String(),
+			})
+		}
+		if !written {
+			return
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ApiValidator returns the details of a validator as json. the validator can be referenced by index or pubkey.
+func ApiValidator(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var validator *v1.Validator
+
+	vars := mux.Vars(r)
+	idxOrPubKey := strings.Replace(vars["idxOrPubKey"], "0x", "", -1)
+	validatorPubKey, err := hex.DecodeString(idxOrPubKey)
+	if err != nil || len(validatorPubKey) != 48 {
+		validatorIndex, err := strconv.ParseUint(vars["idxOrPubKey"], 10, 64)
+		if err != nil {
+			http.Error(w, "invalid validator index or pubkey", http.StatusBadRequest)
+			return
+		}
+		validator = services.GlobalBeaconService.GetValidatorByIndex(phase0.ValidatorIndex(validatorIndex), false)
+	} else {
+		validatorIndex, found := services.GlobalBeaconService.GetValidatorIndexByPubkey(phase0.BLSPubKey(validatorPubKey))
+		if found {
+			validator = services.GlobalBeaconService.GetValidatorByIndex(validatorIndex, false)
+		}
+	}
+
+	if validator == nil {
+		http.Error(w, "validator not found", http.StatusNotFound)
+		return
+	}
+
+	err = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	pageData, err := getValidatorPageData(r.Context(), uint64(validator.Index), "blocks")
+	if err != nil {
+		logrus.WithError(err).Error("error loading validator")
+		http.Error(w, "failed loading validator", http.StatusServiceUnavailable)
+		return
+	}
+
+	response := &models.ApiValidatorResponse{
+		Index:               pageData.Index,
+		Name:                pageData.Name,
+		PublicKey:           pageData.PublicKey,
+		State:               pageData.State,
+		BeaconState:         pageData.BeaconState,
+		Balance:             pageData.Balance,
+		EffectiveBalance:    pageData.EffectiveBalance,
+		ExitReason:          pageData.ExitReason,
+		WithdrawCredentials: pageData.WithdrawCredentials,
+		Upcheck:             pageData.UpcheckActivity,
+		UpcheckMaximum:      pageData.UpcheckMaximum,
+	}
+	if pageData.ShowEligible {
+		response.EligibilityEpoch = &pageData.EligibleEpoch
+	}
+	if pageData.ShowActivation {
+		response.ActivationEpoch = &pageData.ActivationEpoch
+		response.ActivationTime = &pageData.ActivationTs
+	}
+	if pageData.ShowExit {
+		response.ExitEpoch = &pageData.ExitEpoch
+		response.ExitTime = &pageData.ExitTs
+	}
+	if pageData.ShowWithdrawAddress {
+		response.WithdrawAddress = pageData.WithdrawAddress
+	}
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding validator")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
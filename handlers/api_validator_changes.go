@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ApiValidatorChanges returns the validators whose status, balance or credentials changed since the requested epoch.
+// if the changes since the requested epoch are not tracked by the indexer, the full validator set is returned with full_snapshot set.
+func ApiValidatorChanges(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	urlArgs := r.URL.Query()
+	if !urlArgs.Has("since_epoch") {
+		http.Error(w, "missing since_epoch parameter", http.StatusBadRequest)
+		return
+	}
+	sinceEpoch, err := strconv.ParseUint(urlArgs.Get("since_epoch"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid since_epoch parameter", http.StatusBadRequest)
+		return
+	}
+
+	err = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	pageData, err := getApiValidatorChangesData(r.Context(), sinceEpoch)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	err = encodeApiResponse(w, r, pageData)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding validator changes")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
+
+func getApiValidatorChangesData(ctx context.Context, sinceEpoch uint64) (*models.ApiValidatorChangesResponse, error) {
+	pageData := &models.ApiValidatorChangesResponse{}
+	pageCacheKey := fmt.Sprintf("api:validator_changes:%v", sinceEpoch)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildApiValidatorChangesData(pageCall.CallCtx, sinceEpoch)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ApiValidatorChangesResponse)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildApiValidatorChangesData(ctx context.Context, sinceEpoch uint64) (*models.ApiValidatorChangesResponse, time.Duration) {
+	logrus.Debugf("validator changes api called: %v", sinceEpoch)
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	indexer := services.GlobalBeaconService.GetBeaconIndexer()
+	currentEpoch := chainState.CurrentEpoch()
+
+	pageData := &models.ApiValidatorChangesResponse{
+		SinceEpoch:   sinceEpoch,
+		CurrentEpoch: uint64(currentEpoch),
+	}
+
+	changedValidators, tracked := indexer.GetValidatorChangesSince(phase0.Epoch(sinceEpoch), nil)
+	pageData.FullSnapshot = !tracked
+
+	validatorSet := services.GlobalBeaconService.GetCachedValidatorSet(true)
+	appendValidator := func(index phase0.ValidatorIndex) {
+		validator := validatorSet.GetValidator(index)
+		if validator == nil {
+			return
+		}
+
+		pageData.Validators = append(pageData.Validators, &models.ApiValidatorChangesEntry{
+			Index:                 uint64(validator.Index),
+			PublicKey:             validator.Validator.PublicKey[:],
+			Status:                validator.Status.String(),
+			Balance:               uint64(validator.Balance),
+			EffectiveBalance:      uint64(validator.Validator.EffectiveBalance),
+			WithdrawalCredentials: validator.Validator.WithdrawalCredentials,
+			Slashed:               validator.Validator.Slashed,
+			ActivationEpoch:       uint64(validator.Validator.ActivationEpoch),
+			ExitEpoch:             uint64(validator.Validator.ExitEpoch),
+			WithdrawableEpoch:     uint64(validator.Validator.WithdrawableEpoch),
+		})
+	}
+
+	if tracked {
+		pageData.Validators = make([]*models.ApiValidatorChangesEntry, 0, len(changedValidators))
+		for _, index := range changedValidators {
+			appendValidator(index)
+		}
+	} else {
+		pageData.Validators = make([]*models.ApiValidatorChangesEntry, 0, validatorSet.Len())
+		for index := 0; index < validatorSet.Len(); index++ {
+			appendValidator(phase0.ValidatorIndex(index))
+		}
+	}
+	pageData.Count = uint64(len(pageData.Validators))
+
+	return pageData, chainState.GetSpecs().SecondsPerSlot
+}
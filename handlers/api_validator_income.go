@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// maxValidatorIncomeApiLimit limits the number of income periods returned by the validator income api.
+const maxValidatorIncomeApiLimit = 1000
+
+// ApiValidatorIncome returns the income history of a validator in descending order (?limit=<periods>).
+// the validator can be referenced by index or pubkey.
+func ApiValidatorIncome(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	periodEpochs := services.GlobalBeaconService.GetValidatorIncomePeriod()
+	if periodEpochs == 0 {
+		http.Error(w, "income indexer not enabled", http.StatusNotFound)
+		return
+	}
+
+	var validatorIndex phase0.ValidatorIndex
+	vars := mux.Vars(r)
+	idxOrPubKey := strings.Replace(vars["idxOrPubKey"], "0x", "", -1)
+	validatorPubKey, err := hex.DecodeString(idxOrPubKey)
+	if err != nil || len(validatorPubKey) != 48 {
+		index, err := strconv.ParseUint(vars["idxOrPubKey"], 10, 64)
+		if err != nil {
+			http.Error(w, "invalid validator index or pubkey", http.StatusBadRequest)
+			return
+		}
+		validatorIndex = phase0.ValidatorIndex(index)
+	} else {
+		index, found := services.GlobalBeaconService.GetValidatorIndexByPubkey(phase0.BLSPubKey(validatorPubKey))
+		if !found {
+			http.Error(w, "validator not found", http.StatusNotFound)
+			return
+		}
+		validatorIndex = index
+	}
+
+	limit := uint64(validatorIncomeLimit)
+	if r.URL.Query().Has("limit") {
+		limit, err = strconv.ParseUint(r.URL.Query().Get("limit"), 10, 64)
+		if err != nil || limit == 0 {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit = min(limit, maxValidatorIncomeApiLimit)
+	}
+
+	err = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	response := &models.ApiValidatorIncomeResponse{
+		Validator:    uint64(validatorIndex),
+		PeriodEpochs: periodEpochs,
+		Income:       []*models.ApiValidatorIncomeEntry{},
+	}
+	for _, income := range services.GlobalBeaconService.GetValidatorIncome(r.Context(), validatorIndex, limit) {
+		response.Income = append(response.Income, &models.ApiValidatorIncomeEntry{
+			Epoch:             income.Epoch,
+			Time:              chainState.EpochToTime(phase0.Epoch(income.Epoch)).Unix(),
+			AttestationReward: income.AttestationReward,
+			SyncReward:        income.SyncReward,
+			ProposalReward:    income.ProposalReward,
+			Penalty:           income.Penalty,
+			NetIncome:         income.AttestationReward + income.SyncReward + income.ProposalReward - income.Penalty,
+		})
+	}
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding validator income")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
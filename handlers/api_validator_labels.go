@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// maxValidatorLabelsImportSize is the maximum request body size for label imports.
+const maxValidatorLabelsImportSize = 32 * 1024 * 1024
+
+// ApiValidatorLabelsExport exports all validator name mappings as json or csv (?format=csv).
+func ApiValidatorLabelsExport(w http.ResponseWriter, r *http.Request) {
+	err := services.GlobalCallRateLimiter.CheckCallLimit(r, 5)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	names := services.GlobalBeaconService.ExportValidatorNames()
+
+	if r.URL.Query().Get("format") == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", "attachment; filename=validator_labels.csv")
+
+		csvWriter := csv.NewWriter(w)
+		csvWriter.Write([]string{"index", "name", "source"})
+		for _, name := range names {
+			csvWriter.Write([]string{strconv.FormatUint(name.Index, 10), name.Name, name.Source})
+		}
+		csvWriter.Flush()
+		return
+	}
+
+	response := &models.ApiValidatorLabelsResponse{
+		Count:  uint64(len(names)),
+		Labels: make([]*models.ApiValidatorLabelsEntry, len(names)),
+	}
+	for idx, name := range names {
+		response.Labels[idx] = &models.ApiValidatorLabelsEntry{
+			Index:  name.Index,
+			Name:   name.Name,
+			Source: name.Source,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding validator labels")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
+
+// ApiValidatorLabelsImport imports validator labels from a json object ({"<index>": "<name>"}) or csv (index,name) request body.
+// requires the configured labels api key as bearer token.
+func ApiValidatorLabelsImport(w http.ResponseWriter, r *http.Request) {
+	if !checkValidatorLabelsApiKey(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxValidatorLabelsImportSize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error reading request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	var labels map[uint64]string
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "text/csv") {
+		labels, err = parseValidatorLabelsCsv(body)
+	} else {
+		labels, err = parseValidatorLabelsJson(body)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	source := r.Header.Get("X-Label-Source")
+	if source == "" {
+		source = "api"
+	}
+	source = fmt.Sprintf("%v (%v)", source, r.RemoteAddr)
+	// truncate by characters, cutting the bytes could split a multi-byte character
+	if chars := []rune(source); len(chars) > 250 {
+		source = string(chars[:250])
+	}
+
+	changes, err := services.GlobalBeaconService.ImportValidatorLabels(r.Context(), labels, source)
+	if err != nil {
+		logrus.WithError(err).Error("error importing validator labels")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(&models.ApiValidatorLabelsImportResponse{
+		Received: uint64(len(labels)),
+		Changed:  uint64(changes),
+	})
+}
+
+// ApiValidatorLabelsChanges returns the audit log of validator label changes.
+func ApiValidatorLabelsChanges(w http.ResponseWriter, r *http.Request) {
+	if !checkValidatorLabelsApiKey(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	urlArgs := r.URL.Query()
+	var offset uint64
+	if urlArgs.Has("s") {
+		offset, _ = strconv.ParseUint(urlArgs.Get("s"), 10, 64)
+	}
+	var limit uint64 = 100
+	if urlArgs.Has("c") {
+		limit, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+
+	response := &models.ApiValidatorLabelChangesResponse{
+		Changes: []*models.ApiValidatorLabelChangesEntry{},
+	}
+	for _, change := range db.GetValidatorLabelChanges(r.Context(), offset, uint32(limit)) {
+		response.Changes = append(response.Changes, &models.ApiValidatorLabelChangesEntry{
+			Index:      change.Index,
+			ChangeTime: change.ChangeTime,
+			OldName:    change.OldName,
+			NewName:    change.NewName,
+			Source:     change.Source,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	encodeApiResponse(w, r, response)
+}
+
+func checkValidatorLabelsApiKey(r *http.Request) bool {
+	apiKey := utils.Config().Frontend.ValidatorLabelsApiKey
+	if apiKey == "" {
+		return false
+	}
+
+	authToken, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(authToken), []byte(apiKey)) == 1
+}
+
+func parseValidatorLabelsJson(body []byte) (map[uint64]string, error) {
+	labelsJson := map[string]string{}
+	if err := json.Unmarshal(body, &labelsJson); err != nil {
+		return nil, fmt.Errorf("invalid json body: %v", err)
+	}
+
+	labels := make(map[uint64]string, len(labelsJson))
+	for indexStr, name := range labelsJson {
+		index, err := strconv.ParseUint(indexStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator index: %v", indexStr)
+		}
+		if err := addValidatorLabel(labels, index, name); err != nil {
+			return nil, err
+		}
+	}
+
+	return labels, nil
+}
+
+func parseValidatorLabelsCsv(body []byte) (map[uint64]string, error) {
+	csvReader := csv.NewReader(strings.NewReader(string(body)))
+	csvReader.FieldsPerRecord = -1
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid csv body: %v", err)
+	}
+
+	labels := make(map[uint64]string, len(records))
+	for lineIdx, record := range records {
+		if len(record) < 2 {
+			return nil, fmt.Errorf("invalid csv line %v: expected index,name", lineIdx+1)
+		}
+
+		index, err := strconv.ParseUint(strings.TrimSpace(record[0]), 10, 64)
+		if err != nil {
+			if lineIdx == 0 {
+				continue // header line
+			}
+			return nil, fmt.Errorf("invalid validator index in csv line %v: %v", lineIdx+1, record[0])
+		}
+		if err := addValidatorLabel(labels, index, record[1]); err != nil {
+			return nil, err
+		}
+	}
+
+	return labels, nil
+}
+
+func addValidatorLabel(labels map[uint64]string, index uint64, name string) error {
+	name = strings.TrimSpace(name)
+	if len(name) > 250 {
+		return fmt.Errorf("validator label for index %v exceeds 250 characters", index)
+	}
+
+	labels[index] = name
+	return nil
+}
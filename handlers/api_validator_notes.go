@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+const maxValidatorNoteRequestSize = 64 * 1024
+
+// ApiValidatorNotes returns the notes attached to a validator (?validator=<index>).
+func ApiValidatorNotes(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	validatorIndex, err := strconv.ParseUint(r.URL.Query().Get("validator"), 10, 64)
+	if err != nil {
+		http.Error(w, `{"error": "invalid or missing validator index"}`, http.StatusBadRequest)
+		return
+	}
+
+	response := &models.ApiValidatorNotesResponse{
+		Notes: []*models.ApiValidatorNotesEntry{},
+	}
+	for _, note := range services.GlobalBeaconService.GetValidatorNotes(r.Context(), validatorIndex) {
+		entry := &models.ApiValidatorNotesEntry{
+			Id:        note.Id,
+			Validator: note.ValidatorIndex,
+			Note:      note.Note,
+			Author:    note.Author,
+			CreatedAt: note.CreatedAt.Unix(),
+			Tags:      note.Tags,
+		}
+		if entry.Tags == nil {
+			entry.Tags = []string{}
+		}
+		response.Notes = append(response.Notes, entry)
+	}
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding validator notes")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
+
+// ApiAdminValidatorNoteAdd attaches a note with optional tags to a validator, requires a token with the notes scope.
+// the token name is stored as author of the note.
+func ApiAdminValidatorNoteAdd(w http.ResponseWriter, r *http.Request) {
+	token := checkAdminApiToken(w, r, adminScopeNotes, "validator_note.add")
+	if token == nil {
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxValidatorNoteRequestSize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error reading request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	request := &models.ApiAdminValidatorNoteRequest{}
+	if err := json.Unmarshal(body, request); err != nil {
+		http.Error(w, fmt.Sprintf("invalid json body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	noteId, err := services.GlobalBeaconService.AddValidatorNote(r.Context(), request.Validator, request.Note, request.Tags, token.Name)
+	writeAdminActionResponse(w, r, token, "validator_note.add", fmt.Sprintf("note %v added to validator %v", noteId, request.Validator), err)
+}
+
+// ApiAdminValidatorNoteDelete removes a validator note by id, requires a token with the notes scope.
+func ApiAdminValidatorNoteDelete(w http.ResponseWriter, r *http.Request) {
+	token := checkAdminApiToken(w, r, adminScopeNotes, "validator_note.delete")
+	if token == nil {
+		return
+	}
+
+	noteId, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "invalid note id", http.StatusBadRequest)
+		return
+	}
+
+	validatorIndex, err := services.GlobalBeaconService.DeleteValidatorNote(r.Context(), noteId)
+	writeAdminActionResponse(w, r, token, "validator_note.delete", fmt.Sprintf("note %v removed from validator %v", noteId, validatorIndex), err)
+}
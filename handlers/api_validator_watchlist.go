@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	maxValidatorWatchlistRequestSize = 64 * 1024
+	minWatchlistApiKeyLength         = 16
+)
+
+// getWatchlistApiSession returns the watchlist scope for the api key in the X-Api-Key header.
+// api keys are chosen by the client, the watchlists of a key are separate from the watchlists of browser sessions.
+func getWatchlistApiSession(w http.ResponseWriter, r *http.Request) []byte {
+	if !utils.Config().Frontend.ShowValidatorWatchlist {
+		http.Error(w, `{"error": "validator watchlists are not enabled"}`, http.StatusNotFound)
+		return nil
+	}
+
+	apiKey := r.Header.Get("X-Api-Key")
+	if len(apiKey) < minWatchlistApiKeyLength {
+		http.Error(w, fmt.Sprintf(`{"error": "missing or too short api key (X-Api-Key header, min. %v characters)"}`, minWatchlistApiKeyLength), http.StatusUnauthorized)
+		return nil
+	}
+
+	sessionHash := sha256.Sum256([]byte("dora watchlist api key:" + apiKey))
+	return sessionHash[:]
+}
+
+// ApiValidatorWatchlists returns the watchlists of the api key with the status, missed attestations & upcoming duties of all watched validators.
+func ApiValidatorWatchlists(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	sessionHash := getWatchlistApiSession(w, r)
+	if sessionHash == nil {
+		return
+	}
+	if err := services.GlobalCallRateLimiter.CheckCallLimit(r, 2); err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	response := &models.ApiValidatorWatchlistsResponse{
+		Watchlists: []*models.ApiValidatorWatchlist{},
+	}
+	for _, listData := range buildValidatorWatchlistData(services.GlobalBeaconService.GetValidatorWatchlists(r.Context(), sessionHash)) {
+		watchlist := &models.ApiValidatorWatchlist{
+			Name:       listData.Name,
+			CreatedAt:  listData.CreatedAt.Unix(),
+			Validators: []*models.ApiValidatorWatchlistValidator{},
+		}
+		for _, webhook := range listData.Webhooks {
+			watchlist.Webhooks = append(watchlist.Webhooks, &models.ApiValidatorWatchlistWebhook{
+				Url:           webhook.Url,
+				Events:        webhook.Events,
+				OfflineEpochs: webhook.OfflineEpochs,
+				CreatedAt:     webhook.CreatedAt.Unix(),
+			})
+		}
+		for _, validatorData := range listData.Validators {
+			validator := &models.ApiValidatorWatchlistValidator{
+				Index:              validatorData.Index,
+				Name:               validatorData.Name,
+				PublicKey:          "0x" + hex.EncodeToString(validatorData.PublicKey),
+				Status:             validatorData.State,
+				Balance:            validatorData.Balance,
+				EffectiveBalance:   validatorData.EffectiveBalance,
+				AttestationEpochs:  validatorData.AttestationEpochs,
+				MissedAttestations: validatorData.MissedAttestations,
+				UpcomingProposals:  []uint64{},
+				SyncCommittee:      validatorData.SyncCommittee,
+			}
+			for _, proposal := range validatorData.Proposals {
+				validator.UpcomingProposals = append(validator.UpcomingProposals, proposal.Slot)
+			}
+			if validatorData.HasAttestation {
+				validator.NextAttestation = &validatorData.Attestation.Slot
+			}
+			watchlist.Validators = append(watchlist.Validators, validator)
+		}
+		response.Watchlists = append(response.Watchlists, watchlist)
+	}
+
+	err := encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding validator watchlists")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
+
+// ApiValidatorWatchlistAdd adds validators (by index or pubkey) to a watchlist of the api key, the watchlist is created if it doesn't exist.
+func ApiValidatorWatchlistAdd(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	sessionHash := getWatchlistApiSession(w, r)
+	if sessionHash == nil {
+		return
+	}
+	if err := services.GlobalCallRateLimiter.CheckCallLimit(r, 5); err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxValidatorWatchlistRequestSize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf(`{"error": "error reading request body: %v"}`, err), http.StatusBadRequest)
+		return
+	}
+
+	request := &models.ApiValidatorWatchlistRequest{}
+	if err := json.Unmarshal(body, request); err != nil {
+		http.Error(w, `{"error": "invalid json body"}`, http.StatusBadRequest)
+		return
+	}
+
+	listName := mux.Vars(r)["list"]
+	validators, err := parseWatchlistValidators(request.Validators)
+	if err != nil {
+		writeWatchlistUpdateResponse(w, r, "", err)
+		return
+	}
+
+	added, err := services.GlobalBeaconService.AddValidatorWatchlistValidators(r.Context(), sessionHash, listName, validators)
+	writeWatchlistUpdateResponse(w, r, fmt.Sprintf("%v validators added to watchlist %v", added, listName), err)
+}
+
+// ApiValidatorWatchlistDelete removes a watchlist of the api key, or a single validator from it with ?validator=<index>.
+func ApiValidatorWatchlistDelete(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	sessionHash := getWatchlistApiSession(w, r)
+	if sessionHash == nil {
+		return
+	}
+	if err := services.GlobalCallRateLimiter.CheckCallLimit(r, 5); err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	listName := mux.Vars(r)["list"]
+	if !r.URL.Query().Has("validator") {
+		err := services.GlobalBeaconService.DeleteValidatorWatchlist(r.Context(), sessionHash, listName)
+		writeWatchlistUpdateResponse(w, r, fmt.Sprintf("watchlist %v removed", listName), err)
+		return
+	}
+
+	validatorIndex, err := strconv.ParseUint(r.URL.Query().Get("validator"), 10, 64)
+	if err != nil {
+		http.Error(w, `{"error": "invalid validator index"}`, http.StatusBadRequest)
+		return
+	}
+
+	err = services.GlobalBeaconService.RemoveValidatorWatchlistValidator(r.Context(), sessionHash, listName, validatorIndex)
+	writeWatchlistUpdateResponse(w, r, fmt.Sprintf("validator %v removed from watchlist %v", validatorIndex, listName), err)
+}
+
+// ApiValidatorWatchlistWebhookAdd registers a webhook url for a watchlist of the api key.
+// the webhook receives a json POST request for every matching event of the validators on the watchlist.
+func ApiValidatorWatchlistWebhookAdd(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	sessionHash := getWatchlistApiSession(w, r)
+	if sessionHash == nil {
+		return
+	}
+	if err := services.GlobalCallRateLimiter.CheckCallLimit(r, 5); err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxValidatorWatchlistRequestSize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf(`{"error": "error reading request body: %v"}`, err), http.StatusBadRequest)
+		return
+	}
+
+	request := &models.ApiValidatorWatchlistWebhookRequest{}
+	if err := json.Unmarshal(body, request); err != nil {
+		http.Error(w, `{"error": "invalid json body"}`, http.StatusBadRequest)
+		return
+	}
+
+	listName := mux.Vars(r)["list"]
+	err = services.GlobalBeaconService.AddValidatorWatchlistWebhook(r.Context(), sessionHash, listName, request.Url, request.Events, request.OfflineEpochs)
+	writeWatchlistUpdateResponse(w, r, fmt.Sprintf("webhook added to watchlist %v", listName), err)
+}
+
+// ApiValidatorWatchlistWebhookDelete removes the webhook given with ?url=<url> from a watchlist of the api key.
+func ApiValidatorWatchlistWebhookDelete(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	sessionHash := getWatchlistApiSession(w, r)
+	if sessionHash == nil {
+		return
+	}
+	if err := services.GlobalCallRateLimiter.CheckCallLimit(r, 5); err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	listName := mux.Vars(r)["list"]
+	err := services.GlobalBeaconService.RemoveValidatorWatchlistWebhook(r.Context(), sessionHash, listName, r.URL.Query().Get("url"))
+	writeWatchlistUpdateResponse(w, r, fmt.Sprintf("webhook removed from watchlist %v", listName), err)
+}
+
+func writeWatchlistUpdateResponse(w http.ResponseWriter, r *http.Request, message string, err error) {
+	response := &models.ApiValidatorWatchlistUpdateResponse{
+		Status:  "OK",
+		Message: message,
+	}
+	if err != nil {
+		response.Status = "ERROR"
+		response.Message = err.Error()
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}
+	encodeApiResponse(w, r, response)
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ApiValidatorsFiltered returns the validator list of the validators page as json.
+// it accepts the same query parameters as /validators (s, c, o, apy, f, f.pubkey, f.index, f.name, f.status, f.expr, f.tag & at_epoch),
+// so the api link shown on the validators page returns exactly the validators listed on the page.
+func ApiValidatorsFiltered(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	pageArgs := parseValidatorsPageArgs(r.URL.Query(), 10000)
+
+	var err error
+	pageArgs.AtEpoch, err = parseHistoricEpoch(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	pageData, _, err := getValidatorsPageData(r.Context(), pageArgs, 0)
+	if err != nil {
+		logrus.WithError(err).Error("error loading filtered validators")
+		http.Error(w, "failed loading validators", http.StatusServiceUnavailable)
+		return
+	}
+	if pageData.FilterExprError != "" {
+		http.Error(w, "invalid f.expr parameter: "+pageData.FilterExprError, http.StatusBadRequest)
+		return
+	}
+
+	response := &models.ApiValidatorsFilteredResponse{
+		Filter: models.ApiValidatorsFilteredFilter{
+			PubKey: pageData.FilterPubKey,
+			Index:  pageData.FilterIndex,
+			Name:   pageData.FilterName,
+			Expr:   pageData.FilterExpr,
+			Tag:    pageData.FilterTag,
+		},
+		Sorting:    pageData.Sorting,
+		ApyWindow:  pageData.ApyWindow,
+		FirstIndex: pageData.FirstValidator,
+		PageSize:   pageData.PageSize,
+		TotalCount: pageData.FilteredCount,
+		PageLink:   strings.Replace(pageData.ApiLink, "/api/v1/validators/filtered?", "/validators?", 1),
+		Validators: make([]*models.ApiValidatorsFilteredEntry, 0, len(pageData.Validators)),
+	}
+	if pageData.IsHistoric {
+		atEpoch := pageData.HistoricEpoch
+		response.AtEpoch = &atEpoch
+	}
+	if pageData.FilterStatus != "" {
+		response.Filter.Status = strings.Split(pageData.FilterStatus, ",")
+	}
+	if pageData.NextPageIndex > 0 {
+		response.NextPageApi = fmt.Sprintf("%v&o=%v&s=%v", strings.Replace(pageData.FilteredPageLink, "/validators?", "/api/v1/validators/filtered?", 1), pageData.Sorting, pageData.NextPageValIdx)
+	}
+
+	for _, validator := range pageData.Validators {
+		entry := &models.ApiValidatorsFilteredEntry{
+			Index:            validator.Index,
+			Name:             validator.Name,
+			PublicKey:        validator.PublicKey,
+			State:            validator.State,
+			Balance:          validator.Balance,
+			EffectiveBalance: validator.EffectiveBalance,
+		}
+		if validator.ShowActivation {
+			activationEpoch := validator.ActivationEpoch
+			entry.ActivationEpoch = &activationEpoch
+		}
+		if validator.ShowExit {
+			exitEpoch := validator.ExitEpoch
+			entry.ExitEpoch = &exitEpoch
+		}
+		if validator.ShowWithdrawAddress {
+			entry.WithdrawAddress = validator.WithdrawAddress
+		}
+		if validator.HasApy {
+			apy := validator.Apy
+			entry.Apy = &apy
+		}
+		if validator.ShowUpcheck {
+			upcheck := validator.UpcheckActivity
+			entry.Upcheck = &upcheck
+		}
+		response.Validators = append(response.Validators, entry)
+	}
+	response.Count = uint64(len(response.Validators))
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding filtered validators")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
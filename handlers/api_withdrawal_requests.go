@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ApiWithdrawalRequests returns the filtered list of execution layer withdrawal requests as json.
+// it accepts the same query parameters as /validators/el_withdrawals.
+func ApiWithdrawalRequests(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	pageArgs := parseElWithdrawalsPageArgs(r.URL.Query())
+
+	err := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	pageData, err := getFilteredElWithdrawalsPageData(r.Context(), pageArgs.PageIdx, pageArgs.PageSize, pageArgs.MinSlot, pageArgs.MaxSlot, pageArgs.SourceAddr, pageArgs.MinIndex, pageArgs.MaxIndex, pageArgs.ValidatorName, pageArgs.WithOrphaned, pageArgs.WithType, pageArgs.PubKey)
+	if err != nil {
+		logrus.WithError(err).Error("error loading withdrawal requests")
+		http.Error(w, "failed loading withdrawal requests", http.StatusServiceUnavailable)
+		return
+	}
+
+	response := &models.ApiWithdrawalRequestsResponse{
+		Filter: models.ApiWithdrawalRequestsFilter{
+			MinSlot:       pageArgs.MinSlot,
+			MaxSlot:       pageArgs.MaxSlot,
+			SourceAddress: pageArgs.SourceAddr,
+			MinIndex:      pageArgs.MinIndex,
+			MaxIndex:      pageArgs.MaxIndex,
+			ValidatorName: pageArgs.ValidatorName,
+			PubKey:        pageArgs.PubKey,
+			WithOrphaned:  pageArgs.WithOrphaned,
+			WithType:      pageArgs.WithType,
+		},
+		PageIndex:          pageData.CurrentPageIndex,
+		PageSize:           pageData.PageSize,
+		TotalPages:         pageData.TotalPages,
+		WithdrawalRequests: make([]*models.ApiWithdrawalRequestsEntry, 0, len(pageData.ElRequests)),
+	}
+	if pageData.NextPageIndex > 0 {
+		response.NextPageApi = strings.Replace(pageData.NextPageLink, "/validators/el_withdrawals?", "/api/v1/withdrawal_requests?", 1)
+	}
+
+	for _, request := range pageData.ElRequests {
+		entry := &models.ApiWithdrawalRequestsEntry{
+			Slot:          request.SlotNumber,
+			Time:          request.Time,
+			SourceAddress: request.SourceAddr,
+			Amount:        request.Amount,
+			PublicKey:     request.PublicKey,
+		}
+
+		switch request.Status {
+		case 1:
+			entry.Status = "included"
+		case 2:
+			entry.Status = "orphaned"
+		default:
+			entry.Status = "pending"
+		}
+		if request.IsIncluded {
+			entry.SlotRoot = request.SlotRoot
+		}
+		if request.ValidatorValid {
+			validatorIndex := request.ValidatorIndex
+			entry.ValidatorIndex = &validatorIndex
+			entry.ValidatorName = request.ValidatorName
+		}
+
+		if request.LinkedTransaction {
+			entry.TxHash = request.TransactionHash
+			switch request.TxStatus {
+			case 1:
+				entry.TxStatus = "included"
+			case 2:
+				entry.TxStatus = "orphaned"
+			}
+			if request.TransactionDetails != nil {
+				entry.TxBlockNumber = request.TransactionDetails.BlockNumber
+				entry.TxOrigin = request.TransactionDetails.TxOrigin
+				entry.TxFee = request.TransactionDetails.TxFee
+			}
+		}
+
+		response.WithdrawalRequests = append(response.WithdrawalRequests, entry)
+	}
+	response.Count = uint64(len(response.WithdrawalRequests))
+
+	err = encodeApiResponse(w, r, response)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding withdrawal requests")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
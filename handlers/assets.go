@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/ethpandaops/dora/utils"
+)
+
+// StaticAssetCaching serves fingerprinted asset paths from the original asset with far-future cache headers.
+// fingerprinted paths change with the asset content, so the responses can be cached forever by browsers & cdns.
+func StaticAssetCaching(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	if assetPath, found := utils.ResolveAssetPath(r.URL.Path); found {
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		// webfonts are loaded cross-origin when the assets are served from a cdn
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+
+		assetRequest := r.Clone(r.Context())
+		assetRequest.URL.Path = assetPath
+		assetRequest.URL.RawPath = ""
+		r = assetRequest
+	}
+	next(w, r)
+}
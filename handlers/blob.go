@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// blobPreviewSize is the max. number of blob bytes shown in the hex & utf8 previews
+const blobPreviewSize = 4096
+
+// Blob will return the "blob" page using a go template
+// the blob can be referenced by its kzg commitment or versioned hash, ?download returns the blob data as file.
+func Blob(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"blob/blob.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "blockchain", "/blobs", "Blob", templateFiles)
+
+	if !utils.Config().BlobIndexer.Enabled {
+		handlePageError(w, r, errors.New("blob indexer is not enabled"))
+		return
+	}
+
+	hash, err := hex.DecodeString(strings.TrimPrefix(mux.Vars(r)["hash"], "0x"))
+	if err != nil || (len(hash) != 48 && len(hash) != 32) {
+		handlePageError(w, r, errors.New("invalid blob commitment or versioned hash"))
+		return
+	}
+
+	err = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if err != nil {
+		handlePageError(w, r, err)
+		return
+	}
+
+	blobEntries := services.GlobalBeaconService.GetBlobSidecarsByHash(r.Context(), hash)
+	if len(blobEntries) == 0 {
+		NotFound(w, r)
+		return
+	}
+
+	// prefer a canonical inclusion for loading the blob data
+	sidecar := blobEntries[0].Sidecar
+	for _, blobEntry := range blobEntries {
+		if !blobEntry.Orphaned {
+			sidecar = blobEntry.Sidecar
+			break
+		}
+	}
+	blobData, dataErr := services.GlobalBeaconService.GetBlobContents(r.Context(), sidecar)
+
+	if r.URL.Query().Has("download") {
+		if dataErr != nil {
+			handlePageError(w, r, fmt.Errorf("blob data not available: %v", dataErr))
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"blob-0x%x.bin\"", sidecar.Commitment))
+		w.Write(blobData)
+		return
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	pageData := &models.BlobPageData{
+		Commitment:    sidecar.Commitment,
+		VersionedHash: sidecar.VersionedHash,
+		Proof:         sidecar.Proof,
+		Size:          uint64(sidecar.Size),
+		Stored:        sidecar.Stored,
+	}
+	for _, blobEntry := range blobEntries {
+		pageData.Inclusions = append(pageData.Inclusions, &models.BlobPageDataInclusion{
+			Slot:         blobEntry.Sidecar.Slot,
+			BlockRoot:    blobEntry.Sidecar.BlockRoot,
+			Time:         chainState.SlotToTime(phase0.Slot(blobEntry.Sidecar.Slot)),
+			Orphaned:     blobEntry.Orphaned,
+			BlobIndex:    blobEntry.Sidecar.BlobIndex,
+			Proposer:     blobEntry.Sidecar.Proposer,
+			ProposerName: services.GlobalBeaconService.GetValidatorName(blobEntry.Sidecar.Proposer),
+		})
+	}
+
+	if dataErr != nil {
+		pageData.DataError = dataErr.Error()
+	} else {
+		pageData.HaveData = true
+		preview := blobData
+		if len(preview) > blobPreviewSize {
+			preview = preview[:blobPreviewSize]
+			pageData.IsShortPreview = true
+		}
+		pageData.PreviewSize = uint64(len(preview))
+		pageData.HexPreview = formatBlobHexPreview(preview)
+		pageData.Utf8Preview = formatBlobUtf8Preview(preview)
+	}
+	data.Data = pageData
+
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "blob.go", "Blob", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+// formatBlobHexPreview formats the blob data as hex with one 32 byte field element per line
+func formatBlobHexPreview(data []byte) string {
+	var preview strings.Builder
+	for offset := 0; offset < len(data); offset += 32 {
+		end := offset + 32
+		if end > len(data) {
+			end = len(data)
+		}
+		fmt.Fprintf(&preview, "%06x  %x\n", offset, data[offset:end])
+	}
+	return preview.String()
+}
+
+// formatBlobUtf8Preview decodes the blob data as utf8, invalid sequences & control characters are replaced with dots
+func formatBlobUtf8Preview(data []byte) string {
+	return strings.Map(func(r rune) rune {
+		if r == unicode.ReplacementChar || (unicode.IsControl(r) && r != '\n' && r != '\t') {
+			return '.'
+		}
+		return r
+	}, strings.ToValidUTF8(string(data), "�"))
+}
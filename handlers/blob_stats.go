@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// periods selectable on the blob stats page
+var blobStatsPeriods = []struct {
+	key      string
+	label    string
+	duration time.Duration
+}{
+	{"1h", "Last hour", 1 * time.Hour},
+	{"6h", "Last 6 hours", 6 * time.Hour},
+	{"24h", "Last 24 hours", 24 * time.Hour},
+}
+
+// BlobStats will return the "blob stats" page using a go template
+func BlobStats(w http.ResponseWriter, r *http.Request) {
+	var blobStatsTemplateFiles = append(layoutTemplateFiles,
+		"blob_stats/blob_stats.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(blobStatsTemplateFiles...)
+	data := InitPageData(w, r, "blockchain", "/blobs/stats", "Blob Stats", blobStatsTemplateFiles)
+
+	period := r.URL.Query().Get("period")
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getBlobStatsPageData(r.Context(), period)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "blob_stats.go", "Blob Stats", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getBlobStatsPageData(ctx context.Context, period string) (*models.BlobStatsPageData, error) {
+	pageData := &models.BlobStatsPageData{}
+	pageCacheKey := fmt.Sprintf("blob_stats:%v", period)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildBlobStatsPageData(pageCall.CallCtx, period)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.BlobStatsPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildBlobStatsPageData(ctx context.Context, period string) (*models.BlobStatsPageData, time.Duration) {
+	logrus.Debugf("blob stats page called: %v", period)
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	pageData := &models.BlobStatsPageData{
+		PeriodOptions: make([]*models.BlobStatsPageDataPeriod, 0, len(blobStatsPeriods)),
+	}
+
+	periodDuration := blobStatsPeriods[0].duration
+	pageData.Period = blobStatsPeriods[0].key
+	for _, option := range blobStatsPeriods {
+		if option.key == period {
+			periodDuration = option.duration
+			pageData.Period = option.key
+		}
+		pageData.PeriodOptions = append(pageData.PeriodOptions, &models.BlobStatsPageDataPeriod{
+			Key:   option.key,
+			Label: option.label,
+		})
+	}
+
+	currentSlot := chainState.CurrentSlot()
+	slotCount := uint64(periodDuration / chainState.GetSpecs().SecondsPerSlot)
+	firstSlot := uint64(0)
+	if uint64(currentSlot) > slotCount {
+		firstSlot = uint64(currentSlot) - slotCount
+	}
+	pageData.FirstSlot = firstSlot
+	pageData.LastSlot = uint64(currentSlot)
+	pageData.FirstSlotTs = chainState.SlotToTime(phase0.Slot(firstSlot))
+
+	blockFilter := &dbtypes.BlockFilter{
+		WithOrphaned: 0,
+		WithMissing:  0,
+		MinSlot:      &firstSlot,
+	}
+	dbBlocks := services.GlobalBeaconService.GetDbBlocksByFilter(ctx, blockFilter, 0, uint32(slotCount+1), 0)
+
+	blobsPerTx := map[uint64]uint64{}
+	blobsPerBlock := map[uint64]uint64{}
+	for _, dbBlock := range dbBlocks {
+		if dbBlock.Block == nil || dbBlock.Slot < firstSlot {
+			continue
+		}
+
+		block := dbBlock.Block
+		blockBlobs := uint64(0)
+		for idx, txCount := range block.EthBlobTxDist {
+			blobsPerTx[uint64(idx+1)] += uint64(txCount)
+			blockBlobs += uint64(idx+1) * uint64(txCount)
+		}
+
+		pageData.BlockCount++
+		pageData.BlobTxCount += block.EthBlobTxCount
+		pageData.BlobTxSize += block.EthBlobTxSize
+		pageData.BlobCount += blockBlobs
+		if block.EthBlobTxCount > 0 {
+			pageData.BlobBlockCount++
+		}
+		blobsPerBlock[blockBlobs]++
+	}
+
+	if pageData.BlockCount > 0 {
+		pageData.BlobBlockPercent = float64(pageData.BlobBlockCount) * 100 / float64(pageData.BlockCount)
+		pageData.AvgBlobsPerBlock = float64(pageData.BlobCount) / float64(pageData.BlockCount)
+		pageData.AvgBlobTxsPerBlock = float64(pageData.BlobTxCount) / float64(pageData.BlockCount)
+	}
+	if pageData.BlobTxCount > 0 {
+		pageData.AvgBlobsPerTx = float64(pageData.BlobCount) / float64(pageData.BlobTxCount)
+		pageData.AvgBlobTxSize = pageData.BlobTxSize / pageData.BlobTxCount
+	}
+
+	pageData.BlobsPerTx = buildBlobStatsDistribution(blobsPerTx, pageData.BlobTxCount)
+	pageData.BlobsPerBlock = buildBlobStatsDistribution(blobsPerBlock, pageData.BlockCount)
+
+	return pageData, 1 * time.Minute
+}
+
+func buildBlobStatsDistribution(counts map[uint64]uint64, total uint64) []*models.BlobStatsPageDataDistribution {
+	distribution := make([]*models.BlobStatsPageDataDistribution, 0, len(counts))
+	for blobCount, count := range counts {
+		entry := &models.BlobStatsPageDataDistribution{
+			BlobCount: blobCount,
+			Count:     count,
+		}
+		if total > 0 {
+			entry.Percent = float64(count) * 100 / float64(total)
+		}
+		distribution = append(distribution, entry)
+	}
+	sort.Slice(distribution, func(a, b int) bool {
+		return distribution[a].BlobCount < distribution[b].BlobCount
+	})
+	return distribution
+}
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// Blobs will return the filtered "blobs" page using a go template
+func Blobs(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"blobs/blobs.html",
+		"_svg/professor.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "blockchain", "/blobs", "Blobs", templateFiles)
+
+	if !utils.Config().BlobIndexer.Enabled {
+		handlePageError(w, r, errors.New("blob indexer is not enabled"))
+		return
+	}
+
+	urlArgs := r.URL.Query()
+	var pageSize uint64 = 50
+	if urlArgs.Has("c") {
+		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	var pageIdx uint64 = 1
+	if urlArgs.Has("p") {
+		pageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+		if pageIdx < 1 {
+			pageIdx = 1
+		}
+	}
+
+	var minSlot uint64
+	var maxSlot uint64
+	var proposer string
+
+	if urlArgs.Has("f") {
+		if urlArgs.Has("f.mins") {
+			minSlot, _ = strconv.ParseUint(urlArgs.Get("f.mins"), 10, 64)
+		}
+		if urlArgs.Has("f.maxs") {
+			maxSlot, _ = strconv.ParseUint(urlArgs.Get("f.maxs"), 10, 64)
+		}
+		if urlArgs.Has("f.proposer") {
+			proposer = urlArgs.Get("f.proposer")
+		}
+	}
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getFilteredBlobsPageData(r.Context(), pageIdx, pageSize, minSlot, maxSlot, proposer)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "blobs.go", "Blobs", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getFilteredBlobsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, proposer string) (*models.BlobsPageData, error) {
+	pageData := &models.BlobsPageData{}
+	pageCacheKey := fmt.Sprintf("blobs:%v:%v:%v:%v:%v", pageIdx, pageSize, minSlot, maxSlot, proposer)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		return buildFilteredBlobsPageData(pageCall.CallCtx, pageIdx, pageSize, minSlot, maxSlot, proposer)
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.BlobsPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildFilteredBlobsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, proposer string) *models.BlobsPageData {
+	filterArgs := url.Values{}
+	if minSlot != 0 {
+		filterArgs.Add("f.mins", fmt.Sprintf("%v", minSlot))
+	}
+	if maxSlot != 0 {
+		filterArgs.Add("f.maxs", fmt.Sprintf("%v", maxSlot))
+	}
+	if proposer != "" {
+		filterArgs.Add("f.proposer", proposer)
+	}
+
+	pageData := &models.BlobsPageData{
+		FilterMinSlot:  minSlot,
+		FilterMaxSlot:  maxSlot,
+		FilterProposer: proposer,
+	}
+	logrus.Debugf("blobs page called: %v:%v [%v,%v,%v]", pageIdx, pageSize, minSlot, maxSlot, proposer)
+	if pageIdx == 1 {
+		pageData.IsDefaultPage = true
+	}
+
+	if pageSize == 0 {
+		pageSize = 50
+	} else if pageSize > 100 {
+		pageSize = 100
+	}
+	pageData.PageSize = pageSize
+	pageData.TotalPages = pageIdx
+	pageData.CurrentPageIndex = pageIdx
+	if pageIdx > 1 {
+		pageData.PrevPageIndex = pageIdx - 1
+	}
+
+	// load blob sidecars
+	blobFilter := &dbtypes.BlobSidecarFilter{
+		MinSlot: minSlot,
+		MaxSlot: maxSlot,
+	}
+	if proposer != "" {
+		proposerIndex, err := strconv.ParseUint(proposer, 10, 64)
+		if err == nil {
+			blobFilter.Proposer = &proposerIndex
+		}
+	}
+
+	blobEntries, totalRows := services.GlobalBeaconService.GetBlobSidecarsByFilter(ctx, blobFilter, pageIdx-1, uint32(pageSize))
+
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	for _, blobEntry := range blobEntries {
+		sidecar := blobEntry.Sidecar
+		pageData.Blobs = append(pageData.Blobs, &models.BlobsPageDataBlob{
+			Slot:          sidecar.Slot,
+			BlockRoot:     sidecar.BlockRoot,
+			Time:          chainState.SlotToTime(phase0.Slot(sidecar.Slot)),
+			Orphaned:      blobEntry.Orphaned,
+			BlobIndex:     sidecar.BlobIndex,
+			Proposer:      sidecar.Proposer,
+			ProposerName:  services.GlobalBeaconService.GetValidatorName(sidecar.Proposer),
+			Commitment:    sidecar.Commitment,
+			VersionedHash: sidecar.VersionedHash,
+			Size:          uint64(sidecar.Size),
+			Stored:        sidecar.Stored,
+		})
+	}
+	pageData.BlobCount = uint64(len(pageData.Blobs))
+
+	if pageData.BlobCount > 0 {
+		pageData.FirstIndex = pageData.Blobs[0].Slot
+		pageData.LastIndex = pageData.Blobs[pageData.BlobCount-1].Slot
+	}
+
+	pageData.TotalPages = totalRows / pageSize
+	if totalRows%pageSize > 0 {
+		pageData.TotalPages++
+	}
+	pageData.LastPageIndex = pageData.TotalPages
+	if pageIdx < pageData.TotalPages {
+		pageData.NextPageIndex = pageIdx + 1
+	}
+
+	pageData.FirstPageLink = fmt.Sprintf("/blobs?f&%v&c=%v", filterArgs.Encode(), pageData.PageSize)
+	pageData.PrevPageLink = fmt.Sprintf("/blobs?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.PrevPageIndex)
+	pageData.NextPageLink = fmt.Sprintf("/blobs?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.NextPageIndex)
+	pageData.LastPageLink = fmt.Sprintf("/blobs?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.LastPageIndex)
+
+	return pageData
+}
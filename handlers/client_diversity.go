@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+const (
+	clientDiversityChartWidth  = 800
+	clientDiversityChartHeight = 200
+)
+
+var clientDiversityDayOptions = []uint64{7, 30, 90, 180, 365}
+
+type clientDiversityClient struct {
+	name  string
+	color string
+}
+
+// clientDiversityClients are the display names & chart colors of the client ids used by the client diversity classification
+var clientDiversityClients = map[string]clientDiversityClient{
+	"lighthouse":                    {"Lighthouse", "#6f42c1"},
+	"prysm":                         {"Prysm", "#dc3545"},
+	"teku":                          {"Teku", "#fd7e14"},
+	"nimbus":                        {"Nimbus", "#198754"},
+	"lodestar":                      {"Lodestar", "#0dcaf0"},
+	"grandine":                      {"Grandine", "#d63384"},
+	"geth":                          {"Geth", "#0d6efd"},
+	"nethermind":                    {"Nethermind", "#20c997"},
+	"besu":                          {"Besu", "#ffc107"},
+	"erigon":                        {"Erigon", "#fd7e14"},
+	"reth":                          {"Reth", "#dc3545"},
+	"ethereumjs":                    {"EthereumJS", "#6610f2"},
+	services.ClientDiversityUnknown: {"Unknown", "#adb5bd"},
+}
+
+// ClientDiversity will return the "client diversity" page using a go template, which shows the share of the
+// consensus & execution clients of the block proposers, classified from the block graffitis, extra data, gas limit votes & attestation timing
+func ClientDiversity(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"client_diversity/client_diversity.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "clients", "/clients", "Client Diversity", templateFiles)
+
+	urlArgs := r.URL.Query()
+	var days uint64 = 30
+	if urlArgs.Has("d") {
+		days, _ = strconv.ParseUint(urlArgs.Get("d"), 10, 64)
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getClientDiversityPageData(r.Context(), days)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "client_diversity.go", "Client Diversity", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getClientDiversityPageData(ctx context.Context, days uint64) (*models.ClientDiversityPageData, error) {
+	pageData := &models.ClientDiversityPageData{}
+	pageCacheKey := fmt.Sprintf("client_diversity:%v", days)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		return buildClientDiversityPageData(pageCall.CallCtx, days)
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ClientDiversityPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildClientDiversityPageData(ctx context.Context, days uint64) *models.ClientDiversityPageData {
+	logrus.Debugf("client diversity page called: %v", days)
+
+	validDays := false
+	for _, option := range clientDiversityDayOptions {
+		if option == days {
+			validDays = true
+		}
+	}
+	if !validDays {
+		days = 30
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	indexedSlot, bucketEpochs := services.GlobalBeaconService.GetClientDiversityState(ctx)
+	pageData := &models.ClientDiversityPageData{
+		Days:         days,
+		DayOptions:   clientDiversityDayOptions,
+		IndexedSlot:  indexedSlot,
+		BucketEpochs: bucketEpochs,
+		ChartWidth:   clientDiversityChartWidth,
+		ChartHeight:  clientDiversityChartHeight,
+	}
+
+	indexedEpoch := uint64(chainState.EpochOfSlot(phase0.Slot(indexedSlot)))
+	if rangeEpochs := days * bucketEpochs; indexedEpoch > rangeEpochs {
+		pageData.FirstEpoch = indexedEpoch - rangeEpochs
+		pageData.FirstEpoch -= pageData.FirstEpoch % bucketEpochs
+	}
+
+	entries, err := db.GetClientDiversity(ctx, pageData.FirstEpoch)
+	if err != nil {
+		logrus.Warnf("failed loading client diversity: %v", err)
+	}
+
+	for _, layer := range []struct {
+		layer dbtypes.ClientLayer
+		name  string
+	}{
+		{dbtypes.ClientLayerConsensus, "Consensus"},
+		{dbtypes.ClientLayerExecution, "Execution"},
+	} {
+		layerEntries := []*dbtypes.ClientDiversityEntry{}
+		for _, entry := range entries {
+			if entry.Layer == layer.layer {
+				layerEntries = append(layerEntries, entry)
+			}
+		}
+		pageData.Layers = append(pageData.Layers, buildClientDiversityLayer(layer.name, layerEntries, chainState))
+	}
+
+	return pageData
+}
+
+// buildClientDiversityLayer sums up the client shares of a layer and builds the stacked daily share bars.
+func buildClientDiversityLayer(name string, entries []*dbtypes.ClientDiversityEntry, chainState *consensus.ChainState) *models.ClientDiversityPageDataLayer {
+	layerData := &models.ClientDiversityPageDataLayer{
+		Name: name,
+	}
+
+	clientMap := map[string]*models.ClientDiversityPageDataClient{}
+	bucketTotals := map[uint64]uint64{}
+	bucketEpochs := []uint64{}
+	identifiedBlocks := uint64(0)
+	for _, entry := range entries {
+		client := clientMap[entry.Client]
+		if client == nil {
+			clientInfo, found := clientDiversityClients[entry.Client]
+			if !found {
+				clientInfo = clientDiversityClient{entry.Client, "#6c757d"}
+			}
+			client = &models.ClientDiversityPageDataClient{
+				Name:    clientInfo.name,
+				Color:   clientInfo.color,
+				Unknown: entry.Client == services.ClientDiversityUnknown,
+			}
+			clientMap[entry.Client] = client
+			layerData.Clients = append(layerData.Clients, client)
+		}
+		client.BlockCount += entry.BlockCount
+		layerData.TotalBlocks += entry.BlockCount
+		if !client.Unknown {
+			identifiedBlocks += entry.BlockCount
+		}
+
+		if _, found := bucketTotals[entry.BucketEpoch]; !found {
+			bucketEpochs = append(bucketEpochs, entry.BucketEpoch)
+		}
+		bucketTotals[entry.BucketEpoch] += entry.BlockCount
+	}
+	if layerData.TotalBlocks == 0 {
+		return layerData
+	}
+
+	// identified clients by share, unknown blocks last
+	sort.SliceStable(layerData.Clients, func(a, b int) bool {
+		if layerData.Clients[a].Unknown != layerData.Clients[b].Unknown {
+			return !layerData.Clients[a].Unknown
+		}
+		return layerData.Clients[a].BlockCount > layerData.Clients[b].BlockCount
+	})
+	layerData.IdentifiedShare = float64(identifiedBlocks) * 100 / float64(layerData.TotalBlocks)
+	for _, client := range layerData.Clients {
+		client.Share = float64(client.BlockCount) * 100 / float64(layerData.TotalBlocks)
+		if client.Unknown || identifiedBlocks == 0 {
+			continue
+		}
+
+		// a client above 2/3 could finalize an invalid chain on its own, above 1/3 it can prevent finality
+		client.IdentifiedShare = float64(client.BlockCount) * 100 / float64(identifiedBlocks)
+		if client.IdentifiedShare > 200.0/3 {
+			client.ShareLevel = "danger"
+		} else if client.IdentifiedShare > 100.0/3 {
+			client.ShareLevel = "warning"
+		}
+	}
+
+	clientOrder := map[string]int{}
+	for idx, client := range layerData.Clients {
+		clientOrder[client.Name] = idx
+	}
+
+	barWidth := float64(clientDiversityChartWidth) / float64(len(bucketEpochs))
+	bucketSegments := map[uint64][]*dbtypes.ClientDiversityEntry{}
+	for _, entry := range entries {
+		bucketSegments[entry.BucketEpoch] = append(bucketSegments[entry.BucketEpoch], entry)
+	}
+	for idx, bucketEpoch := range bucketEpochs {
+		bar := &models.ClientDiversityPageDataBar{
+			X:     float64(idx) * barWidth,
+			Width: barWidth,
+			Title: fmt.Sprintf("%v (epoch %v)", chainState.EpochToTime(phase0.Epoch(bucketEpoch)).Format("2006-01-02"), bucketEpoch),
+		}
+
+		segments := bucketSegments[bucketEpoch]
+		sort.SliceStable(segments, func(a, b int) bool {
+			return clientOrder[clientMap[segments[a].Client].Name] < clientOrder[clientMap[segments[b].Client].Name]
+		})
+
+		y := float64(clientDiversityChartHeight)
+		for _, segment := range segments {
+			client := clientMap[segment.Client]
+			height := float64(segment.BlockCount) * clientDiversityChartHeight / float64(bucketTotals[bucketEpoch])
+			y -= height
+			bar.Segments = append(bar.Segments, &models.ClientDiversityPageDataBarSegment{
+				Y:      y,
+				Height: height,
+				Color:  client.Color,
+				Title:  fmt.Sprintf("%v: %.2f%% (%v blocks)", client.Name, float64(segment.BlockCount)*100/float64(bucketTotals[bucketEpoch]), segment.BlockCount),
+			})
+		}
+
+		layerData.Bars = append(layerData.Bars, bar)
+	}
+
+	return layerData
+}
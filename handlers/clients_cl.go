@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"sort"
@@ -29,7 +30,7 @@ func ClientsCL(w http.ResponseWriter, r *http.Request) {
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
 	if pageError == nil {
-		data.Data, pageError = getCLClientsPageData()
+		data.Data, pageError = getCLClientsPageData(r.Context())
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -41,11 +42,11 @@ func ClientsCL(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getCLClientsPageData() (*models.ClientsCLPageData, error) {
+func getCLClientsPageData(ctx context.Context) (*models.ClientsCLPageData, error) {
 	pageData := &models.ClientsCLPageData{}
 	pageCacheKey := "clients/consensus"
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
-		pageData, cacheTimeout := buildCLClientsPageData()
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildCLClientsPageData(pageCall.CallCtx)
 		pageCall.CacheTimeout = cacheTimeout
 		return pageData
 	})
@@ -144,13 +145,13 @@ func buildCLPeerMapData() *models.ClientCLPageDataPeerMap {
 	return peerMap
 }
 
-func buildCLClientsPageData() (*models.ClientsCLPageData, time.Duration) {
+func buildCLClientsPageData(ctx context.Context) (*models.ClientsCLPageData, time.Duration) {
 	logrus.Debugf("clients page called")
 	pageData := &models.ClientsCLPageData{
 		Clients:                []*models.ClientsCLPageDataClient{},
 		PeerMap:                buildCLPeerMapData(),
-		ShowSensitivePeerInfos: utils.Config.Frontend.ShowSensitivePeerInfos,
-		ShowPeerDASInfos:       utils.Config.Frontend.ShowPeerDASInfos,
+		ShowSensitivePeerInfos: utils.Config().Frontend.ShowSensitivePeerInfos,
+		ShowPeerDASInfos:       utils.Config().Frontend.ShowPeerDASInfos,
 		PeerDASInfos: &models.ClientCLPagePeerDAS{
 			Warnings: models.ClientCLPageDataPeerDASWarnings{
 				MissingENRsPeers:       []string{},
@@ -343,6 +344,26 @@ func buildCLClientsPageData() (*models.ClientsCLPageData, time.Duration) {
 			resClient.LastError = lastError.Error()
 		}
 
+		if syncProgress := client.GetSyncProgress(); syncProgress != nil {
+			resClient.IsSyncing = true
+			resClient.SyncPercent = syncProgress.Percent()
+		}
+
+		streamHealth := client.GetStreamHealth()
+		resClient.StreamConnected = streamHealth.Connected
+		resClient.StreamResubscribes = streamHealth.Resubscribes
+		for _, topic := range streamHealth.Topics {
+			resClient.StreamTopics = append(resClient.StreamTopics, &models.ClientsCLPageDataStreamTopic{
+				Topic:      topic.Topic,
+				EventCount: topic.EventCount,
+				LastEvent:  topic.LastEvent,
+				StallCount: topic.StallCount,
+			})
+			if !topic.LastStall.IsZero() && time.Since(topic.LastStall) < 10*time.Minute {
+				resClient.StreamStalled = true
+			}
+		}
+
 		pageData.Clients = append(pageData.Clients, resClient)
 
 	}
@@ -0,0 +1,295 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// number of countries & autonomous systems shown in the distribution charts
+const clientsGeoMaxBuckets = 15
+
+// ClientsCLGeo will return the "consensus peer geo" page using a go template
+func ClientsCLGeo(w http.ResponseWriter, r *http.Request) {
+	var clientsGeoTemplateFiles = append(layoutTemplateFiles,
+		"clients/clients_cl_geo.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(clientsGeoTemplateFiles...)
+	data := InitPageData(w, r, "clients/consensus", "/clients/consensus/geo", "Consensus peer locations", clientsGeoTemplateFiles)
+
+	filterClient := r.URL.Query().Get("client")
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getCLClientsGeoPageData(r.Context(), filterClient)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "clients_cl_geo.go", "Consensus peer locations", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getCLClientsGeoPageData(ctx context.Context, filterClient string) (*models.ClientsCLGeoPageData, error) {
+	pageData := &models.ClientsCLGeoPageData{}
+	pageCacheKey := fmt.Sprintf("clients/consensus/geo:%v", filterClient)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildCLClientsGeoPageData(pageCall.CallCtx, filterClient)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ClientsCLGeoPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildCLClientsGeoPageData(ctx context.Context, filterClient string) (*models.ClientsCLGeoPageData, time.Duration) {
+	logrus.Debugf("clients geo page called: %v", filterClient)
+
+	pageData := &models.ClientsCLGeoPageData{
+		DatabaseConfigured: utils.Config().Frontend.PeerGeoIpDatabase != "",
+		DatabaseLoaded:     services.GlobalGeoIpService != nil && services.GlobalGeoIpService.IsLoaded(),
+		ShowIps:            utils.Config().Frontend.ShowSensitivePeerInfos,
+		FilterClient:       filterClient,
+		ClientOpts:         []string{},
+		Countries:          []*models.ClientsCLGeoPageDataBucket{},
+		Asns:               []*models.ClientsCLGeoPageDataBucket{},
+		Nodes:              []*models.ClientsCLGeoPageDataGeoNode{},
+	}
+
+	type geoNode struct {
+		node   *models.ClientsCLGeoPageDataGeoNode
+		ip     netip.Addr
+		seenBy map[string]bool
+	}
+	nodes := map[string]*geoNode{}
+
+	getNode := func(peerId string) *geoNode {
+		node := nodes[peerId]
+		if node == nil {
+			node = &geoNode{
+				node: &models.ClientsCLGeoPageDataGeoNode{
+					PeerID: peerId,
+					Alias:  peerId,
+					Type:   "external",
+				},
+				seenBy: map[string]bool{},
+			}
+			nodes[peerId] = node
+		}
+		return node
+	}
+
+	// the address a peer is connected from takes precedence over the ip announced in its enr
+	setNodeIp := func(node *geoNode, p2pAddress string, enrStr string) {
+		if node.ip.IsValid() {
+			return
+		}
+		if ip, ok := getMultiaddrIp(p2pAddress); ok {
+			node.ip = ip
+		} else if ip, ok := getEnrIp(enrStr); ok {
+			node.ip = ip
+		}
+	}
+
+	for _, client := range services.GlobalBeaconService.GetConsensusClients() {
+		clientName := client.GetName()
+		pageData.ClientOpts = append(pageData.ClientOpts, clientName)
+
+		id := client.GetNodeIdentity()
+		if id == nil {
+			continue
+		}
+
+		clientNode := getNode(id.PeerID)
+		clientNode.node.Alias = clientName
+		clientNode.node.Type = "internal"
+		clientNode.seenBy[clientName] = true
+		for _, p2pAddress := range id.P2PAddresses {
+			setNodeIp(clientNode, p2pAddress, "")
+		}
+		setNodeIp(clientNode, "", id.Enr)
+
+		for _, peer := range client.GetNodePeers() {
+			peerNode := getNode(peer.PeerID)
+			peerNode.seenBy[clientName] = true
+			setNodeIp(peerNode, peer.LastSeenP2PAddress, peer.Enr)
+		}
+	}
+
+	countryMap := map[string]*models.ClientsCLGeoPageDataBucket{}
+	asnMap := map[uint64]*models.ClientsCLGeoPageDataBucket{}
+
+	for _, node := range nodes {
+		if filterClient != "" && !node.seenBy[filterClient] {
+			continue
+		}
+
+		for clientName := range node.seenBy {
+			node.node.SeenBy = append(node.node.SeenBy, clientName)
+		}
+		sort.Strings(node.node.SeenBy)
+		pageData.Nodes = append(pageData.Nodes, node.node)
+
+		if !node.ip.IsValid() {
+			continue
+		}
+		if pageData.ShowIps {
+			node.node.IpPrefix = services.AnonymizeIp(node.ip).String()
+		}
+
+		var geoInfo *services.GeoIpInfo
+		if pageData.DatabaseLoaded {
+			geoInfo = services.GlobalGeoIpService.Lookup(node.ip)
+		}
+		if geoInfo == nil {
+			continue
+		}
+
+		pageData.LocatedCount++
+		node.node.Country = geoInfo.Country
+		node.node.Asn = geoInfo.Asn
+		node.node.AsName = geoInfo.AsName
+
+		country := countryMap[geoInfo.Country]
+		if country == nil {
+			country = &models.ClientsCLGeoPageDataBucket{
+				Key:  geoInfo.Country,
+				Name: geoInfo.Country,
+			}
+			countryMap[geoInfo.Country] = country
+		}
+		country.Count++
+
+		asn := asnMap[geoInfo.Asn]
+		if asn == nil {
+			asn = &models.ClientsCLGeoPageDataBucket{
+				Key:  fmt.Sprintf("AS%v", geoInfo.Asn),
+				Name: geoInfo.AsName,
+			}
+			asnMap[geoInfo.Asn] = asn
+		}
+		asn.Count++
+	}
+	pageData.NodeCount = uint64(len(pageData.Nodes))
+	pageData.CountryCount = uint64(len(countryMap))
+	pageData.AsnCount = uint64(len(asnMap))
+
+	for _, country := range countryMap {
+		pageData.Countries = append(pageData.Countries, country)
+	}
+	for _, asn := range asnMap {
+		pageData.Asns = append(pageData.Asns, asn)
+	}
+	pageData.Countries = getClientsGeoTopBuckets(pageData.Countries, pageData.LocatedCount)
+	pageData.Asns = getClientsGeoTopBuckets(pageData.Asns, pageData.LocatedCount)
+
+	sort.Slice(pageData.Nodes, func(a, b int) bool {
+		nodeA := pageData.Nodes[a]
+		nodeB := pageData.Nodes[b]
+		if nodeA.Type != nodeB.Type {
+			return nodeA.Type == "internal"
+		}
+		if nodeA.Country != nodeB.Country {
+			return nodeA.Country < nodeB.Country
+		}
+		return nodeA.Alias < nodeB.Alias
+	})
+
+	return pageData, 1 * time.Minute
+}
+
+// getClientsGeoTopBuckets sorts the buckets by node count and merges the buckets beyond the chart limit into "other".
+func getClientsGeoTopBuckets(buckets []*models.ClientsCLGeoPageDataBucket, totalCount uint64) []*models.ClientsCLGeoPageDataBucket {
+	sort.Slice(buckets, func(a, b int) bool {
+		if buckets[a].Count != buckets[b].Count {
+			return buckets[a].Count > buckets[b].Count
+		}
+		return buckets[a].Key < buckets[b].Key
+	})
+
+	if len(buckets) > clientsGeoMaxBuckets {
+		other := &models.ClientsCLGeoPageDataBucket{
+			Key:  "other",
+			Name: fmt.Sprintf("%v others", len(buckets)-clientsGeoMaxBuckets+1),
+		}
+		for _, bucket := range buckets[clientsGeoMaxBuckets-1:] {
+			other.Count += bucket.Count
+		}
+		buckets = append(buckets[:clientsGeoMaxBuckets-1], other)
+	}
+
+	for _, bucket := range buckets {
+		if totalCount > 0 {
+			bucket.Percent = float64(bucket.Count) * 100 / float64(totalCount)
+		}
+	}
+	return buckets
+}
+
+// getMultiaddrIp returns the ip of a multiaddr like /ip4/1.2.3.4/tcp/9000
+func getMultiaddrIp(address string) (netip.Addr, bool) {
+	parts := strings.Split(address, "/")
+	if len(parts) < 3 || (parts[1] != "ip4" && parts[1] != "ip6") {
+		return netip.Addr{}, false
+	}
+
+	ip, err := netip.ParseAddr(parts[2])
+	if err != nil || !isClientsGeoPublicIp(ip) {
+		return netip.Addr{}, false
+	}
+	return ip, true
+}
+
+// getEnrIp returns the ipv4 (or ipv6) address announced in an enr
+func getEnrIp(enrStr string) (netip.Addr, bool) {
+	if enrStr == "" {
+		return netip.Addr{}, false
+	}
+	rec, err := utils.DecodeENR(enrStr)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+
+	var ip4 enr.IPv4
+	if rec.Load(&ip4) == nil {
+		if ip, ok := netip.AddrFromSlice(net.IP(ip4)); ok && isClientsGeoPublicIp(ip) {
+			return ip.Unmap(), true
+		}
+	}
+	var ip6 enr.IPv6
+	if rec.Load(&ip6) == nil {
+		if ip, ok := netip.AddrFromSlice(net.IP(ip6)); ok && isClientsGeoPublicIp(ip) {
+			return ip.Unmap(), true
+		}
+	}
+	return netip.Addr{}, false
+}
+
+// isClientsGeoPublicIp returns false for private, loopback & link local ips, which cannot be located
+func isClientsGeoPublicIp(ip netip.Addr) bool {
+	return ip.IsValid() && !ip.IsPrivate() && !ip.IsLoopback() && !ip.IsLinkLocalUnicast() && !ip.IsUnspecified()
+}
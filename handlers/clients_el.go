@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"sort"
@@ -27,7 +28,7 @@ func ClientsEl(w http.ResponseWriter, r *http.Request) {
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
 	if pageError == nil {
-		data.Data, pageError = getELClientsPageData()
+		data.Data, pageError = getELClientsPageData(r.Context())
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -39,11 +40,11 @@ func ClientsEl(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getELClientsPageData() (*models.ClientsELPageData, error) {
+func getELClientsPageData(ctx context.Context) (*models.ClientsELPageData, error) {
 	pageData := &models.ClientsELPageData{}
 	pageCacheKey := "clients/execution"
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
-		pageData, cacheTimeout := buildELClientsPageData()
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildELClientsPageData(pageCall.CallCtx)
 		pageCall.CacheTimeout = cacheTimeout
 		return pageData
 	})
@@ -149,7 +150,7 @@ func buildELPeerMapData(parseEnodeRecord func(enrStr string) *enode.Node) *model
 	return peerMap
 }
 
-func buildELClientsPageData() (*models.ClientsELPageData, time.Duration) {
+func buildELClientsPageData(ctx context.Context) (*models.ClientsELPageData, time.Duration) {
 	logrus.Debugf("clients page called")
 
 	enodeMap := map[string]*enode.Node{}
@@ -170,7 +171,7 @@ func buildELClientsPageData() (*models.ClientsELPageData, time.Duration) {
 	pageData := &models.ClientsELPageData{
 		Clients:                []*models.ClientsELPageDataClient{},
 		PeerMap:                buildELPeerMapData(parseEnodeRecord),
-		ShowSensitivePeerInfos: utils.Config.Frontend.ShowSensitivePeerInfos,
+		ShowSensitivePeerInfos: utils.Config().Frontend.ShowSensitivePeerInfos,
 		Nodes:                  map[string]*models.ClientsELPageDataNode{},
 	}
 	chainState := services.GlobalBeaconService.GetChainState()
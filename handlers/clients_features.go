@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ClientsFeatures will return the "consensus client feature matrix" page using a go template
+func ClientsFeatures(w http.ResponseWriter, r *http.Request) {
+	var clientsFeaturesTemplateFiles = append(layoutTemplateFiles,
+		"clients/clients_features.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(clientsFeaturesTemplateFiles...)
+	data := InitPageData(w, r, "clients/consensus", "/clients/features", "Client feature matrix", clientsFeaturesTemplateFiles)
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data = buildClientsFeaturesPageData()
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "clients_features.go", "Client feature matrix", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func buildClientsFeaturesPageData() *models.ClientsFeaturesPageData {
+	pageData := &models.ClientsFeaturesPageData{
+		Probes:  make([]*models.ClientsFeaturesPageDataProbe, len(consensus.ClientFeatureProbes)),
+		Clients: []*models.ClientsFeaturesPageDataClient{},
+	}
+
+	for idx, probe := range consensus.ClientFeatureProbes {
+		pageData.Probes[idx] = &models.ClientsFeaturesPageDataProbe{
+			Name:     probe.Name,
+			Title:    probe.Title,
+			Method:   probe.Method,
+			Path:     probe.Path,
+			Degrades: probe.Degrades,
+		}
+	}
+
+	for _, client := range services.GlobalBeaconService.GetConsensusClients() {
+		clientData := &models.ClientsFeaturesPageDataClient{
+			Index:    int(client.GetIndex()) + 1,
+			Name:     client.GetName(),
+			Version:  client.GetVersion(),
+			Status:   client.GetStatus().String(),
+			Features: make([]*models.ClientsFeaturesPageDataFeature, len(consensus.ClientFeatureProbes)),
+		}
+		pageData.Clients = append(pageData.Clients, clientData)
+
+		featureState := client.GetFeatureState()
+		for idx, probe := range consensus.ClientFeatureProbes {
+			featureData := &models.ClientsFeaturesPageDataFeature{
+				Support: "unknown",
+			}
+			clientData.Features[idx] = featureData
+
+			if featureState == nil {
+				continue
+			}
+
+			result := featureState.Features[probe.Name]
+			if result == nil {
+				continue
+			}
+
+			featureData.StatusCode = result.StatusCode
+			featureData.Error = result.Error
+			switch result.Support {
+			case consensus.ClientFeatureSupported:
+				featureData.Support = "supported"
+			case consensus.ClientFeatureUnsupported:
+				featureData.Support = "unsupported"
+				pageData.Probes[idx].UnsupportedCount++
+			}
+		}
+
+		if featureState == nil {
+			continue
+		}
+
+		clientData.Checked = true
+		clientData.CheckedAt = featureState.CheckedAt
+		clientData.PresetBase = featureState.PresetBase
+		clientData.ConfigName = featureState.ConfigName
+		clientData.SpecCount = uint64(featureState.SpecCount)
+		clientData.SpecMissing = featureState.SpecMissing
+		clientData.SpecMissingCount = uint64(len(featureState.SpecMissing))
+		clientData.SpecCheckErr = featureState.SpecCheckErr
+	}
+	pageData.ClientCount = uint64(len(pageData.Clients))
+
+	for _, probe := range pageData.Probes {
+		if probe.UnsupportedCount > 0 {
+			pageData.DegradedCount++
+		}
+	}
+
+	return pageData
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// histogram bucket bounds in twelfths of the slot time (1s steps up to the attestation deadline with 12s slots)
+var clientsLatencyBucketBounds = []uint64{1, 2, 3, 4, 6, 8, 12}
+
+// ClientsLatency will return the "block import latency" page using a go template
+func ClientsLatency(w http.ResponseWriter, r *http.Request) {
+	var clientsLatencyTemplateFiles = append(layoutTemplateFiles,
+		"clients/clients_latency.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(clientsLatencyTemplateFiles...)
+	data := InitPageData(w, r, "clients/consensus", "/clients/latency", "Block import latency", clientsLatencyTemplateFiles)
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data = buildClientsLatencyPageData()
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "clients_latency.go", "Block import latency", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func buildClientsLatencyPageData() *models.ClientsLatencyPageData {
+	pageData := &models.ClientsLatencyPageData{
+		Clients: []*models.ClientsLatencyPageDataClient{},
+	}
+
+	slotTime := 12 * time.Second
+	if chainState := services.GlobalBeaconService.GetChainState(); chainState != nil && chainState.GetSpecs() != nil {
+		slotTime = chainState.GetSpecs().SecondsPerSlot
+	}
+	pageData.SlotSeconds = slotTime.Seconds()
+	pageData.DeadlineOffset = 100.0 / 3
+
+	bucketBounds := make([]time.Duration, len(clientsLatencyBucketBounds))
+	lastBound := float64(0)
+	for idx, bound := range clientsLatencyBucketBounds {
+		bucketBounds[idx] = slotTime * time.Duration(bound) / 12
+		pageData.BucketLabels = append(pageData.BucketLabels, fmt.Sprintf("%v-%vs", lastBound, bucketBounds[idx].Seconds()))
+		lastBound = bucketBounds[idx].Seconds()
+	}
+	pageData.BucketLabels = append(pageData.BucketLabels, fmt.Sprintf(">%vs", lastBound))
+	attestationDeadline := slotTime / 3
+
+	for _, client := range services.GlobalBeaconService.GetConsensusClients() {
+		samples := client.GetHeadLatencySamples()
+		clientData := &models.ClientsLatencyPageDataClient{
+			Index:       int(client.GetIndex()) + 1,
+			Name:        client.GetName(),
+			Version:     client.GetVersion(),
+			Status:      client.GetStatus().String(),
+			SampleCount: uint64(len(samples)),
+			Histogram:   make([]*models.ClientsLatencyPageDataBucket, len(pageData.BucketLabels)),
+		}
+		for idx, label := range pageData.BucketLabels {
+			clientData.Histogram[idx] = &models.ClientsLatencyPageDataBucket{Label: label}
+		}
+		pageData.Clients = append(pageData.Clients, clientData)
+
+		if len(samples) == 0 {
+			continue
+		}
+
+		clientData.FirstSlot = uint64(samples[0].Slot)
+		clientData.LastSlot = uint64(samples[len(samples)-1].Slot)
+
+		latencies := make([]time.Duration, len(samples))
+		totalLatency := time.Duration(0)
+		for idx, sample := range samples {
+			latencies[idx] = sample.Latency
+			totalLatency += sample.Latency
+			if sample.Latency > attestationDeadline {
+				clientData.LateCount++
+			}
+
+			bucketIdx := sort.Search(len(bucketBounds), func(i int) bool {
+				return bucketBounds[i] > sample.Latency
+			})
+			clientData.Histogram[bucketIdx].Count++
+		}
+		sort.Slice(latencies, func(a, b int) bool {
+			return latencies[a] < latencies[b]
+		})
+
+		getPercentile := func(percentile int) time.Duration {
+			return latencies[(len(latencies)-1)*percentile/100]
+		}
+		toMs := func(latency time.Duration) float64 {
+			return float64(latency.Microseconds()) / 1000
+		}
+		toSlotPercent := func(latency time.Duration) float64 {
+			percent := float64(latency) * 100 / float64(slotTime)
+			if percent > 100 {
+				percent = 100
+			}
+			return percent
+		}
+
+		clientData.AvgMs = toMs(totalLatency / time.Duration(len(latencies)))
+		clientData.P50Ms = toMs(getPercentile(50))
+		clientData.P90Ms = toMs(getPercentile(90))
+		clientData.P99Ms = toMs(getPercentile(99))
+		clientData.MaxMs = toMs(latencies[len(latencies)-1])
+		clientData.P50Percent = toSlotPercent(getPercentile(50))
+		clientData.P90Percent = toSlotPercent(getPercentile(90)) - clientData.P50Percent
+		clientData.LatePercent = float64(clientData.LateCount) * 100 / float64(len(latencies))
+
+		for _, bucket := range clientData.Histogram {
+			if bucket.Count > clientData.HistogramScale {
+				clientData.HistogramScale = bucket.Count
+			}
+		}
+		for _, bucket := range clientData.Histogram {
+			bucket.Percent = float64(bucket.Count) * 100 / float64(clientData.HistogramScale)
+		}
+	}
+	pageData.ClientCount = uint64(len(pageData.Clients))
+
+	// slowest clients first, clients without samples last
+	sort.SliceStable(pageData.Clients, func(a, b int) bool {
+		clientA := pageData.Clients[a]
+		clientB := pageData.Clients[b]
+		if (clientA.SampleCount == 0) != (clientB.SampleCount == 0) {
+			return clientB.SampleCount == 0
+		}
+		return clientA.P90Ms > clientB.P90Ms
+	})
+
+	return pageData
+}
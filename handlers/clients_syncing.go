@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ClientsSyncing will return the "client synchronization" page using a go template
+func ClientsSyncing(w http.ResponseWriter, r *http.Request) {
+	var clientsSyncingTemplateFiles = append(layoutTemplateFiles,
+		"clients/clients_syncing.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(clientsSyncingTemplateFiles...)
+	data := InitPageData(w, r, "clients/consensus", "/clients/syncing", "Client synchronization", clientsSyncingTemplateFiles)
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		// the sync progress is cheap to collect and changes with every poll, so the page is not cached
+		data.Data = buildClientsSyncingPageData()
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "clients_syncing.go", "Client synchronization", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func buildClientsSyncingPageData() *models.ClientsSyncingPageData {
+	pageData := &models.ClientsSyncingPageData{
+		Clients: []*models.ClientsSyncingPageDataClient{},
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	if chainState != nil && chainState.GetSpecs() != nil {
+		pageData.CurrentSlot = uint64(chainState.CurrentSlot())
+	}
+
+	for _, client := range services.GlobalBeaconService.GetConsensusClients() {
+		headSlot, _ := client.GetLastHead()
+		status := client.GetStatus()
+
+		clientData := &models.ClientsSyncingPageDataClient{
+			Index:       int(client.GetIndex()) + 1,
+			Name:        client.GetName(),
+			Version:     client.GetVersion(),
+			Status:      status.String(),
+			HeadSlot:    uint64(headSlot),
+			SyncPercent: 100,
+		}
+		if lastError := client.GetLastClientError(); lastError != nil {
+			clientData.LastError = lastError.Error()
+		}
+
+		switch status {
+		case consensus.ClientStatusOnline, consensus.ClientStatusOptimistic:
+			pageData.ReadyCount++
+		case consensus.ClientStatusOffline:
+			clientData.SyncPercent = 0
+		}
+
+		if progress := client.GetSyncProgress(); progress != nil {
+			pageData.SyncingCount++
+			clientData.IsSyncing = true
+			clientData.HeadSlot = uint64(progress.HeadSlot)
+			clientData.SyncDistance = uint64(progress.SyncDistance)
+			clientData.SyncPercent = progress.Percent()
+			clientData.SlotsPerSecond = progress.SlotsPerSecond
+			clientData.SyncingSince = progress.SyncingSince
+			clientData.LastUpdate = progress.LastUpdate
+			if progress.Eta > 0 {
+				clientData.HasEta = true
+				clientData.Eta = progress.LastUpdate.Add(progress.Eta).Round(time.Second)
+			}
+		}
+
+		pageData.Clients = append(pageData.Clients, clientData)
+	}
+	pageData.ClientCount = uint64(len(pageData.Clients))
+	pageData.IndexerWaiting = pageData.ReadyCount == 0 && pageData.SyncingCount > 0
+
+	return pageData
+}
@@ -0,0 +1,243 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	execindexer "github.com/ethpandaops/dora/indexer/execution"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ContractLogs will return the filtered "contract_logs" page listing the indexed logs of the watched contracts
+func ContractLogs(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"contract_logs/contract_logs.html",
+		"_svg/professor.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "blockchain", "/contracts/logs", "Contract Logs", templateFiles)
+
+	if services.GlobalBeaconService.GetWatchedContractIndexer() == nil {
+		handlePageError(w, r, errors.New("no watched contracts configured"))
+		return
+	}
+
+	urlArgs := r.URL.Query()
+	var pageSize uint64 = 50
+	if urlArgs.Has("c") {
+		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	var pageIdx uint64 = 1
+	if urlArgs.Has("p") {
+		pageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+		if pageIdx < 1 {
+			pageIdx = 1
+		}
+	}
+
+	var contract string
+	var topic string
+	var sender string
+	var txHash string
+	var minBlock uint64
+	var maxBlock uint64
+	var withOrphaned uint64
+
+	if urlArgs.Has("f") {
+		if urlArgs.Has("f.contract") {
+			contract = urlArgs.Get("f.contract")
+		}
+		if urlArgs.Has("f.topic") {
+			topic = strings.TrimSpace(urlArgs.Get("f.topic"))
+		}
+		if urlArgs.Has("f.sender") {
+			sender = urlArgs.Get("f.sender")
+		}
+		if urlArgs.Has("f.tx") {
+			txHash = urlArgs.Get("f.tx")
+		}
+		if urlArgs.Has("f.minb") {
+			minBlock, _ = strconv.ParseUint(urlArgs.Get("f.minb"), 10, 64)
+		}
+		if urlArgs.Has("f.maxb") {
+			maxBlock, _ = strconv.ParseUint(urlArgs.Get("f.maxb"), 10, 64)
+		}
+		if urlArgs.Has("f.orphaned") {
+			withOrphaned, _ = strconv.ParseUint(urlArgs.Get("f.orphaned"), 10, 64)
+		}
+	}
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getFilteredContractLogsPageData(r.Context(), pageIdx, pageSize, contract, topic, sender, txHash, minBlock, maxBlock, uint8(withOrphaned))
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "contract_logs.go", "Contract Logs", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getFilteredContractLogsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, contract string, topic string, sender string, txHash string, minBlock uint64, maxBlock uint64, withOrphaned uint8) (*models.ContractLogsPageData, error) {
+	pageData := &models.ContractLogsPageData{}
+	pageCacheKey := fmt.Sprintf("contract_logs:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, contract, topic, sender, txHash, minBlock, maxBlock, withOrphaned)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildFilteredContractLogsPageData(pageCall.CallCtx, pageIdx, pageSize, contract, topic, sender, txHash, minBlock, maxBlock, withOrphaned)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ContractLogsPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildFilteredContractLogsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, contract string, topic string, sender string, txHash string, minBlock uint64, maxBlock uint64, withOrphaned uint8) (*models.ContractLogsPageData, time.Duration) {
+	filterArgs := url.Values{}
+	if contract != "" {
+		filterArgs.Add("f.contract", contract)
+	}
+	if topic != "" {
+		filterArgs.Add("f.topic", topic)
+	}
+	if sender != "" {
+		filterArgs.Add("f.sender", sender)
+	}
+	if txHash != "" {
+		filterArgs.Add("f.tx", txHash)
+	}
+	if minBlock != 0 {
+		filterArgs.Add("f.minb", fmt.Sprintf("%v", minBlock))
+	}
+	if maxBlock != 0 {
+		filterArgs.Add("f.maxb", fmt.Sprintf("%v", maxBlock))
+	}
+	if withOrphaned != 0 {
+		filterArgs.Add("f.orphaned", fmt.Sprintf("%v", withOrphaned))
+	}
+
+	pageData := &models.ContractLogsPageData{
+		FilterContract:     contract,
+		FilterTopic:        topic,
+		FilterSender:       sender,
+		FilterTxHash:       txHash,
+		FilterMinBlock:     minBlock,
+		FilterMaxBlock:     maxBlock,
+		FilterWithOrphaned: withOrphaned,
+	}
+	logrus.Debugf("contract_logs page called: %v:%v [%v,%v,%v,%v,%v,%v]", pageIdx, pageSize, contract, topic, sender, txHash, minBlock, maxBlock)
+	if pageIdx == 1 {
+		pageData.IsDefaultPage = true
+	}
+
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	pageData.PageSize = pageSize
+	pageData.TotalPages = pageIdx
+	pageData.CurrentPageIndex = pageIdx
+	if pageIdx > 1 {
+		pageData.PrevPageIndex = pageIdx - 1
+	}
+
+	// collect the watched contracts & the event signatures of their configured topics
+	contractNames := map[common.Address]string{}
+	topicNames := map[common.Hash]string{}
+	for _, watchedContract := range services.GlobalBeaconService.GetWatchedContractIndexer().GetContracts() {
+		contractData := &models.ContractLogsPageDataContract{
+			Name:    watchedContract.Name,
+			Address: watchedContract.Address[:],
+		}
+		for _, topicHash := range watchedContract.Topics {
+			topicName := watchedContract.TopicNames[topicHash]
+			if topicName == "" {
+				topicName = topicHash.Hex()
+			} else {
+				topicNames[topicHash] = topicName
+			}
+			contractData.Topics = append(contractData.Topics, topicName)
+		}
+
+		contractNames[watchedContract.Address] = watchedContract.Name
+		pageData.Contracts = append(pageData.Contracts, contractData)
+	}
+
+	logFilter := &dbtypes.WatchedContractLogFilter{
+		ContractAddress: common.FromHex(contract),
+		TxSender:        common.FromHex(sender),
+		TxHash:          common.FromHex(txHash),
+		MinBlock:        minBlock,
+		MaxBlock:        maxBlock,
+		WithOrphaned:    withOrphaned,
+	}
+	if topic != "" {
+		topicHash, _ := execindexer.ParseWatchedContractTopic(topic)
+		logFilter.Topic0 = topicHash[:]
+	}
+
+	contractLogs, totalRows := services.GlobalBeaconService.GetWatchedContractLogsByFilter(ctx, logFilter, pageIdx-1, uint32(pageSize))
+	for _, entry := range contractLogs {
+		contractLog := entry.Log
+		logData := &models.ContractLogsPageDataLog{
+			ContractName:    contractNames[common.BytesToAddress(contractLog.ContractAddress)],
+			ContractAddress: contractLog.ContractAddress,
+			BlockNumber:     contractLog.BlockNumber,
+			BlockHash:       contractLog.BlockRoot,
+			LogIndex:        contractLog.BlockIndex,
+			Time:            time.Unix(int64(contractLog.BlockTime), 0),
+			Data:            contractLog.Data,
+			TxHash:          contractLog.TxHash,
+			TxSender:        contractLog.TxSender,
+			Orphaned:        entry.Orphaned,
+		}
+
+		for _, logTopic := range [][]byte{contractLog.Topic0, contractLog.Topic1, contractLog.Topic2, contractLog.Topic3} {
+			if logTopic == nil {
+				break
+			}
+			logData.Topics = append(logData.Topics, logTopic)
+		}
+		if contractLog.Topic0 != nil {
+			logData.EventName = topicNames[common.BytesToHash(contractLog.Topic0)]
+		}
+
+		pageData.Logs = append(pageData.Logs, logData)
+	}
+	pageData.LogCount = uint64(len(pageData.Logs))
+
+	pageData.TotalPages = totalRows / pageSize
+	if totalRows%pageSize > 0 {
+		pageData.TotalPages++
+	}
+	pageData.LastPageIndex = pageData.TotalPages
+	if pageIdx < pageData.TotalPages {
+		pageData.NextPageIndex = pageIdx + 1
+	}
+
+	pageData.FirstPageLink = fmt.Sprintf("/contracts/logs?f&%v&c=%v", filterArgs.Encode(), pageData.PageSize)
+	pageData.PrevPageLink = fmt.Sprintf("/contracts/logs?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.PrevPageIndex)
+	pageData.NextPageLink = fmt.Sprintf("/contracts/logs?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.NextPageIndex)
+	pageData.LastPageLink = fmt.Sprintf("/contracts/logs?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.LastPageIndex)
+
+	return pageData, 1 * time.Minute
+}
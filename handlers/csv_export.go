@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// defaultCsvExportRowLimit is the max. number of rows in a csv export if frontend.csvExportRowLimit is not set.
+const defaultCsvExportRowLimit = 10000
+
+// csvExportPageSize is the number of rows loaded per batch while streaming a csv export.
+const csvExportPageSize = 100
+
+// csvExportCallCost is the rate limit cost of a csv export, exports load many pages at once.
+const csvExportCallCost = 10
+
+// isCsvExport returns true if the filtered list page is requested as csv export (?export=csv).
+func isCsvExport(r *http.Request) bool {
+	return r.URL.Query().Get("export") == "csv"
+}
+
+func getCsvExportRowLimit() uint64 {
+	if utils.Config().Frontend.CsvExportRowLimit > 0 {
+		return utils.Config().Frontend.CsvExportRowLimit
+	}
+	return defaultCsvExportRowLimit
+}
+
+// writeCsvExport streams the full filtered result set of a list page as csv file.
+// loadPage is called with increasing page indexes (starting at 0) until it reports no further pages or the row limit is reached.
+// exports that hit the row limit are truncated, the limit is announced via the X-Export-Row-Limit header.
+func writeCsvExport(w http.ResponseWriter, r *http.Request, filename string, header []string, loadPage func(pageIdx uint64) ([][]string, bool)) {
+	if err := services.GlobalCallRateLimiter.CheckCallLimit(r, csvExportCallCost); err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	rowLimit := getCsvExportRowLimit()
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%v-%v.csv", filename, time.Now().UTC().Format("20060102-150405")))
+	w.Header().Set("X-Export-Row-Limit", strconv.FormatUint(rowLimit, 10))
+
+	csvWriter := csv.NewWriter(w)
+	csvWriter.Write(header)
+
+	rowCount := uint64(0)
+	for pageIdx := uint64(0); rowCount < rowLimit; pageIdx++ {
+		rows, hasMore := loadPage(pageIdx)
+		for _, row := range rows {
+			if rowCount >= rowLimit {
+				break
+			}
+			csvWriter.Write(row)
+			rowCount++
+		}
+
+		// flush after every batch, so large exports are streamed to the client
+		csvWriter.Flush()
+		if !hasMore || len(rows) == 0 {
+			break
+		}
+	}
+
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		logrus.WithError(err).Warnf("error writing %v csv export", filename)
+	}
+}
+
+// csvText escapes user controlled text (names, graffitis) so spreadsheet applications don't evaluate it as formula.
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// csvRequestStatus returns the inclusion status of an el triggered request (0 = pending, 1 = included, 2 = orphaned).
+func csvRequestStatus(status uint64) string {
+	switch status {
+	case 1:
+		return "included"
+	case 2:
+		return "orphaned"
+	default:
+		return "pending"
+	}
+}
+
+func csvUint(value uint64) string {
+	return strconv.FormatUint(value, 10)
+}
+
+func csvBool(value bool) string {
+	return strconv.FormatBool(value)
+}
+
+func csvHex(value []byte) string {
+	if len(value) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("0x%x", value)
+}
+
+func csvTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// DebugAnomalies will return the "debug anomalies" page listing recently detected slot time & committee anomalies
+func DebugAnomalies(w http.ResponseWriter, r *http.Request) {
+	var debugAnomaliesTemplateFiles = append(layoutTemplateFiles,
+		"debug_anomalies/debug_anomalies.html",
+	)
+	var pageTemplate = templates.GetTemplate(debugAnomaliesTemplateFiles...)
+
+	if !utils.Config().Frontend.Pprof {
+		handlePageError(w, r, errors.New("debug pages are not enabled"))
+		return
+	}
+
+	data := InitPageData(w, r, "blockchain", "/debug/anomalies", "Debug Anomalies", debugAnomaliesTemplateFiles)
+	data.Data = buildDebugAnomaliesPageData()
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "debug_anomalies.go", "Debug Anomalies", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func buildDebugAnomaliesPageData() *models.DebugAnomaliesPageData {
+	logrus.Debugf("debug anomalies page called")
+
+	pageData := &models.DebugAnomaliesPageData{}
+	for _, anomaly := range services.GlobalBeaconService.GetBeaconIndexer().GetSlotAnomalies() {
+		anomalyData := &models.DebugAnomaliesPageDataAnomaly{
+			Slot:          uint64(anomaly.Slot),
+			BlockRoot:     anomaly.Root[:],
+			SlotTime:      anomaly.SlotTime,
+			ExecutionTime: anomaly.ExecutionTime,
+			DetectedAt:    anomaly.DetectedAt,
+			Description:   anomaly.Description,
+		}
+
+		switch anomaly.Type {
+		case beacon.SlotAnomalyTimestampMismatch:
+			anomalyData.Type = "timestamp_mismatch"
+		case beacon.SlotAnomalyFutureSlot:
+			anomalyData.Type = "future_slot"
+		}
+
+		pageData.Anomalies = append(pageData.Anomalies, anomalyData)
+	}
+	pageData.AnomalyCount = uint64(len(pageData.Anomalies))
+
+	for _, anomaly := range services.GlobalBeaconService.GetBeaconIndexer().GetCommitteeAnomalies() {
+		anomalyData := &models.DebugAnomaliesPageDataCommitteeAnomaly{
+			Epoch:         uint64(anomaly.Epoch),
+			Slot:          uint64(anomaly.Slot),
+			Committee:     anomaly.Committee,
+			DependentRoot: anomaly.DependentRoot[:],
+			Expected:      anomaly.Expected,
+			Actual:        anomaly.Actual,
+			DetectedAt:    anomaly.DetectedAt,
+			Description:   anomaly.Description,
+		}
+		if anomaly.Type == beacon.CommitteeAnomalyAttestation {
+			anomalyData.BlockRoot = anomaly.BlockRoot[:]
+		}
+
+		switch anomaly.Type {
+		case beacon.CommitteeAnomalyCount:
+			anomalyData.Type = "committee_count"
+		case beacon.CommitteeAnomalySize:
+			anomalyData.Type = "committee_size"
+		case beacon.CommitteeAnomalyCoverage:
+			anomalyData.Type = "committee_coverage"
+		case beacon.CommitteeAnomalyTarget:
+			anomalyData.Type = "target_deviation"
+		case beacon.CommitteeAnomalyAttestation:
+			anomalyData.Type = "attestation_size"
+		}
+
+		pageData.CommitteeAnomalies = append(pageData.CommitteeAnomalies, anomalyData)
+	}
+	pageData.CommitteeAnomalyCount = uint64(len(pageData.CommitteeAnomalies))
+
+	return pageData
+}
@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// DebugBundle will return a zip archive with recent logs, client states, the fork cache & finality state for attaching to bug reports
+func DebugBundle(w http.ResponseWriter, r *http.Request) {
+	if !utils.Config().Frontend.Pprof {
+		handlePageError(w, r, errors.New("debug pages are not enabled"))
+		return
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	createdAt := time.Now().UTC()
+	logLines := utils.GetRecentLogLines()
+
+	networkName := chainState.GetSpecs().ConfigName
+	if utils.Config().Chain.DisplayName != "" {
+		networkName = utils.Config().Chain.DisplayName
+	}
+
+	bundleFiles := []struct {
+		name string
+		data interface{}
+	}{
+		{"info.json", &models.DebugBundleInfo{
+			Version:     utils.GetExplorerVersion(),
+			NetworkName: networkName,
+			CreatedAt:   createdAt,
+			CurrentSlot: uint64(chainState.CurrentSlot()),
+			LogLines:    len(logLines),
+		}},
+		{"clients.json", buildDebugBundleClients()},
+		{"finality.json", buildDebugBundleFinality()},
+		{"forks.json", services.GlobalBeaconService.GetBeaconIndexer().GetForkCacheDump()},
+		{"cache.json", services.GlobalBeaconService.GetBeaconIndexer().GetCacheDebugStats()},
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"dora-diagnostics-%v.zip\"", createdAt.Format("20060102-150405")))
+
+	zipWriter := zip.NewWriter(w)
+	for _, bundleFile := range bundleFiles {
+		fileWriter, err := zipWriter.Create(bundleFile.name)
+		if err == nil {
+			encoder := json.NewEncoder(fileWriter)
+			encoder.SetIndent("", "  ")
+			err = encoder.Encode(bundleFile.data)
+		}
+		if err != nil {
+			logrus.WithError(err).Errorf("error writing %v to diagnostic bundle", bundleFile.name)
+			return
+		}
+	}
+
+	logWriter, err := zipWriter.Create("logs.txt")
+	if err == nil {
+		if logLines == nil {
+			_, err = logWriter.Write([]byte("log buffering is disabled\n"))
+		} else {
+			_, err = logWriter.Write([]byte(strings.Join(logLines, "")))
+		}
+	}
+	if err != nil {
+		logrus.WithError(err).Error("error writing logs to diagnostic bundle")
+		return
+	}
+
+	if err := zipWriter.Close(); err != nil {
+		logrus.WithError(err).Error("error closing diagnostic bundle")
+	}
+}
+
+func buildDebugBundleClients() *models.DebugBundleClients {
+	bundleClients := &models.DebugBundleClients{
+		Consensus: []*models.DebugBundleClient{},
+		Execution: []*models.DebugBundleClient{},
+	}
+
+	for _, client := range services.GlobalBeaconService.GetConsensusClients() {
+		headSlot, headRoot := client.GetLastHead()
+		finalizedEpoch, finalizedRoot, justifiedEpoch, justifiedRoot := client.GetFinalityCheckpoint()
+		finalizedEpochNum := uint64(finalizedEpoch)
+		justifiedEpochNum := uint64(justifiedEpoch)
+		bundleClient := &models.DebugBundleClient{
+			Index:          int(client.GetIndex()) + 1,
+			Name:           client.GetName(),
+			Version:        client.GetVersion(),
+			Status:         client.GetStatus().String(),
+			HeadSlot:       uint64(headSlot),
+			HeadRoot:       headRoot[:],
+			LastEvent:      client.GetLastEventTime(),
+			FinalizedEpoch: &finalizedEpochNum,
+			FinalizedRoot:  finalizedRoot[:],
+			JustifiedEpoch: &justifiedEpochNum,
+			JustifiedRoot:  justifiedRoot[:],
+		}
+		if lastErr := client.GetLastClientError(); lastErr != nil {
+			bundleClient.LastError = lastErr.Error()
+		}
+		bundleClients.Consensus = append(bundleClients.Consensus, bundleClient)
+	}
+
+	for _, client := range services.GlobalBeaconService.GetExecutionClients() {
+		headNumber, headHash := client.GetLastHead()
+		bundleClient := &models.DebugBundleClient{
+			Index:     int(client.GetIndex()) + 1,
+			Name:      client.GetName(),
+			Version:   client.GetVersion(),
+			Status:    client.GetStatus().String(),
+			HeadSlot:  headNumber,
+			HeadRoot:  headHash[:],
+			LastEvent: client.GetLastEventTime(),
+		}
+		if lastErr := client.GetLastClientError(); lastErr != nil {
+			bundleClient.LastError = lastErr.Error()
+		}
+		bundleClients.Execution = append(bundleClients.Execution, bundleClient)
+	}
+
+	return bundleClients
+}
+
+func buildDebugBundleFinality() *models.DebugBundleFinality {
+	chainState := services.GlobalBeaconService.GetChainState()
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+
+	finalizedEpoch, finalizedRoot := chainState.GetFinalizedCheckpoint()
+	justifiedEpoch, justifiedRoot := chainState.GetJustifiedCheckpoint()
+	cacheFinalized, cachePruned := beaconIndexer.GetBlockCacheState()
+	syncRunning, syncEpoch := beaconIndexer.GetSynchronizerState()
+
+	finality := &models.DebugBundleFinality{
+		CurrentEpoch:      uint64(chainState.CurrentEpoch()),
+		FinalizedEpoch:    uint64(finalizedEpoch),
+		FinalizedRoot:     finalizedRoot[:],
+		JustifiedEpoch:    uint64(justifiedEpoch),
+		JustifiedRoot:     justifiedRoot[:],
+		CacheFinalized:    uint64(cacheFinalized),
+		CachePruned:       uint64(cachePruned),
+		SynchronizerRun:   syncRunning,
+		SynchronizerEpoch: uint64(syncEpoch),
+		ChainHeads:        []*models.DebugBundleChainHead{},
+	}
+
+	if canonicalHead := beaconIndexer.GetCanonicalHead(nil); canonicalHead != nil {
+		finality.CanonicalHeadSlot = uint64(canonicalHead.Slot)
+		finality.CanonicalHeadRoot = canonicalHead.Root[:]
+	}
+
+	for _, chainHead := range beaconIndexer.GetChainHeads() {
+		finality.ChainHeads = append(finality.ChainHeads, &models.DebugBundleChainHead{
+			HeadSlot:      uint64(chainHead.HeadBlock.Slot),
+			HeadRoot:      chainHead.HeadBlock.Root[:],
+			HeadVotes:     uint64(chainHead.AggregatedHeadVotes),
+			Participation: chainHead.PerEpochVotingPercent,
+		})
+	}
+
+	return finality
+}
@@ -19,7 +19,7 @@ func DebugCache(w http.ResponseWriter, r *http.Request) {
 	)
 	var pageTemplate = templates.GetTemplate(debugCacheTemplateFiles...)
 
-	if !utils.Config.Frontend.Pprof {
+	if !utils.Config().Frontend.Pprof {
 		handlePageError(w, r, errors.New("debug pages are not enabled"))
 		return
 	}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// DebugConsistency will return the "debug consistency" page listing the repairs of the finalized chain consistency checker
+func DebugConsistency(w http.ResponseWriter, r *http.Request) {
+	var debugConsistencyTemplateFiles = append(layoutTemplateFiles,
+		"debug_consistency/debug_consistency.html",
+	)
+	var pageTemplate = templates.GetTemplate(debugConsistencyTemplateFiles...)
+
+	if !utils.Config().Frontend.Pprof {
+		handlePageError(w, r, errors.New("debug pages are not enabled"))
+		return
+	}
+
+	data := InitPageData(w, r, "blockchain", "/debug/consistency", "Debug Chain Consistency", debugConsistencyTemplateFiles)
+	data.Data = buildDebugConsistencyPageData()
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "debug_consistency.go", "Debug Chain Consistency", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func buildDebugConsistencyPageData() *models.DebugConsistencyPageData {
+	logrus.Debugf("debug consistency page called")
+
+	indexer := services.GlobalBeaconService.GetBeaconIndexer()
+	finalizedEpoch, _ := indexer.GetBlockCacheState()
+	checkerState := indexer.GetChainConsistencyState()
+
+	pageData := &models.DebugConsistencyPageData{
+		Running:        checkerState.Running,
+		CheckedEpoch:   uint64(checkerState.CheckedEpoch),
+		FinalizedEpoch: uint64(finalizedEpoch),
+		LastCheck:      checkerState.LastCheck,
+		IssueCount:     checkerState.IssueCount,
+		RepairedCount:  checkerState.RepairedCount,
+	}
+
+	for _, repair := range indexer.GetChainRepairs() {
+		pageData.Repairs = append(pageData.Repairs, &models.DebugConsistencyPageDataRepair{
+			Epoch:      uint64(repair.Epoch),
+			Issues:     repair.Issues,
+			DetectedAt: repair.DetectedAt,
+			RepairedAt: repair.RepairedAt,
+			Success:    repair.Success,
+			Error:      repair.Error,
+		})
+	}
+	pageData.RepairCount = uint64(len(pageData.Repairs))
+
+	return pageData
+}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+var debugEpochTimingColors = []string{"bg-primary", "bg-success", "bg-info", "bg-warning", "bg-danger", "bg-secondary", "bg-dark"}
+
+// DebugEpochTimings will return the "debug epoch timings" page showing the per-stage timing of the recent epoch processing runs
+func DebugEpochTimings(w http.ResponseWriter, r *http.Request) {
+	var debugEpochTimingsTemplateFiles = append(layoutTemplateFiles,
+		"debug_epoch_timings/debug_epoch_timings.html",
+	)
+	var pageTemplate = templates.GetTemplate(debugEpochTimingsTemplateFiles...)
+
+	if !utils.Config().Frontend.Pprof {
+		handlePageError(w, r, errors.New("debug pages are not enabled"))
+		return
+	}
+
+	data := InitPageData(w, r, "blockchain", "/debug/epochtimings", "Epoch Processing Timings", debugEpochTimingsTemplateFiles)
+	data.Data = buildDebugEpochTimingsPageData(r.URL.Query().Get("process"))
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "debug_epoch_timings.go", "Epoch Processing Timings", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func buildDebugEpochTimingsPageData(process string) *models.DebugEpochTimingsPageData {
+	logrus.Debugf("debug epoch timings page called: %v", process)
+
+	pageData := &models.DebugEpochTimingsPageData{
+		Process: process,
+	}
+
+	processCounts := map[beacon.EpochTimingProcess]uint64{}
+	summaries := map[string]*models.DebugEpochTimingsPageDataStageSummary{}
+	stageColors := map[string]string{}
+	stageTotals := map[string]uint64{}
+
+	for _, timing := range services.GlobalBeaconService.GetBeaconIndexer().GetEpochTimings() {
+		processCounts[timing.Process]++
+		if process != "" && string(timing.Process) != process {
+			continue
+		}
+
+		run := &models.DebugEpochTimingsPageDataRun{
+			Process:       string(timing.Process),
+			Epoch:         uint64(timing.Epoch),
+			DependentRoot: timing.DependentRoot[:],
+			StartedAt:     timing.StartedAt,
+			TotalMs:       uint64(timing.Total.Milliseconds()),
+		}
+
+		stageNames := make([]string, 0, len(timing.Stages)+1)
+		stageDurations := make([]uint64, 0, len(timing.Stages)+1)
+		for _, stage := range timing.Stages {
+			stageKey := run.Process + ":" + stage.Name
+			color, found := stageColors[stageKey]
+			if !found {
+				color = debugEpochTimingColors[len(stageColors)%len(debugEpochTimingColors)]
+				stageColors[stageKey] = color
+			}
+
+			stageData := &models.DebugEpochTimingsPageDataStage{
+				Name:       stage.Name,
+				DurationMs: uint64(stage.Duration.Milliseconds()),
+				Color:      color,
+			}
+			if timing.Total > 0 {
+				stageData.Percent = float64(stage.Duration) * 100 / float64(timing.Total)
+			}
+			run.Stages = append(run.Stages, stageData)
+
+			stageNames = append(stageNames, stage.Name)
+			stageDurations = append(stageDurations, stageData.DurationMs)
+		}
+		stageNames = append(stageNames, "total")
+		stageDurations = append(stageDurations, run.TotalMs)
+
+		// timings are sorted newest first, so the first run of each stage is the latest one
+		for i, stageName := range stageNames {
+			stageKey := run.Process + ":" + stageName
+			summary := summaries[stageKey]
+			if summary == nil {
+				summary = &models.DebugEpochTimingsPageDataStageSummary{
+					Process: run.Process,
+					Name:    stageName,
+					Color:   stageColors[stageKey],
+					LastMs:  stageDurations[i],
+				}
+				summaries[stageKey] = summary
+				pageData.Summary = append(pageData.Summary, summary)
+			}
+
+			summary.Runs++
+			stageTotals[stageKey] += stageDurations[i]
+			if stageDurations[i] > summary.MaxMs {
+				summary.MaxMs = stageDurations[i]
+			}
+		}
+
+		pageData.Runs = append(pageData.Runs, run)
+	}
+	pageData.RunCount = uint64(len(pageData.Runs))
+
+	for _, summary := range pageData.Summary {
+		summary.AvgMs = stageTotals[summary.Process+":"+summary.Name] / summary.Runs
+	}
+	sort.SliceStable(pageData.Summary, func(a, b int) bool {
+		return pageData.Summary[a].Process < pageData.Summary[b].Process
+	})
+
+	for _, timingProcess := range []beacon.EpochTimingProcess{beacon.EpochTimingDuties, beacon.EpochTimingFinalization, beacon.EpochTimingSync} {
+		pageData.Processes = append(pageData.Processes, &models.DebugEpochTimingsPageDataProcess{
+			Name:     string(timingProcess),
+			RunCount: processCounts[timingProcess],
+		})
+	}
+
+	return pageData
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// DebugMaintenance will return the "debug maintenance" page showing the state of the database maintenance scheduler
+func DebugMaintenance(w http.ResponseWriter, r *http.Request) {
+	var debugMaintenanceTemplateFiles = append(layoutTemplateFiles,
+		"debug_maintenance/debug_maintenance.html",
+	)
+	var pageTemplate = templates.GetTemplate(debugMaintenanceTemplateFiles...)
+
+	if !utils.Config().Frontend.Pprof {
+		handlePageError(w, r, errors.New("debug pages are not enabled"))
+		return
+	}
+
+	data := InitPageData(w, r, "blockchain", "/debug/maintenance", "Debug Database Maintenance", debugMaintenanceTemplateFiles)
+	data.Data = buildDebugMaintenancePageData()
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "debug_maintenance.go", "Debug Database Maintenance", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func buildDebugMaintenancePageData() *models.DebugMaintenancePageData {
+	logrus.Debugf("debug maintenance page called")
+
+	pageData := &models.DebugMaintenancePageData{
+		Engine: utils.Config().Database.Engine,
+	}
+
+	if cleanupStats := services.GlobalBeaconService.GetBeaconIndexer().GetForkCleanupStats(); cleanupStats != nil {
+		pageData.ForkCleanup = &models.DebugMaintenancePageDataForkCleanup{
+			RetentionEpochs:   utils.Config().Indexer.OrphanedForkRetention,
+			LastRun:           cleanupStats.LastRun,
+			CutoffEpoch:       uint64(cleanupStats.CutoffEpoch),
+			LastError:         cleanupStats.LastError,
+			LastForks:         cleanupStats.LastResult.Forks,
+			LastBlocks:        cleanupStats.LastResult.Blocks,
+			LastOperations:    cleanupStats.LastResult.Operations,
+			LastTransactions:  cleanupStats.LastResult.ElTransactions,
+			LastBlobs:         cleanupStats.LastResult.Blobs,
+			TotalForks:        cleanupStats.TotalResult.Forks,
+			TotalBlocks:       cleanupStats.TotalResult.Blocks,
+			TotalOperations:   cleanupStats.TotalResult.Operations,
+			TotalTransactions: cleanupStats.TotalResult.ElTransactions,
+			TotalBlobs:        cleanupStats.TotalResult.Blobs,
+		}
+	}
+
+	if services.GlobalDatabaseMaintenance == nil {
+		return pageData
+	}
+
+	status := services.GlobalDatabaseMaintenance.GetStatus()
+	pageData.Enabled = true
+	pageData.Running = status.Running
+	pageData.Windows = status.Windows
+	pageData.Interval = status.Interval.String()
+	pageData.LastRun = status.LastRun
+	pageData.LastEnd = status.LastEnd
+	pageData.LastSuccess = status.LastSuccess
+	pageData.LastError = status.LastError
+	pageData.NextRun = status.NextRun
+	if status.LastResult != nil {
+		pageData.HasResult = true
+		pageData.LastCompleted = status.LastResult.Completed
+		pageData.TableCount = uint64(len(status.LastResult.Tables))
+		pageData.FreedPages = status.LastResult.FreedPages
+		pageData.FullVacuum = status.LastResult.FullVacuum
+	}
+
+	return pageData
+}
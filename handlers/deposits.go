@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"math"
 	"net/http"
@@ -41,7 +43,7 @@ func Deposits(w http.ResponseWriter, r *http.Request) {
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
 	if pageError == nil {
-		data.Data, pageError = getDepositsPageData(firstEpoch, pageSize)
+		data.Data, pageError = getDepositsPageData(r.Context(), firstEpoch, pageSize)
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -53,11 +55,11 @@ func Deposits(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getDepositsPageData(firstEpoch uint64, pageSize uint64) (*models.DepositsPageData, error) {
+func getDepositsPageData(ctx context.Context, firstEpoch uint64, pageSize uint64) (*models.DepositsPageData, error) {
 	pageData := &models.DepositsPageData{}
 	pageCacheKey := fmt.Sprintf("deposits:%v:%v", firstEpoch, pageSize)
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
-		pageData, cacheTimeout := buildDepositsPageData(firstEpoch, pageSize)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildDepositsPageData(pageCall.CallCtx, firstEpoch, pageSize)
 		pageCall.CacheTimeout = cacheTimeout
 		return pageData
 	})
@@ -71,7 +73,7 @@ func getDepositsPageData(firstEpoch uint64, pageSize uint64) (*models.DepositsPa
 	return pageData, pageErr
 }
 
-func buildDepositsPageData(firstEpoch uint64, pageSize uint64) (*models.DepositsPageData, time.Duration) {
+func buildDepositsPageData(ctx context.Context, firstEpoch uint64, pageSize uint64) (*models.DepositsPageData, time.Duration) {
 	logrus.Debugf("deposits page called: %v:%v", firstEpoch, pageSize)
 	pageData := &models.DepositsPageData{
 		InitiatedDeposits: []*models.DepositsPageDataInitiatedDeposit{},
@@ -80,7 +82,8 @@ func buildDepositsPageData(firstEpoch uint64, pageSize uint64) (*models.Deposits
 	chainState := services.GlobalBeaconService.GetChainState()
 
 	// load initiated deposits
-	dbDepositTxs := db.GetDepositTxs(0, 20)
+	dbDepositTxs := db.GetDepositTxs(ctx, 0, 20)
+	pubkeyStats := getDepositTxPubkeyStats(ctx, dbDepositTxs)
 	for _, depositTx := range dbDepositTxs {
 		depositTxData := &models.DepositsPageDataInitiatedDeposit{
 			Index:                 depositTx.Index,
@@ -95,6 +98,14 @@ func buildDepositsPageData(firstEpoch uint64, pageSize uint64) (*models.Deposits
 			Valid:                 depositTx.ValidSignature,
 		}
 
+		if stats := pubkeyStats[phase0.BLSPubKey(depositTx.PublicKey)]; stats != nil {
+			depositTxData.IsInitial = depositTx.Index == stats.FirstIndex
+			depositTxData.IsTopUp = depositTx.Index > stats.FirstIndex
+			depositTxData.PubkeyDepositCount = stats.DepositCount
+			depositTxData.PubkeyDepositAmount = stats.Amount
+			depositTxData.FrontrunInitial, depositTxData.FrontrunVictim = getDepositTxFrontrunState(depositTx, stats)
+		}
+
 		validatorIndex, found := services.GlobalBeaconService.GetValidatorIndexByPubkey(phase0.BLSPubKey(depositTx.PublicKey))
 		if !found {
 			depositTxData.ValidatorStatus = "Deposited"
@@ -129,7 +140,7 @@ func buildDepositsPageData(firstEpoch uint64, pageSize uint64) (*models.Deposits
 	pageData.InitiatedDepositCount = uint64(len(pageData.InitiatedDeposits))
 
 	// load included deposits
-	dbDeposits, _ := services.GlobalBeaconService.GetIncludedDepositsByFilter(&dbtypes.DepositFilter{}, 0, 20)
+	dbDeposits, _ := services.GlobalBeaconService.GetIncludedDepositsByFilter(ctx, &dbtypes.DepositFilter{}, 0, 20)
 	for _, deposit := range dbDeposits {
 		depositData := &models.DepositsPageDataIncludedDeposit{
 			PublicKey:             deposit.PublicKey,
@@ -182,3 +193,37 @@ func buildDepositsPageData(firstEpoch uint64, pageSize uint64) (*models.Deposits
 
 	return pageData, 1 * time.Minute
 }
+
+// getDepositTxPubkeyStats loads the aggregated deposits for all pubkeys of the given deposit txs.
+func getDepositTxPubkeyStats(ctx context.Context, depositTxs []*dbtypes.DepositTx) map[phase0.BLSPubKey]*dbtypes.DepositTxPubkeyStats {
+	pubkeys := make([][]byte, 0, len(depositTxs))
+	pubkeyMap := map[phase0.BLSPubKey]*dbtypes.DepositTxPubkeyStats{}
+	for _, depositTx := range depositTxs {
+		pubkey := phase0.BLSPubKey(depositTx.PublicKey)
+		if _, found := pubkeyMap[pubkey]; found {
+			continue
+		}
+		pubkeyMap[pubkey] = nil
+		pubkeys = append(pubkeys, depositTx.PublicKey)
+	}
+
+	for _, stats := range db.GetDepositTxPubkeyStats(ctx, pubkeys) {
+		pubkeyMap[phase0.BLSPubKey(stats.PublicKey)] = stats
+	}
+
+	return pubkeyMap
+}
+
+// getDepositTxFrontrunState checks a deposit tx against the frontrunning pattern of its pubkey.
+// initial is set for the initial deposit of a frontrun pubkey, victim is set for a later deposit from another sender
+// with other withdrawal credentials, which is credited to the withdrawal credentials of the initial deposit.
+func getDepositTxFrontrunState(depositTx *dbtypes.DepositTx, stats *dbtypes.DepositTxPubkeyStats) (initial bool, victim bool) {
+	if stats.FrontrunCount == 0 || depositTx.Orphaned {
+		return false, false
+	}
+	if depositTx.Index == stats.FirstIndex {
+		return true, false
+	}
+	victim = depositTx.Index > stats.FirstIndex && !bytes.Equal(depositTx.WithdrawalCredentials, stats.FirstCredentials) && !bytes.Equal(depositTx.TxSender, stats.FirstSender)
+	return false, victim
+}
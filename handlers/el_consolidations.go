@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -85,10 +86,18 @@ func ElConsolidations(w http.ResponseWriter, r *http.Request) {
 			pubkey = urlArgs.Get("f.pubkey")
 		}
 	}
+	if isCsvExport(r) {
+		writeCsvExport(w, r, "el_consolidations", elConsolidationsCsvHeader, func(pageIdx uint64) ([][]string, bool) {
+			pageData := buildFilteredElConsolidationsPageData(r.Context(), pageIdx+1, csvExportPageSize, minSlot, maxSlot, sourceAddr, minSrcIndex, maxSrcIndex, srcVName, minTgtIndex, maxTgtIndex, tgtVName, uint8(withOrphaned), pubkey)
+			return getElConsolidationsCsvRows(pageData), pageData.NextPageIndex > 0
+		})
+		return
+	}
+
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
 	if pageError == nil {
-		data.Data, pageError = getFilteredElConsolidationsPageData(pageIdx, pageSize, minSlot, maxSlot, sourceAddr, minSrcIndex, maxSrcIndex, srcVName, minTgtIndex, maxTgtIndex, tgtVName, uint8(withOrphaned), pubkey)
+		data.Data, pageError = getFilteredElConsolidationsPageData(r.Context(), pageIdx, pageSize, minSlot, maxSlot, sourceAddr, minSrcIndex, maxSrcIndex, srcVName, minTgtIndex, maxTgtIndex, tgtVName, uint8(withOrphaned), pubkey)
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -100,11 +109,11 @@ func ElConsolidations(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getFilteredElConsolidationsPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, sourceAddr string, minSrcIndex uint64, maxSrcIndex uint64, srcVName string, minTgtIndex uint64, maxTgtIndex uint64, tgtVName string, withOrphaned uint8, pubkey string) (*models.ElConsolidationsPageData, error) {
+func getFilteredElConsolidationsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, sourceAddr string, minSrcIndex uint64, maxSrcIndex uint64, srcVName string, minTgtIndex uint64, maxTgtIndex uint64, tgtVName string, withOrphaned uint8, pubkey string) (*models.ElConsolidationsPageData, error) {
 	pageData := &models.ElConsolidationsPageData{}
 	pageCacheKey := fmt.Sprintf("el_consolidations:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, minSlot, maxSlot, sourceAddr, minSrcIndex, maxSrcIndex, srcVName, minTgtIndex, maxTgtIndex, tgtVName, withOrphaned, pubkey)
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
-		return buildFilteredElConsolidationsPageData(pageIdx, pageSize, minSlot, maxSlot, sourceAddr, minSrcIndex, maxSrcIndex, srcVName, minTgtIndex, maxTgtIndex, tgtVName, withOrphaned, pubkey)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		return buildFilteredElConsolidationsPageData(pageCall.CallCtx, pageIdx, pageSize, minSlot, maxSlot, sourceAddr, minSrcIndex, maxSrcIndex, srcVName, minTgtIndex, maxTgtIndex, tgtVName, withOrphaned, pubkey)
 	})
 	if pageErr == nil && pageRes != nil {
 		resData, resOk := pageRes.(*models.ElConsolidationsPageData)
@@ -116,7 +125,7 @@ func getFilteredElConsolidationsPageData(pageIdx uint64, pageSize uint64, minSlo
 	return pageData, pageErr
 }
 
-func buildFilteredElConsolidationsPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, sourceAddr string, minSrcIndex uint64, maxSrcIndex uint64, srcVName string, minTgtIndex uint64, maxTgtIndex uint64, tgtVName string, withOrphaned uint8, pubkey string) *models.ElConsolidationsPageData {
+func buildFilteredElConsolidationsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, sourceAddr string, minSrcIndex uint64, maxSrcIndex uint64, srcVName string, minTgtIndex uint64, maxTgtIndex uint64, tgtVName string, withOrphaned uint8, pubkey string) *models.ElConsolidationsPageData {
 	filterArgs := url.Values{}
 	if minSlot != 0 {
 		filterArgs.Add("f.mins", fmt.Sprintf("%v", minSlot))
@@ -197,7 +206,7 @@ func buildFilteredElConsolidationsPageData(pageIdx uint64, pageSize uint64, minS
 		},
 	}
 
-	dbElConsolidations, totalRows := services.GlobalBeaconService.GetConsolidationRequestsByFilter(consolidationRequestFilter, pageIdx-1, uint32(pageSize))
+	dbElConsolidations, totalRows := services.GlobalBeaconService.GetConsolidationRequestsByFilter(ctx, consolidationRequestFilter, pageIdx-1, uint32(pageSize))
 	chainState := services.GlobalBeaconService.GetChainState()
 	headBlock := services.GlobalBeaconService.GetBeaconIndexer().GetCanonicalHead(nil)
 	headBlockNum := uint64(0)
@@ -245,6 +254,7 @@ func buildFilteredElConsolidationsPageData(pageIdx uint64, pageSize uint64, minS
 				TxOrigin:    common.Address(transaction.TxSender).Hex(),
 				TxTarget:    common.Address(transaction.TxTarget).Hex(),
 				TxHash:      fmt.Sprintf("%#x", transaction.TxHash),
+				TxFee:       formatRequestTxFee(transaction.TxFee),
 			}
 			elConsolidationData.TxStatus = uint64(1)
 			if consolidation.TransactionOrphaned {
@@ -287,3 +297,38 @@ func buildFilteredElConsolidationsPageData(pageIdx uint64, pageSize uint64, minS
 
 	return pageData
 }
+
+var elConsolidationsCsvHeader = []string{"slot", "time", "status", "source_address", "source_index", "source_name", "source_pubkey", "target_index", "target_name", "target_pubkey", "tx_hash", "tx_block"}
+
+func getElConsolidationsCsvRows(pageData *models.ElConsolidationsPageData) [][]string {
+	rows := make([][]string, 0, len(pageData.ElRequests))
+	for _, request := range pageData.ElRequests {
+		sourceIndex := ""
+		if request.SourceValidatorValid {
+			sourceIndex = csvUint(request.SourceValidatorIndex)
+		}
+		targetIndex := ""
+		if request.TargetValidatorValid {
+			targetIndex = csvUint(request.TargetValidatorIndex)
+		}
+		txBlock := ""
+		if request.TransactionDetails != nil {
+			txBlock = csvUint(request.TransactionDetails.BlockNumber)
+		}
+		rows = append(rows, []string{
+			csvUint(request.SlotNumber),
+			csvTime(request.Time),
+			csvRequestStatus(request.Status),
+			csvHex(request.SourceAddr),
+			sourceIndex,
+			csvText(request.SourceValidatorName),
+			csvHex(request.SourcePublicKey),
+			targetIndex,
+			csvText(request.TargetValidatorName),
+			csvHex(request.TargetPublicKey),
+			csvHex(request.TransactionHash),
+			txBlock,
+		})
+	}
+	return rows
+}
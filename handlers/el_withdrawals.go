@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -25,80 +27,98 @@ func ElWithdrawals(w http.ResponseWriter, r *http.Request) {
 	var pageTemplate = templates.GetTemplate(templateFiles...)
 	data := InitPageData(w, r, "validators", "/validators/el_withdrawals", "Withdrawal Requests", templateFiles)
 
-	urlArgs := r.URL.Query()
-	var pageSize uint64 = 50
+	pageArgs := parseElWithdrawalsPageArgs(r.URL.Query())
+	if isCsvExport(r) {
+		exportElWithdrawalsCsv(w, r, pageArgs)
+		return
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getFilteredElWithdrawalsPageData(r.Context(), pageArgs.PageIdx, pageArgs.PageSize, pageArgs.MinSlot, pageArgs.MaxSlot, pageArgs.SourceAddr, pageArgs.MinIndex, pageArgs.MaxIndex, pageArgs.ValidatorName, pageArgs.WithOrphaned, pageArgs.WithType, pageArgs.PubKey)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "el_withdrawals.go", "ElWithdrawals", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+type elWithdrawalsPageArgs struct {
+	PageIdx       uint64 // p
+	PageSize      uint64 // c
+	MinSlot       uint64 // f.mins (filters are only applied if f is set)
+	MaxSlot       uint64 // f.maxs
+	SourceAddr    string // f.address
+	MinIndex      uint64 // f.mini
+	MaxIndex      uint64 // f.maxi
+	ValidatorName string // f.vname
+	WithOrphaned  uint8  // f.orphaned (defaults to 1 without filter)
+	WithType      uint8  // f.type
+	PubKey        string // f.pubkey
+}
+
+func parseElWithdrawalsPageArgs(urlArgs url.Values) *elWithdrawalsPageArgs {
+	pageArgs := &elWithdrawalsPageArgs{
+		PageIdx:  1,
+		PageSize: 50,
+	}
 	if urlArgs.Has("c") {
-		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+		pageArgs.PageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
 	}
-	var pageIdx uint64 = 1
 	if urlArgs.Has("p") {
-		pageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
-		if pageIdx < 1 {
-			pageIdx = 1
+		pageArgs.PageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+		if pageArgs.PageIdx < 1 {
+			pageArgs.PageIdx = 1
 		}
 	}
 
-	var minSlot uint64
-	var maxSlot uint64
-	var sourceAddr string
-	var minIndex uint64
-	var maxIndex uint64
-	var vname string
-	var withOrphaned uint64
-	var withType uint64
-	var pubkey string
-
 	if urlArgs.Has("f") {
 		if urlArgs.Has("f.mins") {
-			minSlot, _ = strconv.ParseUint(urlArgs.Get("f.mins"), 10, 64)
+			pageArgs.MinSlot, _ = strconv.ParseUint(urlArgs.Get("f.mins"), 10, 64)
 		}
 		if urlArgs.Has("f.maxs") {
-			maxSlot, _ = strconv.ParseUint(urlArgs.Get("f.maxs"), 10, 64)
+			pageArgs.MaxSlot, _ = strconv.ParseUint(urlArgs.Get("f.maxs"), 10, 64)
 		}
 		if urlArgs.Has("f.address") {
-			sourceAddr = urlArgs.Get("f.address")
+			pageArgs.SourceAddr = urlArgs.Get("f.address")
 		}
 		if urlArgs.Has("f.mini") {
-			minIndex, _ = strconv.ParseUint(urlArgs.Get("f.mini"), 10, 64)
+			pageArgs.MinIndex, _ = strconv.ParseUint(urlArgs.Get("f.mini"), 10, 64)
 		}
 		if urlArgs.Has("f.maxi") {
-			maxIndex, _ = strconv.ParseUint(urlArgs.Get("f.maxi"), 10, 64)
+			pageArgs.MaxIndex, _ = strconv.ParseUint(urlArgs.Get("f.maxi"), 10, 64)
 		}
 		if urlArgs.Has("f.vname") {
-			vname = urlArgs.Get("f.vname")
+			pageArgs.ValidatorName = urlArgs.Get("f.vname")
 		}
 		if urlArgs.Has("f.orphaned") {
-			withOrphaned, _ = strconv.ParseUint(urlArgs.Get("f.orphaned"), 10, 64)
+			withOrphaned, _ := strconv.ParseUint(urlArgs.Get("f.orphaned"), 10, 64)
+			pageArgs.WithOrphaned = uint8(withOrphaned)
 		}
 		if urlArgs.Has("f.type") {
-			withType, _ = strconv.ParseUint(urlArgs.Get("f.type"), 10, 64)
+			withType, _ := strconv.ParseUint(urlArgs.Get("f.type"), 10, 64)
+			pageArgs.WithType = uint8(withType)
 		}
 		if urlArgs.Has("f.pubkey") {
-			pubkey = urlArgs.Get("f.pubkey")
+			pageArgs.PubKey = urlArgs.Get("f.pubkey")
 		}
 	} else {
-		withOrphaned = 1
-	}
-	var pageError error
-	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
-	if pageError == nil {
-		data.Data, pageError = getFilteredElWithdrawalsPageData(pageIdx, pageSize, minSlot, maxSlot, sourceAddr, minIndex, maxIndex, vname, uint8(withOrphaned), uint8(withType), pubkey)
-	}
-	if pageError != nil {
-		handlePageError(w, r, pageError)
-		return
-	}
-	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "el_withdrawals.go", "ElWithdrawals", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
-		return // an error has occurred and was processed
+		pageArgs.WithOrphaned = 1
 	}
+
+	return pageArgs
 }
 
-func getFilteredElWithdrawalsPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, sourceAddr string, minIndex uint64, maxIndex uint64, vname string, withOrphaned uint8, withType uint8, pubkey string) (*models.ElWithdrawalsPageData, error) {
+func getFilteredElWithdrawalsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, sourceAddr string, minIndex uint64, maxIndex uint64, vname string, withOrphaned uint8, withType uint8, pubkey string) (*models.ElWithdrawalsPageData, error) {
 	pageData := &models.ElWithdrawalsPageData{}
 	pageCacheKey := fmt.Sprintf("el_withdrawals:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, minSlot, maxSlot, sourceAddr, minIndex, maxIndex, vname, withOrphaned, withType, pubkey)
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
-		return buildFilteredElWithdrawalsPageData(pageIdx, pageSize, minSlot, maxSlot, sourceAddr, minIndex, maxIndex, vname, withOrphaned, withType, pubkey)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		return buildFilteredElWithdrawalsPageData(pageCall.CallCtx, pageIdx, pageSize, minSlot, maxSlot, sourceAddr, minIndex, maxIndex, vname, withOrphaned, withType, pubkey)
 	})
 	if pageErr == nil && pageRes != nil {
 		resData, resOk := pageRes.(*models.ElWithdrawalsPageData)
@@ -110,7 +130,7 @@ func getFilteredElWithdrawalsPageData(pageIdx uint64, pageSize uint64, minSlot u
 	return pageData, pageErr
 }
 
-func buildFilteredElWithdrawalsPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, sourceAddr string, minIndex uint64, maxIndex uint64, vname string, withOrphaned uint8, withType uint8, pubkey string) *models.ElWithdrawalsPageData {
+func buildFilteredElWithdrawalsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, sourceAddr string, minIndex uint64, maxIndex uint64, vname string, withOrphaned uint8, withType uint8, pubkey string) *models.ElWithdrawalsPageData {
 	filterArgs := url.Values{}
 	if minSlot != 0 {
 		filterArgs.Add("f.mins", fmt.Sprintf("%v", minSlot))
@@ -189,7 +209,7 @@ func buildFilteredElWithdrawalsPageData(pageIdx uint64, pageSize uint64, minSlot
 		withdrawalRequestFilter.Filter.MaxAmount = &maxAmount
 	}
 
-	dbElWithdrawals, totalRows := services.GlobalBeaconService.GetWithdrawalRequestsByFilter(withdrawalRequestFilter, pageIdx-1, uint32(pageSize))
+	dbElWithdrawals, totalRows := services.GlobalBeaconService.GetWithdrawalRequestsByFilter(ctx, withdrawalRequestFilter, pageIdx-1, uint32(pageSize))
 	chainState := services.GlobalBeaconService.GetChainState()
 	headBlock := services.GlobalBeaconService.GetBeaconIndexer().GetCanonicalHead(nil)
 	headBlockNum := uint64(0)
@@ -197,6 +217,14 @@ func buildFilteredElWithdrawalsPageData(pageIdx uint64, pageSize uint64, minSlot
 		headBlockNum = uint64(headBlock.GetBlockIndex().ExecutionNumber)
 	}
 
+	nameIndices := make([]uint64, 0, len(dbElWithdrawals))
+	for _, elWithdrawal := range dbElWithdrawals {
+		if validatorIndex := elWithdrawal.ValidatorIndex(); validatorIndex != nil {
+			nameIndices = append(nameIndices, *validatorIndex)
+		}
+	}
+	validatorNames := services.GlobalBeaconService.GetValidatorNames(nameIndices)
+
 	for _, elWithdrawal := range dbElWithdrawals {
 		elWithdrawalData := &models.ElWithdrawalsPageDataWithdrawal{
 			SourceAddr: elWithdrawal.SourceAddress(),
@@ -206,7 +234,7 @@ func buildFilteredElWithdrawalsPageData(pageIdx uint64, pageSize uint64, minSlot
 
 		if validatorIndex := elWithdrawal.ValidatorIndex(); validatorIndex != nil {
 			elWithdrawalData.ValidatorIndex = *validatorIndex
-			elWithdrawalData.ValidatorName = services.GlobalBeaconService.GetValidatorName(*validatorIndex)
+			elWithdrawalData.ValidatorName = validatorNames[*validatorIndex]
 			elWithdrawalData.ValidatorValid = true
 		}
 
@@ -231,6 +259,7 @@ func buildFilteredElWithdrawalsPageData(pageIdx uint64, pageSize uint64, minSlot
 				TxOrigin:    common.Address(transaction.TxSender).Hex(),
 				TxTarget:    common.Address(transaction.TxTarget).Hex(),
 				TxHash:      fmt.Sprintf("%#x", transaction.TxHash),
+				TxFee:       formatRequestTxFee(transaction.TxFee),
 			}
 			elWithdrawalData.TxStatus = uint64(1)
 			if elWithdrawal.TransactionOrphaned {
@@ -273,3 +302,43 @@ func buildFilteredElWithdrawalsPageData(pageIdx uint64, pageSize uint64, minSlot
 
 	return pageData
 }
+
+// formatRequestTxFee formats the fee paid to a system contract, returns an empty string if the fee is unknown (requests sent via other contracts)
+func formatRequestTxFee(fee []byte) string {
+	if fee == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v wei", new(big.Int).SetBytes(fee).String())
+}
+
+// exportElWithdrawalsCsv streams all withdrawal requests matching the page filters as csv file.
+func exportElWithdrawalsCsv(w http.ResponseWriter, r *http.Request, pageArgs *elWithdrawalsPageArgs) {
+	header := []string{"slot", "time", "status", "source_address", "validator_index", "validator_name", "validator_pubkey", "amount", "tx_hash", "tx_block"}
+	writeCsvExport(w, r, "el_withdrawals", header, func(pageIdx uint64) ([][]string, bool) {
+		pageData := buildFilteredElWithdrawalsPageData(r.Context(), pageIdx+1, csvExportPageSize, pageArgs.MinSlot, pageArgs.MaxSlot, pageArgs.SourceAddr, pageArgs.MinIndex, pageArgs.MaxIndex, pageArgs.ValidatorName, pageArgs.WithOrphaned, pageArgs.WithType, pageArgs.PubKey)
+		rows := make([][]string, 0, len(pageData.ElRequests))
+		for _, request := range pageData.ElRequests {
+			validatorIndex := ""
+			if request.ValidatorValid {
+				validatorIndex = csvUint(request.ValidatorIndex)
+			}
+			txBlock := ""
+			if request.TransactionDetails != nil {
+				txBlock = csvUint(request.TransactionDetails.BlockNumber)
+			}
+			rows = append(rows, []string{
+				csvUint(request.SlotNumber),
+				csvTime(request.Time),
+				csvRequestStatus(request.Status),
+				csvHex(request.SourceAddr),
+				validatorIndex,
+				csvText(request.ValidatorName),
+				csvHex(request.PublicKey),
+				csvUint(request.Amount),
+				csvHex(request.TransactionHash),
+				txBlock,
+			})
+		}
+		return rows, pageData.NextPageIndex > 0
+	})
+}
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// ElectraStats will return the "electra stats" page using a go template
+func ElectraStats(w http.ResponseWriter, r *http.Request) {
+	var electraStatsTemplateFiles = append(layoutTemplateFiles,
+		"electra_stats/electra_stats.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(electraStatsTemplateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/electra", "Electra Stats", electraStatsTemplateFiles)
+
+	var pageError error
+	var atEpoch int64
+	atEpoch, pageError = parseHistoricEpoch(r.URL.Query())
+	if pageError == nil {
+		pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	}
+	if pageError == nil {
+		data.Data, pageError = getElectraStatsPageData(r.Context(), atEpoch)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "electra_stats.go", "Electra Stats", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getElectraStatsPageData(ctx context.Context, atEpoch int64) (*models.ElectraStatsPageData, error) {
+	pageData := &models.ElectraStatsPageData{}
+	pageCacheKey := fmt.Sprintf("electra_stats:%v", atEpoch)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildElectraStatsPageData(pageCall.CallCtx, atEpoch)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ElectraStatsPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildElectraStatsPageData(ctx context.Context, atEpoch int64) (*models.ElectraStatsPageData, time.Duration) {
+	logrus.Debugf("electra stats page called: %v", atEpoch)
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	pageData := &models.ElectraStatsPageData{}
+
+	// historical views show the latest stored snapshot at the requested epoch, the balance distribution is not part of the snapshots
+	var stats *services.ElectraStats
+	if atEpoch >= 0 {
+		pageData.IsHistoric = true
+		pageData.HistoricEpoch = uint64(atEpoch)
+		if snapshot := db.GetElectraStatsAtEpoch(ctx, uint64(atEpoch)); snapshot != nil {
+			stats = &services.ElectraStats{
+				ElectraStats: *snapshot,
+			}
+		}
+	} else {
+		stats = services.GlobalBeaconService.GetElectraStats(ctx)
+	}
+	if stats == nil {
+		return pageData, 1 * time.Minute
+	}
+
+	pageData.Available = true
+	pageData.Epoch = stats.Epoch
+	pageData.Ts = chainState.EpochToTime(phase0.Epoch(stats.Epoch))
+	finalizedEpoch, _ := services.GlobalBeaconService.GetFinalizedEpoch()
+	pageData.FinalizedEpoch = uint64(finalizedEpoch)
+	pageData.MaxEffectiveBalance = chainState.GetSpecs().MaxEffectiveBalanceElectra
+	pageData.ActiveValidators = stats.BlsValidators + stats.ExecValidators + stats.CompoundingValidators
+	pageData.TotalEffectiveBalance = stats.TotalEffectiveBalance
+	pageData.BlsValidators = stats.BlsValidators
+	pageData.ExecValidators = stats.ExecValidators
+	pageData.CompoundingValidators = stats.CompoundingValidators
+	pageData.CompoundingBalance = stats.CompoundingBalance
+	pageData.CompoundingMaxEb = stats.CompoundingMaxEb
+	pageData.Consolidations = stats.Consolidations
+	pageData.CredentialSwitches = stats.CredentialSwitches
+
+	if pageData.ActiveValidators > 0 {
+		pageData.BlsPercent = float64(stats.BlsValidators) * 100 / float64(pageData.ActiveValidators)
+		pageData.ExecPercent = float64(stats.ExecValidators) * 100 / float64(pageData.ActiveValidators)
+		pageData.CompoundingPercent = float64(stats.CompoundingValidators) * 100 / float64(pageData.ActiveValidators)
+	}
+	if stats.TotalEffectiveBalance > 0 {
+		pageData.CompoundingEbPercent = float64(stats.CompoundingBalance) * 100 / float64(stats.TotalEffectiveBalance)
+	}
+	if stats.CompoundingValidators > 0 {
+		pageData.CompoundingAvgBalance = stats.CompoundingBalance / stats.CompoundingValidators
+		pageData.UtilizationPercent = float64(pageData.CompoundingAvgBalance) * 100 / float64(pageData.MaxEffectiveBalance)
+	}
+
+	pageData.Buckets = make([]*models.ElectraStatsPageDataBucket, 0, len(stats.EffectiveBalanceBuckets))
+	for _, bucket := range stats.EffectiveBalanceBuckets {
+		bucketData := &models.ElectraStatsPageDataBucket{
+			MinBalance:     bucket.MinBalance,
+			MaxBalance:     bucket.MaxBalance,
+			ValidatorCount: bucket.ValidatorCount,
+			Balance:        bucket.Balance,
+		}
+		if stats.CompoundingValidators > 0 {
+			bucketData.Percent = float64(bucket.ValidatorCount) * 100 / float64(stats.CompoundingValidators)
+		}
+		pageData.Buckets = append(pageData.Buckets, bucketData)
+	}
+
+	// history snapshots are sorted descending, the balance diff (in ETH) refers to the previous (older) snapshot
+	history := db.GetElectraStats(ctx, 100)
+	pageData.History = make([]*models.ElectraStatsPageDataEpoch, 0, len(history))
+	for idx, snapshot := range history {
+		activeValidators := snapshot.BlsValidators + snapshot.ExecValidators + snapshot.CompoundingValidators
+		epochData := &models.ElectraStatsPageDataEpoch{
+			Epoch:                 snapshot.Epoch,
+			Ts:                    chainState.EpochToTime(phase0.Epoch(snapshot.Epoch)),
+			CompoundingValidators: snapshot.CompoundingValidators,
+			CompoundingBalance:    snapshot.CompoundingBalance,
+			CompoundingMaxEb:      snapshot.CompoundingMaxEb,
+			Consolidations:        snapshot.Consolidations,
+			CredentialSwitches:    snapshot.CredentialSwitches,
+		}
+		if activeValidators > 0 {
+			epochData.CompoundingPercent = float64(snapshot.CompoundingValidators) * 100 / float64(activeValidators)
+		}
+		if snapshot.TotalEffectiveBalance > 0 {
+			epochData.CompoundingEbPercent = float64(snapshot.CompoundingBalance) * 100 / float64(snapshot.TotalEffectiveBalance)
+		}
+		if idx+1 < len(history) {
+			epochData.CompoundingBalanceDiff = (int64(snapshot.CompoundingBalance) - int64(history[idx+1].CompoundingBalance)) / int64(utils.GWEI.Uint64())
+		}
+		pageData.History = append(pageData.History, epochData)
+	}
+
+	if pageData.IsHistoric {
+		return pageData, 30 * time.Minute
+	}
+	return pageData, 10 * time.Minute
+}
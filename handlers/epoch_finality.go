@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// EpochFinality will return the "epoch finality" page using a go template
+func EpochFinality(w http.ResponseWriter, r *http.Request) {
+	var epochFinalityTemplateFiles = append(layoutTemplateFiles,
+		"epoch_finality/epoch_finality.html",
+	)
+	var notfoundTemplateFiles = append(layoutTemplateFiles,
+		"epoch/notfound.html",
+	)
+	var pageTemplate = templates.GetTemplate(epochFinalityTemplateFiles...)
+
+	vars := mux.Vars(r)
+	epoch, err := strconv.ParseUint(vars["epoch"], 10, 64)
+	if err != nil {
+		handlePageError(w, r, fmt.Errorf("invalid epoch"))
+		return
+	}
+
+	var pageData *models.EpochFinalityPageData
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		pageData, pageError = getEpochFinalityPageData(r.Context(), epoch)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	if pageData == nil {
+		data := InitPageData(w, r, "blockchain", "/epoch", fmt.Sprintf("Epoch %v", epoch), notfoundTemplateFiles)
+		w.Header().Set("Content-Type", "text/html")
+		if handleTemplateError(w, r, "epoch_finality.go", "Epoch Finality", "", templates.GetTemplate(notfoundTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+			return // an error has occurred and was processed
+		}
+		return
+	}
+
+	data := InitPageData(w, r, "blockchain", "/epoch", fmt.Sprintf("Epoch %v Finality", epoch), epochFinalityTemplateFiles)
+	data.Data = pageData
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "epoch_finality.go", "Epoch Finality", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getEpochFinalityPageData(ctx context.Context, epoch uint64) (*models.EpochFinalityPageData, error) {
+	pageData := &models.EpochFinalityPageData{}
+	pageCacheKey := fmt.Sprintf("epoch_finality:%v", epoch)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildEpochFinalityPageData(pageCall.CallCtx, epoch)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.EpochFinalityPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildEpochFinalityPageData(ctx context.Context, epoch uint64) (*models.EpochFinalityPageData, time.Duration) {
+	logrus.Debugf("epoch finality page called: %v", epoch)
+
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+	chainState := services.GlobalBeaconService.GetChainState()
+	currentEpoch := chainState.CurrentEpoch()
+	if epoch > uint64(currentEpoch) {
+		return nil, -1
+	}
+
+	finalizedEpoch, _ := services.GlobalBeaconService.GetFinalizedEpoch()
+	nextEpoch := epoch + 1
+	if nextEpoch > uint64(currentEpoch) {
+		nextEpoch = 0
+	}
+
+	pageData := &models.EpochFinalityPageData{
+		Epoch:         epoch,
+		PreviousEpoch: epoch - 1,
+		NextEpoch:     nextEpoch,
+		Ts:            chainState.SlotToTime(chainState.EpochToSlot(phase0.Epoch(epoch))),
+		Finalized:     finalizedEpoch > phase0.Epoch(epoch),
+	}
+
+	// classify validators by the client names found in their validator names
+	groupCache := map[string]string{}
+	getGroup := func(validatorIndex phase0.ValidatorIndex) string {
+		name := services.GlobalBeaconService.GetValidatorName(uint64(validatorIndex))
+		group, found := groupCache[name]
+		if !found {
+			group = services.GetClientGroupName(name)
+			groupCache[name] = group
+		}
+		return group
+	}
+
+	breakdown := beaconIndexer.GetEpochFinalityBreakdown(phase0.Epoch(epoch), nil, getGroup)
+	if breakdown == nil {
+		return pageData, 5 * time.Minute
+	}
+
+	pageData.Available = true
+	pageData.TargetRoot = breakdown.TargetRoot[:]
+	pageData.EligibleAmount = uint64(breakdown.EligibleAmount)
+	pageData.RequiredAmount = uint64(breakdown.EligibleAmount) * 2 / 3
+	pageData.TargetVoteAmount = uint64(breakdown.TargetVoteAmount)
+	pageData.MissingAmount = uint64(breakdown.Missing.VoteAmount)
+	pageData.MissingCount = breakdown.Missing.ValidatorCount
+	if breakdown.EligibleAmount > 0 {
+		pageData.TargetVotePercent = float64(breakdown.TargetVoteAmount) * 100 / float64(breakdown.EligibleAmount)
+		pageData.MissingPercent = float64(breakdown.Missing.VoteAmount) * 100 / float64(breakdown.EligibleAmount)
+	}
+
+	if breakdown.Justification != nil {
+		pageData.HasJustification = true
+		pageData.JustificationEpoch = uint64(breakdown.JustificationEpoch)
+		pageData.JustificationBits = fmt.Sprintf("%04b", breakdown.Justification.JustificationBits)
+		pageData.JustificationFinal = breakdown.JustificationFinal
+		pageData.Justified = breakdown.Justified
+		pageData.PreviousJustifiedEpoch = uint64(breakdown.Justification.PreviousJustified.Epoch)
+		pageData.PreviousJustifiedRoot = breakdown.Justification.PreviousJustified.Root[:]
+		pageData.CurrentJustifiedEpoch = uint64(breakdown.Justification.CurrentJustified.Epoch)
+		pageData.CurrentJustifiedRoot = breakdown.Justification.CurrentJustified.Root[:]
+		pageData.FinalizedEpoch = uint64(breakdown.Justification.Finalized.Epoch)
+		pageData.FinalizedRoot = breakdown.Justification.Finalized.Root[:]
+	}
+
+	pageData.Targets = make([]*models.EpochFinalityPageDataTarget, 0, len(breakdown.Targets))
+	for _, target := range breakdown.Targets {
+		targetData := &models.EpochFinalityPageDataTarget{
+			Root:           target.TargetRoot[:],
+			Epoch:          uint64(target.TargetEpoch),
+			Canonical:      target.IsCanonical,
+			VoteAmount:     uint64(target.VoteAmount),
+			ValidatorCount: target.ValidatorCount,
+		}
+		if breakdown.EligibleAmount > 0 {
+			targetData.VotePercent = float64(target.VoteAmount) * 100 / float64(breakdown.EligibleAmount)
+		}
+		pageData.Targets = append(pageData.Targets, targetData)
+	}
+
+	// build per group rows with one vote column per target
+	groupMap := map[string]*models.EpochFinalityPageDataGroup{}
+	getGroupData := func(name string) *models.EpochFinalityPageDataGroup {
+		groupData := groupMap[name]
+		if groupData == nil {
+			groupData = &models.EpochFinalityPageDataGroup{
+				Name:    name,
+				Votes:   make([]*models.EpochFinalityPageDataGroupVotes, len(breakdown.Targets)),
+				Missing: &models.EpochFinalityPageDataGroupVotes{},
+			}
+			for idx := range groupData.Votes {
+				groupData.Votes[idx] = &models.EpochFinalityPageDataGroupVotes{}
+			}
+			groupMap[name] = groupData
+		}
+		return groupData
+	}
+
+	addGroupVotes := func(groupVotes *models.EpochFinalityPageDataGroupVotes, groupData *models.EpochFinalityPageDataGroup, votes *beacon.EpochVoteGroup) {
+		groupVotes.VoteAmount = uint64(votes.VoteAmount)
+		groupVotes.ValidatorCount = votes.ValidatorCount
+		groupData.EligibleAmount += uint64(votes.VoteAmount)
+		groupData.ValidatorCount += votes.ValidatorCount
+	}
+
+	for targetIdx, target := range breakdown.Targets {
+		for name, votes := range target.Groups {
+			groupData := getGroupData(name)
+			addGroupVotes(groupData.Votes[targetIdx], groupData, votes)
+		}
+	}
+	for name, votes := range breakdown.Missing.Groups {
+		groupData := getGroupData(name)
+		addGroupVotes(groupData.Missing, groupData, votes)
+	}
+
+	pageData.Groups = make([]*models.EpochFinalityPageDataGroup, 0, len(groupMap))
+	for _, groupData := range groupMap {
+		if groupData.EligibleAmount > 0 {
+			for targetIdx, groupVotes := range groupData.Votes {
+				groupVotes.VotePercent = float64(groupVotes.VoteAmount) * 100 / float64(groupData.EligibleAmount)
+				if breakdown.Targets[targetIdx].IsCanonical {
+					groupData.TargetPercent = groupVotes.VotePercent
+				}
+			}
+			groupData.Missing.VotePercent = float64(groupData.Missing.VoteAmount) * 100 / float64(groupData.EligibleAmount)
+		}
+		pageData.Groups = append(pageData.Groups, groupData)
+	}
+	sort.Slice(pageData.Groups, func(i, j int) bool {
+		return pageData.Groups[i].EligibleAmount > pageData.Groups[j].EligibleAmount
+	})
+
+	var cacheTimeout time.Duration
+	if pageData.Finalized {
+		cacheTimeout = 30 * time.Minute
+	} else {
+		cacheTimeout = 12 * time.Second
+	}
+	return pageData, cacheTimeout
+}
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// EpochSyncRewards will return the "epoch sync rewards" page using a go template
+func EpochSyncRewards(w http.ResponseWriter, r *http.Request) {
+	var epochSyncRewardsTemplateFiles = append(layoutTemplateFiles,
+		"epoch_sync_rewards/epoch_sync_rewards.html",
+	)
+	var notfoundTemplateFiles = append(layoutTemplateFiles,
+		"epoch/notfound.html",
+	)
+	var pageTemplate = templates.GetTemplate(epochSyncRewardsTemplateFiles...)
+
+	vars := mux.Vars(r)
+	epoch, err := strconv.ParseUint(vars["epoch"], 10, 64)
+	if err != nil {
+		handlePageError(w, r, fmt.Errorf("invalid epoch"))
+		return
+	}
+
+	var pageData *models.EpochSyncRewardsPageData
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		pageData, pageError = getEpochSyncRewardsPageData(r.Context(), epoch)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	if pageData == nil {
+		data := InitPageData(w, r, "blockchain", "/epoch", fmt.Sprintf("Epoch %v", epoch), notfoundTemplateFiles)
+		w.Header().Set("Content-Type", "text/html")
+		if handleTemplateError(w, r, "epoch_sync_rewards.go", "Epoch Sync Rewards", "", templates.GetTemplate(notfoundTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+			return // an error has occurred and was processed
+		}
+		return
+	}
+
+	data := InitPageData(w, r, "blockchain", "/epoch", fmt.Sprintf("Epoch %v Sync Rewards", epoch), epochSyncRewardsTemplateFiles)
+	data.Data = pageData
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "epoch_sync_rewards.go", "Epoch Sync Rewards", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getEpochSyncRewardsPageData(ctx context.Context, epoch uint64) (*models.EpochSyncRewardsPageData, error) {
+	pageData := &models.EpochSyncRewardsPageData{}
+	pageCacheKey := fmt.Sprintf("epoch_sync_rewards:%v", epoch)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildEpochSyncRewardsPageData(pageCall.CallCtx, epoch)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.EpochSyncRewardsPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildEpochSyncRewardsPageData(ctx context.Context, epoch uint64) (*models.EpochSyncRewardsPageData, time.Duration) {
+	logrus.Debugf("epoch sync rewards page called: %v", epoch)
+
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+	chainState := services.GlobalBeaconService.GetChainState()
+	currentEpoch := chainState.CurrentEpoch()
+	if epoch > uint64(currentEpoch) {
+		return nil, -1
+	}
+
+	finalizedEpoch, _ := services.GlobalBeaconService.GetFinalizedEpoch()
+	nextEpoch := epoch + 1
+	if nextEpoch > uint64(currentEpoch) {
+		nextEpoch = 0
+	}
+
+	pageData := &models.EpochSyncRewardsPageData{
+		Epoch:         epoch,
+		PreviousEpoch: epoch - 1,
+		NextEpoch:     nextEpoch,
+		Ts:            chainState.SlotToTime(chainState.EpochToSlot(phase0.Epoch(epoch))),
+		Finalized:     finalizedEpoch > phase0.Epoch(epoch),
+	}
+
+	// classify validators by the client names found in their validator names
+	groupCache := map[string]string{}
+	getGroup := func(validatorIndex phase0.ValidatorIndex) string {
+		name := services.GlobalBeaconService.GetValidatorName(uint64(validatorIndex))
+		group, found := groupCache[name]
+		if !found {
+			group = services.GetClientGroupName(name)
+			groupCache[name] = group
+		}
+		return group
+	}
+
+	syncRewards := beaconIndexer.GetEpochSyncRewards(ctx, phase0.Epoch(epoch), nil, getGroup)
+	if syncRewards == nil {
+		return pageData, 5 * time.Minute
+	}
+
+	pageData.Available = true
+	pageData.ParticipantReward = uint64(syncRewards.ParticipantReward)
+	pageData.BlockCount = syncRewards.BlockCount
+	pageData.EstimatedCount = syncRewards.EstimatedCount
+	pageData.MemberCount = uint64(len(syncRewards.Members))
+
+	var dutyCount, missedCount uint64
+	pageData.Members = make([]*models.EpochSyncRewardsPageDataMember, 0, len(syncRewards.Members))
+	for _, member := range syncRewards.Members {
+		memberData := &models.EpochSyncRewardsPageDataMember{
+			Index:          uint64(member.ValidatorIndex),
+			Name:           services.GlobalBeaconService.GetValidatorName(uint64(member.ValidatorIndex)),
+			Group:          member.Group,
+			Positions:      member.Positions,
+			DutyCount:      member.DutyCount,
+			MissedCount:    member.MissedCount,
+			ExpectedReward: uint64(member.ExpectedReward),
+			ActualReward:   member.ActualReward,
+		}
+		if member.DutyCount > 0 {
+			memberData.ParticipationPercent = float64(member.DutyCount-member.MissedCount) * 100 / float64(member.DutyCount)
+		}
+		pageData.Members = append(pageData.Members, memberData)
+
+		dutyCount += member.DutyCount
+		missedCount += member.MissedCount
+		pageData.ExpectedReward += uint64(member.ExpectedReward)
+		pageData.ActualReward += member.ActualReward
+	}
+	if dutyCount > 0 {
+		pageData.ParticipationPercent = float64(dutyCount-missedCount) * 100 / float64(dutyCount)
+	}
+
+	pageData.Groups = make([]*models.EpochSyncRewardsPageDataGroup, 0, len(syncRewards.Groups))
+	for name, group := range syncRewards.Groups {
+		groupData := &models.EpochSyncRewardsPageDataGroup{
+			Name:           name,
+			MemberCount:    group.MemberCount,
+			DutyCount:      group.DutyCount,
+			MissedCount:    group.MissedCount,
+			ExpectedReward: uint64(group.ExpectedReward),
+			ActualReward:   group.ActualReward,
+			MissedReward:   uint64(int64(group.ExpectedReward) - group.ActualReward),
+		}
+		if group.DutyCount > 0 {
+			groupData.ParticipationPercent = float64(group.DutyCount-group.MissedCount) * 100 / float64(group.DutyCount)
+
+			// flag groups that systematically miss their sync duties compared to the rest of the committee
+			groupData.Failing = groupData.ParticipationPercent < 90 && groupData.ParticipationPercent < pageData.ParticipationPercent-5
+		}
+		pageData.Groups = append(pageData.Groups, groupData)
+	}
+	sort.Slice(pageData.Groups, func(i, j int) bool {
+		if pageData.Groups[i].MemberCount != pageData.Groups[j].MemberCount {
+			return pageData.Groups[i].MemberCount > pageData.Groups[j].MemberCount
+		}
+		return pageData.Groups[i].Name < pageData.Groups[j].Name
+	})
+
+	var cacheTimeout time.Duration
+	if pageData.Finalized {
+		cacheTimeout = 30 * time.Minute
+	} else {
+		cacheTimeout = 12 * time.Second
+	}
+	return pageData, cacheTimeout
+}
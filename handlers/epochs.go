@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"net/http"
@@ -33,11 +34,12 @@ func Epochs(w http.ResponseWriter, r *http.Request) {
 	if urlArgs.Has("count") {
 		pageSize, _ = strconv.ParseUint(urlArgs.Get("count"), 10, 64)
 	}
+	forkName := urlArgs.Get("fork")
 
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
 	if pageError == nil {
-		data.Data, pageError = getEpochsPageData(firstEpoch, pageSize)
+		data.Data, pageError = getEpochsPageData(r.Context(), firstEpoch, pageSize, forkName)
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -49,11 +51,11 @@ func Epochs(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getEpochsPageData(firstEpoch uint64, pageSize uint64) (*models.EpochsPageData, error) {
+func getEpochsPageData(ctx context.Context, firstEpoch uint64, pageSize uint64, forkName string) (*models.EpochsPageData, error) {
 	pageData := &models.EpochsPageData{}
-	pageCacheKey := fmt.Sprintf("epochs:%v:%v", firstEpoch, pageSize)
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
-		pageData, cacheTimeout := buildEpochsPageData(firstEpoch, pageSize)
+	pageCacheKey := fmt.Sprintf("epochs:%v:%v:%v", firstEpoch, pageSize, forkName)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildEpochsPageData(pageCall.CallCtx, firstEpoch, pageSize, forkName)
 		pageCall.CacheTimeout = cacheTimeout
 		return pageData
 	})
@@ -67,26 +69,62 @@ func getEpochsPageData(firstEpoch uint64, pageSize uint64) (*models.EpochsPageDa
 	return pageData, pageErr
 }
 
-func buildEpochsPageData(firstEpoch uint64, pageSize uint64) (*models.EpochsPageData, time.Duration) {
-	logrus.Debugf("epochs page called: %v:%v", firstEpoch, pageSize)
-	pageData := &models.EpochsPageData{}
+func buildEpochsPageData(ctx context.Context, firstEpoch uint64, pageSize uint64, forkName string) (*models.EpochsPageData, time.Duration) {
+	logrus.Debugf("epochs page called: %v:%v:%v", firstEpoch, pageSize, forkName)
+	pageData := &models.EpochsPageData{
+		FilterFork: forkName,
+	}
 
 	chainState := services.GlobalBeaconService.GetChainState()
 	currentEpoch := chainState.CurrentEpoch()
-	if firstEpoch > uint64(currentEpoch) {
+
+	// fork filter options & activation markers
+	forkSchedule := chainState.GetForkSchedule()
+	forkActivations := map[uint64][]string{}
+	for _, fork := range forkSchedule {
+		forkActivations[uint64(fork.Epoch)] = append(forkActivations[uint64(fork.Epoch)], fork.Name)
+		if fork.Epoch > currentEpoch {
+			continue
+		}
+		if _, _, ok := chainState.GetForkEpochRange(fork.Name); ok {
+			pageData.ForkOptions = append(pageData.ForkOptions, &models.EpochsPageDataFork{
+				Name:  fork.Name,
+				Epoch: uint64(fork.Epoch),
+			})
+		}
+	}
+
+	// epoch range to show, restricted to the epochs of the selected fork
+	rangeStart := uint64(0)
+	rangeEnd := uint64(currentEpoch)
+	if forkName != "" {
+		forkStart, forkEnd, ok := chainState.GetForkEpochRange(forkName)
+		if !ok || forkStart > currentEpoch {
+			pageData.IsDefaultPage = true
+			return pageData, 12 * time.Second
+		}
+		rangeStart = uint64(forkStart)
+		if uint64(forkEnd) < rangeEnd {
+			rangeEnd = uint64(forkEnd)
+		}
+	}
+
+	if firstEpoch > rangeEnd {
 		pageData.IsDefaultPage = true
-		firstEpoch = uint64(currentEpoch)
+		firstEpoch = rangeEnd
+	} else if firstEpoch < rangeStart {
+		firstEpoch = rangeStart
 	}
 
 	if pageSize > 100 {
 		pageSize = 100
 	}
-	pagesBefore := (firstEpoch + 1) / pageSize
-	if ((firstEpoch + 1) % pageSize) > 0 {
+	pagesBefore := (firstEpoch - rangeStart + 1) / pageSize
+	if ((firstEpoch - rangeStart + 1) % pageSize) > 0 {
 		pagesBefore++
 	}
-	pagesAfter := (uint64(currentEpoch) - firstEpoch) / pageSize
-	if ((uint64(currentEpoch) - firstEpoch) % pageSize) > 0 {
+	pagesAfter := (rangeEnd - firstEpoch) / pageSize
+	if ((rangeEnd - firstEpoch) % pageSize) > 0 {
 		pagesAfter++
 	}
 	pageData.PageSize = pageSize
@@ -95,11 +133,11 @@ func buildEpochsPageData(firstEpoch uint64, pageSize uint64) (*models.EpochsPage
 	pageData.CurrentPageEpoch = firstEpoch
 	pageData.PrevPageIndex = pageData.CurrentPageIndex - 1
 	pageData.PrevPageEpoch = pageData.CurrentPageEpoch + pageSize
-	if pageData.CurrentPageEpoch >= pageSize {
+	if pageData.CurrentPageEpoch >= rangeStart+pageSize {
 		pageData.NextPageIndex = pageData.CurrentPageIndex + 1
 		pageData.NextPageEpoch = pageData.CurrentPageEpoch - pageSize
 	}
-	pageData.LastPageEpoch = pageSize - 1
+	pageData.LastPageEpoch = rangeStart + pageSize - 1
 
 	finalizedEpoch, _ := chainState.GetFinalizedCheckpoint()
 	justifiedEpoch, _ := chainState.GetJustifiedCheckpoint()
@@ -107,13 +145,13 @@ func buildEpochsPageData(firstEpoch uint64, pageSize uint64) (*models.EpochsPage
 
 	// load epochs
 	pageData.Epochs = make([]*models.EpochsPageDataEpoch, 0)
-	dbEpochs := services.GlobalBeaconService.GetDbEpochs(uint64(firstEpoch), uint32(epochLimit))
+	dbEpochs := services.GlobalBeaconService.GetDbEpochs(ctx, uint64(firstEpoch), uint32(epochLimit))
 	dbIdx := 0
 	dbCnt := len(dbEpochs)
 	epochCount := uint64(0)
 	allFinalized := true
 	allSynchronized := true
-	for epochIdx := int64(firstEpoch); epochIdx >= 0 && epochCount < epochLimit; epochIdx-- {
+	for epochIdx := int64(firstEpoch); epochIdx >= int64(rangeStart) && epochCount < epochLimit; epochIdx-- {
 		epoch := uint64(epochIdx)
 		finalized := int64(finalizedEpoch) > epochIdx
 		if !finalized {
@@ -124,6 +162,7 @@ func buildEpochsPageData(firstEpoch uint64, pageSize uint64) (*models.EpochsPage
 			Ts:        chainState.EpochToTime(phase0.Epoch(epoch)),
 			Finalized: finalized,
 			Justified: int64(justifiedEpoch) > epochIdx,
+			Forks:     forkActivations[epoch],
 		}
 		if dbIdx < dbCnt && dbEpochs[dbIdx] != nil && dbEpochs[dbIdx].Epoch == epoch {
 			dbEpoch := dbEpochs[dbIdx]
@@ -82,6 +82,11 @@ func NotFound(w http.ResponseWriter, r *http.Request) {
 }
 
 func handlePageError(w http.ResponseWriter, r *http.Request, pageError error) {
+	if r.Context().Err() != nil {
+		// client disconnected, nobody is left to see the error page
+		return
+	}
+
 	templateFiles := append(layoutTemplateFiles, "_layout/500.html")
 	notFoundTemplate := templates.GetTemplate(templateFiles...)
 	w.Header().Set("Content-Type", "text/html")
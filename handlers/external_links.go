@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/url"
+	"regexp"
+
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+var externalLinkPlaceholderPattern = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+// getExternalLinks returns the configured external links of a page with their placeholders replaced by the given values.
+// links using a placeholder without value (e.g. {block} of a pre-merge slot) are skipped.
+func getExternalLinks(page string, values map[string]string) []*types.ExternalLink {
+	links := []*types.ExternalLink{}
+	for _, linkConfig := range utils.Config().Frontend.ExternalLinks {
+		if linkConfig.Page != page {
+			continue
+		}
+
+		complete := true
+		linkUrl := externalLinkPlaceholderPattern.ReplaceAllStringFunc(linkConfig.Url, func(placeholder string) string {
+			value := values[placeholder[1:len(placeholder)-1]]
+			if value == "" {
+				complete = false
+			}
+			return url.PathEscape(value)
+		})
+		if !complete {
+			continue
+		}
+
+		links = append(links, &types.ExternalLink{
+			Name: linkConfig.Name,
+			Url:  linkUrl,
+		})
+	}
+	return links
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 	"github.com/ethpandaops/dora/services"
 	"github.com/ethpandaops/dora/templates"
 	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
 	"github.com/sirupsen/logrus"
 )
 
@@ -27,7 +29,7 @@ func Forks(w http.ResponseWriter, r *http.Request) {
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
 	if pageError == nil {
-		data.Data, pageError = getForksPageData()
+		data.Data, pageError = getForksPageData(r.Context())
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -54,11 +56,11 @@ func Forks(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getForksPageData() (*models.ForksPageData, error) {
+func getForksPageData(ctx context.Context) (*models.ForksPageData, error) {
 	pageData := &models.ForksPageData{}
 	pageCacheKey := "forks"
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
-		pageData, cacheTimeout := buildForksPageData()
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildForksPageData(pageCall.CallCtx)
 		pageCall.CacheTimeout = cacheTimeout
 		return pageData
 	})
@@ -72,7 +74,7 @@ func getForksPageData() (*models.ForksPageData, error) {
 	return pageData, pageErr
 }
 
-func buildForksPageData() (*models.ForksPageData, time.Duration) {
+func buildForksPageData(ctx context.Context) (*models.ForksPageData, time.Duration) {
 	logrus.Debugf("forks page called")
 	pageData := &models.ForksPageData{}
 
@@ -89,7 +91,7 @@ func buildForksPageData() (*models.ForksPageData, time.Duration) {
 		}
 		if fork.Slot < chainState.EpochToSlot(finalizedEpoch) {
 			// check block
-			dbBlock := db.GetSlotByRoot(fork.Root[:])
+			dbBlock := db.GetSlotByRoot(ctx, fork.Root[:])
 			if dbBlock != nil && dbBlock.Status == dbtypes.Canonical {
 				headForks[0].AllClients = append(headForks[0].AllClients, fork.AllClients...)
 				headForks[idx] = nil
@@ -140,5 +142,51 @@ func buildForksPageData() (*models.ForksPageData, time.Duration) {
 	}
 	pageData.ForkCount = uint64(len(pageData.Forks))
 
+	if services.GlobalShadowForkDetector != nil {
+		pageData.ShadowFork = buildForksPageShadowForkData(services.GlobalShadowForkDetector.GetStatus())
+	}
+
 	return pageData, cacheTime
 }
+
+func buildForksPageShadowForkData(status *services.ShadowForkStatus) *models.ForksPageDataShadowFork {
+	shadowFork := &models.ForksPageDataShadowFork{
+		UpstreamName: utils.Config().ShadowFork.UpstreamName,
+	}
+	if shadowFork.UpstreamName == "" {
+		shadowFork.UpstreamName = "upstream"
+	}
+	if status == nil {
+		return shadowFork
+	}
+
+	shadowFork.HasStatus = true
+	shadowFork.LastCheck = status.LastCheck
+	shadowFork.CheckError = status.CheckError
+	shadowFork.Diverged = status.Diverged
+	shadowFork.LocalFirstBlock = status.LocalFirstBlock
+	shadowFork.LocalHeadBlock = status.LocalHeadBlock
+	shadowFork.UpstreamHeadBlock = status.UpstreamHeadBlock
+
+	if status.LastCommonBlock != nil {
+		shadowFork.HasLastCommon = true
+		shadowFork.LastCommonBlock = status.LastCommonBlock.Number
+		shadowFork.LastCommonSlot = status.LastCommonBlock.Slot
+		shadowFork.LastCommonHash = status.LastCommonBlock.Hash
+	}
+	if status.Diverged {
+		shadowFork.ForkBlock = status.ForkBlock.Number
+		shadowFork.ForkSlot = status.ForkBlock.Slot
+		shadowFork.ForkTime = status.ForkTime
+		shadowFork.ForkHash = status.ForkBlock.Hash
+		shadowFork.UpstreamForkHash = status.UpstreamForkHash
+		if status.LocalHeadBlock >= status.ForkBlock.Number {
+			shadowFork.LocalBlocksSinceFork = status.LocalHeadBlock - status.ForkBlock.Number + 1
+		}
+		if status.UpstreamHeadBlock >= status.ForkBlock.Number {
+			shadowFork.UpstreamBlocksSinceFork = status.UpstreamHeadBlock - status.ForkBlock.Number + 1
+		}
+	}
+
+	return shadowFork
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// Genesis will return the "genesis" countdown page using a go template
+func Genesis(w http.ResponseWriter, r *http.Request) {
+	var genesisTemplateFiles = append(layoutTemplateFiles,
+		"genesis/genesis.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(genesisTemplateFiles...)
+	data := InitPageData(w, r, "index", "/genesis", "Genesis", genesisTemplateFiles)
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getGenesisPageData(r.Context())
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "genesis.go", "Genesis", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+// isBeforeGenesis returns true if the network genesis (as reported by the clients or configured) is in the future.
+func isBeforeGenesis() bool {
+	genesisTime, known := getNetworkGenesisTime()
+	return known && time.Now().Before(genesisTime)
+}
+
+// getNetworkGenesisTime returns the genesis time reported by the consensus clients, or the configured genesis time as fallback.
+func getNetworkGenesisTime() (time.Time, bool) {
+	chainState := services.GlobalBeaconService.GetChainState()
+	if chainState != nil {
+		if genesis := chainState.GetGenesis(); genesis != nil {
+			return genesis.GenesisTime, true
+		}
+	}
+
+	if utils.Config().Chain.GenesisTime > 0 {
+		return time.Unix(int64(utils.Config().Chain.GenesisTime), 0), true
+	}
+
+	return time.Time{}, false
+}
+
+func getGenesisPageData(ctx context.Context) (*models.GenesisPageData, error) {
+	pageData := &models.GenesisPageData{}
+	pageCacheKey := "genesis"
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildGenesisPageData(pageCall.CallCtx)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.GenesisPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildGenesisPageData(ctx context.Context) (*models.GenesisPageData, time.Duration) {
+	logrus.Debugf("genesis page called")
+
+	pageData := &models.GenesisPageData{
+		IsConfigured:    utils.Config().Chain.GenesisTime > 0,
+		NetworkName:     utils.Config().Chain.DisplayName,
+		ActivationLimit: 32 * utils.GWEI.Uint64(),
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	if specs := chainState.GetSpecs(); specs != nil {
+		if specs.MinActivationBalance > 0 {
+			pageData.ActivationLimit = specs.MinActivationBalance
+		} else {
+			pageData.ActivationLimit = specs.MaxEffectiveBalance
+		}
+		if pageData.NetworkName == "" {
+			pageData.NetworkName = specs.ConfigName
+		}
+	}
+
+	genesisTime, genesisKnown := getNetworkGenesisTime()
+	pageData.GenesisKnown = genesisKnown
+	pageData.GenesisTime = genesisTime
+	if !genesisKnown {
+		return pageData, 10 * time.Second
+	}
+
+	// build genesis validator set from the deposits indexed so far
+	validators, summary, err := db.GetGenesisDepositValidators(ctx, uint64(genesisTime.Unix()), pageData.ActivationLimit, 1000)
+	if err == nil {
+		pageData.ValidatorCount = summary.DepositCount
+		pageData.ActiveCount = summary.FirstIndex
+		pageData.TotalDeposited = summary.Amount
+		pageData.Validators = make([]*models.GenesisPageDataValidator, len(validators))
+
+		for idx, validator := range validators {
+			pageData.Validators[idx] = &models.GenesisPageDataValidator{
+				Index:                 uint64(idx),
+				PublicKey:             validator.PublicKey,
+				WithdrawalCredentials: validator.WithdrawalCredentials,
+				Amount:                validator.Amount,
+				DepositCount:          validator.DepositCount,
+				Active:                validator.Amount >= pageData.ActivationLimit,
+			}
+		}
+		pageData.ShownValidatorCount = uint64(len(pageData.Validators))
+	}
+
+	cacheTimeout := 30 * time.Second
+	if timeUntil := time.Until(genesisTime); timeUntil < cacheTimeout {
+		cacheTimeout = timeUntil
+	}
+	if cacheTimeout < time.Second {
+		cacheTimeout = time.Second
+	}
+
+	return pageData, cacheTimeout
+}
@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// maxGraffitiSearchLength limits the length of graffiti search terms & patterns
+const maxGraffitiSearchLength = 100
+
+// Graffiti will return the "graffiti" page using a go template, which shows the most used block graffitis
+// matching a substring or regex search, or the proposers of a single graffiti
+func Graffiti(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"graffiti/graffiti.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "blockchain", "/graffiti", "Graffiti", templateFiles)
+
+	urlArgs := r.URL.Query()
+	var pageSize uint64 = 50
+	if urlArgs.Has("c") {
+		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	var pageIdx uint64 = 1
+	if urlArgs.Has("p") {
+		pageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+		if pageIdx < 1 {
+			pageIdx = 1
+		}
+	}
+
+	search := urlArgs.Get("q")
+	isRegex := urlArgs.Get("mode") == "regex"
+	proposer := urlArgs.Get("v")
+	selected := urlArgs.Get("g")
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getGraffitiPageData(r.Context(), pageIdx, pageSize, search, isRegex, proposer, selected)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "graffiti.go", "Graffiti", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getGraffitiPageData(ctx context.Context, pageIdx uint64, pageSize uint64, search string, isRegex bool, proposer string, selected string) (*models.GraffitiPageData, error) {
+	pageData := &models.GraffitiPageData{}
+	pageCacheKey := fmt.Sprintf("graffiti:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, search, isRegex, proposer, selected)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		return buildGraffitiPageData(pageCall.CallCtx, pageIdx, pageSize, search, isRegex, proposer, selected)
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.GraffitiPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildGraffitiPageData(ctx context.Context, pageIdx uint64, pageSize uint64, search string, isRegex bool, proposer string, selected string) *models.GraffitiPageData {
+	logrus.Debugf("graffiti page called: %v:%v [%v,%v,%v,%v]", pageIdx, pageSize, search, isRegex, proposer, selected)
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	pageData := &models.GraffitiPageData{
+		FilterSearch:   search,
+		FilterRegex:    isRegex,
+		FilterProposer: proposer,
+		IndexedSlot:    services.GlobalBeaconService.GetGraffitiIndexState(ctx),
+	}
+
+	filterArgs := url.Values{}
+	if search != "" {
+		filterArgs.Add("q", search)
+	}
+	if isRegex {
+		filterArgs.Add("mode", "regex")
+	}
+	if proposer != "" {
+		filterArgs.Add("v", proposer)
+	}
+	if selected != "" {
+		filterArgs.Add("g", selected)
+	}
+
+	if pageIdx == 1 {
+		pageData.IsDefaultPage = true
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	} else if pageSize == 0 {
+		pageSize = 50
+	}
+	pageData.PageSize = pageSize
+	pageData.TotalPages = pageIdx
+	pageData.CurrentPageIndex = pageIdx
+	if pageIdx > 1 {
+		pageData.PrevPageIndex = pageIdx - 1
+	}
+	offset := (pageIdx - 1) * pageSize
+
+	var totalRows uint64
+	if selected != "" {
+		pageData.ShowProposers = true
+		pageData.Selected = selected
+		pageData.SelectedLink = "/slots/filtered?f&f.orphaned=0&f.graffiti=" + url.QueryEscape(selected)
+
+		proposers, total, err := db.GetGraffitiProposers(ctx, selected, offset, uint32(pageSize))
+		if err != nil {
+			pageData.FilterError = fmt.Sprintf("failed loading graffiti proposers: %v", err)
+		}
+		totalRows = total
+		for _, entry := range proposers {
+			pageData.Proposers = append(pageData.Proposers, &models.GraffitiPageDataProposer{
+				ValidatorIndex: entry.Proposer,
+				ValidatorName:  services.GlobalBeaconService.GetValidatorName(entry.Proposer),
+				BlocksLink:     fmt.Sprintf("%v&f.proposer=%v", pageData.SelectedLink, entry.Proposer),
+				BlockCount:     entry.BlockCount,
+				FirstSlot:      entry.FirstSlot,
+				LastSlot:       entry.LastSlot,
+				LastTime:       chainState.SlotToTime(phase0.Slot(entry.LastSlot)),
+			})
+		}
+	} else {
+		filter := &dbtypes.GraffitiFilter{
+			Search: search,
+			Regex:  isRegex,
+		}
+		if proposer != "" {
+			proposerIndex, err := strconv.ParseUint(proposer, 10, 64)
+			if err != nil {
+				pageData.FilterError = "invalid validator index"
+			} else {
+				filter.Proposer = &proposerIndex
+			}
+		}
+		if len(search) > maxGraffitiSearchLength {
+			pageData.FilterError = fmt.Sprintf("search is limited to %v characters", maxGraffitiSearchLength)
+		} else if isRegex {
+			if _, err := regexp.Compile(search); err != nil {
+				pageData.FilterError = fmt.Sprintf("invalid regular expression: %v", err)
+			}
+		}
+
+		if pageData.FilterError == "" {
+			stats, total, err := db.GetGraffitiStats(ctx, filter, offset, uint32(pageSize))
+			if err != nil {
+				pageData.FilterError = fmt.Sprintf("failed searching graffitis: %v", err)
+			}
+			totalRows = total
+			for _, stat := range stats {
+				pageData.Graffiti = append(pageData.Graffiti, &models.GraffitiPageDataEntry{
+					Graffiti:      stat.GraffitiText,
+					Link:          "/graffiti?g=" + url.QueryEscape(stat.GraffitiText),
+					BlocksLink:    "/slots/filtered?f&f.orphaned=0&f.graffiti=" + url.QueryEscape(stat.GraffitiText),
+					BlockCount:    stat.BlockCount,
+					ProposerCount: stat.ProposerCount,
+					FirstSlot:     stat.FirstSlot,
+					LastSlot:      stat.LastSlot,
+					LastTime:      chainState.SlotToTime(phase0.Slot(stat.LastSlot)),
+				})
+			}
+		}
+	}
+	pageData.GraffitiCount = totalRows
+
+	pageData.TotalPages = totalRows / pageSize
+	if totalRows%pageSize > 0 {
+		pageData.TotalPages++
+	}
+	pageData.LastPageIndex = pageData.TotalPages
+	if pageIdx < pageData.TotalPages {
+		pageData.NextPageIndex = pageIdx + 1
+	}
+
+	pageData.FirstPageLink = fmt.Sprintf("/graffiti?%v&c=%v", filterArgs.Encode(), pageData.PageSize)
+	pageData.PrevPageLink = fmt.Sprintf("/graffiti?%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.PrevPageIndex)
+	pageData.NextPageLink = fmt.Sprintf("/graffiti?%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.NextPageIndex)
+	pageData.LastPageLink = fmt.Sprintf("/graffiti?%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.LastPageIndex)
+
+	return pageData
+}
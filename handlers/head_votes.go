@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// size of the inline svg chart on the head votes page
+const (
+	headVotesChartWidth  = 1000
+	headVotesChartHeight = 200
+	headVotesMaxEpochs   = 100
+)
+
+// line colors of the client groups in the head votes chart
+var headVotesGroupColors = []string{
+	"#0d6efd", "#fd7e14", "#198754", "#d63384", "#6f42c1", "#20c997", "#ffc107", "#dc3545", "#0dcaf0", "#6c757d",
+}
+
+// HeadVotes will return the "head vote accuracy" page using a go template
+func HeadVotes(w http.ResponseWriter, r *http.Request) {
+	var headVotesTemplateFiles = append(layoutTemplateFiles,
+		"head_votes/head_votes.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(headVotesTemplateFiles...)
+	data := InitPageData(w, r, "blockchain", "/epochs/head_votes", "Head Vote Accuracy", headVotesTemplateFiles)
+
+	urlArgs := r.URL.Query()
+	var lastEpoch uint64 = math.MaxUint64
+	if urlArgs.Has("epoch") {
+		lastEpoch, _ = strconv.ParseUint(urlArgs.Get("epoch"), 10, 64)
+	}
+	var epochCount uint64 = 32
+	if urlArgs.Has("count") {
+		epochCount, _ = strconv.ParseUint(urlArgs.Get("count"), 10, 64)
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getHeadVotesPageData(r.Context(), lastEpoch, epochCount)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "head_votes.go", "Head Vote Accuracy", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getHeadVotesPageData(ctx context.Context, lastEpoch uint64, epochCount uint64) (*models.HeadVotesPageData, error) {
+	pageData := &models.HeadVotesPageData{}
+	pageCacheKey := fmt.Sprintf("head_votes:%v:%v", lastEpoch, epochCount)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildHeadVotesPageData(pageCall.CallCtx, lastEpoch, epochCount)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.HeadVotesPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildHeadVotesPageData(ctx context.Context, lastEpoch uint64, epochCount uint64) (*models.HeadVotesPageData, time.Duration) {
+	logrus.Debugf("head votes page called: %v:%v", lastEpoch, epochCount)
+
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	// votes of the current epoch are still being included, so start with the previous epoch by default
+	currentEpoch := uint64(chainState.CurrentEpoch())
+	if currentEpoch > 0 && lastEpoch >= currentEpoch {
+		lastEpoch = currentEpoch - 1
+	} else if lastEpoch > currentEpoch {
+		lastEpoch = currentEpoch
+	}
+	if epochCount == 0 {
+		epochCount = 1
+	} else if epochCount > headVotesMaxEpochs {
+		epochCount = headVotesMaxEpochs
+	}
+	if epochCount > lastEpoch+1 {
+		epochCount = lastEpoch + 1
+	}
+
+	pageData := &models.HeadVotesPageData{
+		FirstEpoch: lastEpoch + 1 - epochCount,
+		LastEpoch:  lastEpoch,
+		EpochCount: epochCount,
+	}
+
+	// classify validators by the client names found in their validator names
+	groupCache := map[string]string{}
+	getGroup := func(validatorIndex phase0.ValidatorIndex) string {
+		name := services.GlobalBeaconService.GetValidatorName(uint64(validatorIndex))
+		group, found := groupCache[name]
+		if !found {
+			group = services.GetClientGroupName(name)
+			groupCache[name] = group
+		}
+		return group
+	}
+
+	groupMap := map[string]*models.HeadVotesPageDataGroup{}
+	epochGroups := make([]map[string]*models.HeadVotesPageDataEpochGroup, 0, epochCount)
+
+	// collect epochs in ascending order, the chart is drawn from left to right
+	for epoch := pageData.FirstEpoch; epoch <= lastEpoch; epoch++ {
+		epochData := &models.HeadVotesPageDataEpoch{
+			Epoch: epoch,
+		}
+		pageData.Epochs = append(pageData.Epochs, epochData)
+
+		groups := map[string]*models.HeadVotesPageDataEpochGroup{}
+		epochGroups = append(epochGroups, groups)
+
+		headVotes := beaconIndexer.GetEpochHeadVotes(phase0.Epoch(epoch), nil, getGroup)
+		if headVotes == nil {
+			continue
+		}
+
+		epochData.Available = true
+		epochData.VoteCount = headVotes.VoteCount
+		epochData.CorrectCount = headVotes.CorrectCount
+		if headVotes.VoteCount > 0 {
+			epochData.Accuracy = float64(headVotes.CorrectCount) * 100 / float64(headVotes.VoteCount)
+		}
+		pageData.VoteCount += headVotes.VoteCount
+		pageData.CorrectCount += headVotes.CorrectCount
+
+		for name, groupVotes := range headVotes.Groups {
+			groupData := groupMap[name]
+			if groupData == nil {
+				groupData = &models.HeadVotesPageDataGroup{
+					Name: name,
+				}
+				groupMap[name] = groupData
+			}
+			groupData.VoteCount += groupVotes.VoteCount
+			groupData.CorrectCount += groupVotes.CorrectCount
+
+			epochGroup := &models.HeadVotesPageDataEpochGroup{
+				VoteCount:    groupVotes.VoteCount,
+				CorrectCount: groupVotes.CorrectCount,
+			}
+			if groupVotes.VoteCount > 0 {
+				epochGroup.Accuracy = float64(groupVotes.CorrectCount) * 100 / float64(groupVotes.VoteCount)
+			}
+			groups[name] = epochGroup
+		}
+	}
+	if pageData.VoteCount > 0 {
+		pageData.Accuracy = float64(pageData.CorrectCount) * 100 / float64(pageData.VoteCount)
+	}
+
+	pageData.Groups = make([]*models.HeadVotesPageDataGroup, 0, len(groupMap))
+	for _, groupData := range groupMap {
+		if groupData.VoteCount > 0 {
+			groupData.Accuracy = float64(groupData.CorrectCount) * 100 / float64(groupData.VoteCount)
+		}
+		pageData.Groups = append(pageData.Groups, groupData)
+	}
+	sort.Slice(pageData.Groups, func(i, j int) bool {
+		if pageData.Groups[i].VoteCount != pageData.Groups[j].VoteCount {
+			return pageData.Groups[i].VoteCount > pageData.Groups[j].VoteCount
+		}
+		return pageData.Groups[i].Name < pageData.Groups[j].Name
+	})
+
+	// build the chart lines & the per epoch group columns in the order of the groups
+	overallPoints := []string{}
+	for idx, epochData := range pageData.Epochs {
+		if epochData.Available && epochData.VoteCount > 0 {
+			overallPoints = append(overallPoints, getHeadVotesChartPoint(idx, len(pageData.Epochs), epochData.Accuracy))
+		}
+	}
+	pageData.Chart = strings.Join(overallPoints, " ")
+
+	for groupIdx, groupData := range pageData.Groups {
+		groupData.Color = headVotesGroupColors[groupIdx%len(headVotesGroupColors)]
+
+		groupPoints := []string{}
+		for idx, epochData := range pageData.Epochs {
+			epochGroup := epochGroups[idx][groupData.Name]
+			if epochGroup == nil {
+				epochGroup = &models.HeadVotesPageDataEpochGroup{}
+			} else if epochGroup.VoteCount > 0 {
+				groupPoints = append(groupPoints, getHeadVotesChartPoint(idx, len(pageData.Epochs), epochGroup.Accuracy))
+			}
+			epochData.Groups = append(epochData.Groups, epochGroup)
+		}
+		groupData.Chart = strings.Join(groupPoints, " ")
+	}
+
+	// list the most recent epochs first in the table
+	sort.Slice(pageData.Epochs, func(i, j int) bool {
+		return pageData.Epochs[i].Epoch > pageData.Epochs[j].Epoch
+	})
+
+	return pageData, 1 * time.Minute
+}
+
+func getHeadVotesChartPoint(idx int, count int, accuracy float64) string {
+	x := float64(0)
+	if count > 1 {
+		x = float64(idx) * headVotesChartWidth / float64(count-1)
+	}
+	y := headVotesChartHeight - accuracy*headVotesChartHeight/100
+	return fmt.Sprintf("%.1f,%.1f", x, y)
+}
@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/ethpandaops/dora/services"
+)
+
+// parseHistoricEpoch parses the at_epoch parameter of pages that support historical views.
+// returns -1 if no historical view is requested. historical views are only rendered for finalized epochs,
+// as the state of unfinalized epochs may still change.
+func parseHistoricEpoch(urlArgs url.Values) (int64, error) {
+	if !urlArgs.Has("at_epoch") {
+		return -1, nil
+	}
+
+	epoch, err := strconv.ParseUint(urlArgs.Get("at_epoch"), 10, 63)
+	if err != nil {
+		return -1, fmt.Errorf("invalid at_epoch parameter")
+	}
+
+	finalizedEpoch, _ := services.GlobalBeaconService.GetFinalizedEpoch()
+	if epoch > uint64(finalizedEpoch) {
+		return -1, fmt.Errorf("historical views are only available for finalized epochs (latest finalized epoch: %v)", finalizedEpoch)
+	}
+
+	return int64(epoch), nil
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -27,72 +28,89 @@ func IncludedDeposits(w http.ResponseWriter, r *http.Request) {
 	var pageTemplate = templates.GetTemplate(templateFiles...)
 	data := InitPageData(w, r, "validators", "/validators/included_deposits", "Included Deposits", templateFiles)
 
-	urlArgs := r.URL.Query()
-	var pageSize uint64 = 50
+	pageArgs := parseIncludedDepositsPageArgs(r.URL.Query())
+	if isCsvExport(r) {
+		exportIncludedDepositsCsv(w, r, pageArgs)
+		return
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getFilteredIncludedDepositsPageData(r.Context(), pageArgs.PageIdx, pageArgs.PageSize, pageArgs.MinIndex, pageArgs.MaxIndex, pageArgs.PubKey, pageArgs.ValidatorName, pageArgs.MinAmount, pageArgs.MaxAmount, pageArgs.WithOrphaned)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "slots_filtered.go", "SlotsFiltered", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+type includedDepositsPageArgs struct {
+	PageIdx       uint64 // p
+	PageSize      uint64 // c
+	MinIndex      uint64 // f.mini (filters are only applied if f is set)
+	MaxIndex      uint64 // f.maxi
+	PubKey        string // f.pubkey
+	ValidatorName string // f.vname
+	MinAmount     uint64 // f.mina
+	MaxAmount     uint64 // f.maxa
+	WithOrphaned  uint8  // f.orphaned (defaults to 1 without filter)
+}
+
+func parseIncludedDepositsPageArgs(urlArgs url.Values) *includedDepositsPageArgs {
+	pageArgs := &includedDepositsPageArgs{
+		PageIdx:  1,
+		PageSize: 50,
+	}
 	if urlArgs.Has("c") {
-		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+		pageArgs.PageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
 	}
-	var pageIdx uint64 = 1
 	if urlArgs.Has("p") {
-		pageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
-		if pageIdx < 1 {
-			pageIdx = 1
+		pageArgs.PageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+		if pageArgs.PageIdx < 1 {
+			pageArgs.PageIdx = 1
 		}
 	}
 
-	var minIndex uint64
-	var maxIndex uint64
-	var publickey string
-	var vname string
-	var minAmount uint64
-	var maxAmount uint64
-	var withOrphaned uint64
-
 	if urlArgs.Has("f") {
 		if urlArgs.Has("f.mini") {
-			minIndex, _ = strconv.ParseUint(urlArgs.Get("f.mini"), 10, 64)
+			pageArgs.MinIndex, _ = strconv.ParseUint(urlArgs.Get("f.mini"), 10, 64)
 		}
 		if urlArgs.Has("f.maxi") {
-			maxIndex, _ = strconv.ParseUint(urlArgs.Get("f.maxi"), 10, 64)
+			pageArgs.MaxIndex, _ = strconv.ParseUint(urlArgs.Get("f.maxi"), 10, 64)
 		}
 		if urlArgs.Has("f.pubkey") {
-			publickey = urlArgs.Get("f.pubkey")
+			pageArgs.PubKey = urlArgs.Get("f.pubkey")
 		}
 		if urlArgs.Has("f.vname") {
-			vname = urlArgs.Get("f.vname")
+			pageArgs.ValidatorName = urlArgs.Get("f.vname")
 		}
 		if urlArgs.Has("f.mina") {
-			minAmount, _ = strconv.ParseUint(urlArgs.Get("f.mina"), 10, 64)
+			pageArgs.MinAmount, _ = strconv.ParseUint(urlArgs.Get("f.mina"), 10, 64)
 		}
 		if urlArgs.Has("f.maxa") {
-			maxAmount, _ = strconv.ParseUint(urlArgs.Get("f.maxa"), 10, 64)
+			pageArgs.MaxAmount, _ = strconv.ParseUint(urlArgs.Get("f.maxa"), 10, 64)
 		}
 		if urlArgs.Has("f.orphaned") {
-			withOrphaned, _ = strconv.ParseUint(urlArgs.Get("f.orphaned"), 10, 64)
+			withOrphaned, _ := strconv.ParseUint(urlArgs.Get("f.orphaned"), 10, 64)
+			pageArgs.WithOrphaned = uint8(withOrphaned)
 		}
 	} else {
-		withOrphaned = 1
-	}
-	var pageError error
-	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
-	if pageError == nil {
-		data.Data, pageError = getFilteredIncludedDepositsPageData(pageIdx, pageSize, minIndex, maxIndex, publickey, vname, minAmount, maxAmount, uint8(withOrphaned))
-	}
-	if pageError != nil {
-		handlePageError(w, r, pageError)
-		return
-	}
-	w.Header().Set("Content-Type", "text/html")
-	if handleTemplateError(w, r, "slots_filtered.go", "SlotsFiltered", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
-		return // an error has occurred and was processed
+		pageArgs.WithOrphaned = 1
 	}
+
+	return pageArgs
 }
 
-func getFilteredIncludedDepositsPageData(pageIdx uint64, pageSize uint64, minIndex uint64, maxIndex uint64, publickey string, vname string, minAmount uint64, maxAmount uint64, withOrphaned uint8) (*models.IncludedDepositsPageData, error) {
+func getFilteredIncludedDepositsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, minIndex uint64, maxIndex uint64, publickey string, vname string, minAmount uint64, maxAmount uint64, withOrphaned uint8) (*models.IncludedDepositsPageData, error) {
 	pageData := &models.IncludedDepositsPageData{}
 	pageCacheKey := fmt.Sprintf("included_deposits:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, minIndex, maxIndex, publickey, vname, minAmount, maxAmount, withOrphaned)
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
-		return buildFilteredIncludedDepositsPageData(pageIdx, pageSize, minIndex, maxIndex, publickey, vname, minAmount, maxAmount, withOrphaned)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		return buildFilteredIncludedDepositsPageData(pageCall.CallCtx, pageIdx, pageSize, minIndex, maxIndex, publickey, vname, minAmount, maxAmount, withOrphaned)
 	})
 	if pageErr == nil && pageRes != nil {
 		resData, resOk := pageRes.(*models.IncludedDepositsPageData)
@@ -104,7 +122,7 @@ func getFilteredIncludedDepositsPageData(pageIdx uint64, pageSize uint64, minInd
 	return pageData, pageErr
 }
 
-func buildFilteredIncludedDepositsPageData(pageIdx uint64, pageSize uint64, minIndex uint64, maxIndex uint64, publickey string, vname string, minAmount uint64, maxAmount uint64, withOrphaned uint8) *models.IncludedDepositsPageData {
+func buildFilteredIncludedDepositsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, minIndex uint64, maxIndex uint64, publickey string, vname string, minAmount uint64, maxAmount uint64, withOrphaned uint8) *models.IncludedDepositsPageData {
 	filterArgs := url.Values{}
 	if minIndex != 0 {
 		filterArgs.Add("f.mini", fmt.Sprintf("%v", minIndex))
@@ -163,7 +181,7 @@ func buildFilteredIncludedDepositsPageData(pageIdx uint64, pageSize uint64, minI
 		WithOrphaned:  withOrphaned,
 	}
 
-	dbDeposits, totalRows := services.GlobalBeaconService.GetIncludedDepositsByFilter(depositFilter, pageIdx-1, uint32(pageSize))
+	dbDeposits, totalRows := services.GlobalBeaconService.GetIncludedDepositsByFilter(ctx, depositFilter, pageIdx-1, uint32(pageSize))
 
 	chainState := services.GlobalBeaconService.GetChainState()
 
@@ -238,3 +256,29 @@ func buildFilteredIncludedDepositsPageData(pageIdx uint64, pageSize uint64, minI
 
 	return pageData
 }
+
+// exportIncludedDepositsCsv streams all included deposits matching the page filters as csv file.
+func exportIncludedDepositsCsv(w http.ResponseWriter, r *http.Request, pageArgs *includedDepositsPageArgs) {
+	header := []string{"deposit_index", "slot", "time", "orphaned", "pubkey", "withdrawal_credentials", "amount", "validator_status"}
+	writeCsvExport(w, r, "included_deposits", header, func(pageIdx uint64) ([][]string, bool) {
+		pageData := buildFilteredIncludedDepositsPageData(r.Context(), pageIdx+1, csvExportPageSize, pageArgs.MinIndex, pageArgs.MaxIndex, pageArgs.PubKey, pageArgs.ValidatorName, pageArgs.MinAmount, pageArgs.MaxAmount, pageArgs.WithOrphaned)
+		rows := make([][]string, 0, len(pageData.Deposits))
+		for _, deposit := range pageData.Deposits {
+			depositIndex := ""
+			if deposit.HasIndex {
+				depositIndex = csvUint(deposit.Index)
+			}
+			rows = append(rows, []string{
+				depositIndex,
+				csvUint(deposit.SlotNumber),
+				csvTime(deposit.Time),
+				csvBool(deposit.Orphaned),
+				csvHex(deposit.PublicKey),
+				csvHex(deposit.Withdrawalcredentials),
+				csvUint(deposit.Amount),
+				deposit.ValidatorStatus,
+			})
+		}
+		return rows, pageData.NextPageIndex > 0
+	})
+}
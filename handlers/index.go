@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -23,14 +24,27 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	indexSparklineWidth  = 300
+	indexSparklineHeight = 40
+)
+
 // Index will return the main "index" page using a go template
 func Index(w http.ResponseWriter, r *http.Request) {
+	if isBeforeGenesis() {
+		// show the genesis countdown until the network has started
+		Genesis(w, r)
+		return
+	}
+
 	var indexTemplateFiles = append(layoutTemplateFiles,
 		"index/index.html",
 		"index/networkOverview.html",
 		"index/recentBlocks.html",
 		"index/recentEpochs.html",
+		"index/participationHistory.html",
 		"index/recentSlots.html",
+		"index/headForks.html",
 		"_svg/timeline.html",
 	)
 
@@ -40,7 +54,7 @@ func Index(w http.ResponseWriter, r *http.Request) {
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
 	if pageError == nil {
-		data.Data, pageError = getIndexPageData()
+		data.Data, pageError = getIndexPageData(r.Context())
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -57,7 +71,7 @@ func IndexData(w http.ResponseWriter, r *http.Request) {
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
 	if pageError == nil {
-		pageData, pageError = getIndexPageData()
+		pageData, pageError = getIndexPageData(r.Context())
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -71,11 +85,15 @@ func IndexData(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getIndexPageData() (*models.IndexPageData, error) {
+func getIndexPageData(ctx context.Context) (*models.IndexPageData, error) {
 	pageData := &models.IndexPageData{}
 	pageCacheKey := "index"
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
-		pageData, cacheTimeout := buildIndexPageData()
+	if headBlock := services.GlobalBeaconService.GetBeaconIndexer().GetCanonicalHead(nil); headBlock != nil {
+		// a new head invalidates the cached page right away, so live updates via the event stream show the new block
+		pageCacheKey = fmt.Sprintf("index:%v", headBlock.Root.String())
+	}
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildIndexPageData(pageCall.CallCtx)
 		pageCall.CacheTimeout = cacheTimeout
 		return pageData
 	})
@@ -89,7 +107,7 @@ func getIndexPageData() (*models.IndexPageData, error) {
 	return pageData, pageErr
 }
 
-func buildIndexPageData() (*models.IndexPageData, time.Duration) {
+func buildIndexPageData(ctx context.Context) (*models.IndexPageData, time.Duration) {
 	logrus.Debugf("index page called")
 
 	recentEpochCount := 7
@@ -107,7 +125,7 @@ func buildIndexPageData() (*models.IndexPageData, time.Duration) {
 	justifiedEpoch, _ := chainState.GetJustifiedCheckpoint()
 
 	syncState := dbtypes.IndexerSyncState{}
-	db.GetExplorerState("indexer.syncstate", &syncState)
+	db.GetExplorerState(ctx, "indexer.syncstate", &syncState)
 	var isSynced bool
 	if finalizedEpoch >= 1 {
 		isSynced = syncState.Epoch >= uint64(finalizedEpoch-1)
@@ -127,22 +145,28 @@ func buildIndexPageData() (*models.IndexPageData, time.Duration) {
 		CurrentScheduledCount: specs.SlotsPerEpoch - uint64(currentSlotIndex),
 		CurrentEpochProgress:  float64(100) * float64(currentSlotIndex) / float64(specs.SlotsPerEpoch),
 	}
-	if utils.Config.Chain.DisplayName != "" {
-		pageData.NetworkName = utils.Config.Chain.DisplayName
+	if utils.Config().Chain.DisplayName != "" {
+		pageData.NetworkName = utils.Config().Chain.DisplayName
 	}
 
 	currentValidatorSet := services.GlobalBeaconService.GetCachedValidatorSet(true)
 	if currentValidatorSet != nil {
-		for _, validator := range currentValidatorSet {
-			if strings.HasPrefix(validator.Status.String(), "active") {
+		for index := 0; index < currentValidatorSet.Len(); index++ {
+			record, found := currentValidatorSet.GetRecord(phase0.ValidatorIndex(index))
+			if !found {
+				continue
+			}
+
+			status := record.Status
+			if strings.HasPrefix(status.String(), "active") {
 				pageData.ActiveValidatorCount++
-				pageData.TotalEligibleEther += uint64(validator.Validator.EffectiveBalance)
-				pageData.AverageValidatorBalance += uint64(validator.Balance)
+				pageData.TotalEligibleEther += uint64(record.EffectiveBalance)
+				pageData.AverageValidatorBalance += uint64(record.Balance)
 			}
-			if validator.Status == v1.ValidatorStatePendingQueued {
+			if status == v1.ValidatorStatePendingQueued {
 				pageData.EnteringValidatorCount++
 			}
-			if validator.Status == v1.ValidatorStateActiveExiting {
+			if status == v1.ValidatorStateActiveExiting {
 				pageData.ExitingValidatorCount++
 			}
 		}
@@ -218,23 +242,83 @@ func buildIndexPageData() (*models.IndexPageData, time.Duration) {
 	}
 
 	// load recent epochs
-	buildIndexPageRecentEpochsData(pageData, currentEpoch, finalizedEpoch, justifiedEpoch, recentEpochCount)
+	buildIndexPageRecentEpochsData(ctx, pageData, currentEpoch, finalizedEpoch, justifiedEpoch, recentEpochCount)
+
+	// load participation history
+	buildIndexPageParticipationHistory(pageData)
 
 	// load recent blocks
-	buildIndexPageRecentBlocksData(pageData, recentBlockCount)
+	buildIndexPageRecentBlocksData(ctx, pageData, recentBlockCount)
 
 	// load recent slots
-	buildIndexPageRecentSlotsData(pageData, currentSlot, recentSlotsCount)
+	buildIndexPageRecentSlotsData(ctx, pageData, currentSlot, recentSlotsCount)
+
+	// load head forks while the clients are split
+	if !utils.Config().Frontend.DisableForkSplitView {
+		buildIndexPageHeadForksData(pageData)
+	}
 
 	return pageData, 12 * time.Second
 }
 
-func buildIndexPageRecentEpochsData(pageData *models.IndexPageData, currentEpoch phase0.Epoch, finalizedEpoch phase0.Epoch, justifiedEpoch phase0.Epoch, recentEpochCount int) {
+// buildIndexPageHeadForksData assigns the clients to the chain heads they follow.
+// chain heads without any client are orphaned side chains and not shown, so the result only contains multiple forks during a split.
+func buildIndexPageHeadForksData(pageData *models.IndexPageData) {
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+	chainHeads := beaconIndexer.GetChainHeads()
+	canonicalHead := beaconIndexer.GetCanonicalHead(nil)
+
+	headForks := make([]*models.IndexPageDataHeadFork, len(chainHeads))
+	for idx, chainHead := range chainHeads {
+		headFork := &models.IndexPageDataHeadFork{
+			HeadSlot:  uint64(chainHead.HeadBlock.Slot),
+			HeadRoot:  chainHead.HeadBlock.Root[:],
+			Canonical: canonicalHead != nil && canonicalHead.Root == chainHead.HeadBlock.Root,
+			Clients:   []*models.IndexPageDataHeadForkClient{},
+		}
+		if epochCount := len(chainHead.PerEpochVotingPercent); epochCount > 0 {
+			headFork.CurrentParticipation = chainHead.PerEpochVotingPercent[epochCount-1]
+			if epochCount > 1 {
+				headFork.PreviousParticipation = chainHead.PerEpochVotingPercent[epochCount-2]
+			}
+		}
+		headForks[idx] = headFork
+	}
+
+	for _, client := range beaconIndexer.GetAllClients() {
+		clientHeadSlot, clientHeadRoot := client.GetClient().GetLastHead()
+		for idx, chainHead := range chainHeads {
+			if isInChain, _ := beaconIndexer.GetBlockDistance(clientHeadRoot, chainHead.HeadBlock.Root); !isInChain {
+				continue
+			}
+
+			headForks[idx].Clients = append(headForks[idx].Clients, &models.IndexPageDataHeadForkClient{
+				Index:    int(client.GetIndex()) + 1,
+				Name:     client.GetClient().GetName(),
+				Status:   client.GetClient().GetStatus().String(),
+				HeadSlot: uint64(clientHeadSlot),
+			})
+			break
+		}
+	}
+
+	pageData.HeadForks = make([]*models.IndexPageDataHeadFork, 0, len(headForks))
+	for _, headFork := range headForks {
+		if len(headFork.Clients) == 0 {
+			continue
+		}
+		headFork.ClientCount = uint64(len(headFork.Clients))
+		pageData.HeadForks = append(pageData.HeadForks, headFork)
+	}
+	pageData.HeadForkCount = uint64(len(pageData.HeadForks))
+}
+
+func buildIndexPageRecentEpochsData(ctx context.Context, pageData *models.IndexPageData, currentEpoch phase0.Epoch, finalizedEpoch phase0.Epoch, justifiedEpoch phase0.Epoch, recentEpochCount int) {
 	pageData.RecentEpochs = make([]*models.IndexPageDataEpochs, 0)
 
 	chainState := services.GlobalBeaconService.GetChainState()
 
-	epochsData := services.GlobalBeaconService.GetDbEpochs(uint64(currentEpoch), uint32(recentEpochCount))
+	epochsData := services.GlobalBeaconService.GetDbEpochs(ctx, uint64(currentEpoch), uint32(recentEpochCount))
 	for i := 0; i < len(epochsData); i++ {
 		epochData := epochsData[i]
 		if epochData == nil {
@@ -257,12 +341,83 @@ func buildIndexPageRecentEpochsData(pageData *models.IndexPageData, currentEpoch
 	pageData.RecentEpochCount = uint64(len(pageData.RecentEpochs))
 }
 
-func buildIndexPageRecentBlocksData(pageData *models.IndexPageData, recentBlockCount int) {
+// buildIndexPageParticipationHistory builds the participation sparklines from the hourly participation rollups.
+func buildIndexPageParticipationHistory(pageData *models.IndexPageData) {
+	rollups, bucketEpochs := services.GlobalBeaconService.GetParticipationHistory()
+	if len(rollups) < 2 {
+		return
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	firstRollup := rollups[0]
+	lastRollup := rollups[len(rollups)-1]
+	history := &models.IndexPageDataParticipationHistory{
+		FirstEpoch:   firstRollup.BucketEpoch,
+		LastEpoch:    lastRollup.LastEpoch,
+		BucketEpochs: bucketEpochs,
+		Days:         chainState.EpochToTime(phase0.Epoch(lastRollup.LastEpoch+1)).Sub(chainState.EpochToTime(phase0.Epoch(firstRollup.BucketEpoch))).Hours() / 24,
+	}
+
+	for _, line := range []struct {
+		name  string
+		voted func(rollup *dbtypes.ParticipationRollup) uint64
+	}{
+		{"Target", func(rollup *dbtypes.ParticipationRollup) uint64 { return rollup.VotedTarget }},
+		{"Head", func(rollup *dbtypes.ParticipationRollup) uint64 { return rollup.VotedHead }},
+		{"Total", func(rollup *dbtypes.ParticipationRollup) uint64 { return rollup.VotedTotal }},
+	} {
+		values := make([]float64, len(rollups))
+		var votedSum, eligibleSum uint64
+		for idx, rollup := range rollups {
+			values[idx] = 100
+			if rollup.Eligible > 0 {
+				values[idx] = float64(line.voted(rollup)) * 100 / float64(rollup.Eligible)
+			}
+			votedSum += line.voted(rollup)
+			eligibleSum += rollup.Eligible
+		}
+
+		sparkline := &models.IndexPageDataParticipationLine{
+			Name:    line.name,
+			Current: values[len(values)-1],
+			Min:     values[0],
+			Avg:     100,
+		}
+		for _, value := range values {
+			sparkline.Min = min(sparkline.Min, value)
+		}
+		if eligibleSum > 0 {
+			sparkline.Avg = float64(votedSum) * 100 / float64(eligibleSum)
+		}
+		sparkline.Points = getIndexPageSparklinePoints(values, sparkline.Min)
+		history.Sparklines = append(history.Sparklines, sparkline)
+	}
+
+	pageData.ParticipationHistory = history
+}
+
+// getIndexPageSparklinePoints returns the svg polyline points of a participation sparkline, scaled from the lowest value to 100%.
+func getIndexPageSparklinePoints(values []float64, minValue float64) string {
+	valueRange := 100 - minValue
+	if valueRange < 1 {
+		valueRange = 1
+	}
+
+	points := make([]string, len(values))
+	for idx, value := range values {
+		x := float64(idx) * indexSparklineWidth / float64(len(values)-1)
+		y := indexSparklineHeight - (value-minValue)*indexSparklineHeight/valueRange
+		points[idx] = fmt.Sprintf("%.1f,%.1f", x, y)
+	}
+	return strings.Join(points, " ")
+}
+
+func buildIndexPageRecentBlocksData(ctx context.Context, pageData *models.IndexPageData, recentBlockCount int) {
 	pageData.RecentBlocks = make([]*models.IndexPageDataBlocks, 0)
 
 	chainState := services.GlobalBeaconService.GetChainState()
 
-	blocksData := services.GlobalBeaconService.GetDbBlocksByFilter(&dbtypes.BlockFilter{
+	blocksData := services.GlobalBeaconService.GetDbBlocksByFilter(ctx, &dbtypes.BlockFilter{
 		WithOrphaned: 0,
 		WithMissing:  0,
 	}, 0, uint32(recentBlockCount), 0)
@@ -271,6 +426,9 @@ func buildIndexPageRecentBlocksData(pageData *models.IndexPageData, recentBlockC
 		limit = recentBlockCount
 	}
 
+	blockHashes := make([][]byte, 0, limit)
+	blockModels := make(map[common.Hash]*models.IndexPageDataBlocks, limit)
+
 	for i := 0; i < limit; i++ {
 		blockData := blocksData[i].Block
 		if blockData == nil {
@@ -288,16 +446,31 @@ func buildIndexPageRecentBlocksData(pageData *models.IndexPageData, recentBlockC
 		if blockData.EthBlockNumber != nil {
 			blockModel.WithEthBlock = true
 			blockModel.EthBlock = *blockData.EthBlockNumber
-			if utils.Config.Frontend.EthExplorerLink != "" {
-				blockModel.EthBlockLink, _ = url.JoinPath(utils.Config.Frontend.EthExplorerLink, "block", strconv.FormatUint(blockModel.EthBlock, 10))
+			if utils.Config().Frontend.EthExplorerLink != "" {
+				blockModel.EthBlockLink, _ = url.JoinPath(utils.Config().Frontend.EthExplorerLink, "block", strconv.FormatUint(blockModel.EthBlock, 10))
 			}
 		}
 		pageData.RecentBlocks = append(pageData.RecentBlocks, blockModel)
+		if len(blockData.EthBlockHash) > 0 {
+			blockHashes = append(blockHashes, blockData.EthBlockHash)
+			blockModels[common.BytesToHash(blockData.EthBlockHash)] = blockModel
+		}
 	}
 	pageData.RecentBlockCount = uint64(len(pageData.RecentBlocks))
+
+	// tag relay delivered blocks
+	if len(utils.Config().MevIndexer.Relays) > 0 {
+		for _, mevBlock := range db.GetMevBlocksByBlockHashes(ctx, blockHashes) {
+			if blockModel := blockModels[common.BytesToHash(mevBlock.BlockHash)]; blockModel != nil {
+				blockModel.MevRelayed = true
+				blockModel.MevRelays = strings.Join(getMevBlockRelayNames(mevBlock.SeenbyRelays), ", ")
+				blockModel.MevValue = mevBlock.BlockValueGwei
+			}
+		}
+	}
 }
 
-func buildIndexPageRecentSlotsData(pageData *models.IndexPageData, firstSlot phase0.Slot, slotLimit int) {
+func buildIndexPageRecentSlotsData(ctx context.Context, pageData *models.IndexPageData, firstSlot phase0.Slot, slotLimit int) {
 	var lastSlot uint64
 	if uint64(firstSlot) >= uint64(slotLimit) {
 		lastSlot = uint64(firstSlot) - uint64(slotLimit)
@@ -309,7 +482,7 @@ func buildIndexPageRecentSlotsData(pageData *models.IndexPageData, firstSlot pha
 
 	// load slots
 	pageData.RecentSlots = make([]*models.IndexPageDataSlots, 0)
-	dbSlots := services.GlobalBeaconService.GetDbBlocksForSlots(uint64(firstSlot), uint32(slotLimit), true, true)
+	dbSlots := services.GlobalBeaconService.GetDbBlocksForSlots(ctx, uint64(firstSlot), uint32(slotLimit), true, true)
 	dbIdx := 0
 	dbCnt := len(dbSlots)
 	blockCount := uint64(0)
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -49,6 +50,8 @@ func InitiatedDeposits(w http.ResponseWriter, r *http.Request) {
 	var maxAmount uint64
 	var withOrphaned uint64
 	var withValid uint64
+	var depositType uint64
+	var contract string
 
 	if urlArgs.Has("f") {
 		if urlArgs.Has("f.address") {
@@ -72,14 +75,28 @@ func InitiatedDeposits(w http.ResponseWriter, r *http.Request) {
 		if urlArgs.Has("f.valid") {
 			withValid, _ = strconv.ParseUint(urlArgs.Get("f.valid"), 10, 64)
 		}
+		if urlArgs.Has("f.type") {
+			depositType, _ = strconv.ParseUint(urlArgs.Get("f.type"), 10, 64)
+		}
+		if urlArgs.Has("f.contract") {
+			contract = urlArgs.Get("f.contract")
+		}
 	} else {
 		withOrphaned = 1
 		withValid = 1
 	}
+	if isCsvExport(r) {
+		writeCsvExport(w, r, "initiated_deposits", initiatedDepositsCsvHeader, func(pageIdx uint64) ([][]string, bool) {
+			pageData := buildFilteredInitiatedDepositsPageData(r.Context(), pageIdx+1, csvExportPageSize, address, publickey, vname, minAmount, maxAmount, uint8(withOrphaned), uint8(withValid), uint8(depositType), contract)
+			return getInitiatedDepositsCsvRows(pageData), pageData.NextPageIndex > 0
+		})
+		return
+	}
+
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
 	if pageError == nil {
-		data.Data, pageError = getFilteredInitiatedDepositsPageData(pageIdx, pageSize, address, publickey, vname, minAmount, maxAmount, uint8(withOrphaned), uint8(withValid))
+		data.Data, pageError = getFilteredInitiatedDepositsPageData(r.Context(), pageIdx, pageSize, address, publickey, vname, minAmount, maxAmount, uint8(withOrphaned), uint8(withValid), uint8(depositType), contract)
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -91,11 +108,11 @@ func InitiatedDeposits(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getFilteredInitiatedDepositsPageData(pageIdx uint64, pageSize uint64, address string, publickey string, vname string, minAmount uint64, maxAmount uint64, withOrphaned uint8, withValid uint8) (*models.InitiatedDepositsPageData, error) {
+func getFilteredInitiatedDepositsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, address string, publickey string, vname string, minAmount uint64, maxAmount uint64, withOrphaned uint8, withValid uint8, depositType uint8, contract string) (*models.InitiatedDepositsPageData, error) {
 	pageData := &models.InitiatedDepositsPageData{}
-	pageCacheKey := fmt.Sprintf("initiated_deposits:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, address, publickey, vname, minAmount, maxAmount, withOrphaned, withValid)
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
-		return buildFilteredInitiatedDepositsPageData(pageIdx, pageSize, address, publickey, vname, minAmount, maxAmount, withOrphaned, withValid)
+	pageCacheKey := fmt.Sprintf("initiated_deposits:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, address, publickey, vname, minAmount, maxAmount, withOrphaned, withValid, depositType, contract)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		return buildFilteredInitiatedDepositsPageData(pageCall.CallCtx, pageIdx, pageSize, address, publickey, vname, minAmount, maxAmount, withOrphaned, withValid, depositType, contract)
 	})
 	if pageErr == nil && pageRes != nil {
 		resData, resOk := pageRes.(*models.InitiatedDepositsPageData)
@@ -107,7 +124,7 @@ func getFilteredInitiatedDepositsPageData(pageIdx uint64, pageSize uint64, addre
 	return pageData, pageErr
 }
 
-func buildFilteredInitiatedDepositsPageData(pageIdx uint64, pageSize uint64, address string, publickey string, vname string, minAmount uint64, maxAmount uint64, withOrphaned uint8, withValid uint8) *models.InitiatedDepositsPageData {
+func buildFilteredInitiatedDepositsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, address string, publickey string, vname string, minAmount uint64, maxAmount uint64, withOrphaned uint8, withValid uint8, depositType uint8, contract string) *models.InitiatedDepositsPageData {
 	filterArgs := url.Values{}
 	if address != "" {
 		filterArgs.Add("f.address", address)
@@ -130,6 +147,12 @@ func buildFilteredInitiatedDepositsPageData(pageIdx uint64, pageSize uint64, add
 	if withValid != 0 {
 		filterArgs.Add("f.valid", fmt.Sprintf("%v", withValid))
 	}
+	if depositType != 0 {
+		filterArgs.Add("f.type", fmt.Sprintf("%v", depositType))
+	}
+	if contract != "" {
+		filterArgs.Add("f.contract", contract)
+	}
 
 	pageData := &models.InitiatedDepositsPageData{
 		FilterAddress:       address,
@@ -139,12 +162,46 @@ func buildFilteredInitiatedDepositsPageData(pageIdx uint64, pageSize uint64, add
 		FilterMaxAmount:     maxAmount,
 		FilterWithOrphaned:  withOrphaned,
 		FilterWithValid:     withValid,
+		FilterDepositType:   depositType,
 	}
 	logrus.Debugf("initiated_deposits page called: %v:%v [%v,%v,%v,%v,%v]", pageIdx, pageSize, address, publickey, vname, minAmount, maxAmount)
 	if pageIdx == 1 {
 		pageData.IsDefaultPage = true
 	}
 
+	// deposit txs of additionally configured deposit contracts have their own index sequence, so only one contract is shown at a time
+	depositSyncState := dbtypes.DepositIndexerState{}
+	db.GetExplorerState(ctx, "indexer.depositstate", &depositSyncState)
+
+	var contractFilter []byte
+	if depositIndexer := services.GlobalBeaconService.GetDepositIndexer(); depositIndexer != nil {
+		contractAddress := depositIndexer.GetSpecsContract()
+		if common.IsHexAddress(contract) {
+			contractAddress = common.HexToAddress(contract)
+		}
+
+		contractProgress := depositIndexer.GetIndexerProgress()
+		for _, progress := range contractProgress {
+			if len(contractProgress) > 1 {
+				pageData.DepositContracts = append(pageData.DepositContracts, progress.Contract.Hex())
+			}
+			if progress.Contract == contractAddress {
+				depositSyncState.FinalBlock = progress.FinalBlock
+			}
+		}
+		if contractAddress != depositIndexer.GetSpecsContract() {
+			contractFilter = contractAddress[:]
+		}
+		pageData.FilterContract = contractAddress.Hex()
+
+		if rootState := depositIndexer.GetDepositRootState(contractAddress); rootState != nil && !rootState.Match {
+			pageData.DepositRootMismatch = true
+			pageData.DepositRootBlock = rootState.BlockNumber
+			pageData.DepositRootContractCount = rootState.ContractCount
+			pageData.DepositRootLocalCount = rootState.LocalCount
+		}
+	}
+
 	if pageSize > 100 {
 		pageSize = 100
 	}
@@ -164,17 +221,18 @@ func buildFilteredInitiatedDepositsPageData(pageIdx uint64, pageSize uint64, add
 		MaxAmount:     maxAmount,
 		WithOrphaned:  withOrphaned,
 		WithValid:     withValid,
+		DepositType:   depositType,
+		Contract:      contractFilter,
 	}
 
 	offset := (pageIdx - 1) * pageSize
-	depositSyncState := dbtypes.DepositIndexerState{}
-	db.GetExplorerState("indexer.depositstate", &depositSyncState)
 
-	dbDepositTxs, totalRows, err := db.GetDepositTxsFiltered(offset, uint32(pageSize), depositSyncState.FinalBlock, depositFilter)
+	dbDepositTxs, totalRows, err := db.GetDepositTxsFiltered(ctx, offset, uint32(pageSize), depositSyncState.FinalBlock, depositFilter)
 	if err != nil {
 		panic(err)
 	}
 
+	pubkeyStats := getDepositTxPubkeyStats(ctx, dbDepositTxs)
 	for _, depositTx := range dbDepositTxs {
 		depositTxData := &models.InitiatedDepositsPageDataDeposit{
 			Index:                 depositTx.Index,
@@ -190,6 +248,14 @@ func buildFilteredInitiatedDepositsPageData(pageIdx uint64, pageSize uint64, add
 			ValidatorStatus:       "",
 		}
 
+		if stats := pubkeyStats[phase0.BLSPubKey(depositTx.PublicKey)]; stats != nil {
+			depositTxData.IsInitial = depositTx.Index == stats.FirstIndex
+			depositTxData.IsTopUp = depositTx.Index > stats.FirstIndex
+			depositTxData.PubkeyDepositCount = stats.DepositCount
+			depositTxData.PubkeyDepositAmount = stats.Amount
+			depositTxData.FrontrunInitial, depositTxData.FrontrunVictim = getDepositTxFrontrunState(depositTx, stats)
+		}
+
 		if validatorIdx, found := services.GlobalBeaconService.GetValidatorIndexByPubkey(phase0.BLSPubKey(depositTx.PublicKey)); !found {
 			depositTxData.ValidatorStatus = "Deposited"
 		} else {
@@ -244,3 +310,25 @@ func buildFilteredInitiatedDepositsPageData(pageIdx uint64, pageSize uint64, add
 
 	return pageData
 }
+
+var initiatedDepositsCsvHeader = []string{"deposit_index", "block", "time", "tx_hash", "orphaned", "valid", "sender_address", "pubkey", "withdrawal_credentials", "amount", "validator_status"}
+
+func getInitiatedDepositsCsvRows(pageData *models.InitiatedDepositsPageData) [][]string {
+	rows := make([][]string, 0, len(pageData.Deposits))
+	for _, deposit := range pageData.Deposits {
+		rows = append(rows, []string{
+			csvUint(deposit.Index),
+			csvUint(deposit.Block),
+			csvTime(deposit.Time),
+			csvHex(deposit.TxHash),
+			csvBool(deposit.Orphaned),
+			csvBool(deposit.Valid),
+			csvHex(deposit.Address),
+			csvHex(deposit.PublicKey),
+			csvHex(deposit.Withdrawalcredentials),
+			csvUint(deposit.Amount),
+			deposit.ValidatorStatus,
+		})
+	}
+	return rows
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/clients/execution"
+	"github.com/ethpandaops/dora/db"
+	execindexer "github.com/ethpandaops/dora/indexer/execution"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// Metrics will return the health metrics of the indexer, clients, database & page cache in the prometheus exposition format
+func Metrics(w http.ResponseWriter, r *http.Request) {
+	if !utils.Config().Metrics.Enabled {
+		http.Error(w, "Metrics are not enabled", http.StatusNotFound)
+		return
+	}
+	if !checkMetricsAuthToken(r, utils.Config().Metrics.AuthToken) {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var metrics strings.Builder
+	writeHeader := func(name string, metricType string, help string) {
+		fmt.Fprintf(&metrics, "# HELP %v %v\n# TYPE %v %v\n", name, help, name, metricType)
+	}
+
+	// deposit indexer
+	var depositProgress []*execindexer.DepositIndexerProgress
+	if depositIndexer := services.GlobalBeaconService.GetDepositIndexer(); depositIndexer != nil {
+		depositProgress = depositIndexer.GetIndexerProgress()
+	}
+
+	writeHeader("dora_deposit_indexer_final_block", "gauge", "Last finalized execution block the deposit contract logs have been indexed for.")
+	for _, progress := range depositProgress {
+		fmt.Fprintf(&metrics, "dora_deposit_indexer_final_block{contract=\"%v\"} %v\n", progress.Contract.String(), progress.FinalBlock)
+	}
+
+	writeHeader("dora_deposit_indexer_head_block", "gauge", "Execution block number of the canonical head.")
+	for _, progress := range depositProgress {
+		fmt.Fprintf(&metrics, "dora_deposit_indexer_head_block{contract=\"%v\"} %v\n", progress.Contract.String(), progress.HeadBlock)
+	}
+
+	writeHeader("dora_deposit_indexer_lag_blocks", "gauge", "Number of execution blocks between the indexed deposit contract logs and the canonical head.")
+	for _, progress := range depositProgress {
+		lag := uint64(0)
+		if progress.HeadBlock > progress.FinalBlock {
+			lag = progress.HeadBlock - progress.FinalBlock
+		}
+		fmt.Fprintf(&metrics, "dora_deposit_indexer_lag_blocks{contract=\"%v\"} %v\n", progress.Contract.String(), lag)
+	}
+
+	// block cache
+	writeHeader("dora_block_cache_blocks", "gauge", "Number of blocks in the beacon indexer cache.")
+	fmt.Fprintf(&metrics, "dora_block_cache_blocks %v\n", services.GlobalBeaconService.GetBeaconIndexer().GetBlockCacheSize())
+
+	// fork memory
+	writeHeader("dora_fork_memory_bytes", "gauge", "Approximate memory size of the cached blocks of a fork.")
+	forkMemoryStats := services.GlobalBeaconService.GetBeaconIndexer().GetForkMemoryStats()
+	for _, stats := range forkMemoryStats {
+		fmt.Fprintf(&metrics, "dora_fork_memory_bytes{fork=\"%v\",canonical=\"%v\"} %v\n", stats.ForkId, stats.Canonical, stats.MemorySize)
+	}
+
+	writeHeader("dora_fork_memory_blocks", "gauge", "Number of cached blocks of a fork.")
+	for _, stats := range forkMemoryStats {
+		fmt.Fprintf(&metrics, "dora_fork_memory_blocks{fork=\"%v\",canonical=\"%v\"} %v\n", stats.ForkId, stats.Canonical, stats.Blocks)
+	}
+
+	writeHeader("dora_fork_memory_block_bodies", "gauge", "Number of cached blocks of a fork with their block body in memory.")
+	for _, stats := range forkMemoryStats {
+		fmt.Fprintf(&metrics, "dora_fork_memory_block_bodies{fork=\"%v\",canonical=\"%v\"} %v\n", stats.ForkId, stats.Canonical, stats.BlockBodies)
+	}
+
+	writeHeader("dora_fork_memory_evicted_bodies_total", "counter", "Number of block bodies evicted because their fork exceeded the fork memory limit.")
+	fmt.Fprintf(&metrics, "dora_fork_memory_evicted_bodies_total %v\n", services.GlobalBeaconService.GetBeaconIndexer().GetForkMemoryEvictedBodies())
+
+	// clients
+	writeHeader("dora_consensus_client_ready", "gauge", "Readiness of the consensus client, 1 if online and 0 otherwise with the status as label.")
+	for _, client := range services.GlobalBeaconService.GetConsensusClients() {
+		status := client.GetStatus()
+		fmt.Fprintf(&metrics, "dora_consensus_client_ready{name=\"%v\",status=\"%v\"} %v\n", escapeMetricLabel(client.GetName()), status.String(), boolMetric(status == consensus.ClientStatusOnline))
+	}
+
+	writeHeader("dora_execution_client_ready", "gauge", "Readiness of the execution client, 1 if online and 0 otherwise with the status as label.")
+	for _, client := range services.GlobalBeaconService.GetExecutionClients() {
+		status := client.GetStatus()
+		fmt.Fprintf(&metrics, "dora_execution_client_ready{name=\"%v\",status=\"%v\"} %v\n", escapeMetricLabel(client.GetName()), status.String(), boolMetric(status == execution.ClientStatusOnline))
+	}
+
+	// database
+	txStats := db.GetTransactionStats()
+
+	writeHeader("dora_db_transactions_total", "counter", "Number of database write transactions.")
+	fmt.Fprintf(&metrics, "dora_db_transactions_total %v\n", txStats.Count)
+
+	writeHeader("dora_db_transaction_errors_total", "counter", "Number of failed database write transactions.")
+	fmt.Fprintf(&metrics, "dora_db_transaction_errors_total %v\n", txStats.ErrorCount)
+
+	writeHeader("dora_db_transaction_duration_seconds", "histogram", "Duration of database write transactions in seconds.")
+	for i, bucket := range db.TransactionDurationBuckets {
+		fmt.Fprintf(&metrics, "dora_db_transaction_duration_seconds_bucket{le=\"%v\"} %v\n", strconv.FormatFloat(bucket, 'g', -1, 64), txStats.BucketCounts[i])
+	}
+	fmt.Fprintf(&metrics, "dora_db_transaction_duration_seconds_bucket{le=\"+Inf\"} %v\n", txStats.Count)
+	fmt.Fprintf(&metrics, "dora_db_transaction_duration_seconds_sum %v\n", txStats.TotalDuration.Seconds())
+	fmt.Fprintf(&metrics, "dora_db_transaction_duration_seconds_count %v\n", txStats.Count)
+
+	// frontend cache
+	cacheStats := services.GlobalFrontendCache.GetStats()
+
+	writeHeader("dora_frontend_cache_hits_total", "counter", "Number of page calls served from the frontend cache.")
+	fmt.Fprintf(&metrics, "dora_frontend_cache_hits_total %v\n", cacheStats.CacheHits)
+
+	writeHeader("dora_frontend_cache_misses_total", "counter", "Number of page calls that had to build the page.")
+	fmt.Fprintf(&metrics, "dora_frontend_cache_misses_total %v\n", cacheStats.CacheMisses)
+
+	writeHeader("dora_frontend_cache_shared_calls_total", "counter", "Number of page calls that joined an already running build of the same page.")
+	fmt.Fprintf(&metrics, "dora_frontend_cache_shared_calls_total %v\n", cacheStats.SharedCalls)
+
+	// page rendering
+	writeHeader("dora_page_render_duration_seconds", "summary", "Template render duration of frontend pages in seconds, quantiles refer to the most recent renders of each page.")
+	for _, page := range services.GlobalRenderStats.GetRenderStats() {
+		pageLabel := escapeMetricLabel(page.Page)
+		fmt.Fprintf(&metrics, "dora_page_render_duration_seconds{page=\"%v\",quantile=\"0.5\"} %v\n", pageLabel, page.P50.Seconds())
+		fmt.Fprintf(&metrics, "dora_page_render_duration_seconds{page=\"%v\",quantile=\"0.9\"} %v\n", pageLabel, page.P90.Seconds())
+		fmt.Fprintf(&metrics, "dora_page_render_duration_seconds{page=\"%v\",quantile=\"0.99\"} %v\n", pageLabel, page.P99.Seconds())
+		fmt.Fprintf(&metrics, "dora_page_render_duration_seconds_sum{page=\"%v\"} %v\n", pageLabel, page.Total.Seconds())
+		fmt.Fprintf(&metrics, "dora_page_render_duration_seconds_count{page=\"%v\"} %v\n", pageLabel, page.Count)
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Write([]byte(metrics.String()))
+}
+
+func boolMetric(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// MetricsValidators will return the metrics of the watched validators in the prometheus exposition format
+func MetricsValidators(w http.ResponseWriter, r *http.Request) {
+	if services.GlobalValidatorMetrics == nil {
+		http.Error(w, "Validator metrics are not enabled", http.StatusNotFound)
+		return
+	}
+	if !checkMetricsAuthToken(r, utils.Config().ValidatorMetrics.AuthToken) {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	watchedValidators := services.GlobalValidatorMetrics.GetWatchedValidators()
+
+	type validatorEntry struct {
+		labels    string
+		watched   services.WatchedValidator
+		found     bool
+		balance   uint64
+		effective uint64
+		status    string
+	}
+	entries := make([]*validatorEntry, 0, len(watchedValidators))
+	for _, watched := range watchedValidators {
+		entry := &validatorEntry{
+			watched: watched,
+		}
+		pubkey := ""
+		validator := services.GlobalBeaconService.GetValidatorByIndex(watched.Index, true)
+		if validator != nil && validator.Validator != nil {
+			entry.found = true
+			entry.balance = uint64(validator.Balance)
+			entry.effective = uint64(validator.Validator.EffectiveBalance)
+			entry.status = validator.Status.String()
+			pubkey = fmt.Sprintf("0x%x", validator.Validator.PublicKey[:])
+		}
+		validatorName := services.GlobalBeaconService.GetValidatorName(uint64(watched.Index))
+		entry.labels = fmt.Sprintf(`index="%v",pubkey="%v",name="%v"`, watched.Index, pubkey, escapeMetricLabel(validatorName))
+		entries = append(entries, entry)
+	}
+
+	var metrics strings.Builder
+	writeHeader := func(name string, metricType string, help string) {
+		fmt.Fprintf(&metrics, "# HELP %v %v\n# TYPE %v %v\n", name, help, name, metricType)
+	}
+
+	writeHeader("dora_validator_balance_gwei", "gauge", "Current balance of the watched validator in gwei.")
+	for _, entry := range entries {
+		if entry.found {
+			fmt.Fprintf(&metrics, "dora_validator_balance_gwei{%v} %v\n", entry.labels, entry.balance)
+		}
+	}
+
+	writeHeader("dora_validator_effective_balance_gwei", "gauge", "Current effective balance of the watched validator in gwei.")
+	for _, entry := range entries {
+		if entry.found {
+			fmt.Fprintf(&metrics, "dora_validator_effective_balance_gwei{%v} %v\n", entry.labels, entry.effective)
+		}
+	}
+
+	writeHeader("dora_validator_status", "gauge", "Current status of the watched validator, always 1 with the status as label.")
+	for _, entry := range entries {
+		if entry.found {
+			fmt.Fprintf(&metrics, "dora_validator_status{%v,status=\"%v\"} 1\n", entry.labels, entry.status)
+		}
+	}
+
+	writeHeader("dora_validator_attestation_duties_total", "counter", "Number of checked epochs the watched validator had to attest in.")
+	for _, entry := range entries {
+		fmt.Fprintf(&metrics, "dora_validator_attestation_duties_total{%v} %v\n", entry.labels, entry.watched.AttestationDuties)
+	}
+
+	writeHeader("dora_validator_missed_attestations_total", "counter", "Number of checked epochs without an included attestation of the watched validator.")
+	for _, entry := range entries {
+		fmt.Fprintf(&metrics, "dora_validator_missed_attestations_total{%v} %v\n", entry.labels, entry.watched.MissedAttestations)
+	}
+
+	writeHeader("dora_validator_last_proposal_epoch", "gauge", "Epoch of the latest canonical block proposed by the watched validator.")
+	for _, entry := range entries {
+		if entry.watched.LastProposalSlot != nil {
+			fmt.Fprintf(&metrics, "dora_validator_last_proposal_epoch{%v} %v\n", entry.labels, chainState.EpochOfSlot(*entry.watched.LastProposalSlot))
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Write([]byte(metrics.String()))
+}
+
+// checkMetricsAuthToken checks the bearer token of a metrics scrape request if a token is configured
+func checkMetricsAuthToken(r *http.Request, token string) bool {
+	if token == "" {
+		return true
+	}
+
+	authToken, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(authToken), []byte(token)) == 1
+}
+
+// escapeMetricLabel escapes a label value for the prometheus exposition format
+func escapeMetricLabel(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+	return value
+}
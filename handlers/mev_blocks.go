@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -74,7 +75,7 @@ func MevBlocks(w http.ResponseWriter, r *http.Request) {
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
 	if pageError == nil {
-		data.Data, pageError = getFilteredMevBlocksPageData(pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, withRelays, withProposed)
+		data.Data, pageError = getFilteredMevBlocksPageData(r.Context(), pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, withRelays, withProposed)
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -86,11 +87,11 @@ func MevBlocks(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getFilteredMevBlocksPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, withRelays string, withProposed string) (*models.MevBlocksPageData, error) {
+func getFilteredMevBlocksPageData(ctx context.Context, pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, withRelays string, withProposed string) (*models.MevBlocksPageData, error) {
 	pageData := &models.MevBlocksPageData{}
 	pageCacheKey := fmt.Sprintf("mev_blocks:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, withRelays, withProposed)
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
-		return buildFilteredMevBlocksPageData(pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, withRelays, withProposed)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		return buildFilteredMevBlocksPageData(pageCall.CallCtx, pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, withRelays, withProposed)
 	})
 	if pageErr == nil && pageRes != nil {
 		resData, resOk := pageRes.(*models.MevBlocksPageData)
@@ -102,7 +103,7 @@ func getFilteredMevBlocksPageData(pageIdx uint64, pageSize uint64, minSlot uint6
 	return pageData, pageErr
 }
 
-func buildFilteredMevBlocksPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, withRelays string, withProposed string) *models.MevBlocksPageData {
+func buildFilteredMevBlocksPageData(ctx context.Context, pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, withRelays string, withProposed string) *models.MevBlocksPageData {
 	filterArgs := url.Values{}
 	if minSlot != 0 {
 		filterArgs.Add("f.mins", fmt.Sprintf("%v", minSlot))
@@ -137,7 +138,7 @@ func buildFilteredMevBlocksPageData(pageIdx uint64, pageSize uint64, minSlot uin
 		FilterProposed:      map[uint8]bool{},
 	}
 
-	for _, relay := range utils.Config.MevIndexer.Relays {
+	for _, relay := range utils.Config().MevIndexer.Relays {
 		pageData.FilterRelayOpts = append(pageData.FilterRelayOpts, &models.MevBlocksPageDataRelay{
 			Index: uint64(relay.Index),
 			Name:  relay.Name,
@@ -205,7 +206,7 @@ func buildFilteredMevBlocksPageData(pageIdx uint64, pageSize uint64, minSlot uin
 	}
 
 	offset := (pageIdx - 1) * pageSize
-	dbMevBlocks, totalRows, err := db.GetMevBlocksFiltered(offset, uint32(pageSize), mevBlockFilter)
+	dbMevBlocks, totalRows, err := db.GetMevBlocksFiltered(ctx, offset, uint32(pageSize), mevBlockFilter)
 	if err != nil {
 		panic(err)
 	}
@@ -229,7 +230,7 @@ func buildFilteredMevBlocksPageData(pageIdx uint64, pageSize uint64, minSlot uin
 			BlockValue:     mevBlock.BlockValueGwei,
 		}
 
-		for _, relay := range utils.Config.MevIndexer.Relays {
+		for _, relay := range utils.Config().MevIndexer.Relays {
 			relayFlag := uint64(1) << uint64(relay.Index)
 			if mevBlock.SeenbyRelays&relayFlag > 0 {
 				mevBlockData.Relays = append(mevBlockData.Relays, &models.MevBlocksPageDataRelay{
@@ -265,3 +266,15 @@ func buildFilteredMevBlocksPageData(pageIdx uint64, pageSize uint64, minSlot uin
 
 	return pageData
 }
+
+// getMevBlockRelayNames returns the names of the configured relays that delivered a payload (by relay bitfield).
+func getMevBlockRelayNames(seenbyRelays uint64) []string {
+	relays := []string{}
+	for _, relay := range utils.Config().MevIndexer.Relays {
+		relayFlag := uint64(1) << uint64(relay.Index)
+		if seenbyRelays&relayFlag > 0 {
+			relays = append(relays, relay.Name)
+		}
+	}
+	return relays
+}
@@ -23,14 +23,14 @@ var layoutTemplateFiles = []string{
 }
 
 func InitPageData(w http.ResponseWriter, r *http.Request, active, path, title string, mainTemplates []string) *types.PageData {
-	fullTitle := fmt.Sprintf("%v - %v", utils.Config.Frontend.SiteName, title)
+	fullTitle := fmt.Sprintf("%v - %v", utils.Config().Frontend.SiteName, title)
 
 	if title == "" {
-		fullTitle = fmt.Sprintf("%v", utils.Config.Frontend.SiteName)
+		fullTitle = fmt.Sprintf("%v", utils.Config().Frontend.SiteName)
 	}
 
 	buildTime, _ := time.Parse("2006-01-02T15:04:05Z", utils.Buildtime)
-	siteDomain := utils.Config.Frontend.SiteDomain
+	siteDomain := utils.Config().Frontend.SiteDomain
 	if siteDomain == "" {
 		siteDomain = r.Host
 	}
@@ -48,11 +48,11 @@ func InitPageData(w http.ResponseWriter, r *http.Request, active, path, title st
 		Version:          utils.GetExplorerVersion(),
 		BuildTime:        fmt.Sprintf("%v", buildTime.Unix()),
 		Year:             time.Now().UTC().Year(),
-		ExplorerTitle:    utils.Config.Frontend.SiteName,
-		ExplorerSubtitle: utils.Config.Frontend.SiteSubtitle,
-		ExplorerLogo:     utils.Config.Frontend.SiteLogo,
+		ExplorerTitle:    utils.Config().Frontend.SiteName,
+		ExplorerSubtitle: utils.Config().Frontend.SiteSubtitle,
+		ExplorerLogo:     utils.Config().Frontend.SiteLogo,
 		Lang:             "en-US",
-		Debug:            utils.Config.Frontend.Debug,
+		Debug:            utils.Config().Frontend.Debug,
 		MainMenuItems:    createMenuItems(active),
 	}
 
@@ -62,12 +62,14 @@ func InitPageData(w http.ResponseWriter, r *http.Request, active, path, title st
 		data.ChainSlotsPerEpoch = specs.SlotsPerEpoch
 		data.ChainSecondsPerSlot = uint64(specs.SecondsPerSlot.Seconds())
 		data.ChainGenesisTimestamp = uint64(chainState.GetGenesis().GenesisTime.Unix())
+		data.CurrentSlot = uint64(chainState.CurrentSlot())
+		data.CurrentEpoch = uint64(chainState.CurrentEpoch())
 		data.DepositContract = common.BytesToAddress(specs.DepositContractAddress).String()
 		data.Mainnet = specs.ConfigName == "mainnet"
 	}
 
-	if utils.Config.Frontend.SiteDescription != "" {
-		data.Meta.Description = utils.Config.Frontend.SiteDescription
+	if utils.Config().Frontend.SiteDescription != "" {
+		data.Meta.Description = utils.Config().Frontend.SiteDescription
 	}
 
 	acceptedLangs := strings.Split(r.Header.Get("Accept-Language"), ",")
@@ -119,9 +121,35 @@ func createMenuItems(active string) []types.MainMenuItem {
 				Path:  "/slots",
 				Icon:  "fa-cube",
 			},
+			{
+				Label: "Head Vote Accuracy",
+				Path:  "/epochs/head_votes",
+				Icon:  "fa-crosshairs",
+			},
+			{
+				Label: "Blob Stats",
+				Path:  "/blobs/stats",
+				Icon:  "fa-database",
+			},
+			{
+				Label: "Graffiti",
+				Path:  "/graffiti",
+				Icon:  "fa-pen-nib",
+			},
 		},
 	})
-	if len(utils.Config.MevIndexer.Relays) > 0 {
+	if utils.Config().BlobIndexer.Enabled {
+		blockchainMenu = append(blockchainMenu, types.NavigationGroup{
+			Links: []types.NavigationLink{
+				{
+					Label: "Blobs",
+					Path:  "/blobs",
+					Icon:  "fa-cubes",
+				},
+			},
+		})
+	}
+	if len(utils.Config().MevIndexer.Relays) > 0 {
 		blockchainMenu = append(blockchainMenu, types.NavigationGroup{
 			Links: []types.NavigationLink{
 				{
@@ -133,15 +161,52 @@ func createMenuItems(active string) []types.MainMenuItem {
 		})
 	}
 
+	if len(utils.Config().ExecutionApi.WatchedContracts) > 0 {
+		blockchainMenu = append(blockchainMenu, types.NavigationGroup{
+			Links: []types.NavigationLink{
+				{
+					Label: "Contract Logs",
+					Path:  "/contracts/logs",
+					Icon:  "fa-file-contract",
+				},
+			},
+		})
+	}
+
 	clientLinks := []types.NavigationLink{
 		{
 			Label: "Consensus",
 			Path:  "/clients/consensus",
 			Icon:  "fa-server",
 		},
+		{
+			Label: "Peer Locations",
+			Path:  "/clients/consensus/geo",
+			Icon:  "fa-globe",
+		},
+		{
+			Label: "Synchronization",
+			Path:  "/clients/syncing",
+			Icon:  "fa-rotate",
+		},
+		{
+			Label: "Block Import Latency",
+			Path:  "/clients/latency",
+			Icon:  "fa-stopwatch",
+		},
+		{
+			Label: "Feature Matrix",
+			Path:  "/clients/features",
+			Icon:  "fa-list-check",
+		},
+		{
+			Label: "Client Diversity",
+			Path:  "/clients",
+			Icon:  "fa-chart-pie",
+		},
 	}
 
-	if utils.Config.ExecutionApi.Endpoint != "" || len(utils.Config.ExecutionApi.Endpoints) > 0 {
+	if utils.Config().ExecutionApi.Endpoint != "" || len(utils.Config().ExecutionApi.Endpoints) > 0 {
 		clientLinks = append(clientLinks, types.NavigationLink{
 			Label: "Execution",
 			Path:  "/clients/execution",
@@ -190,6 +255,11 @@ func createMenuItems(active string) []types.MainMenuItem {
 				Path:  "/validators/slashings",
 				Icon:  "fa-user-slash",
 			},
+			{
+				Label: "Slashing Protection Check",
+				Path:  "/validators/slashing_protection",
+				Icon:  "fa-shield-halved",
+			},
 		},
 	})
 
@@ -208,12 +278,22 @@ func createMenuItems(active string) []types.MainMenuItem {
 					Path:  "/validators/el_consolidations",
 					Icon:  "fa-square-plus",
 				},
+				{
+					Label: "Electra Stats",
+					Path:  "/validators/electra",
+					Icon:  "fa-chart-pie",
+				},
+				{
+					Label: "Request Queues",
+					Path:  "/validators/request_queues",
+					Icon:  "fa-layer-group",
+				},
 			},
 		})
 	}
 
 	submitLinks := []types.NavigationLink{}
-	if utils.Config.Frontend.ShowSubmitDeposit {
+	if utils.Config().Frontend.ShowSubmitDeposit {
 		submitLinks = append(submitLinks, types.NavigationLink{
 			Label: "Submit Deposits",
 			Path:  "/validators/deposits/submit",
@@ -221,7 +301,7 @@ func createMenuItems(active string) []types.MainMenuItem {
 		})
 	}
 
-	if utils.Config.Frontend.ShowSubmitElRequests {
+	if utils.Config().Frontend.ShowSubmitElRequests {
 		submitLinks = append(submitLinks, types.NavigationLink{
 			Label: "Submit Consolidations",
 			Path:  "/validators/submit_consolidations",
@@ -234,6 +314,22 @@ func createMenuItems(active string) []types.MainMenuItem {
 		})
 	}
 
+	if utils.Config().Frontend.ShowValidatorOwnership {
+		submitLinks = append(submitLinks, types.NavigationLink{
+			Label: "Prove Validator Ownership",
+			Path:  "/validators/ownership",
+			Icon:  "fa-signature",
+		})
+	}
+
+	if utils.Config().Frontend.ShowValidatorWatchlist {
+		submitLinks = append(submitLinks, types.NavigationLink{
+			Label: "Validator Watchlists",
+			Path:  "/watchlist",
+			Icon:  "fa-eye",
+		})
+	}
+
 	if len(submitLinks) > 0 {
 		validatorMenu = append(validatorMenu, types.NavigationGroup{
 			Links: submitLinks,
@@ -259,8 +355,35 @@ func createMenuItems(active string) []types.MainMenuItem {
 	}
 }
 
+// PageRenderTiming attaches a page render info to the request context, so handleTemplateError can track the render duration of the page.
+func PageRenderTiming(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	ctx, _ := services.WithPageRenderInfo(r.Context())
+	next(w, r.WithContext(ctx))
+}
+
 // used to handle errors constructed by Template.ExecuteTemplate correctly
 func handleTemplateError(w http.ResponseWriter, r *http.Request, fileIdentifier string, functionIdentifier string, infoIdentifier string, err error) error {
+	if renderInfo := services.GetPageRenderInfo(r.Context()); renderInfo != nil && err == nil {
+		// the render duration is the time since the page data became available (or since the request started for uncached pages)
+		now := time.Now()
+		renderStart := renderInfo.DataReady
+		if renderStart.IsZero() {
+			renderStart = renderInfo.Start
+		}
+		renderTime := now.Sub(renderStart)
+		services.GlobalRenderStats.AddRender(functionIdentifier, renderTime)
+
+		if threshold := utils.Config().Frontend.SlowRenderThreshold; threshold > 0 && renderTime >= threshold {
+			logger.WithFields(logger.Fields{
+				"page":       functionIdentifier,
+				"route":      r.URL.String(),
+				"cache_key":  renderInfo.CacheKey,
+				"render":     renderTime,
+				"total_time": now.Sub(renderInfo.Start),
+			}).Warn("slow page render")
+		}
+	}
+
 	// ignore network related errors
 	if err != nil && !errors.Is(err, syscall.EPIPE) && !errors.Is(err, syscall.ETIMEDOUT) {
 		logger.WithFields(logger.Fields{
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// asyncPageBuildWait is the time a request waits for an expensive page build before the build status placeholder is shown
+const asyncPageBuildWait = 2 * time.Second
+
+// PageBuildStatus will return the status of a page build that continues in background as json,
+// it's polled by the placeholder page until the build is done.
+func PageBuildStatus(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	buildStatus := services.GlobalFrontendCache.GetPageBuildStatus(vars["id"])
+	if buildStatus == nil {
+		http.Error(w, "page build not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	err := json.NewEncoder(w).Encode(buildStatus)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding page build status")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
+
+// handlePageBuildPending renders the placeholder for a page that is still built in background.
+// the placeholder polls the build status and reloads the page once the build is done.
+func handlePageBuildPending(w http.ResponseWriter, r *http.Request, active string, title string, buildStatus *services.FrontendCacheBuildStatus) {
+	templateFiles := append(layoutTemplateFiles, "_layout/page_build.html")
+	pageTemplate := templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, active, r.URL.Path, title, templateFiles)
+	data.Data = &models.PageBuildPageData{
+		BuildId:  buildStatus.BuildId,
+		Title:    title,
+		Elapsed:  buildStatus.Elapsed,
+		Progress: buildStatus.Progress,
+		Status:   buildStatus.Status,
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusAccepted)
+	if handleTemplateError(w, r, "page_build.go", "PageBuild", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
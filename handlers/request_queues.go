@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/indexer/execution"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// size of the inline svg charts on the request queues page
+const (
+	requestQueuesChartWidth  = 1000
+	requestQueuesChartHeight = 150
+	requestQueuesChartPoints = 500
+	requestQueuesHistorySize = 50
+)
+
+// RequestQueues will return the "el request queues" page using a go template
+func RequestQueues(w http.ResponseWriter, r *http.Request) {
+	var requestQueuesTemplateFiles = append(layoutTemplateFiles,
+		"request_queues/request_queues.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(requestQueuesTemplateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/request_queues", "Request Queues", requestQueuesTemplateFiles)
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getRequestQueuesPageData(r.Context())
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "request_queues.go", "Request Queues", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getRequestQueuesPageData(ctx context.Context) (*models.RequestQueuesPageData, error) {
+	pageData := &models.RequestQueuesPageData{}
+	pageCacheKey := "request_queues"
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildRequestQueuesPageData(pageCall.CallCtx)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.RequestQueuesPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildRequestQueuesPageData(ctx context.Context) (*models.RequestQueuesPageData, time.Duration) {
+	logrus.Debugf("request queues page called")
+
+	pageData := &models.RequestQueuesPageData{}
+
+	monitor := services.GlobalBeaconService.GetSystemContractMonitor()
+	if monitor == nil {
+		return pageData, 1 * time.Minute
+	}
+
+	queueStates := monitor.GetQueueStates()
+	if len(queueStates) == 0 {
+		return pageData, 12 * time.Second
+	}
+
+	latestState := queueStates[len(queueStates)-1]
+	pageData.Available = true
+	pageData.BlockNumber = latestState.BlockNumber
+	pageData.Slot = latestState.Slot
+	pageData.Time = latestState.Time
+	pageData.HistoryBlocks = uint64(len(queueStates))
+
+	pageData.Withdrawals = buildRequestQueuesPageQueue("Withdrawal Requests", execution.WithdrawalContractAddr, queueStates, func(state *execution.SystemContractQueueState) *execution.SystemContractQueue {
+		return state.Withdrawals
+	})
+	pageData.Consolidations = buildRequestQueuesPageQueue("Consolidation Requests", execution.ConsolidationContractAddr, queueStates, func(state *execution.SystemContractQueueState) *execution.SystemContractQueue {
+		return state.Consolidations
+	})
+
+	pageData.History = make([]*models.RequestQueuesPageDataBlock, 0, requestQueuesHistorySize)
+	for idx := len(queueStates) - 1; idx >= 0 && len(pageData.History) < requestQueuesHistorySize; idx-- {
+		state := queueStates[idx]
+		pageData.History = append(pageData.History, &models.RequestQueuesPageDataBlock{
+			BlockNumber:            state.BlockNumber,
+			Slot:                   state.Slot,
+			Time:                   state.Time,
+			WithdrawalQueue:        state.Withdrawals.QueueLength,
+			WithdrawalFee:          getRequestQueueFee(state.Withdrawals),
+			WithdrawalSaturated:    state.Withdrawals.IsSaturated,
+			ConsolidationQueue:     state.Consolidations.QueueLength,
+			ConsolidationFee:       getRequestQueueFee(state.Consolidations),
+			ConsolidationSaturated: state.Consolidations.IsSaturated,
+		})
+	}
+
+	return pageData, 12 * time.Second
+}
+
+func buildRequestQueuesPageQueue(name string, contract string, queueStates []*execution.SystemContractQueueState, getQueue func(state *execution.SystemContractQueueState) *execution.SystemContractQueue) *models.RequestQueuesPageDataQueue {
+	latestQueue := getQueue(queueStates[len(queueStates)-1])
+	queueData := &models.RequestQueuesPageDataQueue{
+		Name:          name,
+		Contract:      common.HexToAddress(contract).Bytes(),
+		Excess:        latestQueue.Excess,
+		RequestCount:  latestQueue.RequestCount,
+		QueueLength:   latestQueue.QueueLength,
+		DequeueRate:   latestQueue.DequeueRate,
+		RequestFee:    getRequestQueueFee(latestQueue),
+		IsSaturated:   latestQueue.IsSaturated,
+		IsInactivated: latestQueue.IsInactivated,
+		MaxFee:        big.NewInt(0),
+	}
+
+	// downsample the history to the chart resolution, keeping the max values of each bucket
+	bucketSize := (len(queueStates) + requestQueuesChartPoints - 1) / requestQueuesChartPoints
+	bucketCount := (len(queueStates) + bucketSize - 1) / bucketSize
+	feePoints := make([]float64, bucketCount)
+	queuePoints := make([]float64, bucketCount)
+	maxFeeLog := float64(0)
+
+	for idx, state := range queueStates {
+		queue := getQueue(state)
+		fee := getRequestQueueFee(queue)
+		if fee.Cmp(queueData.MaxFee) > 0 {
+			queueData.MaxFee = fee
+		}
+		if queue.QueueLength > queueData.MaxQueue {
+			queueData.MaxQueue = queue.QueueLength
+		}
+
+		// the fee grows exponentially with the excess, so it's charted on a log scale
+		feeLog := float64(0)
+		if fee.Sign() > 0 {
+			feeFloat, _ := new(big.Float).SetInt(fee).Float64()
+			feeLog = math.Log10(feeFloat)
+		}
+		if feeLog > maxFeeLog {
+			maxFeeLog = feeLog
+		}
+
+		bucket := idx / bucketSize
+		feePoints[bucket] = math.Max(feePoints[bucket], feeLog)
+		queuePoints[bucket] = math.Max(queuePoints[bucket], float64(queue.QueueLength))
+	}
+
+	queueData.FeeChart = getRequestQueuesChartPoints(feePoints, maxFeeLog)
+	queueData.QueueChart = getRequestQueuesChartPoints(queuePoints, float64(queueData.MaxQueue))
+
+	return queueData
+}
+
+func getRequestQueueFee(queue *execution.SystemContractQueue) *big.Int {
+	if queue.RequestFee == nil {
+		return big.NewInt(0)
+	}
+	return queue.RequestFee
+}
+
+func getRequestQueuesChartPoints(values []float64, maxValue float64) string {
+	if maxValue <= 0 {
+		maxValue = 1
+	}
+
+	points := make([]string, len(values))
+	for idx, value := range values {
+		x := float64(0)
+		if len(values) > 1 {
+			x = float64(idx) * requestQueuesChartWidth / float64(len(values)-1)
+		}
+		y := requestQueuesChartHeight - value*requestQueuesChartHeight/maxValue
+		points[idx] = fmt.Sprintf("%.1f,%.1f", x, y)
+	}
+	return strings.Join(points, " ")
+}
@@ -403,3 +403,36 @@ func SearchAhead(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
 	}
 }
+
+// SearchSuggest returns typed search suggestions from the in-memory search indexes
+func SearchSuggest(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	urlArgs := r.URL.Query()
+	search := strings.Trim(urlArgs.Get("q"), " \t")
+	limit := 10
+	if urlArgs.Has("limit") {
+		limit, _ = strconv.Atoi(urlArgs.Get("limit"))
+		if limit < 1 || limit > 50 {
+			limit = 10
+		}
+	}
+
+	suggestions := services.GlobalBeaconService.GetSearchSuggestions(search, limit)
+	result := make([]models.SearchSuggestResult, len(suggestions))
+	for idx, suggestion := range suggestions {
+		result[idx] = models.SearchSuggestResult{
+			Type:  suggestion.Type,
+			Label: suggestion.Label,
+			Value: suggestion.Value,
+			Link:  suggestion.Link,
+			Count: suggestion.Count,
+		}
+	}
+
+	err := json.NewEncoder(w).Encode(result)
+	if err != nil {
+		logrus.WithError(err).Error("error encoding search suggestions")
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
@@ -0,0 +1,384 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+	zrnt_common "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// slashingProtectionMaxUploadSize is the max. size of an uploaded slashing protection export.
+const slashingProtectionMaxUploadSize = 32 * 1024 * 1024
+
+// slashingProtectionMaxValidators is the max. number of validators cross-checked per upload.
+const slashingProtectionMaxValidators = 200
+
+// slashingProtectionMaxChainBlocks is the max. number of proposals loaded per validator for the cross-check.
+const slashingProtectionMaxChainBlocks = 100
+
+// slashingProtectionExport is the EIP-3076 slashing protection interchange format.
+type slashingProtectionExport struct {
+	Metadata struct {
+		InterchangeFormatVersion string `json:"interchange_format_version"`
+		GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+	} `json:"metadata"`
+	Data []struct {
+		Pubkey       string `json:"pubkey"`
+		SignedBlocks []struct {
+			Slot        string `json:"slot"`
+			SigningRoot string `json:"signing_root"`
+		} `json:"signed_blocks"`
+		SignedAttestations []struct {
+			SourceEpoch string `json:"source_epoch"`
+			TargetEpoch string `json:"target_epoch"`
+			SigningRoot string `json:"signing_root"`
+		} `json:"signed_attestations"`
+	} `json:"data"`
+}
+
+type slashingProtectionBlock struct {
+	slot        uint64
+	signingRoot []byte
+}
+
+type slashingProtectionAttestation struct {
+	source      uint64
+	target      uint64
+	signingRoot []byte
+}
+
+// SlashingProtection will return the "slashing protection" cross-check page using a go template
+func SlashingProtection(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"slashing_protection/slashing_protection.html",
+	)
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/slashing_protection", "Slashing Protection Check", templateFiles)
+
+	pageData := &models.SlashingProtectionPageData{
+		MaxValidators: slashingProtectionMaxValidators,
+	}
+
+	if r.Method == http.MethodPost {
+		var pageError error
+		pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 10)
+		if pageError != nil {
+			handlePageError(w, r, pageError)
+			return
+		}
+
+		export, err := parseSlashingProtectionUpload(w, r)
+		pageData.HasResult = true
+		if err != nil {
+			pageData.Error = err.Error()
+		} else {
+			err = buildSlashingProtectionPageData(r.Context(), pageData, export)
+			if err != nil {
+				pageData.Error = err.Error()
+			}
+		}
+	}
+
+	data.Data = pageData
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "slashing_protection.go", "SlashingProtection", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func parseSlashingProtectionUpload(w http.ResponseWriter, r *http.Request) (*slashingProtectionExport, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, slashingProtectionMaxUploadSize)
+	if err := r.ParseMultipartForm(slashingProtectionMaxUploadSize); err != nil && !errors.Is(err, http.ErrNotMultipart) {
+		return nil, fmt.Errorf("failed parsing upload: %v", err)
+	}
+
+	var reader io.Reader
+	if file, _, err := r.FormFile("export"); err == nil {
+		defer file.Close()
+		reader = file
+	} else if text := r.FormValue("export_json"); text != "" {
+		reader = strings.NewReader(text)
+	} else {
+		return nil, errors.New("no slashing protection export provided")
+	}
+
+	export := &slashingProtectionExport{}
+	if err := json.NewDecoder(reader).Decode(export); err != nil {
+		return nil, fmt.Errorf("invalid slashing protection export: %v", err)
+	}
+
+	return export, nil
+}
+
+func buildSlashingProtectionPageData(ctx context.Context, pageData *models.SlashingProtectionPageData, export *slashingProtectionExport) error {
+	chainState := services.GlobalBeaconService.GetChainState()
+	genesis := chainState.GetGenesis()
+	if genesis == nil || chainState.GetSpecs() == nil {
+		return errors.New("chain state not ready yet")
+	}
+
+	pageData.FormatVersion = export.Metadata.InterchangeFormatVersion
+	if pageData.FormatVersion != "5" {
+		return fmt.Errorf("unsupported interchange format version %q (expected 5)", pageData.FormatVersion)
+	}
+
+	pageData.GenesisRoot = common.FromHex(export.Metadata.GenesisValidatorsRoot)
+	if common.BytesToHash(pageData.GenesisRoot) != common.Hash(genesis.GenesisValidatorsRoot) || len(pageData.GenesisRoot) != 32 {
+		return fmt.Errorf("export belongs to a different network (genesis validators root 0x%x, expected 0x%x)", pageData.GenesisRoot, genesis.GenesisValidatorsRoot[:])
+	}
+
+	pageData.ValidatorCount = uint64(len(export.Data))
+	pageData.Validators = make([]*models.SlashingProtectionPageDataValidator, 0, len(export.Data))
+
+	for idx, entry := range export.Data {
+		if idx >= slashingProtectionMaxValidators {
+			pageData.SkippedCount++
+			continue
+		}
+
+		validatorData := &models.SlashingProtectionPageDataValidator{
+			PublicKey: common.FromHex(entry.Pubkey),
+			Issues:    []*models.SlashingProtectionPageDataIssue{},
+		}
+		addIssue := func(severity string, link string, format string, args ...interface{}) {
+			validatorData.Issues = append(validatorData.Issues, &models.SlashingProtectionPageDataIssue{
+				Severity: severity,
+				Message:  fmt.Sprintf(format, args...),
+				Link:     link,
+			})
+		}
+		pageData.Validators = append(pageData.Validators, validatorData)
+
+		blocks := make([]*slashingProtectionBlock, 0, len(entry.SignedBlocks))
+		for _, signedBlock := range entry.SignedBlocks {
+			slot, err := strconv.ParseUint(signedBlock.Slot, 10, 64)
+			if err != nil {
+				addIssue("warning", "", "invalid signed block slot %q", signedBlock.Slot)
+				continue
+			}
+			blocks = append(blocks, &slashingProtectionBlock{
+				slot:        slot,
+				signingRoot: common.FromHex(signedBlock.SigningRoot),
+			})
+		}
+
+		attestations := make([]*slashingProtectionAttestation, 0, len(entry.SignedAttestations))
+		for _, signedAttestation := range entry.SignedAttestations {
+			source, err1 := strconv.ParseUint(signedAttestation.SourceEpoch, 10, 64)
+			target, err2 := strconv.ParseUint(signedAttestation.TargetEpoch, 10, 64)
+			if err1 != nil || err2 != nil {
+				addIssue("warning", "", "invalid signed attestation epochs %q -> %q", signedAttestation.SourceEpoch, signedAttestation.TargetEpoch)
+				continue
+			}
+			attestations = append(attestations, &slashingProtectionAttestation{
+				source:      source,
+				target:      target,
+				signingRoot: common.FromHex(signedAttestation.SigningRoot),
+			})
+		}
+
+		validatorData.BlockCount = uint64(len(blocks))
+		validatorData.AttestationCount = uint64(len(attestations))
+		pageData.BlockCount += validatorData.BlockCount
+		pageData.AttestationCount += validatorData.AttestationCount
+
+		checkSlashingProtectionBlocks(blocks, validatorData, addIssue)
+		checkSlashingProtectionAttestations(attestations, validatorData, addIssue)
+
+		if len(validatorData.PublicKey) != 48 {
+			addIssue("warning", "", "invalid validator public key %q", entry.Pubkey)
+			continue
+		}
+
+		validatorIndex, found := services.GlobalBeaconService.GetValidatorIndexByPubkey(phase0.BLSPubKey(validatorData.PublicKey))
+		if !found {
+			addIssue("warning", "", "validator is not known on chain, skipped cross-check with the chain history")
+			continue
+		}
+		validatorData.Known = true
+		validatorData.Index = uint64(validatorIndex)
+		validatorData.Name = services.GlobalBeaconService.GetValidatorName(uint64(validatorIndex))
+
+		crossCheckSlashingProtectionBlocks(ctx, blocks, genesis.GenesisValidatorsRoot, validatorData, addIssue)
+		crossCheckSlashingProtectionVotes(validatorIndex, validatorData, addIssue)
+	}
+
+	for _, validatorData := range pageData.Validators {
+		for _, issue := range validatorData.Issues {
+			switch issue.Severity {
+			case "conflict":
+				pageData.ConflictCount++
+			case "gap":
+				pageData.GapCount++
+			default:
+				pageData.WarningCount++
+			}
+		}
+	}
+
+	logrus.Debugf("slashing protection check: %v validators, %v conflicts, %v gaps", pageData.ValidatorCount, pageData.ConflictCount, pageData.GapCount)
+	return nil
+}
+
+// checkSlashingProtectionBlocks checks the exported blocks for double proposals.
+func checkSlashingProtectionBlocks(blocks []*slashingProtectionBlock, validatorData *models.SlashingProtectionPageDataValidator, addIssue func(string, string, string, ...interface{})) {
+	blocksBySlot := map[uint64]*slashingProtectionBlock{}
+	for _, block := range blocks {
+		if !validatorData.HasBlocks || block.slot > validatorData.MaxBlockSlot {
+			validatorData.HasBlocks = true
+			validatorData.MaxBlockSlot = block.slot
+		}
+
+		if otherBlock := blocksBySlot[block.slot]; otherBlock != nil {
+			if len(block.signingRoot) == 0 || string(block.signingRoot) != string(otherBlock.signingRoot) {
+				addIssue("conflict", fmt.Sprintf("/slot/%v", block.slot), "double proposal: the export contains multiple signed blocks for slot %v", block.slot)
+			}
+			continue
+		}
+		blocksBySlot[block.slot] = block
+	}
+}
+
+// checkSlashingProtectionAttestations checks the exported attestations for invalid, double & surround votes.
+func checkSlashingProtectionAttestations(attestations []*slashingProtectionAttestation, validatorData *models.SlashingProtectionPageDataValidator, addIssue func(string, string, string, ...interface{})) {
+	attestationsByTarget := map[uint64]*slashingProtectionAttestation{}
+	for _, attestation := range attestations {
+		if !validatorData.HasAttestations || attestation.target > validatorData.MaxTargetEpoch {
+			validatorData.HasAttestations = true
+			validatorData.MaxTargetEpoch = attestation.target
+		}
+
+		if attestation.source > attestation.target {
+			addIssue("conflict", "", "invalid vote: source epoch %v is after target epoch %v", attestation.source, attestation.target)
+		}
+
+		if otherAttestation := attestationsByTarget[attestation.target]; otherAttestation != nil {
+			if len(attestation.signingRoot) == 0 || string(attestation.signingRoot) != string(otherAttestation.signingRoot) {
+				addIssue("conflict", fmt.Sprintf("/epoch/%v", attestation.target), "double vote: the export contains multiple signed attestations for target epoch %v", attestation.target)
+			}
+			continue
+		}
+		attestationsByTarget[attestation.target] = attestation
+	}
+
+	// a vote is surrounded if another vote has a lower source and a higher target epoch.
+	// with the votes sorted by source, tracking the max. target of all votes with a lower source finds all surrounded votes.
+	sorted := make([]*slashingProtectionAttestation, len(attestations))
+	copy(sorted, attestations)
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].source < sorted[b].source
+	})
+
+	var maxTarget *slashingProtectionAttestation
+	var groupMaxTarget *slashingProtectionAttestation
+	for idx, attestation := range sorted {
+		if idx > 0 && attestation.source != sorted[idx-1].source {
+			if maxTarget == nil || (groupMaxTarget != nil && groupMaxTarget.target > maxTarget.target) {
+				maxTarget = groupMaxTarget
+			}
+			groupMaxTarget = nil
+		}
+
+		if maxTarget != nil && maxTarget.target > attestation.target {
+			addIssue("conflict", "", "surround vote: vote %v -> %v is surrounded by vote %v -> %v", attestation.source, attestation.target, maxTarget.source, maxTarget.target)
+		}
+
+		if groupMaxTarget == nil || attestation.target > groupMaxTarget.target {
+			groupMaxTarget = attestation
+		}
+	}
+}
+
+// crossCheckSlashingProtectionBlocks compares the exported blocks with the proposals of the validator on chain.
+func crossCheckSlashingProtectionBlocks(ctx context.Context, blocks []*slashingProtectionBlock, genesisValidatorsRoot phase0.Root, validatorData *models.SlashingProtectionPageDataValidator, addIssue func(string, string, string, ...interface{})) {
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	blocksBySlot := map[uint64]*slashingProtectionBlock{}
+	minBlockSlot := uint64(0)
+	for idx, block := range blocks {
+		blocksBySlot[block.slot] = block
+		if idx == 0 || block.slot < minBlockSlot {
+			minBlockSlot = block.slot
+		}
+	}
+
+	proposer := validatorData.Index
+	chainBlocks := services.GlobalBeaconService.GetDbBlocksByFilter(ctx, &dbtypes.BlockFilter{
+		ProposerIndex: &proposer,
+		WithOrphaned:  1,
+		WithMissing:   0,
+	}, 0, slashingProtectionMaxChainBlocks, 0)
+
+	for _, chainBlock := range chainBlocks {
+		if chainBlock.Block == nil || chainBlock.Block.Status == dbtypes.Missing {
+			continue
+		}
+
+		slot := chainBlock.Slot
+		validatorData.ChainBlockCount++
+		if !validatorData.HasChainBlocks || slot > validatorData.LatestChainBlock {
+			validatorData.HasChainBlocks = true
+			validatorData.LatestChainBlock = slot
+		}
+
+		slotLink := fmt.Sprintf("/slot/0x%x", chainBlock.Block.Root)
+		exportedBlock := blocksBySlot[slot]
+		if exportedBlock == nil {
+			if !validatorData.HasBlocks || slot > validatorData.MaxBlockSlot {
+				addIssue("gap", slotLink, "block at slot %v was proposed on chain, but is newer than all blocks in the export", slot)
+			} else if slot >= minBlockSlot {
+				addIssue("warning", slotLink, "block at slot %v was proposed on chain, but is missing in the export", slot)
+			}
+			continue
+		}
+
+		if len(exportedBlock.signingRoot) == 0 {
+			continue
+		}
+
+		forkVersion := chainState.GetForkVersionAtEpoch(chainState.EpochOfSlot(phase0.Slot(slot)))
+		domain := zrnt_common.ComputeDomain(zrnt_common.DOMAIN_BEACON_PROPOSER, zrnt_common.Version(forkVersion), zrnt_common.Root(genesisValidatorsRoot))
+		signingRoot := zrnt_common.ComputeSigningRoot(zrnt_common.Root(chainBlock.Block.Root), domain)
+		if string(signingRoot[:]) != string(exportedBlock.signingRoot) {
+			addIssue("conflict", slotLink, "block at slot %v on chain does not match the signed block in the export (signing root 0x%x, expected 0x%x)", slot, exportedBlock.signingRoot, signingRoot[:])
+		}
+	}
+}
+
+// crossCheckSlashingProtectionVotes compares the exported attestations with the recent votes of the validator on chain.
+func crossCheckSlashingProtectionVotes(validatorIndex phase0.ValidatorIndex, validatorData *models.SlashingProtectionPageDataValidator, addIssue func(string, string, string, ...interface{})) {
+	chainState := services.GlobalBeaconService.GetChainState()
+	activity, _ := services.GlobalBeaconService.GetValidatorVotingActivity(validatorIndex)
+
+	for _, vote := range activity {
+		voteSlot := vote.VoteBlock.Slot
+		if voteSlot > phase0.Slot(vote.VoteDelay) {
+			voteSlot -= phase0.Slot(vote.VoteDelay)
+		}
+
+		voteEpoch := uint64(chainState.EpochOfSlot(voteSlot))
+		if !validatorData.HasChainVote || voteEpoch > validatorData.LatestVoteEpoch {
+			validatorData.HasChainVote = true
+			validatorData.LatestVoteEpoch = voteEpoch
+		}
+	}
+
+	if validatorData.HasChainVote && (!validatorData.HasAttestations || validatorData.LatestVoteEpoch > validatorData.MaxTargetEpoch) {
+		addIssue("gap", fmt.Sprintf("/epoch/%v", validatorData.LatestVoteEpoch), "validator voted for epoch %v on chain, but is newer than all attestations in the export", validatorData.LatestVoteEpoch)
+	}
+}
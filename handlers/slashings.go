@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -76,10 +77,18 @@ func Slashings(w http.ResponseWriter, r *http.Request) {
 	} else {
 		withOrphaned = 1
 	}
+	if isCsvExport(r) {
+		writeCsvExport(w, r, "slashings", slashingsCsvHeader, func(pageIdx uint64) ([][]string, bool) {
+			pageData := buildFilteredSlashingsPageData(r.Context(), pageIdx+1, csvExportPageSize, minSlot, maxSlot, minIndex, maxIndex, vname, sname, uint8(withReason), uint8(withOrphaned))
+			return getSlashingsCsvRows(pageData), pageData.NextPageIndex > 0
+		})
+		return
+	}
+
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
 	if pageError == nil {
-		data.Data, pageError = getFilteredSlashingsPageData(pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, sname, uint8(withReason), uint8(withOrphaned))
+		data.Data, pageError = getFilteredSlashingsPageData(r.Context(), pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, sname, uint8(withReason), uint8(withOrphaned))
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -91,11 +100,11 @@ func Slashings(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getFilteredSlashingsPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, sname string, withReason uint8, withOrphaned uint8) (*models.SlashingsPageData, error) {
+func getFilteredSlashingsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, sname string, withReason uint8, withOrphaned uint8) (*models.SlashingsPageData, error) {
 	pageData := &models.SlashingsPageData{}
 	pageCacheKey := fmt.Sprintf("slashings:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, sname, withReason, withOrphaned)
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
-		return buildFilteredSlashingsPageData(pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, sname, withReason, withOrphaned)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		return buildFilteredSlashingsPageData(pageCall.CallCtx, pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, sname, withReason, withOrphaned)
 	})
 	if pageErr == nil && pageRes != nil {
 		resData, resOk := pageRes.(*models.SlashingsPageData)
@@ -107,7 +116,7 @@ func getFilteredSlashingsPageData(pageIdx uint64, pageSize uint64, minSlot uint6
 	return pageData, pageErr
 }
 
-func buildFilteredSlashingsPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, sname string, withReason uint8, withOrphaned uint8) *models.SlashingsPageData {
+func buildFilteredSlashingsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, sname string, withReason uint8, withOrphaned uint8) *models.SlashingsPageData {
 	filterArgs := url.Values{}
 	if minSlot != 0 {
 		filterArgs.Add("f.mins", fmt.Sprintf("%v", minSlot))
@@ -171,7 +180,7 @@ func buildFilteredSlashingsPageData(pageIdx uint64, pageSize uint64, minSlot uin
 		WithOrphaned:  withOrphaned,
 	}
 
-	dbSlashings, totalRows := services.GlobalBeaconService.GetSlashingsByFilter(slashingFilter, pageIdx-1, uint32(pageSize))
+	dbSlashings, totalRows := services.GlobalBeaconService.GetSlashingsByFilter(ctx, slashingFilter, pageIdx-1, uint32(pageSize))
 
 	chainState := services.GlobalBeaconService.GetChainState()
 
@@ -245,3 +254,31 @@ func buildFilteredSlashingsPageData(pageIdx uint64, pageSize uint64, minSlot uin
 
 	return pageData
 }
+
+var slashingsCsvHeader = []string{"slot", "time", "orphaned", "reason", "validator_index", "validator_name", "validator_status", "balance", "slasher_index", "slasher_name"}
+
+func getSlashingsCsvRows(pageData *models.SlashingsPageData) [][]string {
+	rows := make([][]string, 0, len(pageData.Slashings))
+	for _, slashing := range pageData.Slashings {
+		reason := ""
+		switch dbtypes.SlashingReason(slashing.Reason) {
+		case dbtypes.ProposerSlashing:
+			reason = "proposer_slashing"
+		case dbtypes.AttesterSlashing:
+			reason = "attester_slashing"
+		}
+		rows = append(rows, []string{
+			csvUint(slashing.SlotNumber),
+			csvTime(slashing.Time),
+			csvBool(slashing.Orphaned),
+			reason,
+			csvUint(slashing.ValidatorIndex),
+			csvText(slashing.ValidatorName),
+			slashing.ValidatorStatus,
+			csvUint(slashing.Balance),
+			csvUint(slashing.SlasherIndex),
+			csvText(slashing.SlasherName),
+		})
+	}
+	return rows
+}
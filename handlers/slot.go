@@ -9,6 +9,7 @@ import (
 	"math"
 	"math/big"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -18,13 +19,16 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/gorilla/mux"
 	"github.com/juliangruber/go-intersect"
 	"github.com/sirupsen/logrus"
 
 	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
 	"github.com/ethpandaops/dora/indexer/beacon"
+	execindexer "github.com/ethpandaops/dora/indexer/execution"
 	"github.com/ethpandaops/dora/services"
 	"github.com/ethpandaops/dora/templates"
 	"github.com/ethpandaops/dora/types"
@@ -75,7 +79,7 @@ func Slot(w http.ResponseWriter, r *http.Request) {
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
 	if pageError == nil {
-		pageData, pageError = getSlotPageData(blockSlot, blockRootHash)
+		pageData, pageError = getSlotPageData(r.Context(), blockSlot, blockRootHash)
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -91,6 +95,10 @@ func Slot(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if pageData.EpochFinalized {
+		prefetchAdjacentSlots(pageData.Slot)
+	}
+
 	if urlArgs.Has("blob") && pageData.Block != nil {
 		commitment, err1 := hex.DecodeString(strings.Replace(urlArgs.Get("blob"), "0x", "", -1))
 		blobData, err2 := services.GlobalBeaconService.GetBlockBlob(r.Context(), phase0.Root(pageData.Block.BlockRoot), deneb.KZGCommitment(commitment))
@@ -160,10 +168,10 @@ func SlotBlob(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getSlotPageData(blockSlot int64, blockRoot []byte) (*models.SlotPageData, error) {
+func getSlotPageData(ctx context.Context, blockSlot int64, blockRoot []byte) (*models.SlotPageData, error) {
 	pageData := &models.SlotPageData{}
 	pageCacheKey := fmt.Sprintf("slot:%v:%x", blockSlot, blockRoot)
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
 		pageData, cacheTimeout := buildSlotPageData(pageCall.CallCtx, blockSlot, blockRoot)
 		pageCall.CacheTimeout = cacheTimeout
 		return pageData
@@ -219,12 +227,15 @@ func buildSlotPageData(ctx context.Context, blockSlot int64, blockRoot []byte) (
 		Badges:         []*models.SlotPageBlockBadge{},
 	}
 
+	if fork := chainState.GetForkAtEpoch(epoch); fork != nil {
+		pageData.ForkName = fork.Name
+		pageData.ForkVersion = fork.Version[:]
+	}
+
 	var epochStatsValues *beacon.EpochStatsValues
 	if chainState.EpochOfSlot(slot) >= finalizedEpoch {
 		beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
-		if epochStats := beaconIndexer.GetEpochStats(epoch, nil); epochStats != nil {
-			epochStatsValues = epochStats.GetOrLoadValues(beaconIndexer, true, false)
-		}
+		epochStatsValues = beaconIndexer.GetEpochStatsValues(epoch, nil)
 	}
 
 	var cacheTimeout time.Duration
@@ -252,9 +263,13 @@ func buildSlotPageData(ctx context.Context, blockSlot int64, blockRoot []byte) (
 			}
 		}
 		if pageData.Proposer == math.MaxInt64 {
-			pageData.Proposer = db.GetSlotAssignment(uint64(slot))
+			pageData.Proposer = db.GetSlotAssignment(ctx, uint64(slot))
 		}
 		pageData.ProposerName = services.GlobalBeaconService.GetValidatorName(pageData.Proposer)
+
+		if !pageData.Future && slot > 0 && pageData.Proposer != math.MaxInt64 {
+			pageData.Missed = getSlotPageMissedData(ctx, slot, pageData.Proposer, pageData.ProposerName)
+		}
 	} else {
 		if blockData.Orphaned {
 			pageData.Status = uint16(models.SlotStatusOrphaned)
@@ -263,34 +278,150 @@ func buildSlotPageData(ctx context.Context, blockSlot int64, blockRoot []byte) (
 		}
 		pageData.Proposer = uint64(blockData.Header.Message.ProposerIndex)
 		pageData.ProposerName = services.GlobalBeaconService.GetValidatorName(pageData.Proposer)
-		pageData.Block = getSlotPageBlockData(blockData, epochStatsValues)
+		pageData.Block = getSlotPageBlockData(ctx, blockData, epochStatsValues)
+		if pageData.Block.DepositsCount > 0 {
+			getSlotPageDepositTxs(ctx, pageData.Block, blockData.Root)
+		}
+		if pageData.Block.ExecutionData != nil {
+			getSlotPageTxSummary(ctx, pageData.Block.ExecutionData, blockData)
+		}
 
-		// check mev block
+		// check slot time anomalies
 		if pageData.Block.ExecutionData != nil {
-			mevBlock := db.GetMevBlockByBlockHash(pageData.Block.ExecutionData.BlockHash)
+			if anomaly := beacon.GetExecutionTimeAnomaly(chainState, slot, pageData.Block.ExecutionData.Timestamp); anomaly != nil {
+				pageData.Badges = append(pageData.Badges, &models.SlotPageBlockBadge{
+					Title:       "Timestamp Mismatch",
+					Icon:        "fa-clock",
+					Description: anomaly.Description,
+					ClassName:   "text-bg-danger",
+				})
+			}
+		}
+		for _, anomaly := range services.GlobalBeaconService.GetBeaconIndexer().GetSlotAnomalies() {
+			if anomaly.Type == beacon.SlotAnomalyFutureSlot && anomaly.Root == blockData.Root {
+				pageData.Badges = append(pageData.Badges, &models.SlotPageBlockBadge{
+					Title:       "Early Block",
+					Icon:        "fa-clock",
+					Description: anomaly.Description,
+					ClassName:   "text-bg-danger",
+				})
+			}
+		}
+
+		// check mev block, blocks without a relay delivered payload are considered as locally built
+		if executionData := pageData.Block.ExecutionData; executionData != nil {
+			executionData.ShowBuilder = len(utils.Config().MevIndexer.Relays) > 0
+			mevBlock := db.GetMevBlockByBlockHash(ctx, executionData.BlockHash)
 			if mevBlock != nil {
-				relays := []string{}
-				for _, relay := range utils.Config.MevIndexer.Relays {
-					relayFlag := uint64(1) << uint64(relay.Index)
-					if mevBlock.SeenbyRelays&relayFlag > 0 {
-						relays = append(relays, relay.Name)
-					}
-				}
+				relays := getMevBlockRelayNames(mevBlock.SeenbyRelays)
+				executionData.MevRelayed = true
+				executionData.MevRelays = relays
+				executionData.MevBlockValue = mevBlock.BlockValueGwei
+				executionData.MevBuilder = mevBlock.BuilderPubkey
 
 				pageData.Badges = append(pageData.Badges, &models.SlotPageBlockBadge{
 					Title:       "MEV Block",
 					Icon:        "fa-money-bill",
-					Description: fmt.Sprintf("Block proposed via Relay: %v", strings.Join(relays, ", ")),
+					Description: fmt.Sprintf("Block proposed via Relay: %v (bid value: %v)", strings.Join(relays, ", "), utils.FormatETHFromGwei(mevBlock.BlockValueGwei)),
 					ClassName:   "text-bg-warning",
 				})
 			}
 		}
 	}
 
+	pageData.ExternalLinks = getSlotPageExternalLinks(pageData)
+
 	return pageData, cacheTimeout
 }
 
-func getSlotPageBlockData(blockData *services.CombinedBlockResponse, epochStatsValues *beacon.EpochStatsValues) *models.SlotPageBlockData {
+// getSlotPageExternalLinks returns the configured external links for the slot, the block values are only set for slots with a block
+func getSlotPageExternalLinks(pageData *models.SlotPageData) []*types.ExternalLink {
+	linkValues := map[string]string{
+		"slot":  strconv.FormatUint(pageData.Slot, 10),
+		"epoch": strconv.FormatUint(pageData.Epoch, 10),
+	}
+	if pageData.Proposer != math.MaxInt64 {
+		linkValues["proposer"] = strconv.FormatUint(pageData.Proposer, 10)
+	}
+	if pageData.Block != nil {
+		linkValues["root"] = fmt.Sprintf("0x%x", pageData.Block.BlockRoot)
+		if executionData := pageData.Block.ExecutionData; executionData != nil {
+			linkValues["block"] = strconv.FormatUint(executionData.BlockNumber, 10)
+			linkValues["blockhash"] = fmt.Sprintf("0x%x", executionData.BlockHash)
+			linkValues["address"] = common.BytesToAddress(executionData.FeeRecipient).Hex()
+		}
+	}
+	return getExternalLinks("slot", linkValues)
+}
+
+// getSlotPageMissedData builds the postmortem details for a missed slot.
+// this includes orphaned blocks for the slot, the recent proposal history and a client guess for the proposer.
+func getSlotPageMissedData(ctx context.Context, slot phase0.Slot, proposer uint64, proposerName string) *models.SlotPageMissedData {
+	chainState := services.GlobalBeaconService.GetChainState()
+	missedData := &models.SlotPageMissedData{
+		OrphanedBlocks:  []*models.SlotPageMissedOrphanedBlock{},
+		RecentProposals: []*models.SlotPageMissedProposal{},
+	}
+
+	// check for orphaned blocks on other forks
+	for _, dbSlot := range services.GlobalBeaconService.GetDbBlocksForSlots(ctx, uint64(slot), 1, false, true) {
+		if dbSlot == nil || dbSlot.Slot != uint64(slot) || dbSlot.Status != dbtypes.Orphaned {
+			continue
+		}
+
+		missedData.OrphanedBlocks = append(missedData.OrphanedBlocks, &models.SlotPageMissedOrphanedBlock{
+			BlockRoot:    dbSlot.Root,
+			Proposer:     dbSlot.Proposer,
+			ProposerName: services.GlobalBeaconService.GetValidatorName(dbSlot.Proposer),
+			Graffiti:     dbSlot.Graffiti,
+		})
+	}
+
+	// load recent proposal history of the proposer
+	clientHints := []string{}
+	blocksData := services.GlobalBeaconService.GetDbBlocksByFilter(ctx, &dbtypes.BlockFilter{
+		ProposerIndex: &proposer,
+		WithOrphaned:  1,
+		WithMissing:   1,
+	}, 0, 10, 0)
+	for _, blockData := range blocksData {
+		proposal := &models.SlotPageMissedProposal{
+			Slot:    blockData.Slot,
+			Ts:      chainState.SlotToTime(phase0.Slot(blockData.Slot)),
+			Status:  uint16(dbtypes.Missing),
+			Current: blockData.Slot == uint64(slot),
+		}
+		if blockData.Block != nil {
+			proposal.Status = uint16(blockData.Block.Status)
+			proposal.BlockRoot = blockData.Block.Root
+			clientHints = append(clientHints, blockData.Block.GraffitiText, blockData.Block.EthBlockExtraText)
+		}
+
+		switch dbtypes.SlotStatus(proposal.Status) {
+		case dbtypes.Canonical:
+			missedData.ProposedCount++
+		case dbtypes.Orphaned:
+			missedData.OrphanedCount++
+		default:
+			missedData.MissedCount++
+		}
+
+		missedData.RecentProposals = append(missedData.RecentProposals, proposal)
+	}
+
+	// guess the proposer client from its name, fall back to graffiti & extra data of previous proposals
+	if clientGroup := services.GetClientGroupName(proposerName); clientGroup != "unknown" {
+		missedData.ClientGuess = clientGroup
+		missedData.ClientGuessSource = "validator name"
+	} else if clientGroup := services.GetClientGroupName(strings.Join(clientHints, " ")); clientGroup != "unknown" {
+		missedData.ClientGuess = clientGroup
+		missedData.ClientGuessSource = "graffiti / extra data of previous blocks"
+	}
+
+	return missedData
+}
+
+func getSlotPageBlockData(ctx context.Context, blockData *services.CombinedBlockResponse, epochStatsValues *beacon.EpochStatsValues) *models.SlotPageBlockData {
 	chainState := services.GlobalBeaconService.GetChainState()
 	specs := chainState.GetSpecs()
 	graffiti, _ := blockData.Block.Graffiti()
@@ -352,9 +483,7 @@ func getSlotPageBlockData(blockData *services.CombinedBlockResponse, epochStatsV
 		if !assignmentsLoaded[attEpoch] { // get epoch duties from cache
 			beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
 			if epochStats := beaconIndexer.GetEpochStats(epoch, nil); epochStats != nil {
-				epochStatsValues := epochStats.GetOrLoadValues(beaconIndexer, true, false)
-
-				assignmentsMap[attEpoch] = epochStatsValues
+				assignmentsMap[attEpoch] = beaconIndexer.GetEpochStatsValues(epoch, nil)
 				assignmentsLoaded[attEpoch] = true
 			}
 		}
@@ -564,7 +693,7 @@ func getSlotPageBlockData(blockData *services.CombinedBlockResponse, epochStatsV
 		}
 		if len(syncAssignments) == 0 {
 			syncPeriod := uint64(epoch) / specs.EpochsPerSyncCommitteePeriod
-			syncAssignments = db.GetSyncAssignmentsForPeriod(syncPeriod)
+			syncAssignments = db.GetSyncAssignmentsForPeriod(ctx, syncPeriod)
 		}
 
 		if len(syncAssignments) != 0 {
@@ -734,10 +863,39 @@ func getSlotPageBlockData(blockData *services.CombinedBlockResponse, epochStatsV
 	return pageData
 }
 
+// getSlotPageTxSummary adds the contract creations & top called contracts of the block.
+// the summary is taken from the transaction index if available, otherwise it is built from the block body.
+func getSlotPageTxSummary(ctx context.Context, executionData *models.SlotPageExecutionData, blockData *services.CombinedBlockResponse) {
+	summary := db.GetElTxSummary(ctx, blockData.Root[:])
+	if summary == nil {
+		var err error
+		summary, err = execindexer.BuildElTxSummary(blockData.Block)
+		if err != nil || summary == nil {
+			return
+		}
+	}
+
+	targets := []*dbtypes.ElTxTarget{}
+	if err := json.Unmarshal([]byte(summary.TopTargets), &targets); err != nil {
+		logrus.Warnf("error decoding tx summary targets 0x%x: %v", blockData.Root[:], err)
+	}
+
+	executionData.ContractCreations = summary.ContractCreations
+	executionData.TopTargets = make([]*models.SlotPageTxTarget, len(targets))
+	for i, target := range targets {
+		executionData.TopTargets[i] = &models.SlotPageTxTarget{
+			Address:  target.Address,
+			TxCount:  target.TxCount,
+			GasLimit: target.GasLimit,
+		}
+	}
+}
+
 func getSlotPageTransactions(pageData *models.SlotPageBlockData, tranactions []bellatrix.Transaction) {
 	pageData.Transactions = make([]*models.SlotPageTransaction, 0)
 	sigLookupBytes := []types.TxSignatureBytes{}
 	sigLookupMap := map[types.TxSignatureBytes][]*models.SlotPageTransaction{}
+	blobTxDist := map[uint64]uint64{}
 
 	for idx, txBytes := range tranactions {
 		var tx ethtypes.Transaction
@@ -759,8 +917,15 @@ func getSlotPageTransactions(pageData *models.SlotPageBlockData, tranactions []b
 			Value: txValue,
 			Data:  tx.Data(),
 			Type:  uint64(tx.Type()),
+			Size:  uint64(len(txBytes)),
 		}
 		txData.DataLen = uint64(len(txData.Data))
+		if tx.Type() == ethtypes.BlobTxType {
+			txData.BlobCount = uint64(len(tx.BlobHashes()))
+			pageData.BlobTransactionsCount++
+			pageData.BlobTransactionsSize += txData.Size
+			blobTxDist[txData.BlobCount]++
+		}
 		txFrom, err := ethtypes.Sender(ethtypes.NewPragueSigner(tx.ChainId()), &tx)
 		if err != nil {
 			txData.From = "unknown"
@@ -795,6 +960,17 @@ func getSlotPageTransactions(pageData *models.SlotPageBlockData, tranactions []b
 	}
 	pageData.TransactionsCount = uint64(len(tranactions))
 
+	pageData.BlobsPerTransaction = make([]*models.SlotPageBlobTxDist, 0, len(blobTxDist))
+	for blobCount, txCount := range blobTxDist {
+		pageData.BlobsPerTransaction = append(pageData.BlobsPerTransaction, &models.SlotPageBlobTxDist{
+			BlobCount: blobCount,
+			TxCount:   txCount,
+		})
+	}
+	sort.Slice(pageData.BlobsPerTransaction, func(a, b int) bool {
+		return pageData.BlobsPerTransaction[a].BlobCount < pageData.BlobsPerTransaction[b].BlobCount
+	})
+
 	if len(sigLookupBytes) > 0 {
 		sigLookups := services.GlobalTxSignaturesService.LookupSignatures(sigLookupBytes)
 		for _, sigLookup := range sigLookups {
@@ -812,6 +988,56 @@ func getSlotPageTransactions(pageData *models.SlotPageBlockData, tranactions []b
 	}
 }
 
+// getSlotPageDepositTxs cross-references the deposits included in the block with the indexed deposit transactions.
+// deposits of blocks that are not persisted yet are matched by pubkey & signature instead of the deposit index.
+func getSlotPageDepositTxs(ctx context.Context, pageData *models.SlotPageBlockData, blockRoot phase0.Root) {
+	setDepositTx := func(deposit *models.SlotPageDeposit, depositTx *dbtypes.DepositTx) {
+		deposit.HasDepositTx = true
+		deposit.DepositIndex = depositTx.Index
+		deposit.ValidSignature = depositTx.ValidSignature
+		deposit.TxHash = depositTx.TxHash
+		deposit.TxSender = depositTx.TxSender
+		deposit.TxBlockNumber = depositTx.BlockNumber
+		deposit.TxOrphaned = depositTx.Orphaned
+	}
+
+	for _, depositTx := range db.GetDepositTxsBySlotRoot(ctx, blockRoot[:]) {
+		if depositTx.SlotIndex >= uint64(len(pageData.Deposits)) {
+			continue
+		}
+		deposit := pageData.Deposits[depositTx.SlotIndex]
+		if !deposit.HasDepositTx {
+			setDepositTx(deposit, &depositTx.DepositTx)
+		}
+	}
+
+	pubkeys := [][]byte{}
+	for _, deposit := range pageData.Deposits {
+		if !deposit.HasDepositTx {
+			pubkeys = append(pubkeys, deposit.PublicKey)
+		}
+	}
+	if len(pubkeys) == 0 {
+		return
+	}
+
+	depositTxs := db.GetDepositTxsByPubkeys(ctx, pubkeys)
+	usedTxs := map[*dbtypes.DepositTx]bool{}
+	for _, deposit := range pageData.Deposits {
+		if deposit.HasDepositTx {
+			continue
+		}
+		for _, depositTx := range depositTxs {
+			if usedTxs[depositTx] || !bytes.Equal(depositTx.PublicKey, deposit.PublicKey) || !bytes.Equal(depositTx.Signature, deposit.Signature) || depositTx.Amount != deposit.Amount {
+				continue
+			}
+			usedTxs[depositTx] = true
+			setDepositTx(deposit, depositTx)
+			break
+		}
+	}
+}
+
 func getSlotPageDepositRequests(pageData *models.SlotPageBlockData, depositRequests []*electra.DepositRequest) {
 	pageData.DepositRequests = make([]*models.SlotPageDepositRequest, 0)
 
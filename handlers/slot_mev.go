@@ -0,0 +1,354 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer/mevrelay"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+const (
+	slotMevChartWidth  = 800
+	slotMevChartHeight = 240
+	slotMevTopBidLimit = 100
+)
+
+// slotMevRelayColors are the line colors of the relays in the bid chart (by position in the relay config)
+var slotMevRelayColors = []string{"#0d6efd", "#198754", "#dc3545", "#fd7e14", "#6f42c1", "#20c997", "#d63384", "#0dcaf0", "#ffc107", "#6c757d"}
+
+// SlotMev will return the "block production flow" page, which shows the relay bids received for a slot
+func SlotMev(w http.ResponseWriter, r *http.Request) {
+	var slotMevTemplateFiles = append(layoutTemplateFiles,
+		"slot_mev/slot_mev.html",
+	)
+	var notfoundTemplateFiles = append(layoutTemplateFiles,
+		"slot/notfound.html",
+	)
+	var pageTemplate = templates.GetTemplate(slotMevTemplateFiles...)
+
+	vars := mux.Vars(r)
+	slotOrHash := strings.Replace(vars["slotOrHash"], "0x", "", -1)
+	blockSlot := int64(-1)
+	blockRootHash, err := hex.DecodeString(slotOrHash)
+	if err != nil || len(slotOrHash) != 64 {
+		blockRootHash = []byte{}
+		blockSlot, err = strconv.ParseInt(vars["slotOrHash"], 10, 64)
+		if err != nil || blockSlot < 0 {
+			handlePageError(w, r, fmt.Errorf("invalid slot or block root"))
+			return
+		}
+	}
+
+	var pageData *models.SlotMevPageData
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		pageData, pageError = getSlotMevPageData(r.Context(), blockSlot, blockRootHash)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	if pageData == nil {
+		data := InitPageData(w, r, "blockchain", "/slots", fmt.Sprintf("Slot %v", slotOrHash), notfoundTemplateFiles)
+		data.Data = "slot"
+		w.Header().Set("Content-Type", "text/html")
+		if handleTemplateError(w, r, "slot_mev.go", "Slot Block Production", "notFound", templates.GetTemplate(notfoundTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+			return // an error has occurred and was processed
+		}
+		return
+	}
+
+	data := InitPageData(w, r, "blockchain", "/slots", fmt.Sprintf("Slot %v Block Production", pageData.Slot), slotMevTemplateFiles)
+	data.Data = pageData
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "slot_mev.go", "Slot Block Production", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getSlotMevPageData(ctx context.Context, blockSlot int64, blockRoot []byte) (*models.SlotMevPageData, error) {
+	pageData := &models.SlotMevPageData{}
+	pageCacheKey := fmt.Sprintf("slot_mev:%v:%x", blockSlot, blockRoot)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildSlotMevPageData(pageCall.CallCtx, blockSlot, blockRoot)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.SlotMevPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildSlotMevPageData(ctx context.Context, blockSlot int64, blockRoot []byte) (*models.SlotMevPageData, time.Duration) {
+	logrus.Debugf("slot block production page called: %v:%x", blockSlot, blockRoot)
+
+	chainState := services.GlobalBeaconService.GetChainState()
+	currentSlot := chainState.CurrentSlot()
+
+	var blockData *services.CombinedBlockResponse
+	var err error
+	if blockSlot > -1 {
+		if phase0.Slot(blockSlot) <= currentSlot {
+			blockData, err = services.GlobalBeaconService.GetSlotDetailsBySlot(ctx, phase0.Slot(blockSlot))
+		}
+	} else {
+		blockData, err = services.GlobalBeaconService.GetSlotDetailsByBlockroot(ctx, phase0.Root(blockRoot))
+	}
+	if err != nil {
+		return nil, -1
+	}
+
+	var slot phase0.Slot
+	if blockData != nil {
+		slot = blockData.Header.Message.Slot
+	} else if blockSlot > -1 && phase0.Slot(blockSlot) < currentSlot {
+		slot = phase0.Slot(blockSlot)
+	} else {
+		return nil, -1
+	}
+
+	pageData := &models.SlotMevPageData{
+		Slot:         uint64(slot),
+		Epoch:        uint64(chainState.EpochOfSlot(slot)),
+		Ts:           chainState.SlotToTime(slot),
+		TopBidsLimit: slotMevTopBidLimit,
+		ChartWidth:   slotMevChartWidth,
+		ChartHeight:  slotMevChartHeight,
+	}
+
+	// resolve the payload that made it into the block and the relays that delivered it
+	var mevBlock *dbtypes.MevBlock
+	if blockData != nil {
+		pageData.HasBlock = true
+		pageData.BlockRoot = blockData.Root[:]
+		pageData.Orphaned = blockData.Orphaned
+		pageData.HasProposer = true
+		pageData.ProposerIndex = uint64(blockData.Header.Message.ProposerIndex)
+
+		if blockData.Block != nil {
+			if blockHash, err := blockData.Block.ExecutionBlockHash(); err == nil {
+				pageData.BlockHash = blockHash[:]
+				mevBlock = db.GetMevBlockByBlockHash(ctx, blockHash[:])
+			}
+		}
+	} else {
+		// missed slot: a relay may still have delivered a payload the proposer did not publish
+		mevBlocks, _, err := db.GetMevBlocksFiltered(ctx, 0, 10, &dbtypes.MevBlockFilter{
+			MinSlot: uint64(slot),
+			MaxSlot: uint64(slot),
+		})
+		if err == nil && len(mevBlocks) > 0 {
+			mevBlock = mevBlocks[0]
+			pageData.BlockHash = mevBlock.BlockHash
+			pageData.HasProposer = true
+			pageData.ProposerIndex = mevBlock.ProposerIndex
+		}
+	}
+	if pageData.HasProposer {
+		pageData.ProposerName = services.GlobalBeaconService.GetValidatorName(pageData.ProposerIndex)
+	}
+	if mevBlock != nil {
+		pageData.MevRelayed = true
+		pageData.DeliveringRelays = getMevBlockRelayNames(mevBlock.SeenbyRelays)
+		pageData.WinningValue = mevBlock.BlockValueGwei
+		pageData.WinningBuilder = mevBlock.BuilderPubkey
+	}
+
+	// load the bids received by the relays
+	slotStart := chainState.SlotToTime(slot)
+	relayBids := mevrelay.LoadSlotBids(slot)
+	allBids := []*mevrelay.SlotBid{}
+	hasRelayErrors := false
+	relayColors := map[uint8]string{}
+	relayNames := map[uint8]string{}
+	for idx, relayResult := range relayBids {
+		relayData := &models.SlotMevPageDataRelay{
+			Name:  relayResult.Relay.Name,
+			Color: slotMevRelayColors[idx%len(slotMevRelayColors)],
+		}
+		relayColors[relayResult.Relay.Index] = relayData.Color
+		relayNames[relayResult.Relay.Index] = relayData.Name
+		if mevBlock != nil {
+			relayData.Delivered = mevBlock.SeenbyRelays&(uint64(1)<<relayResult.Relay.Index) > 0
+		}
+
+		if relayResult.Error != nil {
+			logrus.Debugf("failed loading bids for slot %v from relay %v: %v", slot, relayResult.Relay.Name, relayResult.Error)
+			relayData.Error = "bids could not be loaded from this relay"
+			hasRelayErrors = true
+			pageData.Relays = append(pageData.Relays, relayData)
+			continue
+		}
+
+		relayData.BidCount = uint64(len(relayResult.Bids))
+		for bidIdx, bid := range relayResult.Bids {
+			offset := bid.Timestamp.Sub(slotStart).Milliseconds()
+			if bidIdx == 0 {
+				relayData.FirstBidOffset = offset
+			}
+			relayData.LastBidOffset = offset
+			relayData.TopValue = max(relayData.TopValue, bid.ValueGwei)
+			if len(pageData.BlockHash) > 0 && bytes.Equal(bid.BlockHash[:], pageData.BlockHash) {
+				relayData.ReceivedWinning = true
+			}
+		}
+
+		allBids = append(allBids, relayResult.Bids...)
+		pageData.Relays = append(pageData.Relays, relayData)
+	}
+
+	sort.SliceStable(allBids, func(a, b int) bool {
+		return allBids[a].Timestamp.Before(allBids[b].Timestamp)
+	})
+	pageData.BidCount = uint64(len(allBids))
+
+	// find the first receipt of the winning payload
+	var winningBid *mevrelay.SlotBid
+	if len(pageData.BlockHash) > 0 {
+		for _, bid := range allBids {
+			if bytes.Equal(bid.BlockHash[:], pageData.BlockHash) {
+				winningBid = bid
+				break
+			}
+		}
+	}
+	if winningBid != nil {
+		pageData.HasWinningBid = true
+		pageData.WinningBidOffset = winningBid.Timestamp.Sub(slotStart).Milliseconds()
+		if pageData.WinningValue == 0 {
+			pageData.WinningValue = winningBid.ValueGwei
+		}
+		if len(pageData.WinningBuilder) == 0 {
+			pageData.WinningBuilder = winningBid.BuilderPubkey
+		}
+	}
+
+	// collect the top bid progression over all relays
+	for _, bid := range allBids {
+		offset := bid.Timestamp.Sub(slotStart).Milliseconds()
+		pageData.LastBidOffset = offset
+
+		isWinning := bid == winningBid
+		if winningBid != nil && bid.Timestamp.After(winningBid.Timestamp) && bid.ValueGwei > pageData.WinningValue {
+			pageData.HigherBidsAfter++
+		}
+		if bid.ValueGwei <= pageData.TopBidValue && !isWinning {
+			continue
+		}
+		pageData.TopBidValue = max(pageData.TopBidValue, bid.ValueGwei)
+
+		pageData.TopBids = append(pageData.TopBids, &models.SlotMevPageDataBid{
+			RelayName:     relayNames[bid.RelayIndex],
+			Color:         relayColors[bid.RelayIndex],
+			Offset:        offset,
+			Value:         bid.ValueGwei,
+			BlockHash:     bid.BlockHash[:],
+			BuilderPubkey: bid.BuilderPubkey,
+			Optimistic:    bid.Optimistic,
+			IsWinning:     isWinning,
+		})
+	}
+	if len(pageData.TopBids) > slotMevTopBidLimit {
+		pageData.TopBids = pageData.TopBids[len(pageData.TopBids)-slotMevTopBidLimit:]
+	}
+
+	if len(allBids) > 0 {
+		buildSlotMevChart(pageData, relayBids, slotStart, winningBid)
+	}
+
+	// relays keep the received bids & delivered payloads available for a while, so recent slots are reloaded more often
+	var cacheTimeout time.Duration
+	if hasRelayErrors || currentSlot < slot+phase0.Slot(chainState.GetSpecs().SlotsPerEpoch) {
+		cacheTimeout = 1 * time.Minute
+	} else {
+		cacheTimeout = 30 * time.Minute
+	}
+	return pageData, cacheTimeout
+}
+
+// buildSlotMevChart builds the svg step lines of the highest bid per relay over time, relative to the slot start.
+func buildSlotMevChart(pageData *models.SlotMevPageData, relayBids []*mevrelay.SlotRelayBids, slotStart time.Time, winningBid *mevrelay.SlotBid) {
+	minOffset := int64(-1000)
+	maxOffset := int64(1000)
+	maxValue := uint64(1)
+	for _, relayResult := range relayBids {
+		for _, bid := range relayResult.Bids {
+			offset := bid.Timestamp.Sub(slotStart).Milliseconds()
+			minOffset = min(minOffset, offset)
+			maxOffset = max(maxOffset, offset)
+			maxValue = max(maxValue, bid.ValueGwei)
+		}
+	}
+
+	pageData.ChartMinOffset = minOffset
+	pageData.ChartMaxOffset = maxOffset
+	pageData.ChartMaxValue = maxValue
+
+	getX := func(offset int64) float64 {
+		return float64(offset-minOffset) * slotMevChartWidth / float64(maxOffset-minOffset)
+	}
+	getY := func(value uint64) float64 {
+		// keep some headroom above the highest bid
+		return slotMevChartHeight - float64(value)*(slotMevChartHeight-10)/float64(maxValue)
+	}
+
+	pageData.ChartSlotX = getX(0)
+	if winningBid != nil {
+		pageData.ChartWinX = getX(winningBid.Timestamp.Sub(slotStart).Milliseconds())
+		pageData.ChartWinY = getY(winningBid.ValueGwei)
+	}
+
+	for idx, relayResult := range relayBids {
+		if len(relayResult.Bids) == 0 {
+			continue
+		}
+
+		points := []string{}
+		topValue := uint64(0)
+		for bidIdx, bid := range relayResult.Bids {
+			x := getX(bid.Timestamp.Sub(slotStart).Milliseconds())
+			if bidIdx == 0 {
+				topValue = bid.ValueGwei
+				points = append(points, fmt.Sprintf("%.1f,%.1f", x, getY(topValue)))
+				continue
+			}
+			if bid.ValueGwei <= topValue {
+				continue
+			}
+
+			points = append(points, fmt.Sprintf("%.1f,%.1f", x, getY(topValue)))
+			topValue = bid.ValueGwei
+			points = append(points, fmt.Sprintf("%.1f,%.1f", x, getY(topValue)))
+		}
+		lastBid := relayResult.Bids[len(relayResult.Bids)-1]
+		points = append(points, fmt.Sprintf("%.1f,%.1f", getX(lastBid.Timestamp.Sub(slotStart).Milliseconds()), getY(topValue)))
+
+		pageData.ChartLines = append(pageData.ChartLines, &models.SlotMevPageDataChartLine{
+			RelayName: relayResult.Relay.Name,
+			Color:     slotMevRelayColors[idx%len(slotMevRelayColors)],
+			Points:    strings.Join(points, " "),
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// SlotPacking will return the "slot packing" page, which simulates the optimal operation packing for a block
+func SlotPacking(w http.ResponseWriter, r *http.Request) {
+	var slotPackingTemplateFiles = append(layoutTemplateFiles,
+		"slot_packing/slot_packing.html",
+	)
+	var notfoundTemplateFiles = append(layoutTemplateFiles,
+		"slot/notfound.html",
+	)
+	var pageTemplate = templates.GetTemplate(slotPackingTemplateFiles...)
+
+	vars := mux.Vars(r)
+	slotOrHash := strings.Replace(vars["slotOrHash"], "0x", "", -1)
+	blockSlot := int64(-1)
+	blockRootHash, err := hex.DecodeString(slotOrHash)
+	if err != nil || len(slotOrHash) != 64 {
+		blockRootHash = []byte{}
+		blockSlot, err = strconv.ParseInt(vars["slotOrHash"], 10, 64)
+		if err != nil || blockSlot < 0 {
+			handlePageError(w, r, fmt.Errorf("invalid slot or block root"))
+			return
+		}
+	}
+
+	var pageData *models.SlotPackingPageData
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		pageData, pageError = getSlotPackingPageData(r.Context(), blockSlot, blockRootHash)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	if pageData == nil {
+		data := InitPageData(w, r, "blockchain", "/slots", fmt.Sprintf("Slot %v", slotOrHash), notfoundTemplateFiles)
+		data.Data = "slot"
+		w.Header().Set("Content-Type", "text/html")
+		if handleTemplateError(w, r, "slot_packing.go", "Slot Packing", "notFound", templates.GetTemplate(notfoundTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+			return // an error has occurred and was processed
+		}
+		return
+	}
+
+	data := InitPageData(w, r, "blockchain", "/slots", fmt.Sprintf("Slot %v Block Packing", pageData.Slot), slotPackingTemplateFiles)
+	data.Data = pageData
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "slot_packing.go", "Slot Packing", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getSlotPackingPageData(ctx context.Context, blockSlot int64, blockRoot []byte) (*models.SlotPackingPageData, error) {
+	pageData := &models.SlotPackingPageData{}
+	pageCacheKey := fmt.Sprintf("slot_packing:%v:%x", blockSlot, blockRoot)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildSlotPackingPageData(pageCall.CallCtx, blockSlot, blockRoot)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.SlotPackingPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildSlotPackingPageData(ctx context.Context, blockSlot int64, blockRoot []byte) (*models.SlotPackingPageData, time.Duration) {
+	logrus.Debugf("slot packing page called: %v:%x", blockSlot, blockRoot)
+
+	beaconIndexer := services.GlobalBeaconService.GetBeaconIndexer()
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	// the simulation needs the block and its surrounding blocks in the indexer cache
+	var block *beacon.Block
+	if len(blockRoot) == 32 {
+		block = beaconIndexer.GetBlockByRoot(phase0.Root(blockRoot))
+	} else {
+		for _, slotBlock := range beaconIndexer.GetBlocksBySlot(phase0.Slot(blockSlot)) {
+			if block == nil || beaconIndexer.IsCanonicalBlock(slotBlock, nil) {
+				block = slotBlock
+			}
+		}
+	}
+	if block == nil {
+		if blockSlot < 0 || phase0.Slot(blockSlot) > chainState.CurrentSlot() {
+			return nil, -1
+		}
+
+		// blocks outside the cache window can't be simulated
+		return &models.SlotPackingPageData{
+			Slot:  uint64(blockSlot),
+			Epoch: uint64(chainState.EpochOfSlot(phase0.Slot(blockSlot))),
+			Ts:    chainState.SlotToTime(phase0.Slot(blockSlot)),
+		}, 5 * time.Minute
+	}
+
+	pageData := &models.SlotPackingPageData{
+		Slot:      uint64(block.Slot),
+		Epoch:     uint64(chainState.EpochOfSlot(block.Slot)),
+		BlockRoot: block.Root[:],
+		Ts:        chainState.SlotToTime(block.Slot),
+		Orphaned:  !beaconIndexer.IsCanonicalBlock(block, nil),
+	}
+
+	simulation := beaconIndexer.SimulateBlockPacking(block)
+	if simulation == nil {
+		return pageData, 1 * time.Minute
+	}
+
+	pageData.Available = true
+	pageData.ProposerIndex = uint64(simulation.ProposerIndex)
+	pageData.ProposerName = services.GlobalBeaconService.GetValidatorName(uint64(simulation.ProposerIndex))
+	pageData.SourceBlocks = simulation.SourceBlocks
+	pageData.MaxAttestations = simulation.MaxAttestations
+	pageData.IncludedAttestations = simulation.IncludedAttestations
+	pageData.IncludedVotes = simulation.IncludedVotes
+	pageData.IncludedAttestationReward = uint64(simulation.IncludedAttestationReward)
+	pageData.OptimalAttestations = simulation.OptimalAttestations
+	pageData.OptimalVotes = simulation.OptimalVotes
+	pageData.OptimalAttestationReward = uint64(simulation.OptimalAttestationReward)
+	pageData.IncludedSlashings = simulation.IncludedSlashings
+	pageData.IncludedSlashingReward = uint64(simulation.IncludedSlashingReward)
+	pageData.OptimalSlashings = simulation.OptimalSlashings
+	pageData.OptimalSlashingReward = uint64(simulation.OptimalSlashingReward)
+	pageData.IncludedExits = simulation.IncludedExits
+	pageData.IncludedReward = pageData.IncludedAttestationReward + pageData.IncludedSlashingReward
+	pageData.OptimalReward = pageData.OptimalAttestationReward + pageData.OptimalSlashingReward
+	if pageData.OptimalReward > pageData.IncludedReward {
+		pageData.MissedReward = pageData.OptimalReward - pageData.IncludedReward
+	}
+	if pageData.OptimalReward > 0 {
+		pageData.PackingEfficiency = float64(pageData.IncludedReward) * 100 / float64(pageData.OptimalReward)
+	} else {
+		pageData.PackingEfficiency = 100
+	}
+
+	// resolve the names of all validators shown on the page at once
+	validatorIndices := []uint64{}
+	for _, slashing := range simulation.MissedSlashings {
+		for _, validatorIndex := range slashing.Validators {
+			validatorIndices = append(validatorIndices, uint64(validatorIndex))
+		}
+	}
+	for _, voluntaryExit := range simulation.MissedExits {
+		validatorIndices = append(validatorIndices, uint64(voluntaryExit.ValidatorIndex))
+	}
+	validatorNames := services.GlobalBeaconService.GetValidatorNames(validatorIndices)
+
+	pageData.MissedAttestationCount = uint64(len(simulation.MissedAttestations))
+	for idx, attestation := range simulation.MissedAttestations {
+		if idx >= 100 {
+			break
+		}
+
+		pageData.MissedAttestations = append(pageData.MissedAttestations, &models.SlotPackingPageDataAttestation{
+			Slot:            uint64(attestation.Slot),
+			Committee:       attestation.Committee,
+			BeaconBlockRoot: attestation.BeaconBlockRoot[:],
+			TargetRoot:      attestation.TargetRoot[:],
+			TimelySource:    attestation.Flags&beacon.TimelySourceFlag != 0,
+			TimelyTarget:    attestation.Flags&beacon.TimelyTargetFlag != 0,
+			TimelyHead:      attestation.Flags&beacon.TimelyHeadFlag != 0,
+			VoteCount:       attestation.VoteCount,
+			NewVotes:        attestation.NewVotes,
+			Reward:          uint64(attestation.Reward),
+			InOptimal:       attestation.InOptimal,
+			SeenInSlot:      uint64(attestation.SeenInSlot),
+			SeenInRoot:      attestation.SeenInRoot[:],
+		})
+	}
+
+	for _, slashing := range simulation.MissedSlashings {
+		slashingData := &models.SlotPackingPageDataSlashing{
+			Type:       "proposer",
+			Reward:     uint64(slashing.Reward),
+			InOptimal:  slashing.InOptimal,
+			SeenInSlot: uint64(slashing.SeenInSlot),
+			SeenInRoot: slashing.SeenInRoot[:],
+		}
+		if slashing.IsAttesterSlashing {
+			slashingData.Type = "attester"
+		}
+		for _, validatorIndex := range slashing.Validators {
+			slashingData.Validators = append(slashingData.Validators, &models.SlotPackingPageDataValidator{
+				Index: uint64(validatorIndex),
+				Name:  validatorNames[uint64(validatorIndex)],
+			})
+		}
+		pageData.MissedSlashings = append(pageData.MissedSlashings, slashingData)
+	}
+
+	for _, voluntaryExit := range simulation.MissedExits {
+		pageData.MissedExits = append(pageData.MissedExits, &models.SlotPackingPageDataVoluntaryExit{
+			ValidatorIndex: uint64(voluntaryExit.ValidatorIndex),
+			ValidatorName:  validatorNames[uint64(voluntaryExit.ValidatorIndex)],
+			Epoch:          uint64(voluntaryExit.Epoch),
+			SeenInSlot:     uint64(voluntaryExit.SeenInSlot),
+			SeenInRoot:     voluntaryExit.SeenInRoot[:],
+		})
+	}
+
+	// candidates are collected from up to one epoch of later blocks, so the result only settles after that
+	var cacheTimeout time.Duration
+	if chainState.CurrentSlot() > block.Slot+phase0.Slot(chainState.GetSpecs().SlotsPerEpoch) {
+		cacheTimeout = 30 * time.Minute
+	} else {
+		cacheTimeout = 12 * time.Second
+	}
+	return pageData, cacheTimeout
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/utils"
+)
+
+// slotPrefetcher warms the frontend cache with the pages of slots adjacent to a recently viewed finalized slot.
+// this makes slot-by-slot browsing of old slots snappy, as the next/previous pages are already built when requested.
+type slotPrefetcher struct {
+	queue           chan uint64
+	prefetchedMutex sync.Mutex
+	prefetched      *lru.Cache[uint64, time.Time]
+}
+
+var globalSlotPrefetcher *slotPrefetcher
+var globalSlotPrefetcherOnce sync.Once
+
+// getSlotPrefetcher returns the global slot prefetcher and starts its worker on first use.
+func getSlotPrefetcher() *slotPrefetcher {
+	globalSlotPrefetcherOnce.Do(func() {
+		globalSlotPrefetcher = &slotPrefetcher{
+			queue:      make(chan uint64, 100),
+			prefetched: lru.NewCache[uint64, time.Time](1000),
+		}
+
+		go globalSlotPrefetcher.runPrefetchLoop()
+	})
+
+	return globalSlotPrefetcher
+}
+
+// prefetchAdjacentSlots queues the adjacent slots of the given finalized slot for background prefetching.
+func prefetchAdjacentSlots(slot uint64) {
+	prefetchCount := utils.Config().Frontend.SlotPrefetchCount
+	if prefetchCount == 0 {
+		return
+	}
+
+	prefetcher := getSlotPrefetcher()
+	for i := uint64(1); i <= prefetchCount; i++ {
+		prefetcher.enqueue(slot + i)
+		if slot >= i {
+			prefetcher.enqueue(slot - i)
+		}
+	}
+}
+
+func (prefetcher *slotPrefetcher) enqueue(slot uint64) {
+	prefetcher.prefetchedMutex.Lock()
+	lastPrefetch, isPrefetched := prefetcher.prefetched.Get(slot)
+	if isPrefetched && time.Since(lastPrefetch) < 10*time.Minute {
+		prefetcher.prefetchedMutex.Unlock()
+		return
+	}
+	prefetcher.prefetched.Add(slot, time.Now())
+	prefetcher.prefetchedMutex.Unlock()
+
+	select {
+	case prefetcher.queue <- slot:
+	default:
+		// queue full, skip prefetching
+		prefetcher.prefetchedMutex.Lock()
+		prefetcher.prefetched.Remove(slot)
+		prefetcher.prefetchedMutex.Unlock()
+	}
+}
+
+func (prefetcher *slotPrefetcher) runPrefetchLoop() {
+	defer utils.HandleSubroutinePanic("handlers.slotPrefetcher.runPrefetchLoop")
+
+	for slot := range prefetcher.queue {
+		t1 := time.Now()
+		_, err := getSlotPageData(context.Background(), int64(slot), []byte{})
+		if err != nil {
+			logrus.Debugf("slot page prefetch failed for slot %v: %v", slot, err)
+			continue
+		}
+
+		logrus.Debugf("slot page prefetched: %v (%v ms)", slot, time.Since(t1).Milliseconds())
+	}
+}
@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"net/http"
 	"net/url"
 	"sort"
@@ -13,6 +15,7 @@ import (
 	"github.com/ethpandaops/dora/services"
 	"github.com/ethpandaops/dora/templates"
 	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
 	"github.com/sirupsen/logrus"
 )
 
@@ -44,6 +47,7 @@ func SlotsFiltered(w http.ResponseWriter, r *http.Request) {
 	var extradata string
 	var proposer string
 	var pname string
+	var forkName string
 	var withOrphaned uint64
 	var withMissing uint64
 
@@ -60,6 +64,9 @@ func SlotsFiltered(w http.ResponseWriter, r *http.Request) {
 		if urlArgs.Has("f.pname") {
 			pname = urlArgs.Get("f.pname")
 		}
+		if urlArgs.Has("f.fork") {
+			forkName = urlArgs.Get("f.fork")
+		}
 		if urlArgs.Has("f.orphaned") {
 			withOrphaned, _ = strconv.ParseUint(urlArgs.Get("f.orphaned"), 10, 64)
 		}
@@ -70,10 +77,18 @@ func SlotsFiltered(w http.ResponseWriter, r *http.Request) {
 		withOrphaned = 1
 		withMissing = 1
 	}
+	if isCsvExport(r) {
+		writeCsvExport(w, r, "slots", slotsFilteredCsvHeader, func(pageIdx uint64) ([][]string, bool) {
+			pageData := buildFilteredSlotsPageData(r.Context(), pageIdx, csvExportPageSize, graffiti, extradata, proposer, pname, forkName, uint8(withOrphaned), uint8(withMissing), displayColumns)
+			return getSlotsFilteredCsvRows(pageData), pageData.NextPageIndex > 0
+		})
+		return
+	}
+
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
 	if pageError == nil {
-		data.Data, pageError = getFilteredSlotsPageData(pageIdx, pageSize, graffiti, extradata, proposer, pname, uint8(withOrphaned), uint8(withMissing), displayColumns)
+		data.Data, pageError = getFilteredSlotsPageData(r.Context(), pageIdx, pageSize, graffiti, extradata, proposer, pname, forkName, uint8(withOrphaned), uint8(withMissing), displayColumns)
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -85,11 +100,11 @@ func SlotsFiltered(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string, extradata string, proposer string, pname string, withOrphaned uint8, withMissing uint8, displayColumns string) (*models.SlotsFilteredPageData, error) {
+func getFilteredSlotsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, graffiti string, extradata string, proposer string, pname string, forkName string, withOrphaned uint8, withMissing uint8, displayColumns string) (*models.SlotsFilteredPageData, error) {
 	pageData := &models.SlotsFilteredPageData{}
-	pageCacheKey := fmt.Sprintf("slots_filtered:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, graffiti, extradata, proposer, pname, withOrphaned, withMissing, displayColumns)
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
-		return buildFilteredSlotsPageData(pageIdx, pageSize, graffiti, extradata, proposer, pname, withOrphaned, withMissing, displayColumns)
+	pageCacheKey := fmt.Sprintf("slots_filtered:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, graffiti, extradata, proposer, pname, forkName, withOrphaned, withMissing, displayColumns)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		return buildFilteredSlotsPageData(pageCall.CallCtx, pageIdx, pageSize, graffiti, extradata, proposer, pname, forkName, withOrphaned, withMissing, displayColumns)
 	})
 	if pageErr == nil && pageRes != nil {
 		resData, resOk := pageRes.(*models.SlotsFilteredPageData)
@@ -101,7 +116,7 @@ func getFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string,
 	return pageData, pageErr
 }
 
-func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string, extradata string, proposer string, pname string, withOrphaned uint8, withMissing uint8, displayColumns string) *models.SlotsFilteredPageData {
+func buildFilteredSlotsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, graffiti string, extradata string, proposer string, pname string, forkName string, withOrphaned uint8, withMissing uint8, displayColumns string) *models.SlotsFilteredPageData {
 	chainState := services.GlobalBeaconService.GetChainState()
 	filterArgs := url.Values{}
 	if graffiti != "" {
@@ -116,6 +131,9 @@ func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string
 	if pname != "" {
 		filterArgs.Add("f.pname", pname)
 	}
+	if forkName != "" {
+		filterArgs.Add("f.fork", forkName)
+	}
 	if withOrphaned != 0 {
 		filterArgs.Add("f.orphaned", fmt.Sprintf("%v", withOrphaned))
 	}
@@ -169,6 +187,7 @@ func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string
 		FilterExtraData:    extradata,
 		FilterProposer:     proposer,
 		FilterProposerName: pname,
+		FilterFork:         forkName,
 		FilterWithOrphaned: withOrphaned,
 		FilterWithMissing:  withMissing,
 
@@ -207,6 +226,18 @@ func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string
 	finalizedEpoch, _ := services.GlobalBeaconService.GetFinalizedEpoch()
 	currentSlot := chainState.CurrentSlot()
 
+	for _, fork := range chainState.GetForkSchedule() {
+		if fork.Epoch > chainState.EpochOfSlot(currentSlot) {
+			continue
+		}
+		if _, _, ok := chainState.GetForkEpochRange(fork.Name); ok {
+			pageData.ForkOptions = append(pageData.ForkOptions, &models.SlotsFilteredPageDataFork{
+				Name:  fork.Name,
+				Epoch: uint64(fork.Epoch),
+			})
+		}
+	}
+
 	// load slots
 	pageData.Slots = make([]*models.SlotsFilteredPageDataSlot, 0)
 	blockFilter := &dbtypes.BlockFilter{
@@ -220,13 +251,28 @@ func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string
 		pidx, _ := strconv.ParseUint(proposer, 10, 64)
 		blockFilter.ProposerIndex = &pidx
 	}
+	if forkName != "" {
+		minSlot := uint64(1)
+		maxSlot := uint64(0)
+		if forkStart, forkEnd, ok := chainState.GetForkEpochRange(forkName); ok {
+			minSlot = uint64(chainState.EpochToSlot(forkStart))
+			if forkEnd != math.MaxUint64 {
+				maxSlot = uint64(chainState.EpochToSlot(forkEnd+1)) - 1
+				blockFilter.MaxSlot = &maxSlot
+			}
+		} else {
+			// unknown fork, match no slots
+			blockFilter.MaxSlot = &maxSlot
+		}
+		blockFilter.MinSlot = &minSlot
+	}
 
 	withScheduledCount := chainState.GetSpecs().SlotsPerEpoch - uint64(chainState.SlotToSlotIndex(currentSlot)) - 1
 	if withScheduledCount > 16 {
 		withScheduledCount = 16
 	}
 
-	dbBlocks := services.GlobalBeaconService.GetDbBlocksByFilter(blockFilter, pageIdx, uint32(pageSize), withScheduledCount)
+	dbBlocks := services.GlobalBeaconService.GetDbBlocksByFilter(ctx, blockFilter, pageIdx, uint32(pageSize), withScheduledCount)
 	haveMore := false
 	for idx, dbBlock := range dbBlocks {
 		if idx >= int(pageSize) {
@@ -286,3 +332,46 @@ func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string
 
 	return pageData
 }
+
+var slotsFilteredCsvHeader = []string{"slot", "epoch", "time", "status", "proposer", "proposer_name", "block_root", "eth_block", "attestations", "deposits", "exits", "proposer_slashings", "attester_slashings", "sync_participation", "transactions", "graffiti", "el_extra_data"}
+
+func getSlotsFilteredCsvRows(pageData *models.SlotsFilteredPageData) [][]string {
+	rows := make([][]string, 0, len(pageData.Slots))
+	for _, slot := range pageData.Slots {
+		status := ""
+		switch {
+		case slot.Scheduled:
+			status = "scheduled"
+		case slot.Status == uint8(dbtypes.Canonical):
+			status = "proposed"
+		case slot.Status == uint8(dbtypes.Orphaned):
+			status = "orphaned"
+		default:
+			status = "missed"
+		}
+		ethBlock := ""
+		if slot.WithEthBlock {
+			ethBlock = csvUint(slot.EthBlockNumber)
+		}
+		rows = append(rows, []string{
+			csvUint(slot.Slot),
+			csvUint(slot.Epoch),
+			csvTime(slot.Ts),
+			status,
+			csvUint(slot.Proposer),
+			csvText(slot.ProposerName),
+			csvHex(slot.BlockRoot),
+			ethBlock,
+			csvUint(slot.AttestationCount),
+			csvUint(slot.DepositCount),
+			csvUint(slot.ExitCount),
+			csvUint(slot.ProposerSlashingCount),
+			csvUint(slot.AttesterSlashingCount),
+			strconv.FormatFloat(slot.SyncParticipation, 'f', 2, 64),
+			csvUint(slot.EthTransactionCount),
+			csvText(utils.GraffitiToString(slot.Graffiti)),
+			csvHex(slot.ElExtraData),
+		})
+	}
+	return rows
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/sirupsen/logrus"
 
@@ -27,7 +29,7 @@ func SubmitConsolidation(w http.ResponseWriter, r *http.Request) {
 	)
 	var pageTemplate = templates.GetTemplate(submitConsolidationTemplateFiles...)
 
-	if !utils.Config.Frontend.ShowSubmitElRequests {
+	if !utils.Config().Frontend.ShowSubmitElRequests {
 		handlePageError(w, r, errors.New("submit el requests is not enabled"))
 		return
 	}
@@ -41,7 +43,7 @@ func SubmitConsolidation(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pageData, pageError := getSubmitConsolidationPageData()
+	pageData, pageError := getSubmitConsolidationPageData(r.Context())
 	if pageError != nil {
 		handlePageError(w, r, pageError)
 		return
@@ -63,11 +65,11 @@ func SubmitConsolidation(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getSubmitConsolidationPageData() (*models.SubmitConsolidationPageData, error) {
+func getSubmitConsolidationPageData(ctx context.Context) (*models.SubmitConsolidationPageData, error) {
 	pageData := &models.SubmitConsolidationPageData{}
 	pageCacheKey := "submit_consolidation"
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
-		pageData, cacheTimeout := buildSubmitConsolidationPageData()
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildSubmitConsolidationPageData(pageCall.CallCtx)
 		pageCall.CacheTimeout = cacheTimeout
 		return pageData
 	})
@@ -81,7 +83,7 @@ func getSubmitConsolidationPageData() (*models.SubmitConsolidationPageData, erro
 	return pageData, pageErr
 }
 
-func buildSubmitConsolidationPageData() (*models.SubmitConsolidationPageData, time.Duration) {
+func buildSubmitConsolidationPageData(ctx context.Context) (*models.SubmitConsolidationPageData, time.Duration) {
 	logrus.Debugf("submit consolidation page called")
 
 	chainState := services.GlobalBeaconService.GetChainState()
@@ -89,11 +91,11 @@ func buildSubmitConsolidationPageData() (*models.SubmitConsolidationPageData, ti
 
 	pageData := &models.SubmitConsolidationPageData{
 		NetworkName:           specs.ConfigName,
-		PublicRPCUrl:          utils.Config.Frontend.PublicRPCUrl,
-		RainbowkitProjectId:   utils.Config.Frontend.RainbowkitProjectId,
+		PublicRPCUrl:          utils.Config().Frontend.PublicRPCUrl,
+		RainbowkitProjectId:   utils.Config().Frontend.RainbowkitProjectId,
 		ChainId:               specs.DepositChainId,
 		ConsolidationContract: execution.ConsolidationContractAddr,
-		ExplorerUrl:           utils.Config.Frontend.EthExplorerLink,
+		ExplorerUrl:           utils.Config().Frontend.EthExplorerLink,
 	}
 
 	return pageData, 1 * time.Hour
@@ -110,12 +112,23 @@ func handleSubmitConsolidationPageDataAjax(w http.ResponseWriter, r *http.Reques
 
 		validators := services.GlobalBeaconService.GetCachedValidatorSet(true)
 		result := []models.SubmitConsolidationPageDataValidator{}
-		for _, validator := range validators {
-			if validator.Validator.WithdrawalCredentials[0] == 0x00 {
+		for index := 0; index < validators.Len(); index++ {
+			record, found := validators.GetRecord(phase0.ValidatorIndex(index))
+			if !found {
 				continue
 			}
 
-			if !bytes.Equal(validator.Validator.WithdrawalCredentials[12:], addressBytes[:]) {
+			credentials := record.WithdrawalCredentials
+			if credentials[0] == 0x00 {
+				continue
+			}
+
+			if !bytes.Equal(credentials[12:], addressBytes[:]) {
+				continue
+			}
+
+			validator := validators.GetValidator(phase0.ValidatorIndex(index))
+			if validator == nil {
 				continue
 			}
 
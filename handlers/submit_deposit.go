@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,7 +26,7 @@ func SubmitDeposit(w http.ResponseWriter, r *http.Request) {
 	)
 	var pageTemplate = templates.GetTemplate(submitDepositTemplateFiles...)
 
-	if !utils.Config.Frontend.ShowSubmitDeposit {
+	if !utils.Config().Frontend.ShowSubmitDeposit {
 		handlePageError(w, r, errors.New("submit deposit is not enabled"))
 		return
 	}
@@ -44,7 +45,7 @@ func SubmitDeposit(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pageData, pageError := getSubmitDepositPageData()
+	pageData, pageError := getSubmitDepositPageData(r.Context())
 	if pageError != nil {
 		handlePageError(w, r, pageError)
 		return
@@ -66,11 +67,11 @@ func SubmitDeposit(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getSubmitDepositPageData() (*models.SubmitDepositPageData, error) {
+func getSubmitDepositPageData(ctx context.Context) (*models.SubmitDepositPageData, error) {
 	pageData := &models.SubmitDepositPageData{}
 	pageCacheKey := "submit_deposit"
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
-		pageData, cacheTimeout := buildSubmitDepositPageData()
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildSubmitDepositPageData(pageCall.CallCtx)
 		pageCall.CacheTimeout = cacheTimeout
 		return pageData
 	})
@@ -84,7 +85,7 @@ func getSubmitDepositPageData() (*models.SubmitDepositPageData, error) {
 	return pageData, pageErr
 }
 
-func buildSubmitDepositPageData() (*models.SubmitDepositPageData, time.Duration) {
+func buildSubmitDepositPageData(ctx context.Context) (*models.SubmitDepositPageData, time.Duration) {
 	logrus.Debugf("submit deposit page called")
 
 	chainState := services.GlobalBeaconService.GetChainState()
@@ -93,8 +94,8 @@ func buildSubmitDepositPageData() (*models.SubmitDepositPageData, time.Duration)
 	pageData := &models.SubmitDepositPageData{
 		NetworkName:         specs.ConfigName,
 		DepositContract:     specs.DepositContractAddress,
-		PublicRPCUrl:        utils.Config.Frontend.PublicRPCUrl,
-		RainbowkitProjectId: utils.Config.Frontend.RainbowkitProjectId,
+		PublicRPCUrl:        utils.Config().Frontend.PublicRPCUrl,
+		RainbowkitProjectId: utils.Config().Frontend.RainbowkitProjectId,
 		ChainId:             specs.DepositChainId,
 		GenesisForkVersion:  specs.GenesisForkVersion[:],
 	}
@@ -129,9 +130,9 @@ func handleSubmitDepositPageDataAjax(w http.ResponseWriter, r *http.Request) err
 		}
 
 		depositSyncState := dbtypes.DepositIndexerState{}
-		db.GetExplorerState("indexer.depositstate", &depositSyncState)
+		db.GetExplorerState(r.Context(), "indexer.depositstate", &depositSyncState)
 
-		deposits, depositCount, err := db.GetDepositTxsFiltered(0, 1000, depositSyncState.FinalBlock, &dbtypes.DepositTxFilter{
+		deposits, depositCount, err := db.GetDepositTxsFiltered(r.Context(), 0, 1000, depositSyncState.FinalBlock, &dbtypes.DepositTxFilter{
 			PublicKeys:   pubkeys,
 			WithOrphaned: 0,
 		})
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/sirupsen/logrus"
 
@@ -27,7 +29,7 @@ func SubmitWithdrawal(w http.ResponseWriter, r *http.Request) {
 	)
 	var pageTemplate = templates.GetTemplate(submitWithdrawalTemplateFiles...)
 
-	if !utils.Config.Frontend.ShowSubmitElRequests {
+	if !utils.Config().Frontend.ShowSubmitElRequests {
 		handlePageError(w, r, errors.New("submit el requests is not enabled"))
 		return
 	}
@@ -41,7 +43,7 @@ func SubmitWithdrawal(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pageData, pageError := getSubmitWithdrawalPageData()
+	pageData, pageError := getSubmitWithdrawalPageData(r.Context())
 	if pageError != nil {
 		handlePageError(w, r, pageError)
 		return
@@ -63,11 +65,11 @@ func SubmitWithdrawal(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getSubmitWithdrawalPageData() (*models.SubmitWithdrawalPageData, error) {
+func getSubmitWithdrawalPageData(ctx context.Context) (*models.SubmitWithdrawalPageData, error) {
 	pageData := &models.SubmitWithdrawalPageData{}
 	pageCacheKey := "submit_withdrawal"
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
-		pageData, cacheTimeout := buildSubmitWithdrawalPageData()
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildSubmitWithdrawalPageData(pageCall.CallCtx)
 		pageCall.CacheTimeout = cacheTimeout
 		return pageData
 	})
@@ -81,7 +83,7 @@ func getSubmitWithdrawalPageData() (*models.SubmitWithdrawalPageData, error) {
 	return pageData, pageErr
 }
 
-func buildSubmitWithdrawalPageData() (*models.SubmitWithdrawalPageData, time.Duration) {
+func buildSubmitWithdrawalPageData(ctx context.Context) (*models.SubmitWithdrawalPageData, time.Duration) {
 	logrus.Debugf("submit withdrawal page called")
 
 	chainState := services.GlobalBeaconService.GetChainState()
@@ -89,11 +91,11 @@ func buildSubmitWithdrawalPageData() (*models.SubmitWithdrawalPageData, time.Dur
 
 	pageData := &models.SubmitWithdrawalPageData{
 		NetworkName:         specs.ConfigName,
-		PublicRPCUrl:        utils.Config.Frontend.PublicRPCUrl,
-		RainbowkitProjectId: utils.Config.Frontend.RainbowkitProjectId,
+		PublicRPCUrl:        utils.Config().Frontend.PublicRPCUrl,
+		RainbowkitProjectId: utils.Config().Frontend.RainbowkitProjectId,
 		ChainId:             specs.DepositChainId,
 		WithdrawalContract:  execution.WithdrawalContractAddr,
-		ExplorerUrl:         utils.Config.Frontend.EthExplorerLink,
+		ExplorerUrl:         utils.Config().Frontend.EthExplorerLink,
 		MinValidatorBalance: specs.MinActivationBalance,
 	}
 
@@ -111,12 +113,23 @@ func handleSubmitWithdrawalPageDataAjax(w http.ResponseWriter, r *http.Request)
 
 		validators := services.GlobalBeaconService.GetCachedValidatorSet(true)
 		result := []models.SubmitWithdrawalPageDataValidator{}
-		for _, validator := range validators {
-			if validator.Validator.WithdrawalCredentials[0] == 0x00 {
+		for index := 0; index < validators.Len(); index++ {
+			record, found := validators.GetRecord(phase0.ValidatorIndex(index))
+			if !found {
 				continue
 			}
 
-			if !bytes.Equal(validator.Validator.WithdrawalCredentials[12:], addressBytes[:]) {
+			credentials := record.WithdrawalCredentials
+			if credentials[0] == 0x00 {
+				continue
+			}
+
+			if !bytes.Equal(credentials[12:], addressBytes[:]) {
+				continue
+			}
+
+			validator := validators.GetValidator(phase0.ValidatorIndex(index))
+			if validator == nil {
 				continue
 			}
 
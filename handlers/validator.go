@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math"
@@ -21,6 +23,16 @@ import (
 	"github.com/ethpandaops/dora/services"
 	"github.com/ethpandaops/dora/templates"
 	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	// number of income periods shown on the income tab
+	validatorIncomeLimit = 90
+
+	// size of the income chart svg viewbox
+	validatorIncomeChartWidth  = 1000
+	validatorIncomeChartHeight = 150
 )
 
 // Validator will return the main "validator" page using a go template
@@ -33,6 +45,8 @@ func Validator(w http.ResponseWriter, r *http.Request) {
 		"validator/withdrawalRequests.html",
 		"validator/consolidationRequests.html",
 		"validator/txDetails.html",
+		"validator/notes.html",
+		"validator/income.html",
 		"_svg/timeline.html",
 	)
 	var notfoundTemplateFiles = append(layoutTemplateFiles,
@@ -73,15 +87,29 @@ func Validator(w http.ResponseWriter, r *http.Request) {
 		tabView = r.URL.Query().Get("v")
 	}
 
+	var pageData *models.ValidatorPageData
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
 	if pageError == nil {
-		data.Data, pageError = getValidatorPageData(uint64(validator.Index), tabView)
+		pageData, pageError = getValidatorPageData(r.Context(), uint64(validator.Index), tabView)
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
 		return
 	}
+
+	if utils.Config().Frontend.ShowValidatorOwnership {
+		// the page data is shared via the page cache, so the private label is only set on a copy
+		if sessionHash := getOwnershipSession(w, r, false); sessionHash != nil {
+			if ownership := services.GlobalBeaconService.GetValidatorOwnership(r.Context(), sessionHash, uint64(validator.Index)); ownership != nil {
+				sessionPageData := *pageData
+				sessionPageData.OwnershipProven = true
+				sessionPageData.PrivateLabel = ownership.PrivateLabel
+				pageData = &sessionPageData
+			}
+		}
+	}
+	data.Data = pageData
 	w.Header().Set("Content-Type", "text/html")
 
 	if r.URL.Query().Has("lazy") {
@@ -92,11 +120,11 @@ func Validator(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getValidatorPageData(validatorIndex uint64, tabView string) (*models.ValidatorPageData, error) {
+func getValidatorPageData(ctx context.Context, validatorIndex uint64, tabView string) (*models.ValidatorPageData, error) {
 	pageData := &models.ValidatorPageData{}
 	pageCacheKey := fmt.Sprintf("validator:%v:%v", validatorIndex, tabView)
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
-		pageData, cacheTimeout := buildValidatorPageData(validatorIndex, tabView)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildValidatorPageData(pageCall.CallCtx, validatorIndex, tabView)
 		pageCall.CacheTimeout = cacheTimeout
 		return pageData
 	})
@@ -110,7 +138,7 @@ func getValidatorPageData(validatorIndex uint64, tabView string) (*models.Valida
 	return pageData, pageErr
 }
 
-func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.ValidatorPageData, time.Duration) {
+func buildValidatorPageData(ctx context.Context, validatorIndex uint64, tabView string) (*models.ValidatorPageData, time.Duration) {
 	logrus.Debugf("validator page called: %v", validatorIndex)
 
 	chainState := services.GlobalBeaconService.GetChainState()
@@ -154,6 +182,31 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 		pageData.UpcheckMaximum = uint8(3)
 	}
 
+	for _, window := range services.GlobalBeaconService.GetValidatorApyWindows() {
+		apyData := &models.ValidatorPageDataApy{
+			Window:     window.Name,
+			FirstEpoch: window.FirstEpoch,
+			LastEpoch:  window.LastEpoch,
+		}
+		apyData.Apy, apyData.HasApy = services.GlobalBeaconService.GetValidatorApy(validator.Index, window.Name)
+		pageData.Apy = append(pageData.Apy, apyData)
+	}
+
+	for _, window := range services.GlobalBeaconService.GetValidatorLuckWindows() {
+		luckData := &models.ValidatorPageDataLuck{
+			Window:     window.Name,
+			FirstEpoch: window.FirstEpoch,
+			LastEpoch:  window.LastEpoch,
+		}
+		if luck, hasLuck := services.GlobalBeaconService.GetValidatorProposalLuck([]phase0.ValidatorIndex{validator.Index}, window.Name); hasLuck {
+			luckData.HasLuck = true
+			luckData.Proposals = luck.Proposals
+			luckData.Expected = luck.Expected
+			luckData.Luck = luck.Luck
+		}
+		pageData.ProposalLuck = append(pageData.ProposalLuck, luckData)
+	}
+
 	if validator.Validator.ActivationEligibilityEpoch < 18446744073709551615 {
 		pageData.ShowEligible = true
 		pageData.EligibleEpoch = uint64(validator.Validator.ActivationEligibilityEpoch)
@@ -173,12 +226,13 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 	if validator.Validator.WithdrawalCredentials[0] == 0x01 || validator.Validator.WithdrawalCredentials[0] == 0x02 {
 		pageData.ShowWithdrawAddress = true
 		pageData.WithdrawAddress = validator.Validator.WithdrawalCredentials[12:]
+		pageData.NextWithdrawalTs, pageData.ShowNextWithdrawal = services.GlobalBeaconService.GetValidatorWithdrawalProjection(validator.Index)
 	}
 
 	// load latest blocks
 	if pageData.TabView == "blocks" {
 		pageData.RecentBlocks = make([]*models.ValidatorPageDataBlock, 0)
-		blocksData := services.GlobalBeaconService.GetDbBlocksByFilter(&dbtypes.BlockFilter{
+		blocksData := services.GlobalBeaconService.GetDbBlocksByFilter(ctx, &dbtypes.BlockFilter{
 			ProposerIndex: &validatorIndex,
 			WithOrphaned:  1,
 			WithMissing:   1,
@@ -240,7 +294,7 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 					InclusionSlot:  uint64(vote.VoteBlock.Slot),
 					InclusionRoot:  vote.VoteBlock.Root[:],
 					Time:           chainState.SlotToTime(vote.VoteBlock.Slot - phase0.Slot(vote.VoteDelay)),
-					Status:         uint64(services.GlobalBeaconService.CheckBlockOrphanedStatus(vote.VoteBlock.Root)),
+					Status:         uint64(services.GlobalBeaconService.CheckBlockOrphanedStatus(ctx, vote.VoteBlock.Root)),
 					InclusionDelay: uint64(vote.VoteDelay),
 					HasDuty:        true,
 				}
@@ -299,6 +353,14 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 	}
 
 	// load recent deposits
+	// check the deposits against the frontrunning pattern, the result is shown prominently on all tabs
+	if pubkeyStats := db.GetDepositTxPubkeyStats(ctx, [][]byte{validator.Validator.PublicKey[:]}); len(pubkeyStats) > 0 && pubkeyStats[0].FrontrunCount > 0 {
+		pageData.DepositFrontrun = true
+		pageData.DepositFrontrunSender = pubkeyStats[0].FirstSender
+		pageData.DepositFrontrunCredentials = pubkeyStats[0].FirstCredentials
+		pageData.DepositFrontrunCount = pubkeyStats[0].FrontrunCount
+	}
+
 	if pageData.TabView == "deposits" {
 		// first get recent included deposits
 		pageData.RecentDeposits = make([]*models.ValidatorPageDataDeposit, 0)
@@ -318,9 +380,9 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 		}
 
 		depositSyncState := dbtypes.DepositIndexerState{}
-		db.GetExplorerState("indexer.depositstate", &depositSyncState)
+		db.GetExplorerState(ctx, "indexer.depositstate", &depositSyncState)
 
-		depositsData, totalIncludedDeposits := services.GlobalBeaconService.GetIncludedDepositsByFilter(&dbtypes.DepositFilter{
+		depositsData, totalIncludedDeposits := services.GlobalBeaconService.GetIncludedDepositsByFilter(ctx, &dbtypes.DepositFilter{
 			PublicKey: validator.Validator.PublicKey[:],
 		}, 0, 100)
 
@@ -346,7 +408,7 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 			}
 		}
 
-		initiatedDeposits, totalInitiatedDeposits, _ := db.GetDepositTxsFiltered(0, 10, depositSyncState.FinalBlock, initiatedFilter)
+		initiatedDeposits, totalInitiatedDeposits, _ := db.GetDepositTxsFiltered(ctx, 0, 10, depositSyncState.FinalBlock, initiatedFilter)
 		if totalInitiatedDeposits > 10 {
 			pageData.AdditionalInitiatedDepositCount = totalInitiatedDeposits - 10
 		}
@@ -406,7 +468,7 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 		}
 
 		if minDepositIndex < math.MaxUint64 {
-			depositTxs, _, _ := db.GetDepositTxsFiltered(0, 10, depositSyncState.FinalBlock, &dbtypes.DepositTxFilter{
+			depositTxs, _, _ := db.GetDepositTxsFiltered(ctx, 0, 10, depositSyncState.FinalBlock, &dbtypes.DepositTxFilter{
 				MinIndex:  minDepositIndex,
 				MaxIndex:  maxDepositIndex,
 				PublicKey: validator.Validator.PublicKey[:],
@@ -429,12 +491,18 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 			}
 		}
 
+		if pageData.DepositFrontrun {
+			for _, deposit := range pageData.RecentDeposits {
+				deposit.Frontrun = !bytes.Equal(deposit.WithdrawalCreds, pageData.DepositFrontrunCredentials)
+			}
+		}
+
 		pageData.RecentDepositCount = uint64(len(pageData.RecentDeposits))
 	}
 
 	// load recent withdrawal requests
 	if pageData.TabView == "withdrawalrequests" {
-		dbElWithdrawals, totalElWithdrawals := services.GlobalBeaconService.GetWithdrawalRequestsByFilter(&services.CombinedWithdrawalRequestFilter{
+		dbElWithdrawals, totalElWithdrawals := services.GlobalBeaconService.GetWithdrawalRequestsByFilter(ctx, &services.CombinedWithdrawalRequestFilter{
 			Filter: &dbtypes.WithdrawalRequestFilter{
 				PublicKey: validator.Validator.PublicKey[:],
 			},
@@ -508,7 +576,7 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 
 	// load recent consolidation requests
 	if pageData.TabView == "consolidationrequests" {
-		dbConsolidations, totalConsolidations := services.GlobalBeaconService.GetConsolidationRequestsByFilter(&services.CombinedConsolidationRequestFilter{
+		dbConsolidations, totalConsolidations := services.GlobalBeaconService.GetConsolidationRequestsByFilter(ctx, &services.CombinedConsolidationRequestFilter{
 			Filter: &dbtypes.ConsolidationRequestFilter{
 				PublicKey: validator.Validator.PublicKey[:],
 			},
@@ -593,13 +661,51 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 		pageData.ConsolidationRequestCount = uint64(len(pageData.ConsolidationRequests))
 	}
 
+	// load income history, the income tab is only shown if the income index is enabled
+	pageData.IncomePeriodEpochs = services.GlobalBeaconService.GetValidatorIncomePeriod()
+	pageData.IncomeEnabled = pageData.IncomePeriodEpochs > 0
+	if pageData.TabView == "income" && pageData.IncomeEnabled {
+		dbIncomes := services.GlobalBeaconService.GetValidatorIncome(ctx, phase0.ValidatorIndex(validatorIndex), validatorIncomeLimit)
+		chartValues := make([]float64, len(dbIncomes))
+		for idx, dbIncome := range dbIncomes {
+			income := &models.ValidatorPageDataIncome{
+				Epoch:             dbIncome.Epoch,
+				Time:              chainState.EpochToTime(phase0.Epoch(dbIncome.Epoch)),
+				AttestationReward: uint64(dbIncome.AttestationReward),
+				SyncReward:        uint64(dbIncome.SyncReward),
+				ProposalReward:    uint64(dbIncome.ProposalReward),
+				Penalty:           uint64(dbIncome.Penalty),
+				NetIncome:         dbIncome.AttestationReward + dbIncome.SyncReward + dbIncome.ProposalReward - dbIncome.Penalty,
+			}
+			pageData.Income = append(pageData.Income, income)
+			pageData.IncomeTotal += income.NetIncome
+
+			// the chart is drawn from the oldest to the latest period
+			chartValues[len(dbIncomes)-idx-1] = float64(income.NetIncome)
+		}
+		pageData.IncomeChart, pageData.IncomeChartZero = getValidatorIncomeChartPoints(chartValues)
+	}
+
+	// load notes, the notes tab is only shown for validators with notes
+	for _, note := range services.GlobalBeaconService.GetValidatorNotes(ctx, validatorIndex) {
+		pageData.Notes = append(pageData.Notes, &models.ValidatorPageDataNote{
+			Id:        note.Id,
+			Note:      note.Note,
+			Author:    note.Author,
+			CreatedAt: note.CreatedAt,
+			Tags:      note.Tags,
+		})
+	}
+	pageData.NoteCount = uint64(len(pageData.Notes))
+	pageData.NoteTags = services.GlobalBeaconService.GetValidatorNoteTags(ctx, validatorIndex)
+
 	// Check for exit reason if validator is exiting or has exited
 	if pageData.ShowExit {
 		zeroAmount := uint64(0)
 		exitSlot := uint64(chainState.EpochToSlot(validator.Validator.ExitEpoch))
 
 		// Check for slashing
-		if slashings, totalSlashings := services.GlobalBeaconService.GetSlashingsByFilter(&dbtypes.SlashingFilter{
+		if slashings, totalSlashings := services.GlobalBeaconService.GetSlashingsByFilter(ctx, &dbtypes.SlashingFilter{
 			MinIndex: validatorIndex,
 			MaxIndex: validatorIndex,
 		}, 0, 1); totalSlashings > 0 && len(slashings) > 0 {
@@ -609,7 +715,7 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 			pageData.ExitReasonSlashingReason = uint64(slashings[0].Reason)
 
 			// Check for voluntary exit
-		} else if exits, totalExits := services.GlobalBeaconService.GetVoluntaryExitsByFilter(&dbtypes.VoluntaryExitFilter{
+		} else if exits, totalExits := services.GlobalBeaconService.GetVoluntaryExitsByFilter(ctx, &dbtypes.VoluntaryExitFilter{
 			MinIndex: validatorIndex,
 			MaxIndex: validatorIndex,
 		}, 0, 1); totalExits > 0 && len(exits) > 0 {
@@ -618,7 +724,7 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 			pageData.ExitReasonSlot = exits[0].SlotNumber
 
 			// Check for full withdrawal request
-		} else if withdrawals, totalWithdrawals := services.GlobalBeaconService.GetWithdrawalRequestsByFilter(&services.CombinedWithdrawalRequestFilter{
+		} else if withdrawals, totalWithdrawals := services.GlobalBeaconService.GetWithdrawalRequestsByFilter(ctx, &services.CombinedWithdrawalRequestFilter{
 			Filter: &dbtypes.WithdrawalRequestFilter{
 				PublicKey:     validator.Validator.PublicKey[:],
 				SourceAddress: pageData.WithdrawAddress,
@@ -643,7 +749,7 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 				}
 			}
 			// Check for consolidation request
-		} else if consolidations, totalConsolidations := services.GlobalBeaconService.GetConsolidationRequestsByFilter(&services.CombinedConsolidationRequestFilter{
+		} else if consolidations, totalConsolidations := services.GlobalBeaconService.GetConsolidationRequestsByFilter(ctx, &services.CombinedConsolidationRequestFilter{
 			Filter: &dbtypes.ConsolidationRequestFilter{
 				PublicKey:     validator.Validator.PublicKey[:],
 				SourceAddress: pageData.WithdrawAddress,
@@ -674,5 +780,38 @@ func buildValidatorPageData(validatorIndex uint64, tabView string) (*models.Vali
 		}
 	}
 
+	linkValues := map[string]string{
+		"index":  strconv.FormatUint(pageData.Index, 10),
+		"pubkey": fmt.Sprintf("0x%x", pageData.PublicKey),
+	}
+	if pageData.ShowWithdrawAddress {
+		linkValues["address"] = common.BytesToAddress(pageData.WithdrawAddress).Hex()
+	}
+	pageData.ExternalLinks = getExternalLinks("validator", linkValues)
+
 	return pageData, 10 * time.Minute
 }
+
+func getValidatorIncomeChartPoints(values []float64) (string, float64) {
+	minValue, maxValue := float64(0), float64(0)
+	for _, value := range values {
+		minValue = min(minValue, value)
+		maxValue = max(maxValue, value)
+	}
+	valueRange := maxValue - minValue
+	if valueRange <= 0 {
+		valueRange = 1
+	}
+
+	points := make([]string, len(values))
+	for idx, value := range values {
+		x := float64(0)
+		if len(values) > 1 {
+			x = float64(idx) * validatorIncomeChartWidth / float64(len(values)-1)
+		}
+		y := validatorIncomeChartHeight - (value-minValue)*validatorIncomeChartHeight/valueRange
+		points[idx] = fmt.Sprintf("%.1f,%.1f", x, y)
+	}
+	zeroY := validatorIncomeChartHeight + minValue*validatorIncomeChartHeight/valueRange
+	return strings.Join(points, " "), zeroY
+}
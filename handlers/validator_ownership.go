@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const ownershipSessionCookie = "dora_session"
+
+// getOwnershipSession returns the hash of the ownership session token from the session cookie.
+// with create set, a new session token is issued if the request has none.
+func getOwnershipSession(w http.ResponseWriter, r *http.Request, create bool) []byte {
+	if cookie, err := r.Cookie(ownershipSessionCookie); err == nil {
+		token, err := hex.DecodeString(cookie.Value)
+		if err == nil && len(token) == 32 {
+			sessionHash := sha256.Sum256(token)
+			return sessionHash[:]
+		}
+	}
+	if !create {
+		return nil
+	}
+
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     ownershipSessionCookie,
+		Value:    hex.EncodeToString(token),
+		Path:     "/",
+		MaxAge:   int((90 * 24 * time.Hour).Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	sessionHash := sha256.Sum256(token)
+	return sessionHash[:]
+}
+
+// ValidatorOwnership will return the "validator ownership" page, which verifies signatures over a session challenge to prove the ownership of validator keys
+func ValidatorOwnership(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"validator_ownership/validator_ownership.html",
+	)
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+
+	if !utils.Config().Frontend.ShowValidatorOwnership {
+		handlePageError(w, r, errors.New("validator ownership proofs are not enabled"))
+		return
+	}
+
+	sessionHash := getOwnershipSession(w, r, true)
+	if sessionHash == nil {
+		handlePageError(w, r, errors.New("failed creating session"))
+		return
+	}
+
+	data := InitPageData(w, r, "validators", "/validators/ownership", "Prove Validator Ownership", templateFiles)
+	pageData := &models.ValidatorOwnershipPageData{}
+
+	if r.Method == http.MethodPost {
+		pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 5)
+		if pageError != nil {
+			handlePageError(w, r, pageError)
+			return
+		}
+
+		message, err := handleValidatorOwnershipAction(r, sessionHash)
+		if err != nil {
+			pageData.Error = err.Error()
+		} else {
+			pageData.Message = message
+		}
+	}
+
+	challenge := services.GlobalBeaconService.GetValidatorOwnershipChallenge(sessionHash)
+	if challenge != nil {
+		pageData.Challenge = challenge.Challenge[:]
+		pageData.Domain = challenge.Domain[:]
+		pageData.SigningRoot = challenge.SigningRoot[:]
+		pageData.ChallengeValidTil = challenge.ValidUntil
+	}
+
+	ownerships := services.GlobalBeaconService.GetValidatorOwnerships(r.Context(), sessionHash)
+	validatorIndices := make([]uint64, 0, len(ownerships))
+	for _, ownership := range ownerships {
+		validatorIndices = append(validatorIndices, ownership.ValidatorIndex)
+	}
+	validatorNames := services.GlobalBeaconService.GetValidatorNames(validatorIndices)
+
+	for _, ownership := range ownerships {
+		validatorData := &models.ValidatorOwnershipPageDataValidator{
+			Index:        ownership.ValidatorIndex,
+			Name:         validatorNames[ownership.ValidatorIndex],
+			ProvenAt:     ownership.ProvenAt,
+			PrivateLabel: ownership.PrivateLabel,
+		}
+		if validator := services.GlobalBeaconService.GetValidatorByIndex(phase0.ValidatorIndex(ownership.ValidatorIndex), false); validator != nil {
+			validatorData.PublicKey = validator.Validator.PublicKey[:]
+			validatorData.State = validator.Status.String()
+		}
+		pageData.Validators = append(pageData.Validators, validatorData)
+	}
+
+	data.Data = pageData
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "validator_ownership.go", "ValidatorOwnership", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func handleValidatorOwnershipAction(r *http.Request, sessionHash []byte) (string, error) {
+	if err := r.ParseForm(); err != nil {
+		return "", fmt.Errorf("failed parsing form: %v", err)
+	}
+
+	switch r.FormValue("action") {
+	case "verify":
+		validatorIndex, err := parseOwnershipValidator(r.FormValue("validator"))
+		if err != nil {
+			return "", err
+		}
+		signature, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(r.FormValue("signature")), "0x"))
+		if err != nil {
+			return "", errors.New("invalid signature encoding")
+		}
+		err = services.GlobalBeaconService.VerifyValidatorOwnership(r.Context(), sessionHash, validatorIndex, signature)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Ownership of validator %v proven", validatorIndex), nil
+
+	case "label":
+		validatorIndex, err := strconv.ParseUint(r.FormValue("index"), 10, 64)
+		if err != nil {
+			return "", errors.New("invalid validator index")
+		}
+		err = services.GlobalBeaconService.SetValidatorPrivateLabel(r.Context(), sessionHash, validatorIndex, r.FormValue("label"))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Private label of validator %v updated", validatorIndex), nil
+
+	case "remove":
+		validatorIndex, err := strconv.ParseUint(r.FormValue("index"), 10, 64)
+		if err != nil {
+			return "", errors.New("invalid validator index")
+		}
+		err = services.GlobalBeaconService.RemoveValidatorOwnership(r.Context(), sessionHash, validatorIndex)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Validator %v removed from this session", validatorIndex), nil
+	}
+
+	return "", errors.New("invalid action")
+}
+
+// parseOwnershipValidator resolves a validator index or pubkey to the validator index
+func parseOwnershipValidator(validator string) (phase0.ValidatorIndex, error) {
+	validator = strings.TrimSpace(validator)
+	pubkey, err := hex.DecodeString(strings.TrimPrefix(validator, "0x"))
+	if err == nil && len(pubkey) == 48 {
+		validatorIndex, found := services.GlobalBeaconService.GetValidatorIndexByPubkey(phase0.BLSPubKey(pubkey))
+		if !found {
+			return 0, errors.New("validator pubkey not found")
+		}
+		return validatorIndex, nil
+	}
+
+	validatorIndex, err := strconv.ParseUint(validator, 10, 64)
+	if err != nil {
+		return 0, errors.New("invalid validator index or pubkey")
+	}
+	return phase0.ValidatorIndex(validatorIndex), nil
+}
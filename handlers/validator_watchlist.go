@@ -0,0 +1,271 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// watchlistAttestationLookback is the number of epochs the missed attestations of watched validators are counted for
+const watchlistAttestationLookback = 32
+
+// ValidatorWatchlist will return the "validator watchlist" page, which shows the live status & upcoming duties of the validators on the sessions watchlists
+func ValidatorWatchlist(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"watchlist/watchlist.html",
+	)
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+
+	if !utils.Config().Frontend.ShowValidatorWatchlist {
+		handlePageError(w, r, errors.New("validator watchlists are not enabled"))
+		return
+	}
+
+	data := InitPageData(w, r, "validators", "/watchlist", "Validator Watchlists", templateFiles)
+	pageData := &models.ValidatorWatchlistPageData{
+		AttestationLookback: watchlistAttestationLookback,
+		WebhooksEnabled:     utils.Config().Notifications.WatchlistWebhooks,
+		WebhookEvents:       services.ValidatorWatchlistWebhookEvents,
+	}
+
+	// the session is only created when adding validators, so plain page views don't issue cookies
+	sessionHash := getOwnershipSession(w, r, r.Method == http.MethodPost)
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil && r.Method == http.MethodPost {
+		if sessionHash == nil {
+			pageError = errors.New("failed creating session")
+		} else {
+			message, err := handleValidatorWatchlistAction(r, sessionHash)
+			if err != nil {
+				pageData.Error = err.Error()
+			} else {
+				pageData.Message = message
+			}
+		}
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+
+	if sessionHash != nil {
+		pageData.Lists = buildValidatorWatchlistData(services.GlobalBeaconService.GetValidatorWatchlists(r.Context(), sessionHash))
+	}
+
+	data.Data = pageData
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "validator_watchlist.go", "ValidatorWatchlist", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func handleValidatorWatchlistAction(r *http.Request, sessionHash []byte) (string, error) {
+	if err := r.ParseForm(); err != nil {
+		return "", fmt.Errorf("failed parsing form: %v", err)
+	}
+
+	listName := r.FormValue("list")
+	switch r.FormValue("action") {
+	case "add":
+		validators, err := parseWatchlistValidators(strings.FieldsFunc(r.FormValue("validators"), func(c rune) bool {
+			return c == ',' || c == ' ' || c == '\n' || c == '\r' || c == '\t'
+		}))
+		if err != nil {
+			return "", err
+		}
+		added, err := services.GlobalBeaconService.AddValidatorWatchlistValidators(r.Context(), sessionHash, listName, validators)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%v validators added to watchlist %v", added, listName), nil
+
+	case "remove":
+		validatorIndex, err := strconv.ParseUint(r.FormValue("index"), 10, 64)
+		if err != nil {
+			return "", errors.New("invalid validator index")
+		}
+		err = services.GlobalBeaconService.RemoveValidatorWatchlistValidator(r.Context(), sessionHash, listName, validatorIndex)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Validator %v removed from watchlist %v", validatorIndex, listName), nil
+
+	case "delete":
+		err := services.GlobalBeaconService.DeleteValidatorWatchlist(r.Context(), sessionHash, listName)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Watchlist %v removed", listName), nil
+
+	case "add-webhook":
+		offlineEpochs := uint64(0)
+		if offlineStr := r.FormValue("offline_epochs"); offlineStr != "" {
+			var err error
+			offlineEpochs, err = strconv.ParseUint(offlineStr, 10, 64)
+			if err != nil {
+				return "", errors.New("invalid offline epochs")
+			}
+		}
+		err := services.GlobalBeaconService.AddValidatorWatchlistWebhook(r.Context(), sessionHash, listName, r.FormValue("url"), r.Form["events"], offlineEpochs)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Webhook added to watchlist %v", listName), nil
+
+	case "remove-webhook":
+		err := services.GlobalBeaconService.RemoveValidatorWatchlistWebhook(r.Context(), sessionHash, listName, r.FormValue("url"))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Webhook removed from watchlist %v", listName), nil
+	}
+
+	return "", errors.New("invalid action")
+}
+
+// parseWatchlistValidators resolves a list of validator indices or pubkeys to validator indices
+func parseWatchlistValidators(validators []string) ([]phase0.ValidatorIndex, error) {
+	validatorIndices := make([]phase0.ValidatorIndex, 0, len(validators))
+	for _, validator := range validators {
+		validatorIndex, err := parseOwnershipValidator(validator)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", validator, err)
+		}
+		validatorIndices = append(validatorIndices, validatorIndex)
+	}
+	return validatorIndices, nil
+}
+
+// buildValidatorWatchlistData loads the current status, recent attestations & upcoming duties of the validators on the given watchlists.
+// the data is session specific, so it's built on every call instead of going through the page cache.
+func buildValidatorWatchlistData(watchlists []*services.ValidatorWatchlist) []*models.ValidatorWatchlistPageDataList {
+	chainState := services.GlobalBeaconService.GetChainState()
+
+	validatorIndices := []uint64{}
+	dutyIndices := []phase0.ValidatorIndex{}
+	for _, watchlist := range watchlists {
+		for _, validatorIndex := range watchlist.Validators {
+			validatorIndices = append(validatorIndices, validatorIndex)
+			dutyIndices = append(dutyIndices, phase0.ValidatorIndex(validatorIndex))
+		}
+	}
+	validatorNames := services.GlobalBeaconService.GetValidatorNames(validatorIndices)
+	upcomingDuties := services.GlobalBeaconService.GetValidatorUpcomingDuties(dutyIndices)
+
+	// the liveness of the last 2 epochs is not final yet, as attestations may still be included
+	refEpoch := chainState.CurrentEpoch()
+	if refEpoch > 2 {
+		refEpoch -= 2
+	} else {
+		refEpoch = 0
+	}
+
+	dutySlot := func(slot phase0.Slot) *models.ValidatorWatchlistPageDataDutySlot {
+		return &models.ValidatorWatchlistPageDataDutySlot{
+			Slot: uint64(slot),
+			Time: chainState.SlotToTime(slot),
+		}
+	}
+
+	lists := make([]*models.ValidatorWatchlistPageDataList, 0, len(watchlists))
+	for _, watchlist := range watchlists {
+		listData := &models.ValidatorWatchlistPageDataList{
+			Name:      watchlist.Name,
+			CreatedAt: watchlist.CreatedAt,
+		}
+		for _, webhook := range watchlist.Webhooks {
+			listData.Webhooks = append(listData.Webhooks, &models.ValidatorWatchlistPageDataWebhook{
+				Url:           webhook.Url,
+				Events:        strings.Split(webhook.Events, ","),
+				OfflineEpochs: webhook.OfflineEpochs,
+				CreatedAt:     time.Unix(webhook.CreatedAt, 0),
+			})
+		}
+
+		for _, validatorIndex := range watchlist.Validators {
+			validator := services.GlobalBeaconService.GetValidatorByIndex(phase0.ValidatorIndex(validatorIndex), true)
+			if validator == nil {
+				continue
+			}
+
+			validatorData := &models.ValidatorWatchlistPageDataValidator{
+				Index:            validatorIndex,
+				Name:             validatorNames[validatorIndex],
+				PublicKey:        validator.Validator.PublicKey[:],
+				Balance:          uint64(validator.Balance),
+				EffectiveBalance: uint64(validator.Validator.EffectiveBalance),
+			}
+			if strings.HasPrefix(validator.Status.String(), "pending") {
+				validatorData.State = "Pending"
+			} else if validator.Status == v1.ValidatorStateActiveOngoing {
+				validatorData.State = "Active"
+				validatorData.ShowUpcheck = true
+			} else if validator.Status == v1.ValidatorStateActiveExiting {
+				validatorData.State = "Exiting"
+				validatorData.ShowUpcheck = true
+			} else if validator.Status == v1.ValidatorStateActiveSlashed {
+				validatorData.State = "Slashed"
+				validatorData.ShowUpcheck = true
+			} else if validator.Status == v1.ValidatorStateExitedUnslashed {
+				validatorData.State = "Exited"
+			} else if validator.Status == v1.ValidatorStateExitedSlashed {
+				validatorData.State = "Slashed"
+			} else {
+				validatorData.State = validator.Status.String()
+			}
+
+			if validatorData.ShowUpcheck {
+				listData.ActiveCount++
+				validatorData.UpcheckActivity = uint8(services.GlobalBeaconService.GetValidatorLiveness(validator.Index, 3))
+				validatorData.UpcheckMaximum = uint8(3)
+
+				// only count the epochs the validator was active in
+				lookback := uint64(watchlistAttestationLookback)
+				if activationEpoch := validator.Validator.ActivationEpoch; activationEpoch > refEpoch {
+					lookback = 0
+				} else if activeEpochs := uint64(refEpoch-activationEpoch) + 1; activeEpochs < lookback {
+					lookback = activeEpochs
+				}
+				if lookback > 0 {
+					votedEpochs := services.GlobalBeaconService.GetValidatorLiveness(validator.Index, lookback)
+					validatorData.AttestationEpochs = lookback
+					if votedEpochs < lookback {
+						validatorData.MissedAttestations = lookback - votedEpochs
+					}
+				}
+				listData.MissedAttestations += validatorData.MissedAttestations
+			}
+			listData.TotalBalance += validatorData.Balance
+
+			if duties := upcomingDuties[validator.Index]; duties != nil {
+				for _, slot := range duties.Proposals {
+					validatorData.Proposals = append(validatorData.Proposals, dutySlot(slot))
+				}
+				listData.UpcomingProposals += uint64(len(duties.Proposals))
+				if duties.HasAttestation {
+					validatorData.HasAttestation = true
+					validatorData.Attestation = dutySlot(duties.AttestationSlot)
+				}
+				validatorData.SyncCommittee = duties.SyncCommittee
+			}
+
+			listData.Validators = append(listData.Validators, validatorData)
+		}
+
+		lists = append(lists, listData)
+	}
+
+	return lists
+}
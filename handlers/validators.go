@@ -2,18 +2,24 @@ package handlers
 
 import (
 	"bytes"
+	"cmp"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/indexer/beacon"
 	"github.com/ethpandaops/dora/services"
 	"github.com/ethpandaops/dora/templates"
 	"github.com/ethpandaops/dora/types/models"
@@ -32,52 +38,39 @@ func Validators(w http.ResponseWriter, r *http.Request) {
 	data := InitPageData(w, r, "validators", "/validators", "Validators", validatorsTemplateFiles)
 
 	urlArgs := r.URL.Query()
-	var firstIdx uint64 = 0
-	if urlArgs.Has("s") {
-		firstIdx, _ = strconv.ParseUint(urlArgs.Get("s"), 10, 64)
-	}
-	var pageSize uint64 = 50
-	if urlArgs.Has("c") {
-		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
-	}
-	if urlArgs.Has("json") && pageSize > 10000 {
-		pageSize = 10000
-	} else if !urlArgs.Has("json") && pageSize > 1000 {
-		pageSize = 1000
+	var maxPageSize uint64 = 1000
+	if urlArgs.Has("json") {
+		maxPageSize = 10000
 	}
+	pageArgs := parseValidatorsPageArgs(urlArgs, maxPageSize)
 
-	var filterPubKey string
-	var filterIndex string
-	var filterName string
-	var filterStatus string
-	if urlArgs.Has("f") {
-		if urlArgs.Has("f.pubkey") {
-			filterPubKey = urlArgs.Get("f.pubkey")
-		}
-		if urlArgs.Has("f.index") {
-			filterIndex = urlArgs.Get("f.index")
-		}
-		if urlArgs.Has("f.name") {
-			filterName = urlArgs.Get("f.name")
-		}
-		if urlArgs.Has("f.status") {
-			filterStatus = strings.Join(urlArgs["f.status"], ",")
-		}
-	}
-	var sortOrder string
-	if urlArgs.Has("o") {
-		sortOrder = urlArgs.Get("o")
+	// filtered & sorted views of big validator sets take a while on first load, the html page shows a placeholder meanwhile
+	var asyncWait time.Duration
+	if !urlArgs.Has("json") {
+		asyncWait = asyncPageBuildWait
 	}
 
 	var pageError error
-	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	var buildStatus *services.FrontendCacheBuildStatus
+	pageArgs.AtEpoch, pageError = parseHistoricEpoch(urlArgs)
+	if pageError == nil && isCsvExport(r) {
+		exportValidatorsCsv(w, r, pageArgs)
+		return
+	}
+	if pageError == nil {
+		pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	}
 	if pageError == nil {
-		data.Data, pageError = getValidatorsPageData(firstIdx, pageSize, sortOrder, filterPubKey, filterIndex, filterName, filterStatus)
+		data.Data, buildStatus, pageError = getValidatorsPageData(r.Context(), pageArgs, asyncWait)
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
 		return
 	}
+	if buildStatus != nil {
+		handlePageBuildPending(w, r, "validators", "Validators", buildStatus)
+		return
+	}
 
 	if urlArgs.Has("json") {
 		w.Header().Set("Content-Type", "application/json")
@@ -86,6 +79,7 @@ func Validators(w http.ResponseWriter, r *http.Request) {
 			logrus.WithError(err).Error("error encoding index data")
 			http.Error(w, "Internal server error", http.StatusServiceUnavailable)
 		}
+		return
 	}
 
 	w.Header().Set("Content-Type", "text/html")
@@ -94,45 +88,145 @@ func Validators(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder string, filterPubKey string, filterIndex string, filterName string, filterStatus string) (*models.ValidatorsPageData, error) {
+// validatorsPageArgs holds the query parameters of the validators page.
+// the same parameters are accepted by the filtered validators api, so links shared from the page return the same validator list via api.
+type validatorsPageArgs struct {
+	FirstIdx     uint64 // s
+	PageSize     uint64 // c
+	SortOrder    string // o
+	ApyWindow    string // apy
+	FilterPubKey string // f.pubkey (filters are only applied if f is set)
+	FilterIndex  string // f.index
+	FilterName   string // f.name
+	FilterStatus string // f.status (multiple values are joined with ",")
+	FilterExpr   string // f.expr
+	FilterTag    string // f.tag
+	AtEpoch      int64  // at_epoch (historical view of a finalized epoch, -1 for the current state)
+}
+
+func parseValidatorsPageArgs(urlArgs url.Values, maxPageSize uint64) *validatorsPageArgs {
+	pageArgs := &validatorsPageArgs{
+		PageSize: 50,
+		AtEpoch:  -1,
+	}
+	if urlArgs.Has("s") {
+		pageArgs.FirstIdx, _ = strconv.ParseUint(urlArgs.Get("s"), 10, 64)
+	}
+	if urlArgs.Has("c") {
+		pageArgs.PageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	if pageArgs.PageSize > maxPageSize {
+		pageArgs.PageSize = maxPageSize
+	}
+
+	if urlArgs.Has("f") {
+		if urlArgs.Has("f.pubkey") {
+			pageArgs.FilterPubKey = urlArgs.Get("f.pubkey")
+		}
+		if urlArgs.Has("f.index") {
+			pageArgs.FilterIndex = urlArgs.Get("f.index")
+		}
+		if urlArgs.Has("f.name") {
+			pageArgs.FilterName = urlArgs.Get("f.name")
+		}
+		if urlArgs.Has("f.status") {
+			pageArgs.FilterStatus = strings.Join(urlArgs["f.status"], ",")
+		}
+		if urlArgs.Has("f.expr") {
+			pageArgs.FilterExpr = urlArgs.Get("f.expr")
+		}
+		if urlArgs.Has("f.tag") {
+			pageArgs.FilterTag = urlArgs.Get("f.tag")
+		}
+	}
+	if urlArgs.Has("o") {
+		pageArgs.SortOrder = urlArgs.Get("o")
+	}
+	if urlArgs.Has("apy") {
+		pageArgs.ApyWindow = urlArgs.Get("apy")
+	}
+
+	return pageArgs
+}
+
+// getValidatorsPageData returns the validators page model.
+// with asyncWait > 0 builds that take longer continue in background and only their build status is returned.
+func getValidatorsPageData(ctx context.Context, pageArgs *validatorsPageArgs, asyncWait time.Duration) (*models.ValidatorsPageData, *services.FrontendCacheBuildStatus, error) {
 	pageData := &models.ValidatorsPageData{}
-	pageCacheKey := fmt.Sprintf("validators:%v:%v:%v:%v:%v:%v:%v", firstValIdx, pageSize, sortOrder, filterPubKey, filterIndex, filterName, filterStatus)
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
-		pageData, cacheTimeout := buildValidatorsPageData(firstValIdx, pageSize, sortOrder, filterPubKey, filterIndex, filterName, filterStatus)
+	pageCacheKey := fmt.Sprintf("validators:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageArgs.FirstIdx, pageArgs.PageSize, pageArgs.SortOrder, pageArgs.ApyWindow, pageArgs.FilterPubKey, pageArgs.FilterIndex, pageArgs.FilterName, pageArgs.FilterStatus, pageArgs.FilterExpr, pageArgs.FilterTag, pageArgs.AtEpoch)
+	buildFn := func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildValidatorsPageData(pageCall.CallCtx, pageArgs.FirstIdx, pageArgs.PageSize, pageArgs.SortOrder, pageArgs.ApyWindow, pageArgs.FilterPubKey, pageArgs.FilterIndex, pageArgs.FilterName, pageArgs.FilterStatus, pageArgs.FilterExpr, pageArgs.FilterTag, pageArgs.AtEpoch)
 		pageCall.CacheTimeout = cacheTimeout
 		return pageData
-	})
+	}
+
+	var pageRes interface{}
+	var buildStatus *services.FrontendCacheBuildStatus
+	var pageErr error
+	if asyncWait > 0 {
+		pageRes, buildStatus, pageErr = services.GlobalFrontendCache.ProcessCachedPageAsync(ctx, pageCacheKey, true, pageData, buildFn, asyncWait)
+	} else {
+		pageRes, pageErr = services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, buildFn)
+	}
+	if pageErr == nil && buildStatus != nil {
+		return nil, buildStatus, nil
+	}
 	if pageErr == nil && pageRes != nil {
 		resData, resOk := pageRes.(*models.ValidatorsPageData)
 		if !resOk {
-			return nil, ErrInvalidPageModel
+			return nil, nil, ErrInvalidPageModel
 		}
 		pageData = resData
 	}
-	return pageData, pageErr
+	return pageData, nil, pageErr
 }
 
-func buildValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder string, filterPubKey string, filterIndex string, filterName string, filterStatus string) (*models.ValidatorsPageData, time.Duration) {
-	logrus.Debugf("validators page called: %v:%v:%v:%v:%v:%v:%v", firstValIdx, pageSize, sortOrder, filterPubKey, filterIndex, filterName, filterStatus)
+func buildValidatorsPageData(ctx context.Context, firstValIdx uint64, pageSize uint64, sortOrder string, apyWindow string, filterPubKey string, filterIndex string, filterName string, filterStatus string, filterExpr string, filterTag string, atEpoch int64) (*models.ValidatorsPageData, time.Duration) {
+	logrus.Debugf("validators page called: %v:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v", firstValIdx, pageSize, sortOrder, apyWindow, filterPubKey, filterIndex, filterName, filterStatus, filterExpr, filterTag, atEpoch)
 	pageData := &models.ValidatorsPageData{}
 	cacheTime := 10 * time.Minute
 
 	chainState := services.GlobalBeaconService.GetChainState()
 
-	// get latest validator set
-	var validatorSet []*v1.Validator
-	validatorSetRsp := services.GlobalBeaconService.GetCachedValidatorSet(true)
-	if validatorSetRsp == nil {
-		cacheTime = 5 * time.Minute
-		validatorSet = []*v1.Validator{}
+	services.SetPageBuildProgress(ctx, 5, "loading validator set")
+	// get latest validator set, or the reconstructed set of the requested finalized epoch for historical views
+	var validatorSet *beacon.ValidatorSetView
+	if atEpoch >= 0 {
+		validatorSet = services.GlobalBeaconService.GetHistoricValidatorSet(ctx, phase0.Epoch(atEpoch))
+		pageData.IsHistoric = true
+		pageData.HistoricEpoch = uint64(atEpoch)
+		pageData.HistoricTs = chainState.EpochToTime(phase0.Epoch(atEpoch))
+		pageData.HistoricBalances = validatorSet.HasBalances()
+		finalizedEpoch, _ := services.GlobalBeaconService.GetFinalizedEpoch()
+		for _, epoch := range services.GlobalBeaconService.GetValidatorBalanceSnapshotEpochs(ctx) {
+			if len(pageData.HistoricSnapshotEpochs) >= 10 {
+				break
+			}
+			if epoch > uint64(finalizedEpoch) {
+				continue
+			}
+			pageData.HistoricSnapshotEpochs = append(pageData.HistoricSnapshotEpochs, epoch)
+		}
+		cacheTime = 30 * time.Minute
 	} else {
-		validatorSet = validatorSetRsp
+		validatorSet = services.GlobalBeaconService.GetCachedValidatorSet(true)
+	}
+	if validatorSet.Len() == 0 {
+		cacheTime = 5 * time.Minute
+	}
+
+	// the filters & sort orders are applied to a list of validator indices, the validator records are only materialized for the shown page
+	validatorIndices := make([]phase0.ValidatorIndex, validatorSet.Len())
+	for i := range validatorIndices {
+		validatorIndices[i] = phase0.ValidatorIndex(i)
 	}
 
 	// get status options
 	statusMap := map[v1.ValidatorState]uint64{}
-	for _, val := range validatorSet {
-		statusMap[val.Status]++
+	for _, index := range validatorIndices {
+		if record, found := validatorSet.GetRecord(index); found {
+			statusMap[record.Status]++
+		}
 	}
 	pageData.FilterStatusOpts = make([]models.ValidatorsPageDataStatusOption, 0)
 	for status, count := range statusMap {
@@ -145,11 +239,25 @@ func buildValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder stri
 		return strings.Compare(pageData.FilterStatusOpts[a].Status, pageData.FilterStatusOpts[b].Status) < 0
 	})
 
+	// get note tag options
+	pageData.FilterTagOpts = make([]models.ValidatorsPageDataTagOption, 0)
+	for _, tagCount := range db.GetValidatorNoteTagCounts(ctx) {
+		pageData.FilterTagOpts = append(pageData.FilterTagOpts, models.ValidatorsPageDataTagOption{
+			Tag:   tagCount.Tag,
+			Count: tagCount.Count,
+		})
+	}
+
 	filterArgs := url.Values{}
-	if filterPubKey != "" || filterIndex != "" || filterName != "" || filterStatus != "" {
+	if pageData.IsHistoric {
+		filterArgs.Add("at_epoch", strconv.FormatUint(pageData.HistoricEpoch, 10))
+	}
+	if filterPubKey != "" || filterIndex != "" || filterName != "" || filterStatus != "" || filterExpr != "" || filterTag != "" {
 		var filterPubKeyVal []byte
 		var filterIndexVal uint64
 		var filterStatusVal []string
+		var filterExprVal validatorFilterExpr
+		var filterTagVal map[uint64]bool
 
 		if filterPubKey != "" {
 			filterArgs.Add("f.pubkey", filterPubKey)
@@ -166,90 +274,162 @@ func buildValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder stri
 			filterArgs.Add("f.status", filterStatus)
 			filterStatusVal = strings.Split(filterStatus, ",")
 		}
+		if filterExpr != "" {
+			filterArgs.Add("f.expr", filterExpr)
+
+			var err error
+			filterExprVal, err = parseValidatorFilterExpr(filterExpr)
+			if err != nil {
+				pageData.FilterExprError = err.Error()
+			}
+		}
+		if filterTag != "" {
+			filterArgs.Add("f.tag", filterTag)
+			filterTagVal = map[uint64]bool{}
+			if tag, valid := services.NormalizeValidatorNoteTag(filterTag); valid {
+				for _, index := range db.GetValidatorIndexesByNoteTag(ctx, tag) {
+					filterTagVal[index] = true
+				}
+			}
+		}
+
+		services.SetPageBuildProgress(ctx, 25, "filtering validators")
+		var filterNameVal map[uint64]string
+		if filterName != "" {
+			nameIndices := make([]uint64, len(validatorIndices))
+			for i, index := range validatorIndices {
+				nameIndices[i] = uint64(index)
+			}
+			filterNameVal = services.GlobalBeaconService.GetValidatorNames(nameIndices)
+		}
 
 		// apply filter
-		filteredValidatorSet := make([]*v1.Validator, 0)
-		for _, val := range validatorSet {
-			if filterPubKey != "" && !bytes.Equal(filterPubKeyVal, val.Validator.PublicKey[:]) {
+		filteredIndices := make([]phase0.ValidatorIndex, 0)
+		for _, index := range validatorIndices {
+			record, found := validatorSet.GetRecord(index)
+			if !found {
+				continue
+			}
+			if filterPubKey != "" && !bytes.Equal(filterPubKeyVal, record.Pubkey[:]) {
 				continue
 			}
-			if filterIndex != "" && filterIndexVal != uint64(val.Index) {
+			if filterIndex != "" && filterIndexVal != uint64(index) {
 				continue
 			}
 			if filterName != "" {
-				valName := services.GlobalBeaconService.GetValidatorName(uint64(val.Index))
-				if !strings.Contains(valName, filterName) {
+				if !strings.Contains(filterNameVal[uint64(index)], filterName) {
 					continue
 				}
 			}
-			if filterStatus != "" && !utils.SliceContains(filterStatusVal, val.Status.String()) {
+			if filterStatus != "" && !utils.SliceContains(filterStatusVal, record.Status.String()) {
 				continue
 			}
-			filteredValidatorSet = append(filteredValidatorSet, val)
+			if filterExpr != "" && (filterExprVal == nil || !filterExprVal.match(&record)) {
+				continue
+			}
+			if filterTag != "" && !filterTagVal[uint64(index)] {
+				continue
+			}
+			filteredIndices = append(filteredIndices, index)
 		}
-		validatorSet = filteredValidatorSet
+		validatorIndices = filteredIndices
 	}
 	pageData.FilterPubKey = filterPubKey
 	pageData.FilterIndex = filterIndex
 	pageData.FilterName = filterName
 	pageData.FilterStatus = filterStatus
+	pageData.FilterExpr = filterExpr
+	pageData.FilterTag = filterTag
+
+	// get apy window, the apy column shows the 7d apy by default
+	pageData.ApyWindows = []string{}
+	for _, window := range services.GlobalBeaconService.GetValidatorApyWindows() {
+		pageData.ApyWindows = append(pageData.ApyWindows, window.Name)
+	}
+	if apyWindow == "" || !utils.SliceContains(pageData.ApyWindows, apyWindow) {
+		apyWindow = "7d"
+	}
+	pageData.ApyWindow = apyWindow
+	getApy := func(index phase0.ValidatorIndex) (float64, bool) {
+		if pageData.IsHistoric {
+			// the apy is only computed for the latest snapshot
+			return 0, false
+		}
+		return services.GlobalBeaconService.GetValidatorApy(index, apyWindow)
+	}
 
 	// apply sort order
-	validatorSetLen := len(validatorSet)
+	services.SetPageBuildProgress(ctx, 50, "sorting validators")
+	validatorSetLen := len(validatorIndices)
 	if sortOrder == "" {
 		sortOrder = "index"
 	}
 
-	sortedValidatorSet := make([]*v1.Validator, validatorSetLen)
-	copy(sortedValidatorSet, validatorSet)
+	sortedIndices := validatorIndices
 
 	switch sortOrder {
 	case "index":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Index < sortedValidatorSet[b].Index
-		})
 		pageData.IsDefaultSorting = true
 	case "index-d":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Index > sortedValidatorSet[b].Index
-		})
-	case "pubkey":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return bytes.Compare(sortedValidatorSet[a].Validator.PublicKey[:], sortedValidatorSet[b].Validator.PublicKey[:]) < 0
-		})
-	case "pubkey-d":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return bytes.Compare(sortedValidatorSet[a].Validator.PublicKey[:], sortedValidatorSet[b].Validator.PublicKey[:]) > 0
-		})
-	case "balance":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Balance < sortedValidatorSet[b].Balance
-		})
-	case "balance-d":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Balance > sortedValidatorSet[b].Balance
-		})
-	case "activation":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Validator.ActivationEpoch < sortedValidatorSet[b].Validator.ActivationEpoch
-		})
-	case "activation-d":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Validator.ActivationEpoch > sortedValidatorSet[b].Validator.ActivationEpoch
-		})
-	case "exit":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Validator.ExitEpoch < sortedValidatorSet[b].Validator.ExitEpoch
+		sort.Slice(sortedIndices, func(a, b int) bool {
+			return sortedIndices[a] > sortedIndices[b]
 		})
-	case "exit-d":
-		sort.Slice(sortedValidatorSet, func(a, b int) bool {
-			return sortedValidatorSet[a].Validator.ExitEpoch > sortedValidatorSet[b].Validator.ExitEpoch
+	case "pubkey", "pubkey-d":
+		pubkeys := make([]phase0.BLSPubKey, validatorSetLen)
+		for i, index := range sortedIndices {
+			record, _ := validatorSet.GetRecord(index)
+			pubkeys[i] = record.Pubkey
+		}
+		sortValidatorIndices(sortedIndices, pubkeys, func(a, b phase0.BLSPubKey) int {
+			return bytes.Compare(a[:], b[:])
+		}, sortOrder == "pubkey-d")
+	case "balance", "balance-d":
+		balances := make([]phase0.Gwei, validatorSetLen)
+		for i, index := range sortedIndices {
+			record, _ := validatorSet.GetRecord(index)
+			balances[i] = record.Balance
+		}
+		sortValidatorIndices(sortedIndices, balances, cmp.Compare[phase0.Gwei], sortOrder == "balance-d")
+	case "activation", "activation-d":
+		activationEpochs := make([]phase0.Epoch, validatorSetLen)
+		for i, index := range sortedIndices {
+			record, _ := validatorSet.GetRecord(index)
+			activationEpochs[i] = record.ActivationEpoch
+		}
+		sortValidatorIndices(sortedIndices, activationEpochs, cmp.Compare[phase0.Epoch], sortOrder == "activation-d")
+	case "exit", "exit-d":
+		exitEpochs := make([]phase0.Epoch, validatorSetLen)
+		for i, index := range sortedIndices {
+			record, _ := validatorSet.GetRecord(index)
+			exitEpochs[i] = record.ExitEpoch
+		}
+		sortValidatorIndices(sortedIndices, exitEpochs, cmp.Compare[phase0.Epoch], sortOrder == "exit-d")
+	case "apy", "apy-d":
+		// validators without apy are always sorted last
+		apyValues := make(map[phase0.ValidatorIndex]float64, validatorSetLen)
+		for _, index := range sortedIndices {
+			if apy, ok := getApy(index); ok {
+				apyValues[index] = apy
+			}
+		}
+		descending := sortOrder == "apy-d"
+		sort.SliceStable(sortedIndices, func(a, b int) bool {
+			apyA, okA := apyValues[sortedIndices[a]]
+			apyB, okB := apyValues[sortedIndices[b]]
+			if okA != okB {
+				return okA
+			}
+			if descending {
+				return apyA > apyB
+			}
+			return apyA < apyB
 		})
 	}
-	validatorSet = sortedValidatorSet
+	validatorIndices = sortedIndices
 	pageData.Sorting = sortOrder
 
 	totalValidatorCount := uint64(validatorSetLen)
+	pageData.FilteredCount = totalValidatorCount
 	if firstValIdx == 0 {
 		pageData.IsDefaultPage = true
 	} else if firstValIdx > totalValidatorCount {
@@ -279,20 +459,28 @@ func buildValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder stri
 	pageData.LastPageValIdx = totalValidatorCount - pageSize
 
 	// get validators
+	services.SetPageBuildProgress(ctx, 75, "loading validator details")
 	lastValIdx := firstValIdx + pageSize
 	if lastValIdx >= totalValidatorCount {
 		lastValIdx = totalValidatorCount
 	}
 	pageData.Validators = make([]*models.ValidatorsPageDataValidator, 0)
 
-	for _, validator := range validatorSet[firstValIdx:lastValIdx] {
-		if validator == nil || validator.Validator == nil {
+	pageIndices := make([]uint64, 0, lastValIdx-firstValIdx)
+	for _, index := range validatorIndices[firstValIdx:lastValIdx] {
+		pageIndices = append(pageIndices, uint64(index))
+	}
+	validatorNames := services.GlobalBeaconService.GetValidatorNames(pageIndices)
+
+	for _, index := range validatorIndices[firstValIdx:lastValIdx] {
+		validator := validatorSet.GetValidator(index)
+		if validator == nil {
 			continue
 		}
 
 		validatorData := &models.ValidatorsPageDataValidator{
 			Index:            uint64(validator.Index),
-			Name:             services.GlobalBeaconService.GetValidatorName(uint64(validator.Index)),
+			Name:             validatorNames[uint64(validator.Index)],
 			PublicKey:        validator.Validator.PublicKey[:],
 			Balance:          uint64(validator.Balance),
 			EffectiveBalance: uint64(validator.Validator.EffectiveBalance),
@@ -316,6 +504,10 @@ func buildValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder stri
 			validatorData.State = validator.Status.String()
 		}
 
+		if pageData.IsHistoric {
+			// the validator liveness is only tracked for recent epochs
+			validatorData.ShowUpcheck = false
+		}
 		if validatorData.ShowUpcheck {
 			validatorData.UpcheckActivity = uint8(services.GlobalBeaconService.GetValidatorLiveness(validator.Index, 3))
 			validatorData.UpcheckMaximum = uint8(3)
@@ -336,12 +528,95 @@ func buildValidatorsPageData(firstValIdx uint64, pageSize uint64, sortOrder stri
 			validatorData.WithdrawAddress = validator.Validator.WithdrawalCredentials[12:]
 		}
 
+		validatorData.Apy, validatorData.HasApy = getApy(validator.Index)
+
 		pageData.Validators = append(pageData.Validators, validatorData)
 	}
 	pageData.ValidatorCount = uint64(len(pageData.Validators))
 	pageData.FirstValidator = firstValIdx
 	pageData.LastValidator = lastValIdx
+	if apyWindow != "7d" {
+		filterArgs.Add("apy", apyWindow)
+	}
 	pageData.FilteredPageLink = fmt.Sprintf("/validators?f&%v&c=%v", filterArgs.Encode(), pageData.PageSize)
 
+	apiArgs := url.Values{}
+	if !pageData.IsDefaultSorting {
+		apiArgs.Add("o", sortOrder)
+	}
+	if firstValIdx > 0 {
+		apiArgs.Add("s", strconv.FormatUint(firstValIdx, 10))
+	}
+	pageData.ApiLink = fmt.Sprintf("/api/v1/validators/filtered?f&%v&c=%v", filterArgs.Encode(), pageData.PageSize)
+	if len(apiArgs) > 0 {
+		pageData.ApiLink += "&" + apiArgs.Encode()
+	}
+
 	return pageData, cacheTime
 }
+
+// sortValidatorIndices sorts the validator indices by the given keys, keys[i] is the sort key of indices[i].
+// the keys are loaded once before sorting, so the validator set is not accessed for every comparison.
+func sortValidatorIndices[K any](indices []phase0.ValidatorIndex, keys []K, compare func(a, b K) int, descending bool) {
+	order := make([]int, len(indices))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		if descending {
+			return compare(keys[b], keys[a])
+		}
+		return compare(keys[a], keys[b])
+	})
+
+	sorted := make([]phase0.ValidatorIndex, len(indices))
+	for i, pos := range order {
+		sorted[i] = indices[pos]
+	}
+	copy(indices, sorted)
+}
+
+// validatorsCsvExportBatchSize is the number of validators loaded per batch for the csv export.
+// the validator set is filtered & sorted in memory for every batch, so the batches are larger than for the db backed pages.
+const validatorsCsvExportBatchSize = 1000
+
+// exportValidatorsCsv streams all validators matching the page filters in the selected sort order as csv file.
+func exportValidatorsCsv(w http.ResponseWriter, r *http.Request, pageArgs *validatorsPageArgs) {
+	header := []string{"index", "name", "pubkey", "balance", "effective_balance", "state", "activation_epoch", "exit_epoch", "withdrawal_address", "apy"}
+	writeCsvExport(w, r, "validators", header, func(pageIdx uint64) ([][]string, bool) {
+		firstIdx := pageIdx * validatorsCsvExportBatchSize
+		pageData, _ := buildValidatorsPageData(r.Context(), firstIdx, validatorsCsvExportBatchSize, pageArgs.SortOrder, pageArgs.ApyWindow, pageArgs.FilterPubKey, pageArgs.FilterIndex, pageArgs.FilterName, pageArgs.FilterStatus, pageArgs.FilterExpr, pageArgs.FilterTag, pageArgs.AtEpoch)
+		rows := make([][]string, 0, len(pageData.Validators))
+		for _, validator := range pageData.Validators {
+			activationEpoch := ""
+			if validator.ShowActivation {
+				activationEpoch = csvUint(validator.ActivationEpoch)
+			}
+			exitEpoch := ""
+			if validator.ShowExit {
+				exitEpoch = csvUint(validator.ExitEpoch)
+			}
+			withdrawAddress := ""
+			if validator.ShowWithdrawAddress {
+				withdrawAddress = csvHex(validator.WithdrawAddress)
+			}
+			apy := ""
+			if validator.HasApy {
+				apy = strconv.FormatFloat(validator.Apy, 'f', 4, 64)
+			}
+			rows = append(rows, []string{
+				csvUint(validator.Index),
+				csvText(validator.Name),
+				csvHex(validator.PublicKey),
+				csvUint(validator.Balance),
+				csvUint(validator.EffectiveBalance),
+				validator.State,
+				activationEpoch,
+				exitEpoch,
+				withdrawAddress,
+				apy,
+			})
+		}
+		return rows, firstIdx+validatorsCsvExportBatchSize < pageData.FilteredCount
+	})
+}
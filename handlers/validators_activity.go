@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -60,7 +61,7 @@ func ValidatorsActivity(w http.ResponseWriter, r *http.Request) {
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
 	if pageError == nil {
-		data.Data, pageError = getValidatorsActivityPageData(pageIdx, pageSize, sortOrder, groupBy)
+		data.Data, pageError = getValidatorsActivityPageData(r.Context(), pageIdx, pageSize, sortOrder, groupBy)
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -72,12 +73,12 @@ func ValidatorsActivity(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getValidatorsActivityPageData(pageIdx uint64, pageSize uint64, sortOrder string, groupBy uint64) (*models.ValidatorsActivityPageData, error) {
+func getValidatorsActivityPageData(ctx context.Context, pageIdx uint64, pageSize uint64, sortOrder string, groupBy uint64) (*models.ValidatorsActivityPageData, error) {
 	pageData := &models.ValidatorsActivityPageData{}
 	pageCacheKey := fmt.Sprintf("validators_activiy:%v:%v:%v:%v", pageIdx, pageSize, sortOrder, groupBy)
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(processingPage *services.FrontendCacheProcessingPage) interface{} {
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(processingPage *services.FrontendCacheProcessingPage) interface{} {
 		processingPage.CacheTimeout = 10 * time.Second
-		return buildValidatorsActivityPageData(pageIdx, pageSize, sortOrder, groupBy)
+		return buildValidatorsActivityPageData(processingPage.CallCtx, pageIdx, pageSize, sortOrder, groupBy)
 	})
 	if pageErr == nil && pageRes != nil {
 		resData, resOk := pageRes.(*models.ValidatorsActivityPageData)
@@ -89,7 +90,7 @@ func getValidatorsActivityPageData(pageIdx uint64, pageSize uint64, sortOrder st
 	return pageData, pageErr
 }
 
-func buildValidatorsActivityPageData(pageIdx uint64, pageSize uint64, sortOrder string, groupBy uint64) *models.ValidatorsActivityPageData {
+func buildValidatorsActivityPageData(ctx context.Context, pageIdx uint64, pageSize uint64, sortOrder string, groupBy uint64) *models.ValidatorsActivityPageData {
 	filterArgs := url.Values{}
 	filterArgs.Add("group", fmt.Sprintf("%v", groupBy))
 
@@ -114,9 +115,16 @@ func buildValidatorsActivityPageData(pageIdx uint64, pageSize uint64, sortOrder
 
 	// group validators
 	validatorGroupMap := map[string]*models.ValidatorsActiviyPageDataGroup{}
+	validatorGroupIndexes := map[string][]phase0.ValidatorIndex{}
 	validatorSet := services.GlobalBeaconService.GetCachedValidatorSet(false)
 
-	for vIdx, validator := range validatorSet {
+	for vIdx := 0; vIdx < validatorSet.Len(); vIdx++ {
+		record, found := validatorSet.GetRecord(phase0.ValidatorIndex(vIdx))
+		if !found {
+			continue
+		}
+		status := record.Status
+
 		var groupKey string
 		var groupName string
 
@@ -150,8 +158,9 @@ func buildValidatorsActivityPageData(pageIdx uint64, pageSize uint64, sortOrder
 		}
 
 		validatorGroup.Validators++
+		validatorGroupIndexes[groupKey] = append(validatorGroupIndexes[groupKey], phase0.ValidatorIndex(vIdx))
 
-		statusStr := validator.Status.String()
+		statusStr := status.String()
 		if strings.HasPrefix(statusStr, "active_") {
 			validatorGroup.Activated++
 
@@ -169,6 +178,20 @@ func buildValidatorsActivityPageData(pageIdx uint64, pageSize uint64, sortOrder
 		}
 	}
 
+	// proposal luck of the groups within the longest luck window
+	if luckWindows := services.GlobalBeaconService.GetValidatorLuckWindows(); len(luckWindows) > 0 {
+		luckWindow := luckWindows[len(luckWindows)-1]
+		pageData.LuckWindow = luckWindow.Name
+		for groupKey, validatorGroup := range validatorGroupMap {
+			if luck, hasLuck := services.GlobalBeaconService.GetValidatorProposalLuck(validatorGroupIndexes[groupKey], luckWindow.Name); hasLuck {
+				validatorGroup.HasLuck = true
+				validatorGroup.Proposals = luck.Proposals
+				validatorGroup.ExpectedProposals = luck.Expected
+				validatorGroup.Luck = luck.Luck
+			}
+		}
+	}
+
 	// sort / filter groups
 	validatorGroups := maps.Values(validatorGroupMap)
 	switch sortOrder {
@@ -229,6 +252,14 @@ func buildValidatorsActivityPageData(pageIdx uint64, pageSize uint64, sortOrder
 		sort.Slice(validatorGroups, func(a, b int) bool {
 			return validatorGroups[a].Slashed > validatorGroups[b].Slashed
 		})
+	case "luck":
+		sort.Slice(validatorGroups, func(a, b int) bool {
+			return validatorGroups[a].Luck < validatorGroups[b].Luck
+		})
+	case "luck-d":
+		sort.Slice(validatorGroups, func(a, b int) bool {
+			return validatorGroups[a].Luck > validatorGroups[b].Luck
+		})
 	}
 
 	groupCount := uint64(len(validatorGroups))
@@ -0,0 +1,464 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/services"
+)
+
+// validatorFilterExpr is a parsed validators page filter expression.
+//
+// Syntax:
+//
+//	expr      := andExpr { "or" andExpr }
+//	andExpr   := unaryExpr { "and" unaryExpr }
+//	unaryExpr := "not" unaryExpr | "(" expr ")" | condition
+//	condition := field [ "not" ] operator value
+//	operator  := "=" | "!=" | "<" | "<=" | ">" | ">=" | "contains" | "startswith" | "in"
+//	value     := word | "quoted string" | "[" value { "," value } "]"
+//
+// Example: status in [active, exiting] and name not contains "lighthouse"
+type validatorFilterExpr interface {
+	match(record *beacon.ValidatorRecord) bool
+}
+
+type validatorFilterFieldType int
+
+const (
+	validatorFilterString validatorFilterFieldType = iota
+	validatorFilterNumber
+	validatorFilterGwei
+)
+
+var validatorFilterFields = map[string]validatorFilterFieldType{
+	"index":              validatorFilterNumber,
+	"pubkey":             validatorFilterString,
+	"name":               validatorFilterString,
+	"status":             validatorFilterString,
+	"balance":            validatorFilterGwei,
+	"effective_balance":  validatorFilterGwei,
+	"activation_epoch":   validatorFilterNumber,
+	"exit_epoch":         validatorFilterNumber,
+	"credentials":        validatorFilterString,
+	"withdrawal_address": validatorFilterString,
+}
+
+// validatorFilterStatusAliases maps the short state names shown on the validators page to the matching validator states.
+var validatorFilterStatusAliases = map[string][]v1.ValidatorState{
+	"pending":    {v1.ValidatorStatePendingInitialized, v1.ValidatorStatePendingQueued},
+	"active":     {v1.ValidatorStateActiveOngoing},
+	"exiting":    {v1.ValidatorStateActiveExiting},
+	"slashed":    {v1.ValidatorStateActiveSlashed, v1.ValidatorStateExitedSlashed},
+	"exited":     {v1.ValidatorStateExitedUnslashed},
+	"withdrawal": {v1.ValidatorStateWithdrawalPossible, v1.ValidatorStateWithdrawalDone},
+}
+
+type validatorFilterAnd []validatorFilterExpr
+
+func (expr validatorFilterAnd) match(record *beacon.ValidatorRecord) bool {
+	for _, subExpr := range expr {
+		if !subExpr.match(record) {
+			return false
+		}
+	}
+	return true
+}
+
+type validatorFilterOr []validatorFilterExpr
+
+func (expr validatorFilterOr) match(record *beacon.ValidatorRecord) bool {
+	for _, subExpr := range expr {
+		if subExpr.match(record) {
+			return true
+		}
+	}
+	return false
+}
+
+type validatorFilterNot struct {
+	expr validatorFilterExpr
+}
+
+func (expr *validatorFilterNot) match(record *beacon.ValidatorRecord) bool {
+	return !expr.expr.match(record)
+}
+
+type validatorFilterCondition struct {
+	field     string
+	fieldType validatorFilterFieldType
+	operator  string
+	negate    bool
+	strValues []string
+	numValues []uint64
+}
+
+func (cond *validatorFilterCondition) match(record *beacon.ValidatorRecord) bool {
+	var res bool
+	if cond.fieldType == validatorFilterString {
+		res = cond.matchString(record)
+	} else {
+		res = cond.matchNumber(record)
+	}
+	return res != cond.negate
+}
+
+func (cond *validatorFilterCondition) matchString(record *beacon.ValidatorRecord) bool {
+	if cond.field == "status" && (cond.operator == "=" || cond.operator == "!=" || cond.operator == "in") {
+		matched := false
+		for _, value := range cond.strValues {
+			if validatorFilterMatchStatus(record.Status, value) {
+				matched = true
+				break
+			}
+		}
+		return matched != (cond.operator == "!=")
+	}
+
+	var fieldValue string
+	switch cond.field {
+	case "pubkey":
+		fieldValue = fmt.Sprintf("0x%x", record.Pubkey[:])
+	case "name":
+		fieldValue = strings.ToLower(services.GlobalBeaconService.GetValidatorName(uint64(record.Index)))
+	case "status":
+		fieldValue = record.Status.String()
+	case "credentials":
+		fieldValue = fmt.Sprintf("0x%02x", record.WithdrawalCredentials[0])
+	case "withdrawal_address":
+		if record.WithdrawalCredentials[0] == 0x00 {
+			return false
+		}
+		fieldValue = fmt.Sprintf("0x%x", record.WithdrawalCredentials[12:])
+	}
+
+	switch cond.operator {
+	case "=", "in":
+		for _, value := range cond.strValues {
+			if fieldValue == value {
+				return true
+			}
+		}
+		return false
+	case "!=":
+		return fieldValue != cond.strValues[0]
+	case "contains":
+		return strings.Contains(fieldValue, cond.strValues[0])
+	case "startswith":
+		return strings.HasPrefix(fieldValue, cond.strValues[0])
+	}
+	return false
+}
+
+func (cond *validatorFilterCondition) matchNumber(record *beacon.ValidatorRecord) bool {
+	var fieldValue uint64
+	switch cond.field {
+	case "index":
+		fieldValue = uint64(record.Index)
+	case "balance":
+		fieldValue = uint64(record.Balance)
+	case "effective_balance":
+		fieldValue = uint64(record.EffectiveBalance)
+	case "activation_epoch":
+		fieldValue = uint64(record.ActivationEpoch)
+	case "exit_epoch":
+		fieldValue = uint64(record.ExitEpoch)
+	}
+
+	switch cond.operator {
+	case "=", "in":
+		for _, value := range cond.numValues {
+			if fieldValue == value {
+				return true
+			}
+		}
+		return false
+	case "!=":
+		return fieldValue != cond.numValues[0]
+	case "<":
+		return fieldValue < cond.numValues[0]
+	case "<=":
+		return fieldValue <= cond.numValues[0]
+	case ">":
+		return fieldValue > cond.numValues[0]
+	case ">=":
+		return fieldValue >= cond.numValues[0]
+	}
+	return false
+}
+
+func validatorFilterMatchStatus(status v1.ValidatorState, value string) bool {
+	if status.String() == value {
+		return true
+	}
+	for _, aliasStatus := range validatorFilterStatusAliases[value] {
+		if status == aliasStatus {
+			return true
+		}
+	}
+	return false
+}
+
+type validatorFilterToken struct {
+	value  string
+	quoted bool
+}
+
+type validatorFilterParser struct {
+	tokens []validatorFilterToken
+	pos    int
+}
+
+// parseValidatorFilterExpr parses a validators page filter expression.
+func parseValidatorFilterExpr(expr string) (validatorFilterExpr, error) {
+	tokens, err := tokenizeValidatorFilterExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty filter expression")
+	}
+
+	parser := &validatorFilterParser{
+		tokens: tokens,
+	}
+	res, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if parser.pos < len(parser.tokens) {
+		return nil, fmt.Errorf("unexpected '%v' at end of filter expression", parser.tokens[parser.pos].value)
+	}
+	return res, nil
+}
+
+func tokenizeValidatorFilterExpr(expr string) ([]validatorFilterToken, error) {
+	tokens := []validatorFilterToken{}
+	for pos := 0; pos < len(expr); {
+		char := expr[pos]
+		switch {
+		case char == ' ' || char == '\t' || char == '\n' || char == '\r':
+			pos++
+		case char == '(' || char == ')' || char == '[' || char == ']' || char == ',':
+			tokens = append(tokens, validatorFilterToken{value: string(char)})
+			pos++
+		case char == '"' || char == '\'':
+			end := strings.IndexByte(expr[pos+1:], char)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string at position %v", pos)
+			}
+			tokens = append(tokens, validatorFilterToken{value: expr[pos+1 : pos+1+end], quoted: true})
+			pos += end + 2
+		case char == '=' || char == '!' || char == '<' || char == '>':
+			if pos+1 < len(expr) && expr[pos+1] == '=' {
+				tokens = append(tokens, validatorFilterToken{value: expr[pos : pos+2]})
+				pos += 2
+			} else if char == '!' {
+				return nil, fmt.Errorf("unexpected '!' at position %v", pos)
+			} else {
+				tokens = append(tokens, validatorFilterToken{value: string(char)})
+				pos++
+			}
+		default:
+			end := pos
+			for end < len(expr) && !strings.ContainsRune(" \t\n\r()[],\"'=!<>", rune(expr[end])) {
+				end++
+			}
+			tokens = append(tokens, validatorFilterToken{value: expr[pos:end]})
+			pos = end
+		}
+	}
+	return tokens, nil
+}
+
+func (parser *validatorFilterParser) peek() *validatorFilterToken {
+	if parser.pos >= len(parser.tokens) {
+		return nil
+	}
+	return &parser.tokens[parser.pos]
+}
+
+// peekKeyword checks if the next token is the given (unquoted, case insensitive) keyword and consumes it.
+func (parser *validatorFilterParser) peekKeyword(keyword string) bool {
+	token := parser.peek()
+	if token == nil || token.quoted || !strings.EqualFold(token.value, keyword) {
+		return false
+	}
+	parser.pos++
+	return true
+}
+
+func (parser *validatorFilterParser) parseOr() (validatorFilterExpr, error) {
+	exprs := validatorFilterOr{}
+	for {
+		expr, err := parser.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+		if !parser.peekKeyword("or") {
+			break
+		}
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return exprs, nil
+}
+
+func (parser *validatorFilterParser) parseAnd() (validatorFilterExpr, error) {
+	exprs := validatorFilterAnd{}
+	for {
+		expr, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+		if !parser.peekKeyword("and") {
+			break
+		}
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return exprs, nil
+}
+
+func (parser *validatorFilterParser) parseUnary() (validatorFilterExpr, error) {
+	if parser.peekKeyword("not") {
+		expr, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &validatorFilterNot{expr: expr}, nil
+	}
+	if parser.peekKeyword("(") {
+		expr, err := parser.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !parser.peekKeyword(")") {
+			return nil, fmt.Errorf("missing closing ')'")
+		}
+		return expr, nil
+	}
+	return parser.parseCondition()
+}
+
+func (parser *validatorFilterParser) parseCondition() (validatorFilterExpr, error) {
+	token := parser.peek()
+	if token == nil {
+		return nil, fmt.Errorf("unexpected end of filter expression")
+	}
+	field := strings.ToLower(token.value)
+	fieldType, isField := validatorFilterFields[field]
+	if token.quoted || !isField {
+		return nil, fmt.Errorf("unknown filter field '%v'", token.value)
+	}
+	parser.pos++
+
+	cond := &validatorFilterCondition{
+		field:     field,
+		fieldType: fieldType,
+	}
+	cond.negate = parser.peekKeyword("not")
+
+	token = parser.peek()
+	if token == nil || token.quoted {
+		return nil, fmt.Errorf("missing operator for field '%v'", field)
+	}
+	cond.operator = strings.ToLower(token.value)
+	switch cond.operator {
+	case "=", "!=", "in":
+	case "<", "<=", ">", ">=":
+		if fieldType == validatorFilterString {
+			return nil, fmt.Errorf("operator '%v' not supported for field '%v'", cond.operator, field)
+		}
+	case "contains", "startswith":
+		if fieldType != validatorFilterString {
+			return nil, fmt.Errorf("operator '%v' not supported for field '%v'", cond.operator, field)
+		}
+	default:
+		return nil, fmt.Errorf("unknown operator '%v' for field '%v'", token.value, field)
+	}
+	parser.pos++
+
+	values := []string{}
+	if cond.operator == "in" {
+		if !parser.peekKeyword("[") {
+			return nil, fmt.Errorf("expected '[' after '%v in'", field)
+		}
+		for {
+			value, err := parser.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+			if parser.peekKeyword("]") {
+				break
+			}
+			if !parser.peekKeyword(",") {
+				return nil, fmt.Errorf("expected ',' or ']' in value list of '%v'", field)
+			}
+		}
+	} else {
+		value, err := parser.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	for _, value := range values {
+		switch fieldType {
+		case validatorFilterString:
+			value = strings.ToLower(value)
+			if field == "pubkey" || field == "withdrawal_address" || field == "credentials" {
+				hexValue := strings.TrimPrefix(value, "0x")
+				if cond.operator == "contains" {
+					value = hexValue
+				} else {
+					value = "0x" + hexValue
+				}
+				if cond.operator != "contains" && cond.operator != "startswith" {
+					if _, err := hex.DecodeString(hexValue); err != nil {
+						return nil, fmt.Errorf("invalid hex value '%v' for field '%v'", value, field)
+					}
+				}
+			}
+			cond.strValues = append(cond.strValues, value)
+		case validatorFilterNumber:
+			numValue, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number '%v' for field '%v'", value, field)
+			}
+			cond.numValues = append(cond.numValues, numValue)
+		case validatorFilterGwei:
+			// balances are given in ETH
+			ethValue, err := strconv.ParseFloat(value, 64)
+			if err != nil || ethValue < 0 {
+				return nil, fmt.Errorf("invalid ETH amount '%v' for field '%v'", value, field)
+			}
+			cond.numValues = append(cond.numValues, uint64(ethValue*1e9))
+		}
+	}
+
+	return cond, nil
+}
+
+func (parser *validatorFilterParser) parseValue() (string, error) {
+	token := parser.peek()
+	if token == nil {
+		return "", fmt.Errorf("unexpected end of filter expression")
+	}
+	if !token.quoted && strings.ContainsAny(token.value, "()[],=!<>") {
+		return "", fmt.Errorf("unexpected '%v', expected a value", token.value)
+	}
+	parser.pos++
+	return token.value, nil
+}
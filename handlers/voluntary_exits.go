@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -68,10 +69,18 @@ func VoluntaryExits(w http.ResponseWriter, r *http.Request) {
 	} else {
 		withOrphaned = 1
 	}
+	if isCsvExport(r) {
+		writeCsvExport(w, r, "voluntary_exits", voluntaryExitsCsvHeader, func(pageIdx uint64) ([][]string, bool) {
+			pageData := buildFilteredVoluntaryExitsPageData(r.Context(), pageIdx+1, csvExportPageSize, minSlot, maxSlot, minIndex, maxIndex, vname, uint8(withOrphaned))
+			return getVoluntaryExitsCsvRows(pageData), pageData.NextPageIndex > 0
+		})
+		return
+	}
+
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
 	if pageError == nil {
-		data.Data, pageError = getFilteredVoluntaryExitsPageData(pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, uint8(withOrphaned))
+		data.Data, pageError = getFilteredVoluntaryExitsPageData(r.Context(), pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, uint8(withOrphaned))
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -83,11 +92,11 @@ func VoluntaryExits(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getFilteredVoluntaryExitsPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, withOrphaned uint8) (*models.VoluntaryExitsPageData, error) {
+func getFilteredVoluntaryExitsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, withOrphaned uint8) (*models.VoluntaryExitsPageData, error) {
 	pageData := &models.VoluntaryExitsPageData{}
 	pageCacheKey := fmt.Sprintf("voluntary_exits:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, withOrphaned)
-	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
-		return buildFilteredVoluntaryExitsPageData(pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, withOrphaned)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(ctx, pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		return buildFilteredVoluntaryExitsPageData(pageCall.CallCtx, pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, withOrphaned)
 	})
 	if pageErr == nil && pageRes != nil {
 		resData, resOk := pageRes.(*models.VoluntaryExitsPageData)
@@ -99,7 +108,7 @@ func getFilteredVoluntaryExitsPageData(pageIdx uint64, pageSize uint64, minSlot
 	return pageData, pageErr
 }
 
-func buildFilteredVoluntaryExitsPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, withOrphaned uint8) *models.VoluntaryExitsPageData {
+func buildFilteredVoluntaryExitsPageData(ctx context.Context, pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, withOrphaned uint8) *models.VoluntaryExitsPageData {
 	filterArgs := url.Values{}
 	if minSlot != 0 {
 		filterArgs.Add("f.mins", fmt.Sprintf("%v", minSlot))
@@ -153,7 +162,7 @@ func buildFilteredVoluntaryExitsPageData(pageIdx uint64, pageSize uint64, minSlo
 		WithOrphaned:  withOrphaned,
 	}
 
-	dbVoluntaryExits, totalRows := services.GlobalBeaconService.GetVoluntaryExitsByFilter(voluntaryExitFilter, pageIdx-1, uint32(pageSize))
+	dbVoluntaryExits, totalRows := services.GlobalBeaconService.GetVoluntaryExitsByFilter(ctx, voluntaryExitFilter, pageIdx-1, uint32(pageSize))
 
 	chainState := services.GlobalBeaconService.GetChainState()
 
@@ -225,3 +234,22 @@ func buildFilteredVoluntaryExitsPageData(pageIdx uint64, pageSize uint64, minSlo
 
 	return pageData
 }
+
+var voluntaryExitsCsvHeader = []string{"slot", "time", "orphaned", "validator_index", "validator_name", "pubkey", "withdrawal_credentials", "validator_status"}
+
+func getVoluntaryExitsCsvRows(pageData *models.VoluntaryExitsPageData) [][]string {
+	rows := make([][]string, 0, len(pageData.VoluntaryExits))
+	for _, exit := range pageData.VoluntaryExits {
+		rows = append(rows, []string{
+			csvUint(exit.SlotNumber),
+			csvTime(exit.Time),
+			csvBool(exit.Orphaned),
+			csvUint(exit.ValidatorIndex),
+			csvText(exit.ValidatorName),
+			csvHex(exit.PublicKey),
+			csvHex(exit.WithdrawalCreds),
+			exit.ValidatorStatus,
+		})
+	}
+	return rows
+}
@@ -0,0 +1,110 @@
+package beacon
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+)
+
+// maxSlotAnomalies is the number of recent slot anomalies kept in memory.
+const maxSlotAnomalies = 200
+
+// slotClockDisparity is the tolerated difference between the local wall clock and the slot time (MAXIMUM_GOSSIP_CLOCK_DISPARITY).
+const slotClockDisparity = 500 * time.Millisecond
+
+type SlotAnomalyType uint8
+
+const (
+	SlotAnomalyTimestampMismatch SlotAnomalyType = 1 // execution timestamp does not match the slot time
+	SlotAnomalyFutureSlot        SlotAnomalyType = 2 // block received before the start of its slot
+)
+
+// SlotAnomaly represents a block whose timing disagrees with the expected slot time.
+type SlotAnomaly struct {
+	Type          SlotAnomalyType
+	Slot          phase0.Slot
+	Root          phase0.Root
+	SlotTime      time.Time
+	ExecutionTime time.Time
+	DetectedAt    time.Time
+	Description   string
+}
+
+// GetExecutionTimeAnomaly checks the execution timestamp of a block against the expected slot time.
+// returns nil if the timestamp matches or the block has no execution payload.
+func GetExecutionTimeAnomaly(chainState *consensus.ChainState, slot phase0.Slot, executionTime uint64) *SlotAnomaly {
+	if executionTime == 0 {
+		return nil
+	}
+
+	slotTime := chainState.SlotToTime(slot)
+	if uint64(slotTime.Unix()) == executionTime {
+		return nil
+	}
+
+	execTime := time.Unix(int64(executionTime), 0)
+	return &SlotAnomaly{
+		Type:          SlotAnomalyTimestampMismatch,
+		Slot:          slot,
+		SlotTime:      slotTime,
+		ExecutionTime: execTime,
+		Description:   fmt.Sprintf("execution timestamp %v differs from slot time %v by %v", executionTime, slotTime.Unix(), execTime.Sub(slotTime)),
+	}
+}
+
+// checkSlotTimeAnomalies checks a newly received block for timing anomalies and records them.
+func (indexer *Indexer) checkSlotTimeAnomalies(block *Block) {
+	chainState := indexer.consensusPool.GetChainState()
+	now := time.Now()
+	anomalies := []*SlotAnomaly{}
+
+	if blockIndex := block.GetBlockIndex(); blockIndex != nil {
+		if anomaly := GetExecutionTimeAnomaly(chainState, block.Slot, blockIndex.ExecutionTime); anomaly != nil {
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+
+	if slotTime := chainState.SlotToTime(block.Slot); slotTime.After(now.Add(slotClockDisparity)) {
+		anomalies = append(anomalies, &SlotAnomaly{
+			Type:        SlotAnomalyFutureSlot,
+			Slot:        block.Slot,
+			SlotTime:    slotTime,
+			Description: fmt.Sprintf("block received %v before the start of its slot", slotTime.Sub(now)),
+		})
+	}
+
+	if len(anomalies) == 0 {
+		return
+	}
+
+	indexer.slotAnomaliesMutex.Lock()
+	defer indexer.slotAnomaliesMutex.Unlock()
+
+	for _, anomaly := range anomalies {
+		anomaly.Root = block.Root
+		anomaly.DetectedAt = now
+
+		indexer.logger.Warnf("slot time anomaly in block %v [0x%x]: %v", block.Slot, block.Root[:], anomaly.Description)
+		indexer.slotAnomalies = append(indexer.slotAnomalies, anomaly)
+	}
+
+	if len(indexer.slotAnomalies) > maxSlotAnomalies {
+		indexer.slotAnomalies = indexer.slotAnomalies[len(indexer.slotAnomalies)-maxSlotAnomalies:]
+	}
+}
+
+// GetSlotAnomalies returns the recently detected slot anomalies, newest first.
+func (indexer *Indexer) GetSlotAnomalies() []*SlotAnomaly {
+	indexer.slotAnomaliesMutex.Lock()
+	defer indexer.slotAnomaliesMutex.Unlock()
+
+	anomalies := make([]*SlotAnomaly, len(indexer.slotAnomalies))
+	for i, anomaly := range indexer.slotAnomalies {
+		anomalies[len(anomalies)-i-1] = anomaly
+	}
+
+	return anomalies
+}
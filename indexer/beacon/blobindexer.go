@@ -0,0 +1,189 @@
+package beacon
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// blobIndexerMaxRetries is the max. number of attempts to fetch the blob sidecars of a block.
+const blobIndexerMaxRetries = 5
+
+// blobIndexerRetryDelay is the delay between the attempts to fetch the blob sidecars of a block.
+const blobIndexerRetryDelay = 12 * time.Second
+
+// blobIndexer fetches the blob sidecars of new blocks from the beacon nodes and persists the sidecar metadata.
+// the blob contents are written to the blob storage if one is configured.
+type blobIndexer struct {
+	indexer       *Indexer
+	logger        logrus.FieldLogger
+	storage       BlobStorage
+	pendingBlocks []*blobIndexerBlock
+}
+
+type blobIndexerBlock struct {
+	block    *Block
+	attempts int
+	nextTry  time.Time
+}
+
+// newBlobIndexer creates the blob indexer, returns nil if the blob indexer is disabled.
+func newBlobIndexer(indexer *Indexer, logger logrus.FieldLogger) *blobIndexer {
+	if !utils.Config().BlobIndexer.Enabled {
+		return nil
+	}
+
+	storage, err := newBlobStorage(utils.Config().BlobIndexer.Storage, utils.Config().BlobIndexer.StoragePath)
+	if err != nil {
+		logger.Errorf("failed initializing blob storage, blob contents will not be stored: %v", err)
+	}
+
+	return &blobIndexer{
+		indexer: indexer,
+		logger:  logger,
+		storage: storage,
+	}
+}
+
+func (bi *blobIndexer) runBlobIndexer() {
+	defer utils.HandleSubroutinePanic("blobIndexer.runBlobIndexer")
+
+	blockSubscription := bi.indexer.SubscribeBlockEvent(100)
+	defer blockSubscription.Unsubscribe()
+
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case block := <-blockSubscription.Channel():
+			bi.pendingBlocks = append(bi.pendingBlocks, &blobIndexerBlock{
+				block: block,
+			})
+		case <-ticker.C:
+			bi.processPendingBlocks()
+		}
+	}
+}
+
+func (bi *blobIndexer) processPendingBlocks() {
+	now := time.Now()
+	pendingBlocks := make([]*blobIndexerBlock, 0, len(bi.pendingBlocks))
+
+	for _, pendingBlock := range bi.pendingBlocks {
+		if pendingBlock.nextTry.After(now) {
+			pendingBlocks = append(pendingBlocks, pendingBlock)
+			continue
+		}
+
+		err := bi.indexBlockBlobs(pendingBlock.block)
+		if err == nil {
+			continue
+		}
+
+		pendingBlock.attempts++
+		if pendingBlock.attempts >= blobIndexerMaxRetries {
+			bi.logger.Warnf("failed indexing blobs of block %v [%v]: %v", pendingBlock.block.Slot, pendingBlock.block.Root.String(), err)
+			continue
+		}
+
+		bi.logger.Debugf("failed indexing blobs of block %v [%v] (attempt %v): %v", pendingBlock.block.Slot, pendingBlock.block.Root.String(), pendingBlock.attempts, err)
+		pendingBlock.nextTry = now.Add(blobIndexerRetryDelay)
+		pendingBlocks = append(pendingBlocks, pendingBlock)
+	}
+
+	bi.pendingBlocks = pendingBlocks
+}
+
+// indexBlockBlobs fetches and persists the blob sidecars of a block, blocks without blobs are skipped.
+func (bi *blobIndexer) indexBlockBlobs(block *Block) error {
+	blockBody := block.GetBlock()
+	header := block.GetHeader()
+	if blockBody == nil || header == nil {
+		// block body has already been pruned from the cache
+		return nil
+	}
+
+	commitments, err := blockBody.BlobKZGCommitments()
+	if err != nil || len(commitments) == 0 {
+		// pre-deneb block or block without blobs
+		return nil
+	}
+
+	client := bi.indexer.GetReadyClientByBlockRoot(block.Root, false)
+	if client == nil {
+		seenBy := block.GetSeenBy()
+		if len(seenBy) == 0 {
+			return fmt.Errorf("no client available")
+		}
+		client = seenBy[0]
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	blobSidecars, err := client.GetClient().GetRPCClient().GetBlobSidecarsByBlockroot(ctx, block.Root[:])
+	if err != nil {
+		return fmt.Errorf("failed loading blob sidecars from %v: %v", client.GetClient().GetName(), err)
+	}
+	if len(blobSidecars) != len(commitments) {
+		return fmt.Errorf("unexpected number of blob sidecars from %v (got %v, expected %v)", client.GetClient().GetName(), len(blobSidecars), len(commitments))
+	}
+
+	dbSidecars := make([]*dbtypes.BlobSidecar, 0, len(blobSidecars))
+	for _, blobSidecar := range blobSidecars {
+		blobData := TrimBlobData(blobSidecar.Blob[:])
+		versionedHash := sha256.Sum256(blobSidecar.KZGCommitment[:])
+		versionedHash[0] = 0x01 // VERSIONED_HASH_VERSION_KZG
+
+		dbSidecar := &dbtypes.BlobSidecar{
+			BlockRoot:     block.Root[:],
+			BlobIndex:     uint64(blobSidecar.Index),
+			Slot:          uint64(block.Slot),
+			Proposer:      uint64(header.Message.ProposerIndex),
+			Commitment:    blobSidecar.KZGCommitment[:],
+			Proof:         blobSidecar.KZGProof[:],
+			VersionedHash: versionedHash[:],
+			Size:          uint32(len(blobData)),
+		}
+
+		if bi.storage != nil {
+			if err := bi.storage.StoreBlob(dbSidecar.Commitment, blobData); err != nil {
+				bi.logger.Warnf("failed storing blob %v of block %v: %v", blobSidecar.Index, block.Slot, err)
+			} else {
+				dbSidecar.Stored = true
+			}
+		}
+
+		dbSidecars = append(dbSidecars, dbSidecar)
+	}
+
+	return db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertBlobSidecars(dbSidecars, tx)
+	})
+}
+
+// GetBlobStorage returns the blob storage of the blob indexer, returns nil if blob contents are not stored.
+func (indexer *Indexer) GetBlobStorage() BlobStorage {
+	if indexer.blobIndexer == nil {
+		return nil
+	}
+	return indexer.blobIndexer.storage
+}
+
+// TrimBlobData strips the trailing zero bytes of a blob.
+func TrimBlobData(blob []byte) []byte {
+	size := len(blob)
+	for size > 0 && blob[size-1] == 0 {
+		size--
+	}
+	return blob[:size]
+}
@@ -0,0 +1,88 @@
+package beacon
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+)
+
+// BlobStorage persists the contents of indexed blobs, blobs are referenced by their kzg commitment.
+// the blob indexer stores blobs without their trailing zero bytes (see TrimBlobData).
+type BlobStorage interface {
+	StoreBlob(commitment []byte, data []byte) error
+	LoadBlob(commitment []byte) ([]byte, error)
+}
+
+// newBlobStorage creates the blob storage for the configured storage type, returns nil if blob contents should not be stored.
+func newBlobStorage(storageType string, storagePath string) (BlobStorage, error) {
+	switch storageType {
+	case "":
+		return nil, nil
+	case "db":
+		return &dbBlobStorage{}, nil
+	case "fs":
+		if storagePath == "" {
+			return nil, fmt.Errorf("missing storage path")
+		}
+		if err := os.MkdirAll(storagePath, 0o755); err != nil {
+			return nil, fmt.Errorf("failed creating storage path: %v", err)
+		}
+		return &fsBlobStorage{basePath: storagePath}, nil
+	default:
+		return nil, fmt.Errorf("unknown blob storage type '%v'", storageType)
+	}
+}
+
+// dbBlobStorage stores the blob contents in the blob_contents table.
+type dbBlobStorage struct{}
+
+func (s *dbBlobStorage) StoreBlob(commitment []byte, data []byte) error {
+	return db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertBlobContents(commitment, data, tx)
+	})
+}
+
+func (s *dbBlobStorage) LoadBlob(commitment []byte) ([]byte, error) {
+	return db.GetBlobContents(commitment)
+}
+
+// fsBlobStorage stores the blob contents as files in a local directory.
+// files are grouped in sub directories by the first commitment byte to keep the directory sizes reasonable.
+type fsBlobStorage struct {
+	basePath string
+}
+
+func (s *fsBlobStorage) getBlobPath(commitment []byte) string {
+	commitmentHex := hex.EncodeToString(commitment)
+	return filepath.Join(s.basePath, commitmentHex[0:2], commitmentHex+".blob")
+}
+
+func (s *fsBlobStorage) StoreBlob(commitment []byte, data []byte) error {
+	if len(commitment) == 0 {
+		return fmt.Errorf("empty commitment")
+	}
+
+	blobPath := s.getBlobPath(commitment)
+	if err := os.MkdirAll(filepath.Dir(blobPath), 0o755); err != nil {
+		return err
+	}
+
+	// write to a temporary file first, so readers never see partially written blobs
+	tmpPath := blobPath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, blobPath)
+}
+
+func (s *fsBlobStorage) LoadBlob(commitment []byte) ([]byte, error) {
+	if len(commitment) == 0 {
+		return nil, fmt.Errorf("empty commitment")
+	}
+	return os.ReadFile(s.getBlobPath(commitment))
+}
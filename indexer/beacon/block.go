@@ -30,8 +30,9 @@ type Block struct {
 	blockChan         chan bool
 	block             *spec.VersionedSignedBeaconBlock
 	blockIndex        *BlockBodyIndex
-	isInFinalizedDb   bool // block is in finalized table (slots)
-	isInUnfinalizedDb bool // block is in unfinalized table (unfinalized_blocks)
+	blockSize         uint32 // ssz size of the block body (used for memory accounting)
+	isInFinalizedDb   bool   // block is in finalized table (slots)
+	isInUnfinalizedDb bool   // block is in unfinalized table (unfinalized_blocks)
 	processingStatus  dbtypes.UnfinalizedBlockStatus
 	seenMutex         sync.RWMutex
 	seenMap           map[uint16]*Client
@@ -45,6 +46,7 @@ type BlockBodyIndex struct {
 	ExecutionExtraData []byte
 	ExecutionHash      phase0.Hash32
 	ExecutionNumber    uint64
+	ExecutionTime      uint64
 }
 
 // newBlock creates a new Block instance.
@@ -241,8 +243,15 @@ func (block *Block) setBlockIndex(body *spec.VersionedSignedBeaconBlock) {
 	blockIndex.ExecutionExtraData, _ = getBlockExecutionExtraData(body)
 	blockIndex.ExecutionHash, _ = body.ExecutionBlockHash()
 	blockIndex.ExecutionNumber, _ = body.ExecutionBlockNumber()
+	blockIndex.ExecutionTime, _ = getBlockExecutionTimestamp(body)
 
 	block.blockIndex = blockIndex
+
+	if block.blockSize == 0 && block.dynSsz != nil {
+		if blockSize, err := getBlockSize(block.dynSsz, body); err == nil {
+			block.blockSize = uint32(blockSize)
+		}
+	}
 }
 
 // GetBlockIndex returns the block index of this block.
@@ -260,13 +269,13 @@ func (block *Block) GetBlockIndex() *BlockBodyIndex {
 }
 
 // buildUnfinalizedBlock builds an unfinalized block from the block data.
-func (block *Block) buildUnfinalizedBlock(compress bool) (*dbtypes.UnfinalizedBlock, error) {
+func (block *Block) buildUnfinalizedBlock(compression blockCompressionType) (*dbtypes.UnfinalizedBlock, error) {
 	headerSSZ, err := block.header.MarshalSSZ()
 	if err != nil {
 		return nil, fmt.Errorf("marshal header ssz failed: %v", err)
 	}
 
-	blockVer, blockSSZ, err := marshalVersionedSignedBeaconBlockSSZ(block.dynSsz, block.GetBlock(), compress)
+	blockVer, blockSSZ, err := marshalVersionedSignedBeaconBlockSSZ(block.dynSsz, block.GetBlock(), compression)
 	if err != nil {
 		return nil, fmt.Errorf("marshal block ssz failed: %v", err)
 	}
@@ -284,13 +293,13 @@ func (block *Block) buildUnfinalizedBlock(compress bool) (*dbtypes.UnfinalizedBl
 }
 
 // buildOrphanedBlock builds an orphaned block from the block data.
-func (block *Block) buildOrphanedBlock(compress bool) (*dbtypes.OrphanedBlock, error) {
+func (block *Block) buildOrphanedBlock(compression blockCompressionType) (*dbtypes.OrphanedBlock, error) {
 	headerSSZ, err := block.header.MarshalSSZ()
 	if err != nil {
 		return nil, fmt.Errorf("marshal header ssz failed: %v", err)
 	}
 
-	blockVer, blockSSZ, err := marshalVersionedSignedBeaconBlockSSZ(block.dynSsz, block.GetBlock(), compress)
+	blockVer, blockSSZ, err := marshalVersionedSignedBeaconBlockSSZ(block.dynSsz, block.GetBlock(), compression)
 	if err != nil {
 		return nil, fmt.Errorf("marshal block ssz failed: %v", err)
 	}
@@ -304,6 +313,20 @@ func (block *Block) buildOrphanedBlock(compress bool) (*dbtypes.OrphanedBlock, e
 	}, nil
 }
 
+// evictBlockBody removes the block body from memory if it can be reloaded from the unfinalized blocks table.
+func (block *Block) evictBlockBody() bool {
+	if block.block == nil || !block.isInUnfinalizedDb {
+		return false
+	}
+
+	if block.blockIndex == nil {
+		block.setBlockIndex(block.block)
+	}
+
+	block.block = nil
+	return true
+}
+
 // unpruneBlockBody retrieves the block body from the database if it is not already present.
 func (block *Block) unpruneBlockBody() {
 	if block.block != nil || !block.isInUnfinalizedDb {
@@ -3,6 +3,7 @@ package beacon
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/altair"
@@ -11,16 +12,19 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethpandaops/dora/utils"
 	dynssz "github.com/pk910/dynamic-ssz"
+	"github.com/prysmaticlabs/go-bitfield"
 )
 
 var jsonVersionFlag uint64 = 0x40000000
 var compressionFlag uint64 = 0x20000000
+var zstdCompressionFlag uint64 = 0x10000000
 
 // marshalVersionedSignedBeaconBlockSSZ marshals a versioned signed beacon block using SSZ encoding.
-func marshalVersionedSignedBeaconBlockSSZ(dynSsz *dynssz.DynSsz, block *spec.VersionedSignedBeaconBlock, compress bool) (version uint64, ssz []byte, err error) {
-	if utils.Config.KillSwitch.DisableSSZEncoding {
+func marshalVersionedSignedBeaconBlockSSZ(dynSsz *dynssz.DynSsz, block *spec.VersionedSignedBeaconBlock, compression blockCompressionType) (version uint64, ssz []byte, err error) {
+	if utils.Config().KillSwitch.DisableSSZEncoding {
 		// SSZ encoding disabled, use json instead
 		version, ssz, err = marshalVersionedSignedBeaconBlockJson(block)
 	} else {
@@ -49,23 +53,60 @@ func marshalVersionedSignedBeaconBlockSSZ(dynSsz *dynssz.DynSsz, block *spec.Ver
 		}
 	}
 
-	if compress {
+	if err != nil {
+		return
+	}
+
+	version, ssz = compressBlockBody(version, ssz, compression)
+
+	return
+}
+
+// compressBlockBody compresses an uncompressed block body with the given compression algorithm and sets the matching version flag.
+func compressBlockBody(version uint64, ssz []byte, compression blockCompressionType) (uint64, []byte) {
+	switch compression {
+	case blockCompressionZlib:
 		ssz = compressBytes(ssz)
 		version |= compressionFlag
+	case blockCompressionZstd:
+		ssz = blockZstdCodec.compress(ssz)
+		version |= zstdCompressionFlag
 	}
 
-	return
+	return version, ssz
+}
+
+// decompressBlockBody decompresses a block body according to its version flags.
+// returns the version without compression flags and the uncompressed body.
+func decompressBlockBody(version uint64, ssz []byte) (uint64, []byte, error) {
+	if (version & zstdCompressionFlag) != 0 {
+		d, err := blockZstdCodec.decompress(ssz)
+		if err != nil {
+			return 0, nil, err
+		}
+		ssz = d
+		version &= ^zstdCompressionFlag
+	}
+
+	if (version & compressionFlag) != 0 {
+		d, err := decompressBytes(ssz)
+		if err != nil {
+			return 0, nil, err
+		}
+		ssz = d
+		version &= ^compressionFlag
+	}
+
+	return version, ssz, nil
 }
 
 // unmarshalVersionedSignedBeaconBlockSSZ unmarshals a versioned signed beacon block using SSZ encoding.
 func unmarshalVersionedSignedBeaconBlockSSZ(dynSsz *dynssz.DynSsz, version uint64, ssz []byte) (*spec.VersionedSignedBeaconBlock, error) {
-	if (version & compressionFlag) != 0 {
+	if (version & (compressionFlag | zstdCompressionFlag)) != 0 {
 		// decompress
-		if d, err := decompressBytes(ssz); err != nil {
+		var err error
+		if version, ssz, err = decompressBlockBody(version, ssz); err != nil {
 			return nil, fmt.Errorf("failed to decompress: %v", err)
-		} else {
-			ssz = d
-			version &= ^compressionFlag
 		}
 	}
 
@@ -286,6 +327,75 @@ func getStateDepositIndex(state *spec.VersionedBeaconState) uint64 {
 	return 0
 }
 
+// getStateNextWithdrawalValidatorIndex returns the withdrawal sweep cursor from a versioned beacon state (capella+).
+func getStateNextWithdrawalValidatorIndex(state *spec.VersionedBeaconState) phase0.ValidatorIndex {
+	switch state.Version {
+	case spec.DataVersionCapella:
+		return state.Capella.NextWithdrawalValidatorIndex
+	case spec.DataVersionDeneb:
+		return state.Deneb.NextWithdrawalValidatorIndex
+	case spec.DataVersionElectra:
+		return state.Electra.NextWithdrawalValidatorIndex
+	}
+	return 0
+}
+
+// getStateJustification returns the justification bits & checkpoints from a versioned beacon state.
+func getStateJustification(v *spec.VersionedBeaconState) (*EpochJustification, error) {
+	var justificationBits bitfield.Bitvector4
+	var previousJustified, currentJustified, finalized *phase0.Checkpoint
+
+	switch v.Version {
+	case spec.DataVersionPhase0:
+		if v.Phase0 == nil {
+			return nil, errors.New("no phase0 block")
+		}
+		justificationBits, previousJustified, currentJustified, finalized = v.Phase0.JustificationBits, v.Phase0.PreviousJustifiedCheckpoint, v.Phase0.CurrentJustifiedCheckpoint, v.Phase0.FinalizedCheckpoint
+	case spec.DataVersionAltair:
+		if v.Altair == nil {
+			return nil, errors.New("no altair block")
+		}
+		justificationBits, previousJustified, currentJustified, finalized = v.Altair.JustificationBits, v.Altair.PreviousJustifiedCheckpoint, v.Altair.CurrentJustifiedCheckpoint, v.Altair.FinalizedCheckpoint
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+		justificationBits, previousJustified, currentJustified, finalized = v.Bellatrix.JustificationBits, v.Bellatrix.PreviousJustifiedCheckpoint, v.Bellatrix.CurrentJustifiedCheckpoint, v.Bellatrix.FinalizedCheckpoint
+	case spec.DataVersionCapella:
+		if v.Capella == nil {
+			return nil, errors.New("no capella block")
+		}
+		justificationBits, previousJustified, currentJustified, finalized = v.Capella.JustificationBits, v.Capella.PreviousJustifiedCheckpoint, v.Capella.CurrentJustifiedCheckpoint, v.Capella.FinalizedCheckpoint
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil {
+			return nil, errors.New("no deneb block")
+		}
+		justificationBits, previousJustified, currentJustified, finalized = v.Deneb.JustificationBits, v.Deneb.PreviousJustifiedCheckpoint, v.Deneb.CurrentJustifiedCheckpoint, v.Deneb.FinalizedCheckpoint
+	case spec.DataVersionElectra:
+		if v.Electra == nil {
+			return nil, errors.New("no electra block")
+		}
+		justificationBits, previousJustified, currentJustified, finalized = v.Electra.JustificationBits, v.Electra.PreviousJustifiedCheckpoint, v.Electra.CurrentJustifiedCheckpoint, v.Electra.FinalizedCheckpoint
+	default:
+		return nil, errors.New("unknown version")
+	}
+
+	if previousJustified == nil || currentJustified == nil || finalized == nil {
+		return nil, errors.New("missing checkpoints in state")
+	}
+
+	justification := &EpochJustification{
+		PreviousJustified: *previousJustified,
+		CurrentJustified:  *currentJustified,
+		Finalized:         *finalized,
+	}
+	if len(justificationBits) > 0 {
+		justification.JustificationBits = justificationBits[0] & 0x0f
+	}
+
+	return justification, nil
+}
+
 // getStateRandaoMixes returns the current sync committee from a versioned beacon state.
 func getStateCurrentSyncCommittee(v *spec.VersionedBeaconState) ([]phase0.BLSPubKey, error) {
 	switch v.Version {
@@ -325,3 +435,113 @@ func getStateCurrentSyncCommittee(v *spec.VersionedBeaconState) ([]phase0.BLSPub
 		return nil, errors.New("unknown version")
 	}
 }
+
+// getBlockSize returns the SSZ encoded size of a versioned signed beacon block.
+func getBlockSize(dynSsz *dynssz.DynSsz, block *spec.VersionedSignedBeaconBlock) (int, error) {
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		return dynSsz.SizeSSZ(block.Phase0)
+	case spec.DataVersionAltair:
+		return dynSsz.SizeSSZ(block.Altair)
+	case spec.DataVersionBellatrix:
+		return dynSsz.SizeSSZ(block.Bellatrix)
+	case spec.DataVersionCapella:
+		return dynSsz.SizeSSZ(block.Capella)
+	case spec.DataVersionDeneb:
+		return dynSsz.SizeSSZ(block.Deneb)
+	case spec.DataVersionElectra:
+		return dynSsz.SizeSSZ(block.Electra)
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// getBlockExecutionTimestamp returns the execution payload timestamp of the given block.
+func getBlockExecutionTimestamp(v *spec.VersionedSignedBeaconBlock) (uint64, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil || v.Bellatrix.Message.Body.ExecutionPayload == nil {
+			return 0, errors.New("no bellatrix block")
+		}
+
+		return v.Bellatrix.Message.Body.ExecutionPayload.Timestamp, nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil || v.Capella.Message.Body.ExecutionPayload == nil {
+			return 0, errors.New("no capella block")
+		}
+
+		return v.Capella.Message.Body.ExecutionPayload.Timestamp, nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil || v.Deneb.Message.Body.ExecutionPayload == nil {
+			return 0, errors.New("no deneb block")
+		}
+
+		return v.Deneb.Message.Body.ExecutionPayload.Timestamp, nil
+	case spec.DataVersionElectra:
+		if v.Electra == nil || v.Electra.Message == nil || v.Electra.Message.Body == nil || v.Electra.Message.Body.ExecutionPayload == nil {
+			return 0, errors.New("no electra block")
+		}
+
+		return v.Electra.Message.Body.ExecutionPayload.Timestamp, nil
+	default:
+		return 0, errors.New("unknown version")
+	}
+}
+
+// getBlockBlobTxStats returns the count & total size of the blob (type 3) transactions in the execution payload
+// and the distribution of blobs per transaction (index i holds the number of transactions with i+1 blobs).
+func getBlockBlobTxStats(transactions []bellatrix.Transaction) (uint64, uint64, []byte) {
+	txCount := uint64(0)
+	txSize := uint64(0)
+	var blobDist []byte
+
+	for _, txBytes := range transactions {
+		if len(txBytes) == 0 || txBytes[0] != ethtypes.BlobTxType {
+			continue
+		}
+
+		var tx ethtypes.Transaction
+		if err := tx.UnmarshalBinary(txBytes); err != nil {
+			continue
+		}
+
+		txCount++
+		txSize += uint64(len(txBytes))
+
+		blobCount := len(tx.BlobHashes())
+		if blobCount == 0 {
+			continue
+		}
+		for len(blobDist) < blobCount {
+			blobDist = append(blobDist, 0)
+		}
+		if blobDist[blobCount-1] < math.MaxUint8 {
+			blobDist[blobCount-1]++
+		}
+	}
+
+	return txCount, txSize, blobDist
+}
+
+// getBlockAttestationDelay returns the average inclusion delay of the included attestations in 1/100 slots (0 if the block has no attestations).
+// clients pack attestations of past slots differently, so the delay is used as a timing fingerprint of the proposing consensus client.
+func getBlockAttestationDelay(slot phase0.Slot, attestations []spec.VersionedAttestation) uint32 {
+	delaySum := uint64(0)
+	count := uint64(0)
+
+	for _, attestation := range attestations {
+		attData, err := attestation.Data()
+		if err != nil || attData.Slot >= slot {
+			continue
+		}
+
+		delaySum += uint64(slot - attData.Slot)
+		count++
+	}
+
+	if count == 0 {
+		return 0
+	}
+
+	return uint32(delaySum * 100 / count)
+}
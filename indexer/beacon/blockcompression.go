@@ -0,0 +1,165 @@
+package beacon
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/klauspost/compress/dict"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// blockCompressionDictSamples is the max. number of block bodies (per block table) used to train a zstd dictionary.
+const blockCompressionDictSamples = 256
+
+// blockCompressionMinSamples is the min. number of block bodies required to train a zstd dictionary.
+const blockCompressionMinSamples = 32
+
+// blockCompressionDictSize is the max. size of a trained zstd dictionary.
+const blockCompressionDictSize = 64 * 1024
+
+// blockCompressionDictIdBase is the first zstd dictionary id used for trained dictionaries (lower ids are reserved by the zstd format).
+const blockCompressionDictIdBase = 32768
+
+// blockRecompressionBatchSize is the number of block bodies recompressed per db transaction.
+const blockRecompressionBatchSize = 100
+
+// loadBlockCompressionDicts registers the stored zstd dictionaries, so block bodies compressed with them can be restored.
+func (indexer *Indexer) loadBlockCompressionDicts() {
+	dicts := db.GetBlockCompressionDicts()
+	for idx, dbDict := range dicts {
+		if _, err := blockZstdCodec.addDictionary(dbDict.Dict, idx == 0); err != nil {
+			indexer.logger.Warnf("failed loading block compression dictionary %v: %v", dbDict.DictId, err)
+		}
+	}
+}
+
+// runBlockCompressionWorker trains the zstd dictionary for block bodies if none is available yet
+// and recompresses the existing block bodies in the unfinalized & orphaned block tables afterwards.
+func (indexer *Indexer) runBlockCompressionWorker() {
+	defer utils.HandleSubroutinePanic("Indexer.runBlockCompressionWorker")
+
+	if indexer.blockCompression != blockCompressionZstd {
+		return
+	}
+
+	for blockZstdCodec.getActiveDictionary() == 0 {
+		err := indexer.trainBlockCompressionDict()
+		if err == nil {
+			break
+		}
+
+		indexer.logger.Infof("could not train block compression dictionary yet: %v", err)
+		time.Sleep(10 * time.Minute)
+	}
+
+	if utils.Config().Indexer.DisableBlockRecompression {
+		return
+	}
+
+	for _, table := range []string{"unfinalized_blocks", "orphaned_blocks"} {
+		indexer.recompressBlockBodies(table)
+	}
+}
+
+// trainBlockCompressionDict trains a new zstd dictionary on the most recent stored block bodies and activates it.
+func (indexer *Indexer) trainBlockCompressionDict() error {
+	t1 := time.Now()
+
+	samples := [][]byte{}
+	for _, body := range db.GetBlockBodySamples(blockCompressionDictSamples) {
+		_, ssz, err := decompressBlockBody(body.BlockVer, body.BlockSSZ)
+		if err != nil {
+			continue
+		}
+		samples = append(samples, ssz)
+	}
+
+	if len(samples) < blockCompressionMinSamples {
+		return fmt.Errorf("not enough stored block bodies (%v/%v)", len(samples), blockCompressionMinSamples)
+	}
+
+	dictId := uint32(blockCompressionDictIdBase + len(db.GetBlockCompressionDicts()) + 1)
+	dictBytes, err := dict.BuildZstdDict(samples, dict.Options{
+		MaxDictSize: blockCompressionDictSize,
+		HashBytes:   6,
+		ZstdDictID:  dictId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed building dictionary: %w", err)
+	}
+
+	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertBlockCompressionDict(&dbtypes.BlockCompressionDict{
+			DictId:     dictId,
+			CreateTime: time.Now().Unix(),
+			Dict:       dictBytes,
+		}, tx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed persisting dictionary: %w", err)
+	}
+
+	if _, err := blockZstdCodec.addDictionary(dictBytes, true); err != nil {
+		return err
+	}
+
+	indexer.logger.Infof("trained block compression dictionary %v from %v block bodies (%v bytes, %v ms)", dictId, len(samples), len(dictBytes), time.Since(t1).Milliseconds())
+	return nil
+}
+
+// recompressBlockBodies migrates all block bodies of the given table that are not zstd compressed yet.
+func (indexer *Indexer) recompressBlockBodies(table string) {
+	t1 := time.Now()
+	recompressedCount := 0
+	savedBytes := 0
+
+	var lastRoot []byte
+	for {
+		bodies := db.GetBlockBodiesWithoutFlag(table, zstdCompressionFlag, lastRoot, blockRecompressionBatchSize)
+		if len(bodies) == 0 {
+			break
+		}
+		lastRoot = bodies[len(bodies)-1].Root
+
+		updatedBodies := make([]*dbtypes.BlockBody, 0, len(bodies))
+		for _, body := range bodies {
+			version, ssz, err := decompressBlockBody(body.BlockVer, body.BlockSSZ)
+			if err != nil {
+				indexer.logger.Warnf("failed decompressing block body 0x%x from %v: %v", body.Root, table, err)
+				continue
+			}
+
+			version, ssz = compressBlockBody(version, ssz, blockCompressionZstd)
+			savedBytes += len(body.BlockSSZ) - len(ssz)
+			updatedBodies = append(updatedBodies, &dbtypes.BlockBody{
+				Root:     body.Root,
+				BlockVer: version,
+				BlockSSZ: ssz,
+			})
+		}
+
+		err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			for _, body := range updatedBodies {
+				if err := db.UpdateBlockBody(table, body, tx); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			indexer.logger.Errorf("failed persisting recompressed block bodies in %v: %v", table, err)
+			return
+		}
+
+		recompressedCount += len(updatedBodies)
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if recompressedCount > 0 {
+		indexer.logger.Infof("recompressed %v block bodies in %v (saved %v kB, %.3f sec)", recompressedCount, table, savedBytes/1024, time.Since(t1).Seconds())
+	}
+}
@@ -0,0 +1,848 @@
+package beacon
+
+import (
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/indexer/beacon/duties"
+	"github.com/juliangruber/go-intersect"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// block operation limits of the beacon chain presets (mainnet & minimal use the same values)
+const maxAttestations = 128
+const maxAttestationsElectra = 8
+const maxProposerSlashings = 16
+const maxAttesterSlashings = 2
+const maxAttesterSlashingsElectra = 1
+const maxVoluntaryExits = 16
+const whistleblowerRewardQuotient = 512
+const whistleblowerRewardQuotientElectra = 4096
+
+// altair participation flags & their incentivization weights
+const (
+	TimelySourceFlag uint8 = 1 << iota
+	TimelyTargetFlag
+	TimelyHeadFlag
+)
+const timelySourceWeight = 14
+const timelyTargetWeight = 26
+const timelyHeadWeight = 14
+const proposerWeight = 8
+
+// BlockPackingSimulation holds the result of a block packing simulation for a block.
+type BlockPackingSimulation struct {
+	Slot            phase0.Slot
+	BlockRoot       phase0.Root
+	ProposerIndex   phase0.ValidatorIndex
+	SourceBlocks    uint64 // number of cached blocks the candidate operations were collected from
+	MaxAttestations uint64
+
+	IncludedAttestations      uint64
+	IncludedVotes             uint64 // validator votes with new participation flags
+	IncludedAttestationReward phase0.Gwei
+	OptimalAttestations       uint64
+	OptimalVotes              uint64
+	OptimalAttestationReward  phase0.Gwei
+	MissedAttestations        []*PackingAttestation
+
+	IncludedSlashings      uint64
+	IncludedSlashingReward phase0.Gwei
+	OptimalSlashings       uint64
+	OptimalSlashingReward  phase0.Gwei
+	MissedSlashings        []*PackingSlashing
+
+	IncludedExits uint64
+	MissedExits   []*PackingVoluntaryExit
+}
+
+// PackingAttestation is a committee aggregate that was available for the block, but not included.
+type PackingAttestation struct {
+	Slot            phase0.Slot
+	Committee       uint64
+	BeaconBlockRoot phase0.Root
+	TargetRoot      phase0.Root
+	Flags           uint8 // participation flags the aggregate earns when included in the block
+	VoteCount       uint64
+	NewVotes        uint64 // votes with participation flags not covered by the chain & the block
+	Reward          phase0.Gwei
+	InOptimal       bool // aggregate is part of the simulated optimal packing
+	SeenInSlot      phase0.Slot
+	SeenInRoot      phase0.Root
+}
+
+// PackingSlashing is a slashing that was available for the block, but not included.
+type PackingSlashing struct {
+	IsAttesterSlashing bool
+	Validators         []phase0.ValidatorIndex // validators that were not slashed yet
+	Reward             phase0.Gwei
+	InOptimal          bool
+	SeenInSlot         phase0.Slot
+	SeenInRoot         phase0.Root
+}
+
+// PackingVoluntaryExit is a voluntary exit that was available for the block, but not included.
+type PackingVoluntaryExit struct {
+	ValidatorIndex phase0.ValidatorIndex
+	Epoch          phase0.Epoch
+	SeenInSlot     phase0.Slot
+	SeenInRoot     phase0.Root
+}
+
+// packingUnit is a single committee aggregate of an attestation.
+type packingUnit struct {
+	epoch      phase0.Epoch
+	data       *phase0.AttestationData
+	dataRoot   phase0.Root
+	committee  uint64
+	validators []duties.ActiveIndiceIndex
+	flags      uint8
+	seenIn     *Block
+	inOptimal  bool
+}
+
+// packingState tracks the participation flags of the attesters and the proposer reward they result in.
+type packingState struct {
+	epochValues            map[phase0.Epoch]*EpochStatsValues
+	participation          map[phase0.Epoch][]uint8
+	baseRewardPerIncrement uint64
+	gweiPerIncrement       uint64
+}
+
+func (ps *packingState) clone() *packingState {
+	clone := &packingState{
+		epochValues:            ps.epochValues,
+		participation:          make(map[phase0.Epoch][]uint8, len(ps.participation)),
+		baseRewardPerIncrement: ps.baseRewardPerIncrement,
+		gweiPerIncrement:       ps.gweiPerIncrement,
+	}
+	for epoch, flags := range ps.participation {
+		clone.participation[epoch] = append([]uint8(nil), flags...)
+	}
+	return clone
+}
+
+// unitValue returns the proposer reward numerator & the number of votes with new flags the unit would add.
+func (ps *packingState) unitValue(unit *packingUnit) (uint64, uint64) {
+	participation := ps.participation[unit.epoch]
+	epochValues := ps.epochValues[unit.epoch]
+	if participation == nil || unit.flags == 0 {
+		return 0, 0
+	}
+
+	rewardNumerator := uint64(0)
+	newVotes := uint64(0)
+	for _, validatorIndice := range unit.validators {
+		newFlags := unit.flags &^ participation[validatorIndice]
+		if newFlags == 0 {
+			continue
+		}
+
+		baseReward := uint64(epochValues.EffectiveBalances[validatorIndice]) * EtherGweiFactor / ps.gweiPerIncrement * ps.baseRewardPerIncrement
+		rewardNumerator += baseReward * participationFlagWeight(newFlags)
+		newVotes++
+	}
+	return rewardNumerator, newVotes
+}
+
+func (ps *packingState) applyUnit(unit *packingUnit) {
+	participation := ps.participation[unit.epoch]
+	if participation == nil {
+		return
+	}
+	for _, validatorIndice := range unit.validators {
+		participation[validatorIndice] |= unit.flags
+	}
+}
+
+func participationFlagWeight(flags uint8) uint64 {
+	weight := uint64(0)
+	if flags&TimelySourceFlag != 0 {
+		weight += timelySourceWeight
+	}
+	if flags&TimelyTargetFlag != 0 {
+		weight += timelyTargetWeight
+	}
+	if flags&TimelyHeadFlag != 0 {
+		weight += timelyHeadWeight
+	}
+	return weight
+}
+
+// proposerRewardDenominator converts the summed up base reward weights into the proposer reward (see process_attestation)
+const proposerRewardDenominator = (weightDenominator - proposerWeight) * weightDenominator / proposerWeight
+
+// SimulateBlockPacking compares the operations included in the block with the attestations, slashings & voluntary exits that
+// were known to the indexer and valid for inclusion in the block. Candidates are taken from the block itself and from all cached
+// blocks (canonical & orphaned) up to one epoch after the block. As the explorer has no view into the operation pools of the
+// clients, an operation seen in a later block is assumed to have been available at the block slot already, so the missed rewards
+// are an upper bound. returns nil if the block body or the attester duties are not available in cache.
+func (indexer *Indexer) SimulateBlockPacking(block *Block) *BlockPackingSimulation {
+	chainState := indexer.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	if specs == nil || specs.AltairForkEpoch == nil || specs.EffectiveBalanceIncrement == 0 {
+		return nil
+	}
+
+	blockEpoch := chainState.EpochOfSlot(block.Slot)
+	if uint64(blockEpoch) < *specs.AltairForkEpoch {
+		return nil
+	}
+
+	blockBody := block.GetBlock()
+	if blockBody == nil {
+		return nil
+	}
+
+	proposerIndex, _ := blockBody.ProposerIndex()
+	simulation := &BlockPackingSimulation{
+		Slot:            block.Slot,
+		BlockRoot:       block.Root,
+		ProposerIndex:   proposerIndex,
+		MaxAttestations: maxAttestations,
+	}
+	isElectra := blockBody.Version >= spec.DataVersionElectra
+	if isElectra {
+		simulation.MaxAttestations = maxAttestationsElectra
+	}
+
+	// attestations of the current & previous epoch can be included in the block
+	firstEpoch := blockEpoch
+	if firstEpoch > 0 {
+		firstEpoch--
+	}
+
+	baseState := &packingState{
+		epochValues:      map[phase0.Epoch]*EpochStatsValues{},
+		participation:    map[phase0.Epoch][]uint8{},
+		gweiPerIncrement: specs.EffectiveBalanceIncrement,
+	}
+	for epoch := firstEpoch; epoch <= blockEpoch; epoch++ {
+		epochStats := indexer.getBlockEpochStats(epoch, block)
+		if epochStats == nil {
+			continue
+		}
+		epochStatsValues := epochStats.GetOrLoadValues(indexer, true, false)
+		if epochStatsValues == nil {
+			continue
+		}
+
+		baseState.epochValues[epoch] = epochStatsValues
+		baseState.participation[epoch] = make([]uint8, len(epochStatsValues.ActiveIndices))
+	}
+
+	blockEpochValues := baseState.epochValues[blockEpoch]
+	if blockEpochValues == nil || blockEpochValues.EffectiveBalance == 0 {
+		return nil
+	}
+	baseState.baseRewardPerIncrement = specs.EffectiveBalanceIncrement * specs.BaseRewardFactor / integerSquareRoot(uint64(blockEpochValues.EffectiveBalance))
+
+	// collect the chain the block builds on
+	firstSlot := chainState.EpochToSlot(firstEpoch)
+	chainBlocks := []*Block{}
+	if parentRoot := block.GetParentRoot(); parentRoot != nil {
+		chainBlock := indexer.blockCache.getBlockByRoot(*parentRoot)
+		for chainBlock != nil && chainBlock.Slot >= firstSlot {
+			chainBlocks = append(chainBlocks, chainBlock)
+
+			parentRoot := chainBlock.GetParentRoot()
+			if parentRoot == nil {
+				break
+			}
+			chainBlock = indexer.blockCache.getBlockByRoot(*parentRoot)
+		}
+	}
+	sort.Slice(chainBlocks, func(i, j int) bool {
+		return chainBlocks[i].Slot < chainBlocks[j].Slot
+	})
+
+	// chainRootAt returns the head of the chain at the given slot, slots before the collected chain resolve to its parent root
+	var chainBaseRoot phase0.Root
+	if len(chainBlocks) > 0 {
+		if parentRoot := chainBlocks[0].GetParentRoot(); parentRoot != nil {
+			chainBaseRoot = *parentRoot
+		}
+	} else if parentRoot := block.GetParentRoot(); parentRoot != nil {
+		chainBaseRoot = *parentRoot
+	}
+	chainRootAt := func(slot phase0.Slot) phase0.Root {
+		root := chainBaseRoot
+		for _, chainBlock := range chainBlocks {
+			if chainBlock.Slot > slot {
+				break
+			}
+			root = chainBlock.Root
+		}
+		return root
+	}
+
+	getFlags := func(attData *phase0.AttestationData, inclusionSlot phase0.Slot) uint8 {
+		return getAttestationParticipationFlags(chainState, specs, attData, inclusionSlot, chainRootAt)
+	}
+
+	// replay the attestations of the chain to get the participation flags before the block
+	for _, chainBlock := range chainBlocks {
+		chainBlockBody := chainBlock.GetBlock()
+		if chainBlockBody == nil {
+			continue
+		}
+
+		for _, attestation := range indexer.getPackingUnits(chainState, specs, chainBlockBody, baseState, firstEpoch, chainBlock, func(attData *phase0.AttestationData) uint8 {
+			return getFlags(attData, chainBlock.Slot)
+		}) {
+			for _, unit := range attestation {
+				baseState.applyUnit(unit)
+			}
+		}
+	}
+
+	// account the attestations included in the block
+	blockState := baseState.clone()
+	blockAttestations := indexer.getPackingUnits(chainState, specs, blockBody, baseState, firstEpoch, block, func(attData *phase0.AttestationData) uint8 {
+		return getFlags(attData, block.Slot)
+	})
+	for _, attestation := range blockAttestations {
+		rewardNumerator := uint64(0)
+		for _, unit := range attestation {
+			unitReward, unitVotes := blockState.unitValue(unit)
+			rewardNumerator += unitReward
+			simulation.IncludedVotes += unitVotes
+			blockState.applyUnit(unit)
+		}
+		simulation.IncludedAttestationReward += phase0.Gwei(rewardNumerator / proposerRewardDenominator)
+	}
+	if attestations, err := blockBody.Attestations(); err == nil {
+		simulation.IncludedAttestations = uint64(len(attestations))
+	}
+
+	// collect the candidate aggregates from the block itself & all cached blocks up to one epoch later
+	sourceBlocks := []*Block{block}
+	for slot := block.Slot; slot <= block.Slot+phase0.Slot(specs.SlotsPerEpoch); slot++ {
+		for _, sourceBlock := range indexer.blockCache.getBlocksBySlot(slot) {
+			if sourceBlock.Root == block.Root || sourceBlock.GetBlock() == nil {
+				continue
+			}
+			sourceBlocks = append(sourceBlocks, sourceBlock)
+		}
+	}
+	simulation.SourceBlocks = uint64(len(sourceBlocks))
+
+	candidates := []*packingUnit{}
+	candidateMap := map[string]bool{}
+	for _, sourceBlock := range sourceBlocks {
+		for _, attestation := range indexer.getPackingUnits(chainState, specs, sourceBlock.GetBlock(), baseState, firstEpoch, sourceBlock, func(attData *phase0.AttestationData) uint8 {
+			if attData.Slot >= block.Slot {
+				return 0
+			}
+			return getFlags(attData, block.Slot)
+		}) {
+			for _, unit := range attestation {
+				if unit.flags == 0 {
+					continue
+				}
+
+				unitKey := getPackingUnitKey(unit)
+				if candidateMap[unitKey] {
+					continue
+				}
+				candidateMap[unitKey] = true
+				candidates = append(candidates, unit)
+			}
+		}
+	}
+
+	indexer.simulateOptimalAttestations(simulation, baseState, candidates, isElectra)
+
+	// list the available aggregates that would have added rewards on top of the block
+	for _, unit := range candidates {
+		if unit.seenIn == block {
+			continue
+		}
+
+		unitReward, unitVotes := blockState.unitValue(unit)
+		if unitVotes == 0 {
+			continue
+		}
+
+		simulation.MissedAttestations = append(simulation.MissedAttestations, &PackingAttestation{
+			Slot:            unit.data.Slot,
+			Committee:       unit.committee,
+			BeaconBlockRoot: unit.data.BeaconBlockRoot,
+			TargetRoot:      unit.data.Target.Root,
+			Flags:           unit.flags,
+			VoteCount:       uint64(len(unit.validators)),
+			NewVotes:        unitVotes,
+			Reward:          phase0.Gwei(unitReward / proposerRewardDenominator),
+			InOptimal:       unit.inOptimal,
+			SeenInSlot:      unit.seenIn.Slot,
+			SeenInRoot:      unit.seenIn.Root,
+		})
+	}
+	sort.Slice(simulation.MissedAttestations, func(i, j int) bool {
+		if simulation.MissedAttestations[i].Reward != simulation.MissedAttestations[j].Reward {
+			return simulation.MissedAttestations[i].Reward > simulation.MissedAttestations[j].Reward
+		}
+		return simulation.MissedAttestations[i].Slot > simulation.MissedAttestations[j].Slot
+	})
+
+	indexer.simulateBlockSlashingsAndExits(simulation, chainState, block, chainBlocks, sourceBlocks[1:], isElectra)
+
+	return simulation
+}
+
+// getBlockEpochStats returns the epoch stats for the chain the block belongs to.
+func (indexer *Indexer) getBlockEpochStats(epoch phase0.Epoch, block *Block) *EpochStats {
+	for _, epochStats := range indexer.epochCache.getEpochStatsByEpoch(epoch) {
+		if indexer.blockCache.isCanonicalBlock(epochStats.dependentRoot, block.Root) {
+			return epochStats
+		}
+	}
+
+	forkId := block.GetForkId()
+	return indexer.GetEpochStats(epoch, &forkId)
+}
+
+// getAttestationParticipationFlags returns the participation flags an attestation earns when included at the given slot.
+// returns 0 if the attestation cannot be included (see process_attestation / get_attestation_participation_flag_indices).
+func getAttestationParticipationFlags(chainState *consensus.ChainState, specs *consensus.ChainSpec, attData *phase0.AttestationData, inclusionSlot phase0.Slot, chainRootAt func(slot phase0.Slot) phase0.Root) uint8 {
+	if attData.Slot+1 > inclusionSlot {
+		return 0
+	}
+
+	inclusionEpoch := chainState.EpochOfSlot(inclusionSlot)
+	if attData.Target.Epoch != inclusionEpoch && attData.Target.Epoch+1 != inclusionEpoch {
+		return 0
+	}
+
+	inclusionDelay := uint64(inclusionSlot - attData.Slot)
+	isDeneb := specs.DenebForkEpoch != nil && uint64(inclusionEpoch) >= *specs.DenebForkEpoch
+	if !isDeneb && inclusionDelay > specs.SlotsPerEpoch {
+		return 0 // EIP-7045 extended the inclusion window with deneb
+	}
+
+	flags := uint8(0)
+	if inclusionDelay <= integerSquareRoot(specs.SlotsPerEpoch) {
+		flags |= TimelySourceFlag
+	}
+
+	targetSlot := chainState.EpochToSlot(attData.Target.Epoch)
+	if attData.Target.Root == chainRootAt(targetSlot) {
+		if isDeneb || inclusionDelay <= specs.SlotsPerEpoch {
+			flags |= TimelyTargetFlag
+		}
+
+		if attData.BeaconBlockRoot == chainRootAt(attData.Slot) && inclusionDelay == 1 {
+			flags |= TimelyHeadFlag
+		}
+	}
+
+	return flags
+}
+
+// getPackingUnits splits the attestations of a block into committee aggregates. one slice of units is returned per attestation.
+func (indexer *Indexer) getPackingUnits(chainState *consensus.ChainState, specs *consensus.ChainSpec, blockBody *spec.VersionedSignedBeaconBlock, state *packingState, firstEpoch phase0.Epoch, sourceBlock *Block, getFlags func(attData *phase0.AttestationData) uint8) [][]*packingUnit {
+	attestations, err := blockBody.Attestations()
+	if err != nil {
+		return nil
+	}
+
+	attestationUnits := make([][]*packingUnit, 0, len(attestations))
+	for _, attVersioned := range attestations {
+		attData, err := attVersioned.Data()
+		if err != nil {
+			continue
+		}
+
+		attEpoch := chainState.EpochOfSlot(attData.Slot)
+		epochValues := state.epochValues[attEpoch]
+		if attEpoch < firstEpoch || epochValues == nil {
+			continue
+		}
+
+		attAggregationBits, err := attVersioned.AggregationBits()
+		if err != nil {
+			continue
+		}
+
+		dataRoot, err := attData.HashTreeRoot()
+		if err != nil {
+			continue
+		}
+
+		flags := getFlags(attData)
+		slotIndex := chainState.SlotToSlotIndex(attData.Slot)
+		units := []*packingUnit{}
+		addCommitteeUnit := func(committee uint64, aggregationBits bitfield.Bitfield, aggregationBitsOffset uint64) uint64 {
+			if int(slotIndex) >= len(epochValues.AttesterDuties) || int(committee) >= len(epochValues.AttesterDuties[slotIndex]) {
+				return 0
+			}
+
+			voteDuties := epochValues.AttesterDuties[slotIndex][committee]
+			unit := &packingUnit{
+				epoch:     attEpoch,
+				data:      attData,
+				dataRoot:  dataRoot,
+				committee: committee,
+				flags:     flags,
+				seenIn:    sourceBlock,
+			}
+			for bitIdx, validatorIndice := range voteDuties {
+				if aggregationBits.BitAt(uint64(bitIdx) + aggregationBitsOffset) {
+					unit.validators = append(unit.validators, validatorIndice)
+				}
+			}
+			if len(unit.validators) > 0 {
+				units = append(units, unit)
+			}
+
+			return uint64(len(voteDuties))
+		}
+
+		if attVersioned.Version >= spec.DataVersionElectra {
+			committeeBits, err := attVersioned.CommitteeBits()
+			if err != nil {
+				continue
+			}
+
+			aggregationBitsOffset := uint64(0)
+			for _, committee := range committeeBits.BitIndices() {
+				if uint64(committee) >= specs.MaxCommitteesPerSlot {
+					continue
+				}
+
+				aggregationBitsOffset += addCommitteeUnit(uint64(committee), attAggregationBits, aggregationBitsOffset)
+			}
+		} else {
+			addCommitteeUnit(uint64(attData.Index), attAggregationBits, 0)
+		}
+
+		attestationUnits = append(attestationUnits, units)
+	}
+
+	return attestationUnits
+}
+
+func getPackingUnitKey(unit *packingUnit) string {
+	key := make([]byte, 0, 32+8+len(unit.validators)*4)
+	key = append(key, unit.dataRoot[:]...)
+	key = append(key, byte(unit.committee), byte(unit.committee>>8))
+	for _, validatorIndice := range unit.validators {
+		key = append(key, byte(validatorIndice), byte(validatorIndice>>8), byte(validatorIndice>>16), byte(validatorIndice>>24))
+	}
+	return string(key)
+}
+
+// simulateOptimalAttestations packs the candidate aggregates greedily by their marginal proposer reward.
+// pre electra each aggregate takes one attestation slot of the block, with electra all committee aggregates with the same
+// attestation data get packed into a single on-chain attestation (one aggregate per committee).
+func (indexer *Indexer) simulateOptimalAttestations(simulation *BlockPackingSimulation, baseState *packingState, candidates []*packingUnit, isElectra bool) {
+	type packingGroup struct {
+		units      []*packingUnit
+		cachedGain uint64
+		selected   bool
+	}
+
+	groups := []*packingGroup{}
+	if isElectra {
+		groupMap := map[phase0.Root]*packingGroup{}
+		for _, unit := range candidates {
+			group := groupMap[unit.dataRoot]
+			if group == nil {
+				group = &packingGroup{}
+				groupMap[unit.dataRoot] = group
+				groups = append(groups, group)
+			}
+			group.units = append(group.units, unit)
+		}
+	} else {
+		for _, unit := range candidates {
+			groups = append(groups, &packingGroup{units: []*packingUnit{unit}})
+		}
+	}
+
+	optimalState := baseState.clone()
+
+	// groupGain returns the best aggregate per committee of the group and the resulting reward numerator
+	groupGain := func(group *packingGroup) (uint64, []*packingUnit) {
+		bestUnits := map[uint64]*packingUnit{}
+		bestGains := map[uint64]uint64{}
+		for _, unit := range group.units {
+			gain, _ := optimalState.unitValue(unit)
+			if gain > bestGains[unit.committee] {
+				bestGains[unit.committee] = gain
+				bestUnits[unit.committee] = unit
+			}
+		}
+
+		totalGain := uint64(0)
+		units := make([]*packingUnit, 0, len(bestUnits))
+		for committee, unit := range bestUnits {
+			totalGain += bestGains[committee]
+			units = append(units, unit)
+		}
+		return totalGain, units
+	}
+
+	for _, group := range groups {
+		group.cachedGain, _ = groupGain(group)
+	}
+
+	// lazy greedy selection, the marginal gain of a group can only decrease when other groups get selected
+	for simulation.OptimalAttestations < simulation.MaxAttestations {
+		var bestGroup *packingGroup
+		for _, group := range groups {
+			if !group.selected && group.cachedGain > 0 && (bestGroup == nil || group.cachedGain > bestGroup.cachedGain) {
+				bestGroup = group
+			}
+		}
+		if bestGroup == nil {
+			break
+		}
+
+		gain, units := groupGain(bestGroup)
+		if gain < bestGroup.cachedGain {
+			bestGroup.cachedGain = gain
+			continue
+		}
+
+		bestGroup.selected = true
+		for _, unit := range units {
+			_, unitVotes := optimalState.unitValue(unit)
+			simulation.OptimalVotes += unitVotes
+			optimalState.applyUnit(unit)
+			unit.inOptimal = true
+		}
+		simulation.OptimalAttestations++
+		simulation.OptimalAttestationReward += phase0.Gwei(gain / proposerRewardDenominator)
+	}
+
+	// the greedy packing is not guaranteed to be optimal, fall back to the actual block packing if it performed better
+	if simulation.OptimalAttestationReward < simulation.IncludedAttestationReward {
+		simulation.OptimalAttestations = simulation.IncludedAttestations
+		simulation.OptimalVotes = simulation.IncludedVotes
+		simulation.OptimalAttestationReward = simulation.IncludedAttestationReward
+	}
+}
+
+// simulateBlockSlashingsAndExits compares the slashings & voluntary exits of the block with the ones found in the source blocks.
+func (indexer *Indexer) simulateBlockSlashingsAndExits(simulation *BlockPackingSimulation, chainState *consensus.ChainState, block *Block, chainBlocks []*Block, sourceBlocks []*Block, isElectra bool) {
+	forkId := block.GetForkId()
+	slotEpoch := chainState.EpochOfSlot(block.Slot)
+
+	rewardQuotient := uint64(whistleblowerRewardQuotient)
+	attesterSlashingLimit := maxAttesterSlashings
+	if isElectra {
+		rewardQuotient = whistleblowerRewardQuotientElectra
+		attesterSlashingLimit = maxAttesterSlashingsElectra
+	}
+
+	type slashingCandidate struct {
+		slashing   *PackingSlashing
+		validators []phase0.ValidatorIndex
+	}
+
+	getSlashings := func(blockBody *spec.VersionedSignedBeaconBlock, sourceBlock *Block) []*slashingCandidate {
+		candidates := []*slashingCandidate{}
+		if proposerSlashings, err := blockBody.ProposerSlashings(); err == nil {
+			for _, proposerSlashing := range proposerSlashings {
+				if proposerSlashing.SignedHeader1.Message.Slot > block.Slot {
+					continue
+				}
+
+				candidates = append(candidates, &slashingCandidate{
+					slashing: &PackingSlashing{
+						SeenInSlot: sourceBlock.Slot,
+						SeenInRoot: sourceBlock.Root,
+					},
+					validators: []phase0.ValidatorIndex{proposerSlashing.SignedHeader1.Message.ProposerIndex},
+				})
+			}
+		}
+		if attesterSlashings, err := blockBody.AttesterSlashings(); err == nil {
+			for _, attesterSlashing := range attesterSlashings {
+				att1, _ := attesterSlashing.Attestation1()
+				att2, _ := attesterSlashing.Attestation2()
+				if att1 == nil || att2 == nil {
+					continue
+				}
+
+				att1Data, _ := att1.Data()
+				att2Data, _ := att2.Data()
+				if att1Data == nil || att2Data == nil || att1Data.Slot >= block.Slot || att2Data.Slot >= block.Slot {
+					continue
+				}
+
+				att1AttestingIndices, _ := att1.AttestingIndices()
+				att2AttestingIndices, _ := att2.AttestingIndices()
+				candidate := &slashingCandidate{
+					slashing: &PackingSlashing{
+						IsAttesterSlashing: true,
+						SeenInSlot:         sourceBlock.Slot,
+						SeenInRoot:         sourceBlock.Root,
+					},
+				}
+				for _, j := range intersect.Simple(att1AttestingIndices, att2AttestingIndices) {
+					candidate.validators = append(candidate.validators, phase0.ValidatorIndex(j.(uint64)))
+				}
+				candidates = append(candidates, candidate)
+			}
+		}
+		return candidates
+	}
+
+	// validators slashed or exited by the chain before the block are no candidates
+	slashedValidators := map[phase0.ValidatorIndex]bool{}
+	exitedValidators := map[phase0.ValidatorIndex]bool{}
+	addBlockOperations := func(blockBody *spec.VersionedSignedBeaconBlock, sourceBlock *Block) {
+		for _, candidate := range getSlashings(blockBody, sourceBlock) {
+			for _, validatorIndex := range candidate.validators {
+				slashedValidators[validatorIndex] = true
+			}
+		}
+		if voluntaryExits, err := blockBody.VoluntaryExits(); err == nil {
+			for _, voluntaryExit := range voluntaryExits {
+				exitedValidators[voluntaryExit.Message.ValidatorIndex] = true
+			}
+		}
+	}
+	for _, chainBlock := range chainBlocks {
+		if chainBlockBody := chainBlock.GetBlock(); chainBlockBody != nil {
+			addBlockOperations(chainBlockBody, chainBlock)
+		}
+	}
+
+	slashingReward := func(validators []phase0.ValidatorIndex, slashed map[phase0.ValidatorIndex]bool) (phase0.Gwei, []phase0.ValidatorIndex) {
+		reward := phase0.Gwei(0)
+		newValidators := []phase0.ValidatorIndex{}
+		for _, validatorIndex := range validators {
+			if slashed[validatorIndex] {
+				continue
+			}
+
+			newValidators = append(newValidators, validatorIndex)
+			if validator := indexer.GetValidatorByIndex(validatorIndex, &forkId); validator != nil {
+				reward += validator.EffectiveBalance / phase0.Gwei(rewardQuotient)
+			}
+		}
+		return reward, newValidators
+	}
+
+	// slashings & exits of the block itself
+	blockBody := block.GetBlock()
+	slashingCandidates := getSlashings(blockBody, block)
+	blockSlashed := make(map[phase0.ValidatorIndex]bool, len(slashedValidators))
+	for validatorIndex := range slashedValidators {
+		blockSlashed[validatorIndex] = true
+	}
+	for _, candidate := range slashingCandidates {
+		reward, newValidators := slashingReward(candidate.validators, blockSlashed)
+		for _, validatorIndex := range newValidators {
+			blockSlashed[validatorIndex] = true
+		}
+		simulation.IncludedSlashings++
+		simulation.IncludedSlashingReward += reward
+	}
+
+	blockExited := make(map[phase0.ValidatorIndex]bool, len(exitedValidators))
+	for validatorIndex := range exitedValidators {
+		blockExited[validatorIndex] = true
+	}
+	if voluntaryExits, err := blockBody.VoluntaryExits(); err == nil {
+		simulation.IncludedExits = uint64(len(voluntaryExits))
+		for _, voluntaryExit := range voluntaryExits {
+			blockExited[voluntaryExit.Message.ValidatorIndex] = true
+		}
+	}
+
+	// candidates from the source blocks
+	for _, sourceBlock := range sourceBlocks {
+		sourceBody := sourceBlock.GetBlock()
+		for _, candidate := range getSlashings(sourceBody, sourceBlock) {
+			reward, newValidators := slashingReward(candidate.validators, blockSlashed)
+			if len(newValidators) == 0 {
+				continue
+			}
+
+			candidate.slashing.Validators = newValidators
+			candidate.slashing.Reward = reward
+			slashingCandidates = append(slashingCandidates, candidate)
+			simulation.MissedSlashings = append(simulation.MissedSlashings, candidate.slashing)
+			for _, validatorIndex := range newValidators {
+				blockSlashed[validatorIndex] = true
+			}
+		}
+
+		if voluntaryExits, err := sourceBody.VoluntaryExits(); err == nil {
+			for _, voluntaryExit := range voluntaryExits {
+				if voluntaryExit.Message.Epoch > slotEpoch || blockExited[voluntaryExit.Message.ValidatorIndex] {
+					continue
+				}
+
+				blockExited[voluntaryExit.Message.ValidatorIndex] = true
+				simulation.MissedExits = append(simulation.MissedExits, &PackingVoluntaryExit{
+					ValidatorIndex: voluntaryExit.Message.ValidatorIndex,
+					Epoch:          voluntaryExit.Message.Epoch,
+					SeenInSlot:     sourceBlock.Slot,
+					SeenInRoot:     sourceBlock.Root,
+				})
+			}
+		}
+	}
+
+	// greedy slashing packing within the per type limits
+	optimalSlashed := slashedValidators
+	proposerSlashingCount := 0
+	attesterSlashingCount := 0
+	for {
+		var bestCandidate *slashingCandidate
+		var bestReward phase0.Gwei
+		for _, candidate := range slashingCandidates {
+			if candidate.slashing.InOptimal {
+				continue
+			}
+			if candidate.slashing.IsAttesterSlashing && attesterSlashingCount >= attesterSlashingLimit {
+				continue
+			}
+			if !candidate.slashing.IsAttesterSlashing && proposerSlashingCount >= maxProposerSlashings {
+				continue
+			}
+
+			reward, newValidators := slashingReward(candidate.validators, optimalSlashed)
+			if len(newValidators) > 0 && (bestCandidate == nil || reward > bestReward) {
+				bestCandidate = candidate
+				bestReward = reward
+			}
+		}
+		if bestCandidate == nil {
+			break
+		}
+
+		bestCandidate.slashing.InOptimal = true
+		for _, validatorIndex := range bestCandidate.validators {
+			optimalSlashed[validatorIndex] = true
+		}
+		if bestCandidate.slashing.IsAttesterSlashing {
+			attesterSlashingCount++
+		} else {
+			proposerSlashingCount++
+		}
+		simulation.OptimalSlashings++
+		simulation.OptimalSlashingReward += bestReward
+	}
+
+	if simulation.OptimalSlashingReward < simulation.IncludedSlashingReward {
+		simulation.OptimalSlashings = simulation.IncludedSlashings
+		simulation.OptimalSlashingReward = simulation.IncludedSlashingReward
+	}
+
+	// exits beyond the remaining capacity of the block could not have been included
+	exitCapacity := maxVoluntaryExits - int(simulation.IncludedExits)
+	if exitCapacity < 0 {
+		exitCapacity = 0
+	}
+	if len(simulation.MissedExits) > exitCapacity {
+		simulation.MissedExits = simulation.MissedExits[:exitCapacity]
+	}
+}
@@ -0,0 +1,121 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// blockBodyFetcher fetches block bodies from multiple clients in parallel.
+// concurrent requests for the same block root are deduplicated, so multiple clients backfilling the same chain segment
+// only load each block body once.
+type blockBodyFetcher struct {
+	indexer     *Indexer
+	maxParallel int
+	fetchMutex  sync.Mutex
+	fetchMap    map[phase0.Root]*blockBodyFetch
+}
+
+// blockBodyFetch represents a pending or completed block body request.
+type blockBodyFetch struct {
+	doneChan chan bool
+	body     *spec.VersionedSignedBeaconBlock
+	err      error
+}
+
+// newBlockBodyFetcher creates a new instance of blockBodyFetcher.
+func newBlockBodyFetcher(indexer *Indexer, maxParallel int) *blockBodyFetcher {
+	if maxParallel < 1 {
+		maxParallel = 1
+	}
+
+	return &blockBodyFetcher{
+		indexer:     indexer,
+		maxParallel: maxParallel,
+		fetchMap:    map[phase0.Root]*blockBodyFetch{},
+	}
+}
+
+// fetchBlockBodies loads the block bodies for the given block roots with a bounded worker pool.
+// requests are distributed across all ready clients, with the requesting client used as fallback.
+func (fetcher *blockBodyFetcher) fetchBlockBodies(ctx context.Context, client *Client, roots []phase0.Root) map[phase0.Root]*spec.VersionedSignedBeaconBlock {
+	fetchClients := []*Client{client}
+	for _, readyClient := range fetcher.indexer.GetReadyClients(false) {
+		if readyClient != client {
+			fetchClients = append(fetchClients, readyClient)
+		}
+	}
+
+	results := make(map[phase0.Root]*spec.VersionedSignedBeaconBlock, len(roots))
+	resultsMutex := sync.Mutex{}
+	limiter := make(chan bool, fetcher.maxParallel)
+	waitGroup := sync.WaitGroup{}
+
+	for idx, root := range roots {
+		waitGroup.Add(1)
+		limiter <- true
+
+		go func(idx int, root phase0.Root) {
+			defer func() {
+				<-limiter
+				waitGroup.Done()
+			}()
+
+			body, err := fetcher.fetchBlockBody(ctx, root, fetchClients[idx%len(fetchClients)], client)
+			if err != nil {
+				fetcher.indexer.logger.Debugf("failed prefetching block body [0x%x]: %v", root[:], err)
+				return
+			}
+
+			resultsMutex.Lock()
+			results[root] = body
+			resultsMutex.Unlock()
+		}(idx, root)
+	}
+
+	waitGroup.Wait()
+
+	return results
+}
+
+// fetchBlockBody loads a single block body, waiting for already pending requests for the same root.
+func (fetcher *blockBodyFetcher) fetchBlockBody(ctx context.Context, root phase0.Root, client *Client, fallbackClient *Client) (*spec.VersionedSignedBeaconBlock, error) {
+	fetcher.fetchMutex.Lock()
+	fetch := fetcher.fetchMap[root]
+	if fetch != nil {
+		fetcher.fetchMutex.Unlock()
+
+		select {
+		case <-fetch.doneChan:
+			return fetch.body, fetch.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	fetch = &blockBodyFetch{
+		doneChan: make(chan bool),
+	}
+	fetcher.fetchMap[root] = fetch
+	fetcher.fetchMutex.Unlock()
+
+	defer func() {
+		fetcher.fetchMutex.Lock()
+		delete(fetcher.fetchMap, root)
+		fetcher.fetchMutex.Unlock()
+		close(fetch.doneChan)
+	}()
+
+	fetch.body, fetch.err = LoadBeaconBlock(ctx, client, root)
+	if (fetch.err != nil || fetch.body == nil) && fallbackClient != nil && fallbackClient != client {
+		fetch.body, fetch.err = LoadBeaconBlock(ctx, fallbackClient, root)
+	}
+	if fetch.err == nil && fetch.body == nil {
+		fetch.err = fmt.Errorf("block body not found")
+	}
+
+	return fetch.body, fetch.err
+}
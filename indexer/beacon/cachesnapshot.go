@@ -0,0 +1,137 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// persistCacheSnapshot writes all unfinalized blocks that are not yet persisted to the unfinalized_blocks table,
+// refreshes the fork ids of all cached blocks and stores a snapshot marker in the explorer state.
+// this ensures the full unfinalized cache can be restored on the next startup without refetching blocks from the clients.
+func (indexer *Indexer) persistCacheSnapshot() error {
+	chainState := indexer.consensusPool.GetChainState()
+	finalizedSlot := chainState.GetFinalizedSlot()
+	t1 := time.Now()
+
+	insertBlocks := []*dbtypes.UnfinalizedBlock{}
+	insertedBlocks := []*Block{}
+	forkIdUpdates := map[ForkKey][][]byte{}
+	headSlot := phase0.Slot(0)
+	blockCount := uint64(0)
+
+	for _, block := range indexer.blockCache.getLatestBlocks(0, nil) {
+		if block.Slot < finalizedSlot {
+			continue
+		}
+
+		blockCount++
+		if block.Slot > headSlot {
+			headSlot = block.Slot
+		}
+
+		if block.fokChecked {
+			forkIdUpdates[block.forkId] = append(forkIdUpdates[block.forkId], block.Root[:])
+		}
+
+		if block.isInUnfinalizedDb || block.header == nil || block.block == nil {
+			continue
+		}
+
+		dbBlock, err := block.buildUnfinalizedBlock(indexer.blockCompression)
+		if err != nil {
+			indexer.logger.Warnf("failed building unfinalized block %v [%v] for cache snapshot: %v", block.Slot, block.Root.String(), err)
+			continue
+		}
+
+		insertBlocks = append(insertBlocks, dbBlock)
+		insertedBlocks = append(insertedBlocks, block)
+	}
+
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		for _, dbBlock := range insertBlocks {
+			err := db.InsertUnfinalizedBlock(dbBlock, tx)
+			if err != nil {
+				return fmt.Errorf("error inserting unfinalized block %v: %v", dbBlock.Slot, err)
+			}
+		}
+
+		for forkId, roots := range forkIdUpdates {
+			batchSize := 1000
+			for start := 0; start < len(roots); start += batchSize {
+				end := start + batchSize
+				if end > len(roots) {
+					end = len(roots)
+				}
+
+				err := db.UpdateUnfinalizedBlockForkId(roots[start:end], uint64(forkId), tx)
+				if err != nil {
+					return fmt.Errorf("error updating unfinalized block fork ids: %v", err)
+				}
+			}
+		}
+
+		err := indexer.forkCache.updateForkState(tx)
+		if err != nil {
+			return err
+		}
+
+		return db.SetExplorerState("indexer.cachestate", &dbtypes.IndexerCacheState{
+			FinalizedEpoch: uint64(indexer.lastFinalizedEpoch),
+			PrunedEpoch:    uint64(indexer.lastPrunedEpoch),
+			HeadSlot:       uint64(headSlot),
+			BlockCount:     blockCount,
+			SnapshotTime:   time.Now().Unix(),
+		}, tx)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, block := range insertedBlocks {
+		block.isInUnfinalizedDb = true
+	}
+
+	indexer.logger.Infof("persisted unfinalized cache snapshot: %v blocks (%v new), head slot %v (%v ms)", blockCount, len(insertBlocks), headSlot, time.Since(t1).Milliseconds())
+
+	return nil
+}
+
+// loadCacheSnapshotState loads the snapshot marker written by the last shutdown and clears it,
+// so an unclean shutdown can be detected on the next startup.
+func (indexer *Indexer) loadCacheSnapshotState() *dbtypes.IndexerCacheState {
+	cacheState := dbtypes.IndexerCacheState{}
+	_, err := db.GetExplorerState(context.Background(), "indexer.cachestate", &cacheState)
+	if err != nil || cacheState.SnapshotTime == 0 {
+		return nil
+	}
+
+	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.SetExplorerState("indexer.cachestate", &dbtypes.IndexerCacheState{}, tx)
+	})
+	if err != nil {
+		indexer.logger.WithError(err).Warnf("failed resetting cache snapshot state")
+	}
+
+	return &cacheState
+}
+
+// StopIndexer stops the indexing process and persists a snapshot of the unfinalized cache.
+func (indexer *Indexer) StopIndexer() {
+	if !indexer.running {
+		return
+	}
+
+	indexer.running = false
+
+	err := indexer.persistCacheSnapshot()
+	if err != nil {
+		indexer.logger.WithError(err).Errorf("failed persisting unfinalized cache snapshot")
+	}
+}
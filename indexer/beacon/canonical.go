@@ -11,6 +11,9 @@ import (
 
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
 )
 
 const FarFutureEpoch = phase0.Epoch(math.MaxUint64)
@@ -22,6 +25,13 @@ type ChainHead struct {
 	PerEpochVotingPercent []float64   // The voting percentage in the last epochs (ascendeing order).
 }
 
+// HeadChange is a canonical head switch, OldHead is nil for the first head after startup.
+type HeadChange struct {
+	OldHead *Block
+	NewHead *Block
+	IsReorg bool
+}
+
 // GetCanonicalHead returns the canonical head block of the chain.
 func (indexer *Indexer) GetCanonicalHead(overrideForkId *ForkKey) *Block {
 	indexer.computeCanonicalChain()
@@ -133,8 +143,21 @@ func (indexer *Indexer) computeCanonicalChain() bool {
 	}
 
 	t1 := time.Now()
+	prevHead := indexer.canonicalHead
 
 	defer func() {
+		isReorg := prevHead != nil && headBlock != nil && prevHead.Root != headBlock.Root && !indexer.blockCache.isCanonicalBlock(prevHead.Root, headBlock.Root)
+		if isReorg {
+			go indexer.logChainReorgEvent(prevHead, headBlock)
+		}
+		if headBlock != nil && (prevHead == nil || prevHead.Root != headBlock.Root) {
+			indexer.headDispatcher.Fire(&HeadChange{
+				OldHead: prevHead,
+				NewHead: headBlock,
+				IsReorg: isReorg,
+			})
+		}
+
 		indexer.canonicalHead = headBlock
 		indexer.cachedChainHeads = chainHeads
 		indexer.canonicalComputation = latestBlockRoot
@@ -223,6 +246,40 @@ func (indexer *Indexer) computeCanonicalChain() bool {
 	return true
 }
 
+// logChainReorgEvent adds a reorg event for a canonical head switch to a block that does not descend from the previous head.
+func (indexer *Indexer) logChainReorgEvent(oldHead *Block, newHead *Block) {
+	if indexer.blockCache.getBlockByRoot(oldHead.Root) == nil {
+		return // old head already pruned from cache, can't determine the reorg base
+	}
+
+	// walk back from the old head to the latest common ancestor of both heads
+	baseBlock := oldHead
+	for baseBlock != nil && !indexer.blockCache.isCanonicalBlock(baseBlock.Root, newHead.Root) {
+		parentRoot := baseBlock.GetParentRoot()
+		if parentRoot == nil {
+			baseBlock = nil
+			break
+		}
+		baseBlock = indexer.blockCache.getBlockByRoot(*parentRoot)
+	}
+
+	eventData := &dbtypes.IndexerEventChainReorgData{
+		OldHeadSlot: uint64(oldHead.Slot),
+		OldHeadRoot: oldHead.Root.String(),
+		NewHeadSlot: uint64(newHead.Slot),
+		NewHeadRoot: newHead.Root.String(),
+	}
+	if baseBlock != nil {
+		eventData.BaseSlot = uint64(baseBlock.Slot)
+		eventData.BaseRoot = baseBlock.Root.String()
+	}
+
+	err := db.AppendIndexerEvent(dbtypes.IndexerEventChainReorg, fmt.Sprintf("reorg:%v:%v", oldHead.Root.String(), newHead.Root.String()), eventData)
+	if err != nil {
+		indexer.logger.Warnf("failed logging reorg event: %v", err)
+	}
+}
+
 // aggregateForkVotes aggregates the votes for a given fork.
 func (indexer *Indexer) aggregateForkVotes(forkId ForkKey, epochLimit uint64) (totalVotes phase0.Gwei, epochPercent []float64) {
 	chainState := indexer.consensusPool.GetChainState()
@@ -350,80 +407,74 @@ func (indexer *Indexer) aggregateForkVotes(forkId ForkKey, epochLimit uint64) (t
 	return
 }
 
-// GetEpochValidatorSet returns the full validator set for a given epoch, including balances and validator status.
-// If an overrideForkId is provided, the validator set for the fork is returned.
-func (indexer *Indexer) GetEpochValidatorSet(epoch phase0.Epoch, overrideForkId *ForkKey, withBalances bool) []*v1.Validator {
-	var epochStats *EpochStats
-
-	if withBalances {
-		chainState := indexer.consensusPool.GetChainState()
-
-		canonicalHead := indexer.GetCanonicalHead(overrideForkId)
-		if canonicalHead == nil {
-			return []*v1.Validator{}
-		}
-
-		headEpoch := chainState.EpochOfSlot(canonicalHead.Slot)
+// getValidatorSetEpochStats returns the most recent loaded epoch stats on the canonical chain that are not ahead of the given epoch.
+// returns false if no epoch state is loaded within the last 2 epochs.
+func (indexer *Indexer) getValidatorSetEpochStats(epoch phase0.Epoch, overrideForkId *ForkKey) (*EpochStats, bool) {
+	chainState := indexer.consensusPool.GetChainState()
 
-		for {
-			cEpoch := chainState.EpochOfSlot(canonicalHead.Slot)
-			if headEpoch-cEpoch > 2 {
-				return []*v1.Validator{}
-			}
+	canonicalHead := indexer.GetCanonicalHead(overrideForkId)
+	if canonicalHead == nil {
+		return nil, false
+	}
 
-			dependentBlock := indexer.blockCache.getDependentBlock(chainState, canonicalHead, nil)
-			if dependentBlock == nil {
-				return []*v1.Validator{}
-			}
-			canonicalHead = dependentBlock
+	headEpoch := chainState.EpochOfSlot(canonicalHead.Slot)
 
-			stats := indexer.epochCache.getEpochStats(cEpoch, dependentBlock.Root)
-			if cEpoch > 0 && (stats == nil || stats.dependentState == nil || stats.dependentState.loadingStatus != 2) {
-				continue // retry previous state
-			}
+	for {
+		cEpoch := chainState.EpochOfSlot(canonicalHead.Slot)
+		if headEpoch-cEpoch > 2 {
+			return nil, false
+		}
 
-			epochStats = stats
+		dependentBlock := indexer.blockCache.getDependentBlock(chainState, canonicalHead, nil)
+		if dependentBlock == nil {
+			return nil, false
+		}
+		canonicalHead = dependentBlock
 
-			if cEpoch > 0 && stats.epoch > epoch {
-				continue
-			}
+		stats := indexer.epochCache.getEpochStats(cEpoch, dependentBlock.Root)
+		if cEpoch > 0 && (stats == nil || stats.dependentState == nil || stats.dependentState.loadingStatus != 2) {
+			continue // retry previous state
+		}
 
-			break
+		if cEpoch > 0 && stats.epoch > epoch {
+			continue
 		}
+
+		return stats, true
 	}
+}
 
-	hasBalances := epochStats != nil && epochStats.dependentState != nil && epochStats.dependentState.loadingStatus == 2
+// GetRecentValidatorBalances returns the validator balances of the most recent loaded epoch state on the canonical chain.
+// The returned epoch stats reference the epoch & dependent block the balances belong to.
+func (indexer *Indexer) GetRecentValidatorBalances(overrideForkId *ForkKey) ([]phase0.Gwei, *EpochStats) {
+	chainState := indexer.consensusPool.GetChainState()
 
-	var basicValidatorSet []*phase0.Validator
-	if hasBalances {
-		basicValidatorSet = indexer.validatorCache.getValidatorSetForRoot(epochStats.dependentRoot)
-	} else {
-		basicValidatorSet = indexer.validatorCache.getValidatorSet(overrideForkId)
+	canonicalHead := indexer.GetCanonicalHead(overrideForkId)
+	if canonicalHead == nil {
+		return nil, nil
 	}
 
-	validatorSet := make([]*v1.Validator, len(basicValidatorSet))
-	for index, validator := range basicValidatorSet {
-		var balance *phase0.Gwei
-		if hasBalances {
-			balance = &epochStats.dependentState.validatorBalances[index]
-		}
+	headEpoch := chainState.EpochOfSlot(canonicalHead.Slot)
 
-		state := v1.ValidatorToState(validator, balance, epoch, FarFutureEpoch)
+	for {
+		cEpoch := chainState.EpochOfSlot(canonicalHead.Slot)
+		if headEpoch-cEpoch > 2 {
+			return nil, nil
+		}
 
-		validatorData := &v1.Validator{
-			Index:     phase0.ValidatorIndex(index),
-			Status:    state,
-			Validator: basicValidatorSet[index],
+		dependentBlock := indexer.blockCache.getDependentBlock(chainState, canonicalHead, nil)
+		if dependentBlock == nil {
+			return nil, nil
 		}
+		canonicalHead = dependentBlock
 
-		if balance != nil {
-			validatorData.Balance = *balance
+		stats := indexer.epochCache.getEpochStats(cEpoch, dependentBlock.Root)
+		if stats == nil || stats.dependentState == nil || stats.dependentState.loadingStatus != 2 {
+			continue // retry previous state
 		}
 
-		validatorSet[index] = validatorData
+		return stats.dependentState.validatorBalances, stats
 	}
-
-	return validatorSet
 }
 
 // GetEpochValidator returns the full validator set for a given epoch, including balances and validator status.
@@ -432,40 +483,11 @@ func (indexer *Indexer) GetEpochValidator(validatorIndex phase0.ValidatorIndex,
 	var epochStats *EpochStats
 
 	if withBalances {
-		chainState := indexer.consensusPool.GetChainState()
-
-		canonicalHead := indexer.GetCanonicalHead(overrideForkId)
-		if canonicalHead == nil {
+		var ok bool
+		epochStats, ok = indexer.getValidatorSetEpochStats(epoch, overrideForkId)
+		if !ok {
 			return nil
 		}
-
-		headEpoch := chainState.EpochOfSlot(canonicalHead.Slot)
-
-		for {
-			cEpoch := chainState.EpochOfSlot(canonicalHead.Slot)
-			if headEpoch-cEpoch > 2 {
-				return nil
-			}
-
-			dependentBlock := indexer.blockCache.getDependentBlock(chainState, canonicalHead, nil)
-			if dependentBlock == nil {
-				return nil
-			}
-			canonicalHead = dependentBlock
-
-			stats := indexer.epochCache.getEpochStats(cEpoch, dependentBlock.Root)
-			if cEpoch > 0 && (stats == nil || stats.dependentState == nil || stats.dependentState.loadingStatus != 2) {
-				continue // retry previous state
-			}
-
-			epochStats = stats
-
-			if cEpoch > 0 && stats.epoch > epoch {
-				continue
-			}
-
-			break
-		}
 	}
 
 	hasBalances := epochStats != nil && epochStats.dependentState != nil && epochStats.dependentState.loadingStatus == 2
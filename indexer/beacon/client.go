@@ -135,6 +135,11 @@ func (c *Client) emitBlockLogEntry(slot phase0.Slot, root phase0.Root, source st
 // runClientLoop runs the client event processing subroutine.
 func (c *Client) runClientLoop() error {
 	// 1 - load & process head block
+	if c.client.GetStatus() == consensus.ClientStatusSynchronizing {
+		c.logger.Debugf("client is synchronizing, retrying later")
+		return nil
+	}
+
 	headSlot, headRoot := c.client.GetLastHead()
 	if bytes.Equal(headRoot[:], consensus.NullRoot[:]) {
 		c.logger.Debugf("no chain head from client, retrying later")
@@ -143,7 +148,7 @@ func (c *Client) runClientLoop() error {
 
 	c.headRoot = headRoot
 
-	headBlock, isNew, processingTimes, err := c.processBlock(headSlot, headRoot, nil)
+	headBlock, isNew, processingTimes, err := c.processBlock(headSlot, headRoot, nil, nil)
 	if err != nil {
 		return fmt.Errorf("failed processing head block: %v", err)
 	}
@@ -286,7 +291,7 @@ func (c *Client) processHeadEvent(headEvent *v1.HeadEvent) error {
 
 // processStreamBlock processes a block received from the stream (either via block or head events).
 func (c *Client) processStreamBlock(slot phase0.Slot, root phase0.Root) (*Block, error) {
-	block, isNew, processingTimes, err := c.processBlock(slot, root, nil)
+	block, isNew, processingTimes, err := c.processBlock(slot, root, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -340,7 +345,8 @@ func (c *Client) processReorg(oldHead *Block, newHead *Block) error {
 }
 
 // processBlock processes a block (from stream & polling).
-func (c *Client) processBlock(slot phase0.Slot, root phase0.Root, header *phase0.SignedBeaconBlockHeader) (block *Block, isNew bool, processingTimes []time.Duration, err error) {
+// header and body are optional and will be loaded from the client if not supplied.
+func (c *Client) processBlock(slot phase0.Slot, root phase0.Root, header *phase0.SignedBeaconBlockHeader, body *spec.VersionedSignedBeaconBlock) (block *Block, isNew bool, processingTimes []time.Duration, err error) {
 	chainState := c.client.GetPool().GetChainState()
 	finalizedSlot := chainState.GetFinalizedSlot()
 	processingTimes = make([]time.Duration, 3)
@@ -379,6 +385,9 @@ func (c *Client) processBlock(slot phase0.Slot, root phase0.Root, header *phase0
 	}
 
 	isNew, err = block.EnsureBlock(func() (*spec.VersionedSignedBeaconBlock, error) {
+		if body != nil {
+			return body, nil
+		}
 
 		t1 := time.Now()
 		defer func() {
@@ -402,6 +411,8 @@ func (c *Client) processBlock(slot phase0.Slot, root phase0.Root, header *phase0
 			c.logger.Warnf("failed processing new fork: %v", err2)
 		}
 
+		c.indexer.checkSlotTimeAnomalies(block)
+
 		// insert into unfinalized blocks
 		var dbBlock *dbtypes.UnfinalizedBlock
 		dbBlock, err = block.buildUnfinalizedBlock(c.indexer.blockCompression)
@@ -429,6 +440,17 @@ func (c *Client) processBlock(slot phase0.Slot, root phase0.Root, header *phase0
 
 		block.isInUnfinalizedDb = true
 		c.indexer.blockCache.latestBlock = block
+		c.indexer.blockDispatcher.Fire(block)
+
+		eventData := &dbtypes.IndexerEventBlockAddedData{
+			Slot:       uint64(block.Slot),
+			Root:       block.Root.String(),
+			ParentRoot: block.GetHeader().Message.ParentRoot.String(),
+			Proposer:   uint64(block.GetHeader().Message.ProposerIndex),
+		}
+		if err2 := db.AppendIndexerEvent(dbtypes.IndexerEventBlockAdded, fmt.Sprintf("block:%v", block.Root.String()), eventData); err2 != nil {
+			c.logger.Warnf("failed logging block event: %v", err2)
+		}
 	}
 
 	if slot < finalizedSlot && !block.isInFinalizedDb {
@@ -441,11 +463,20 @@ func (c *Client) processBlock(slot phase0.Slot, root phase0.Root, header *phase0
 	return
 }
 
+// backfillBlockRef holds a reference to a block that needs to be loaded during backfilling.
+type backfillBlockRef struct {
+	slot   phase0.Slot
+	root   phase0.Root
+	header *phase0.SignedBeaconBlockHeader
+}
+
 // backfillParentBlocks backfills parent blocks up to the finalization checkpoint or known in cache.
+// the parent chain is walked via block headers first, then the missing block bodies are loaded in parallel batches.
 func (c *Client) backfillParentBlocks(headBlock *Block) error {
 	chainState := c.client.GetPool().GetChainState()
 
-	// walk backwards and load all blocks until we reach a block that is marked as seen by this client or is smaller than finalized
+	// walk backwards and load all headers until we reach a block that is marked as seen by this client or is smaller than finalized
+	backfillRefs := []*backfillBlockRef{}
 	parentRoot := *headBlock.GetParentRoot()
 	for {
 		var parentHead *phase0.SignedBeaconBlockHeader
@@ -475,31 +506,31 @@ func (c *Client) backfillParentBlocks(headBlock *Block) error {
 		}
 
 		parentSlot := parentHead.Message.Slot
-		isNewBlock := false
 
 		if parentSlot < chainState.GetFinalizedSlot() {
 			c.logger.Debugf("backfill cache: reached finalized slot %v:%v [0x%x]", chainState.EpochOfSlot(parentSlot), parentSlot, parentRoot)
 			break
 		}
 
-		var processingTimes []time.Duration
 		if parentBlock == nil {
-			var err error
-
-			parentBlock, isNewBlock, processingTimes, err = c.processBlock(parentSlot, parentRoot, parentHead)
-			if err != nil {
-				return fmt.Errorf("could not process block [0x%x]: %v", parentRoot, err)
-			}
+			backfillRefs = append(backfillRefs, &backfillBlockRef{
+				slot:   parentSlot,
+				root:   parentRoot,
+				header: parentHead,
+			})
+		} else {
+			c.emitBlockLogEntry(parentSlot, parentRoot, "backfill", false, parentBlock.forkId, nil)
 		}
 
-		c.emitBlockLogEntry(parentSlot, parentRoot, "backfill", isNewBlock, parentBlock.forkId, processingTimes)
-
 		if parentSlot == 0 {
 			c.logger.Debugf("backfill cache: reached gensis slot [0x%x]", parentRoot)
 			break
 		}
 
-		parentRootPtr := parentBlock.GetParentRoot()
+		var parentRootPtr *phase0.Root
+		if parentBlock != nil {
+			parentRootPtr = parentBlock.GetParentRoot()
+		}
 		if parentRootPtr != nil {
 			parentRoot = *parentRootPtr
 		} else {
@@ -511,5 +542,37 @@ func (c *Client) backfillParentBlocks(headBlock *Block) error {
 			break
 		}
 	}
+
+	// process missing blocks in ascending order, so parent blocks are always processed before their children
+	batchSize := int(c.indexer.backfillBatchSize)
+	for batchEnd := len(backfillRefs); batchEnd > 0; batchEnd -= batchSize {
+		batchStart := batchEnd - batchSize
+		if batchStart < 0 {
+			batchStart = 0
+		}
+
+		batchRefs := backfillRefs[batchStart:batchEnd]
+		batchRoots := make([]phase0.Root, len(batchRefs))
+		for idx, ref := range batchRefs {
+			batchRoots[idx] = ref.root
+		}
+
+		t1 := time.Now()
+		bodies := c.indexer.bodyFetcher.fetchBlockBodies(c.getContext(), c, batchRoots)
+		if len(batchRefs) > 1 {
+			c.logger.Debugf("backfill cache: prefetched %v/%v block bodies (%v ms)", len(bodies), len(batchRefs), time.Since(t1).Milliseconds())
+		}
+
+		for idx := len(batchRefs) - 1; idx >= 0; idx-- {
+			ref := batchRefs[idx]
+			block, isNewBlock, processingTimes, err := c.processBlock(ref.slot, ref.root, ref.header, bodies[ref.root])
+			if err != nil {
+				return fmt.Errorf("could not process block [0x%x]: %v", ref.root, err)
+			}
+
+			c.emitBlockLogEntry(ref.slot, ref.root, "backfill", isNewBlock, block.forkId, processingTimes)
+		}
+	}
+
 	return nil
 }
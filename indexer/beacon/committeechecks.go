@@ -0,0 +1,278 @@
+package beacon
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/indexer/beacon/duties"
+)
+
+// maxCommitteeAnomalies is the number of recent committee anomalies kept in memory.
+const maxCommitteeAnomalies = 200
+
+type CommitteeAnomalyType uint8
+
+const (
+	CommitteeAnomalyCount       CommitteeAnomalyType = 1 // number of committees differs from get_committee_count_per_slot
+	CommitteeAnomalySize        CommitteeAnomalyType = 2 // committee size is not an even split of the active validators
+	CommitteeAnomalyCoverage    CommitteeAnomalyType = 3 // active validators not assigned to exactly one committee
+	CommitteeAnomalyTarget      CommitteeAnomalyType = 4 // committee sizes outside the bounds implied by TARGET_COMMITTEE_SIZE
+	CommitteeAnomalyAttestation CommitteeAnomalyType = 5 // attestation aggregation bits do not match the computed committee size
+)
+
+// CommitteeAnomaly represents a deviation of the computed (or attested) committees from the spec formulas.
+type CommitteeAnomaly struct {
+	Type          CommitteeAnomalyType
+	Epoch         phase0.Epoch
+	Slot          phase0.Slot
+	Committee     int64 // -1 for epoch wide anomalies
+	DependentRoot phase0.Root
+	BlockRoot     phase0.Root // block with the mismatching attestation (attestation anomalies only)
+	Expected      uint64
+	Actual        uint64
+	DetectedAt    time.Time
+	Description   string
+}
+
+// CheckEpochCommittees verifies the attester committees of an epoch against the spec formulas.
+// the committee count per slot follows get_committee_count_per_slot, and compute_committee splits the shuffled
+// active validators into committees that differ by at most one validator.
+func CheckEpochCommittees(chainState *consensus.ChainState, epoch phase0.Epoch, activeCount uint64, attesterDuties [][][]duties.ActiveIndiceIndex) []*CommitteeAnomaly {
+	specs := chainState.GetSpecs()
+	anomalies := []*CommitteeAnomaly{}
+	firstSlot := chainState.EpochToSlot(epoch)
+
+	if uint64(len(attesterDuties)) != specs.SlotsPerEpoch {
+		return append(anomalies, &CommitteeAnomaly{
+			Type:        CommitteeAnomalyCount,
+			Epoch:       epoch,
+			Slot:        firstSlot,
+			Committee:   -1,
+			Expected:    specs.SlotsPerEpoch,
+			Actual:      uint64(len(attesterDuties)),
+			Description: fmt.Sprintf("duties cover %v slots, expected %v", len(attesterDuties), specs.SlotsPerEpoch),
+		})
+	}
+
+	// get_committee_count_per_slot, evaluated independently from the duty computation
+	committeesPerSlot := max(1, min(specs.MaxCommitteesPerSlot, activeCount/specs.SlotsPerEpoch/specs.TargetCommitteeSize))
+	committeesCount := committeesPerSlot * specs.SlotsPerEpoch
+	minSize := activeCount / committeesCount
+	maxSize := minSize
+	if activeCount%committeesCount != 0 {
+		maxSize++
+	}
+
+	// with more than one committee per slot, the committee count formula guarantees at least TARGET_COMMITTEE_SIZE members.
+	// below MAX_COMMITTEES_PER_SLOT, committees stay below twice the target size.
+	if committeesPerSlot > 1 && minSize < specs.TargetCommitteeSize {
+		anomalies = append(anomalies, &CommitteeAnomaly{
+			Type:        CommitteeAnomalyTarget,
+			Epoch:       epoch,
+			Slot:        firstSlot,
+			Committee:   -1,
+			Expected:    specs.TargetCommitteeSize,
+			Actual:      minSize,
+			Description: fmt.Sprintf("committee size %v below target size %v with %v committees per slot", minSize, specs.TargetCommitteeSize, committeesPerSlot),
+		})
+	}
+	if committeesPerSlot < specs.MaxCommitteesPerSlot && minSize >= 2*specs.TargetCommitteeSize {
+		anomalies = append(anomalies, &CommitteeAnomaly{
+			Type:        CommitteeAnomalyTarget,
+			Epoch:       epoch,
+			Slot:        firstSlot,
+			Committee:   -1,
+			Expected:    2*specs.TargetCommitteeSize - 1,
+			Actual:      minSize,
+			Description: fmt.Sprintf("committee size %v exceeds twice the target size %v with only %v committees per slot", minSize, specs.TargetCommitteeSize, committeesPerSlot),
+		})
+	}
+
+	assigned := make([]uint8, activeCount)
+	assignedCount := uint64(0)
+	invalidCount := uint64(0)
+
+	for slotIndex, slotCommittees := range attesterDuties {
+		slot := firstSlot + phase0.Slot(slotIndex)
+		if uint64(len(slotCommittees)) != committeesPerSlot {
+			anomalies = append(anomalies, &CommitteeAnomaly{
+				Type:        CommitteeAnomalyCount,
+				Epoch:       epoch,
+				Slot:        slot,
+				Committee:   -1,
+				Expected:    committeesPerSlot,
+				Actual:      uint64(len(slotCommittees)),
+				Description: fmt.Sprintf("slot has %v committees, expected %v for %v active validators", len(slotCommittees), committeesPerSlot, activeCount),
+			})
+		}
+
+		for committeeIndex, committee := range slotCommittees {
+			committeeSize := uint64(len(committee))
+			if committeeSize < minSize || committeeSize > maxSize {
+				anomalies = append(anomalies, &CommitteeAnomaly{
+					Type:        CommitteeAnomalySize,
+					Epoch:       epoch,
+					Slot:        slot,
+					Committee:   int64(committeeIndex),
+					Expected:    minSize,
+					Actual:      committeeSize,
+					Description: fmt.Sprintf("committee has %v members, expected %v-%v", committeeSize, minSize, maxSize),
+				})
+			}
+
+			for _, indice := range committee {
+				if uint64(indice) >= activeCount {
+					invalidCount++
+					continue
+				}
+				if assigned[indice] < 255 {
+					assigned[indice]++
+				}
+				assignedCount++
+			}
+		}
+	}
+
+	missingCount := uint64(0)
+	duplicateCount := uint64(0)
+	for _, count := range assigned {
+		if count == 0 {
+			missingCount++
+		} else if count > 1 {
+			duplicateCount++
+		}
+	}
+
+	if missingCount > 0 || duplicateCount > 0 || invalidCount > 0 {
+		anomalies = append(anomalies, &CommitteeAnomaly{
+			Type:        CommitteeAnomalyCoverage,
+			Epoch:       epoch,
+			Slot:        firstSlot,
+			Committee:   -1,
+			Expected:    activeCount,
+			Actual:      assignedCount,
+			Description: fmt.Sprintf("%v assignments for %v active validators (%v unassigned, %v duplicate, %v out of range)", assignedCount, activeCount, missingCount, duplicateCount, invalidCount),
+		})
+	}
+
+	return anomalies
+}
+
+// checkEpochCommittees checks the computed attester duties of an epoch and records the detected anomalies.
+func (indexer *Indexer) checkEpochCommittees(epochStats *EpochStats, values *EpochStatsValues) {
+	if values.AttesterDuties == nil {
+		return
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	anomalies := CheckEpochCommittees(chainState, epochStats.epoch, values.ActiveValidators, values.AttesterDuties)
+	for _, anomaly := range anomalies {
+		anomaly.DependentRoot = epochStats.dependentRoot
+	}
+
+	indexer.addCommitteeAnomalies(anomalies)
+}
+
+// checkAttestationCommitteeSize compares the aggregation bits length of an included attestation with the computed committee sizes.
+// a mismatch means the proposing client and dora disagree about the committee shuffling.
+// values may be nil if the epoch stats values are pruned and could not be restored, the check is skipped then.
+func (indexer *Indexer) checkAttestationCommitteeSize(epochStats *EpochStats, values *EpochStatsValues, block *Block, attSlot phase0.Slot, committees []uint64, bitsLength uint64) {
+	if values == nil {
+		return
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	slotIndex := chainState.SlotToSlotIndex(attSlot)
+	if uint64(slotIndex) >= uint64(len(values.AttesterDuties)) {
+		return
+	}
+
+	slotCommittees := values.AttesterDuties[slotIndex]
+	expectedLength := uint64(0)
+	for _, committee := range committees {
+		if committee >= uint64(len(slotCommittees)) {
+			indexer.addCommitteeAnomalies([]*CommitteeAnomaly{{
+				Type:          CommitteeAnomalyAttestation,
+				Epoch:         epochStats.epoch,
+				Slot:          attSlot,
+				Committee:     int64(committee),
+				DependentRoot: epochStats.dependentRoot,
+				BlockRoot:     block.Root,
+				Expected:      uint64(len(slotCommittees)),
+				Actual:        committee + 1,
+				Description:   fmt.Sprintf("attestation in block %v references committee %v, but the slot has %v committees", block.Slot, committee, len(slotCommittees)),
+			}})
+			return
+		}
+		expectedLength += uint64(len(slotCommittees[committee]))
+	}
+
+	if expectedLength == bitsLength {
+		return
+	}
+
+	committee := int64(-1)
+	if len(committees) == 1 {
+		committee = int64(committees[0])
+	}
+
+	indexer.addCommitteeAnomalies([]*CommitteeAnomaly{{
+		Type:          CommitteeAnomalyAttestation,
+		Epoch:         epochStats.epoch,
+		Slot:          attSlot,
+		Committee:     committee,
+		DependentRoot: epochStats.dependentRoot,
+		BlockRoot:     block.Root,
+		Expected:      expectedLength,
+		Actual:        bitsLength,
+		Description:   fmt.Sprintf("attestation in block %v has %v aggregation bits, computed committee size is %v", block.Slot, bitsLength, expectedLength),
+	}})
+}
+
+// addCommitteeAnomalies records committee anomalies, anomalies that have already been recorded for the same duties are skipped.
+func (indexer *Indexer) addCommitteeAnomalies(anomalies []*CommitteeAnomaly) {
+	if len(anomalies) == 0 {
+		return
+	}
+
+	indexer.committeeAnomaliesMutex.Lock()
+	defer indexer.committeeAnomaliesMutex.Unlock()
+
+	now := time.Now()
+	for _, anomaly := range anomalies {
+		isDuplicate := false
+		for _, recorded := range indexer.committeeAnomalies {
+			if recorded.Type == anomaly.Type && recorded.Slot == anomaly.Slot && recorded.Committee == anomaly.Committee && recorded.DependentRoot == anomaly.DependentRoot && recorded.BlockRoot == anomaly.BlockRoot {
+				isDuplicate = true
+				break
+			}
+		}
+		if isDuplicate {
+			continue
+		}
+
+		anomaly.DetectedAt = now
+		indexer.logger.Warnf("committee anomaly in epoch %v (slot %v, dependent root %v): %v", anomaly.Epoch, anomaly.Slot, anomaly.DependentRoot.String(), anomaly.Description)
+		indexer.committeeAnomalies = append(indexer.committeeAnomalies, anomaly)
+	}
+
+	if len(indexer.committeeAnomalies) > maxCommitteeAnomalies {
+		indexer.committeeAnomalies = indexer.committeeAnomalies[len(indexer.committeeAnomalies)-maxCommitteeAnomalies:]
+	}
+}
+
+// GetCommitteeAnomalies returns the recently detected committee anomalies, newest first.
+func (indexer *Indexer) GetCommitteeAnomalies() []*CommitteeAnomaly {
+	indexer.committeeAnomaliesMutex.Lock()
+	defer indexer.committeeAnomaliesMutex.Unlock()
+
+	anomalies := make([]*CommitteeAnomaly, len(indexer.committeeAnomalies))
+	for i, anomaly := range indexer.committeeAnomalies {
+		anomalies[len(anomalies)-i-1] = anomaly
+	}
+
+	return anomalies
+}
@@ -3,6 +3,10 @@ package beacon
 import (
 	"bytes"
 	"compress/zlib"
+	"fmt"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
 )
 
 // compressBytes compresses the given byte slice using zlib compression algorithm.
@@ -30,3 +34,115 @@ func decompressBytes(data []byte) ([]byte, error) {
 
 	return buf.Bytes(), nil
 }
+
+// blockCompressionType defines the compression algorithm used for block bodies persisted in the unfinalized & orphaned block tables.
+type blockCompressionType uint8
+
+const (
+	blockCompressionNone blockCompressionType = iota
+	blockCompressionZlib
+	blockCompressionZstd
+)
+
+// zstdCodec compresses block bodies with zstd, using the most recent trained dictionary if available.
+// the decoder holds all known dictionaries, so bodies compressed with older dictionaries can still be decompressed.
+type zstdCodec struct {
+	mutex      sync.RWMutex
+	encoder    *zstd.Encoder
+	decoder    *zstd.Decoder
+	dicts      map[uint32][]byte
+	activeDict uint32
+}
+
+// blockZstdCodec is the zstd codec shared by all block body (de)serializations.
+var blockZstdCodec = newZstdCodec()
+
+func newZstdCodec() *zstdCodec {
+	codec := &zstdCodec{
+		dicts: map[uint32][]byte{},
+	}
+
+	if err := codec.reset(); err != nil {
+		panic(fmt.Sprintf("failed initializing zstd codec: %v", err))
+	}
+
+	return codec
+}
+
+// reset recreates the encoder & decoder with the current dictionaries. must be called with the mutex held.
+func (codec *zstdCodec) reset() error {
+	encoderOpts := []zstd.EOption{
+		zstd.WithEncoderLevel(zstd.SpeedDefault),
+		zstd.WithEncoderConcurrency(1),
+	}
+	if codec.activeDict != 0 {
+		encoderOpts = append(encoderOpts, zstd.WithEncoderDict(codec.dicts[codec.activeDict]))
+	}
+
+	encoder, err := zstd.NewWriter(nil, encoderOpts...)
+	if err != nil {
+		return fmt.Errorf("failed creating zstd encoder: %w", err)
+	}
+
+	dicts := make([][]byte, 0, len(codec.dicts))
+	for _, dict := range codec.dicts {
+		dicts = append(dicts, dict)
+	}
+
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderDicts(dicts...))
+	if err != nil {
+		return fmt.Errorf("failed creating zstd decoder: %w", err)
+	}
+
+	if codec.encoder != nil {
+		codec.encoder.Close()
+	}
+	if codec.decoder != nil {
+		codec.decoder.Close()
+	}
+
+	codec.encoder = encoder
+	codec.decoder = decoder
+	return nil
+}
+
+// addDictionary registers a trained dictionary. if activate is true, the dictionary is used for all further compressions.
+func (codec *zstdCodec) addDictionary(dict []byte, activate bool) (uint32, error) {
+	dictInfo, err := zstd.InspectDictionary(dict)
+	if err != nil {
+		return 0, fmt.Errorf("invalid zstd dictionary: %w", err)
+	}
+
+	codec.mutex.Lock()
+	defer codec.mutex.Unlock()
+
+	dictId := dictInfo.ID()
+	codec.dicts[dictId] = dict
+	if activate {
+		codec.activeDict = dictId
+	}
+
+	return dictId, codec.reset()
+}
+
+// getActiveDictionary returns the id of the dictionary used for compression, or 0 if no dictionary is available.
+func (codec *zstdCodec) getActiveDictionary() uint32 {
+	codec.mutex.RLock()
+	defer codec.mutex.RUnlock()
+
+	return codec.activeDict
+}
+
+func (codec *zstdCodec) compress(data []byte) []byte {
+	codec.mutex.RLock()
+	defer codec.mutex.RUnlock()
+
+	return codec.encoder.EncodeAll(data, make([]byte, 0, len(data)/4))
+}
+
+func (codec *zstdCodec) decompress(data []byte) ([]byte, error) {
+	codec.mutex.RLock()
+	defer codec.mutex.RUnlock()
+
+	return codec.decoder.DecodeAll(data, nil)
+}
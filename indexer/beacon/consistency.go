@@ -0,0 +1,332 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// consistencyCheckBatchSize is the number of epochs loaded from the db per consistency check query.
+const consistencyCheckBatchSize = 32
+
+// consistencyRepairRetries is the number of clients tried for repairing an inconsistent epoch.
+const consistencyRepairRetries = 3
+
+// maxChainRepairs is the number of recent chain repairs kept in memory.
+const maxChainRepairs = 100
+
+// ChainRepair represents a finalized epoch with inconsistent slot data and the outcome of its repair.
+type ChainRepair struct {
+	Epoch      phase0.Epoch
+	Issues     []string
+	DetectedAt time.Time
+	RepairedAt time.Time
+	Success    bool
+	Error      string
+}
+
+// ChainConsistencyState holds the progress of the finalized chain consistency checker.
+type ChainConsistencyState struct {
+	Running       bool
+	CheckedEpoch  phase0.Epoch
+	LastCheck     time.Time
+	IssueCount    uint64
+	RepairedCount uint64
+}
+
+// consistencyChecker scans the finalized range of the slots table for gaps and broken parent links
+// and re-synchronizes affected epochs from the clients.
+type consistencyChecker struct {
+	indexer *Indexer
+	logger  logrus.FieldLogger
+
+	stateMutex    sync.Mutex
+	running       bool
+	checkedEpoch  phase0.Epoch
+	lastCheck     time.Time
+	issueCount    uint64
+	repairedCount uint64
+	repairs       []*ChainRepair
+}
+
+func newConsistencyChecker(indexer *Indexer, logger logrus.FieldLogger) *consistencyChecker {
+	checker := &consistencyChecker{
+		indexer: indexer,
+		logger:  logger,
+	}
+
+	// restore checker state
+	checkerState := &dbtypes.IndexerConsistencyState{}
+	if _, err := db.GetExplorerState(context.Background(), "indexer.consistencystate", checkerState); err == nil {
+		checker.checkedEpoch = phase0.Epoch(checkerState.Epoch)
+	}
+
+	return checker
+}
+
+func (checker *consistencyChecker) start() {
+	checker.stateMutex.Lock()
+	defer checker.stateMutex.Unlock()
+
+	if checker.running {
+		return
+	}
+	checker.running = true
+
+	go checker.runCheckerLoop()
+}
+
+func (checker *consistencyChecker) runCheckerLoop() {
+	defer utils.HandleSubroutinePanic("consistencyChecker.runCheckerLoop")
+
+	chainState := checker.indexer.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	epochDuration := specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch)
+
+	for {
+		checkEpoch, endEpoch := checker.getCheckRange()
+		if checkEpoch >= endEpoch {
+			time.Sleep(epochDuration)
+			continue
+		}
+
+		if endEpoch > checkEpoch+consistencyCheckBatchSize {
+			endEpoch = checkEpoch + consistencyCheckBatchSize
+		}
+
+		if !checker.checkEpochRange(checkEpoch, endEpoch) {
+			time.Sleep(10 * time.Second)
+			continue
+		}
+
+		checker.stateMutex.Lock()
+		checker.checkedEpoch = endEpoch
+		checker.lastCheck = time.Now()
+		checker.stateMutex.Unlock()
+
+		err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			return db.SetExplorerState("indexer.consistencystate", &dbtypes.IndexerConsistencyState{
+				Epoch: uint64(endEpoch),
+			}, tx)
+		})
+		if err != nil {
+			checker.logger.Warnf("failed persisting consistency checker state: %v", err)
+		}
+
+		time.Sleep(1 * time.Second)
+	}
+}
+
+// getCheckRange returns the range of epochs that are ready to be checked.
+// only epochs that are finalized and already processed by the synchronizer are checked.
+func (checker *consistencyChecker) getCheckRange() (phase0.Epoch, phase0.Epoch) {
+	checker.stateMutex.Lock()
+	checkEpoch := checker.checkedEpoch
+	checker.stateMutex.Unlock()
+
+	endEpoch := checker.indexer.lastFinalizedEpoch
+	if endEpoch > 0 {
+		endEpoch-- // leave the most recently finalized epoch to the finalization routine
+	}
+
+	sync := checker.indexer.synchronizer
+	sync.stateMutex.Lock()
+	if sync.running && sync.currentEpoch < endEpoch {
+		endEpoch = sync.currentEpoch
+	}
+	sync.stateMutex.Unlock()
+
+	return checkEpoch, endEpoch
+}
+
+// checkEpochRange checks & repairs the epochs in the given range. returns false if the slot entries could not be loaded.
+func (checker *consistencyChecker) checkEpochRange(firstEpoch phase0.Epoch, endEpoch phase0.Epoch) bool {
+	chainState := checker.indexer.consensusPool.GetChainState()
+	firstSlot := chainState.EpochStartSlot(firstEpoch)
+	lastSlot := chainState.EpochStartSlot(endEpoch) - 1
+
+	entries := db.GetSlotChainEntries(uint64(firstSlot), uint64(lastSlot))
+	if entries == nil {
+		return false
+	}
+
+	parentRoot := db.GetHighestRootBeforeSlot(uint64(firstSlot), false)
+	entryIdx := 0
+
+	for epoch := firstEpoch; epoch < endEpoch; epoch++ {
+		epochEntries := []*dbtypes.SlotChainEntry{}
+		epochEndSlot := uint64(chainState.EpochStartSlot(epoch + 1))
+		for entryIdx < len(entries) && entries[entryIdx].Slot < epochEndSlot {
+			epochEntries = append(epochEntries, entries[entryIdx])
+			entryIdx++
+		}
+
+		issues, lastRoot := checker.checkEpochEntries(epoch, epochEntries, parentRoot)
+		if len(issues) > 0 {
+			checker.repairEpoch(epoch, issues)
+			lastRoot = db.GetHighestRootBeforeSlot(epochEndSlot, false)
+		}
+		parentRoot = lastRoot
+	}
+
+	return true
+}
+
+// checkEpochEntries checks the slot entries of an epoch for gaps & parent root mismatches.
+// returns the detected issues and the root of the last canonical block.
+func (checker *consistencyChecker) checkEpochEntries(epoch phase0.Epoch, entries []*dbtypes.SlotChainEntry, parentRoot []byte) ([]string, []byte) {
+	chainState := checker.indexer.consensusPool.GetChainState()
+	firstSlot := uint64(chainState.EpochStartSlot(epoch))
+	lastSlot := uint64(chainState.EpochStartSlot(epoch+1)) - 1
+
+	issues := []string{}
+	slotEntries := map[uint64][]*dbtypes.SlotChainEntry{}
+	for _, entry := range entries {
+		slotEntries[entry.Slot] = append(slotEntries[entry.Slot], entry)
+	}
+
+	for slot := firstSlot; slot <= lastSlot; slot++ {
+		var canonicalBlock *dbtypes.SlotChainEntry
+		canonicalCount := 0
+		for _, entry := range slotEntries[slot] {
+			if entry.Status == dbtypes.Canonical {
+				canonicalBlock = entry
+				canonicalCount++
+			}
+		}
+
+		if len(slotEntries[slot]) == 0 {
+			issues = append(issues, fmt.Sprintf("slot %v: no canonical or missed slot entry", slot))
+			continue
+		}
+		if canonicalCount > 1 {
+			issues = append(issues, fmt.Sprintf("slot %v: %v canonical blocks", slot, canonicalCount))
+		}
+		if canonicalBlock == nil {
+			continue
+		}
+
+		if slot > 0 && parentRoot != nil && !bytes.Equal(canonicalBlock.ParentRoot, parentRoot) {
+			issues = append(issues, fmt.Sprintf("slot %v: parent root 0x%x does not match previous canonical block 0x%x", slot, canonicalBlock.ParentRoot, parentRoot))
+		}
+		parentRoot = canonicalBlock.Root
+	}
+
+	return issues, parentRoot
+}
+
+// repairEpoch re-synchronizes an inconsistent epoch from the clients and verifies the result.
+func (checker *consistencyChecker) repairEpoch(epoch phase0.Epoch, issues []string) {
+	repair := &ChainRepair{
+		Epoch:      epoch,
+		Issues:     issues,
+		DetectedAt: time.Now(),
+	}
+
+	checker.logger.Warnf("detected %v inconsistencies in finalized epoch %v (%v), re-synchronizing epoch", len(issues), epoch, issues[0])
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repairSync := &synchronizer{
+		indexer:      checker.indexer,
+		logger:       checker.logger,
+		syncCtx:      ctx,
+		cachedBlocks: make(map[phase0.Slot]*Block),
+		repairMode:   true,
+	}
+
+	var err error
+	done := false
+	syncClients := repairSync.getSyncClients(epoch)
+	if len(syncClients) == 0 {
+		err = fmt.Errorf("no clients available")
+	}
+	for retry := 0; retry < len(syncClients) && retry < consistencyRepairRetries && !done; retry++ {
+		done, err = repairSync.syncEpoch(epoch, syncClients[retry], false)
+		if err != nil {
+			checker.logger.Warnf("re-synchronization of epoch %v with client %v failed: %v", epoch, syncClients[retry].client.GetName(), err)
+		}
+	}
+
+	if done {
+		// verify the repaired epoch
+		chainState := checker.indexer.consensusPool.GetChainState()
+		firstSlot := chainState.EpochStartSlot(epoch)
+		entries := db.GetSlotChainEntries(uint64(firstSlot), uint64(chainState.EpochStartSlot(epoch+1)-1))
+		remainingIssues, _ := checker.checkEpochEntries(epoch, entries, db.GetHighestRootBeforeSlot(uint64(firstSlot), false))
+		if len(remainingIssues) > 0 {
+			err = fmt.Errorf("%v issues remaining after re-synchronization (%v)", len(remainingIssues), remainingIssues[0])
+		}
+	} else if err == nil {
+		err = fmt.Errorf("re-synchronization aborted")
+	}
+
+	repair.RepairedAt = time.Now()
+	repair.Success = err == nil
+	if err != nil {
+		repair.Error = err.Error()
+		checker.logger.Errorf("repair of finalized epoch %v failed: %v", epoch, err)
+	} else {
+		checker.logger.Infof("repaired finalized epoch %v", epoch)
+	}
+
+	checker.stateMutex.Lock()
+	defer checker.stateMutex.Unlock()
+
+	checker.issueCount += uint64(len(issues))
+	if repair.Success {
+		checker.repairedCount++
+	}
+	checker.repairs = append(checker.repairs, repair)
+	if len(checker.repairs) > maxChainRepairs {
+		checker.repairs = checker.repairs[len(checker.repairs)-maxChainRepairs:]
+	}
+}
+
+// GetChainConsistencyState returns the progress of the finalized chain consistency checker.
+func (indexer *Indexer) GetChainConsistencyState() *ChainConsistencyState {
+	checker := indexer.consistency
+	if checker == nil {
+		return &ChainConsistencyState{}
+	}
+
+	checker.stateMutex.Lock()
+	defer checker.stateMutex.Unlock()
+
+	return &ChainConsistencyState{
+		Running:       checker.running,
+		CheckedEpoch:  checker.checkedEpoch,
+		LastCheck:     checker.lastCheck,
+		IssueCount:    checker.issueCount,
+		RepairedCount: checker.repairedCount,
+	}
+}
+
+// GetChainRepairs returns the recent repairs of the finalized chain consistency checker, newest first.
+func (indexer *Indexer) GetChainRepairs() []*ChainRepair {
+	checker := indexer.consistency
+	if checker == nil {
+		return []*ChainRepair{}
+	}
+
+	checker.stateMutex.Lock()
+	defer checker.stateMutex.Unlock()
+
+	repairs := make([]*ChainRepair, len(checker.repairs))
+	for i, repair := range checker.repairs {
+		repairs[len(repairs)-i-1] = repair
+	}
+
+	return repairs
+}
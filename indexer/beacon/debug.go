@@ -2,6 +2,7 @@ package beacon
 
 import (
 	"reflect"
+	"sort"
 
 	mapsize "github.com/520MianXiangDuiXiang520/MapSize"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -34,12 +35,15 @@ type CacheDebugStats struct {
 		ParentIdCacheLen  uint64
 		ParentIdCacheHit  uint64
 		ParentIdCacheMiss uint64
+		EvictedBodies     uint64
+		ForkMemory        []*ForkMemoryStats
 	}
 	ValidatorCache struct {
 		Validators        uint64
 		ValidatorDiffs    uint64
 		ValidatorData     uint64
 		ValidatorActivity uint64
+		ActivityEpochs    uint64
 		PubkeyMap         CacheDebugMapSize
 	}
 }
@@ -54,6 +58,7 @@ func (indexer *Indexer) GetCacheDebugStats() *CacheDebugStats {
 	indexer.getBlockCacheDebugStats(cacheStats)
 	indexer.getEpochCacheDebugStats(cacheStats)
 	indexer.getForkCacheDebugStats(cacheStats)
+	cacheStats.ForkCache.ForkMemory = indexer.GetForkMemoryStats()
 	indexer.getValidatorCacheDebugStats(cacheStats)
 	return cacheStats
 }
@@ -142,36 +147,94 @@ func (indexer *Indexer) getForkCacheDebugStats(cacheStats *CacheDebugStats) {
 	cacheStats.ForkCache.ParentIdCacheLen = uint64(indexer.forkCache.parentIdCache.Len())
 	cacheStats.ForkCache.ParentIdCacheHit = indexer.forkCache.parentIdCacheHit
 	cacheStats.ForkCache.ParentIdCacheMiss = indexer.forkCache.parentIdCacheMiss
+	cacheStats.ForkCache.EvictedBodies = indexer.forkMemoryEvictedBodies
 }
 
 func (indexer *Indexer) getValidatorCacheDebugStats(cacheStats *CacheDebugStats) {
 	indexer.validatorCache.cacheMutex.RLock()
 	defer indexer.validatorCache.cacheMutex.RUnlock()
 
-	cacheStats.ValidatorCache.Validators = uint64(len(indexer.validatorCache.valsetCache))
+	cacheStats.ValidatorCache.Validators = uint64(indexer.validatorCache.finalColumns.len())
 
-	validatorsMap := map[*phase0.Validator]bool{}
-	for _, validator := range indexer.validatorCache.valsetCache {
-		refs := len(validator.validatorDiffs)
-		for _, diff := range validator.validatorDiffs {
-			validatorsMap[diff.validator] = true
+	finalValidators := uint64(0)
+	for _, known := range indexer.validatorCache.finalColumns.known {
+		if known {
+			finalValidators++
 		}
+	}
 
-		if validator.finalValidator != nil {
-			validatorsMap[validator.finalValidator] = true
-			refs++
+	validatorsMap := map[*phase0.Validator]bool{}
+	for _, diffs := range indexer.validatorCache.validatorDiffs {
+		for _, diff := range diffs {
+			validatorsMap[diff.validator] = true
 		}
-		cacheStats.ValidatorCache.ValidatorDiffs += uint64(refs)
+		cacheStats.ValidatorCache.ValidatorDiffs += uint64(len(diffs))
 	}
 
-	cacheStats.ValidatorCache.ValidatorData = uint64(len(validatorsMap))
+	cacheStats.ValidatorCache.ValidatorDiffs += finalValidators
+	cacheStats.ValidatorCache.ValidatorData = uint64(len(validatorsMap)) + finalValidators
 
 	for _, recentActivity := range indexer.validatorCache.validatorActivityMap {
 		cacheStats.ValidatorCache.ValidatorActivity += uint64(len(recentActivity))
 	}
+	cacheStats.ValidatorCache.ActivityEpochs = uint64(len(indexer.validatorCache.activityEpochs))
 
 	cacheStats.ValidatorCache.PubkeyMap = CacheDebugMapSize{
 		Length: len(indexer.validatorCache.pubkeyMap),
 		Size:   mapsize.Size(indexer.validatorCache.pubkeyMap),
 	}
 }
+
+// ForkDebugInfo represents a fork in the fork cache dump.
+type ForkDebugInfo struct {
+	ForkId     uint64
+	BaseSlot   uint64
+	BaseRoot   phase0.Root
+	LeafSlot   uint64
+	LeafRoot   phase0.Root
+	ParentFork uint64
+	HeadSlot   *uint64      `json:",omitempty"`
+	HeadRoot   *phase0.Root `json:",omitempty"`
+}
+
+// ForkCacheDump represents the state of the fork cache.
+type ForkCacheDump struct {
+	FinalizedForkId uint64
+	LastForkId      uint64
+	Forks           []*ForkDebugInfo
+}
+
+// GetForkCacheDump returns all forks in the fork cache, ordered by fork id.
+func (indexer *Indexer) GetForkCacheDump() *ForkCacheDump {
+	indexer.forkCache.cacheMutex.RLock()
+	defer indexer.forkCache.cacheMutex.RUnlock()
+
+	dump := &ForkCacheDump{
+		FinalizedForkId: uint64(indexer.forkCache.finalizedForkId),
+		LastForkId:      uint64(indexer.forkCache.lastForkId),
+		Forks:           make([]*ForkDebugInfo, 0, len(indexer.forkCache.forkMap)),
+	}
+
+	for _, fork := range indexer.forkCache.forkMap {
+		forkInfo := &ForkDebugInfo{
+			ForkId:     uint64(fork.forkId),
+			BaseSlot:   uint64(fork.baseSlot),
+			BaseRoot:   fork.baseRoot,
+			LeafSlot:   uint64(fork.leafSlot),
+			LeafRoot:   fork.leafRoot,
+			ParentFork: uint64(fork.parentFork),
+		}
+		if fork.headBlock != nil {
+			headSlot := uint64(fork.headBlock.Slot)
+			forkInfo.HeadSlot = &headSlot
+			forkInfo.HeadRoot = &fork.headBlock.Root
+		}
+		dump.Forks = append(dump.Forks, forkInfo)
+	}
+
+	sort.Slice(dump.Forks, func(a, b int) bool {
+		return dump.Forks[a].ForkId < dump.Forks[b].ForkId
+	})
+
+	return dump
+}
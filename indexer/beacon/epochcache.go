@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/lru"
 
 	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/utils"
 )
 
 // epochStatsKey is the primary key for EpochStats entries in cache.
@@ -435,6 +436,11 @@ func (cache *epochCache) loadEpochStats(epochStats *EpochStats) bool {
 	if epochStats.dependentState.loadingStatus != 2 {
 		// epoch state could not be loaded
 		epochStats.dependentState.retryCount++
+		if epochStats.dependentState.retryCount >= beaconStateFallbackRetryCount && !epochStats.dependentState.validatorSetFallback {
+			epochStats.dependentState.validatorSetFallback = true
+			// keep the validator set up to date via batched validator queries while the full state keeps failing
+			go cache.loadValidatorSetFallback(client, epochStats)
+		}
 		return false
 	}
 
@@ -461,3 +467,34 @@ func (cache *epochCache) loadEpochStats(epochStats *EpochStats) bool {
 
 	return true
 }
+
+// loadValidatorSetFallback refreshes the validator set cache for the dependent state of the epoch via batched validator queries.
+// this is used when the full beacon state repeatedly fails to load, which may happen for large validator sets on some clients.
+func (cache *epochCache) loadValidatorSetFallback(client *Client, epochStats *EpochStats) {
+	defer utils.HandleSubroutinePanic("epochCache.loadValidatorSetFallback")
+
+	var blockHeader *phase0.SignedBeaconBlockHeader
+	if block := cache.indexer.blockCache.getBlockByRoot(epochStats.dependentRoot); block != nil {
+		blockHeader = block.GetHeader()
+	}
+	if blockHeader == nil {
+		var err error
+		blockHeader, err = LoadBeaconHeader(client.getContext(), client, epochStats.dependentRoot)
+		if err != nil {
+			client.logger.Warnf("failed loading dependent block header for validator set fallback (dep: %v): %v", epochStats.dependentRoot.String(), err)
+			return
+		}
+	}
+
+	t1 := time.Now()
+	validatorSet, _, err := LoadValidatorSet(client.getContext(), client, blockHeader.Message.StateRoot)
+	if err != nil {
+		client.logger.Warnf("failed loading validator set for epoch %v via batched queries (dep: %v): %v", epochStats.epoch, epochStats.dependentRoot.String(), err)
+		return
+	}
+
+	chainState := cache.indexer.consensusPool.GetChainState()
+	cache.indexer.validatorCache.updateValidatorSet(chainState.EpochOfSlot(blockHeader.Message.Slot), epochStats.dependentRoot, validatorSet)
+
+	client.logger.Infof("refreshed validator set for epoch %v via batched queries (dep: %v, %v validators, %v ms)", epochStats.epoch, epochStats.dependentRoot.String(), len(validatorSet), time.Since(t1).Milliseconds())
+}
@@ -0,0 +1,209 @@
+package beacon
+
+import (
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+
+	"github.com/ethpandaops/dora/indexer/beacon/duties"
+)
+
+// EpochJustification holds the justification bits & checkpoints of a beacon state.
+type EpochJustification struct {
+	JustificationBits uint8
+	PreviousJustified phase0.Checkpoint
+	CurrentJustified  phase0.Checkpoint
+	Finalized         phase0.Checkpoint
+}
+
+// EpochFinalityBreakdown holds the target vote breakdown & justification status for an epoch.
+type EpochFinalityBreakdown struct {
+	Epoch            phase0.Epoch
+	TargetRoot       phase0.Root
+	EligibleAmount   phase0.Gwei
+	TargetVoteAmount phase0.Gwei
+	Targets          []*EpochTargetVotes
+	Missing          *EpochTargetVotes
+
+	// justification as seen by the most recent cached state that accounts for votes of this epoch.
+	// the state of epoch N contains the justification bits processed in the N-1 epoch transition, so bit (N-2-epoch) refers to this epoch.
+	Justification      *EpochJustification
+	JustificationEpoch phase0.Epoch
+	JustificationFinal bool
+	Justified          bool
+}
+
+// EpochTargetVotes holds the votes for a specific target checkpoint, grouped by the validator groups of the caller.
+type EpochTargetVotes struct {
+	TargetRoot     phase0.Root
+	TargetEpoch    phase0.Epoch
+	IsCanonical    bool
+	VoteAmount     phase0.Gwei
+	ValidatorCount uint64
+	Groups         map[string]*EpochVoteGroup
+}
+
+// EpochVoteGroup holds the aggregated votes of a validator group.
+type EpochVoteGroup struct {
+	VoteAmount     phase0.Gwei
+	ValidatorCount uint64
+}
+
+func (votes *EpochTargetVotes) addVote(group string, amount phase0.Gwei) {
+	votes.VoteAmount += amount
+	votes.ValidatorCount++
+
+	groupVotes := votes.Groups[group]
+	if groupVotes == nil {
+		groupVotes = &EpochVoteGroup{}
+		votes.Groups[group] = groupVotes
+	}
+	groupVotes.VoteAmount += amount
+	groupVotes.ValidatorCount++
+}
+
+// GetEpochFinalityBreakdown aggregates the target votes for the given epoch by target checkpoint & validator group.
+// getGroup classifies validators into groups (eg. by client type). returns nil if the epoch duties are not available in cache.
+func (indexer *Indexer) GetEpochFinalityBreakdown(epoch phase0.Epoch, overrideForkId *ForkKey, getGroup func(validatorIndex phase0.ValidatorIndex) string) *EpochFinalityBreakdown {
+	epochStats := indexer.GetEpochStats(epoch, overrideForkId)
+	if epochStats == nil {
+		return nil
+	}
+
+	epochStatsValues := epochStats.GetOrLoadValues(indexer, true, false)
+	if epochStatsValues == nil {
+		return nil
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	headBlock := indexer.GetCanonicalHead(overrideForkId)
+	votingBlocks := epochStats.getVotingBlocks(indexer, headBlock)
+
+	breakdown := &EpochFinalityBreakdown{
+		Epoch:          epoch,
+		EligibleAmount: epochStatsValues.EffectiveBalance,
+		Missing: &EpochTargetVotes{
+			Groups: map[string]*EpochVoteGroup{},
+		},
+	}
+
+	if len(votingBlocks) > 0 {
+		firstBlock := votingBlocks[0]
+		if chainState.EpochOfSlot(firstBlock.Slot) == epoch && chainState.SlotToSlotIndex(firstBlock.Slot) == 0 {
+			breakdown.TargetRoot = firstBlock.Root
+		} else if parentRoot := firstBlock.GetParentRoot(); parentRoot != nil {
+			breakdown.TargetRoot = *parentRoot
+		}
+	}
+
+	targetVotes := map[phase0.Root]*EpochTargetVotes{}
+	votedBitlist := bitfield.NewBitlist(epochStatsValues.ActiveValidators)
+
+	addCommitteeVotes := func(targetVote *EpochTargetVotes, slotIndex phase0.Slot, committee uint64, aggregationBits bitfield.Bitfield, aggregationBitsOffset uint64) uint64 {
+		voteDuties := epochStatsValues.AttesterDuties[slotIndex][committee]
+		for bitIdx, validatorIndice := range voteDuties {
+			if !aggregationBits.BitAt(uint64(bitIdx)+aggregationBitsOffset) || votedBitlist.BitAt(uint64(validatorIndice)) {
+				continue
+			}
+
+			votedBitlist.SetBitAt(uint64(validatorIndice), true)
+			targetVote.addVote(getGroup(epochStatsValues.ActiveIndices[validatorIndice]), epochStatsValues.GetEffectiveBalance(validatorIndice))
+		}
+
+		return uint64(len(voteDuties))
+	}
+
+	for _, block := range votingBlocks {
+		blockBody := block.GetBlock()
+		if blockBody == nil {
+			continue
+		}
+
+		attestations, err := blockBody.Attestations()
+		if err != nil {
+			continue
+		}
+
+		for _, attVersioned := range attestations {
+			attData, err := attVersioned.Data()
+			if err != nil || chainState.EpochOfSlot(attData.Slot) != epoch {
+				continue
+			}
+
+			attAggregationBits, err := attVersioned.AggregationBits()
+			if err != nil {
+				continue
+			}
+
+			targetVote := targetVotes[attData.Target.Root]
+			if targetVote == nil {
+				targetVote = &EpochTargetVotes{
+					TargetRoot:  attData.Target.Root,
+					TargetEpoch: attData.Target.Epoch,
+					IsCanonical: attData.Target.Root == breakdown.TargetRoot,
+					Groups:      map[string]*EpochVoteGroup{},
+				}
+				targetVotes[attData.Target.Root] = targetVote
+			}
+
+			slotIndex := chainState.SlotToSlotIndex(attData.Slot)
+			if attVersioned.Version >= spec.DataVersionElectra {
+				committeeBits, err := attVersioned.CommitteeBits()
+				if err != nil {
+					continue
+				}
+
+				aggregationBitsOffset := uint64(0)
+				for _, committee := range committeeBits.BitIndices() {
+					if uint64(committee) >= specs.MaxCommitteesPerSlot {
+						continue
+					}
+
+					aggregationBitsOffset += addCommitteeVotes(targetVote, slotIndex, uint64(committee), attAggregationBits, aggregationBitsOffset)
+				}
+			} else {
+				addCommitteeVotes(targetVote, slotIndex, uint64(attData.Index), attAggregationBits, 0)
+			}
+		}
+	}
+
+	// collect validators that did not vote at all
+	for validatorIndice := uint64(0); validatorIndice < epochStatsValues.ActiveValidators; validatorIndice++ {
+		if votedBitlist.BitAt(validatorIndice) {
+			continue
+		}
+
+		breakdown.Missing.addVote(getGroup(epochStatsValues.ActiveIndices[validatorIndice]), epochStatsValues.GetEffectiveBalance(duties.ActiveIndiceIndex(validatorIndice)))
+	}
+
+	breakdown.Targets = make([]*EpochTargetVotes, 0, len(targetVotes))
+	for _, targetVote := range targetVotes {
+		if targetVote.IsCanonical {
+			breakdown.TargetVoteAmount = targetVote.VoteAmount
+		}
+		breakdown.Targets = append(breakdown.Targets, targetVote)
+	}
+	sort.Slice(breakdown.Targets, func(i, j int) bool {
+		return breakdown.Targets[i].VoteAmount > breakdown.Targets[j].VoteAmount
+	})
+
+	// get justification status from the states of the following epochs
+	for stateEpoch := epoch + 3; stateEpoch >= epoch+2; stateEpoch-- {
+		stateEpochStats := indexer.GetEpochStats(stateEpoch, overrideForkId)
+		if stateEpochStats == nil || stateEpochStats.dependentState == nil || stateEpochStats.dependentState.justification == nil {
+			continue
+		}
+
+		justification := stateEpochStats.dependentState.justification
+		breakdown.Justification = justification
+		breakdown.JustificationEpoch = stateEpoch
+		breakdown.Justified = justification.JustificationBits&(1<<(stateEpoch-epoch-2)) != 0
+		breakdown.JustificationFinal = breakdown.Justified || stateEpoch == epoch+3
+		break
+	}
+
+	return breakdown
+}
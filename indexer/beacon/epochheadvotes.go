@@ -0,0 +1,142 @@
+package beacon
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// EpochHeadVotes holds the head vote correctness of the included attestations for an epoch.
+type EpochHeadVotes struct {
+	Epoch        phase0.Epoch
+	VoteCount    uint64 // number of validators with an included attestation
+	CorrectCount uint64 // number of validators that voted for the canonical head of their attestation slot
+	Groups       map[string]*HeadVoteGroup
+}
+
+// HeadVoteGroup holds the aggregated head votes of a validator group.
+type HeadVoteGroup struct {
+	VoteCount    uint64
+	CorrectCount uint64
+}
+
+// GetEpochHeadVotes checks the head votes of all attestations for the given epoch against the canonical chain.
+// a head vote is correct if it points to the latest canonical block at or before the attestation slot.
+// only the first included attestation of each validator is accounted. getGroup classifies validators into groups (eg. by client type).
+// returns nil if the epoch duties are not available in cache.
+func (indexer *Indexer) GetEpochHeadVotes(epoch phase0.Epoch, overrideForkId *ForkKey, getGroup func(validatorIndex phase0.ValidatorIndex) string) *EpochHeadVotes {
+	epochStats := indexer.GetEpochStats(epoch, overrideForkId)
+	if epochStats == nil {
+		return nil
+	}
+
+	epochStatsValues := epochStats.GetOrLoadValues(indexer, true, false)
+	if epochStatsValues == nil {
+		return nil
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	headBlock := indexer.GetCanonicalHead(overrideForkId)
+	votingBlocks := epochStats.getVotingBlocks(indexer, headBlock)
+
+	headVotes := &EpochHeadVotes{
+		Epoch:  epoch,
+		Groups: map[string]*HeadVoteGroup{},
+	}
+	if len(votingBlocks) == 0 {
+		return headVotes
+	}
+
+	// resolve the canonical head for each slot of the epoch, empty slots inherit the head of the previous slot
+	headRoots := make([]phase0.Root, specs.SlotsPerEpoch)
+	var currentHead phase0.Root
+	if parentRoot := votingBlocks[0].GetParentRoot(); parentRoot != nil {
+		currentHead = *parentRoot
+	}
+	firstSlot := chainState.EpochToSlot(epoch)
+	blockIdx := 0
+	for slotIndex := range headRoots {
+		slot := firstSlot + phase0.Slot(slotIndex)
+		for blockIdx < len(votingBlocks) && votingBlocks[blockIdx].Slot <= slot {
+			currentHead = votingBlocks[blockIdx].Root
+			blockIdx++
+		}
+		headRoots[slotIndex] = currentHead
+	}
+
+	votedBitlist := bitfield.NewBitlist(epochStatsValues.ActiveValidators)
+
+	addCommitteeVotes := func(correct bool, slotIndex phase0.Slot, committee uint64, aggregationBits bitfield.Bitfield, aggregationBitsOffset uint64) uint64 {
+		voteDuties := epochStatsValues.AttesterDuties[slotIndex][committee]
+		for bitIdx, validatorIndice := range voteDuties {
+			if !aggregationBits.BitAt(uint64(bitIdx)+aggregationBitsOffset) || votedBitlist.BitAt(uint64(validatorIndice)) {
+				continue
+			}
+
+			votedBitlist.SetBitAt(uint64(validatorIndice), true)
+
+			group := getGroup(epochStatsValues.ActiveIndices[validatorIndice])
+			groupVotes := headVotes.Groups[group]
+			if groupVotes == nil {
+				groupVotes = &HeadVoteGroup{}
+				headVotes.Groups[group] = groupVotes
+			}
+
+			headVotes.VoteCount++
+			groupVotes.VoteCount++
+			if correct {
+				headVotes.CorrectCount++
+				groupVotes.CorrectCount++
+			}
+		}
+
+		return uint64(len(voteDuties))
+	}
+
+	for _, block := range votingBlocks {
+		blockBody := block.GetBlock()
+		if blockBody == nil {
+			continue
+		}
+
+		attestations, err := blockBody.Attestations()
+		if err != nil {
+			continue
+		}
+
+		for _, attVersioned := range attestations {
+			attData, err := attVersioned.Data()
+			if err != nil || chainState.EpochOfSlot(attData.Slot) != epoch {
+				continue
+			}
+
+			attAggregationBits, err := attVersioned.AggregationBits()
+			if err != nil {
+				continue
+			}
+
+			slotIndex := chainState.SlotToSlotIndex(attData.Slot)
+			correct := attData.BeaconBlockRoot == headRoots[slotIndex]
+			if attVersioned.Version >= spec.DataVersionElectra {
+				committeeBits, err := attVersioned.CommitteeBits()
+				if err != nil {
+					continue
+				}
+
+				aggregationBitsOffset := uint64(0)
+				for _, committee := range committeeBits.BitIndices() {
+					if uint64(committee) >= specs.MaxCommitteesPerSlot {
+						continue
+					}
+
+					aggregationBitsOffset += addCommitteeVotes(correct, slotIndex, uint64(committee), attAggregationBits, aggregationBitsOffset)
+				}
+			} else {
+				addCommitteeVotes(correct, slotIndex, uint64(attData.Index), attAggregationBits, 0)
+			}
+		}
+	}
+
+	return headVotes
+}
@@ -22,10 +22,14 @@ type epochState struct {
 	readyChan      chan bool
 	highPriority   bool
 
+	validatorSetFallback bool // validator set refresh via batched validator queries has been triggered
+
 	validatorBalances []phase0.Gwei
 	randaoMixes       []phase0.Root
 	depositIndex      uint64
+	withdrawalIndex   phase0.ValidatorIndex
 	syncCommittee     []phase0.ValidatorIndex
+	justification     *EpochJustification
 }
 
 // newEpochState creates a new epochState instance with the root of the state to be loaded.
@@ -173,6 +177,14 @@ func (s *epochState) processState(state *spec.VersionedBeaconState, cache *epoch
 
 	s.randaoMixes = randaoMixes
 	s.depositIndex = getStateDepositIndex(state)
+	s.withdrawalIndex = getStateNextWithdrawalValidatorIndex(state)
+
+	justification, err := getStateJustification(state)
+	if err != nil {
+		return fmt.Errorf("error getting justification from state %v: %v", s.slotRoot.String(), err)
+	}
+
+	s.justification = justification
 
 	if state.Version >= spec.DataVersionAltair {
 		currentSyncCommittee, err := getStateCurrentSyncCommittee(state)
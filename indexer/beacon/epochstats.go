@@ -94,6 +94,15 @@ func (es *EpochStats) GetDependentRoot() phase0.Root {
 	return es.dependentRoot
 }
 
+// GetWithdrawalSweepIndex returns the withdrawal sweep cursor (next_withdrawal_validator_index) from the dependent state.
+// returns false if the dependent state is not loaded.
+func (es *EpochStats) GetWithdrawalSweepIndex() (phase0.ValidatorIndex, bool) {
+	if es.dependentState == nil || es.dependentState.loadingStatus != 2 {
+		return 0, false
+	}
+	return es.dependentState.withdrawalIndex, true
+}
+
 // addRequestedBy adds a client to the list of clients that have requested this EpochStats.
 func (es *EpochStats) addRequestedBy(client *Client) bool {
 	es.requestedMutex.Lock()
@@ -326,6 +335,7 @@ func (es *EpochStats) processState(indexer *Indexer, validatorSet []*phase0.Vali
 	}()
 
 	t1 := time.Now()
+	timer := newEpochTimer(EpochTimingDuties, es.epoch)
 
 	chainState := indexer.consensusPool.GetChainState()
 	values := &EpochStatsValues{
@@ -338,22 +348,33 @@ func (es *EpochStats) processState(indexer *Indexer, validatorSet []*phase0.Vali
 		FirstDepositIndex:   es.dependentState.depositIndex,
 	}
 
-	if validatorSet == nil {
-		validatorSet = indexer.validatorCache.getValidatorSetForRoot(es.dependentRoot)
-	}
-
 	// get active validator indices & aggregate balances
-	for index, validator := range validatorSet {
+	validatorCount := len(validatorSet)
+	addValidator := func(index phase0.ValidatorIndex, activationEpoch phase0.Epoch, exitEpoch phase0.Epoch, effectiveBalance phase0.Gwei) {
 		values.TotalBalance += es.dependentState.validatorBalances[index]
-		if es.epoch >= validator.ActivationEpoch && es.epoch < validator.ExitEpoch {
-			values.ActiveIndices = append(values.ActiveIndices, phase0.ValidatorIndex(index))
-			values.EffectiveBalances = append(values.EffectiveBalances, uint16(validator.EffectiveBalance/EtherGweiFactor))
-			values.EffectiveBalance += validator.EffectiveBalance
+		if es.epoch >= activationEpoch && es.epoch < exitEpoch {
+			values.ActiveIndices = append(values.ActiveIndices, index)
+			values.EffectiveBalances = append(values.EffectiveBalances, uint16(effectiveBalance/EtherGweiFactor))
+			values.EffectiveBalance += effectiveBalance
 			values.ActiveBalance += es.dependentState.validatorBalances[index]
 		}
 	}
 
+	if validatorSet == nil {
+		validatorView := indexer.validatorCache.getValidatorSetView(es.dependentRoot, es.epoch, nil)
+		validatorCount = validatorView.Len()
+		validatorView.forEachRecord(func(record *ValidatorRecord) {
+			addValidator(record.Index, record.ActivationEpoch, record.ExitEpoch, record.EffectiveBalance)
+		})
+	} else {
+		for index, validator := range validatorSet {
+			addValidator(phase0.ValidatorIndex(index), validator.ActivationEpoch, validator.ExitEpoch, validator.EffectiveBalance)
+		}
+	}
+
 	values.ActiveValidators = uint64(len(values.ActiveIndices))
+	timer.stage("active indices & balances")
+
 	beaconState := &duties.BeaconState{
 		GetRandaoMixes: func() []phase0.Root {
 			return es.dependentState.randaoMixes
@@ -366,7 +387,7 @@ func (es *EpochStats) processState(indexer *Indexer, validatorSet []*phase0.Vali
 		},
 	}
 
-	indexer.logger.Debugf("processing epoch %v stats (root: %v / state: %v), validators: %v/%v", es.epoch, es.dependentRoot.String(), es.dependentState.stateRoot.String(), values.ActiveValidators, len(validatorSet))
+	indexer.logger.Debugf("processing epoch %v stats (root: %v / state: %v), validators: %v/%v", es.epoch, es.dependentRoot.String(), es.dependentState.stateRoot.String(), values.ActiveValidators, validatorCount)
 
 	// compute proposers
 	proposerDuties := []phase0.ValidatorIndex{}
@@ -388,6 +409,7 @@ func (es *EpochStats) processState(indexer *Indexer, validatorSet []*phase0.Vali
 		values.RandaoMix = *beaconState.RandaoMix
 		values.NextRandaoMix = *beaconState.NextRandaoMix
 	}
+	timer.stage("proposer duties")
 
 	// compute committees
 	attesterDuties, err := duties.GetAttesterDuties(chainState.GetSpecs(), beaconState, es.epoch)
@@ -395,11 +417,17 @@ func (es *EpochStats) processState(indexer *Indexer, validatorSet []*phase0.Vali
 		indexer.logger.Warnf("failed computing attester duties for epoch %v: %v", es.epoch, err)
 	}
 	values.AttesterDuties = attesterDuties
+	timer.stage("attester duties")
+
+	indexer.checkEpochCommittees(es, values)
+	timer.stage("committee checks")
 
 	es.values = values
 	es.precalcValues = nil
 
 	packedSsz, _ := es.buildPackedSSZ(indexer.dynSsz)
+	timer.stage("ssz packing")
+
 	dbDuty := &dbtypes.UnfinalizedDuty{
 		Epoch:         uint64(es.epoch),
 		DependentRoot: es.dependentRoot[:],
@@ -414,6 +442,8 @@ func (es *EpochStats) processState(indexer *Indexer, validatorSet []*phase0.Vali
 	}
 
 	es.isInDb = true
+	timer.stage("db write")
+	timer.finish(indexer, es.dependentRoot)
 
 	indexer.logger.Infof(
 		"processed epoch %v stats (root: %v / state: %v, validators: %v/%v, %v ms), %v bytes",
@@ -665,7 +695,15 @@ func (es *EpochStats) GetEpochVotes(indexer *Indexer, headBlock *Block) *EpochVo
 		headBlock = indexer.GetCanonicalHead(nil)
 	}
 
-	// collect all blocks for this & next epoch in chain defined by headBlock
+	votingBlocks := es.getVotingBlocks(indexer, headBlock)
+
+	// compute epoch votes
+	return indexer.aggregateEpochVotes(es.epoch, chainState, votingBlocks, es)
+}
+
+// getVotingBlocks returns all blocks of this & the next epoch in the chain defined by headBlock, sorted ascending.
+func (es *EpochStats) getVotingBlocks(indexer *Indexer, headBlock *Block) []*Block {
+	chainState := indexer.consensusPool.GetChainState()
 	votingBlocks := []*Block{}
 	currentBlock := headBlock
 	for {
@@ -690,6 +728,5 @@ func (es *EpochStats) GetEpochVotes(indexer *Indexer, headBlock *Block) *EpochVo
 		return votingBlocks[i].Slot < votingBlocks[j].Slot
 	})
 
-	// compute epoch votes
-	return indexer.aggregateEpochVotes(es.epoch, chainState, votingBlocks, es)
+	return votingBlocks
 }
@@ -0,0 +1,202 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// syncRewardWeight & weightDenominator are the altair incentivization weights of the sync committee reward.
+const syncRewardWeight = 2
+const weightDenominator = 64
+
+// EpochSyncRewards holds the expected & actual sync committee rewards of the members for an epoch.
+type EpochSyncRewards struct {
+	Epoch             phase0.Epoch
+	ParticipantReward phase0.Gwei // reward (or penalty) per committee position & block
+	BlockCount        uint64      // number of canonical blocks in the epoch with a sync aggregate
+	EstimatedCount    uint64      // number of blocks whose actual rewards are estimated from the sync aggregate bits, as the beacon api did not return them
+	Members           []*SyncCommitteeMemberRewards
+	Groups            map[string]*SyncRewardGroup
+}
+
+// SyncCommitteeMemberRewards holds the sync rewards of a single sync committee member.
+// members with multiple committee positions get rewarded / penalized per position.
+type SyncCommitteeMemberRewards struct {
+	ValidatorIndex phase0.ValidatorIndex
+	Group          string
+	Positions      uint64
+	DutyCount      uint64
+	MissedCount    uint64
+	ExpectedReward phase0.Gwei // reward for full participation
+	ActualReward   int64       // balance change applied by the sync aggregates (from the beacon api rewards endpoint), negative for net penalties
+}
+
+// SyncRewardGroup holds the aggregated sync rewards of a validator group.
+type SyncRewardGroup struct {
+	MemberCount    uint64
+	DutyCount      uint64
+	MissedCount    uint64
+	ExpectedReward phase0.Gwei
+	ActualReward   int64
+}
+
+func integerSquareRoot(n uint64) uint64 {
+	x := uint64(math.Sqrt(float64(n)))
+	for x*x > n {
+		x--
+	}
+	for (x+1)*(x+1) <= n {
+		x++
+	}
+	return x
+}
+
+// GetEpochSyncRewards computes the expected sync committee rewards from the total active balance and compares them with the
+// rewards & penalties applied by the sync aggregates of the canonical blocks in the epoch, as reported by the beacon api.
+// getGroup classifies validators into groups (eg. by client type). returns nil if the epoch duties are not available in cache.
+func (indexer *Indexer) GetEpochSyncRewards(ctx context.Context, epoch phase0.Epoch, overrideForkId *ForkKey, getGroup func(validatorIndex phase0.ValidatorIndex) string) *EpochSyncRewards {
+	chainState := indexer.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	if specs == nil || specs.AltairForkEpoch == nil || uint64(epoch) < *specs.AltairForkEpoch || specs.EffectiveBalanceIncrement == 0 {
+		return nil
+	}
+
+	epochStats := indexer.GetEpochStats(epoch, overrideForkId)
+	if epochStats == nil {
+		return nil
+	}
+
+	epochStatsValues := epochStats.GetOrLoadValues(indexer, true, false)
+	if epochStatsValues == nil || len(epochStatsValues.SyncCommitteeDuties) == 0 || epochStatsValues.EffectiveBalance == 0 {
+		return nil
+	}
+
+	// see process_sync_aggregate in the altair beacon chain spec
+	totalActiveBalance := uint64(epochStatsValues.EffectiveBalance)
+	totalActiveIncrements := totalActiveBalance / specs.EffectiveBalanceIncrement
+	baseRewardPerIncrement := specs.EffectiveBalanceIncrement * specs.BaseRewardFactor / integerSquareRoot(totalActiveBalance)
+	totalBaseRewards := baseRewardPerIncrement * totalActiveIncrements
+	maxParticipantRewards := totalBaseRewards * syncRewardWeight / weightDenominator / specs.SlotsPerEpoch
+	participantReward := maxParticipantRewards / uint64(len(epochStatsValues.SyncCommitteeDuties))
+
+	rewards := &EpochSyncRewards{
+		Epoch:             epoch,
+		ParticipantReward: phase0.Gwei(participantReward),
+		Groups:            map[string]*SyncRewardGroup{},
+	}
+
+	memberMap := map[phase0.ValidatorIndex]*SyncCommitteeMemberRewards{}
+	positionMembers := make([]*SyncCommitteeMemberRewards, len(epochStatsValues.SyncCommitteeDuties))
+	for position, validatorIndex := range epochStatsValues.SyncCommitteeDuties {
+		member := memberMap[validatorIndex]
+		if member == nil {
+			member = &SyncCommitteeMemberRewards{
+				ValidatorIndex: validatorIndex,
+				Group:          getGroup(validatorIndex),
+			}
+			memberMap[validatorIndex] = member
+			rewards.Members = append(rewards.Members, member)
+		}
+		member.Positions++
+		positionMembers[position] = member
+	}
+
+	firstSlot := chainState.EpochToSlot(epoch)
+	for slot := firstSlot; slot < firstSlot+phase0.Slot(specs.SlotsPerEpoch); slot++ {
+		for _, block := range indexer.GetBlocksBySlot(slot) {
+			if !indexer.IsCanonicalBlock(block, overrideForkId) {
+				continue
+			}
+
+			blockBody := block.GetBlock()
+			if blockBody == nil {
+				continue
+			}
+
+			syncAggregate, err := blockBody.SyncAggregate()
+			if err != nil || syncAggregate == nil {
+				continue
+			}
+
+			// the reported rewards include the effects of the spec (eg. penalties capped at a zero balance), that can't be derived from the bits
+			blockRewards, err := indexer.loadBlockSyncRewards(ctx, block)
+			if err != nil {
+				indexer.logger.Debugf("failed loading sync committee rewards for block %v [0x%x]: %v", block.Slot, block.Root[:], err)
+				rewards.EstimatedCount++
+			}
+
+			rewards.BlockCount++
+			for position, member := range positionMembers {
+				member.DutyCount++
+				member.ExpectedReward += phase0.Gwei(participantReward)
+				participated := syncAggregate.SyncCommitteeBits.BitAt(uint64(position))
+				if !participated {
+					member.MissedCount++
+				}
+				if blockRewards == nil {
+					if participated {
+						member.ActualReward += int64(participantReward)
+					} else {
+						member.ActualReward -= int64(participantReward)
+					}
+				}
+			}
+			if blockRewards != nil {
+				for _, member := range rewards.Members {
+					member.ActualReward += blockRewards[member.ValidatorIndex]
+				}
+			}
+		}
+	}
+
+	for _, member := range rewards.Members {
+		group := rewards.Groups[member.Group]
+		if group == nil {
+			group = &SyncRewardGroup{}
+			rewards.Groups[member.Group] = group
+		}
+		group.MemberCount++
+		group.DutyCount += member.DutyCount
+		group.MissedCount += member.MissedCount
+		group.ExpectedReward += member.ExpectedReward
+		group.ActualReward += member.ActualReward
+	}
+
+	sort.Slice(rewards.Members, func(i, j int) bool {
+		if rewards.Members[i].MissedCount != rewards.Members[j].MissedCount {
+			return rewards.Members[i].MissedCount > rewards.Members[j].MissedCount
+		}
+		return rewards.Members[i].ValidatorIndex < rewards.Members[j].ValidatorIndex
+	})
+
+	return rewards
+}
+
+// loadBlockSyncRewards loads the sync committee rewards applied by the given block from the beacon api, aggregated per validator.
+func (indexer *Indexer) loadBlockSyncRewards(ctx context.Context, block *Block) (map[phase0.ValidatorIndex]int64, error) {
+	clients := indexer.GetReadyClientsByBlockRoot(block.Root, true)
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("no ready client found")
+	}
+
+	var err error
+	for _, client := range clients {
+		syncRewards, err2 := client.GetClient().GetRPCClient().GetSyncCommitteeRewards(ctx, block.Root[:])
+		if err2 != nil {
+			err = err2
+			continue
+		}
+
+		blockRewards := make(map[phase0.ValidatorIndex]int64, len(syncRewards))
+		for _, reward := range syncRewards {
+			blockRewards[phase0.ValidatorIndex(reward.ValidatorIndex)] += reward.Reward
+		}
+		return blockRewards, nil
+	}
+
+	return nil, err
+}
@@ -0,0 +1,97 @@
+package beacon
+
+import (
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// maxEpochTimings is the number of recent epoch processing timings kept in memory.
+const maxEpochTimings = 200
+
+type EpochTimingProcess string
+
+const (
+	EpochTimingDuties       EpochTimingProcess = "duties"       // duty computation from the dependent state (EpochStats.processState)
+	EpochTimingFinalization EpochTimingProcess = "finalization" // epoch finalization (aggregation & persisting of finalized epoch data)
+	EpochTimingSync         EpochTimingProcess = "sync"         // historic epoch synchronization
+)
+
+// EpochTimingStage is the duration of a single stage of an epoch processing run.
+type EpochTimingStage struct {
+	Name     string
+	Duration time.Duration
+}
+
+// EpochTiming holds the per-stage durations of a single epoch processing run.
+type EpochTiming struct {
+	Process       EpochTimingProcess
+	Epoch         phase0.Epoch
+	DependentRoot phase0.Root
+	StartedAt     time.Time
+	Total         time.Duration
+	Stages        []*EpochTimingStage
+}
+
+// epochTimer measures the stages of an epoch processing run.
+type epochTimer struct {
+	timing    *EpochTiming
+	lastStage time.Time
+}
+
+func newEpochTimer(process EpochTimingProcess, epoch phase0.Epoch) *epochTimer {
+	now := time.Now()
+	return &epochTimer{
+		timing: &EpochTiming{
+			Process:   process,
+			Epoch:     epoch,
+			StartedAt: now,
+			Stages:    []*EpochTimingStage{},
+		},
+		lastStage: now,
+	}
+}
+
+// stage records the time since the previous stage (or the start of the run) as the named stage.
+func (t *epochTimer) stage(name string) {
+	now := time.Now()
+	t.timing.Stages = append(t.timing.Stages, &EpochTimingStage{
+		Name:     name,
+		Duration: now.Sub(t.lastStage),
+	})
+	t.lastStage = now
+}
+
+// skip excludes the time since the previous stage from the next stage, e.g. for intentional waits.
+func (t *epochTimer) skip() {
+	t.lastStage = time.Now()
+}
+
+// finish records the timing of the completed run on the indexer.
+func (t *epochTimer) finish(indexer *Indexer, dependentRoot phase0.Root) {
+	t.timing.DependentRoot = dependentRoot
+	for _, stage := range t.timing.Stages {
+		t.timing.Total += stage.Duration
+	}
+
+	indexer.epochTimingsMutex.Lock()
+	defer indexer.epochTimingsMutex.Unlock()
+
+	indexer.epochTimings = append(indexer.epochTimings, t.timing)
+	if len(indexer.epochTimings) > maxEpochTimings {
+		indexer.epochTimings = indexer.epochTimings[len(indexer.epochTimings)-maxEpochTimings:]
+	}
+}
+
+// GetEpochTimings returns the timings of the recent epoch processing runs, newest first.
+func (indexer *Indexer) GetEpochTimings() []*EpochTiming {
+	indexer.epochTimingsMutex.Lock()
+	defer indexer.epochTimingsMutex.Unlock()
+
+	timings := make([]*EpochTiming, len(indexer.epochTimings))
+	for i, timing := range indexer.epochTimings {
+		timings[len(timings)-i-1] = timing
+	}
+
+	return timings
+}
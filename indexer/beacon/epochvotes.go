@@ -167,6 +167,14 @@ func (indexer *Indexer) aggregateEpochVotesAndActivity(epoch phase0.Epoch, chain
 					continue
 				}
 
+				if processActivity {
+					committees := make([]uint64, 0, committeeBits.Count())
+					for _, committee := range committeeBits.BitIndices() {
+						committees = append(committees, uint64(committee))
+					}
+					indexer.checkAttestationCommitteeSize(epochStats, epochStatsValues, block, attData.Slot, committees, attAggregationBits.Len())
+				}
+
 				aggregationBitsOffset := uint64(0)
 				aggregationBitsIndex := uint64(0)
 
@@ -187,6 +195,10 @@ func (indexer *Indexer) aggregateEpochVotesAndActivity(epoch phase0.Epoch, chain
 				}
 			} else {
 				// pre electra attestation aggregation
+				if processActivity {
+					indexer.checkAttestationCommitteeSize(epochStats, epochStatsValues, block, attData.Slot, []uint64{uint64(attData.Index)}, attAggregationBits.Len())
+				}
+
 				if epochStatsValues != nil {
 					voteAmt, _ := votes.aggregateVotes(epochStatsValues, slotIndex, uint64(attData.Index), attAggregationBits, 0, &activityBitlist, updateActivity)
 					voteAmount += voteAmt
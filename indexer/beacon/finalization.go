@@ -119,6 +119,7 @@ func (indexer *Indexer) processFinalityEvent(finalityEvent *v1.Finality) error {
 func (indexer *Indexer) finalizeEpoch(epoch phase0.Epoch, justifiedRoot phase0.Root, client *Client, lastTry bool) (bool, error) {
 	t1 := time.Now()
 	t1loading := time.Duration(0)
+	timer := newEpochTimer(EpochTimingFinalization, epoch)
 	epochBlocks := indexer.blockCache.getEpochBlocks(epoch)
 	nextEpochBlocks := indexer.blockCache.getEpochBlocks(epoch + 1)
 	chainState := indexer.consensusPool.GetChainState()
@@ -246,6 +247,8 @@ func (indexer *Indexer) finalizeEpoch(epoch phase0.Epoch, justifiedRoot phase0.R
 		}
 	}
 
+	timer.stage("block preparation")
+
 	// get epoch stats
 	var epochStatsValues *EpochStatsValues
 	var epochVotes *EpochVotes
@@ -274,6 +277,7 @@ func (indexer *Indexer) finalizeEpoch(epoch phase0.Epoch, justifiedRoot phase0.R
 
 		epochStatsValues = epochStats.GetOrLoadValues(indexer, false, true)
 	}
+	timer.stage("epoch stats loading")
 
 	if epochStatsValues == nil {
 		if !lastTry { // do not error on last try, we can at least persist the canonical and orphaned blocks even without epoch stats
@@ -289,6 +293,7 @@ func (indexer *Indexer) finalizeEpoch(epoch phase0.Epoch, justifiedRoot phase0.R
 			return false, fmt.Errorf("failed computing votes for epoch %v", epoch)
 		}
 	}
+	timer.stage("vote aggregation")
 
 	canonicalRoots := make([][]byte, len(canonicalBlocks))
 	canonicalBlockHashes := make([][]byte, len(canonicalBlocks))
@@ -372,13 +377,24 @@ func (indexer *Indexer) finalizeEpoch(epoch phase0.Epoch, justifiedRoot phase0.R
 	}
 
 	t2dur := time.Since(t1)
+	timer.stage("db write")
 
 	indexer.lastFinalizedEpoch = epoch + 1
 
+	err = db.AppendIndexerEvent(dbtypes.IndexerEventEpochFinalized, fmt.Sprintf("finalized:%v", epoch), &dbtypes.IndexerEventEpochFinalizedData{
+		Epoch:      uint64(epoch),
+		BlockCount: uint64(len(canonicalBlocks)),
+		Orphaned:   uint64(len(orphanedBlocks)),
+	})
+	if err != nil {
+		indexer.logger.Warnf("failed logging finalization event for epoch %v: %v", epoch, err)
+	}
+
 	// sleep 500 ms to give running UI threads time to fetch data from cache
 	time.Sleep(500 * time.Millisecond)
 
 	t1 = time.Now()
+	timer.skip()
 
 	// update validator cache
 	if len(canonicalBlocks) > 0 {
@@ -402,6 +418,9 @@ func (indexer *Indexer) finalizeEpoch(epoch phase0.Epoch, justifiedRoot phase0.R
 		indexer.blockCache.removeBlock(block)
 	}
 
+	timer.stage("cache cleanup")
+	timer.finish(indexer, dependentRoot)
+
 	// log summary
 	indexer.logger.Infof("completed epoch %v finalization (process: %v ms, load: %v s, write: %v ms, clean: %v ms)", epoch, t1dur.Milliseconds(), t1loading.Seconds(), t2dur.Milliseconds(), time.Since(t1).Milliseconds())
 	indexer.logger.Infof("epoch %v blocks: %v canonical, %v orphaned", epoch, len(canonicalBlocks), len(orphanedBlocks))
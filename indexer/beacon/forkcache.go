@@ -2,6 +2,7 @@ package beacon
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"sort"
 	"sync"
@@ -38,7 +39,7 @@ func newForkCache(indexer *Indexer) *forkCache {
 // loadForkState loads the fork state from the database.
 func (cache *forkCache) loadForkState() error {
 	forkState := dbtypes.IndexerForkState{}
-	db.GetExplorerState("indexer.forkstate", &forkState)
+	db.GetExplorerState(context.Background(), "indexer.forkstate", &forkState)
 
 	if forkState.ForkId == 0 {
 		forkState.ForkId = 1
@@ -118,6 +119,13 @@ func (cache *forkCache) removeFork(forkId ForkKey) {
 	delete(cache.forkMap, forkId)
 }
 
+// removeParentIds drops the cached parent relations of the given forks.
+func (cache *forkCache) removeParentIds(forkIds []ForkKey) {
+	for _, forkId := range forkIds {
+		cache.parentIdCache.Remove(forkId)
+	}
+}
+
 // getParentForkIds returns the parent fork ids of the given fork.
 func (cache *forkCache) getParentForkIds(forkId ForkKey) []ForkKey {
 	parentForks := []ForkKey{forkId}
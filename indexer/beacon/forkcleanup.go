@@ -0,0 +1,109 @@
+package beacon
+
+import (
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+)
+
+// orphanedForkCleanupBatchSize is the max number of forks deleted per db transaction.
+const orphanedForkCleanupBatchSize = 100
+
+// orphanedForkCleanupMaxBatches limits the work done per finality event, remaining forks are cleaned with the next event.
+const orphanedForkCleanupMaxBatches = 10
+
+// ForkCleanupStats holds the state & results of the orphaned fork cleanup.
+type ForkCleanupStats struct {
+	LastRun     time.Time
+	CutoffEpoch phase0.Epoch
+	LastError   string
+	LastResult  db.OrphanedForkCleanupResult
+	TotalResult db.OrphanedForkCleanupResult
+}
+
+// runOrphanedForkCleanup deletes orphaned forks that ended more than the configured retention behind the finalized checkpoint.
+func (indexer *Indexer) runOrphanedForkCleanup() {
+	indexer.forkCleanupMutex.Lock()
+	defer indexer.forkCleanupMutex.Unlock()
+
+	if indexer.lastFinalizedEpoch <= indexer.orphanedForkRetention {
+		return
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	cutoffEpoch := indexer.lastFinalizedEpoch - indexer.orphanedForkRetention
+	cutoffSlot := chainState.EpochToSlot(cutoffEpoch)
+
+	// never touch forks that are part of the canonical chain or still tracked in the fork cache
+	canonicalForkIds := map[ForkKey]bool{}
+	if canonicalHead := indexer.GetCanonicalHead(nil); canonicalHead != nil {
+		for _, forkId := range indexer.forkCache.getParentForkIds(canonicalHead.forkId) {
+			canonicalForkIds[forkId] = true
+		}
+	}
+
+	result := db.OrphanedForkCleanupResult{}
+	var cleanupErr error
+
+	for batch := 0; batch < orphanedForkCleanupMaxBatches; batch++ {
+		dbForks := db.GetOrphanedForksBefore(uint64(cutoffSlot), orphanedForkCleanupBatchSize)
+
+		forkIds := []uint64{}
+		forkKeys := []ForkKey{}
+		for _, dbFork := range dbForks {
+			forkKey := ForkKey(dbFork.ForkId)
+			if canonicalForkIds[forkKey] || indexer.forkCache.getForkById(forkKey) != nil {
+				continue
+			}
+
+			forkIds = append(forkIds, dbFork.ForkId)
+			forkKeys = append(forkKeys, forkKey)
+		}
+		if len(forkIds) == 0 {
+			break
+		}
+
+		var batchResult *db.OrphanedForkCleanupResult
+		cleanupErr = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			var err error
+			batchResult, err = db.DeleteOrphanedForkData(forkIds, tx)
+			return err
+		})
+		if cleanupErr != nil {
+			break
+		}
+
+		indexer.forkCache.removeParentIds(forkKeys)
+
+		result.Forks += batchResult.Forks
+		result.Blocks += batchResult.Blocks
+		result.Operations += batchResult.Operations
+		result.ElTransactions += batchResult.ElTransactions
+		result.Blobs += batchResult.Blobs
+
+		if len(dbForks) < orphanedForkCleanupBatchSize {
+			break
+		}
+	}
+
+	stats := &indexer.forkCleanupStats
+	stats.LastRun = time.Now()
+	stats.CutoffEpoch = cutoffEpoch
+	stats.LastResult = result
+	stats.TotalResult.Forks += result.Forks
+	stats.TotalResult.Blocks += result.Blocks
+	stats.TotalResult.Operations += result.Operations
+	stats.TotalResult.ElTransactions += result.ElTransactions
+	stats.TotalResult.Blobs += result.Blobs
+	stats.LastError = ""
+
+	if cleanupErr != nil {
+		stats.LastError = cleanupErr.Error()
+		indexer.logger.WithError(cleanupErr).Errorf("failed cleaning up orphaned forks before epoch %v", cutoffEpoch)
+	} else if result.Forks > 0 {
+		indexer.logger.Infof("cleaned up %v orphaned forks before epoch %v (%v blocks, %v operations, %v el transactions, %v blobs)", result.Forks, cutoffEpoch, result.Blocks, result.Operations, result.ElTransactions, result.Blobs)
+	}
+}
@@ -0,0 +1,174 @@
+package beacon
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// blockHeaderSize is the approximate in-memory size of a signed beacon block header.
+const blockHeaderSize = uint64(8 + 8 + 32 + 32 + 32 + 96)
+
+// forkEvictionParticipation is the participation threshold (in percent) below which a stale fork is considered for eviction.
+const forkEvictionParticipation = float64(20)
+
+// ForkMemoryStats holds the memory accounting for all cached blocks of a single fork.
+type ForkMemoryStats struct {
+	ForkId        ForkKey
+	HeadSlot      phase0.Slot
+	Blocks        uint64
+	BlockBodies   uint64
+	MemorySize    uint64
+	Canonical     bool
+	Participation float64
+	Evicted       uint64
+}
+
+// getBlockMemorySize returns the approximate memory size of the given block.
+func getBlockMemorySize(block *Block) uint64 {
+	size := uint64(reflect.TypeOf(Block{}).Size())
+	if block.header != nil {
+		size += blockHeaderSize
+	}
+	if block.blockIndex != nil {
+		size += uint64(reflect.TypeOf(BlockBodyIndex{}).Size()) + uint64(len(block.blockIndex.ExecutionExtraData))
+	}
+	if block.block != nil {
+		size += uint64(block.blockSize)
+	}
+
+	return size
+}
+
+// getForkMemoryStats returns the memory accounting for all forks with cached blocks.
+func (indexer *Indexer) getForkMemoryStats() map[ForkKey]*ForkMemoryStats {
+	forkStats := map[ForkKey]*ForkMemoryStats{}
+
+	indexer.blockCache.cacheMutex.RLock()
+	for _, block := range indexer.blockCache.rootMap {
+		stats := forkStats[block.forkId]
+		if stats == nil {
+			stats = &ForkMemoryStats{
+				ForkId: block.forkId,
+			}
+			forkStats[block.forkId] = stats
+		}
+
+		stats.Blocks++
+		stats.MemorySize += getBlockMemorySize(block)
+		if block.block != nil {
+			stats.BlockBodies++
+		}
+		if block.Slot > stats.HeadSlot {
+			stats.HeadSlot = block.Slot
+		}
+	}
+	indexer.blockCache.cacheMutex.RUnlock()
+
+	// mark canonical forks
+	if canonicalHead := indexer.GetCanonicalHead(nil); canonicalHead != nil {
+		for _, forkId := range indexer.forkCache.getParentForkIds(canonicalHead.forkId) {
+			if stats := forkStats[forkId]; stats != nil {
+				stats.Canonical = true
+			}
+		}
+	}
+
+	// attach participation of the last epoch from the chain heads
+	for _, chainHead := range indexer.cachedChainHeads {
+		if len(chainHead.PerEpochVotingPercent) == 0 {
+			continue
+		}
+
+		for _, forkId := range indexer.forkCache.getParentForkIds(chainHead.HeadBlock.forkId) {
+			stats := forkStats[forkId]
+			if stats == nil {
+				continue
+			}
+
+			participation := chainHead.PerEpochVotingPercent[len(chainHead.PerEpochVotingPercent)-1]
+			if participation > stats.Participation {
+				stats.Participation = participation
+			}
+		}
+	}
+
+	return forkStats
+}
+
+// GetForkMemoryStats returns the memory accounting for all forks with cached blocks, sorted by memory size.
+func (indexer *Indexer) GetForkMemoryStats() []*ForkMemoryStats {
+	forkStats := indexer.getForkMemoryStats()
+	statsList := make([]*ForkMemoryStats, 0, len(forkStats))
+	for _, stats := range forkStats {
+		statsList = append(statsList, stats)
+	}
+
+	sort.Slice(statsList, func(i, j int) bool {
+		return statsList[i].MemorySize > statsList[j].MemorySize
+	})
+
+	return statsList
+}
+
+// GetForkMemoryEvictedBodies returns the number of block bodies evicted from the cache because their fork exceeded the memory limit.
+func (indexer *Indexer) GetForkMemoryEvictedBodies() uint64 {
+	return indexer.forkMemoryEvictedBodies
+}
+
+// enforceForkMemoryLimits evicts block bodies of stale, low-participation forks that exceed the configured per-fork memory limit.
+// evicted bodies remain available from the unfinalized blocks table and are lazily reloaded when needed.
+func (indexer *Indexer) enforceForkMemoryLimits() {
+	if indexer.maxForkMemory == 0 {
+		return
+	}
+
+	chainState := indexer.consensusPool.GetChainState()
+	currentSlot := chainState.CurrentSlot()
+	staleSlots := phase0.Slot(chainState.GetSpecs().SlotsPerEpoch)
+
+	evictedBodies := uint64(0)
+	for _, stats := range indexer.GetForkMemoryStats() {
+		if stats.Canonical || stats.MemorySize <= indexer.maxForkMemory {
+			continue
+		}
+
+		if stats.HeadSlot+staleSlots > currentSlot {
+			// fork is still active
+			continue
+		}
+
+		if stats.Participation >= forkEvictionParticipation {
+			continue
+		}
+
+		// evict oldest block bodies first until the fork is below the limit
+		forkBlocks := indexer.blockCache.getForkBlocks(stats.ForkId)
+		sort.Slice(forkBlocks, func(i, j int) bool {
+			return forkBlocks[i].Slot < forkBlocks[j].Slot
+		})
+
+		memorySize := stats.MemorySize
+		for _, block := range forkBlocks {
+			if memorySize <= indexer.maxForkMemory {
+				break
+			}
+
+			bodySize := uint64(block.blockSize)
+			if block.evictBlockBody() {
+				memorySize -= bodySize
+				evictedBodies++
+				stats.Evicted++
+			}
+		}
+
+		indexer.logger.Infof("fork %v exceeds memory limit (%v kB, %.2f%% participation), evicted %v block bodies", stats.ForkId, stats.MemorySize/1024, stats.Participation, stats.Evicted)
+	}
+
+	if evictedBodies > 0 {
+		indexer.forkMemoryEvictedBodies += evictedBodies
+		runtime.GC()
+	}
+}
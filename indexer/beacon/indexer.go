@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"runtime/debug"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common/lru"
 	"github.com/jmoiron/sqlx"
 	dynssz "github.com/pk910/dynamic-ssz"
 	"github.com/sirupsen/logrus"
@@ -29,34 +31,56 @@ type Indexer struct {
 	consensusPool *consensus.Pool
 	dynSsz        *dynssz.DynSsz
 	synchronizer  *synchronizer
+	consistency   *consistencyChecker
 
 	// configuration
-	disableSync           bool
-	blockCompression      bool
-	inMemoryEpochs        uint16
-	activityHistoryLength uint16
-	maxParallelStateCalls uint16
+	disableSync                 bool
+	blockCompression            blockCompressionType
+	inMemoryEpochs              uint16
+	activityHistoryLength       uint16
+	maxParallelStateCalls       uint16
+	maxParallelValidatorQueries int
+	backfillBatchSize           uint16
+	maxForkMemory               uint64
+	cleanupOrphanedForks        bool
+	orphanedForkRetention       phase0.Epoch
 
 	// caches
 	blockCache     *blockCache
 	epochCache     *epochCache
 	forkCache      *forkCache
 	validatorCache *validatorCache
+	bodyFetcher    *blockBodyFetcher
+	blobIndexer    *blobIndexer
+	stateTreeMutex sync.Mutex
+	stateTreeCache *lru.Cache[phase0.Root, *StateTree]
 
 	// indexer state
-	clients               []*Client
-	dbWriter              *dbWriter
-	running               bool
-	backfillCompleteMutex sync.Mutex
-	backfillingCount      int
-	backfillComplete      bool
-	backfillCompleteChan  chan bool
-	lastFinalizedEpoch    phase0.Epoch
-	lastPrunedEpoch       phase0.Epoch
-	lastPruneRunEpoch     phase0.Epoch
-	lastPrecalcRunEpoch   phase0.Epoch
-	finalitySubscription  *consensus.Subscription[*v1.Finality]
-	wallclockSubscription *consensus.Subscription[*ethwallclock.Slot]
+	clients                 []*Client
+	dbWriter                *dbWriter
+	running                 bool
+	syncPaused              atomic.Bool
+	backfillCompleteMutex   sync.Mutex
+	backfillingCount        int
+	backfillComplete        bool
+	backfillCompleteChan    chan bool
+	lastFinalizedEpoch      phase0.Epoch
+	lastPrunedEpoch         phase0.Epoch
+	lastPruneRunEpoch       phase0.Epoch
+	lastPrecalcRunEpoch     phase0.Epoch
+	forkMemoryEvictedBodies uint64
+	forkCleanupMutex        sync.Mutex
+	forkCleanupStats        ForkCleanupStats
+	slotAnomaliesMutex      sync.Mutex
+	slotAnomalies           []*SlotAnomaly
+	committeeAnomaliesMutex sync.Mutex
+	committeeAnomalies      []*CommitteeAnomaly
+	epochTimingsMutex       sync.Mutex
+	epochTimings            []*EpochTiming
+	finalitySubscription    *consensus.Subscription[*v1.Finality]
+	wallclockSubscription   *consensus.Subscription[*ethwallclock.Slot]
+	blockDispatcher         consensus.Dispatcher[*Block]
+	headDispatcher          consensus.Dispatcher[*HeadChange]
 
 	// canonical head state
 	canonicalHeadMutex   sync.Mutex
@@ -68,21 +92,34 @@ type Indexer struct {
 // NewIndexer creates a new instance of the Indexer.
 func NewIndexer(logger logrus.FieldLogger, consensusPool *consensus.Pool) *Indexer {
 	// Initialize the indexer with default values from the configuration.
-	inMemoryEpochs := utils.Config.Indexer.InMemoryEpochs
+	inMemoryEpochs := utils.Config().Indexer.InMemoryEpochs
 	if inMemoryEpochs < 2 {
 		inMemoryEpochs = 2
 	}
-	activityHistoryLength := utils.Config.Indexer.ActivityHistoryLength
+	activityHistoryLength := utils.Config().Indexer.ActivityHistoryLength
 	if activityHistoryLength == 0 {
 		activityHistoryLength = 6
 	}
-	maxParallelStateCalls := uint16(utils.Config.Indexer.MaxParallelValidatorSetRequests)
+	maxParallelStateCalls := uint16(utils.Config().Indexer.MaxParallelValidatorSetRequests)
 	if maxParallelStateCalls < 2 {
 		maxParallelStateCalls = 2
 	}
-	blockCompression := true
-	if utils.Config.KillSwitch.DisableBlockCompression {
-		blockCompression = false
+	maxParallelValidatorQueries := int(utils.Config().Indexer.MaxParallelValidatorQueries)
+	if maxParallelValidatorQueries < 1 {
+		maxParallelValidatorQueries = 4
+	}
+	maxParallelBlockCalls := int(utils.Config().Indexer.MaxParallelBlockRequests)
+	if maxParallelBlockCalls < 1 {
+		maxParallelBlockCalls = 4
+	}
+	blockCompression := blockCompressionZstd
+	switch {
+	case utils.Config().KillSwitch.DisableBlockCompression:
+		blockCompression = blockCompressionNone
+	case utils.Config().Indexer.BlockCompression == "zlib":
+		blockCompression = blockCompressionZlib
+	case utils.Config().Indexer.BlockCompression != "" && utils.Config().Indexer.BlockCompression != "zstd":
+		logger.Warnf("unknown block compression '%v', using zstd", utils.Config().Indexer.BlockCompression)
 	}
 
 	// Create the indexer instance.
@@ -90,25 +127,43 @@ func NewIndexer(logger logrus.FieldLogger, consensusPool *consensus.Pool) *Index
 		logger:        logger,
 		consensusPool: consensusPool,
 
-		disableSync:           utils.Config.Indexer.DisableSynchronizer,
-		blockCompression:      blockCompression,
-		inMemoryEpochs:        inMemoryEpochs,
-		activityHistoryLength: activityHistoryLength,
-		maxParallelStateCalls: maxParallelStateCalls,
+		disableSync:                 utils.Config().Indexer.DisableSynchronizer,
+		blockCompression:            blockCompression,
+		inMemoryEpochs:              inMemoryEpochs,
+		activityHistoryLength:       activityHistoryLength,
+		maxParallelStateCalls:       maxParallelStateCalls,
+		maxParallelValidatorQueries: maxParallelValidatorQueries,
+		backfillBatchSize:           uint16(maxParallelBlockCalls * 8),
+		maxForkMemory:               uint64(utils.Config().Indexer.MaxForkCacheSize) * 1024 * 1024,
+		cleanupOrphanedForks:        utils.Config().Indexer.CleanupOrphanedForks,
+		orphanedForkRetention:       phase0.Epoch(utils.Config().Indexer.OrphanedForkRetention),
 
 		clients:              make([]*Client, 0),
 		backfillCompleteChan: make(chan bool),
+		stateTreeCache:       lru.NewCache[phase0.Root, *StateTree](stateTreeCacheSize),
 	}
 
 	indexer.blockCache = newBlockCache(indexer)
 	indexer.epochCache = newEpochCache(indexer)
 	indexer.forkCache = newForkCache(indexer)
 	indexer.validatorCache = newValidatorCache(indexer)
+	indexer.bodyFetcher = newBlockBodyFetcher(indexer, maxParallelBlockCalls)
 	indexer.dbWriter = newDbWriter(indexer)
+	indexer.blobIndexer = newBlobIndexer(indexer, logger.WithField("service", "blob-indexer"))
 
 	return indexer
 }
 
+// SubscribeBlockEvent subscribes to new unfinalized blocks processed by the indexer.
+func (indexer *Indexer) SubscribeBlockEvent(capacity int) *consensus.Subscription[*Block] {
+	return indexer.blockDispatcher.Subscribe(capacity, false)
+}
+
+// SubscribeHeadEvent subscribes to canonical head changes, including reorgs to blocks that don't descend from the previous head.
+func (indexer *Indexer) SubscribeHeadEvent(capacity int) *consensus.Subscription[*HeadChange] {
+	return indexer.headDispatcher.Subscribe(capacity, false)
+}
+
 func (indexer *Indexer) GetActivityHistoryLength() uint16 {
 	return indexer.activityHistoryLength
 }
@@ -205,15 +260,19 @@ func (indexer *Indexer) StartIndexer() {
 	}
 	indexer.dynSsz = dynssz.NewDynSsz(staticSpec)
 
+	// load block compression dictionaries before restoring blocks from db
+	indexer.loadBlockCompressionDicts()
+
 	// initialize synchronizer & restore state
 	indexer.synchronizer = newSynchronizer(indexer, indexer.logger.WithField("service", "synchronizer"))
+	indexer.consistency = newConsistencyChecker(indexer, indexer.logger.WithField("service", "consistency"))
 	finalizedSlot := chainState.GetFinalizedSlot()
 	finalizedEpoch, _ := chainState.GetFinalizedCheckpoint()
 	indexer.lastFinalizedEpoch = finalizedEpoch
 	indexer.lastPrecalcRunEpoch = chainState.CurrentEpoch()
 
 	pruneState := dbtypes.IndexerPruneState{}
-	db.GetExplorerState("indexer.prunestate", &pruneState)
+	db.GetExplorerState(context.Background(), "indexer.prunestate", &pruneState)
 	indexer.lastPrunedEpoch = phase0.Epoch(pruneState.Epoch)
 
 	if indexer.lastPrunedEpoch < finalizedEpoch {
@@ -228,6 +287,14 @@ func (indexer *Indexer) StartIndexer() {
 
 	indexer.lastPruneRunEpoch = chainState.CurrentEpoch()
 
+	// load unfinalized cache snapshot state from last shutdown
+	cacheSnapshot := indexer.loadCacheSnapshotState()
+	if cacheSnapshot == nil {
+		indexer.logger.Infof("no unfinalized cache snapshot found (unclean shutdown?), restoring from unfinalized db entries")
+	} else {
+		indexer.logger.Infof("found unfinalized cache snapshot from %v (%v blocks, head slot %v)", time.Unix(cacheSnapshot.SnapshotTime, 0).Format(time.RFC3339), cacheSnapshot.BlockCount, cacheSnapshot.HeadSlot)
+	}
+
 	// restore unfinalized forks from db
 	for _, dbFork := range db.GetUnfinalizedForks(uint64(finalizedSlot)) {
 		fork := newForkFromDb(dbFork)
@@ -360,6 +427,10 @@ func (indexer *Indexer) StartIndexer() {
 		indexer.logger.Infof("restored %v unfinalized blocks from DB (%v with bodies, %.3f sec)", restoredBlockCount, restoredBodyCount, time.Since(t1).Seconds())
 	}
 
+	if cacheSnapshot != nil && uint64(restoredBlockCount) < cacheSnapshot.BlockCount && cacheSnapshot.FinalizedEpoch >= uint64(finalizedEpoch) {
+		indexer.logger.Warnf("restored less blocks than persisted in cache snapshot (%v < %v), missing blocks will be backfilled", restoredBlockCount, cacheSnapshot.BlockCount)
+	}
+
 	// start indexing for all clients
 	for _, client := range indexer.clients {
 		client.startIndexing()
@@ -369,6 +440,10 @@ func (indexer *Indexer) StartIndexer() {
 	indexer.finalitySubscription = indexer.consensusPool.SubscribeFinalizedEvent(10)
 	indexer.wallclockSubscription = indexer.consensusPool.SubscribeWallclockSlotEvent(1)
 
+	if indexer.blobIndexer != nil {
+		go indexer.blobIndexer.runBlobIndexer()
+	}
+
 	go func() {
 		// start processing a bit delayed to allow clients to complete initial block backfill
 		if chainState.CurrentEpoch() > 0 {
@@ -387,9 +462,15 @@ func (indexer *Indexer) StartIndexer() {
 		indexer.logger.Infof("starting indexer processing (finalization, pruning & synchronization)")
 
 		go indexer.runIndexerLoop()
+		go indexer.runBlockCompressionWorker()
 
 		// start synchronizer
 		indexer.startSynchronizer(indexer.lastFinalizedEpoch)
+
+		// start finalized chain consistency checker
+		if !indexer.disableSync && !utils.Config().Indexer.DisableConsistencyChecker {
+			indexer.consistency.start()
+		}
 	}()
 }
 
@@ -430,6 +511,10 @@ func (indexer *Indexer) runIndexerLoop() {
 
 			indexer.lastPruneRunEpoch = chainState.CurrentEpoch()
 
+			if indexer.cleanupOrphanedForks {
+				indexer.runOrphanedForkCleanup()
+			}
+
 		case slotEvent := <-indexer.wallclockSubscription.Channel():
 			epoch := chainState.EpochOfSlot(phase0.Slot(slotEvent.Number()))
 			slotIndex := chainState.SlotToSlotIndex(phase0.Slot(slotEvent.Number()))
@@ -445,6 +530,11 @@ func (indexer *Indexer) runIndexerLoop() {
 				indexer.lastPrecalcRunEpoch = epoch + 1
 			}
 
+			// evict block bodies of stale forks that exceed the per-fork memory limit
+			if slotIndex == 0 {
+				indexer.enforceForkMemoryLimits()
+			}
+
 			// prune cache if last pruning epoch is outdated and we are at least 50% into the current
 			if epoch > indexer.lastPruneRunEpoch && slotProgress >= 50 {
 				err := indexer.runCachePruning()
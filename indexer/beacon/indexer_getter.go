@@ -9,6 +9,7 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethpandaops/dora/clients/consensus"
 	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
 )
 
 // GetAllClients returns a slice of all clients in the indexer.
@@ -110,6 +111,14 @@ func (indexer *Indexer) GetReadyClient(preferArchive bool) *Client {
 	return nil
 }
 
+// GetBlockCacheSize returns the number of blocks in the block cache.
+func (indexer *Indexer) GetBlockCacheSize() uint64 {
+	indexer.blockCache.cacheMutex.RLock()
+	defer indexer.blockCache.cacheMutex.RUnlock()
+
+	return uint64(len(indexer.blockCache.rootMap))
+}
+
 // GetBlockCacheState returns the state of the block cache, including the last finalized epoch and the last pruned epoch.
 // this represents the internal cache state and might be behind the actual finalization checkpoint.
 func (indexer *Indexer) GetBlockCacheState() (finalizedEpoch phase0.Epoch, prunedEpoch phase0.Epoch) {
@@ -125,6 +134,24 @@ func (indexer *Indexer) GetSynchronizerState() (running bool, syncHead phase0.Ep
 	return indexer.synchronizer.running, indexer.synchronizer.currentEpoch
 }
 
+// IsSynchronizerPaused returns true if the synchronizer has been paused via PauseSynchronizer.
+func (indexer *Indexer) IsSynchronizerPaused() bool {
+	return indexer.syncPaused.Load()
+}
+
+// GetForkCleanupStats returns the results of the orphaned fork cleanup, or nil if the cleanup is disabled.
+func (indexer *Indexer) GetForkCleanupStats() *ForkCleanupStats {
+	if !indexer.cleanupOrphanedForks {
+		return nil
+	}
+
+	indexer.forkCleanupMutex.Lock()
+	defer indexer.forkCleanupMutex.Unlock()
+
+	stats := indexer.forkCleanupStats
+	return &stats
+}
+
 // GetForkHeads returns a slice of fork heads in the indexer.
 func (indexer *Indexer) GetForkHeads() []*ForkHead {
 	return indexer.forkCache.getForkHeads()
@@ -246,15 +273,26 @@ func (indexer *Indexer) GetEpochStats(epoch phase0.Epoch, overrideForkId *ForkKe
 	return bestEpochStats
 }
 
+// GetEpochStatsValues returns the epoch stats values for the given epoch and optional fork ID override, loading them from the database if necessary.
+// returns nil if the epoch stats are not available.
+func (indexer *Indexer) GetEpochStatsValues(epoch phase0.Epoch, overrideForkId *ForkKey) *EpochStatsValues {
+	return indexer.GetEpochStats(epoch, overrideForkId).GetOrLoadValues(indexer, true, false)
+}
+
+// GetDbBlock returns the database representation of the given block.
+func (indexer *Indexer) GetDbBlock(block *Block) *dbtypes.Slot {
+	return block.GetDbBlock(indexer)
+}
+
 // GetParentForkIds returns the parent fork ids of the given fork.
 func (indexer *Indexer) GetParentForkIds(forkId ForkKey) []ForkKey {
 	return indexer.forkCache.getParentForkIds(forkId)
 }
 
-// GetValidatorSet returns the most recent basic validator set, excluding balances and validator status.
+// GetValidatorSetView returns a view of the most recent validator set, excluding balances.
 // If an overrideForkId is provided, the validator set for the fork is returned.
-func (indexer *Indexer) GetValidatorSet(overrideForkId *ForkKey) []*phase0.Validator {
-	return indexer.validatorCache.getValidatorSet(overrideForkId)
+func (indexer *Indexer) GetValidatorSetView(overrideForkId *ForkKey) *ValidatorSetView {
+	return indexer.validatorCache.getCanonicalValidatorSetView(overrideForkId)
 }
 
 // GetValidatorIndexByPubkey returns the validator index for a given pubkey.
@@ -267,6 +305,55 @@ func (indexer *Indexer) GetValidatorByIndex(index phase0.ValidatorIndex, overrid
 	return indexer.validatorCache.getValidatorByIndex(index, overrideForkId)
 }
 
+// GetValidatorChangesSince returns the indices of all validators whose record or status changed after the given epoch.
+// If an overrideForkId is provided, the changes on the fork are returned.
+// The second return value is false if the changes since the given epoch are not tracked in cache.
+func (indexer *Indexer) GetValidatorChangesSince(sinceEpoch phase0.Epoch, overrideForkId *ForkKey) ([]phase0.ValidatorIndex, bool) {
+	canonicalHead := indexer.GetCanonicalHead(overrideForkId)
+	if canonicalHead == nil {
+		return nil, false
+	}
+
+	changedValidators, tracked := indexer.validatorCache.getChangedValidatorsForRoot(sinceEpoch, canonicalHead.Root)
+	if !tracked {
+		return nil, false
+	}
+
+	// status transitions happen at the epochs set in the validator record, so validators with a lifecycle epoch
+	// in the requested range changed status without a change of their record.
+	currentEpoch := indexer.consensusPool.GetChainState().CurrentEpoch()
+	isInRange := func(epoch phase0.Epoch) bool {
+		return epoch > sinceEpoch && epoch <= currentEpoch
+	}
+
+	changedMap := make(map[phase0.ValidatorIndex]bool, len(changedValidators))
+	for _, index := range changedValidators {
+		changedMap[index] = true
+	}
+
+	indexer.validatorCache.getValidatorSetView(canonicalHead.Root, currentEpoch, nil).forEachRecord(func(record *ValidatorRecord) {
+		if changedMap[record.Index] {
+			return
+		}
+
+		if isInRange(record.ActivationEligibilityEpoch) || isInRange(record.ActivationEpoch) || isInRange(record.ExitEpoch) || isInRange(record.WithdrawableEpoch) {
+			changedValidators = append(changedValidators, record.Index)
+		}
+	})
+
+	sort.Slice(changedValidators, func(i, j int) bool {
+		return changedValidators[i] < changedValidators[j]
+	})
+
+	return changedValidators, true
+}
+
+// GetValidatorLiveness returns the number of epochs within the lookback window ending at refEpoch a validator voted for.
+// unlike GetValidatorActivity, this reads the incrementally maintained activity bitfield and does not scan the activity history.
+func (indexer *Indexer) GetValidatorLiveness(validatorIndex phase0.ValidatorIndex, refEpoch phase0.Epoch, lookbackEpochs uint64) uint64 {
+	return indexer.validatorCache.getValidatorLiveness(validatorIndex, refEpoch, lookbackEpochs)
+}
+
 // GetValidatorActivity returns the validator activity for a given validator index.
 func (indexer *Indexer) GetValidatorActivity(validatorIndex phase0.ValidatorIndex) ([]ValidatorActivity, phase0.Epoch) {
 	activity := indexer.validatorCache.getValidatorActivity(validatorIndex)
@@ -18,8 +18,14 @@ const beaconBodyRequestTimeout time.Duration = 30 * time.Second
 // BeaconStateRequestTimeout is the timeout duration for beacon state requests.
 const beaconStateRequestTimeout time.Duration = 600 * time.Second
 
+// beaconValidatorSetRequestTimeout is the timeout duration for batched validator set requests.
+const beaconValidatorSetRequestTimeout time.Duration = 300 * time.Second
+
 const beaconStateRetryCount = 10
 
+// beaconStateFallbackRetryCount is the state retry count after which the validator set is loaded via batched validator queries.
+const beaconStateFallbackRetryCount = 4
+
 // LoadBeaconHeader loads the block header from the client.
 func LoadBeaconHeader(ctx context.Context, client *Client, root phase0.Root) (*phase0.SignedBeaconBlockHeader, error) {
 	ctx, cancel := context.WithTimeout(ctx, beaconHeaderRequestTimeout)
@@ -75,3 +81,24 @@ func LoadBeaconState(ctx context.Context, client *Client, root phase0.Root) (*sp
 
 	return resState, nil
 }
+
+// LoadValidatorSet loads the validator set & balances of the given state via batched validator queries from the client.
+func LoadValidatorSet(ctx context.Context, client *Client, stateRoot phase0.Root) ([]*phase0.Validator, []phase0.Gwei, error) {
+	ctx, cancel := context.WithTimeout(ctx, beaconValidatorSetRequestTimeout)
+	defer cancel()
+
+	maxParallel := client.indexer.maxParallelValidatorQueries
+	resValidators, err := client.client.GetRPCClient().GetStateValidators(ctx, fmt.Sprintf("0x%x", stateRoot[:]), maxParallel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validators := make([]*phase0.Validator, len(resValidators))
+	balances := make([]phase0.Gwei, len(resValidators))
+	for i, validator := range resValidators {
+		validators[i] = validator.Validator
+		balances[i] = validator.Balance
+	}
+
+	return validators, balances, nil
+}
@@ -0,0 +1,341 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/prysmaticlabs/go-bitfield"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+)
+
+// stateTreeCacheSize is the number of beacon state trees kept for repeated proof requests against the same state.
+const stateTreeCacheSize = 2
+
+// StateTree is the merkle tree of a beacon state, used to build proofs for state fields.
+type StateTree struct {
+	Root            *ssz.Node
+	StateRoot       phase0.Root
+	Version         spec.DataVersion
+	FieldCount      uint64
+	ValidatorsCount uint64
+}
+
+var bitlistType = reflect.TypeOf(bitfield.Bitlist{})
+
+// stateTreeListLimits returns the limits of beacon state lists that depend on the chain preset.
+// the ssz-max tags of the go-eth2-client types only hold the mainnet values.
+var stateTreeListLimits = map[string]func(specs *consensus.ChainSpec) uint64{
+	"ETH1DataVotes":             func(specs *consensus.ChainSpec) uint64 { return specs.EpochsPerEth1VotingPeriod * specs.SlotsPerEpoch },
+	"PendingDeposits":           func(specs *consensus.ChainSpec) uint64 { return specs.PendingDepositsLimit },
+	"PendingPartialWithdrawals": func(specs *consensus.ChainSpec) uint64 { return specs.PendingPartialWithdrawalsLimit },
+	"PendingConsolidations":     func(specs *consensus.ChainSpec) uint64 { return specs.PendingConsolidationsLimit },
+}
+
+// GetStateTree returns the merkle tree of the beacon state with the given state root.
+// recently built trees are cached, otherwise the state is loaded from a ready client.
+func (indexer *Indexer) GetStateTree(ctx context.Context, stateRoot phase0.Root) (*StateTree, error) {
+	indexer.stateTreeMutex.Lock()
+	defer indexer.stateTreeMutex.Unlock()
+
+	if tree, found := indexer.stateTreeCache.Get(stateRoot); found {
+		return tree, nil
+	}
+
+	client := indexer.GetReadyClient(true)
+	if client == nil {
+		return nil, fmt.Errorf("no clients available")
+	}
+
+	state, err := LoadBeaconState(ctx, client, stateRoot)
+	if err != nil {
+		return nil, fmt.Errorf("error loading state %v: %v", stateRoot.String(), err)
+	}
+
+	tree, err := BuildStateTree(indexer.consensusPool.GetChainState().GetSpecs(), state)
+	if err != nil {
+		return nil, err
+	}
+	if phase0.Root(tree.Root.Hash()) != stateRoot {
+		return nil, fmt.Errorf("state tree root does not match the state root %v", stateRoot.String())
+	}
+	tree.StateRoot = stateRoot
+
+	indexer.stateTreeCache.Add(stateRoot, tree)
+	return tree, nil
+}
+
+// BuildStateTree builds the merkle tree of a beacon state. unlike the generated fastssz tree functions, vector sizes & list limits
+// are taken from the state & chain specs, so the tree matches the state root on all presets.
+func BuildStateTree(specs *consensus.ChainSpec, state *spec.VersionedBeaconState) (*StateTree, error) {
+	var stateObj any
+	switch state.Version {
+	case spec.DataVersionPhase0:
+		stateObj = state.Phase0
+	case spec.DataVersionAltair:
+		stateObj = state.Altair
+	case spec.DataVersionBellatrix:
+		stateObj = state.Bellatrix
+	case spec.DataVersionCapella:
+		stateObj = state.Capella
+	case spec.DataVersionDeneb:
+		stateObj = state.Deneb
+	case spec.DataVersionElectra:
+		stateObj = state.Electra
+	default:
+		return nil, fmt.Errorf("unsupported state version %v", state.Version)
+	}
+
+	stateValue := reflect.ValueOf(stateObj)
+	if stateValue.IsNil() {
+		return nil, fmt.Errorf("no %v state", state.Version)
+	}
+
+	builder := &stateTreeBuilder{specs: specs}
+	root, err := builder.buildValue(stateValue, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error building state tree: %v", err)
+	}
+
+	tree := &StateTree{
+		Root:       root,
+		Version:    state.Version,
+		FieldCount: uint64(stateValue.Elem().NumField()),
+	}
+	if validators, err := state.Validators(); err == nil {
+		tree.ValidatorsCount = uint64(len(validators))
+	}
+	return tree, nil
+}
+
+// stateTreeBuilder builds ssz merkle trees of the go-eth2-client spec types based on their ssz tags.
+type stateTreeBuilder struct {
+	specs *consensus.ChainSpec
+}
+
+func (b *stateTreeBuilder) buildValue(value reflect.Value, field *reflect.StructField) (*ssz.Node, error) {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			value = reflect.New(value.Type().Elem())
+		}
+		return b.buildValue(value.Elem(), field)
+	case reflect.Struct:
+		nodes := make([]*ssz.Node, value.NumField())
+		for i := range nodes {
+			fieldType := value.Type().Field(i)
+			node, err := b.buildValue(value.Field(i), &fieldType)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v", fieldType.Name, err)
+			}
+			nodes[i] = node
+		}
+		return ssz.TreeFromNodes(nodes, nextPowerOfTwo(uint64(len(nodes))))
+	case reflect.Bool:
+		return ssz.LeafFromBool(value.Bool()), nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return ssz.LeafFromBytes(packBasicValues(value)), nil
+	case reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return b.buildBytes(value, value.Len(), nil)
+		}
+		return b.buildSequence(value, value.Len(), nil)
+	case reflect.Slice:
+		isList, limit, err := b.getListLimit(field)
+		if err != nil {
+			return nil, err
+		}
+		if value.Type() == bitlistType {
+			return b.buildBitlist(value.Interface().(bitfield.Bitlist), limit)
+		}
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			if !isList {
+				size, err := getStaticSize(field)
+				if err != nil {
+					return nil, err
+				}
+				return b.buildBytes(value, size, nil)
+			}
+			return b.buildBytes(value, int(limit), &limit)
+		}
+		if !isList {
+			// vector sizes depend on the preset, the state always holds the full vector
+			return b.buildSequence(value, value.Len(), nil)
+		}
+		return b.buildSequence(value, int(limit), &limit)
+	default:
+		return nil, fmt.Errorf("unsupported type %v", value.Type())
+	}
+}
+
+// buildBytes builds the tree of a byte vector (limit == nil) or byte list with the given size / limit.
+func (b *stateTreeBuilder) buildBytes(value reflect.Value, size int, limit *uint64) (*ssz.Node, error) {
+	if value.Len() > size {
+		return nil, fmt.Errorf("byte array length %v exceeds size %v", value.Len(), size)
+	}
+	dataLen := size
+	if limit != nil {
+		dataLen = value.Len()
+	}
+	data := make([]byte, dataLen)
+	for i := 0; i < value.Len(); i++ {
+		data[i] = byte(value.Index(i).Uint())
+	}
+
+	chunks := chunkBytes(data)
+	root, err := ssz.TreeFromNodes(chunks, nextPowerOfTwo(uint64(size+31)/32))
+	if err != nil || limit == nil {
+		return root, err
+	}
+	return ssz.NewNodeWithLR(root, ssz.LeafFromUint64(uint64(value.Len()))), nil
+}
+
+// buildBitlist builds the tree of a bitlist, the length bit is not part of the merkleized data.
+func (b *stateTreeBuilder) buildBitlist(bits bitfield.Bitlist, limit uint64) (*ssz.Node, error) {
+	if bits.Len() > limit {
+		return nil, fmt.Errorf("bitlist length %v exceeds limit %v", bits.Len(), limit)
+	}
+	root, err := ssz.TreeFromNodes(chunkBytes(bits.Bytes()), nextPowerOfTwo((limit+255)/256))
+	if err != nil {
+		return nil, err
+	}
+	return ssz.NewNodeWithLR(root, ssz.LeafFromUint64(bits.Len())), nil
+}
+
+// buildSequence builds the tree of a vector (limit == nil) or list of basic or composite elements.
+func (b *stateTreeBuilder) buildSequence(value reflect.Value, size int, limit *uint64) (*ssz.Node, error) {
+	length := value.Len()
+	if length > size {
+		return nil, fmt.Errorf("list length %v exceeds limit %v", length, size)
+	}
+
+	var nodes []*ssz.Node
+	var chunkCount uint64
+	elemType := value.Type().Elem()
+	if elemSize := getBasicTypeSize(elemType); elemSize > 0 {
+		// basic values are packed into 32 byte chunks
+		nodes = chunkBytes(packBasicValues(value))
+		chunkCount = (uint64(size)*uint64(elemSize) + 31) / 32
+	} else {
+		nodes = make([]*ssz.Node, length)
+		for i := 0; i < length; i++ {
+			node, err := b.buildValue(value.Index(i), nil)
+			if err != nil {
+				return nil, fmt.Errorf("index %v: %v", i, err)
+			}
+			nodes[i] = node
+		}
+		chunkCount = uint64(size)
+	}
+
+	if limit != nil {
+		return ssz.TreeFromNodesWithMixin(nodes, length, nextPowerOfTwo(chunkCount))
+	}
+	return ssz.TreeFromNodes(nodes, nextPowerOfTwo(chunkCount))
+}
+
+// getListLimit returns whether the field is a list and its max. number of elements.
+func (b *stateTreeBuilder) getListLimit(field *reflect.StructField) (bool, uint64, error) {
+	if field == nil {
+		return false, 0, fmt.Errorf("nested slices are not supported")
+	}
+	if sizeTag, found := field.Tag.Lookup("ssz-size"); found && !strings.HasPrefix(sizeTag, "?") {
+		return false, 0, nil
+	}
+
+	maxTag, found := field.Tag.Lookup("ssz-max")
+	if !found {
+		return false, 0, fmt.Errorf("missing ssz-max tag")
+	}
+	limit, err := strconv.ParseUint(strings.Split(maxTag, ",")[0], 10, 64)
+	if err != nil {
+		return false, 0, fmt.Errorf("invalid ssz-max tag: %v", err)
+	}
+	if specLimit := stateTreeListLimits[field.Name]; specLimit != nil && b.specs != nil {
+		if specValue := specLimit(b.specs); specValue > 0 {
+			limit = specValue
+		}
+	}
+	return true, limit, nil
+}
+
+// getStaticSize returns the first dimension of the ssz-size tag of a field.
+func getStaticSize(field *reflect.StructField) (int, error) {
+	sizeTag := strings.Split(field.Tag.Get("ssz-size"), ",")[0]
+	size, err := strconv.ParseUint(sizeTag, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ssz-size tag: %v", err)
+	}
+	return int(size), nil
+}
+
+// getBasicTypeSize returns the ssz size of basic types, or 0 for composite types.
+func getBasicTypeSize(t reflect.Type) int {
+	switch t.Kind() {
+	case reflect.Bool, reflect.Uint8:
+		return 1
+	case reflect.Uint16:
+		return 2
+	case reflect.Uint32:
+		return 4
+	case reflect.Uint64:
+		return 8
+	default:
+		return 0
+	}
+}
+
+// packBasicValues serializes a basic value or a sequence of basic values (little endian).
+func packBasicValues(value reflect.Value) []byte {
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		size := getBasicTypeSize(value.Type())
+		data := make([]byte, size)
+		putBasicValue(data, value)
+		return data
+	}
+
+	size := getBasicTypeSize(value.Type().Elem())
+	data := make([]byte, value.Len()*size)
+	for i := 0; i < value.Len(); i++ {
+		putBasicValue(data[i*size:(i+1)*size], value.Index(i))
+	}
+	return data
+}
+
+func putBasicValue(data []byte, value reflect.Value) {
+	if value.Kind() == reflect.Bool {
+		if value.Bool() {
+			data[0] = 1
+		}
+		return
+	}
+	num := value.Uint()
+	for i := range data {
+		data[i] = byte(num >> (8 * i))
+	}
+}
+
+// chunkBytes splits the data into zero padded 32 byte leaves.
+func chunkBytes(data []byte) []*ssz.Node {
+	nodes := make([]*ssz.Node, 0, (len(data)+31)/32)
+	for i := 0; i < len(data); i += 32 {
+		chunk := make([]byte, 32)
+		copy(chunk, data[i:min(i+32, len(data))])
+		nodes = append(nodes, ssz.NewNodeWithValue(chunk))
+	}
+	return nodes
+}
+
+func nextPowerOfTwo(n uint64) int {
+	size := 1
+	for uint64(size) < n {
+		size <<= 1
+	}
+	return size
+}
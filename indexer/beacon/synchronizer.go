@@ -32,10 +32,12 @@ type synchronizer struct {
 
 	cachedSlot   phase0.Slot
 	cachedBlocks map[phase0.Slot]*Block
+
+	repairMode bool // re-sync already synchronized epochs without touching the sync state
 }
 
 func (indexer *Indexer) startSynchronizer(startEpoch phase0.Epoch) {
-	if indexer.disableSync {
+	if indexer.disableSync || indexer.syncPaused.Load() {
 		return
 	}
 	if !indexer.synchronizer.isEpochAhead(startEpoch) || !indexer.synchronizer.running {
@@ -43,6 +45,30 @@ func (indexer *Indexer) startSynchronizer(startEpoch phase0.Epoch) {
 	}
 }
 
+// PauseSynchronizer stops a running synchronization and keeps the synchronizer from being restarted on finalization.
+func (indexer *Indexer) PauseSynchronizer() error {
+	if indexer.disableSync || indexer.synchronizer == nil {
+		return fmt.Errorf("synchronizer is not enabled")
+	}
+
+	indexer.syncPaused.Store(true)
+	indexer.synchronizer.stopSync()
+	return nil
+}
+
+// ResumeSynchronizer restarts a paused synchronizer from its last synchronized epoch.
+func (indexer *Indexer) ResumeSynchronizer() error {
+	if indexer.disableSync || indexer.synchronizer == nil {
+		return fmt.Errorf("synchronizer is not enabled")
+	}
+	if !indexer.syncPaused.CompareAndSwap(true, false) {
+		return nil
+	}
+
+	indexer.startSynchronizer(indexer.synchronizer.currentEpoch)
+	return nil
+}
+
 func newSynchronizer(indexer *Indexer, logger logrus.FieldLogger) *synchronizer {
 	sync := &synchronizer{
 		indexer: indexer,
@@ -51,7 +77,7 @@ func newSynchronizer(indexer *Indexer, logger logrus.FieldLogger) *synchronizer
 
 	// restore sync state
 	syncState := &dbtypes.IndexerSyncState{}
-	if _, err := db.GetExplorerState("indexer.syncstate", syncState); err == nil {
+	if _, err := db.GetExplorerState(context.Background(), "indexer.syncstate", syncState); err == nil {
 		sync.currentEpoch = phase0.Epoch(syncState.Epoch)
 	}
 
@@ -268,12 +294,13 @@ func (sync *synchronizer) loadBlockBody(client *Client, root phase0.Root) (*spec
 }
 
 func (sync *synchronizer) syncEpoch(syncEpoch phase0.Epoch, client *Client, lastTry bool) (bool, error) {
-	if !utils.Config.Indexer.ResyncForceUpdate && db.IsEpochSynchronized(uint64(syncEpoch)) {
+	if !utils.Config().Indexer.ResyncForceUpdate && !sync.repairMode && db.IsEpochSynchronized(context.Background(), uint64(syncEpoch)) {
 		return true, nil
 	}
 
 	chainState := sync.indexer.consensusPool.GetChainState()
 	specs := chainState.GetSpecs()
+	timer := newEpochTimer(EpochTimingSync, syncEpoch)
 
 	// load headers & blocks from this & next epoch
 	firstSlot := chainState.EpochStartSlot(syncEpoch)
@@ -330,6 +357,7 @@ func (sync *synchronizer) syncEpoch(syncEpoch phase0.Epoch, client *Client, last
 		}
 	}
 	sync.cachedSlot = lastSlot
+	timer.stage("block loading")
 
 	if sync.syncCtx.Err() != nil {
 		return false, nil
@@ -354,6 +382,7 @@ func (sync *synchronizer) syncEpoch(syncEpoch phase0.Epoch, client *Client, last
 	if (err != nil || epochState.loadingStatus != 2) && !lastTry {
 		return false, fmt.Errorf("error fetching epoch %v state: %v", syncEpoch, err)
 	}
+	timer.stage("state loading")
 
 	var epochStats *EpochStats
 	var epochStatsValues *EpochStatsValues
@@ -374,6 +403,7 @@ func (sync *synchronizer) syncEpoch(syncEpoch phase0.Epoch, client *Client, last
 		epochStats.processState(sync.indexer, validatorSet)
 		epochStatsValues = epochStats.GetValues(false)
 	}
+	timer.stage("duty computation")
 
 	if sync.syncCtx.Err() != nil {
 		return false, nil
@@ -390,6 +420,7 @@ func (sync *synchronizer) syncEpoch(syncEpoch phase0.Epoch, client *Client, last
 			return false, fmt.Errorf("failed computing votes for epoch %v", syncEpoch)
 		}
 	}
+	timer.stage("vote aggregation")
 
 	// save blocks
 	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
@@ -420,6 +451,14 @@ func (sync *synchronizer) syncEpoch(syncEpoch phase0.Epoch, client *Client, last
 			return fmt.Errorf("failed deleting finalized forks: %v", err)
 		}
 
+		if sync.repairMode {
+			// blocks wrongly marked as canonical are replaced by the re-fetched chain
+			if err := db.OrphanCanonicalSlotsInRange(uint64(firstSlot), uint64(chainState.EpochStartSlot(syncEpoch+1)-1), canonicalBlockRoots, tx); err != nil {
+				return fmt.Errorf("failed orphaning stale canonical blocks: %v", err)
+			}
+			return nil
+		}
+
 		err = db.SetExplorerState("indexer.syncstate", &dbtypes.IndexerSyncState{
 			Epoch: uint64(syncEpoch),
 		}, tx)
@@ -432,6 +471,8 @@ func (sync *synchronizer) syncEpoch(syncEpoch phase0.Epoch, client *Client, last
 	if err != nil {
 		return false, err
 	}
+	timer.stage("db write")
+	timer.finish(sync.indexer, dependentRoot)
 
 	// cleanup cache (remove blocks from this epoch)
 	for slot := firstSlot; slot <= lastSlot; slot++ {
@@ -1,8 +1,8 @@
 package beacon
 
 import (
-	"encoding/binary"
 	"math"
+	"math/bits"
 	"reflect"
 	"sort"
 	"sync"
@@ -14,37 +14,20 @@ import (
 type validatorCache struct {
 	indexer *Indexer
 
-	valsetCache          []*validatorEntry // cache for validators
-	cacheMutex           sync.RWMutex      // mutex to protect valsetCache for concurrent access
+	finalColumns         validatorColumns                           // finalized validator records
+	validatorDiffs       map[phase0.ValidatorIndex][]*validatorDiff // unfinalized validator record changes
+	cacheMutex           sync.RWMutex                               // mutex to protect finalColumns & validatorDiffs for concurrent access
 	validatorActivityMap map[phase0.ValidatorIndex][]ValidatorActivity
-	activityMutex        sync.RWMutex // mutex to protect recentActivity for concurrent access
-	lastFinalized        phase0.Epoch // last finalized epoch
-	oldestActivityEpoch  phase0.Epoch // oldest epoch in activity cache
+	activityEpochs       []validatorActivityEpochs // per validator bitfield of epochs with included votes
+	activityMutex        sync.RWMutex              // mutex to protect recentActivity & activityEpochs for concurrent access
+	lastFinalized        phase0.Epoch              // last finalized epoch
+	changeTracking       bool                      // true if finalized validator changes are being tracked
+	changeTrackingEpoch  phase0.Epoch              // first epoch with tracked validator changes
+	oldestActivityEpoch  phase0.Epoch              // oldest epoch in activity cache
 	pubkeyMap            map[phase0.BLSPubKey]phase0.ValidatorIndex
 	pubkeyMutex          sync.RWMutex // mutex to protect pubkeyMap for concurrent access
 }
 
-// validatorDiffKey is the primary key for validatorDiff entries in cache.
-// consists of dependendRoot (32 byte) and epoch (8 byte).
-type validatorDiffKey [32 + 8]byte
-
-// generate validatorDiffKey from epoch and dependentRoot
-func getValidatorDiffKey(epoch phase0.Epoch, dependentRoot phase0.Root) validatorDiffKey {
-	var key validatorDiffKey
-
-	copy(key[0:], dependentRoot[:])
-	binary.LittleEndian.PutUint64(key[32:], uint64(epoch))
-
-	return key
-}
-
-// validatorEntry represents a validator in the validator set cache.
-type validatorEntry struct {
-	index          phase0.ValidatorIndex
-	finalValidator *phase0.Validator
-	validatorDiffs map[validatorDiffKey]*validatorDiff
-}
-
 // ValidatorActivity represents a validator's activity in an epoch.
 // entry size: 18 bytes (10 bytes data + 8 bytes pointer)
 // max. entries per validator: 3-8 (inMemoryEpochs)
@@ -63,6 +46,55 @@ type ValidatorActivity struct {
 	VoteDelay uint16 // the inclusion delay of the vote in slots
 }
 
+// validatorActivityEpochs tracks the epochs a validator voted for as a bitfield relative to the latest voted epoch.
+// bit n is set if the validator voted for epoch (latestEpoch - n).
+// entry size: 16 bytes, 1M validators: 16MB
+type validatorActivityEpochs struct {
+	latestEpoch phase0.Epoch
+	epochBits   uint64
+}
+
+// setEpoch marks the given epoch as voted.
+func (entry *validatorActivityEpochs) setEpoch(epoch phase0.Epoch) {
+	switch {
+	case entry.epochBits == 0:
+		entry.latestEpoch = epoch
+		entry.epochBits = 1
+	case epoch > entry.latestEpoch:
+		shift := epoch - entry.latestEpoch
+		if shift >= 64 {
+			entry.epochBits = 0
+		} else {
+			entry.epochBits <<= shift
+		}
+		entry.epochBits |= 1
+		entry.latestEpoch = epoch
+	case entry.latestEpoch-epoch < 64:
+		entry.epochBits |= 1 << (entry.latestEpoch - epoch)
+	}
+}
+
+// countEpochs returns the number of voted epochs within the lookback window ending at refEpoch.
+func (entry *validatorActivityEpochs) countEpochs(refEpoch phase0.Epoch, lookbackEpochs uint64) uint64 {
+	if entry.epochBits == 0 {
+		return 0
+	}
+	if refEpoch < entry.latestEpoch {
+		refEpoch = entry.latestEpoch
+	}
+
+	distance := uint64(refEpoch - entry.latestEpoch)
+	if distance >= lookbackEpochs {
+		return 0
+	}
+
+	windowBits := lookbackEpochs - distance
+	if windowBits >= 64 {
+		return uint64(bits.OnesCount64(entry.epochBits))
+	}
+	return uint64(bits.OnesCount64(entry.epochBits & (1<<windowBits - 1)))
+}
+
 // validatorDiff represents an updated validator entry in the validator set cache.
 type validatorDiff struct {
 	epoch         phase0.Epoch
@@ -74,6 +106,7 @@ type validatorDiff struct {
 func newValidatorCache(indexer *Indexer) *validatorCache {
 	cache := &validatorCache{
 		indexer:              indexer,
+		validatorDiffs:       make(map[phase0.ValidatorIndex][]*validatorDiff),
 		validatorActivityMap: make(map[phase0.ValidatorIndex][]ValidatorActivity),
 		oldestActivityEpoch:  math.MaxInt64,
 		pubkeyMap:            make(map[phase0.BLSPubKey]phase0.ValidatorIndex),
@@ -83,14 +116,11 @@ func newValidatorCache(indexer *Indexer) *validatorCache {
 }
 
 // updateValidatorSet updates the validator set cache with the new validator set.
+// only the records that differ from the parent record on the chain of the dependentRoot are stored as unfinalized changes.
 func (cache *validatorCache) updateValidatorSet(epoch phase0.Epoch, dependentRoot phase0.Root, validators []*phase0.Validator) {
 	cache.cacheMutex.Lock()
 	defer cache.cacheMutex.Unlock()
 
-	if cache.valsetCache == nil {
-		cache.valsetCache = make([]*validatorEntry, 0, len(validators))
-	}
-
 	isParentMap := map[phase0.Root]bool{}
 	isAheadMap := map[phase0.Root]bool{}
 
@@ -109,37 +139,35 @@ func (cache *validatorCache) updateValidatorSet(epoch phase0.Epoch, dependentRoo
 		return
 	}
 
-	for i := range validators {
+	for i, validator := range validators {
+		index := phase0.ValidatorIndex(i)
+		if i >= cache.finalColumns.len() {
+			cache.finalColumns.appendValidator(validator.PublicKey)
+			cache.pubkeyMutex.Lock()
+			cache.pubkeyMap[validator.PublicKey] = index
+			cache.pubkeyMutex.Unlock()
+		}
+
 		var parentValidator *phase0.Validator
 		parentEpoch := phase0.Epoch(0)
 
-		aheadDiffIdx := validatorDiffKey{}
-		foundAhead := false
+		var aheadDiff *validatorDiff
 		aheadEpoch := phase0.Epoch(math.MaxInt64)
 
-		var cachedValidator *validatorEntry
-		if len(cache.valsetCache) > i {
-			cachedValidator = cache.valsetCache[i]
-		}
+		var sameKeyDiff *validatorDiff
 
-		if cachedValidator == nil {
-			cachedValidator = &validatorEntry{
-				index:          phase0.ValidatorIndex(i),
-				validatorDiffs: make(map[validatorDiffKey]*validatorDiff),
-			}
-			cache.valsetCache = append(cache.valsetCache, cachedValidator)
-			cache.pubkeyMutex.Lock()
-			cache.pubkeyMap[validators[i].PublicKey] = phase0.ValidatorIndex(i)
-			cache.pubkeyMutex.Unlock()
-		} else {
-			parentValidator = cachedValidator.finalValidator
-		}
-
-		for diffkey, diff := range cachedValidator.validatorDiffs {
+		diffs := cache.validatorDiffs[index]
+		diffCount := len(diffs)
+		keptDiffs := diffs[:0]
+		for _, diff := range diffs {
 			if diff.epoch < cutOffEpoch {
-				delete(cachedValidator.validatorDiffs, diffkey)
 				continue
 			}
+			keptDiffs = append(keptDiffs, diff)
+
+			if diff.epoch == epoch && diff.dependentRoot == dependentRoot {
+				sameKeyDiff = diff
+			}
 
 			if diff.epoch < epoch {
 				isParent, checkedParent := isParentMap[diff.dependentRoot]
@@ -162,36 +190,65 @@ func (cache *validatorCache) updateValidatorSet(epoch phase0.Epoch, dependentRoo
 				}
 
 				if isAhead && diff.epoch < aheadEpoch {
-					aheadDiffIdx = diffkey
+					aheadDiff = diff
 					aheadEpoch = diff.epoch
-					foundAhead = true
 				}
 			}
 		}
-
-		if parentValidator != nil && reflect.DeepEqual(parentValidator, validators[i]) {
-			// no change
-			continue
+		for j := len(keptDiffs); j < diffCount; j++ {
+			diffs[j] = nil // clear for gc
 		}
+		diffs = keptDiffs
 
-		diffKey := getValidatorDiffKey(epoch, dependentRoot)
-
-		if foundAhead && reflect.DeepEqual(cachedValidator.validatorDiffs[aheadDiffIdx].validator, validators[i]) {
-			diff := cachedValidator.validatorDiffs[aheadDiffIdx]
-			diff.epoch = epoch
-			diff.dependentRoot = dependentRoot
-			cachedValidator.validatorDiffs[diffKey] = diff
-			delete(cachedValidator.validatorDiffs, aheadDiffIdx)
+		isUnchanged := false
+		if parentValidator != nil {
+			isUnchanged = reflect.DeepEqual(parentValidator, validator)
 		} else {
-			cachedValidator.validatorDiffs[diffKey] = &validatorDiff{
+			isUnchanged = cache.finalColumns.equalsRecord(index, validator)
+		}
+
+		switch {
+		case isUnchanged:
+			// no change
+		case aheadDiff != nil && reflect.DeepEqual(aheadDiff.validator, validator):
+			// move the change of the descendant epoch back to this epoch
+			if sameKeyDiff != nil {
+				diffs = removeValidatorDiff(diffs, sameKeyDiff)
+			}
+			aheadDiff.epoch = epoch
+			aheadDiff.dependentRoot = dependentRoot
+		case sameKeyDiff != nil:
+			sameKeyDiff.validator = validator
+		default:
+			diffs = append(diffs, &validatorDiff{
 				epoch:         epoch,
 				dependentRoot: dependentRoot,
-				validator:     validators[i],
+				validator:     validator,
+			})
+		}
+
+		if len(diffs) == 0 {
+			if diffCount > 0 {
+				delete(cache.validatorDiffs, index)
 			}
+		} else {
+			cache.validatorDiffs[index] = diffs
 		}
 	}
 }
 
+// removeValidatorDiff removes the given diff from the list of diffs.
+func removeValidatorDiff(diffs []*validatorDiff, diff *validatorDiff) []*validatorDiff {
+	for i, d := range diffs {
+		if d == diff {
+			copy(diffs[i:], diffs[i+1:])
+			diffs[len(diffs)-1] = nil
+			return diffs[:len(diffs)-1]
+		}
+	}
+	return diffs
+}
+
 // updateValidatorActivity updates the validator activity cache.
 func (cache *validatorCache) updateValidatorActivity(validatorIndex phase0.ValidatorIndex, epoch phase0.Epoch, dutySlot phase0.Slot, voteBlock *Block) {
 	chainState := cache.indexer.consensusPool.GetChainState()
@@ -214,6 +271,16 @@ func (cache *validatorCache) updateValidatorActivity(validatorIndex phase0.Valid
 	cache.activityMutex.Lock()
 	defer cache.activityMutex.Unlock()
 
+	if int(validatorIndex) >= len(cache.activityEpochs) {
+		if int(validatorIndex) >= cap(cache.activityEpochs) {
+			activityEpochs := make([]validatorActivityEpochs, len(cache.activityEpochs), (validatorIndex+1)*5/4)
+			copy(activityEpochs, cache.activityEpochs)
+			cache.activityEpochs = activityEpochs
+		}
+		cache.activityEpochs = cache.activityEpochs[:validatorIndex+1]
+	}
+	cache.activityEpochs[validatorIndex].setEpoch(epoch)
+
 	recentActivity := cache.validatorActivityMap[validatorIndex]
 	if recentActivity == nil {
 		recentActivity = make([]ValidatorActivity, 0, cache.indexer.activityHistoryLength)
@@ -275,47 +342,71 @@ func (cache *validatorCache) setFinalizedEpoch(epoch phase0.Epoch, nextEpochDepe
 
 	cache.lastFinalized = epoch
 
-	for _, cachedValidator := range cache.valsetCache {
-		for diffKey, diff := range cachedValidator.validatorDiffs {
-			if diff.dependentRoot == nextEpochDependentRoot {
-				cachedValidator.finalValidator = diff.validator
+	trackChanges := cache.changeTracking
+	if !trackChanges {
+		// changes are tracked relative to the first finalized validator set
+		cache.changeTracking = true
+		cache.changeTrackingEpoch = epoch
+	}
+
+	for index, diffs := range cache.validatorDiffs {
+		var finalDiff *validatorDiff
+		diffCount := len(diffs)
+		keptDiffs := diffs[:0]
+		for _, diff := range diffs {
+			if diff.dependentRoot == nextEpochDependentRoot && (finalDiff == nil || diff.epoch > finalDiff.epoch) {
+				finalDiff = diff
 			}
 
-			if diff.epoch <= epoch {
-				delete(cachedValidator.validatorDiffs, diffKey)
+			if diff.epoch > epoch {
+				keptDiffs = append(keptDiffs, diff)
 			}
 		}
-	}
-}
 
-// getValidatorSet returns the validator set for a given forkId.
-func (cache *validatorCache) getValidatorSet(overrideForkId *ForkKey) []*phase0.Validator {
-	canonicalHead := cache.indexer.GetCanonicalHead(overrideForkId)
-	if canonicalHead == nil {
-		return []*phase0.Validator{}
-	}
+		if finalDiff != nil {
+			if trackChanges && !cache.finalColumns.equalsRecord(index, finalDiff.validator) {
+				cache.finalColumns.changeEpochs[index] = finalDiff.epoch
+			}
+			cache.finalColumns.setRecord(index, finalDiff.validator)
+		}
 
-	return cache.getValidatorSetForRoot(canonicalHead.Root)
+		for j := len(keptDiffs); j < diffCount; j++ {
+			diffs[j] = nil // clear for gc
+		}
+		if len(keptDiffs) == 0 {
+			delete(cache.validatorDiffs, index)
+		} else {
+			cache.validatorDiffs[index] = keptDiffs
+		}
+	}
 }
 
-// getValidatorSetForRoot returns the validator set for a given blockRoot.
-func (cache *validatorCache) getValidatorSetForRoot(blockRoot phase0.Root) []*phase0.Validator {
+// getValidatorSetView returns a view of the validator set for a given blockRoot.
+// the statuses are computed for the given epoch, the balances are optional.
+func (cache *validatorCache) getValidatorSetView(blockRoot phase0.Root, epoch phase0.Epoch, balances []phase0.Gwei) *ValidatorSetView {
 	cache.cacheMutex.RLock()
 	defer cache.cacheMutex.RUnlock()
 
+	view := &ValidatorSetView{
+		cache:    cache,
+		epoch:    epoch,
+		count:    cache.finalColumns.len(),
+		overlay:  make(map[phase0.ValidatorIndex]*phase0.Validator, len(cache.validatorDiffs)),
+		balances: balances,
+	}
+
 	isParentMap := map[phase0.Root]bool{}
 	isAheadMap := map[phase0.Root]bool{}
 
-	validatorSet := make([]*phase0.Validator, 0, len(cache.valsetCache))
-
-	for _, cachedValidator := range cache.valsetCache {
-		validator := cachedValidator.finalValidator
+	for index, diffs := range cache.validatorDiffs {
+		var validator *phase0.Validator
+		hasRecord := cache.finalColumns.known[index]
 		validatorEpoch := cache.lastFinalized
 
 		var aheadValidator *phase0.Validator
 		aheadEpoch := phase0.Epoch(math.MaxInt64)
 
-		for _, diff := range cachedValidator.validatorDiffs {
+		for _, diff := range diffs {
 			isParent, checkedParent := isParentMap[diff.dependentRoot]
 			if !checkedParent {
 				isParent = cache.indexer.blockCache.isCanonicalBlock(diff.dependentRoot, blockRoot)
@@ -325,9 +416,10 @@ func (cache *validatorCache) getValidatorSetForRoot(blockRoot phase0.Root) []*ph
 			if isParent && diff.epoch >= validatorEpoch {
 				validator = diff.validator
 				validatorEpoch = diff.epoch
+				hasRecord = true
 			}
 
-			if !isParent && validator == nil {
+			if !isParent && !hasRecord {
 				isAhead, checkedAhead := isAheadMap[diff.dependentRoot]
 				if !checkedAhead {
 					isAhead = cache.indexer.blockCache.isCanonicalBlock(blockRoot, diff.dependentRoot)
@@ -341,14 +433,28 @@ func (cache *validatorCache) getValidatorSetForRoot(blockRoot phase0.Root) []*ph
 			}
 		}
 
-		if validator == nil && aheadValidator != nil {
+		if !hasRecord && aheadValidator != nil {
 			validator = aheadValidator
 		}
 
-		validatorSet = append(validatorSet, validator)
+		if validator != nil {
+			view.overlay[index] = validator
+		}
+	}
+
+	return view
+}
+
+// getCanonicalValidatorSetView returns a view of the validator set on the canonical chain of the given forkId, as of the current epoch.
+func (cache *validatorCache) getCanonicalValidatorSetView(overrideForkId *ForkKey) *ValidatorSetView {
+	currentEpoch := cache.indexer.consensusPool.GetChainState().CurrentEpoch()
+
+	canonicalHead := cache.indexer.GetCanonicalHead(overrideForkId)
+	if canonicalHead == nil {
+		return &ValidatorSetView{cache: cache, epoch: currentEpoch}
 	}
 
-	return validatorSet
+	return cache.getValidatorSetView(canonicalHead.Root, currentEpoch, nil)
 }
 
 // getValidatorByIndex returns the validator by index for a given forkId.
@@ -368,19 +474,14 @@ func (cache *validatorCache) getValidatorByIndexAndRoot(index phase0.ValidatorIn
 
 	isParentMap := map[phase0.Root]bool{}
 
-	if index >= phase0.ValidatorIndex(len(cache.valsetCache)) {
+	if index >= phase0.ValidatorIndex(cache.finalColumns.len()) {
 		return nil
 	}
 
-	cachedValidator := cache.valsetCache[index]
-	if cachedValidator == nil {
-		return nil
-	}
-
-	validator := cachedValidator.finalValidator
+	var validator *phase0.Validator
 	validatorEpoch := cache.lastFinalized
 
-	for _, diff := range cachedValidator.validatorDiffs {
+	for _, diff := range cache.validatorDiffs[index] {
 		isParent, checkedParent := isParentMap[diff.dependentRoot]
 		if !checkedParent {
 			isParent = cache.indexer.blockCache.isCanonicalBlock(diff.dependentRoot, blockRoot)
@@ -393,9 +494,58 @@ func (cache *validatorCache) getValidatorByIndexAndRoot(index phase0.ValidatorIn
 		}
 	}
 
+	if validator == nil {
+		validator = cache.finalColumns.getValidator(index)
+	}
+
 	return validator
 }
 
+// getChangedValidatorsForRoot returns the indices of all validators whose record changed after the given epoch on the chain of the given blockRoot.
+// returns false if changes since the given epoch are not tracked, which is the case for epochs before the first finalization after startup.
+func (cache *validatorCache) getChangedValidatorsForRoot(sinceEpoch phase0.Epoch, blockRoot phase0.Root) ([]phase0.ValidatorIndex, bool) {
+	cache.cacheMutex.RLock()
+	defer cache.cacheMutex.RUnlock()
+
+	if !cache.changeTracking || sinceEpoch < cache.changeTrackingEpoch {
+		return nil, false
+	}
+
+	isParentMap := map[phase0.Root]bool{}
+	changedValidators := []phase0.ValidatorIndex{}
+
+	for index, changeEpoch := range cache.finalColumns.changeEpochs {
+		if changeEpoch > sinceEpoch {
+			changedValidators = append(changedValidators, phase0.ValidatorIndex(index))
+		}
+	}
+
+	for index, diffs := range cache.validatorDiffs {
+		if cache.finalColumns.changeEpochs[index] > sinceEpoch {
+			continue // already added
+		}
+
+		for _, diff := range diffs {
+			if diff.epoch <= sinceEpoch {
+				continue
+			}
+
+			isParent, checkedParent := isParentMap[diff.dependentRoot]
+			if !checkedParent {
+				isParent = cache.indexer.blockCache.isCanonicalBlock(diff.dependentRoot, blockRoot)
+				isParentMap[diff.dependentRoot] = isParent
+			}
+
+			if isParent {
+				changedValidators = append(changedValidators, index)
+				break
+			}
+		}
+	}
+
+	return changedValidators, true
+}
+
 // getValidatorIndexByPubkey returns the validator index by pubkey.
 func (cache *validatorCache) getValidatorIndexByPubkey(pubkey phase0.BLSPubKey) (phase0.ValidatorIndex, bool) {
 	cache.pubkeyMutex.RLock()
@@ -424,3 +574,14 @@ func (cache *validatorCache) getValidatorActivity(validatorIndex phase0.Validato
 
 	return recentActivity
 }
+
+// getValidatorLiveness returns the number of epochs within the lookback window ending at refEpoch the validator voted for.
+func (cache *validatorCache) getValidatorLiveness(validatorIndex phase0.ValidatorIndex, refEpoch phase0.Epoch, lookbackEpochs uint64) uint64 {
+	cache.activityMutex.RLock()
+	defer cache.activityMutex.RUnlock()
+
+	if int(validatorIndex) >= len(cache.activityEpochs) {
+		return 0
+	}
+	return cache.activityEpochs[validatorIndex].countEpochs(refEpoch, lookbackEpochs)
+}
@@ -0,0 +1,305 @@
+package beacon
+
+import (
+	"bytes"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// validatorColumns holds the finalized validator records of the validator cache in a compact, column oriented layout.
+// all columns are indexed by validator index and do not contain pointers, so large sets don't add to GC scan times.
+// the records are updated in place on finalization.
+// memory consumption: ~140 bytes per validator (~140MB for 1M validators)
+type validatorColumns struct {
+	known                       []bool // false for validators without finalized record
+	pubkeys                     []phase0.BLSPubKey
+	withdrawalCredentials       [][32]byte
+	effectiveBalances           []phase0.Gwei
+	slashed                     []bool
+	activationEligibilityEpochs []phase0.Epoch
+	activationEpochs            []phase0.Epoch
+	exitEpochs                  []phase0.Epoch
+	withdrawableEpochs          []phase0.Epoch
+	changeEpochs                []phase0.Epoch // epoch of the last finalized change
+}
+
+// ValidatorRecord is the record of a single validator, read from a validator set view.
+type ValidatorRecord struct {
+	Index                      phase0.ValidatorIndex
+	Pubkey                     phase0.BLSPubKey
+	WithdrawalCredentials      [32]byte
+	EffectiveBalance           phase0.Gwei
+	Balance                    phase0.Gwei // the effective balance if the view does not include balances
+	Status                     v1.ValidatorState
+	Slashed                    bool
+	ActivationEligibilityEpoch phase0.Epoch
+	ActivationEpoch            phase0.Epoch
+	ExitEpoch                  phase0.Epoch
+	WithdrawableEpoch          phase0.Epoch
+}
+
+// ValidatorSetView is a read-only view of the cached validator set on a given chain as of a given epoch.
+// the finalized records are read from the columns of the validator cache, only the unfinalized records of the viewed chain
+// are resolved on creation, so views are cheap to create and don't copy the validator set.
+// the finalized records are updated in place, so a view may return records that got finalized after it was created.
+type ValidatorSetView struct {
+	cache    *validatorCache
+	epoch    phase0.Epoch
+	count    int
+	overlay  map[phase0.ValidatorIndex]*phase0.Validator // unfinalized records of the viewed chain
+	balances []phase0.Gwei
+}
+
+func (columns *validatorColumns) len() int {
+	return len(columns.known)
+}
+
+// appendValidator adds a validator without finalized record to the columns.
+func (columns *validatorColumns) appendValidator(pubkey phase0.BLSPubKey) {
+	columns.known = append(columns.known, false)
+	columns.pubkeys = append(columns.pubkeys, pubkey)
+	columns.withdrawalCredentials = append(columns.withdrawalCredentials, [32]byte{})
+	columns.effectiveBalances = append(columns.effectiveBalances, 0)
+	columns.slashed = append(columns.slashed, false)
+	columns.activationEligibilityEpochs = append(columns.activationEligibilityEpochs, 0)
+	columns.activationEpochs = append(columns.activationEpochs, 0)
+	columns.exitEpochs = append(columns.exitEpochs, 0)
+	columns.withdrawableEpochs = append(columns.withdrawableEpochs, 0)
+	columns.changeEpochs = append(columns.changeEpochs, 0)
+}
+
+// setRecord updates the finalized record of a validator.
+func (columns *validatorColumns) setRecord(index phase0.ValidatorIndex, validator *phase0.Validator) {
+	columns.known[index] = true
+	columns.pubkeys[index] = validator.PublicKey
+	copy(columns.withdrawalCredentials[index][:], validator.WithdrawalCredentials)
+	columns.effectiveBalances[index] = validator.EffectiveBalance
+	columns.slashed[index] = validator.Slashed
+	columns.activationEligibilityEpochs[index] = validator.ActivationEligibilityEpoch
+	columns.activationEpochs[index] = validator.ActivationEpoch
+	columns.exitEpochs[index] = validator.ExitEpoch
+	columns.withdrawableEpochs[index] = validator.WithdrawableEpoch
+}
+
+// equalsRecord returns true if the finalized record of the validator matches the given validator.
+func (columns *validatorColumns) equalsRecord(index phase0.ValidatorIndex, validator *phase0.Validator) bool {
+	return columns.known[index] &&
+		columns.pubkeys[index] == validator.PublicKey &&
+		bytes.Equal(columns.withdrawalCredentials[index][:], validator.WithdrawalCredentials) &&
+		columns.effectiveBalances[index] == validator.EffectiveBalance &&
+		columns.slashed[index] == validator.Slashed &&
+		columns.activationEligibilityEpochs[index] == validator.ActivationEligibilityEpoch &&
+		columns.activationEpochs[index] == validator.ActivationEpoch &&
+		columns.exitEpochs[index] == validator.ExitEpoch &&
+		columns.withdrawableEpochs[index] == validator.WithdrawableEpoch
+}
+
+// loadRecord reads the finalized record of a validator, returns false if there is no finalized record.
+func (columns *validatorColumns) loadRecord(index phase0.ValidatorIndex, record *ValidatorRecord) bool {
+	if !columns.known[index] {
+		return false
+	}
+
+	record.Pubkey = columns.pubkeys[index]
+	record.WithdrawalCredentials = columns.withdrawalCredentials[index]
+	record.EffectiveBalance = columns.effectiveBalances[index]
+	record.Slashed = columns.slashed[index]
+	record.ActivationEligibilityEpoch = columns.activationEligibilityEpochs[index]
+	record.ActivationEpoch = columns.activationEpochs[index]
+	record.ExitEpoch = columns.exitEpochs[index]
+	record.WithdrawableEpoch = columns.withdrawableEpochs[index]
+	return true
+}
+
+// getValidator materializes the finalized record of a validator, returns nil if there is no finalized record.
+func (columns *validatorColumns) getValidator(index phase0.ValidatorIndex) *phase0.Validator {
+	record := ValidatorRecord{}
+	if !columns.loadRecord(index, &record) {
+		return nil
+	}
+	return record.toValidator()
+}
+
+// toValidator materializes the phase0 validator record.
+func (record *ValidatorRecord) toValidator() *phase0.Validator {
+	return &phase0.Validator{
+		PublicKey:                  record.Pubkey,
+		WithdrawalCredentials:      bytes.Clone(record.WithdrawalCredentials[:]),
+		EffectiveBalance:           record.EffectiveBalance,
+		Slashed:                    record.Slashed,
+		ActivationEligibilityEpoch: record.ActivationEligibilityEpoch,
+		ActivationEpoch:            record.ActivationEpoch,
+		ExitEpoch:                  record.ExitEpoch,
+		WithdrawableEpoch:          record.WithdrawableEpoch,
+	}
+}
+
+// Epoch returns the epoch the validator statuses are computed for.
+func (view *ValidatorSetView) Epoch() phase0.Epoch {
+	return view.epoch
+}
+
+// HasBalances returns true if the view includes the validator balances.
+func (view *ValidatorSetView) HasBalances() bool {
+	return view.balances != nil
+}
+
+// Len returns the number of validators in the set.
+func (view *ValidatorSetView) Len() int {
+	return view.count
+}
+
+// IsKnown returns true if the validator record is known for the set.
+func (view *ValidatorSetView) IsKnown(index phase0.ValidatorIndex) bool {
+	if uint64(index) >= uint64(view.count) {
+		return false
+	}
+	if view.overlay[index] != nil {
+		return true
+	}
+
+	view.cache.cacheMutex.RLock()
+	defer view.cache.cacheMutex.RUnlock()
+
+	return view.cache.finalColumns.known[index]
+}
+
+// GetRecord returns the record of a single validator, the second return value is false if the validator is unknown.
+func (view *ValidatorSetView) GetRecord(index phase0.ValidatorIndex) (ValidatorRecord, bool) {
+	view.cache.cacheMutex.RLock()
+	defer view.cache.cacheMutex.RUnlock()
+
+	record := ValidatorRecord{}
+	found := view.loadRecord(index, &record)
+	return record, found
+}
+
+// GetValidator materializes the record of a single validator, including balance and status.
+// returns nil if the validator is unknown.
+func (view *ValidatorSetView) GetValidator(index phase0.ValidatorIndex) *v1.Validator {
+	record, found := view.GetRecord(index)
+	if !found {
+		return nil
+	}
+
+	validator := &v1.Validator{
+		Index:     index,
+		Status:    record.Status,
+		Validator: record.toValidator(),
+	}
+
+	if view.HasBalances() {
+		validator.Balance = record.Balance
+	}
+
+	return validator
+}
+
+// forEachRecord calls fn for all known validators of the set.
+// the validator cache is locked for the whole iteration, so fn must not call back into the validator cache.
+func (view *ValidatorSetView) forEachRecord(fn func(record *ValidatorRecord)) {
+	view.cache.cacheMutex.RLock()
+	defer view.cache.cacheMutex.RUnlock()
+
+	record := ValidatorRecord{}
+	for index := 0; index < view.count; index++ {
+		if view.loadRecord(phase0.ValidatorIndex(index), &record) {
+			fn(&record)
+		}
+	}
+}
+
+// loadRecord reads the record of a validator, the caller must hold the validator cache lock.
+func (view *ValidatorSetView) loadRecord(index phase0.ValidatorIndex, record *ValidatorRecord) bool {
+	if uint64(index) >= uint64(view.count) {
+		return false
+	}
+
+	if validator := view.overlay[index]; validator != nil {
+		record.Pubkey = validator.PublicKey
+		copy(record.WithdrawalCredentials[:], validator.WithdrawalCredentials)
+		record.EffectiveBalance = validator.EffectiveBalance
+		record.Slashed = validator.Slashed
+		record.ActivationEligibilityEpoch = validator.ActivationEligibilityEpoch
+		record.ActivationEpoch = validator.ActivationEpoch
+		record.ExitEpoch = validator.ExitEpoch
+		record.WithdrawableEpoch = validator.WithdrawableEpoch
+	} else if !view.cache.finalColumns.loadRecord(index, record) {
+		return false
+	}
+
+	record.Index = index
+
+	var balance *phase0.Gwei
+	if view.balances != nil && int(index) < len(view.balances) {
+		record.Balance = view.balances[index]
+		balance = &record.Balance
+	} else {
+		record.Balance = record.EffectiveBalance
+	}
+
+	validator := phase0.Validator{
+		EffectiveBalance:           record.EffectiveBalance,
+		Slashed:                    record.Slashed,
+		ActivationEligibilityEpoch: record.ActivationEligibilityEpoch,
+		ActivationEpoch:            record.ActivationEpoch,
+		ExitEpoch:                  record.ExitEpoch,
+		WithdrawableEpoch:          record.WithdrawableEpoch,
+	}
+	record.Status = v1.ValidatorToState(&validator, balance, view.epoch, FarFutureEpoch)
+
+	return true
+}
+
+// GetEpochValidatorSetView returns a view of the validator set for a given epoch, including balances and validator status.
+// If an overrideForkId is provided, the validator set for the fork is returned.
+func (indexer *Indexer) GetEpochValidatorSetView(epoch phase0.Epoch, overrideForkId *ForkKey, withBalances bool) *ValidatorSetView {
+	var epochStats *EpochStats
+
+	if withBalances {
+		var ok bool
+		epochStats, ok = indexer.getValidatorSetEpochStats(epoch, overrideForkId)
+		if !ok {
+			return &ValidatorSetView{cache: indexer.validatorCache, epoch: epoch}
+		}
+	}
+
+	if epochStats != nil && epochStats.dependentState != nil && epochStats.dependentState.loadingStatus == 2 {
+		return indexer.validatorCache.getValidatorSetView(epochStats.dependentRoot, epoch, epochStats.dependentState.validatorBalances)
+	}
+
+	canonicalHead := indexer.GetCanonicalHead(overrideForkId)
+	if canonicalHead == nil {
+		return &ValidatorSetView{cache: indexer.validatorCache, epoch: epoch}
+	}
+
+	return indexer.validatorCache.getValidatorSetView(canonicalHead.Root, epoch, nil)
+}
+
+// GetHistoricValidatorSetView returns a view of the validator set as of a past epoch, reconstructed from the canonical validator registry.
+// Validators registered after the epoch are cut off and the statuses are computed for the epoch. All other fields reflect the latest registry,
+// so exits & credential changes initiated after the epoch are already visible.
+func (indexer *Indexer) GetHistoricValidatorSetView(epoch phase0.Epoch, balances []phase0.Gwei) *ValidatorSetView {
+	canonicalHead := indexer.GetCanonicalHead(nil)
+	if canonicalHead == nil {
+		return &ValidatorSetView{cache: indexer.validatorCache, epoch: epoch}
+	}
+
+	view := indexer.validatorCache.getValidatorSetView(canonicalHead.Root, epoch, nil)
+
+	// validators are appended to the registry, so all validators that were not eligible at the epoch are at the end of the set
+	for view.count > 0 {
+		record, found := view.GetRecord(phase0.ValidatorIndex(view.count - 1))
+		if found && record.ActivationEligibilityEpoch <= epoch {
+			break
+		}
+		view.count--
+	}
+
+	if balances != nil && len(balances) >= view.count {
+		view.balances = balances
+	}
+
+	return view
+}
@@ -268,6 +268,7 @@ func (dbw *dbWriter) buildDbBlock(block *Block, epochStats *EpochStats, override
 		AttesterSlashingCount: uint64(len(attesterSlashings)),
 		ProposerSlashingCount: uint64(len(proposerSlashings)),
 		BLSChangeCount:        uint64(len(blsToExecChanges)),
+		AttestationDelay:      getBlockAttestationDelay(block.header.Message.Slot, attestations),
 	}
 
 	if overrideForkId != nil {
@@ -294,6 +295,7 @@ func (dbw *dbWriter) buildDbBlock(block *Block, epochStats *EpochStats, override
 
 	if executionBlockNumber > 0 {
 		dbBlock.EthTransactionCount = uint64(len(executionTransactions))
+		dbBlock.EthBlobTxCount, dbBlock.EthBlobTxSize, dbBlock.EthBlobTxDist = getBlockBlobTxStats(executionTransactions)
 		dbBlock.EthBlockNumber = &executionBlockNumber
 		dbBlock.EthBlockHash = executionBlockHash[:]
 		dbBlock.EthBlockExtra = executionExtraData
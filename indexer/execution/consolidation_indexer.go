@@ -1,7 +1,7 @@
 package execution
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"time"
 
@@ -35,7 +35,7 @@ type consolidationRequestMatch struct {
 
 // NewConsolidationIndexer creates a new consolidation system contract indexer
 func NewConsolidationIndexer(indexer *IndexerCtx) *ConsolidationIndexer {
-	batchSize := utils.Config.ExecutionApi.LogBatchSize
+	batchSize := utils.Config().ExecutionApi.LogBatchSize
 	if batchSize == 0 {
 		batchSize = 1000
 	}
@@ -54,8 +54,9 @@ func NewConsolidationIndexer(indexer *IndexerCtx) *ConsolidationIndexer {
 		&contractIndexerOptions[dbtypes.ConsolidationRequestTx]{
 			stateKey:        "indexer.consolidationindexer",
 			batchSize:       batchSize,
+			concurrency:     utils.Config().ExecutionApi.LogConcurrency,
 			contractAddress: common.HexToAddress(ConsolidationContractAddr),
-			deployBlock:     uint64(utils.Config.ExecutionApi.ElectraDeployBlock),
+			deployBlock:     uint64(utils.Config().ExecutionApi.ElectraDeployBlock),
 			dequeueRate:     specs.MaxConsolidationRequestsPerPayload,
 
 			processFinalTx:  ci.processFinalTx,
@@ -70,7 +71,7 @@ func NewConsolidationIndexer(indexer *IndexerCtx) *ConsolidationIndexer {
 		indexer.logger.WithField("contract-matcher", "consolidations"),
 		&transactionMatcherOptions[consolidationRequestMatch]{
 			stateKey:    "indexer.consolidationmatcher",
-			deployBlock: uint64(utils.Config.ExecutionApi.ElectraDeployBlock),
+			deployBlock: uint64(utils.Config().ExecutionApi.ElectraDeployBlock),
 			timeLimit:   2 * time.Second,
 
 			matchBlockRange: ci.matchBlockRange,
@@ -122,6 +123,7 @@ func (ci *ConsolidationIndexer) processFinalTx(log *types.Log, tx *types.Transac
 	requestTx.TxSender = txFrom[:]
 	requestTx.TxTarget = txTo[:]
 	requestTx.DequeueBlock = dequeueBlock
+	requestTx.TxFee = ci.getRequestFee(tx)
 
 	return requestTx, nil
 }
@@ -140,6 +142,7 @@ func (ci *ConsolidationIndexer) processRecentTx(log *types.Log, tx *types.Transa
 	requestTx.TxSender = txFrom[:]
 	requestTx.TxTarget = txTo[:]
 	requestTx.DequeueBlock = dequeueBlock
+	requestTx.TxFee = ci.getRequestFee(tx)
 
 	clBlock := ci.indexerCtx.beaconIndexer.GetBlocksByExecutionBlockHash(phase0.Hash32(log.BlockHash))
 	if len(clBlock) > 0 {
@@ -151,6 +154,15 @@ func (ci *ConsolidationIndexer) processRecentTx(log *types.Log, tx *types.Transa
 	return requestTx, nil
 }
 
+// getRequestFee returns the fee paid for a consolidation request, nil if the request was not sent to the system contract directly
+func (ci *ConsolidationIndexer) getRequestFee(tx *types.Transaction) []byte {
+	if txTo := tx.To(); txTo == nil || *txTo != common.HexToAddress(ConsolidationContractAddr) {
+		return nil
+	}
+
+	return tx.Value().Bytes()
+}
+
 // parseRequestLog parses a consolidation request log and returns the corresponding consolidation request transaction
 func (ci *ConsolidationIndexer) parseRequestLog(log *types.Log, forkId *beacon.ForkKey) *dbtypes.ConsolidationRequestTx {
 	// data layout:
@@ -169,21 +181,13 @@ func (ci *ConsolidationIndexer) parseRequestLog(log *types.Log, forkId *beacon.F
 
 	// get the validator indices for the source and target pubkeys
 	var sourceIndex, targetIndex *uint64
-	for index, validator := range ci.indexerCtx.beaconIndexer.GetValidatorSet(forkId) {
-		if sourceIndex == nil && bytes.Equal(validator.PublicKey[:], sourcePubkey) {
-			index := uint64(index)
-			sourceIndex = &index
-			if targetIndex != nil {
-				break
-			}
-		}
-		if targetIndex == nil && bytes.Equal(validator.PublicKey[:], targetPubkey) {
-			index := uint64(index)
-			targetIndex = &index
-			if sourceIndex != nil {
-				break
-			}
-		}
+	if index, found := ci.indexerCtx.beaconIndexer.GetValidatorIndexByPubkey(phase0.BLSPubKey(sourcePubkey)); found && ci.indexerCtx.beaconIndexer.GetValidatorByIndex(index, forkId) != nil {
+		index := uint64(index)
+		sourceIndex = &index
+	}
+	if index, found := ci.indexerCtx.beaconIndexer.GetValidatorIndexByPubkey(phase0.BLSPubKey(targetPubkey)); found && ci.indexerCtx.beaconIndexer.GetValidatorByIndex(index, forkId) != nil {
+		index := uint64(index)
+		targetIndex = &index
 	}
 
 	requestTx := &dbtypes.ConsolidationRequestTx{
@@ -224,7 +228,7 @@ func (ds *ConsolidationIndexer) matchBlockRange(fromBlock uint64, toBlock uint64
 	requestMatches := []*consolidationRequestMatch{}
 
 	// get all consolidation request transactions that are dequeued in the block range
-	dequeueConsolidationTxs := db.GetConsolidationRequestTxsByDequeueRange(fromBlock, toBlock)
+	dequeueConsolidationTxs := db.GetConsolidationRequestTxsByDequeueRange(context.Background(), fromBlock, toBlock)
 	if len(dequeueConsolidationTxs) > 0 {
 		firstBlock := dequeueConsolidationTxs[0].DequeueBlock
 		lastBlock := dequeueConsolidationTxs[len(dequeueConsolidationTxs)-1].DequeueBlock
@@ -1,15 +1,17 @@
 package execution
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"math"
 	"math/big"
+	"runtime/debug"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/jmoiron/sqlx"
 	"github.com/sirupsen/logrus"
@@ -19,6 +21,14 @@ import (
 	"github.com/ethpandaops/dora/indexer/beacon"
 )
 
+const (
+	contractIndexerTxCacheSize        = 2000            // number of transactions kept in the tx cache
+	contractIndexerHeaderCacheSize    = 1000            // number of block headers kept in the header cache
+	contractIndexerFetchBatchSize     = 100             // max number of calls per json-rpc batch request
+	contractIndexerClientCooldown     = 1 * time.Second // min time between log requests to the same client
+	contractIndexerFullBlockThreshold = 5               // min number of uncached transactions in a block to load the full block instead
+)
+
 // contractIndexer handles the indexing of contract events for a specific system contract
 // it crawls logs in order and tracks the queue length to precalculate the dequeue block number where the request will be sent to the beacon chain
 type contractIndexer[TxType any] struct {
@@ -26,14 +36,24 @@ type contractIndexer[TxType any] struct {
 	logger  logrus.FieldLogger
 	options *contractIndexerOptions[TxType]
 	state   *contractIndexerState
+
+	// transactions & headers of processed logs, many logs share the same tx or block and recent blocks are crawled repeatedly
+	txCache     *lru.Cache[common.Hash, *types.Transaction]
+	headerCache *lru.Cache[common.Hash, *types.Header]
+
+	cooldownMutex   sync.Mutex
+	clientCooldowns map[*execution.Client]time.Time
 }
 
 // contractIndexerOptions defines the configuration for the contract indexer
 type contractIndexerOptions[TxType any] struct {
 	stateKey        string         // key to identify the indexer state in the database
 	batchSize       int            // number of logs to fetch per request
+	concurrency     int            // max number of parallel log requests for finalized blocks, 0 for one per ready client
 	contractAddress common.Address // address of the contract to index
+	topics          []common.Hash  // topic0 values to index, empty for all logs of the contract
 	deployBlock     uint64         // block number from where to start crawling logs
+	endBlock        uint64         // block number until which to crawl logs, 0 for no limit
 	dequeueRate     uint64         // number of logs to dequeue per block, 0 for no queue
 
 	// processFinalTx processes a finalized transaction log
@@ -44,6 +64,9 @@ type contractIndexerOptions[TxType any] struct {
 
 	// persistTxs persists processed transactions to the database
 	persistTxs func(tx *sqlx.Tx, txs []*TxType) error
+
+	// txsPersisted is called with the persisted transactions after the database transaction got committed (optional)
+	txsPersisted func(txs []*TxType)
 }
 
 // contractIndexerState represents the current state of the contract indexer
@@ -62,9 +85,13 @@ type contractIndexerForkState struct {
 // newContractIndexer creates a new contract indexer with the given options
 func newContractIndexer[TxType any](indexer *IndexerCtx, logger logrus.FieldLogger, options *contractIndexerOptions[TxType]) *contractIndexer[TxType] {
 	ci := &contractIndexer[TxType]{
-		indexer: indexer,
-		logger:  logger,
-		options: options,
+		indexer:     indexer,
+		logger:      logger,
+		options:     options,
+		txCache:     lru.NewCache[common.Hash, *types.Transaction](contractIndexerTxCacheSize),
+		headerCache: lru.NewCache[common.Hash, *types.Header](contractIndexerHeaderCacheSize),
+
+		clientCooldowns: map[*execution.Client]time.Time{},
 	}
 
 	return ci
@@ -73,7 +100,7 @@ func newContractIndexer[TxType any](indexer *IndexerCtx, logger logrus.FieldLogg
 // loadState loads the contract indexer state from the database
 func (ci *contractIndexer[_]) loadState() {
 	syncState := contractIndexerState{}
-	db.GetExplorerState(ci.options.stateKey, &syncState)
+	db.GetExplorerState(context.Background(), ci.options.stateKey, &syncState)
 	ci.state = &syncState
 
 	if ci.state.ForkStates == nil {
@@ -117,6 +144,10 @@ func (ci *contractIndexer[_]) runContractIndexer() error {
 			return fmt.Errorf("finalized block not found in cache or db")
 		}
 
+		if ci.options.endBlock > 0 && finalizedBlockNumber > ci.options.endBlock {
+			finalizedBlockNumber = ci.options.endBlock
+		}
+
 		if finalizedBlockNumber < ci.state.FinalBlock {
 			return fmt.Errorf("finalized block number (%v) smaller than index state (%v)", finalizedBlockNumber, ci.state.FinalBlock)
 		}
@@ -129,6 +160,11 @@ func (ci *contractIndexer[_]) runContractIndexer() error {
 		}
 	}
 
+	if ci.options.endBlock > 0 && ci.state.FinalBlock >= ci.options.endBlock {
+		// contract range fully indexed
+		return nil
+	}
+
 	ci.processRecentBlocks()
 
 	return nil
@@ -147,7 +183,7 @@ func (ci *contractIndexer[_]) getFinalizedBlockNumber() uint64 {
 
 	if finalizedBlockNumber == 0 {
 		// load from db
-		if finalizedBlock := db.GetSlotByRoot(finalizedRoot[:]); finalizedBlock != nil && finalizedBlock.EthBlockNumber != nil {
+		if finalizedBlock := db.GetSlotByRoot(context.Background(), finalizedRoot[:]); finalizedBlock != nil && finalizedBlock.EthBlockNumber != nil {
 			finalizedBlockNumber = *finalizedBlock.EthBlockNumber
 		}
 	}
@@ -163,186 +199,406 @@ func (ci *contractIndexer[_]) loadFilteredLogs(ctx context.Context, client *exec
 	return client.GetRPCClient().GetEthClient().FilterLogs(ctx, query)
 }
 
-// loadTransactionByHash fetches a transaction by its hash from the execution client
+// loadTransactionByHash returns a transaction from the tx cache or fetches it by its hash from the execution client
 func (ci *contractIndexer[_]) loadTransactionByHash(ctx context.Context, client *execution.Client, hash common.Hash) (*types.Transaction, error) {
+	if tx, found := ci.txCache.Get(hash); found {
+		return tx, nil
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	tx, _, err := client.GetRPCClient().GetEthClient().TransactionByHash(ctx, hash)
-	return tx, err
+	if err != nil {
+		return nil, err
+	}
+
+	ci.txCache.Add(hash, tx)
+	return tx, nil
 }
 
-// loadHeaderByHash fetches a block header by its hash from the execution client
+// loadHeaderByHash returns a block header from the header cache or fetches it by its hash from the execution client
 func (ci *contractIndexer[_]) loadHeaderByHash(ctx context.Context, client *execution.Client, hash common.Hash) (*types.Header, error) {
+	if header, found := ci.headerCache.Get(hash); found {
+		return header, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	header, err := client.GetRPCClient().GetHeaderByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	ci.headerCache.Add(hash, header)
+	return header, nil
+}
+
+// prefetchLogDetails loads the transactions & block headers of all given logs that are not cached yet.
+// blocks with many uncached transactions are loaded as full block, all other entries are loaded with json-rpc batch requests.
+// errors are not fatal, as missing entries are fetched one by one when processing the logs.
+func (ci *contractIndexer[_]) prefetchLogDetails(ctx context.Context, client *execution.Client, logs []types.Log) {
+	blockHashes := []common.Hash{}
+	blockTxHashes := map[common.Hash][]common.Hash{}
+	seenHashes := map[common.Hash]bool{}
+	for idx := range logs {
+		log := &logs[idx]
+		if !seenHashes[log.BlockHash] {
+			blockHashes = append(blockHashes, log.BlockHash)
+		}
+		seenHashes[log.BlockHash] = true
+
+		if !seenHashes[log.TxHash] && !ci.txCache.Contains(log.TxHash) {
+			blockTxHashes[log.BlockHash] = append(blockTxHashes[log.BlockHash], log.TxHash)
+		}
+		seenHashes[log.TxHash] = true
+	}
+
+	txHashes := []common.Hash{}
+	headerHashes := []common.Hash{}
+	for _, blockHash := range blockHashes {
+		missingTxs := blockTxHashes[blockHash]
+		if len(missingTxs) >= contractIndexerFullBlockThreshold && ci.prefetchFullBlock(ctx, client, blockHash, missingTxs) {
+			continue
+		}
+
+		txHashes = append(txHashes, missingTxs...)
+		if !ci.headerCache.Contains(blockHash) {
+			headerHashes = append(headerHashes, blockHash)
+		}
+	}
+
+	for start := 0; start < len(txHashes); start += contractIndexerFetchBatchSize {
+		end := start + contractIndexerFetchBatchSize
+		if end > len(txHashes) {
+			end = len(txHashes)
+		}
+
+		batchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		txs, err := client.GetRPCClient().GetTransactionsByHash(batchCtx, txHashes[start:end])
+		cancel()
+		if err != nil {
+			ci.logger.Debugf("error prefetching transactions: %v", err)
+			break
+		}
+
+		for idx, tx := range txs {
+			ci.txCache.Add(txHashes[start+idx], tx)
+		}
+	}
+
+	for start := 0; start < len(headerHashes); start += contractIndexerFetchBatchSize {
+		end := start + contractIndexerFetchBatchSize
+		if end > len(headerHashes) {
+			end = len(headerHashes)
+		}
+
+		batchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		headers, err := client.GetRPCClient().GetHeadersByHash(batchCtx, headerHashes[start:end])
+		cancel()
+		if err != nil {
+			ci.logger.Debugf("error prefetching block headers: %v", err)
+			break
+		}
+
+		for idx, header := range headers {
+			ci.headerCache.Add(headerHashes[start+idx], header)
+		}
+	}
+}
+
+// contractLogRange is a finalized block range whose logs are fetched by a crawler goroutine
+type contractLogRange struct {
+	fromBlock uint64
+	toBlock   uint64
+	logs      []types.Log
+	client    *execution.Client
+	err       error
+	done      chan struct{}
+}
+
+// prefetchFullBlock loads a full block with all transactions in a single call and caches its header & the given transactions
+func (ci *contractIndexer[_]) prefetchFullBlock(ctx context.Context, client *execution.Client, blockHash common.Hash, txHashes []common.Hash) bool {
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	return client.GetRPCClient().GetHeaderByHash(ctx, hash)
+	block, err := client.GetRPCClient().GetBlockByHash(ctx, blockHash)
+	if err != nil {
+		ci.logger.Debugf("error prefetching block %v: %v", blockHash, err)
+		return false
+	}
+
+	ci.headerCache.Add(blockHash, block.Header())
+
+	// only cache the requested transactions, so large blocks do not evict other entries
+	for _, txHash := range txHashes {
+		if tx := block.Transaction(txHash); tx != nil {
+			ci.txCache.Add(txHash, tx)
+		}
+	}
+
+	return true
 }
 
 // processFinalizedBlocks processes contract events from finalized block ranges
-// it fetches logs in batches and calls the provided processFinalTx function to process each log
+// it fetches the logs of multiple batches in parallel across all ready clients, but processes & persists the batches in order,
+// as the queue length is tracked across all logs. the provided processFinalTx function is called to process each log
 func (ci *contractIndexer[TxType]) processFinalizedBlocks(finalizedBlockNumber uint64) error {
 	clients := ci.indexer.getFinalizedClients(execution.AnyClient)
 	if len(clients) == 0 {
 		return fmt.Errorf("no ready execution client found")
 	}
 
+	concurrency := ci.options.concurrency
+	if concurrency <= 0 {
+		concurrency = len(clients)
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	retryCount := 0
+	pendingRanges := []*contractLogRange{}
+	nextBlock := ci.state.FinalBlock + 1
+	clientIdx := 0
 
 	// process blocks in range until the finalized block is reached
-	for ci.state.FinalBlock < finalizedBlockNumber {
-		client := clients[retryCount%len(clients)]
+	for {
+		// keep up to <concurrency> batches in flight
+		for len(pendingRanges) < concurrency && nextBlock <= finalizedBlockNumber {
+			toBlock := nextBlock + uint64(ci.options.batchSize) - 1
+			if toBlock > finalizedBlockNumber {
+				toBlock = finalizedBlockNumber
+			}
 
-		batchSize := uint64(ci.options.batchSize)
-		if retryCount > 0 {
-			// reduce batch size on retries to avoid response limit errors for block ranges with many logs
-			batchSize /= uint64(math.Pow(2, float64(retryCount)))
-			if batchSize < 10 {
-				batchSize = 10
+			logRange := &contractLogRange{
+				fromBlock: nextBlock,
+				toBlock:   toBlock,
+				done:      make(chan struct{}),
 			}
+			go ci.crawlLogRange(ctx, logRange, clients, clientIdx)
+
+			pendingRanges = append(pendingRanges, logRange)
+			nextBlock = toBlock + 1
+			clientIdx++
 		}
 
-		toBlock := ci.state.FinalBlock + uint64(ci.options.batchSize)
-		if toBlock > finalizedBlockNumber {
-			toBlock = finalizedBlockNumber
+		if len(pendingRanges) == 0 {
+			break
 		}
 
-		// fetch logs from the execution client
-		query := ethereum.FilterQuery{
-			FromBlock: big.NewInt(0).SetUint64(ci.state.FinalBlock + 1),
-			ToBlock:   big.NewInt(0).SetUint64(toBlock),
-			Addresses: []common.Address{
-				ci.options.contractAddress,
-			},
+		logRange := pendingRanges[0]
+		pendingRanges = pendingRanges[1:]
+
+		<-logRange.done
+		if logRange.err != nil {
+			return logRange.err
 		}
 
-		logs, err := ci.loadFilteredLogs(ctx, client, query)
+		ci.logger.Debugf("received contract logs for block %v - %v: %v events", logRange.fromBlock, logRange.toBlock, len(logRange.logs))
+
+		processed, err := ci.processFinalizedLogRange(ctx, logRange)
 		if err != nil {
-			if retryCount < 3 {
-				retryCount++
-				continue
-			}
+			return err
+		}
+		if !processed {
+			return nil
+		}
+	}
 
-			return fmt.Errorf("error fetching contract logs: %v", err)
+	return nil
+}
+
+// crawlLogRange fetches the logs of the given block range, retrying with the next client and smaller batches on errors
+func (ci *contractIndexer[_]) crawlLogRange(ctx context.Context, logRange *contractLogRange, clients []*execution.Client, clientIdx int) {
+	defer func() {
+		if err := recover(); err != nil {
+			ci.logger.Errorf("uncaught panic in contractIndexer.crawlLogRange subroutine: %v, stack: %v", err, string(debug.Stack()))
+			logRange.err = fmt.Errorf("panic while fetching contract logs: %v", err)
 		}
+		close(logRange.done)
+	}()
 
-		ci.logger.Debugf("received contract logs for block %v - %v: %v events", ci.state.FinalBlock, toBlock, len(logs))
+	for retryCount := 0; ; retryCount++ {
+		client := clients[(clientIdx+retryCount)%len(clients)]
 
-		retryCount = 0
+		batchSize := uint64(ci.options.batchSize)
+		if retryCount > 0 {
+			// reduce batch size on retries to avoid response limit errors for block ranges with many logs
+			batchSize /= uint64(math.Pow(2, float64(retryCount)))
+			if batchSize < 10 {
+				batchSize = 10
+			}
+		}
 
-		// parse logs and load tx/block details
-		var txHash, txHeaderHash []byte
-		var txDetails *types.Transaction
-		var txBlockHeader *types.Header
+		logs := []types.Log{}
+		var err error
+		for fromBlock := logRange.fromBlock; fromBlock <= logRange.toBlock; fromBlock += batchSize {
+			toBlock := fromBlock + batchSize - 1
+			if toBlock > logRange.toBlock {
+				toBlock = logRange.toBlock
+			}
 
-		requestTxs := []*TxType{}
-		queueBlock := ci.state.FinalBlock
-		queueLength := ci.state.FinalQueueLen
+			var batchLogs []types.Log
+			batchLogs, err = ci.loadLogRange(ctx, client, fromBlock, toBlock)
+			if err != nil {
+				break
+			}
 
-		// we start crawling from the next block, so we need to decrease the queue length for the current block
-		if queueLength > ci.options.dequeueRate {
-			queueLength -= ci.options.dequeueRate
-		} else {
-			queueLength = 0
+			logs = append(logs, batchLogs...)
 		}
 
-		for idx := range logs {
-			log := &logs[idx]
+		if err == nil {
+			logRange.logs = logs
+			logRange.client = client
+			return
+		}
 
-			// load transaction if not already loaded
-			if txHash == nil || !bytes.Equal(txHash, log.TxHash[:]) {
-				txDetails, err = ci.loadTransactionByHash(ctx, client, log.TxHash)
-				if err != nil {
-					return fmt.Errorf("could not load tx details (%v): %v", log.TxHash, err)
-				}
+		if retryCount >= 3 || ctx.Err() != nil {
+			logRange.err = fmt.Errorf("error fetching contract logs: %v", err)
+			return
+		}
+	}
+}
 
-				txHash = log.TxHash[:]
-			}
+// loadLogRange fetches the contract logs of the given block range, respecting the request cooldown of the client
+func (ci *contractIndexer[_]) loadLogRange(ctx context.Context, client *execution.Client, fromBlock, toBlock uint64) ([]types.Log, error) {
+	if err := ci.waitClientCooldown(ctx, client); err != nil {
+		return nil, err
+	}
 
-			// load block header if not already loaded
-			if txBlockHeader == nil || !bytes.Equal(txHeaderHash, log.BlockHash[:]) {
-				txBlockHeader, err = ci.loadHeaderByHash(ctx, client, log.BlockHash)
-				if err != nil {
-					return fmt.Errorf("could not load block details (%v): %v", log.BlockHash, err)
-				}
+	query := ethereum.FilterQuery{
+		FromBlock: big.NewInt(0).SetUint64(fromBlock),
+		ToBlock:   big.NewInt(0).SetUint64(toBlock),
+		Addresses: []common.Address{
+			ci.options.contractAddress,
+		},
+	}
+	if len(ci.options.topics) > 0 {
+		query.Topics = [][]common.Hash{ci.options.topics}
+	}
 
-				txHeaderHash = log.BlockHash[:]
-			}
+	return ci.loadFilteredLogs(ctx, client, query)
+}
 
-			// get transaction sender
-			txFrom, err := types.Sender(types.LatestSignerForChainID(txDetails.ChainId()), txDetails)
-			if err != nil {
-				return fmt.Errorf("could not decode tx sender (%v): %v", log.TxHash, err)
-			}
+// waitClientCooldown spaces the log requests sent to the same client to avoid rate limiting from external archive nodes
+func (ci *contractIndexer[_]) waitClientCooldown(ctx context.Context, client *execution.Client) error {
+	ci.cooldownMutex.Lock()
+	now := time.Now()
+	nextRequest := ci.clientCooldowns[client]
+	if nextRequest.Before(now) {
+		nextRequest = now
+	}
+	ci.clientCooldowns[client] = nextRequest.Add(contractIndexerClientCooldown)
+	ci.cooldownMutex.Unlock()
+
+	select {
+	case <-time.After(time.Until(nextRequest)):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
 
-			// process queue decrease for past blocks
-			if queueBlock > log.BlockNumber {
-				ci.logger.Warnf("contract log for block %v received after block %v", log.BlockNumber, queueBlock)
-				return nil
-			} else if ci.options.dequeueRate > 0 && queueBlock < log.BlockNumber {
-				// calculate how many requests were dequeued since the last processed log
-				dequeuedRequests := (log.BlockNumber - queueBlock) * ci.options.dequeueRate
-				if dequeuedRequests > queueLength {
-					queueLength = 0
-				} else {
-					queueLength -= dequeuedRequests
-				}
+// processFinalizedLogRange processes the logs of a crawled block range and persists the resulting transactions & indexer state
+// returns false if the range could not be processed and indexing should be stopped for now
+func (ci *contractIndexer[TxType]) processFinalizedLogRange(ctx context.Context, logRange *contractLogRange) (bool, error) {
+	logs := logRange.logs
 
-				queueBlock = log.BlockNumber
-			}
+	// parse logs and load tx/block details
+	ci.prefetchLogDetails(ctx, logRange.client, logs)
 
-			// calculate the dequeue block number for the current log
-			var dequeueBlock uint64
-			if ci.options.dequeueRate > 0 {
-				dequeueBlock = log.BlockNumber + (queueLength / ci.options.dequeueRate)
-				queueLength++
-			} else {
-				dequeueBlock = log.BlockNumber
-			}
+	requestTxs := []*TxType{}
+	queueBlock := ci.state.FinalBlock
+	queueLength := ci.state.FinalQueueLen
 
-			// process the log and get the corresponding transaction
-			requestTx, err := ci.options.processFinalTx(log, txDetails, txBlockHeader, txFrom, dequeueBlock)
-			if err != nil {
-				continue
-			}
+	// we start crawling from the next block, so we need to decrease the queue length for the current block
+	if queueLength > ci.options.dequeueRate {
+		queueLength -= ci.options.dequeueRate
+	} else {
+		queueLength = 0
+	}
 
-			if requestTx == nil {
-				continue
-			}
+	for idx := range logs {
+		log := &logs[idx]
 
-			requestTxs = append(requestTxs, requestTx)
+		txDetails, err := ci.loadTransactionByHash(ctx, logRange.client, log.TxHash)
+		if err != nil {
+			return false, fmt.Errorf("could not load tx details (%v): %v", log.TxHash, err)
+		}
+
+		txBlockHeader, err := ci.loadHeaderByHash(ctx, logRange.client, log.BlockHash)
+		if err != nil {
+			return false, fmt.Errorf("could not load block details (%v): %v", log.BlockHash, err)
+		}
+
+		// get transaction sender
+		txFrom, err := types.Sender(types.LatestSignerForChainID(txDetails.ChainId()), txDetails)
+		if err != nil {
+			return false, fmt.Errorf("could not decode tx sender (%v): %v", log.TxHash, err)
 		}
 
-		// calculate how many requests were dequeued at the end of the current block range
-		if ci.options.dequeueRate > 0 && queueBlock < toBlock {
-			dequeuedRequests := (toBlock - queueBlock) * ci.options.dequeueRate
+		// process queue decrease for past blocks
+		if queueBlock > log.BlockNumber {
+			ci.logger.Warnf("contract log for block %v received after block %v", log.BlockNumber, queueBlock)
+			return false, nil
+		} else if ci.options.dequeueRate > 0 && queueBlock < log.BlockNumber {
+			// calculate how many requests were dequeued since the last processed log
+			dequeuedRequests := (log.BlockNumber - queueBlock) * ci.options.dequeueRate
 			if dequeuedRequests > queueLength {
 				queueLength = 0
 			} else {
 				queueLength -= dequeuedRequests
 			}
 
-			queueBlock = toBlock
+			queueBlock = log.BlockNumber
 		}
 
-		if len(requestTxs) > 0 {
-			ci.logger.Infof("crawled transactions for block %v - %v: %v events", ci.state.FinalBlock, toBlock, len(requestTxs))
+		// calculate the dequeue block number for the current log
+		var dequeueBlock uint64
+		if ci.options.dequeueRate > 0 {
+			dequeueBlock = log.BlockNumber + (queueLength / ci.options.dequeueRate)
+			queueLength++
+		} else {
+			dequeueBlock = log.BlockNumber
 		}
 
-		// persist the processed transactions and update the indexer state
-		err = ci.persistFinalizedRequestTxs(toBlock, queueLength, requestTxs)
+		// process the log and get the corresponding transaction
+		requestTx, err := ci.options.processFinalTx(log, txDetails, txBlockHeader, txFrom, dequeueBlock)
 		if err != nil {
-			return fmt.Errorf("could not persist indexed transactions: %v", err)
+			continue
+		}
+
+		if requestTx == nil {
+			continue
 		}
 
-		// cooldown to avoid rate limiting from external archive nodes
-		time.Sleep(1 * time.Second)
+		requestTxs = append(requestTxs, requestTx)
 	}
-	return nil
+
+	// calculate how many requests were dequeued at the end of the current block range
+	if ci.options.dequeueRate > 0 && queueBlock < logRange.toBlock {
+		dequeuedRequests := (logRange.toBlock - queueBlock) * ci.options.dequeueRate
+		if dequeuedRequests > queueLength {
+			queueLength = 0
+		} else {
+			queueLength -= dequeuedRequests
+		}
+
+		queueBlock = logRange.toBlock
+	}
+
+	if len(requestTxs) > 0 {
+		ci.logger.Infof("crawled transactions for block %v - %v: %v events", logRange.fromBlock, logRange.toBlock, len(requestTxs))
+	}
+
+	// persist the processed transactions and update the indexer state
+	err := ci.persistFinalizedRequestTxs(logRange.toBlock, queueLength, requestTxs)
+	if err != nil {
+		return false, fmt.Errorf("could not persist indexed transactions: %v", err)
+	}
+
+	return true, nil
 }
 
 // processRecentBlocks processes contract events from recent (non-finalized) blocks across all forks
@@ -378,6 +634,9 @@ func (ci *contractIndexer[TxType]) processRecentBlocksForFork(headFork *forkWith
 	if elHeadBlockNumber > 0 {
 		elHeadBlockNumber--
 	}
+	if ci.options.endBlock > 0 && elHeadBlockNumber > ci.options.endBlock {
+		elHeadBlockNumber = ci.options.endBlock
+	}
 
 	startBlockNumber := ci.state.FinalBlock + 1
 	queueLength := ci.state.FinalQueueLen
@@ -421,9 +680,6 @@ func (ci *contractIndexer[TxType]) processRecentBlocksForFork(headFork *forkWith
 		var toBlock uint64
 		var logs []types.Log
 		var reqError error
-		var txHash, txHeaderHash []byte
-		var txDetails *types.Transaction
-		var txBlockHeader *types.Header
 
 		requestTxs := []*TxType{}
 
@@ -458,6 +714,9 @@ func (ci *contractIndexer[TxType]) processRecentBlocksForFork(headFork *forkWith
 					ci.options.contractAddress,
 				},
 			}
+			if len(ci.options.topics) > 0 {
+				query.Topics = [][]common.Hash{ci.options.topics}
+			}
 
 			logs, reqError = ci.loadFilteredLogs(ctx, client, query)
 			if reqError != nil {
@@ -465,29 +724,19 @@ func (ci *contractIndexer[TxType]) processRecentBlocksForFork(headFork *forkWith
 				continue
 			}
 
-			for idx := range logs {
-				var err error
+			ci.prefetchLogDetails(ctx, client, logs)
 
+			for idx := range logs {
 				log := &logs[idx]
 
-				// load transaction if not already loaded
-				if txHash == nil || !bytes.Equal(txHash, log.TxHash[:]) {
-					txDetails, err = ci.loadTransactionByHash(ctx, client, log.TxHash)
-					if err != nil {
-						return fmt.Errorf("could not load tx details (%v): %v", log.TxHash, err)
-					}
-
-					txHash = log.TxHash[:]
+				txDetails, err := ci.loadTransactionByHash(ctx, client, log.TxHash)
+				if err != nil {
+					return fmt.Errorf("could not load tx details (%v): %v", log.TxHash, err)
 				}
 
-				// load block header if not already loaded
-				if txBlockHeader == nil || !bytes.Equal(txHeaderHash, log.BlockHash[:]) {
-					txBlockHeader, err = ci.loadHeaderByHash(ctx, client, log.BlockHash)
-					if err != nil {
-						return fmt.Errorf("could not load block details (%v): %v", log.BlockHash, err)
-					}
-
-					txHeaderHash = log.BlockHash[:]
+				txBlockHeader, err := ci.loadHeaderByHash(ctx, client, log.BlockHash)
+				if err != nil {
+					return fmt.Errorf("could not load block details (%v): %v", log.BlockHash, err)
 				}
 
 				// get transaction sender
@@ -573,7 +822,7 @@ func (ci *contractIndexer[TxType]) processRecentBlocksForFork(headFork *forkWith
 
 // persistFinalizedRequestTxs persists processed finalized transactions and the indexer state to the database
 func (ci *contractIndexer[TxType]) persistFinalizedRequestTxs(finalBlockNumber, finalQueueLen uint64, requests []*TxType) error {
-	return db.RunDBTransaction(func(tx *sqlx.Tx) error {
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
 		if len(requests) > 0 {
 			err := ci.options.persistTxs(tx, requests)
 			if err != nil {
@@ -586,11 +835,17 @@ func (ci *contractIndexer[TxType]) persistFinalizedRequestTxs(finalBlockNumber,
 
 		return ci.persistState(tx)
 	})
+	if err != nil {
+		return err
+	}
+
+	ci.notifyPersistedTxs(requests)
+	return nil
 }
 
 // persistRecentRequestTxs persists processed recent transactions and the indexer state to the database
 func (ci *contractIndexer[TxType]) persistRecentRequestTxs(forkId beacon.ForkKey, finalBlockNumber, finalQueueLen uint64, requests []*TxType) error {
-	return db.RunDBTransaction(func(tx *sqlx.Tx) error {
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
 		if len(requests) > 0 {
 			err := ci.options.persistTxs(tx, requests)
 			if err != nil {
@@ -605,4 +860,17 @@ func (ci *contractIndexer[TxType]) persistRecentRequestTxs(forkId beacon.ForkKey
 
 		return ci.persistState(tx)
 	})
+	if err != nil {
+		return err
+	}
+
+	ci.notifyPersistedTxs(requests)
+	return nil
+}
+
+// notifyPersistedTxs passes committed transactions to the txsPersisted callback
+func (ci *contractIndexer[TxType]) notifyPersistedTxs(requests []*TxType) {
+	if ci.options.txsPersisted != nil && len(requests) > 0 {
+		ci.options.txsPersisted(requests)
+	}
 }
@@ -21,6 +21,7 @@ import (
 
 	"github.com/ethpandaops/dora/db"
 	"github.com/ethpandaops/dora/dbtypes"
+	dora_types "github.com/ethpandaops/dora/types"
 	"github.com/ethpandaops/dora/utils"
 )
 
@@ -30,16 +31,18 @@ const depositContractAbi = `[{"inputs":[],"stateMutability":"nonpayable","type":
 type DepositIndexer struct {
 	indexerCtx *IndexerCtx
 	logger     logrus.FieldLogger
-	indexer    *contractIndexer[dbtypes.DepositTx]
+	indexers   []*contractIndexer[dbtypes.DepositTx]
 
 	depositContractAbi *abi.ABI
 	depositEventTopic  []byte
 	depositSigDomain   zrnt_common.BLSDomain
+	specsContract      common.Address
+	reconcilers        []*depositReconciler
 }
 
 // NewDepositIndexer creates a new deposit contract indexer
 func NewDepositIndexer(indexer *IndexerCtx) *DepositIndexer {
-	batchSize := utils.Config.ExecutionApi.LogBatchSize
+	batchSize := utils.Config().ExecutionApi.LogBatchSize
 	if batchSize == 0 {
 		batchSize = 1000
 	}
@@ -63,22 +66,61 @@ func NewDepositIndexer(indexer *IndexerCtx) *DepositIndexer {
 		depositSigDomain:   depositSigDomain,
 	}
 
-	// create contract indexer for the deposit contract
-	ds.indexer = newContractIndexer(
-		indexer,
-		ds.logger.WithField("routine", "crawler"),
-		&contractIndexerOptions[dbtypes.DepositTx]{
-			stateKey:        "indexer.depositstate",
-			batchSize:       batchSize,
-			contractAddress: common.Address(specs.DepositContractAddress),
-			deployBlock:     uint64(utils.Config.ExecutionApi.DepositDeployBlock),
-			dequeueRate:     0,
-
-			processFinalTx:  ds.processFinalTx,
-			processRecentTx: ds.processRecentTx,
-			persistTxs:      ds.persistDepositTxs,
+	// create contract indexers for the deposit contract from the chain specs and all additionally configured contracts
+	specsContract := common.Address(specs.DepositContractAddress)
+	ds.specsContract = specsContract
+	depositContracts := []*dora_types.DepositContractConfig{
+		{
+			Address:   specsContract.String(),
+			FromBlock: uint64(utils.Config().ExecutionApi.DepositDeployBlock),
 		},
-	)
+	}
+	for idx := range utils.Config().ExecutionApi.DepositContracts {
+		contractConfig := &utils.Config().ExecutionApi.DepositContracts[idx]
+		if !common.IsHexAddress(contractConfig.Address) {
+			ds.logger.Errorf("invalid deposit contract address: %v", contractConfig.Address)
+			continue
+		}
+
+		if common.HexToAddress(contractConfig.Address) == specsContract {
+			// override block range of the specs contract
+			depositContracts[0] = contractConfig
+			continue
+		}
+
+		depositContracts = append(depositContracts, contractConfig)
+	}
+
+	for _, contractConfig := range depositContracts {
+		contractAddress := common.HexToAddress(contractConfig.Address)
+
+		// keep the original state key for the specs contract, so existing index states are reused
+		stateKey := "indexer.depositstate"
+		if contractAddress != specsContract {
+			stateKey = fmt.Sprintf("indexer.depositstate.%v", strings.ToLower(contractAddress.Hex()))
+		}
+
+		contractIndexer := newContractIndexer(
+			indexer,
+			ds.logger.WithField("routine", "crawler").WithField("contract", contractAddress.Hex()),
+			&contractIndexerOptions[dbtypes.DepositTx]{
+				stateKey:        stateKey,
+				batchSize:       batchSize,
+				concurrency:     utils.Config().ExecutionApi.LogConcurrency,
+				contractAddress: contractAddress,
+				deployBlock:     contractConfig.FromBlock,
+				endBlock:        contractConfig.ToBlock,
+				dequeueRate:     0,
+
+				processFinalTx:  ds.processFinalTx,
+				processRecentTx: ds.processRecentTx,
+				persistTxs:      ds.persistDepositTxs,
+				txsPersisted:    ds.logDepositEvents,
+			},
+		)
+		ds.indexers = append(ds.indexers, contractIndexer)
+		ds.reconcilers = append(ds.reconcilers, newDepositReconciler(ds, contractIndexer))
+	}
 
 	go ds.runDepositIndexerLoop()
 
@@ -93,13 +135,76 @@ func (ds *DepositIndexer) runDepositIndexerLoop() {
 		time.Sleep(60 * time.Second)
 		ds.logger.Debugf("run deposit indexer logic")
 
-		err := ds.indexer.runContractIndexer()
-		if err != nil {
-			ds.logger.Errorf("deposit indexer error: %v", err)
+		for _, contractIndexer := range ds.indexers {
+			err := contractIndexer.runContractIndexer()
+			if err != nil {
+				ds.logger.Errorf("deposit indexer error (%v): %v", contractIndexer.options.contractAddress.Hex(), err)
+			}
+		}
+
+		for _, reconciler := range ds.reconcilers {
+			err := reconciler.reconcile()
+			if err != nil {
+				ds.logger.Warnf("deposit root reconciliation failed (%v): %v", reconciler.contractIndexer.options.contractAddress.Hex(), err)
+			}
 		}
 	}
 }
 
+// DepositIndexerProgress is the indexing progress of a deposit contract
+type DepositIndexerProgress struct {
+	Contract   common.Address
+	FinalBlock uint64 // last finalized el block the contract logs have been indexed for
+	HeadBlock  uint64 // el block number of the canonical head
+}
+
+// GetIndexerProgress returns the indexing progress of all indexed deposit contracts.
+func (ds *DepositIndexer) GetIndexerProgress() []*DepositIndexerProgress {
+	var headBlock uint64
+	if canonicalHead := ds.indexerCtx.beaconIndexer.GetCanonicalHead(nil); canonicalHead != nil {
+		if blockIndex := canonicalHead.GetBlockIndex(); blockIndex != nil {
+			headBlock = blockIndex.ExecutionNumber
+		}
+	}
+
+	progress := make([]*DepositIndexerProgress, 0, len(ds.indexers))
+	for _, indexer := range ds.indexers {
+		contractProgress := &DepositIndexerProgress{
+			Contract:  indexer.options.contractAddress,
+			HeadBlock: headBlock,
+		}
+		if indexer.state != nil {
+			contractProgress.FinalBlock = indexer.state.FinalBlock
+		}
+		progress = append(progress, contractProgress)
+	}
+	return progress
+}
+
+// GetDepositRootState returns the result of the latest deposit root reconciliation against the given deposit contract.
+// returns nil if the contract is not indexed or the deposit root was not reconciled yet.
+func (ds *DepositIndexer) GetDepositRootState(contract common.Address) *DepositRootState {
+	for _, reconciler := range ds.reconcilers {
+		if reconciler.contractIndexer.options.contractAddress == contract {
+			return reconciler.getState()
+		}
+	}
+	return nil
+}
+
+// GetSpecsContract returns the address of the deposit contract from the chain specs.
+func (ds *DepositIndexer) GetSpecsContract() common.Address {
+	return ds.specsContract
+}
+
+// getContractArg returns the contract address the deposit txs of the given contract are stored with in the db.
+func (ds *DepositIndexer) getContractArg(contract common.Address) []byte {
+	if contract == ds.specsContract {
+		return nil
+	}
+	return contract[:]
+}
+
 // processFinalTx is the callback for the contract indexer to process final transactions
 // it parses the transaction and returns the corresponding deposit transaction
 func (ci *DepositIndexer) processFinalTx(log *types.Log, tx *types.Transaction, header *types.Header, txFrom common.Address, dequeueBlock uint64) (*dbtypes.DepositTx, error) {
@@ -162,6 +267,7 @@ func (ci *DepositIndexer) parseDepositLog(log *types.Log) *dbtypes.DepositTx {
 		Amount:                binary.LittleEndian.Uint64(event[2].([]byte)),
 		Signature:             event[3].([]byte),
 		TxHash:                log.TxHash[:],
+		ContractAddress:       ci.getContractArg(log.Address),
 	}
 	ci.checkDepositValidity(requestTx)
 
@@ -186,6 +292,32 @@ func (ci *DepositIndexer) persistDepositTxs(tx *sqlx.Tx, requests []*dbtypes.Dep
 	return nil
 }
 
+// logDepositEvents adds a deposit event for each persisted deposit transaction to the indexer event log
+func (ds *DepositIndexer) logDepositEvents(depositTxs []*dbtypes.DepositTx) {
+	events := make([]*dbtypes.IndexerEvent, 0, len(depositTxs))
+	for _, depositTx := range depositTxs {
+		eventKey := fmt.Sprintf("deposit:%x:%v:%x", depositTx.TxHash, depositTx.Index, depositTx.BlockRoot)
+		event, err := db.NewIndexerEvent(dbtypes.IndexerEventDepositSeen, eventKey, &dbtypes.IndexerEventDepositSeenData{
+			Index:       depositTx.Index,
+			BlockNumber: depositTx.BlockNumber,
+			BlockHash:   fmt.Sprintf("0x%x", depositTx.BlockRoot),
+			TxHash:      fmt.Sprintf("0x%x", depositTx.TxHash),
+			PublicKey:   fmt.Sprintf("0x%x", depositTx.PublicKey),
+			Amount:      depositTx.Amount,
+			Valid:       depositTx.ValidSignature,
+			Orphaned:    depositTx.Orphaned,
+		})
+		if err != nil {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	if err := db.AppendIndexerEvents(events); err != nil {
+		ds.logger.Warnf("failed logging deposit events: %v", err)
+	}
+}
+
 // checkDepositValidity checks if a deposit transaction has a valid signature
 func (ds *DepositIndexer) checkDepositValidity(depositTx *dbtypes.DepositTx) {
 	depositMsg := &zrnt_common.DepositMessage{
@@ -0,0 +1,264 @@
+package execution
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	zrnt_common "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/ztyp/tree"
+
+	"github.com/ethpandaops/dora/clients/execution"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// depositTreeDepth is the depth of the deposit contract merkle tree.
+const depositTreeDepth = 32
+
+// depositReconcileBatchSize is the number of deposit txs loaded from the db per query while rebuilding the tree.
+const depositReconcileBatchSize = 10000
+
+// DepositRootState holds the result of the latest deposit root reconciliation against the deposit contract.
+type DepositRootState struct {
+	CheckTime     time.Time
+	BlockNumber   uint64
+	ContractCount uint64
+	ContractRoot  common.Hash
+	LocalCount    uint64
+	LocalRoot     common.Hash
+	MissingIndex  *uint64 // first deposit index missing in the db, if any
+	Match         bool
+}
+
+// depositTree is the incremental merkle tree of the deposit contract, rebuilt from the indexed deposit txs.
+type depositTree struct {
+	branch [depositTreeDepth][32]byte
+	count  uint64
+}
+
+// depositReconciler periodically verifies the indexed deposit txs against the deposit root of the deposit contract.
+type depositReconciler struct {
+	depositIndexer  *DepositIndexer
+	contractIndexer *contractIndexer[dbtypes.DepositTx]
+	tree            *depositTree
+	treeBlock       uint64
+	lastBlock       uint64
+
+	stateMutex sync.RWMutex
+	state      *DepositRootState
+}
+
+var depositZeroHashes = func() [depositTreeDepth][32]byte {
+	zeroHashes := [depositTreeDepth][32]byte{}
+	for height := 1; height < depositTreeDepth; height++ {
+		zeroHashes[height] = sha256.Sum256(append(zeroHashes[height-1][:], zeroHashes[height-1][:]...))
+	}
+	return zeroHashes
+}()
+
+// push adds a deposit leaf to the tree, following the deposit contract's deposit() logic.
+func (dt *depositTree) push(leaf [32]byte) {
+	dt.count++
+	size := dt.count
+	node := leaf
+	for height := 0; height < depositTreeDepth; height++ {
+		if size&1 == 1 {
+			dt.branch[height] = node
+			return
+		}
+		node = sha256.Sum256(append(dt.branch[height][:], node[:]...))
+		size /= 2
+	}
+}
+
+// root returns the deposit root, following the deposit contract's get_deposit_root() logic.
+func (dt *depositTree) root() [32]byte {
+	node := [32]byte{}
+	size := dt.count
+	for height := 0; height < depositTreeDepth; height++ {
+		if size&1 == 1 {
+			node = sha256.Sum256(append(dt.branch[height][:], node[:]...))
+		} else {
+			node = sha256.Sum256(append(node[:], depositZeroHashes[height][:]...))
+		}
+		size /= 2
+	}
+
+	countBytes := make([]byte, 32)
+	binary.LittleEndian.PutUint64(countBytes, dt.count)
+	return sha256.Sum256(append(node[:], countBytes...))
+}
+
+func newDepositReconciler(depositIndexer *DepositIndexer, contractIndexer *contractIndexer[dbtypes.DepositTx]) *depositReconciler {
+	return &depositReconciler{
+		depositIndexer:  depositIndexer,
+		contractIndexer: contractIndexer,
+	}
+}
+
+// getState returns the result of the latest reconciliation, or nil if no reconciliation was done yet.
+func (dr *depositReconciler) getState() *DepositRootState {
+	dr.stateMutex.RLock()
+	defer dr.stateMutex.RUnlock()
+	return dr.state
+}
+
+// reconcile compares the deposit count & root of the deposit contract at the last finalized block processed by the contract
+// indexer with the deposit tree rebuilt from the indexed deposit txs.
+func (dr *depositReconciler) reconcile() error {
+	contractIndexer := dr.contractIndexer
+	if contractIndexer.state == nil || contractIndexer.state.FinalBlock <= contractIndexer.options.deployBlock {
+		return nil
+	}
+
+	blockNumber := contractIndexer.state.FinalBlock
+	if blockNumber == dr.lastBlock {
+		return nil
+	}
+
+	clients := dr.depositIndexer.indexerCtx.getFinalizedClients(execution.AnyClient)
+	if len(clients) == 0 {
+		return fmt.Errorf("no ready execution client found")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var contractCount uint64
+	var contractRoot common.Hash
+	var err error
+	for _, client := range clients {
+		contractCount, contractRoot, err = dr.loadContractDepositRoot(ctx, client, contractIndexer.options.contractAddress, blockNumber)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed loading deposit root from contract: %v", err)
+	}
+
+	state := &DepositRootState{
+		CheckTime:     time.Now(),
+		BlockNumber:   blockNumber,
+		ContractCount: contractCount,
+		ContractRoot:  contractRoot,
+	}
+
+	if dr.tree == nil || dr.tree.count > contractCount || dr.treeBlock > blockNumber {
+		dr.tree = &depositTree{}
+	}
+
+	// add all new deposits to the tree
+	contractArg := dr.depositIndexer.getContractArg(contractIndexer.options.contractAddress)
+	for dr.tree.count < contractCount {
+		depositTxs := db.GetCanonicalDepositTxs(contractArg, dr.tree.count, blockNumber, depositReconcileBatchSize)
+		if len(depositTxs) == 0 {
+			break
+		}
+
+		gap := false
+		for _, depositTx := range depositTxs {
+			if dr.tree.count >= contractCount {
+				break
+			}
+			if depositTx.Index < dr.tree.count {
+				continue // duplicate entry for an already processed index
+			}
+			if depositTx.Index > dr.tree.count {
+				gap = true
+				break
+			}
+
+			depositData := &zrnt_common.DepositData{
+				Pubkey:                zrnt_common.BLSPubkey(depositTx.PublicKey),
+				WithdrawalCredentials: tree.Root(depositTx.WithdrawalCredentials),
+				Amount:                zrnt_common.Gwei(depositTx.Amount),
+				Signature:             zrnt_common.BLSSignature(depositTx.Signature),
+			}
+			dr.tree.push(depositData.HashTreeRoot(tree.GetHashFn()))
+		}
+
+		if gap || len(depositTxs) < depositReconcileBatchSize {
+			break
+		}
+	}
+	dr.treeBlock = blockNumber
+
+	state.LocalCount = dr.tree.count
+	state.LocalRoot = dr.tree.root()
+	state.Match = state.LocalCount == state.ContractCount && state.LocalRoot == state.ContractRoot
+
+	logger := dr.depositIndexer.logger.WithField("routine", "reconciler").WithField("contract", contractIndexer.options.contractAddress.Hex())
+	switch {
+	case state.Match:
+		logger.Debugf("deposit root verified at block %v: %v deposits, root 0x%x", blockNumber, state.LocalCount, state.LocalRoot)
+	case state.LocalCount < state.ContractCount:
+		missingIndex := state.LocalCount
+		state.MissingIndex = &missingIndex
+		logger.Errorf("deposit root mismatch at block %v: deposit %v is missing in the db (contract count: %v)", blockNumber, missingIndex, state.ContractCount)
+	default:
+		logger.Errorf("deposit root mismatch at block %v: contract root 0x%x, local root 0x%x (%v deposits)", blockNumber, state.ContractRoot, state.LocalRoot, state.LocalCount)
+	}
+
+	if !state.Match {
+		// rebuild the tree from scratch on the next run, the indexed deposits might get corrected in the meantime
+		dr.tree = nil
+	}
+
+	dr.lastBlock = blockNumber
+	dr.stateMutex.Lock()
+	dr.state = state
+	dr.stateMutex.Unlock()
+
+	return nil
+}
+
+// loadContractDepositRoot calls get_deposit_count & get_deposit_root on the deposit contract at the given block.
+func (dr *depositReconciler) loadContractDepositRoot(ctx context.Context, client *execution.Client, contractAddress common.Address, blockNumber uint64) (uint64, common.Hash, error) {
+	contractAbi := dr.depositIndexer.depositContractAbi
+	ethClient := client.GetRPCClient().GetEthClient()
+	callBlock := big.NewInt(0).SetUint64(blockNumber)
+
+	callData, err := contractAbi.Pack("get_deposit_count")
+	if err != nil {
+		return 0, common.Hash{}, err
+	}
+	result, err := ethClient.CallContract(ctx, ethereum.CallMsg{To: &contractAddress, Data: callData}, callBlock)
+	if err != nil {
+		return 0, common.Hash{}, fmt.Errorf("get_deposit_count call failed: %v", err)
+	}
+	countRes, err := contractAbi.Unpack("get_deposit_count", result)
+	if err != nil || len(countRes) != 1 {
+		return 0, common.Hash{}, fmt.Errorf("invalid get_deposit_count result: %v", err)
+	}
+	countBytes, ok := countRes[0].([]byte)
+	if !ok || len(countBytes) != 8 {
+		return 0, common.Hash{}, fmt.Errorf("invalid get_deposit_count result")
+	}
+
+	callData, err = contractAbi.Pack("get_deposit_root")
+	if err != nil {
+		return 0, common.Hash{}, err
+	}
+	result, err = ethClient.CallContract(ctx, ethereum.CallMsg{To: &contractAddress, Data: callData}, callBlock)
+	if err != nil {
+		return 0, common.Hash{}, fmt.Errorf("get_deposit_root call failed: %v", err)
+	}
+	rootRes, err := contractAbi.Unpack("get_deposit_root", result)
+	if err != nil || len(rootRes) != 1 {
+		return 0, common.Hash{}, fmt.Errorf("invalid get_deposit_root result: %v", err)
+	}
+	root, ok := rootRes[0].([32]byte)
+	if !ok {
+		return 0, common.Hash{}, fmt.Errorf("invalid get_deposit_root result")
+	}
+
+	return binary.LittleEndian.Uint64(countBytes), common.Hash(root), nil
+}
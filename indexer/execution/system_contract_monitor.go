@@ -0,0 +1,244 @@
+package execution
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/clients/execution"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// systemContractHistoryLength is the number of el blocks kept in the queue state history (~1 day with 12s slots).
+const systemContractHistoryLength = 7200
+
+// storage layout of the eip-7002 & eip-7251 system contracts
+var (
+	systemContractExcessSlot        = common.BigToHash(big.NewInt(0))
+	systemContractCountSlot         = common.BigToHash(big.NewInt(1))
+	systemContractQueueHeadSlot     = common.BigToHash(big.NewInt(2))
+	systemContractQueueTailSlot     = common.BigToHash(big.NewInt(3))
+	systemContractExcessInhibitor   = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	systemContractFeeUpdateFraction = big.NewInt(17)
+)
+
+// SystemContractMonitor reads the request queues of the eip-7002 withdrawal and eip-7251 consolidation
+// system contracts from the el state for every new block.
+type SystemContractMonitor struct {
+	indexerCtx  *IndexerCtx
+	logger      logrus.FieldLogger
+	stateMutex  sync.RWMutex
+	queueStates []*SystemContractQueueState
+}
+
+// SystemContractQueueState holds the request queue states of both system contracts after a el block.
+type SystemContractQueueState struct {
+	BlockNumber    uint64
+	BlockHash      common.Hash
+	Slot           uint64
+	Time           time.Time
+	Withdrawals    *SystemContractQueue
+	Consolidations *SystemContractQueue
+}
+
+// SystemContractQueue holds the request queue state of a system contract.
+type SystemContractQueue struct {
+	Excess        uint64
+	RequestCount  uint64   // number of requests added in the block
+	QueueLength   uint64   // number of requests waiting for dequeue
+	RequestFee    *big.Int // fee for the next request in wei
+	DequeueRate   uint64   // max. number of requests dequeued per block
+	IsSaturated   bool     // more requests queued than can be dequeued with the next block
+	IsInactivated bool     // contract not activated yet (pre-electra)
+}
+
+// NewSystemContractMonitor creates a new system contract queue monitor
+func NewSystemContractMonitor(indexer *IndexerCtx) *SystemContractMonitor {
+	scm := &SystemContractMonitor{
+		indexerCtx: indexer,
+		logger:     indexer.logger.WithField("indexer", "system-contracts"),
+	}
+
+	go scm.runMonitorLoop()
+
+	return scm
+}
+
+// GetQueueStates returns the recorded queue states in ascending block order.
+func (scm *SystemContractMonitor) GetQueueStates() []*SystemContractQueueState {
+	scm.stateMutex.RLock()
+	defer scm.stateMutex.RUnlock()
+
+	states := make([]*SystemContractQueueState, len(scm.queueStates))
+	copy(states, scm.queueStates)
+	return states
+}
+
+// GetLatestQueueState returns the queue state of the latest processed block or nil if no block has been processed yet.
+func (scm *SystemContractMonitor) GetLatestQueueState() *SystemContractQueueState {
+	scm.stateMutex.RLock()
+	defer scm.stateMutex.RUnlock()
+
+	if len(scm.queueStates) == 0 {
+		return nil
+	}
+	return scm.queueStates[len(scm.queueStates)-1]
+}
+
+func (scm *SystemContractMonitor) runMonitorLoop() {
+	defer utils.HandleSubroutinePanic("SystemContractMonitor.runMonitorLoop")
+
+	blockSubscription := scm.indexerCtx.beaconIndexer.SubscribeBlockEvent(10)
+	defer blockSubscription.Unsubscribe()
+
+	for block := range blockSubscription.Channel() {
+		if !scm.isElectraBlock(block) {
+			continue
+		}
+
+		err := scm.processBlock(block)
+		if err != nil {
+			scm.logger.Warnf("failed reading system contract queues for slot %v: %v", block.Slot, err)
+		}
+	}
+}
+
+func (scm *SystemContractMonitor) isElectraBlock(block *beacon.Block) bool {
+	specs := scm.indexerCtx.chainState.GetSpecs()
+	if specs.ElectraForkEpoch == nil {
+		return false
+	}
+	return uint64(scm.indexerCtx.chainState.EpochOfSlot(block.Slot)) >= *specs.ElectraForkEpoch
+}
+
+func (scm *SystemContractMonitor) processBlock(block *beacon.Block) error {
+	blockIndex := block.GetBlockIndex()
+	if blockIndex == nil || blockIndex.ExecutionNumber == 0 {
+		return nil
+	}
+
+	client := scm.indexerCtx.executionPool.GetReadyEndpoint(execution.AnyClient)
+	if client == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	blockHash := common.Hash(blockIndex.ExecutionHash)
+	specs := scm.indexerCtx.chainState.GetSpecs()
+
+	withdrawals, err := scm.readQueue(ctx, client, common.HexToAddress(WithdrawalContractAddr), blockHash, specs.MaxWithdrawalRequestsPerPayload)
+	if err != nil {
+		return err
+	}
+	consolidations, err := scm.readQueue(ctx, client, common.HexToAddress(ConsolidationContractAddr), blockHash, specs.MaxConsolidationRequestsPerPayload)
+	if err != nil {
+		return err
+	}
+
+	queueState := &SystemContractQueueState{
+		BlockNumber:    blockIndex.ExecutionNumber,
+		BlockHash:      blockHash,
+		Slot:           uint64(block.Slot),
+		Time:           time.Unix(int64(blockIndex.ExecutionTime), 0),
+		Withdrawals:    withdrawals,
+		Consolidations: consolidations,
+	}
+
+	scm.addQueueState(queueState)
+	return nil
+}
+
+func (scm *SystemContractMonitor) readQueue(ctx context.Context, client *execution.Client, contract common.Address, blockHash common.Hash, dequeueRate uint64) (*SystemContractQueue, error) {
+	readSlot := func(slot common.Hash) (*big.Int, error) {
+		value, err := client.GetRPCClient().GetStorageAtHash(ctx, contract, slot, blockHash)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetBytes(value), nil
+	}
+
+	excess, err := readSlot(systemContractExcessSlot)
+	if err != nil {
+		return nil, err
+	}
+	if excess.Cmp(systemContractExcessInhibitor) == 0 {
+		return &SystemContractQueue{
+			DequeueRate:   dequeueRate,
+			IsInactivated: true,
+		}, nil
+	}
+
+	count, err := readSlot(systemContractCountSlot)
+	if err != nil {
+		return nil, err
+	}
+	queueHead, err := readSlot(systemContractQueueHeadSlot)
+	if err != nil {
+		return nil, err
+	}
+	queueTail, err := readSlot(systemContractQueueTailSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := &SystemContractQueue{
+		Excess:       excess.Uint64(),
+		RequestCount: count.Uint64(),
+		RequestFee:   getSystemContractRequestFee(excess),
+		DequeueRate:  dequeueRate,
+	}
+	if queueTail.Cmp(queueHead) > 0 {
+		queue.QueueLength = new(big.Int).Sub(queueTail, queueHead).Uint64()
+	}
+	queue.IsSaturated = dequeueRate > 0 && queue.QueueLength > dequeueRate
+
+	return queue, nil
+}
+
+func (scm *SystemContractMonitor) addQueueState(queueState *SystemContractQueueState) {
+	scm.stateMutex.Lock()
+	defer scm.stateMutex.Unlock()
+
+	var lastState *SystemContractQueueState
+	if len(scm.queueStates) > 0 {
+		lastState = scm.queueStates[len(scm.queueStates)-1]
+	}
+
+	// replace states of reorged blocks
+	stateIdx := len(scm.queueStates)
+	for stateIdx > 0 && scm.queueStates[stateIdx-1].BlockNumber >= queueState.BlockNumber {
+		stateIdx--
+	}
+	scm.queueStates = append(scm.queueStates[:stateIdx], queueState)
+
+	if len(scm.queueStates) > systemContractHistoryLength {
+		scm.queueStates = append([]*SystemContractQueueState{}, scm.queueStates[len(scm.queueStates)-systemContractHistoryLength:]...)
+	}
+
+	if queueState.Withdrawals.IsSaturated && (lastState == nil || !lastState.Withdrawals.IsSaturated) {
+		scm.logger.Warnf("withdrawal request queue saturated at block %v: %v requests queued, request fee %v wei", queueState.BlockNumber, queueState.Withdrawals.QueueLength, queueState.Withdrawals.RequestFee)
+	}
+	if queueState.Consolidations.IsSaturated && (lastState == nil || !lastState.Consolidations.IsSaturated) {
+		scm.logger.Warnf("consolidation request queue saturated at block %v: %v requests queued, request fee %v wei", queueState.BlockNumber, queueState.Consolidations.QueueLength, queueState.Consolidations.RequestFee)
+	}
+}
+
+// getSystemContractRequestFee calculates the request fee for the given excess (fake_exponential(1, excess, 17))
+func getSystemContractRequestFee(excess *big.Int) *big.Int {
+	factor := big.NewInt(1)
+	output := new(big.Int)
+	numeratorAccum := new(big.Int).Mul(factor, systemContractFeeUpdateFraction)
+	for i := int64(1); numeratorAccum.Sign() > 0; i++ {
+		output.Add(output, numeratorAccum)
+		numeratorAccum.Mul(numeratorAccum, excess)
+		numeratorAccum.Div(numeratorAccum, new(big.Int).Mul(systemContractFeeUpdateFraction, big.NewInt(i)))
+	}
+	return output.Div(output, systemContractFeeUpdateFraction)
+}
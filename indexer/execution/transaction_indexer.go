@@ -0,0 +1,210 @@
+package execution
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// elTxSummaryTopTargets is the number of most called contracts stored per block summary
+const elTxSummaryTopTargets = 5
+
+// TransactionIndexer decodes the execution payload transactions of new beacon blocks and stores a summary per block,
+// so the transaction details of historical slots can be shown without decoding the full block body again.
+type TransactionIndexer struct {
+	indexerCtx *IndexerCtx
+	logger     logrus.FieldLogger
+}
+
+// NewTransactionIndexer creates a new transaction indexer
+func NewTransactionIndexer(indexer *IndexerCtx) *TransactionIndexer {
+	ti := &TransactionIndexer{
+		indexerCtx: indexer,
+		logger:     indexer.logger.WithField("indexer", "transactions"),
+	}
+
+	go ti.runTransactionIndexerLoop()
+
+	return ti
+}
+
+// runTransactionIndexerLoop is the main loop for the transaction indexer
+func (ti *TransactionIndexer) runTransactionIndexerLoop() {
+	defer utils.HandleSubroutinePanic("TransactionIndexer.runTransactionIndexerLoop")
+
+	blockSubscription := ti.indexerCtx.beaconIndexer.SubscribeBlockEvent(100)
+	defer blockSubscription.Unsubscribe()
+
+	for block := range blockSubscription.Channel() {
+		err := ti.processBlock(block)
+		if err != nil {
+			ti.logger.Warnf("failed indexing transactions of block %v (%v): %v", block.Slot, block.Root.String(), err)
+		}
+	}
+}
+
+// processBlock builds & persists the transaction summary of a block
+func (ti *TransactionIndexer) processBlock(block *beacon.Block) error {
+	blockBody := block.GetBlock()
+	if blockBody == nil {
+		return fmt.Errorf("block body not available")
+	}
+
+	summary, err := BuildElTxSummary(blockBody)
+	if err != nil || summary == nil {
+		// pre-merge blocks have no execution payload
+		return err
+	}
+	summary.BlockRoot = block.Root[:]
+	summary.Slot = uint64(block.Slot)
+
+	return db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertElTxSummaries([]*dbtypes.ElTxSummary{summary}, tx)
+	})
+}
+
+// BuildElTxSummary decodes the execution payload transactions of a beacon block and returns their summary.
+// it returns nil for blocks without execution payload. the block root & slot are not set.
+func BuildElTxSummary(blockBody *spec.VersionedSignedBeaconBlock) (*dbtypes.ElTxSummary, error) {
+	summary := &dbtypes.ElTxSummary{}
+
+	switch blockBody.Version {
+	case spec.DataVersionPhase0, spec.DataVersionAltair:
+		return nil, nil
+	case spec.DataVersionBellatrix:
+		if blockBody.Bellatrix == nil {
+			return nil, fmt.Errorf("missing bellatrix block")
+		}
+		payload := blockBody.Bellatrix.Message.Body.ExecutionPayload
+		summary.GasUsed = payload.GasUsed
+		summary.GasLimit = payload.GasLimit
+		summary.BaseFee = getLittleEndianBaseFee(payload.BaseFeePerGas)
+	case spec.DataVersionCapella:
+		if blockBody.Capella == nil {
+			return nil, fmt.Errorf("missing capella block")
+		}
+		payload := blockBody.Capella.Message.Body.ExecutionPayload
+		summary.GasUsed = payload.GasUsed
+		summary.GasLimit = payload.GasLimit
+		summary.BaseFee = getLittleEndianBaseFee(payload.BaseFeePerGas)
+	case spec.DataVersionDeneb:
+		if blockBody.Deneb == nil {
+			return nil, fmt.Errorf("missing deneb block")
+		}
+		payload := blockBody.Deneb.Message.Body.ExecutionPayload
+		summary.GasUsed = payload.GasUsed
+		summary.GasLimit = payload.GasLimit
+		summary.BaseFee = payload.BaseFeePerGas.Uint64()
+	case spec.DataVersionElectra:
+		if blockBody.Electra == nil {
+			return nil, fmt.Errorf("missing electra block")
+		}
+		payload := blockBody.Electra.Message.Body.ExecutionPayload
+		summary.GasUsed = payload.GasUsed
+		summary.GasLimit = payload.GasLimit
+		summary.BaseFee = payload.BaseFeePerGas.Uint64()
+	default:
+		return nil, fmt.Errorf("unknown block version: %v", blockBody.Version)
+	}
+
+	blockHash, err := blockBody.ExecutionBlockHash()
+	if err != nil {
+		return nil, err
+	}
+	summary.BlockHash = blockHash[:]
+
+	summary.BlockNumber, err = blockBody.ExecutionBlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := blockBody.ExecutionTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	targetMap := map[common.Address]*dbtypes.ElTxTarget{}
+	for idx, txBytes := range transactions {
+		var tx types.Transaction
+		if err := tx.UnmarshalBinary(txBytes); err != nil {
+			return nil, fmt.Errorf("error decoding transaction %v: %v", idx, err)
+		}
+
+		summary.TxCount++
+		if tx.Type() == types.BlobTxType {
+			summary.BlobTxCount++
+		}
+
+		txTo := tx.To()
+		if txTo == nil {
+			summary.ContractCreations++
+			continue
+		}
+		if len(tx.Data()) == 0 {
+			// plain transfer, not a contract call
+			continue
+		}
+
+		target := targetMap[*txTo]
+		if target == nil {
+			target = &dbtypes.ElTxTarget{
+				Address: txTo.Bytes(),
+			}
+			targetMap[*txTo] = target
+		}
+		target.TxCount++
+		target.GasLimit += tx.Gas()
+	}
+
+	summary.TopTargets, err = encodeElTxTargets(targetMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// getLittleEndianBaseFee converts the little endian base fee of pre-deneb payloads
+func getLittleEndianBaseFee(baseFee [32]byte) uint64 {
+	var baseFeeBE [32]byte
+	for i := 0; i < 32; i++ {
+		baseFeeBE[i] = baseFee[32-1-i]
+	}
+	return new(big.Int).SetBytes(baseFeeBE[:]).Uint64()
+}
+
+// encodeElTxTargets returns the json encoded list of the most called contracts
+func encodeElTxTargets(targetMap map[common.Address]*dbtypes.ElTxTarget) (string, error) {
+	targets := make([]*dbtypes.ElTxTarget, 0, len(targetMap))
+	for _, target := range targetMap {
+		targets = append(targets, target)
+	}
+	sort.Slice(targets, func(a, b int) bool {
+		if targets[a].TxCount != targets[b].TxCount {
+			return targets[a].TxCount > targets[b].TxCount
+		}
+		return bytes.Compare(targets[a].Address, targets[b].Address) < 0
+	})
+	if len(targets) > elTxSummaryTopTargets {
+		targets = targets[:elTxSummaryTopTargets]
+	}
+
+	targetsJson, err := json.Marshal(targets)
+	if err != nil {
+		return "", err
+	}
+	return string(targetsJson), nil
+}
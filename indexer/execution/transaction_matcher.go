@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -79,7 +80,7 @@ func (ds *transactionMatcher[MatchType]) runTransactionMatcher(indexerBlock uint
 			return nil
 		}
 
-		syncBlock := db.GetSlotByRoot(syncBlockRoot)
+		syncBlock := db.GetSlotByRoot(context.Background(), syncBlockRoot)
 		if syncBlock == nil {
 			// block not found, not synced at all
 			return nil
@@ -144,7 +145,7 @@ func (ds *transactionMatcher[MatchType]) runTransactionMatcher(indexerBlock uint
 // loadState loads the state of the transaction matcher from the database
 func (ds *transactionMatcher[_]) loadState() {
 	syncState := transactionMatcherState{}
-	db.GetExplorerState(ds.options.stateKey, &syncState)
+	db.GetExplorerState(context.Background(), ds.options.stateKey, &syncState)
 	ds.state = &syncState
 
 	if ds.state.MatchHeight == 0 {
@@ -176,7 +177,7 @@ func (ds *transactionMatcher[_]) getFinalizedBlockNumber() uint64 {
 
 	if finalizedBlockNumber == 0 {
 		// load from db
-		if finalizedBlock := db.GetSlotByRoot(finalizedRoot[:]); finalizedBlock != nil && finalizedBlock.EthBlockNumber != nil {
+		if finalizedBlock := db.GetSlotByRoot(context.Background(), finalizedRoot[:]); finalizedBlock != nil && finalizedBlock.EthBlockNumber != nil {
 			finalizedBlockNumber = *finalizedBlock.EthBlockNumber
 		}
 	}
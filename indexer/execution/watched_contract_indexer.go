@@ -0,0 +1,200 @@
+package execution
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// WatchedContractIndexer indexes the logs of the contracts configured in executionapi.watchedContracts
+type WatchedContractIndexer struct {
+	indexerCtx *IndexerCtx
+	logger     logrus.FieldLogger
+	contracts  []*WatchedContract
+	indexers   []*contractIndexer[dbtypes.WatchedContractLog]
+}
+
+// WatchedContract describes a watched contract with its resolved topic filter
+type WatchedContract struct {
+	Name       string
+	Address    common.Address
+	Topics     []common.Hash
+	TopicNames map[common.Hash]string // event signatures for topics configured by signature
+}
+
+// NewWatchedContractIndexer creates a new indexer for the configured watched contracts
+func NewWatchedContractIndexer(indexer *IndexerCtx) *WatchedContractIndexer {
+	batchSize := utils.Config().ExecutionApi.LogBatchSize
+	if batchSize == 0 {
+		batchSize = 1000
+	}
+
+	wi := &WatchedContractIndexer{
+		indexerCtx: indexer,
+		logger:     indexer.logger.WithField("indexer", "watched-contracts"),
+	}
+
+	for idx := range utils.Config().ExecutionApi.WatchedContracts {
+		contractConfig := &utils.Config().ExecutionApi.WatchedContracts[idx]
+		if !common.IsHexAddress(contractConfig.Address) {
+			wi.logger.Errorf("invalid watched contract address: %v", contractConfig.Address)
+			continue
+		}
+
+		contract := &WatchedContract{
+			Name:       contractConfig.Name,
+			Address:    common.HexToAddress(contractConfig.Address),
+			Topics:     []common.Hash{},
+			TopicNames: map[common.Hash]string{},
+		}
+		if contract.Name == "" {
+			contract.Name = contract.Address.Hex()
+		}
+
+		for _, topic := range contractConfig.Topics {
+			topicHash, isSignature := ParseWatchedContractTopic(topic)
+			contract.Topics = append(contract.Topics, topicHash)
+			if isSignature {
+				contract.TopicNames[topicHash] = topic
+			}
+		}
+
+		wi.contracts = append(wi.contracts, contract)
+		wi.indexers = append(wi.indexers, newContractIndexer(
+			indexer,
+			wi.logger.WithField("routine", "crawler").WithField("contract", contract.Address.Hex()),
+			&contractIndexerOptions[dbtypes.WatchedContractLog]{
+				stateKey:        fmt.Sprintf("indexer.watchedcontract.%v", strings.ToLower(contract.Address.Hex())),
+				batchSize:       batchSize,
+				concurrency:     utils.Config().ExecutionApi.LogConcurrency,
+				contractAddress: contract.Address,
+				topics:          contract.Topics,
+				deployBlock:     contractConfig.FromBlock,
+				endBlock:        contractConfig.ToBlock,
+				dequeueRate:     0,
+
+				processFinalTx:  wi.processFinalTx,
+				processRecentTx: wi.processRecentTx,
+				persistTxs:      wi.persistContractLogs,
+			},
+		))
+	}
+
+	go wi.runWatchedContractIndexerLoop()
+
+	return wi
+}
+
+// ParseWatchedContractTopic returns the topic0 hash for a topic given as hash or event signature, and whether it was a signature
+func ParseWatchedContractTopic(topic string) (common.Hash, bool) {
+	topicBytes := common.FromHex(topic)
+	if strings.HasPrefix(topic, "0x") && len(topicBytes) == common.HashLength {
+		return common.BytesToHash(topicBytes), false
+	}
+
+	return crypto.Keccak256Hash([]byte(strings.ReplaceAll(topic, " ", ""))), true
+}
+
+// GetContracts returns the watched contracts
+func (wi *WatchedContractIndexer) GetContracts() []*WatchedContract {
+	return wi.contracts
+}
+
+// runWatchedContractIndexerLoop is the main loop for the watched contract indexer
+func (wi *WatchedContractIndexer) runWatchedContractIndexerLoop() {
+	defer utils.HandleSubroutinePanic("WatchedContractIndexer.runWatchedContractIndexerLoop")
+
+	for {
+		time.Sleep(60 * time.Second)
+		wi.logger.Debugf("run watched contract indexer logic")
+
+		for _, contractIndexer := range wi.indexers {
+			err := contractIndexer.runContractIndexer()
+			if err != nil {
+				wi.logger.Errorf("watched contract indexer error (%v): %v", contractIndexer.options.contractAddress.Hex(), err)
+			}
+		}
+	}
+}
+
+// processFinalTx is the callback for the contract indexer to process finalized logs
+func (wi *WatchedContractIndexer) processFinalTx(log *types.Log, tx *types.Transaction, header *types.Header, txFrom common.Address, dequeueBlock uint64) (*dbtypes.WatchedContractLog, error) {
+	contractLog := wi.parseContractLog(log, tx, header, txFrom)
+
+	return contractLog, nil
+}
+
+// processRecentTx is the callback for the contract indexer to process recent logs
+func (wi *WatchedContractIndexer) processRecentTx(log *types.Log, tx *types.Transaction, header *types.Header, txFrom common.Address, dequeueBlock uint64, fork *forkWithClients) (*dbtypes.WatchedContractLog, error) {
+	contractLog := wi.parseContractLog(log, tx, header, txFrom)
+
+	clBlock := wi.indexerCtx.beaconIndexer.GetBlocksByExecutionBlockHash(phase0.Hash32(log.BlockHash))
+	if len(clBlock) > 0 {
+		contractLog.ForkId = uint64(clBlock[0].GetForkId())
+	} else {
+		contractLog.ForkId = uint64(fork.forkId)
+	}
+
+	return contractLog, nil
+}
+
+// parseContractLog converts a contract log to its database representation
+func (wi *WatchedContractIndexer) parseContractLog(log *types.Log, tx *types.Transaction, header *types.Header, txFrom common.Address) *dbtypes.WatchedContractLog {
+	contractLog := &dbtypes.WatchedContractLog{
+		ContractAddress: log.Address[:],
+		BlockNumber:     log.BlockNumber,
+		BlockIndex:      uint64(log.Index),
+		BlockTime:       header.Time,
+		BlockRoot:       log.BlockHash[:],
+		Data:            log.Data,
+		TxHash:          log.TxHash[:],
+		TxSender:        txFrom[:],
+	}
+	if contractLog.Data == nil {
+		contractLog.Data = []byte{}
+	}
+
+	// logs emitted during contract creation have no tx target
+	if txTo := tx.To(); txTo != nil {
+		contractLog.TxTarget = txTo[:]
+	}
+
+	topics := []*[]byte{&contractLog.Topic0, &contractLog.Topic1, &contractLog.Topic2, &contractLog.Topic3}
+	for i, topic := range log.Topics {
+		if i >= len(topics) {
+			break
+		}
+		*topics[i] = topic.Bytes()
+	}
+
+	return contractLog
+}
+
+// persistContractLogs is the callback for the contract indexer to persist contract logs to the database
+func (wi *WatchedContractIndexer) persistContractLogs(tx *sqlx.Tx, contractLogs []*dbtypes.WatchedContractLog) error {
+	logCount := len(contractLogs)
+	for logIdx := 0; logIdx < logCount; logIdx += 500 {
+		endIdx := logIdx + 500
+		if endIdx > logCount {
+			endIdx = logCount
+		}
+
+		err := db.InsertWatchedContractLogs(contractLogs[logIdx:endIdx], tx)
+		if err != nil {
+			return fmt.Errorf("error while inserting watched contract logs: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -1,7 +1,7 @@
 package execution
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"math/big"
 	"time"
@@ -36,7 +36,7 @@ type withdrawalRequestMatch struct {
 
 // NewWithdrawalIndexer creates a new withdrawal contract indexer
 func NewWithdrawalIndexer(indexer *IndexerCtx) *WithdrawalIndexer {
-	batchSize := utils.Config.ExecutionApi.LogBatchSize
+	batchSize := utils.Config().ExecutionApi.LogBatchSize
 	if batchSize == 0 {
 		batchSize = 1000
 	}
@@ -55,8 +55,9 @@ func NewWithdrawalIndexer(indexer *IndexerCtx) *WithdrawalIndexer {
 		&contractIndexerOptions[dbtypes.WithdrawalRequestTx]{
 			stateKey:        "indexer.withdrawalindexer",
 			batchSize:       batchSize,
+			concurrency:     utils.Config().ExecutionApi.LogConcurrency,
 			contractAddress: common.HexToAddress(WithdrawalContractAddr),
-			deployBlock:     uint64(utils.Config.ExecutionApi.ElectraDeployBlock),
+			deployBlock:     uint64(utils.Config().ExecutionApi.ElectraDeployBlock),
 			dequeueRate:     specs.MaxWithdrawalRequestsPerPayload,
 
 			processFinalTx:  wi.processFinalTx,
@@ -71,7 +72,7 @@ func NewWithdrawalIndexer(indexer *IndexerCtx) *WithdrawalIndexer {
 		indexer.logger.WithField("contract-matcher", "withdrawals"),
 		&transactionMatcherOptions[withdrawalRequestMatch]{
 			stateKey:    "indexer.withdrawalmatcher",
-			deployBlock: uint64(utils.Config.ExecutionApi.ElectraDeployBlock),
+			deployBlock: uint64(utils.Config().ExecutionApi.ElectraDeployBlock),
 			timeLimit:   2 * time.Second,
 
 			matchBlockRange: wi.matchBlockRange,
@@ -123,6 +124,7 @@ func (wi *WithdrawalIndexer) processFinalTx(log *types.Log, tx *types.Transactio
 	requestTx.TxSender = txFrom[:]
 	requestTx.TxTarget = txTo[:]
 	requestTx.DequeueBlock = dequeueBlock
+	requestTx.TxFee = wi.getRequestFee(tx)
 
 	return requestTx, nil
 }
@@ -141,6 +143,7 @@ func (wi *WithdrawalIndexer) processRecentTx(log *types.Log, tx *types.Transacti
 	requestTx.TxSender = txFrom[:]
 	requestTx.TxTarget = txTo[:]
 	requestTx.DequeueBlock = dequeueBlock
+	requestTx.TxFee = wi.getRequestFee(tx)
 
 	clBlock := wi.indexerCtx.beaconIndexer.GetBlocksByExecutionBlockHash(phase0.Hash32(log.BlockHash))
 	if len(clBlock) > 0 {
@@ -152,6 +155,17 @@ func (wi *WithdrawalIndexer) processRecentTx(log *types.Log, tx *types.Transacti
 	return requestTx, nil
 }
 
+// getRequestFee returns the fee paid for a withdrawal request
+// the fee is only known for transactions that call the system contract directly, requests sent via other contracts
+// pay the fee with an internal call that is not visible in the transaction.
+func (wi *WithdrawalIndexer) getRequestFee(tx *types.Transaction) []byte {
+	if txTo := tx.To(); txTo == nil || *txTo != common.HexToAddress(WithdrawalContractAddr) {
+		return nil
+	}
+
+	return tx.Value().Bytes()
+}
+
 // parseRequestLog parses a withdrawal log and returns the corresponding withdrawal transaction
 func (wi *WithdrawalIndexer) parseRequestLog(log *types.Log, forkId *beacon.ForkKey) *dbtypes.WithdrawalRequestTx {
 	// data layout:
@@ -168,15 +182,10 @@ func (wi *WithdrawalIndexer) parseRequestLog(log *types.Log, forkId *beacon.Fork
 	validatorPubkey := log.Data[20:68]
 	amount := big.NewInt(0).SetBytes(log.Data[68:76]).Uint64()
 
-	validatorSet := wi.indexerCtx.beaconIndexer.GetValidatorSet(forkId)
-
 	var validatorIndex *uint64
-	for index, validator := range validatorSet {
-		if bytes.Equal(validator.PublicKey[:], validatorPubkey) {
-			index := uint64(index)
-			validatorIndex = &index
-			break
-		}
+	if index, found := wi.indexerCtx.beaconIndexer.GetValidatorIndexByPubkey(phase0.BLSPubKey(validatorPubkey)); found && wi.indexerCtx.beaconIndexer.GetValidatorByIndex(index, forkId) != nil {
+		index := uint64(index)
+		validatorIndex = &index
 	}
 
 	requestTx := &dbtypes.WithdrawalRequestTx{
@@ -215,7 +224,7 @@ func (wi *WithdrawalIndexer) persistWithdrawalTxs(tx *sqlx.Tx, requests []*dbtyp
 func (wi *WithdrawalIndexer) matchBlockRange(fromBlock uint64, toBlock uint64) ([]*withdrawalRequestMatch, error) {
 	requestMatches := []*withdrawalRequestMatch{}
 
-	dequeueWithdrawalTxs := db.GetWithdrawalRequestTxsByDequeueRange(fromBlock, toBlock)
+	dequeueWithdrawalTxs := db.GetWithdrawalRequestTxsByDequeueRange(context.Background(), fromBlock, toBlock)
 	if len(dequeueWithdrawalTxs) > 0 {
 		firstBlock := dequeueWithdrawalTxs[0].DequeueBlock
 		lastBlock := dequeueWithdrawalTxs[len(dequeueWithdrawalTxs)-1].DequeueBlock
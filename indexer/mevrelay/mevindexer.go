@@ -1,6 +1,7 @@
 package mevrelay
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -57,8 +58,8 @@ func (mev *MevIndexer) StartUpdater() {
 		return
 	}
 
-	if utils.Config.MevIndexer.RefreshInterval == 0 {
-		utils.Config.MevIndexer.RefreshInterval = 10 * time.Minute
+	if utils.Config().MevIndexer.RefreshInterval == 0 {
+		utils.Config().MevIndexer.RefreshInterval = 10 * time.Minute
 	}
 
 	mev.updaterRunning = true
@@ -79,12 +80,7 @@ func (mev *MevIndexer) runUpdaterLoop() {
 }
 
 func (mev *MevIndexer) runUpdater() error {
-	if time.Since(mev.lastRefresh) < utils.Config.MevIndexer.RefreshInterval {
-		return nil
-	}
-
-	validatorSet := mev.beaconIndexer.GetValidatorSet(nil)
-	if validatorSet == nil {
+	if time.Since(mev.lastRefresh) < utils.Config().MevIndexer.RefreshInterval {
 		return nil
 	}
 
@@ -97,7 +93,7 @@ func (mev *MevIndexer) runUpdater() error {
 		}
 		loadedCount := uint64(0)
 		for {
-			mevBlocks, totalCount, err := db.GetMevBlocksFiltered(0, 1000, &dbtypes.MevBlockFilter{
+			mevBlocks, totalCount, err := db.GetMevBlocksFiltered(context.Background(), 0, 1000, &dbtypes.MevBlockFilter{
 				MinSlot: uint64(finalizedSlot),
 			})
 			if err != nil {
@@ -117,7 +113,7 @@ func (mev *MevIndexer) runUpdater() error {
 			}
 		}
 
-		for _, relay := range utils.Config.MevIndexer.Relays {
+		for _, relay := range utils.Config().MevIndexer.Relays {
 			lastSlot, err := db.GetHighestMevBlockSlotByRelay(relay.Index)
 			if err != nil {
 				continue
@@ -130,7 +126,7 @@ func (mev *MevIndexer) runUpdater() error {
 
 	// load data from all relays
 	wg := &sync.WaitGroup{}
-	for idx := range utils.Config.MevIndexer.Relays {
+	for idx := range utils.Config().MevIndexer.Relays {
 		wg.Add(1)
 
 		go func(idx int, relay *types.MevRelayConfig) {
@@ -142,7 +138,7 @@ func (mev *MevIndexer) runUpdater() error {
 			if err != nil {
 				mev.logger.Errorf("error loading mev blocks from relay %v (%v): %v", idx, relay.Name, err)
 			}
-		}(idx, &utils.Config.MevIndexer.Relays[idx])
+		}(idx, &utils.Config().MevIndexer.Relays[idx])
 	}
 	wg.Wait()
 
@@ -272,7 +268,7 @@ func (mev *MevIndexer) loadMevBlocksFromRelay(relay *types.MevRelayConfig) error
 
 			if slot < uint64(finalizedSlot) {
 				// try load from db
-				mevBlock := db.GetMevBlockByBlockHash(blockHash[:])
+				mevBlock := db.GetMevBlockByBlockHash(context.Background(), blockHash[:])
 				if mevBlock != nil {
 					cachedBlock = &mevIndexerBlockCache{
 						block: mevBlock,
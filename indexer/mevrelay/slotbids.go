@@ -0,0 +1,134 @@
+package mevrelay
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// SlotBid is a single builder bid received by a relay for a slot.
+type SlotBid struct {
+	RelayIndex    uint8
+	BlockHash     common.Hash
+	BuilderPubkey []byte
+	ValueGwei     uint64
+	Timestamp     time.Time
+	Optimistic    bool
+}
+
+// SlotRelayBids holds the bids a relay received for a slot, or the error that occurred while loading them.
+type SlotRelayBids struct {
+	Relay *types.MevRelayConfig
+	Bids  []*SlotBid
+	Error error
+}
+
+type mevIndexerRelayBidResponse struct {
+	Slot                 string `json:"slot"`
+	BlockHash            string `json:"block_hash"`
+	BuilderPubkey        string `json:"builder_pubkey"`
+	Value                string `json:"value"`
+	TimestampMs          string `json:"timestamp_ms"`
+	OptimisticSubmission bool   `json:"optimistic_submission"`
+}
+
+// LoadSlotBids fetches the builder bids for a slot from all configured relays in parallel.
+// the relay data APIs only keep the received bids for a limited time, so this is loaded on demand and not indexed.
+func LoadSlotBids(slot phase0.Slot) []*SlotRelayBids {
+	results := make([]*SlotRelayBids, len(utils.Config().MevIndexer.Relays))
+
+	wg := &sync.WaitGroup{}
+	for idx := range utils.Config().MevIndexer.Relays {
+		wg.Add(1)
+
+		go func(idx int, relay *types.MevRelayConfig) {
+			defer wg.Done()
+
+			bids, err := loadSlotBidsFromRelay(relay, slot)
+			results[idx] = &SlotRelayBids{
+				Relay: relay,
+				Bids:  bids,
+				Error: err,
+			}
+		}(idx, &utils.Config().MevIndexer.Relays[idx])
+	}
+	wg.Wait()
+
+	return results
+}
+
+func loadSlotBidsFromRelay(relay *types.MevRelayConfig, slot phase0.Slot) ([]*SlotBid, error) {
+	relayUrl, err := url.Parse(relay.Url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid relay url: %v", err)
+	}
+
+	relayUrl.Path = path.Join(relayUrl.Path, "/relay/v1/data/bidtraces/builder_blocks_received")
+	apiUrl := fmt.Sprintf("%v?slot=%v", relayUrl.String(), slot)
+
+	client := &http.Client{Timeout: time.Second * 10}
+	resp, err := client.Get(apiUrl)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch bids (%v): %v", utils.GetRedactedUrl(apiUrl), err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("could not fetch bids (%v): not found", utils.GetRedactedUrl(apiUrl))
+		}
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("url: %v, error-response: %s", utils.GetRedactedUrl(apiUrl), data)
+	}
+	bidsResponse := []*mevIndexerRelayBidResponse{}
+	dec := json.NewDecoder(resp.Body)
+	err = dec.Decode(&bidsResponse)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing bids response: %v", err)
+	}
+
+	bids := make([]*SlotBid, 0, len(bidsResponse))
+	for _, bidData := range bidsResponse {
+		if bidData.Slot != "" && bidData.Slot != strconv.FormatUint(uint64(slot), 10) {
+			continue
+		}
+
+		timestampMs, err := strconv.ParseInt(bidData.TimestampMs, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		bidValue, ok := big.NewInt(0).SetString(bidData.Value, 10)
+		if !ok {
+			continue
+		}
+
+		bids = append(bids, &SlotBid{
+			RelayIndex:    relay.Index,
+			BlockHash:     common.HexToHash(bidData.BlockHash),
+			BuilderPubkey: common.FromHex(bidData.BuilderPubkey),
+			ValueGwei:     big.NewInt(0).Div(bidValue, utils.GWEI).Uint64(),
+			Timestamp:     time.UnixMilli(timestampMs),
+			Optimistic:    bidData.OptimisticSubmission,
+		})
+	}
+
+	sort.Slice(bids, func(a, b int) bool {
+		return bids[a].Timestamp.Before(bids[b].Timestamp)
+	})
+
+	return bids, nil
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/utils"
+)
+
+// AdminAuditLog keeps track of the actions performed via the admin api.
+// every entry is written to the log as well, the in-memory history is limited to the configured audit log size.
+type AdminAuditLog struct {
+	logger  logrus.FieldLogger
+	mutex   sync.RWMutex
+	entries []*AdminAuditEntry
+	limit   int
+}
+
+// AdminAuditEntry represents a single admin api action.
+type AdminAuditEntry struct {
+	Time    time.Time
+	Token   string // name of the used token
+	Remote  string
+	Action  string
+	Success bool
+	Message string
+}
+
+var GlobalAdminAuditLog *AdminAuditLog
+
+// StartAdminAuditLog is used to initialize the global admin audit log
+func StartAdminAuditLog(logger logrus.FieldLogger) error {
+	if GlobalAdminAuditLog != nil || !utils.Config().AdminApi.Enabled {
+		return nil
+	}
+
+	limit := utils.Config().AdminApi.AuditLogSize
+	if limit <= 0 {
+		limit = 1000
+	}
+
+	GlobalAdminAuditLog = &AdminAuditLog{
+		logger:  logger,
+		entries: []*AdminAuditEntry{},
+		limit:   limit,
+	}
+	return nil
+}
+
+// AddEntry records an admin action.
+func (al *AdminAuditLog) AddEntry(entry *AdminAuditEntry) {
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+
+	logger := al.logger.WithFields(logrus.Fields{
+		"token":  entry.Token,
+		"remote": entry.Remote,
+		"action": entry.Action,
+	})
+	if entry.Success {
+		logger.Infof("admin action succeeded: %v", entry.Message)
+	} else {
+		logger.Warnf("admin action failed: %v", entry.Message)
+	}
+
+	al.mutex.Lock()
+	defer al.mutex.Unlock()
+
+	al.entries = append(al.entries, entry)
+	if len(al.entries) > al.limit {
+		al.entries = al.entries[len(al.entries)-al.limit:]
+	}
+}
+
+// GetEntries returns the recorded admin actions, newest first.
+func (al *AdminAuditLog) GetEntries(offset uint64, limit uint64) []AdminAuditEntry {
+	al.mutex.RLock()
+	defer al.mutex.RUnlock()
+
+	entries := []AdminAuditEntry{}
+	for idx := len(al.entries) - 1 - int(offset); idx >= 0 && uint64(len(entries)) < limit; idx-- {
+		entries = append(entries, *al.entries[idx])
+	}
+	return entries
+}
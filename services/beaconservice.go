@@ -0,0 +1,200 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/clients/execution"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	execindexer "github.com/ethpandaops/dora/indexer/execution"
+)
+
+// BeaconService is the chain data access used by the page & api handlers.
+// all methods must be safe for concurrent use, as they are called from parallel page requests.
+// methods that may query the database or the clients take a context, so they get cancelled with the request.
+type BeaconService interface {
+	// lifecycle
+	StartService() error
+	StopService()
+
+	// clients, indexers & chain state
+	GetBeaconIndexer() BeaconIndexer
+	GetDepositIndexer() DepositIndexer
+	GetConsolidationIndexer() RequestIndexer
+	GetWithdrawalIndexer() RequestIndexer
+	GetSystemContractMonitor() SystemContractMonitor
+	GetWatchedContractIndexer() WatchedContractIndexer
+	GetConsensusClients() []*consensus.Client
+	GetExecutionClients() []*execution.Client
+	GetConsensusClientForks() []*ConsensusClientFork
+	SubscribeFinalityEvent(capacity int) *consensus.Subscription[*v1.Finality]
+	GetChainState() *consensus.ChainState
+	GetFinalizedEpoch() (phase0.Epoch, phase0.Root)
+	GetGenesis() (*v1.Genesis, error)
+	GetHeadForks(readyOnly bool) []*beacon.ForkHead
+	GetCanonicalForkIds() []uint64
+	GetParentForkIds(forkId beacon.ForkKey) []beacon.ForkKey
+
+	// blocks & epochs
+	GetSlotDetailsByBlockroot(ctx context.Context, blockroot phase0.Root) (*CombinedBlockResponse, error)
+	GetSlotDetailsBySlot(ctx context.Context, slot phase0.Slot) (*CombinedBlockResponse, error)
+	GetBlockBlob(ctx context.Context, blockroot phase0.Root, commitment deneb.KZGCommitment) (*deneb.BlobSidecar, error)
+	GetBlobSidecarsByBlockRoot(ctx context.Context, blockroot []byte) ([]*deneb.BlobSidecar, error)
+	GetBlobSidecarsByFilter(ctx context.Context, filter *dbtypes.BlobSidecarFilter, pageIdx uint64, pageSize uint32) ([]*BlobSidecarEntry, uint64)
+	GetBlobSidecarsByHash(ctx context.Context, hash []byte) []*BlobSidecarEntry
+	GetBlobContents(ctx context.Context, sidecar *dbtypes.BlobSidecar) ([]byte, error)
+	GetDbBlocksForSlots(ctx context.Context, firstSlot uint64, slotLimit uint32, withMissing bool, withOrphaned bool) []*dbtypes.Slot
+	GetDbBlocksByFilter(ctx context.Context, filter *dbtypes.BlockFilter, pageIdx uint64, pageSize uint32, withScheduledCount uint64) []*dbtypes.AssignedSlot
+	GetDbBlocksByParentRoot(ctx context.Context, parentRoot phase0.Root) []*dbtypes.Slot
+	CheckBlockOrphanedStatus(ctx context.Context, blockRoot phase0.Root) dbtypes.SlotStatus
+	GetDbEpochs(ctx context.Context, firstEpoch uint64, limit uint32) []*dbtypes.Epoch
+	GetStateProof(ctx context.Context, slot phase0.Slot, field string, index uint64) (*StateProof, error)
+	GetExecutionStorageProof(ctx context.Context, slot phase0.Slot, account common.Address, keys []common.Hash) (*ExecutionStorageProof, error)
+
+	// operations
+	GetIncludedDepositsByFilter(ctx context.Context, filter *dbtypes.DepositFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.Deposit, uint64)
+	GetVoluntaryExitsByFilter(ctx context.Context, filter *dbtypes.VoluntaryExitFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.VoluntaryExit, uint64)
+	GetSlashingsByFilter(ctx context.Context, filter *dbtypes.SlashingFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.Slashing, uint64)
+	GetWithdrawalRequestsByFilter(ctx context.Context, filter *CombinedWithdrawalRequestFilter, pageIdx uint64, pageSize uint32) ([]*CombinedWithdrawalRequest, uint64)
+	GetWithdrawalRequestOperationsByFilter(ctx context.Context, filter *dbtypes.WithdrawalRequestFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.WithdrawalRequest, uint64)
+	GetConsolidationRequestsByFilter(ctx context.Context, filter *CombinedConsolidationRequestFilter, pageIdx uint64, pageSize uint32) ([]*CombinedConsolidationRequest, uint64)
+	GetConsolidationRequestOperationsByFilter(ctx context.Context, filter *dbtypes.ConsolidationRequestFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.ConsolidationRequest, uint64)
+	GetWatchedContractLogsByFilter(ctx context.Context, filter *dbtypes.WatchedContractLogFilter, pageIdx uint64, pageSize uint32) ([]*WatchedContractLogEntry, uint64)
+
+	// validators
+	GetCachedValidatorSet(withBalance bool) *beacon.ValidatorSetView
+	GetHistoricValidatorSet(ctx context.Context, epoch phase0.Epoch) *beacon.ValidatorSetView
+	GetValidatorBalanceSnapshotEpochs(ctx context.Context) []uint64
+	GetValidatorByIndex(index phase0.ValidatorIndex, withBalance bool) *v1.Validator
+	GetValidatorIndexByPubkey(pubkey phase0.BLSPubKey) (phase0.ValidatorIndex, bool)
+	GetValidatorVotingActivity(validatorIndex phase0.ValidatorIndex) ([]beacon.ValidatorActivity, phase0.Epoch)
+	GetValidatorLiveness(validatorIndex phase0.ValidatorIndex, lookbackEpochs uint64) (votedEpochs uint64)
+	GetValidatorApyWindows() []*ValidatorApyWindow
+	GetValidatorApy(validatorIndex phase0.ValidatorIndex, window string) (float64, bool)
+	GetValidatorIncomePeriod() uint64
+	GetValidatorIncome(ctx context.Context, validatorIndex phase0.ValidatorIndex, limit uint64) []*dbtypes.ValidatorIncome
+	GetValidatorLuckWindows() []*ValidatorLuckWindow
+	GetValidatorProposalLuck(validatorIndexes []phase0.ValidatorIndex, window string) (*ValidatorProposalLuck, bool)
+	GetValidatorWithdrawalProjection(index phase0.ValidatorIndex) (time.Time, bool)
+
+	// validator names & notes
+	GetValidatorName(index uint64) string
+	GetValidatorNames(indices []uint64) map[uint64]string
+	GetValidatorNamesCount() uint64
+	ReloadValidatorNames() chan bool
+	ImportValidatorLabels(ctx context.Context, labels map[uint64]string, source string) (int, error)
+	ExportValidatorNames() []*ValidatorNameExport
+	AddValidatorNote(ctx context.Context, validatorIndex uint64, note string, tags []string, author string) (uint64, error)
+	DeleteValidatorNote(ctx context.Context, noteId uint64) (uint64, error)
+	GetValidatorNotes(ctx context.Context, validatorIndex uint64) []*ValidatorNote
+	GetValidatorNoteTags(ctx context.Context, validatorIndex uint64) []string
+
+	// validator ownership
+	GetValidatorOwnershipChallenge(sessionHash []byte) *ValidatorOwnershipChallenge
+	VerifyValidatorOwnership(ctx context.Context, sessionHash []byte, validatorIndex phase0.ValidatorIndex, signature []byte) error
+	GetValidatorOwnerships(ctx context.Context, sessionHash []byte) []*ValidatorOwnership
+	GetValidatorOwnership(ctx context.Context, sessionHash []byte, validatorIndex uint64) *ValidatorOwnership
+	SetValidatorPrivateLabel(ctx context.Context, sessionHash []byte, validatorIndex uint64, label string) error
+	RemoveValidatorOwnership(ctx context.Context, sessionHash []byte, validatorIndex uint64) error
+
+	// validator watchlists
+	GetValidatorWatchlists(ctx context.Context, sessionHash []byte) []*ValidatorWatchlist
+	AddValidatorWatchlistValidators(ctx context.Context, sessionHash []byte, listName string, validators []phase0.ValidatorIndex) (int, error)
+	RemoveValidatorWatchlistValidator(ctx context.Context, sessionHash []byte, listName string, validatorIndex uint64) error
+	DeleteValidatorWatchlist(ctx context.Context, sessionHash []byte, listName string) error
+	AddValidatorWatchlistWebhook(ctx context.Context, sessionHash []byte, listName string, hookUrl string, events []string, offlineEpochs uint64) error
+	RemoveValidatorWatchlistWebhook(ctx context.Context, sessionHash []byte, listName string, hookUrl string) error
+	GetValidatorUpcomingDuties(validators []phase0.ValidatorIndex) map[phase0.ValidatorIndex]*ValidatorUpcomingDuties
+
+	// statistics & search
+	GetElectraStats(ctx context.Context) *ElectraStats
+	GetRollingStats() []*RollingStatsWindow
+	GetParticipationHistory() ([]*dbtypes.ParticipationRollup, uint64)
+	GetGraffitiIndexState(ctx context.Context) uint64
+	GetClientDiversityState(ctx context.Context) (uint64, uint64)
+	GetSearchSuggestions(query string, limit int) []*SearchSuggestion
+}
+
+var _ BeaconService = (*ChainService)(nil)
+
+// BeaconIndexer is the part of the beacon indexer used by the page & api handlers.
+type BeaconIndexer interface {
+	// blocks & chain heads
+	GetBlockByRoot(blockRoot phase0.Root) *beacon.Block
+	GetBlockByStateRoot(stateRoot phase0.Root) *beacon.Block
+	GetBlocksBySlot(slot phase0.Slot) []*beacon.Block
+	GetBlocksByExecutionBlockHash(blockHash phase0.Hash32) []*beacon.Block
+	GetBlocksByExecutionBlockNumber(blockNumber uint64) []*beacon.Block
+	GetBlockDistance(baseRoot phase0.Root, headRoot phase0.Root) (bool, uint64)
+	GetDbBlock(block *beacon.Block) *dbtypes.Slot
+	GetCanonicalHead(overrideForkId *beacon.ForkKey) *beacon.Block
+	IsCanonicalBlock(block *beacon.Block, overrideForkId *beacon.ForkKey) bool
+	GetChainHeads() []*beacon.ChainHead
+	SimulateBlockPacking(block *beacon.Block) *beacon.BlockPackingSimulation
+	SubscribeBlockEvent(capacity int) *consensus.Subscription[*beacon.Block]
+	SubscribeHeadEvent(capacity int) *consensus.Subscription[*beacon.HeadChange]
+
+	// epochs & validators
+	GetEpochStats(epoch phase0.Epoch, overrideForkId *beacon.ForkKey) *beacon.EpochStats
+	GetEpochStatsValues(epoch phase0.Epoch, overrideForkId *beacon.ForkKey) *beacon.EpochStatsValues
+	GetEpochFinalityBreakdown(epoch phase0.Epoch, overrideForkId *beacon.ForkKey, getGroup func(validatorIndex phase0.ValidatorIndex) string) *beacon.EpochFinalityBreakdown
+	GetEpochHeadVotes(epoch phase0.Epoch, overrideForkId *beacon.ForkKey, getGroup func(validatorIndex phase0.ValidatorIndex) string) *beacon.EpochHeadVotes
+	GetEpochSyncRewards(ctx context.Context, epoch phase0.Epoch, overrideForkId *beacon.ForkKey, getGroup func(validatorIndex phase0.ValidatorIndex) string) *beacon.EpochSyncRewards
+	GetValidatorChangesSince(sinceEpoch phase0.Epoch, overrideForkId *beacon.ForkKey) ([]phase0.ValidatorIndex, bool)
+	GetActivityHistoryLength() uint16
+
+	// clients, caches & debugging
+	GetAllClients() []*beacon.Client
+	GetBlockCacheSize() uint64
+	GetBlockCacheState() (finalizedEpoch phase0.Epoch, prunedEpoch phase0.Epoch)
+	GetCacheDebugStats() *beacon.CacheDebugStats
+	GetForkCacheDump() *beacon.ForkCacheDump
+	GetForkCleanupStats() *beacon.ForkCleanupStats
+	GetForkMemoryStats() []*beacon.ForkMemoryStats
+	GetForkMemoryEvictedBodies() uint64
+	GetChainConsistencyState() *beacon.ChainConsistencyState
+	GetChainRepairs() []*beacon.ChainRepair
+	GetCommitteeAnomalies() []*beacon.CommitteeAnomaly
+	GetSlotAnomalies() []*beacon.SlotAnomaly
+	GetEpochTimings() []*beacon.EpochTiming
+	GetSynchronizerState() (running bool, syncHead phase0.Epoch)
+	IsSynchronizerPaused() bool
+	PauseSynchronizer() error
+	ResumeSynchronizer() error
+}
+
+// DepositIndexer is the part of the deposit contract indexer used by the page & api handlers.
+type DepositIndexer interface {
+	GetIndexerProgress() []*execindexer.DepositIndexerProgress
+	GetDepositRootState(contract common.Address) *execindexer.DepositRootState
+	GetSpecsContract() common.Address
+}
+
+// RequestIndexer is the part of the withdrawal & consolidation request indexers used by the page & api handlers.
+type RequestIndexer interface {
+	GetMatcherHeight() uint64
+}
+
+// SystemContractMonitor is the part of the eip-7002/7251 request queue monitor used by the page & api handlers.
+type SystemContractMonitor interface {
+	GetQueueStates() []*execindexer.SystemContractQueueState
+}
+
+// WatchedContractIndexer is the part of the watched contract log indexer used by the page & api handlers.
+type WatchedContractIndexer interface {
+	GetContracts() []*execindexer.WatchedContract
+}
+
+var _ BeaconIndexer = (*beacon.Indexer)(nil)
+var _ DepositIndexer = (*execindexer.DepositIndexer)(nil)
+var _ RequestIndexer = (*execindexer.ConsolidationIndexer)(nil)
+var _ RequestIndexer = (*execindexer.WithdrawalIndexer)(nil)
+var _ SystemContractMonitor = (*execindexer.SystemContractMonitor)(nil)
+var _ WatchedContractIndexer = (*execindexer.WatchedContractIndexer)(nil)
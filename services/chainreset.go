@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// checkGenesisState compares the network genesis with the genesis of the indexed data.
+// on mismatch, the database is reset if enabled in config, otherwise an error is returned.
+func (cs *ChainService) checkGenesisState(genesis *v1.Genesis) error {
+	genesisState := dbtypes.IndexerGenesisState{
+		GenesisTime:           genesis.GenesisTime.Unix(),
+		GenesisValidatorsRoot: fmt.Sprintf("0x%x", genesis.GenesisValidatorsRoot[:]),
+		GenesisForkVersion:    fmt.Sprintf("0x%x", genesis.GenesisForkVersion[:]),
+	}
+
+	storedState := dbtypes.IndexerGenesisState{}
+	if _, err := db.GetExplorerState(context.Background(), "indexer.genesisstate", &storedState); err == nil && storedState.GenesisTime != 0 {
+		if storedState == genesisState {
+			return nil
+		}
+
+		if !utils.Config().Indexer.ResetOnChainReset {
+			return fmt.Errorf("network genesis (%v, %v) does not match indexed data (%v, %v), enable indexer.resetOnChainReset to reset the database automatically", genesisState.GenesisTime, genesisState.GenesisValidatorsRoot, storedState.GenesisTime, storedState.GenesisValidatorsRoot)
+		}
+
+		cs.logger.Warnf("network genesis changed (%v -> %v), resetting database", storedState.GenesisValidatorsRoot, genesisState.GenesisValidatorsRoot)
+		if err := db.ResetDatabase(); err != nil {
+			return fmt.Errorf("failed resetting database: %v", err)
+		}
+	}
+
+	return db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.SetExplorerState("indexer.genesisstate", &genesisState, tx)
+	})
+}
+
+// runChainResetWatcher stops the explorer when all consensus clients moved to a different network genesis.
+// the database gets reset by checkGenesisState on the next start.
+func (cs *ChainService) runChainResetWatcher() {
+	defer utils.HandleSubroutinePanic("ChainService.runChainResetWatcher")
+
+	chainState := cs.consensusPool.GetChainState()
+	var mismatchSince time.Time
+
+	for {
+		time.Sleep(30 * time.Second)
+
+		hasReadyClient := false
+		for _, client := range cs.consensusPool.GetAllEndpoints() {
+			if client.GetStatus() != consensus.ClientStatusOffline {
+				hasReadyClient = true
+				break
+			}
+		}
+
+		mismatchGenesis := chainState.GetMismatchingGenesis()
+		if mismatchGenesis == nil || hasReadyClient {
+			mismatchSince = time.Time{}
+			continue
+		}
+
+		if mismatchSince.IsZero() {
+			mismatchSince = time.Now()
+			cs.logger.Warnf("consensus clients report a different network genesis (%v)", mismatchGenesis.GenesisTime)
+			continue
+		}
+
+		if time.Since(mismatchSince) > 5*time.Minute {
+			cs.logger.Fatalf("network genesis changed (%v -> %v), stopping explorer to reset the database on restart", chainState.GetGenesis().GenesisTime, mismatchGenesis.GenesisTime)
+		}
+	}
+}
@@ -4,8 +4,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"math"
 	"sort"
+	"sync"
 	"time"
 
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
@@ -33,11 +33,22 @@ type ChainService struct {
 	depositIndexer       *execindexer.DepositIndexer
 	consolidationIndexer *execindexer.ConsolidationIndexer
 	withdrawalIndexer    *execindexer.WithdrawalIndexer
+	systemContracts      *execindexer.SystemContractMonitor
+	watchedContracts     *execindexer.WatchedContractIndexer
+	transactionIndexer   *execindexer.TransactionIndexer
 	mevRelayIndexer      *mevrelay.MevIndexer
+	rollingStats         *rollingStats
+	validatorRewards     *validatorRewards
+	validatorIncome      *validatorIncome
+	proposalLuck         *proposalLuck
+	participationHistory *participationHistory
+	searchIndex          *searchIndex
+	withdrawalProjection *withdrawalProjection
+	startMutex           sync.Mutex
 	started              bool
 }
 
-var GlobalBeaconService *ChainService
+var GlobalBeaconService BeaconService
 
 // InitChainService is used to initialize the global beaconchain service
 func InitChainService(ctx context.Context, logger logrus.FieldLogger) {
@@ -65,6 +76,9 @@ func InitChainService(ctx context.Context, logger logrus.FieldLogger) {
 
 // StartService is used to start the beaconchain service
 func (cs *ChainService) StartService() error {
+	cs.startMutex.Lock()
+	defer cs.startMutex.Unlock()
+
 	if cs.started {
 		return fmt.Errorf("service already started")
 	}
@@ -73,12 +87,12 @@ func (cs *ChainService) StartService() error {
 	executionIndexerCtx := execindexer.NewIndexerCtx(cs.logger.WithField("service", "el-indexer"), cs.executionPool, cs.consensusPool, cs.beaconIndexer)
 
 	// add consensus clients
-	for index, endpoint := range utils.Config.BeaconApi.Endpoints {
+	for index, endpoint := range utils.Config().BeaconApi.Endpoints {
 		endpointConfig := &consensus.ClientConfig{
 			URL:        endpoint.Url,
 			Name:       endpoint.Name,
 			Headers:    endpoint.Headers,
-			DisableSSZ: utils.Config.KillSwitch.DisableSSZRequests,
+			DisableSSZ: utils.Config().KillSwitch.DisableSSZRequests,
 		}
 
 		if endpoint.Ssh != nil {
@@ -105,7 +119,7 @@ func (cs *ChainService) StartService() error {
 	}
 
 	// add execution clients
-	for _, endpoint := range utils.Config.ExecutionApi.Endpoints {
+	for _, endpoint := range utils.Config().ExecutionApi.Endpoints {
 		endpointConfig := &execution.ClientConfig{
 			URL:     endpoint.Url,
 			Name:    endpoint.Name,
@@ -132,17 +146,17 @@ func (cs *ChainService) StartService() error {
 	}
 
 	// reset sync state if configured
-	if utils.Config.Indexer.ResyncFromEpoch != nil {
+	if utils.Config().Indexer.ResyncFromEpoch != nil {
 		err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
 			syncState := &dbtypes.IndexerSyncState{
-				Epoch: *utils.Config.Indexer.ResyncFromEpoch,
+				Epoch: *utils.Config().Indexer.ResyncFromEpoch,
 			}
 			return db.SetExplorerState("indexer.syncstate", syncState, tx)
 		})
 		if err != nil {
 			return fmt.Errorf("failed resetting sync state: %v", err)
 		}
-		cs.logger.Warnf("Reset explorer synchronization status to epoch %v as configured! Please remove this setting again.", *utils.Config.Indexer.ResyncFromEpoch)
+		cs.logger.Warnf("Reset explorer synchronization status to epoch %v as configured! Please remove this setting again.", *utils.Config().Indexer.ResyncFromEpoch)
 	}
 
 	// await beacon pool readiness
@@ -162,6 +176,22 @@ func (cs *ChainService) StartService() error {
 		time.Sleep(1 * time.Second)
 	}
 
+	// await a synchronized consensus client while the clients are still syncing,
+	// the indexer would start from the outdated finality checkpoint of a syncing client otherwise.
+	lastLog = time.Now()
+	for cs.isConsensusPoolSyncing() {
+		if time.Since(lastLog) > 30*time.Second {
+			for _, client := range cs.consensusPool.GetAllEndpoints() {
+				if progress := client.GetSyncProgress(); progress != nil {
+					cs.logger.Infof("waiting for synchronized consensus client... %v: head slot %v, %.2f%% synced, eta %v", client.GetName(), progress.HeadSlot, progress.Percent(), progress.Eta.Round(time.Second))
+				}
+			}
+			lastLog = time.Now()
+		}
+
+		time.Sleep(1 * time.Second)
+	}
+
 	specs := chainState.GetSpecs()
 	genesis := chainState.GetGenesis()
 	cs.logger.WithFields(logrus.Fields{
@@ -170,6 +200,15 @@ func (cs *ChainService) StartService() error {
 		"genesis_fork": fmt.Sprintf("%x", genesis.GenesisForkVersion),
 	}).Infof("beacon client pool ready")
 
+	// check indexed data against the network genesis
+	err := cs.checkGenesisState(genesis)
+	if err != nil {
+		return err
+	}
+	if utils.Config().Indexer.ResetOnChainReset {
+		go cs.runChainResetWatcher()
+	}
+
 	// start validator names updater
 	validatorNamesLoading := cs.validatorNames.LoadValidatorNames()
 	<-validatorNamesLoading
@@ -182,10 +221,58 @@ func (cs *ChainService) StartService() error {
 	// start chain indexer
 	cs.beaconIndexer.StartIndexer()
 
+	// start rolling stats aggregation
+	cs.rollingStats = newRollingStats(specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch))
+	go cs.runRollingStatsWorker()
+
+	// start validator rewards index
+	cs.validatorRewards = newValidatorRewards(specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch))
+	go cs.runValidatorRewardsWorker()
+
+	// start validator income index
+	if utils.Config().IncomeIndexer.Enabled {
+		cs.validatorIncome = newValidatorIncome(specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch))
+		go cs.runValidatorIncomeWorker()
+	}
+
+	// start proposal luck aggregation
+	cs.proposalLuck = newProposalLuck(specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch))
+	go cs.runProposalLuckWorker()
+
+	// start participation rollups
+	cs.participationHistory = newParticipationHistory(specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch))
+	go cs.runParticipationHistoryWorker()
+
+	// start graffiti index
+	go cs.runGraffitiIndexWorker()
+
+	// start client diversity classification
+	go cs.runClientDiversityWorker()
+
+	// start search suggestion index
+	cs.searchIndex = newSearchIndex()
+	go cs.runSearchIndexWorker()
+
+	cs.withdrawalProjection = newWithdrawalProjection()
+
+	// start electra stats tracking
+	if specs.ElectraForkEpoch != nil {
+		go cs.runElectraStatsWorker()
+	}
+
 	// add execution indexers
 	cs.depositIndexer = execindexer.NewDepositIndexer(executionIndexerCtx)
 	cs.consolidationIndexer = execindexer.NewConsolidationIndexer(executionIndexerCtx)
 	cs.withdrawalIndexer = execindexer.NewWithdrawalIndexer(executionIndexerCtx)
+	if specs.ElectraForkEpoch != nil {
+		cs.systemContracts = execindexer.NewSystemContractMonitor(executionIndexerCtx)
+	}
+	if len(utils.Config().ExecutionApi.WatchedContracts) > 0 {
+		cs.watchedContracts = execindexer.NewWatchedContractIndexer(executionIndexerCtx)
+	}
+	if utils.Config().ExecutionApi.IndexTransactions {
+		cs.transactionIndexer = execindexer.NewTransactionIndexer(executionIndexerCtx)
+	}
 
 	// start MEV relay indexer
 	cs.mevRelayIndexer.StartUpdater()
@@ -193,18 +280,76 @@ func (cs *ChainService) StartService() error {
 	return nil
 }
 
-func (bs *ChainService) GetBeaconIndexer() *beacon.Indexer {
+// isConsensusPoolSyncing returns true if none of the consensus clients is ready, but at least one is synchronizing.
+func (cs *ChainService) isConsensusPoolSyncing() bool {
+	isSyncing := false
+	for _, client := range cs.consensusPool.GetAllEndpoints() {
+		switch client.GetStatus() {
+		case consensus.ClientStatusOnline, consensus.ClientStatusOptimistic:
+			return false
+		case consensus.ClientStatusSynchronizing:
+			isSyncing = true
+		}
+	}
+	return isSyncing
+}
+
+// StopService is used to stop the beaconchain service and persist the in-memory indexer state
+func (cs *ChainService) StopService() {
+	cs.startMutex.Lock()
+	defer cs.startMutex.Unlock()
+
+	if !cs.started {
+		return
+	}
+
+	cs.beaconIndexer.StopIndexer()
+}
+
+func (bs *ChainService) GetBeaconIndexer() BeaconIndexer {
+	if bs.beaconIndexer == nil {
+		return nil
+	}
 	return bs.beaconIndexer
 }
 
-func (bs *ChainService) GetConsolidationIndexer() *execindexer.ConsolidationIndexer {
+func (bs *ChainService) GetDepositIndexer() DepositIndexer {
+	if bs.depositIndexer == nil {
+		return nil
+	}
+	return bs.depositIndexer
+}
+
+func (bs *ChainService) GetConsolidationIndexer() RequestIndexer {
+	if bs.consolidationIndexer == nil {
+		return nil
+	}
 	return bs.consolidationIndexer
 }
 
-func (bs *ChainService) GetWithdrawalIndexer() *execindexer.WithdrawalIndexer {
+func (bs *ChainService) GetWithdrawalIndexer() RequestIndexer {
+	if bs.withdrawalIndexer == nil {
+		return nil
+	}
 	return bs.withdrawalIndexer
 }
 
+// GetSystemContractMonitor returns the eip-7002/7251 request queue monitor, nil if electra is not scheduled.
+func (bs *ChainService) GetSystemContractMonitor() SystemContractMonitor {
+	if bs.systemContracts == nil {
+		return nil
+	}
+	return bs.systemContracts
+}
+
+// GetWatchedContractIndexer returns the watched contract log indexer, nil if no watched contracts are configured.
+func (bs *ChainService) GetWatchedContractIndexer() WatchedContractIndexer {
+	if bs.watchedContracts == nil {
+		return nil
+	}
+	return bs.watchedContracts
+}
+
 func (bs *ChainService) GetConsensusClients() []*consensus.Client {
 	if bs == nil || bs.consensusPool == nil {
 		return nil
@@ -217,6 +362,11 @@ func (bs *ChainService) GetExecutionClients() []*execution.Client {
 	return bs.executionPool.GetAllEndpoints()
 }
 
+// SubscribeFinalityEvent subscribes to finality checkpoint updates of the consensus clients.
+func (bs *ChainService) SubscribeFinalityEvent(capacity int) *consensus.Subscription[*v1.Finality] {
+	return bs.consensusPool.SubscribeFinalizedEvent(capacity)
+}
+
 func (bs *ChainService) GetChainState() *consensus.ChainState {
 	if bs == nil || bs.consensusPool == nil {
 		return nil
@@ -256,13 +406,31 @@ func (bs *ChainService) GetValidatorName(index uint64) string {
 	return bs.validatorNames.GetValidatorName(index)
 }
 
+// GetValidatorNames returns the names of the given validators in a single lookup, unnamed validators are omitted.
+func (bs *ChainService) GetValidatorNames(indices []uint64) map[uint64]string {
+	return bs.validatorNames.GetValidatorNames(indices)
+}
+
+// ReloadValidatorNames reloads the validator names from the configured yaml & inventory sources.
+func (bs *ChainService) ReloadValidatorNames() chan bool {
+	return bs.validatorNames.LoadValidatorNames()
+}
+
 func (bs *ChainService) GetValidatorNamesCount() uint64 {
 	return bs.validatorNames.GetValidatorNamesCount()
 }
 
-func (bs *ChainService) GetCachedValidatorSet(withBalance bool) []*v1.Validator {
+func (bs *ChainService) ImportValidatorLabels(ctx context.Context, labels map[uint64]string, source string) (int, error) {
+	return bs.validatorNames.ImportValidatorLabels(ctx, labels, source)
+}
+
+func (bs *ChainService) ExportValidatorNames() []*ValidatorNameExport {
+	return bs.validatorNames.ExportValidatorNames()
+}
+
+func (bs *ChainService) GetCachedValidatorSet(withBalance bool) *beacon.ValidatorSetView {
 	currentEpoch := bs.consensusPool.GetChainState().CurrentEpoch()
-	return bs.beaconIndexer.GetEpochValidatorSet(currentEpoch, nil, withBalance)
+	return bs.beaconIndexer.GetEpochValidatorSetView(currentEpoch, nil, withBalance)
 }
 
 func (bs *ChainService) GetValidatorByIndex(index phase0.ValidatorIndex, withBalance bool) *v1.Validator {
@@ -368,33 +536,17 @@ func (bs *ChainService) GetValidatorVotingActivity(validatorIndex phase0.Validat
 	return bs.beaconIndexer.GetValidatorActivity(validatorIndex)
 }
 
+// GetValidatorLiveness returns the number of epochs within the last lookbackEpochs a validator voted for.
+// the epoch bitfield is updated by the indexer while processing blocks, so this doesn't scan the validators activity history.
 func (bs *ChainService) GetValidatorLiveness(validatorIndex phase0.ValidatorIndex, lookbackEpochs uint64) (votedEpochs uint64) {
-	validatorActivity, _ := bs.beaconIndexer.GetValidatorActivity(validatorIndex)
 	chainState := bs.consensusPool.GetChainState()
 
-	latestEpoch := uint64(chainState.CurrentEpoch())
-	if latestEpoch > 2 {
-		latestEpoch -= 2
+	refEpoch := chainState.CurrentEpoch()
+	if refEpoch > 2 {
+		refEpoch -= 2
 	} else {
-		latestEpoch = 0
-	}
-
-	lastEpoch := uint64(math.MaxUint64)
-	for _, activity := range validatorActivity {
-		epoch := uint64(chainState.EpochOfSlot(activity.VoteBlock.Slot - phase0.Slot(activity.VoteDelay)))
-		if latestEpoch < epoch {
-			latestEpoch = epoch
-		} else if epoch+lookbackEpochs <= latestEpoch {
-			break
-		}
-
-		if epoch == lastEpoch {
-			continue
-		}
-
-		lastEpoch = epoch
-		votedEpochs++
+		refEpoch = 0
 	}
 
-	return
+	return bs.beaconIndexer.GetValidatorLiveness(validatorIndex, refEpoch, lookbackEpochs)
 }
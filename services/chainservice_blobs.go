@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer/beacon"
+)
+
+type BlobSidecarEntry struct {
+	Sidecar  *dbtypes.BlobSidecar
+	Orphaned bool
+}
+
+// GetBlobSidecarsByFilter returns the indexed blob sidecars matching the filter, newest first.
+func (bs *ChainService) GetBlobSidecarsByFilter(ctx context.Context, filter *dbtypes.BlobSidecarFilter, pageIdx uint64, pageSize uint32) ([]*BlobSidecarEntry, uint64) {
+	dbSidecars, totalSidecars, err := db.GetBlobSidecarsFiltered(ctx, pageIdx*uint64(pageSize), pageSize, filter)
+	if err != nil {
+		return nil, 0
+	}
+
+	return bs.getBlobSidecarEntries(ctx, dbSidecars), totalSidecars
+}
+
+// GetBlobSidecarsByHash returns the indexed blob sidecars with the given kzg commitment or versioned hash, newest first.
+func (bs *ChainService) GetBlobSidecarsByHash(ctx context.Context, hash []byte) []*BlobSidecarEntry {
+	return bs.getBlobSidecarEntries(ctx, db.GetBlobSidecarsByHash(ctx, hash))
+}
+
+func (bs *ChainService) getBlobSidecarEntries(ctx context.Context, dbSidecars []*dbtypes.BlobSidecar) []*BlobSidecarEntry {
+	blockStatus := map[phase0.Root]dbtypes.SlotStatus{}
+	entries := make([]*BlobSidecarEntry, len(dbSidecars))
+	for idx, dbSidecar := range dbSidecars {
+		blockRoot := phase0.Root(dbSidecar.BlockRoot)
+		status, found := blockStatus[blockRoot]
+		if !found {
+			status = bs.CheckBlockOrphanedStatus(ctx, blockRoot)
+			blockStatus[blockRoot] = status
+		}
+
+		entries[idx] = &BlobSidecarEntry{
+			Sidecar:  dbSidecar,
+			Orphaned: status == dbtypes.Orphaned,
+		}
+	}
+	return entries
+}
+
+// GetBlobContents returns the blob data (without trailing zero bytes) of an indexed blob sidecar.
+// the data is loaded from the blob storage if available, otherwise it's requested from the beacon nodes,
+// which only works as long as the blob is within the blob retention period.
+func (bs *ChainService) GetBlobContents(ctx context.Context, sidecar *dbtypes.BlobSidecar) ([]byte, error) {
+	if storage := bs.beaconIndexer.GetBlobStorage(); storage != nil && sidecar.Stored {
+		data, err := storage.LoadBlob(sidecar.Commitment)
+		if err == nil {
+			return data, nil
+		}
+		bs.logger.Warnf("failed loading blob %x from blob storage: %v", sidecar.Commitment, err)
+	}
+
+	blobSidecar, err := bs.GetBlockBlob(ctx, phase0.Root(sidecar.BlockRoot), deneb.KZGCommitment(sidecar.Commitment))
+	if err != nil {
+		return nil, err
+	}
+	if blobSidecar == nil {
+		return nil, fmt.Errorf("blob not available on the beacon nodes")
+	}
+	return beacon.TrimBlobData(blobSidecar.Blob[:]), nil
+}
@@ -235,12 +235,13 @@ func (bs *ChainService) GetBlobSidecarsByBlockRoot(ctx context.Context, blockroo
 }
 
 // GetDbBlocksForSlots retrieves blocks for a range of slots from cache & database.
+// The ctx parameter cancels the database queries.
 // The firstSlot parameter specifies the starting slot.
 // The slotLimit parameter limits the number of slots to retrieve.
 // The withMissing parameter indicates whether to include missing blocks.
 // The withOrphaned parameter indicates whether to include orphaned blocks.
 // The returned slice contains the retrieved blocks.
-func (bs *ChainService) GetDbBlocksForSlots(firstSlot uint64, slotLimit uint32, withMissing bool, withOrphaned bool) []*dbtypes.Slot {
+func (bs *ChainService) GetDbBlocksForSlots(ctx context.Context, firstSlot uint64, slotLimit uint32, withMissing bool, withOrphaned bool) []*dbtypes.Slot {
 	resBlocks := make([]*dbtypes.Slot, 0)
 
 	chainState := bs.consensusPool.GetChainState()
@@ -406,7 +407,7 @@ func (bs *ChainService) GetDbBlocksForSlots(firstSlot uint64, slotLimit uint32,
 
 		// load selected blocks from db
 		if len(blockRoots) > 0 {
-			blockMap := db.GetSlotsByRoots(blockRoots)
+			blockMap := db.GetSlotsByRoots(ctx, blockRoots)
 			if blockMap != nil {
 				for idx, blockRoot := range blockRoots {
 					if dbBlock, ok := blockMap[phase0.Root(blockRoot)]; ok {
@@ -424,7 +425,7 @@ func (bs *ChainService) GetDbBlocksForSlots(firstSlot uint64, slotLimit uint32,
 
 	// get finalized blocks from db
 	if uint64(slot) > lastSlot {
-		dbBlocks := db.GetSlotsRange(uint64(slot), uint64(lastSlot), withMissing, withOrphaned)
+		dbBlocks := db.GetSlotsRange(ctx, uint64(slot), uint64(lastSlot), withMissing, withOrphaned)
 		for _, dbBlock := range dbBlocks {
 			if withMissing {
 				for ; uint64(slot) > dbBlock.Slot+1; slot-- {
@@ -472,12 +473,13 @@ type cachedDbBlock struct {
 }
 
 // GetDbBlocksByFilter retrieves a filtered range of blocks from cache & database.
+// The ctx parameter cancels the database queries.
 // The filter parameter specifies the filter criteria.
 // The pageIdx parameter specifies the page index.
 // The pageSize parameter specifies the page size.
 // The withScheduledCount parameter specifies the number of scheduled slots to include.
 // The returned slice contains the retrieved blocks.
-func (bs *ChainService) GetDbBlocksByFilter(filter *dbtypes.BlockFilter, pageIdx uint64, pageSize uint32, withScheduledCount uint64) []*dbtypes.AssignedSlot {
+func (bs *ChainService) GetDbBlocksByFilter(ctx context.Context, filter *dbtypes.BlockFilter, pageIdx uint64, pageSize uint32, withScheduledCount uint64) []*dbtypes.AssignedSlot {
 	cachedMatches := make([]cachedDbBlock, 0)
 
 	chainState := bs.consensusPool.GetChainState()
@@ -490,6 +492,13 @@ func (bs *ChainService) GetDbBlocksByFilter(filter *dbtypes.BlockFilter, pageIdx
 	if withScheduledCount > 0 {
 		startSlot += phase0.Slot(withScheduledCount)
 	}
+	if filter.MaxSlot != nil && startSlot > phase0.Slot(*filter.MaxSlot) {
+		startSlot = phase0.Slot(*filter.MaxSlot)
+	}
+	endSlot := finalizedSlot
+	if filter.MinSlot != nil && endSlot < phase0.Slot(*filter.MinSlot) {
+		endSlot = phase0.Slot(*filter.MinSlot)
+	}
 
 	// getCanonicalProposer is a local helper function to get the canonical proposer for a given slot
 	var proposerAssignments map[phase0.Slot]phase0.ValidatorIndex
@@ -519,7 +528,7 @@ func (bs *ChainService) GetDbBlocksByFilter(filter *dbtypes.BlockFilter, pageIdx
 
 	// get blocks from cache
 	// iterate from current slot to finalized slot
-	for slotIdx := int64(startSlot); slotIdx >= int64(finalizedSlot); slotIdx-- {
+	for slotIdx := int64(startSlot); slotIdx >= int64(endSlot); slotIdx-- {
 		slot := phase0.Slot(slotIdx)
 		blocks := bs.beaconIndexer.GetBlocksBySlot(slot)
 		for _, block := range blocks {
@@ -688,7 +697,7 @@ func (bs *ChainService) GetDbBlocksByFilter(filter *dbtypes.BlockFilter, pageIdx
 
 	// load pruned blocks from database
 	if len(blockRoots) > 0 {
-		blockMap := db.GetSlotsByRoots(blockRoots)
+		blockMap := db.GetSlotsByRoots(ctx, blockRoots)
 		if blockMap != nil {
 			for idx, blockRoot := range blockRoots {
 				if dbBlock, ok := blockMap[phase0.Root(blockRoot)]; ok {
@@ -716,16 +725,16 @@ func (bs *ChainService) GetDbBlocksByFilter(filter *dbtypes.BlockFilter, pageIdx
 	dbCacheOffset := uint64(pageSize) - (cachedMatchesLen % uint64(pageSize))
 	var dbBlocks []*dbtypes.AssignedSlot
 	if dbPage == 0 {
-		dbBlocks = db.GetFilteredSlots(filter, uint64(finalizedSlot), 0, uint32(dbCacheOffset)+1)
+		dbBlocks = db.GetFilteredSlots(ctx, filter, uint64(finalizedSlot), 0, uint32(dbCacheOffset)+1)
 	} else {
-		dbBlocks = db.GetFilteredSlots(filter, uint64(finalizedSlot), (dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize+1)
+		dbBlocks = db.GetFilteredSlots(ctx, filter, uint64(finalizedSlot), (dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize+1)
 	}
 	resBlocks = append(resBlocks, dbBlocks...)
 
 	return resBlocks
 }
 
-func (bs *ChainService) GetDbBlocksByParentRoot(parentRoot phase0.Root) []*dbtypes.Slot {
+func (bs *ChainService) GetDbBlocksByParentRoot(ctx context.Context, parentRoot phase0.Root) []*dbtypes.Slot {
 	parentBlock := bs.beaconIndexer.GetBlockByRoot(parentRoot)
 	cachedMatches := bs.beaconIndexer.GetBlockByParentRoot(parentRoot)
 	resBlocks := make([]*dbtypes.Slot, len(cachedMatches))
@@ -733,12 +742,12 @@ func (bs *ChainService) GetDbBlocksByParentRoot(parentRoot phase0.Root) []*dbtyp
 		resBlocks[idx] = block.GetDbBlock(bs.beaconIndexer)
 	}
 	if parentBlock == nil {
-		resBlocks = append(resBlocks, db.GetSlotsByParentRoot(parentRoot[:])...)
+		resBlocks = append(resBlocks, db.GetSlotsByParentRoot(ctx, parentRoot[:])...)
 	}
 	return resBlocks
 }
 
-func (bs *ChainService) CheckBlockOrphanedStatus(blockRoot phase0.Root) dbtypes.SlotStatus {
+func (bs *ChainService) CheckBlockOrphanedStatus(ctx context.Context, blockRoot phase0.Root) dbtypes.SlotStatus {
 	cachedBlock := bs.beaconIndexer.GetBlockByRoot(blockRoot)
 	if cachedBlock != nil {
 		if bs.beaconIndexer.IsCanonicalBlock(cachedBlock, nil) {
@@ -747,7 +756,7 @@ func (bs *ChainService) CheckBlockOrphanedStatus(blockRoot phase0.Root) dbtypes.
 			return dbtypes.Orphaned
 		}
 	}
-	dbRefs := db.GetSlotStatus([][]byte{blockRoot[:]})
+	dbRefs := db.GetSlotStatus(ctx, [][]byte{blockRoot[:]})
 	if len(dbRefs) > 0 {
 		return dbRefs[0].Status
 	}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"strings"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -73,7 +74,7 @@ func (ccr *CombinedConsolidationRequest) TargetPubkey() []byte {
 	return nil
 }
 
-func (bs *ChainService) GetConsolidationRequestsByFilter(filter *CombinedConsolidationRequestFilter, pageIdx uint64, pageSize uint32) ([]*CombinedConsolidationRequest, uint64) {
+func (bs *ChainService) GetConsolidationRequestsByFilter(ctx context.Context, filter *CombinedConsolidationRequestFilter, pageIdx uint64, pageSize uint32) ([]*CombinedConsolidationRequest, uint64) {
 	totalResults := uint64(0)
 	combinedResults := make([]*CombinedConsolidationRequest, 0)
 	canonicalForkIds := bs.GetCanonicalForkIds()
@@ -93,14 +94,14 @@ func (bs *ChainService) GetConsolidationRequestsByFilter(filter *CombinedConsoli
 	var dbOperations []*dbtypes.ConsolidationRequest
 
 	if filter.Request != 1 {
-		dbOperations, totalResults = bs.GetConsolidationRequestOperationsByFilter(filter.Filter, pageIdx, pageSize)
+		dbOperations, totalResults = bs.GetConsolidationRequestOperationsByFilter(ctx, filter.Filter, pageIdx, pageSize)
 		if len(dbOperations) > 0 {
 			initiatedFilter.MinDequeue = dbOperations[0].BlockNumber + 1
 		}
 	}
 
 	if filter.Request != 2 {
-		dbTransactions, totalDbTransactions, _ := db.GetConsolidationRequestTxsFiltered(0, 20, canonicalForkIds, initiatedFilter)
+		dbTransactions, totalDbTransactions, _ := db.GetConsolidationRequestTxsFiltered(ctx, 0, 20, canonicalForkIds, initiatedFilter)
 		totalResults += totalDbTransactions
 
 		for _, consolidation := range dbTransactions {
@@ -124,7 +125,7 @@ func (bs *ChainService) GetConsolidationRequestsByFilter(filter *CombinedConsoli
 				requestTxDetailsFor = append(requestTxDetailsFor, dbOperation.TxHash)
 			} else if matcherHeight := bs.GetConsolidationIndexer().GetMatcherHeight(); dbOperation.BlockNumber > matcherHeight {
 				// consolidation request has not been matched with a tx yet, try to find the tx on the fly
-				requestTxs := db.GetConsolidationRequestTxsByDequeueRange(dbOperation.BlockNumber, dbOperation.BlockNumber)
+				requestTxs := db.GetConsolidationRequestTxsByDequeueRange(ctx, dbOperation.BlockNumber, dbOperation.BlockNumber)
 				if len(requestTxs) > 1 {
 					forkIds := bs.GetParentForkIds(beacon.ForkKey(dbOperation.ForkId))
 					isParentFork := func(forkId uint64) bool {
@@ -159,7 +160,7 @@ func (bs *ChainService) GetConsolidationRequestsByFilter(filter *CombinedConsoli
 
 		// load tx details for consolidation requests
 		if len(requestTxDetailsFor) > 0 {
-			for _, txDetails := range db.GetConsolidationRequestTxsByTxHashes(requestTxDetailsFor) {
+			for _, txDetails := range db.GetConsolidationRequestTxsByTxHashes(ctx, requestTxDetailsFor) {
 				for _, combinedResult := range combinedResults {
 					if combinedResult.Request != nil && bytes.Equal(combinedResult.Request.TxHash, txDetails.TxHash) {
 						combinedResult.Transaction = txDetails
@@ -173,7 +174,7 @@ func (bs *ChainService) GetConsolidationRequestsByFilter(filter *CombinedConsoli
 	return combinedResults, totalResults
 }
 
-func (bs *ChainService) GetConsolidationRequestOperationsByFilter(filter *dbtypes.ConsolidationRequestFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.ConsolidationRequest, uint64) {
+func (bs *ChainService) GetConsolidationRequestOperationsByFilter(ctx context.Context, filter *dbtypes.ConsolidationRequestFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.ConsolidationRequest, uint64) {
 	chainState := bs.consensusPool.GetChainState()
 	_, prunedEpoch := bs.beaconIndexer.GetBlockCacheState()
 	idxMinSlot := chainState.EpochToSlot(prunedEpoch)
@@ -281,12 +282,12 @@ func (bs *ChainService) GetConsolidationRequestOperationsByFilter(filter *dbtype
 
 	if resIdx > int(pageSize) {
 		// all results from cache, just get result count from db
-		_, dbCount, err = db.GetConsolidationRequestsFiltered(0, 1, canonicalForkIds, filter)
+		_, dbCount, err = db.GetConsolidationRequestsFiltered(ctx, 0, 1, canonicalForkIds, filter)
 	} else if dbPage == 0 {
 		// first page, load first `pagesize-cachedResults` items from db
-		dbObjects, dbCount, err = db.GetConsolidationRequestsFiltered(0, uint32(dbCacheOffset), canonicalForkIds, filter)
+		dbObjects, dbCount, err = db.GetConsolidationRequestsFiltered(ctx, 0, uint32(dbCacheOffset), canonicalForkIds, filter)
 	} else {
-		dbObjects, dbCount, err = db.GetConsolidationRequestsFiltered((dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, canonicalForkIds, filter)
+		dbObjects, dbCount, err = db.GetConsolidationRequestsFiltered(ctx, (dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, canonicalForkIds, filter)
 	}
 
 	if err != nil {
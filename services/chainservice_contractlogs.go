@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+type WatchedContractLogEntry struct {
+	Log      *dbtypes.WatchedContractLog
+	Orphaned bool
+}
+
+// GetWatchedContractLogsByFilter returns the indexed logs of the watched contracts matching the filter, newest first.
+func (bs *ChainService) GetWatchedContractLogsByFilter(ctx context.Context, filter *dbtypes.WatchedContractLogFilter, pageIdx uint64, pageSize uint32) ([]*WatchedContractLogEntry, uint64) {
+	canonicalForkIds := bs.GetCanonicalForkIds()
+
+	dbLogs, totalLogs, err := db.GetWatchedContractLogsFiltered(ctx, pageIdx*uint64(pageSize), pageSize, canonicalForkIds, filter)
+	if err != nil {
+		return nil, 0
+	}
+
+	entries := make([]*WatchedContractLogEntry, len(dbLogs))
+	for idx, dbLog := range dbLogs {
+		entries[idx] = &WatchedContractLogEntry{
+			Log:      dbLog,
+			Orphaned: !bs.isCanonicalForkId(dbLog.ForkId, canonicalForkIds),
+		}
+	}
+
+	return entries, totalLogs
+}
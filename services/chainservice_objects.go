@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"strings"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -13,7 +14,7 @@ import (
 	"github.com/ethpandaops/dora/utils"
 )
 
-func (bs *ChainService) GetIncludedDepositsByFilter(filter *dbtypes.DepositFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.Deposit, uint64) {
+func (bs *ChainService) GetIncludedDepositsByFilter(ctx context.Context, filter *dbtypes.DepositFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.Deposit, uint64) {
 	chainState := bs.consensusPool.GetChainState()
 	finalizedBlock, prunedEpoch := bs.beaconIndexer.GetBlockCacheState()
 	idxMinSlot := chainState.EpochToSlot(prunedEpoch)
@@ -112,12 +113,12 @@ func (bs *ChainService) GetIncludedDepositsByFilter(filter *dbtypes.DepositFilte
 
 	if resIdx > int(pageSize) {
 		// all results from cache, just get result count from db
-		_, dbCount, err = db.GetDepositsFiltered(0, 1, uint64(finalizedBlock), filter)
+		_, dbCount, err = db.GetDepositsFiltered(ctx, 0, 1, uint64(finalizedBlock), filter)
 	} else if dbPage == 0 {
 		// first page, load first `pagesize-cachedResults` items from db
-		dbObjects, dbCount, err = db.GetDepositsFiltered(0, uint32(dbCacheOffset), uint64(finalizedBlock), filter)
+		dbObjects, dbCount, err = db.GetDepositsFiltered(ctx, 0, uint32(dbCacheOffset), uint64(finalizedBlock), filter)
 	} else {
-		dbObjects, dbCount, err = db.GetDepositsFiltered((dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, uint64(finalizedBlock), filter)
+		dbObjects, dbCount, err = db.GetDepositsFiltered(ctx, (dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, uint64(finalizedBlock), filter)
 	}
 
 	if err != nil {
@@ -125,7 +126,7 @@ func (bs *ChainService) GetIncludedDepositsByFilter(filter *dbtypes.DepositFilte
 	} else {
 		for idx, dbObject := range dbObjects {
 			if dbObject.SlotNumber > uint64(finalizedBlock) {
-				blockStatus := bs.CheckBlockOrphanedStatus(phase0.Root(dbObject.SlotRoot))
+				blockStatus := bs.CheckBlockOrphanedStatus(ctx, phase0.Root(dbObject.SlotRoot))
 				dbObjects[idx].Orphaned = blockStatus == dbtypes.Orphaned
 			}
 
@@ -145,7 +146,7 @@ func (bs *ChainService) GetIncludedDepositsByFilter(filter *dbtypes.DepositFilte
 	return resObjs, cachedMatchesLen + dbCount
 }
 
-func (bs *ChainService) GetVoluntaryExitsByFilter(filter *dbtypes.VoluntaryExitFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.VoluntaryExit, uint64) {
+func (bs *ChainService) GetVoluntaryExitsByFilter(ctx context.Context, filter *dbtypes.VoluntaryExitFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.VoluntaryExit, uint64) {
 	chainState := bs.consensusPool.GetChainState()
 	finalizedBlock, prunedEpoch := bs.beaconIndexer.GetBlockCacheState()
 	idxMinSlot := chainState.EpochToSlot(prunedEpoch)
@@ -222,12 +223,12 @@ func (bs *ChainService) GetVoluntaryExitsByFilter(filter *dbtypes.VoluntaryExitF
 
 	if resIdx > int(pageSize) {
 		// all results from cache, just get result count from db
-		_, dbCount, err = db.GetVoluntaryExitsFiltered(0, 1, uint64(finalizedBlock), filter)
+		_, dbCount, err = db.GetVoluntaryExitsFiltered(ctx, 0, 1, uint64(finalizedBlock), filter)
 	} else if dbPage == 0 {
 		// first page, load first `pagesize-cachedResults` items from db
-		dbObjects, dbCount, err = db.GetVoluntaryExitsFiltered(0, uint32(dbCacheOffset), uint64(finalizedBlock), filter)
+		dbObjects, dbCount, err = db.GetVoluntaryExitsFiltered(ctx, 0, uint32(dbCacheOffset), uint64(finalizedBlock), filter)
 	} else {
-		dbObjects, dbCount, err = db.GetVoluntaryExitsFiltered((dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, uint64(finalizedBlock), filter)
+		dbObjects, dbCount, err = db.GetVoluntaryExitsFiltered(ctx, (dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, uint64(finalizedBlock), filter)
 	}
 
 	if err != nil {
@@ -235,7 +236,7 @@ func (bs *ChainService) GetVoluntaryExitsByFilter(filter *dbtypes.VoluntaryExitF
 	} else {
 		for idx, dbObject := range dbObjects {
 			if dbObject.SlotNumber > uint64(finalizedBlock) {
-				blockStatus := bs.CheckBlockOrphanedStatus(phase0.Root(dbObject.SlotRoot))
+				blockStatus := bs.CheckBlockOrphanedStatus(ctx, phase0.Root(dbObject.SlotRoot))
 				dbObjects[idx].Orphaned = blockStatus == dbtypes.Orphaned
 			}
 
@@ -255,7 +256,7 @@ func (bs *ChainService) GetVoluntaryExitsByFilter(filter *dbtypes.VoluntaryExitF
 	return resObjs, cachedMatchesLen + dbCount
 }
 
-func (bs *ChainService) GetSlashingsByFilter(filter *dbtypes.SlashingFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.Slashing, uint64) {
+func (bs *ChainService) GetSlashingsByFilter(ctx context.Context, filter *dbtypes.SlashingFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.Slashing, uint64) {
 	chainState := bs.consensusPool.GetChainState()
 	finalizedBlock, prunedEpoch := bs.beaconIndexer.GetBlockCacheState()
 	idxMinSlot := chainState.EpochToSlot(prunedEpoch)
@@ -338,12 +339,12 @@ func (bs *ChainService) GetSlashingsByFilter(filter *dbtypes.SlashingFilter, pag
 
 	if resIdx > int(pageSize) {
 		// all results from cache, just get result count from db
-		_, dbCount, err = db.GetSlashingsFiltered(0, 1, uint64(finalizedBlock), filter)
+		_, dbCount, err = db.GetSlashingsFiltered(ctx, 0, 1, uint64(finalizedBlock), filter)
 	} else if dbPage == 0 {
 		// first page, load first `pagesize-cachedResults` items from db
-		dbObjects, dbCount, err = db.GetSlashingsFiltered(0, uint32(dbCacheOffset), uint64(finalizedBlock), filter)
+		dbObjects, dbCount, err = db.GetSlashingsFiltered(ctx, 0, uint32(dbCacheOffset), uint64(finalizedBlock), filter)
 	} else {
-		dbObjects, dbCount, err = db.GetSlashingsFiltered((dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, uint64(finalizedBlock), filter)
+		dbObjects, dbCount, err = db.GetSlashingsFiltered(ctx, (dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, uint64(finalizedBlock), filter)
 	}
 
 	if err != nil {
@@ -351,7 +352,7 @@ func (bs *ChainService) GetSlashingsByFilter(filter *dbtypes.SlashingFilter, pag
 	} else {
 		for idx, dbObject := range dbObjects {
 			if dbObject.SlotNumber > uint64(finalizedBlock) {
-				blockStatus := bs.CheckBlockOrphanedStatus(phase0.Root(dbObject.SlotRoot))
+				blockStatus := bs.CheckBlockOrphanedStatus(ctx, phase0.Root(dbObject.SlotRoot))
 				dbObjects[idx].Orphaned = blockStatus == dbtypes.Orphaned
 			}
 
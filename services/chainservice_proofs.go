@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethpandaops/dora/clients/execution"
+	"github.com/ethpandaops/dora/clients/execution/rpc"
+)
+
+// StateProof represents a SSZ merkle proof of a beacon state field against the state root.
+type StateProof struct {
+	Slot            phase0.Slot
+	BlockRoot       phase0.Root
+	StateRoot       phase0.Root
+	Version         spec.DataVersion
+	GeneralizedIdx  uint64
+	Leaf            []byte
+	Branch          [][]byte
+	ValidatorsCount uint64
+}
+
+// ExecutionStorageProof represents the eth_getProof result of an execution layer account at the payload of a beacon block.
+type ExecutionStorageProof struct {
+	Slot                 phase0.Slot
+	BlockRoot            phase0.Root
+	StateRoot            phase0.Root
+	ExecutionBlockNumber uint64
+	ExecutionBlockHash   common.Hash
+	ExecutionStateRoot   common.Hash
+	ClientName           string
+	Proof                *rpc.AccountProof
+}
+
+// top level beacon state fields that can be proven, the field positions are the same for all forks
+var stateProofFields = map[string]uint64{
+	"genesis_time":                  0,
+	"genesis_validators_root":       1,
+	"slot":                          2,
+	"fork":                          3,
+	"latest_block_header":           4,
+	"block_roots":                   5,
+	"state_roots":                   6,
+	"historical_roots":              7,
+	"eth1_data":                     8,
+	"eth1_deposit_index":            10,
+	"validators":                    11,
+	"balances":                      12,
+	"randao_mixes":                  13,
+	"slashings":                     14,
+	"justification_bits":            17,
+	"previous_justified_checkpoint": 18,
+	"current_justified_checkpoint":  19,
+	"finalized_checkpoint":          20,
+}
+
+// list limit of the validators & balances lists (VALIDATOR_REGISTRY_LIMIT)
+const stateProofRegistryLimitDepth = 40
+
+// IsStateProofField returns true if the given field name can be proven via GetStateProof.
+func IsStateProofField(field string) bool {
+	if field == "validator" || field == "balance" {
+		return true
+	}
+	_, found := stateProofFields[field]
+	return found
+}
+
+// GetStateProof builds a merkle proof for the requested field of the beacon state of the canonical block at the given finalized slot.
+// the state tree is built by the beacon indexer, which keeps the trees of recently requested states.
+// the "validator" and "balance" fields refer to single entries of the validator registry, selected by index.
+// all other fields refer to the top level state fields listed in stateProofFields.
+func (bs *ChainService) GetStateProof(ctx context.Context, slot phase0.Slot, field string, index uint64) (*StateProof, error) {
+	finalizedEpoch, _ := bs.GetFinalizedEpoch()
+	if slot >= bs.consensusPool.GetChainState().EpochToSlot(finalizedEpoch) {
+		return nil, fmt.Errorf("slot %v is not finalized", slot)
+	}
+
+	blockData, err := bs.GetSlotDetailsBySlot(ctx, slot)
+	if err != nil {
+		return nil, fmt.Errorf("error loading block at slot %v: %v", slot, err)
+	}
+	if blockData == nil || blockData.Header == nil || blockData.Orphaned || blockData.Header.Message.Slot != slot {
+		return nil, fmt.Errorf("no canonical block found at slot %v", slot)
+	}
+
+	stateRoot := blockData.Header.Message.StateRoot
+	tree, err := bs.beaconIndexer.GetStateTree(ctx, stateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldDepth := uint64(bits.Len64(tree.FieldCount - 1))
+	validatorsCount := tree.ValidatorsCount
+
+	var gindex uint64
+	switch field {
+	case "validator":
+		if index >= validatorsCount {
+			return nil, fmt.Errorf("validator %v not found in state", index)
+		}
+		// validators list root -> data root -> validator entry
+		listIdx := (uint64(1) << fieldDepth) + stateProofFields["validators"]
+		gindex = (listIdx*2)<<stateProofRegistryLimitDepth + index
+	case "balance":
+		if index >= validatorsCount {
+			return nil, fmt.Errorf("balance %v not found in state", index)
+		}
+		// balances are packed into chunks of 4 balances
+		listIdx := (uint64(1) << fieldDepth) + stateProofFields["balances"]
+		gindex = (listIdx*2)<<(stateProofRegistryLimitDepth-2) + index/4
+	default:
+		fieldIdx, found := stateProofFields[field]
+		if !found {
+			return nil, fmt.Errorf("unknown state field %v", field)
+		}
+		gindex = (uint64(1) << fieldDepth) + fieldIdx
+	}
+
+	proof, err := tree.Root.Prove(int(gindex))
+	if err != nil {
+		return nil, fmt.Errorf("error building proof: %v", err)
+	}
+
+	return &StateProof{
+		Slot:            slot,
+		BlockRoot:       blockData.Root,
+		StateRoot:       stateRoot,
+		Version:         tree.Version,
+		GeneralizedIdx:  gindex,
+		Leaf:            proof.Leaf,
+		Branch:          proof.Hashes,
+		ValidatorsCount: validatorsCount,
+	}, nil
+}
+
+// GetExecutionStorageProof collects the eth_getProof account & storage proofs of the given account at the execution payload
+// of the canonical block at the given slot. the ready execution clients are tried in random order, as most clients only
+// serve proofs for recent blocks.
+func (bs *ChainService) GetExecutionStorageProof(ctx context.Context, slot phase0.Slot, account common.Address, keys []common.Hash) (*ExecutionStorageProof, error) {
+	blockData, err := bs.GetSlotDetailsBySlot(ctx, slot)
+	if err != nil {
+		return nil, fmt.Errorf("error loading block at slot %v: %v", slot, err)
+	}
+	if blockData == nil || blockData.Block == nil || blockData.Orphaned || blockData.Header.Message.Slot != slot {
+		return nil, fmt.Errorf("no canonical block found at slot %v", slot)
+	}
+
+	blockHash, err := blockData.Block.ExecutionBlockHash()
+	if err != nil {
+		return nil, fmt.Errorf("block at slot %v has no execution payload", slot)
+	}
+	blockNumber, _ := blockData.Block.ExecutionBlockNumber()
+
+	clients := bs.executionPool.GetReadyEndpoints(execution.AnyClient)
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("no execution clients available")
+	}
+
+	var lastErr error
+	for _, client := range clients {
+		header, err := client.GetRPCClient().GetHeaderByHash(ctx, common.Hash(blockHash))
+		if err != nil {
+			lastErr = fmt.Errorf("error loading execution block %v from %v: %v", blockNumber, client.GetName(), err)
+			continue
+		}
+
+		proof, err := client.GetRPCClient().GetProof(ctx, account, keys, common.Hash(blockHash))
+		if err != nil {
+			lastErr = fmt.Errorf("error loading proof from %v: %v", client.GetName(), err)
+			continue
+		}
+
+		return &ExecutionStorageProof{
+			Slot:                 slot,
+			BlockRoot:            blockData.Root,
+			StateRoot:            blockData.Header.Message.StateRoot,
+			ExecutionBlockNumber: blockNumber,
+			ExecutionBlockHash:   common.Hash(blockHash),
+			ExecutionStateRoot:   header.Root,
+			ClientName:           client.GetName(),
+			Proof:                proof,
+		}, nil
+	}
+
+	return nil, lastErr
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"strings"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -63,7 +64,7 @@ func (cwr *CombinedWithdrawalRequest) Amount() uint64 {
 	return 0
 }
 
-func (bs *ChainService) GetWithdrawalRequestsByFilter(filter *CombinedWithdrawalRequestFilter, pageIdx uint64, pageSize uint32) ([]*CombinedWithdrawalRequest, uint64) {
+func (bs *ChainService) GetWithdrawalRequestsByFilter(ctx context.Context, filter *CombinedWithdrawalRequestFilter, pageIdx uint64, pageSize uint32) ([]*CombinedWithdrawalRequest, uint64) {
 	totalResults := uint64(0)
 	combinedResults := make([]*CombinedWithdrawalRequest, 0)
 	canonicalForkIds := bs.GetCanonicalForkIds()
@@ -79,14 +80,14 @@ func (bs *ChainService) GetWithdrawalRequestsByFilter(filter *CombinedWithdrawal
 	var dbOperations []*dbtypes.WithdrawalRequest
 
 	if filter.Request != 1 {
-		dbOperations, totalResults = bs.GetWithdrawalRequestOperationsByFilter(filter.Filter, pageIdx, pageSize)
+		dbOperations, totalResults = bs.GetWithdrawalRequestOperationsByFilter(ctx, filter.Filter, pageIdx, pageSize)
 		if len(dbOperations) > 0 {
 			initiatedFilter.MinDequeue = dbOperations[0].BlockNumber + 1
 		}
 	}
 
 	if filter.Request != 2 {
-		dbTransactions, totalDbTransactions, _ := db.GetWithdrawalRequestTxsFiltered(0, 20, canonicalForkIds, initiatedFilter)
+		dbTransactions, totalDbTransactions, _ := db.GetWithdrawalRequestTxsFiltered(ctx, 0, 20, canonicalForkIds, initiatedFilter)
 		totalResults += totalDbTransactions
 
 		for _, withdrawal := range dbTransactions {
@@ -110,7 +111,7 @@ func (bs *ChainService) GetWithdrawalRequestsByFilter(filter *CombinedWithdrawal
 				requestTxDetailsFor = append(requestTxDetailsFor, dbOperation.TxHash)
 			} else if matcherHeight := bs.GetWithdrawalIndexer().GetMatcherHeight(); dbOperation.BlockNumber > matcherHeight {
 				// withdrawal request has not been matched with a tx yet, try to find the tx on the fly
-				requestTxs := db.GetWithdrawalRequestTxsByDequeueRange(dbOperation.BlockNumber, dbOperation.BlockNumber)
+				requestTxs := db.GetWithdrawalRequestTxsByDequeueRange(ctx, dbOperation.BlockNumber, dbOperation.BlockNumber)
 				if len(requestTxs) > 1 {
 					forkIds := bs.GetParentForkIds(beacon.ForkKey(dbOperation.ForkId))
 					isParentFork := func(forkId uint64) bool {
@@ -145,7 +146,7 @@ func (bs *ChainService) GetWithdrawalRequestsByFilter(filter *CombinedWithdrawal
 
 		// load tx details for withdrawal requests
 		if len(requestTxDetailsFor) > 0 {
-			for _, txDetails := range db.GetWithdrawalRequestTxsByTxHashes(requestTxDetailsFor) {
+			for _, txDetails := range db.GetWithdrawalRequestTxsByTxHashes(ctx, requestTxDetailsFor) {
 				for _, combinedResult := range combinedResults {
 					if combinedResult.Request != nil && bytes.Equal(combinedResult.Request.TxHash, txDetails.TxHash) {
 						combinedResult.Transaction = txDetails
@@ -159,7 +160,7 @@ func (bs *ChainService) GetWithdrawalRequestsByFilter(filter *CombinedWithdrawal
 	return combinedResults, totalResults
 }
 
-func (bs *ChainService) GetWithdrawalRequestOperationsByFilter(filter *dbtypes.WithdrawalRequestFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.WithdrawalRequest, uint64) {
+func (bs *ChainService) GetWithdrawalRequestOperationsByFilter(ctx context.Context, filter *dbtypes.WithdrawalRequestFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.WithdrawalRequest, uint64) {
 	chainState := bs.consensusPool.GetChainState()
 	_, prunedEpoch := bs.beaconIndexer.GetBlockCacheState()
 	idxMinSlot := chainState.EpochToSlot(prunedEpoch)
@@ -251,12 +252,12 @@ func (bs *ChainService) GetWithdrawalRequestOperationsByFilter(filter *dbtypes.W
 
 	if resIdx > int(pageSize) {
 		// all results from cache, just get result count from db
-		_, dbCount, err = db.GetWithdrawalRequestsFiltered(0, 1, canonicalForkIds, filter)
+		_, dbCount, err = db.GetWithdrawalRequestsFiltered(ctx, 0, 1, canonicalForkIds, filter)
 	} else if dbPage == 0 {
 		// first page, load first `pagesize-cachedResults` items from db
-		dbObjects, dbCount, err = db.GetWithdrawalRequestsFiltered(0, uint32(dbCacheOffset), canonicalForkIds, filter)
+		dbObjects, dbCount, err = db.GetWithdrawalRequestsFiltered(ctx, 0, uint32(dbCacheOffset), canonicalForkIds, filter)
 	} else {
-		dbObjects, dbCount, err = db.GetWithdrawalRequestsFiltered((dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, canonicalForkIds, filter)
+		dbObjects, dbCount, err = db.GetWithdrawalRequestsFiltered(ctx, (dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, canonicalForkIds, filter)
 	}
 
 	if err != nil {
@@ -0,0 +1,303 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// clientDiversityBatchSlots is the max. number of slots classified into the client diversity buckets per transaction
+const clientDiversityBatchSlots = 10000
+
+const clientDiversityStateKey = "indexer.clientdiversitystate"
+
+// ClientDiversityUnknown is the client id of blocks that could not be attributed to a client
+const ClientDiversityUnknown = "unknown"
+
+// clientFingerprint describes how a client identifies itself in block graffitis & execution extra data.
+type clientFingerprint struct {
+	client string
+	layer  dbtypes.ClientLayer
+	code   string         // two letter client code of the graffiti client version format (EL code + commit, CL code + commit)
+	name   *regexp.Regexp // pattern of the client name used in graffitis & extra data
+}
+
+var clientFingerprints = []*clientFingerprint{
+	{"lighthouse", dbtypes.ClientLayerConsensus, "LH", regexp.MustCompile(`(?i)\blighthouse\b`)},
+	{"prysm", dbtypes.ClientLayerConsensus, "PM", regexp.MustCompile(`(?i)\bprysm(atic)?\b`)},
+	{"teku", dbtypes.ClientLayerConsensus, "TK", regexp.MustCompile(`(?i)\bteku\b`)},
+	{"nimbus", dbtypes.ClientLayerConsensus, "NB", regexp.MustCompile(`(?i)\bnimbus\b`)},
+	{"lodestar", dbtypes.ClientLayerConsensus, "LS", regexp.MustCompile(`(?i)\blodestar\b`)},
+	{"grandine", dbtypes.ClientLayerConsensus, "GR", regexp.MustCompile(`(?i)\bgrandine\b`)},
+	{"geth", dbtypes.ClientLayerExecution, "GE", regexp.MustCompile(`(?i)\b(geth|go-ethereum)\b`)},
+	{"nethermind", dbtypes.ClientLayerExecution, "NM", regexp.MustCompile(`(?i)\bnethermind\b`)},
+	{"besu", dbtypes.ClientLayerExecution, "BU", regexp.MustCompile(`(?i)\bbesu\b`)},
+	{"erigon", dbtypes.ClientLayerExecution, "EG", regexp.MustCompile(`(?i)\berigon\b`)},
+	{"reth", dbtypes.ClientLayerExecution, "RH", regexp.MustCompile(`(?i)\breth\b`)},
+	{"ethereumjs", dbtypes.ClientLayerExecution, "EJ", regexp.MustCompile(`(?i)\bethereumjs\b`)},
+}
+
+// clientSignatureMinBlocks is the min. number of identified blocks with a gas limit / timing signature before the signature is used to classify other blocks.
+const clientSignatureMinBlocks = 16
+
+// clientSignatureMinShare is the min. share of a single client among the identified blocks with a signature before the signature is attributed to the client.
+const clientSignatureMinShare = 0.9
+
+// clientVersionGraffitiPattern matches the client version graffiti prefix, e.g. "GE168dLH5590".
+var clientVersionGraffitiPattern = regexp.MustCompile(`^([A-Z]{2})[0-9a-f]{0,8}([A-Z]{2})[0-9a-f]{0,8}`)
+
+// classifyBlockClients returns the consensus & execution client of a block proposer.
+// the client version prefix in the graffiti is the most reliable hint, followed by client names in the graffiti.
+// the execution client is also matched against the payload extra data, which only names the client for locally built payloads.
+func classifyBlockClients(graffiti string, extraData []byte) (string, string) {
+	clClient := ClientDiversityUnknown
+	elClient := ClientDiversityUnknown
+
+	if match := clientVersionGraffitiPattern.FindStringSubmatch(graffiti); match != nil {
+		elCode, clCode := "", ""
+		for _, fingerprint := range clientFingerprints {
+			if fingerprint.layer == dbtypes.ClientLayerExecution && fingerprint.code == match[1] {
+				elCode = fingerprint.client
+			}
+			if fingerprint.layer == dbtypes.ClientLayerConsensus && fingerprint.code == match[2] {
+				clCode = fingerprint.client
+			}
+		}
+		if elCode != "" && clCode != "" {
+			return clCode, elCode
+		}
+	}
+
+	for _, fingerprint := range clientFingerprints {
+		switch fingerprint.layer {
+		case dbtypes.ClientLayerConsensus:
+			if clClient == ClientDiversityUnknown && fingerprint.name.MatchString(graffiti) {
+				clClient = fingerprint.client
+			}
+		case dbtypes.ClientLayerExecution:
+			if elClient == ClientDiversityUnknown && (fingerprint.name.MatchString(graffiti) || fingerprint.name.Match(extraData)) {
+				elClient = fingerprint.client
+			}
+		}
+	}
+
+	return clClient, elClient
+}
+
+// getGasLimitSignature returns the gas limit vote of the execution client that built the payload.
+// each block moves the gas limit towards the configured target of the building client, so blocks that raise or lower the gas limit
+// reveal the target of their client. blocks that keep the gas limit are not distinguishable and return an empty signature.
+func getGasLimitSignature(fingerprint *dbtypes.SlotClientFingerprint) string {
+	if fingerprint.GasLimit == 0 || fingerprint.ParentGasLimit == 0 {
+		return ""
+	}
+
+	switch {
+	case fingerprint.GasLimit > fingerprint.ParentGasLimit:
+		return "up"
+	case fingerprint.GasLimit < fingerprint.ParentGasLimit:
+		return "down"
+	default:
+		return ""
+	}
+}
+
+// getAttestationTimingSignature returns the attestation inclusion timing of the block in half slot steps.
+// consensus clients differ in how many attestations of older slots they pack, which shifts the average inclusion delay of their blocks.
+func getAttestationTimingSignature(fingerprint *dbtypes.SlotClientFingerprint) string {
+	if fingerprint.AttestationDelay == 0 {
+		return ""
+	}
+
+	bucket := fingerprint.AttestationDelay / 50
+	if bucket > 8 {
+		bucket = 8
+	}
+	return fmt.Sprintf("%v", bucket)
+}
+
+// clientSignatureModel learns which client produces a signature from the blocks identified by graffiti or extra data.
+// a signature is only attributed to a client that produced nearly all identified blocks with the signature,
+// so signatures that are common to several clients (e.g. during a gas limit increase supported by all clients) stay unattributed.
+type clientSignatureModel struct {
+	counts map[string]map[string]uint64
+}
+
+func newClientSignatureModel() *clientSignatureModel {
+	return &clientSignatureModel{
+		counts: map[string]map[string]uint64{},
+	}
+}
+
+func (model *clientSignatureModel) learn(signature string, client string) {
+	if signature == "" || client == ClientDiversityUnknown {
+		return
+	}
+
+	clientCounts := model.counts[signature]
+	if clientCounts == nil {
+		clientCounts = map[string]uint64{}
+		model.counts[signature] = clientCounts
+	}
+	clientCounts[client]++
+}
+
+func (model *clientSignatureModel) classify(signature string) string {
+	if signature == "" {
+		return ClientDiversityUnknown
+	}
+
+	totalCount := uint64(0)
+	topClient := ClientDiversityUnknown
+	topCount := uint64(0)
+	for client, count := range model.counts[signature] {
+		totalCount += count
+		if count > topCount || (count == topCount && client < topClient) {
+			topClient = client
+			topCount = count
+		}
+	}
+
+	if topCount < clientSignatureMinBlocks || float64(topCount) < float64(totalCount)*clientSignatureMinShare {
+		return ClientDiversityUnknown
+	}
+	return topClient
+}
+
+// classifyBatchClients returns the consensus & execution clients of the given blocks.
+// blocks that can't be identified by graffiti or extra data are matched against the gas limit & attestation timing signatures
+// of the identified blocks in the same batch, as the signatures of a client change with its version & configuration over time.
+func classifyBatchClients(fingerprints []*dbtypes.SlotClientFingerprint) ([]string, []string) {
+	clClients := make([]string, len(fingerprints))
+	elClients := make([]string, len(fingerprints))
+	gasLimitModel := newClientSignatureModel()
+	timingModel := newClientSignatureModel()
+
+	for idx, fingerprint := range fingerprints {
+		clClients[idx], elClients[idx] = classifyBlockClients(fingerprint.GraffitiText, fingerprint.ExtraData)
+		gasLimitModel.learn(getGasLimitSignature(fingerprint), elClients[idx])
+		timingModel.learn(getAttestationTimingSignature(fingerprint), clClients[idx])
+	}
+
+	for idx, fingerprint := range fingerprints {
+		if elClients[idx] == ClientDiversityUnknown {
+			elClients[idx] = gasLimitModel.classify(getGasLimitSignature(fingerprint))
+		}
+		if clClients[idx] == ClientDiversityUnknown {
+			clClients[idx] = timingModel.classify(getAttestationTimingSignature(fingerprint))
+		}
+	}
+
+	return clClients, elClients
+}
+
+// GetClientDiversityState returns the first slot that has not been classified yet and the number of epochs per client diversity bucket.
+func (bs *ChainService) GetClientDiversityState(ctx context.Context) (uint64, uint64) {
+	bucketEpochs := bs.getClientDiversityBucketEpochs()
+	state := dbtypes.ClientDiversityIndexerState{}
+	if _, err := db.GetExplorerState(ctx, clientDiversityStateKey, &state); err != nil {
+		return 0, bucketEpochs
+	}
+	return state.NextSlot, bucketEpochs
+}
+
+// getClientDiversityBucketEpochs returns the number of epochs per client diversity bucket (one day)
+func (bs *ChainService) getClientDiversityBucketEpochs() uint64 {
+	specs := bs.consensusPool.GetChainState().GetSpecs()
+	if specs == nil {
+		return 1
+	}
+	bucketEpochs := uint64(24 * time.Hour / (specs.SecondsPerSlot * time.Duration(specs.SlotsPerEpoch)))
+	if bucketEpochs == 0 {
+		bucketEpochs = 1
+	}
+	return bucketEpochs
+}
+
+// runClientDiversityWorker classifies the proposers of the finalized blocks by client and counts them per day.
+func (bs *ChainService) runClientDiversityWorker() {
+	defer utils.HandleSubroutinePanic("ChainService.runClientDiversityWorker")
+
+	for {
+		if syncRunning, _ := bs.beaconIndexer.GetSynchronizerState(); !syncRunning {
+			err := bs.updateClientDiversity()
+			if err != nil {
+				bs.logger.Warnf("failed updating client diversity: %v", err)
+			}
+		}
+
+		time.Sleep(1 * time.Minute)
+	}
+}
+
+func (bs *ChainService) updateClientDiversity() error {
+	finalizedEpoch, _ := bs.GetFinalizedEpoch()
+	if finalizedEpoch < 1 {
+		return nil
+	}
+
+	// the slots table holds all blocks before the finalized epoch
+	chainState := bs.consensusPool.GetChainState()
+	slotsPerEpoch := chainState.GetSpecs().SlotsPerEpoch
+	maxSlot := uint64(chainState.EpochToSlot(finalizedEpoch)) - 1
+	nextSlot, bucketEpochs := bs.GetClientDiversityState(context.Background())
+
+	for nextSlot <= maxSlot {
+		lastSlot := nextSlot + clientDiversityBatchSlots - 1
+		if lastSlot > maxSlot {
+			lastSlot = maxSlot
+		}
+
+		fingerprints, err := db.GetSlotClientFingerprints(nextSlot, lastSlot)
+		if err != nil {
+			return fmt.Errorf("failed loading blocks of slots %v - %v: %v", nextSlot, lastSlot, err)
+		}
+
+		entryMap := map[string]*dbtypes.ClientDiversityEntry{}
+		entries := []*dbtypes.ClientDiversityEntry{}
+		addEntry := func(bucketEpoch uint64, layer dbtypes.ClientLayer, client string) {
+			entryKey := fmt.Sprintf("%v:%v:%v", bucketEpoch, layer, client)
+			entry := entryMap[entryKey]
+			if entry == nil {
+				entry = &dbtypes.ClientDiversityEntry{
+					BucketEpoch: bucketEpoch,
+					Layer:       layer,
+					Client:      client,
+				}
+				entryMap[entryKey] = entry
+				entries = append(entries, entry)
+			}
+			entry.BlockCount++
+		}
+		clClients, elClients := classifyBatchClients(fingerprints)
+		for idx, fingerprint := range fingerprints {
+			epoch := fingerprint.Slot / slotsPerEpoch
+			bucketEpoch := epoch - epoch%bucketEpochs
+			addEntry(bucketEpoch, dbtypes.ClientLayerConsensus, clClients[idx])
+			addEntry(bucketEpoch, dbtypes.ClientLayerExecution, elClients[idx])
+		}
+
+		err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			if err := db.InsertClientDiversity(entries, tx); err != nil {
+				return err
+			}
+			return db.SetExplorerState(clientDiversityStateKey, &dbtypes.ClientDiversityIndexerState{
+				NextSlot: lastSlot + 1,
+			}, tx)
+		})
+		if err != nil {
+			return fmt.Errorf("failed storing client diversity of slots %v - %v: %v", nextSlot, lastSlot, err)
+		}
+
+		nextSlot = lastSlot + 1
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/clients/execution"
+)
+
+// GuessClientsFromName guesses the consensus & execution client of a validator from its name.
+// devnet validator names usually follow the "<cl>-<el>-<n>" scheme (eg. "lighthouse-geth-1").
+func GuessClientsFromName(name string) (consensus.ClientType, execution.ClientType) {
+	clClient := consensus.UnknownClient
+	elClient := execution.UnknownClient
+
+	nameParts := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, namePart := range nameParts {
+		if clClient == consensus.UnknownClient {
+			if clientType := consensus.ParseClientType(namePart); clientType != consensus.UnknownClient {
+				clClient = clientType
+				continue
+			}
+		}
+		if elClient == execution.UnknownClient {
+			if namePart == "ethereumjs" {
+				namePart = "ethjs"
+			}
+			if clientType := execution.ParseClientType(namePart); clientType != execution.UnknownClient {
+				elClient = clientType
+			}
+		}
+	}
+
+	return clClient, elClient
+}
+
+// GetClientGroupName returns a client pair label ("<cl>-<el>") for the given validator name.
+// returns "unknown" if no client could be detected.
+func GetClientGroupName(name string) string {
+	clClient, elClient := GuessClientsFromName(name)
+
+	switch {
+	case clClient != consensus.UnknownClient && elClient != execution.UnknownClient:
+		return clClient.String() + "-" + elClient.String()
+	case clClient != consensus.UnknownClient:
+		return clClient.String()
+	case elClient != execution.UnknownClient:
+		return elClient.String()
+	default:
+		return "unknown"
+	}
+}
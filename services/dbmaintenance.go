@@ -0,0 +1,276 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const dbMaintenanceCheckInterval = 1 * time.Minute
+
+// DatabaseMaintenance runs the database maintenance (vacuum & analyze) during the configured low-traffic windows.
+type DatabaseMaintenance struct {
+	logger       logrus.FieldLogger
+	loadedConfig *types.Config
+	windows      []*dbMaintenanceWindow
+	interval     time.Duration
+	stateMutex   sync.RWMutex
+	running      bool
+	lastRun      time.Time
+	lastEnd      time.Time
+	lastResult   *db.DatabaseMaintenanceResult
+	lastError    error
+}
+
+// dbMaintenanceWindow is a daily time window, given as offsets from midnight (UTC).
+type dbMaintenanceWindow struct {
+	name  string
+	start time.Duration
+	end   time.Duration
+}
+
+// DatabaseMaintenanceStatus holds the state of the database maintenance scheduler.
+type DatabaseMaintenanceStatus struct {
+	Running     bool
+	Windows     []string
+	Interval    time.Duration
+	LastRun     time.Time
+	LastEnd     time.Time
+	LastSuccess bool
+	LastError   string
+	LastResult  *db.DatabaseMaintenanceResult
+	NextRun     time.Time
+}
+
+var GlobalDatabaseMaintenance *DatabaseMaintenance
+
+// StartDatabaseMaintenance is used to start the global database maintenance scheduler
+func StartDatabaseMaintenance(logger logrus.FieldLogger) error {
+	if GlobalDatabaseMaintenance != nil || !utils.Config().Database.Maintenance.Enabled {
+		return nil
+	}
+
+	maintenance := &DatabaseMaintenance{
+		logger: logger,
+	}
+	if err := maintenance.loadSchedule(); err != nil {
+		return err
+	}
+
+	// restore last run, so restarts within a window do not trigger another run
+	maintenanceState := &dbtypes.DatabaseMaintenanceState{}
+	if _, err := db.GetExplorerState(context.Background(), "dbmaintenance.state", maintenanceState); err == nil && maintenanceState.LastRun > 0 {
+		maintenance.lastRun = time.Unix(maintenanceState.LastRun, 0)
+	}
+
+	GlobalDatabaseMaintenance = maintenance
+	go maintenance.runSchedulerLoop()
+	return nil
+}
+
+// loadSchedule parses the maintenance windows & interval of the active config.
+// the scheduler loop re-runs it when the config got reloaded, an invalid schedule keeps the previous one.
+func (dm *DatabaseMaintenance) loadSchedule() error {
+	loadedConfig := utils.Config()
+	dm.loadedConfig = loadedConfig
+	config := &loadedConfig.Database.Maintenance
+
+	windows := []*dbMaintenanceWindow{}
+	for _, windowStr := range config.Windows {
+		window, err := parseDbMaintenanceWindow(windowStr)
+		if err != nil {
+			return err
+		}
+		windows = append(windows, window)
+	}
+	if len(windows) == 0 {
+		return fmt.Errorf("no database maintenance windows configured")
+	}
+
+	interval := config.Interval
+	if interval == 0 {
+		interval = 24 * time.Hour
+	}
+
+	dm.stateMutex.Lock()
+	dm.windows = windows
+	dm.interval = interval
+	dm.stateMutex.Unlock()
+	return nil
+}
+
+// parseDbMaintenanceWindow parses a "HH:MM-HH:MM" time window, windows may span midnight.
+func parseDbMaintenanceWindow(windowStr string) (*dbMaintenanceWindow, error) {
+	startStr, endStr, found := strings.Cut(strings.TrimSpace(windowStr), "-")
+	if !found {
+		return nil, fmt.Errorf("invalid database maintenance window %v: expected HH:MM-HH:MM", windowStr)
+	}
+
+	startTime, err := time.Parse("15:04", strings.TrimSpace(startStr))
+	if err != nil {
+		return nil, fmt.Errorf("invalid database maintenance window %v: %v", windowStr, err)
+	}
+	endTime, err := time.Parse("15:04", strings.TrimSpace(endStr))
+	if err != nil {
+		return nil, fmt.Errorf("invalid database maintenance window %v: %v", windowStr, err)
+	}
+	if startTime.Equal(endTime) {
+		return nil, fmt.Errorf("invalid database maintenance window %v: empty window", windowStr)
+	}
+
+	midnight := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &dbMaintenanceWindow{
+		name:  windowStr,
+		start: startTime.Sub(midnight),
+		end:   endTime.Sub(midnight),
+	}, nil
+}
+
+func (dm *DatabaseMaintenance) runSchedulerLoop() {
+	defer utils.HandleSubroutinePanic("DatabaseMaintenance.runSchedulerLoop")
+
+	for {
+		if utils.Config() != dm.loadedConfig {
+			if err := dm.loadSchedule(); err != nil {
+				dm.logger.Warnf("failed loading reloaded database maintenance schedule, keeping the previous one: %v", err)
+			}
+		}
+
+		now := time.Now().UTC()
+		windowEnd, active := dm.getActiveWindowEnd(now)
+
+		dm.stateMutex.RLock()
+		lastRun := dm.lastRun
+		interval := dm.interval
+		dm.stateMutex.RUnlock()
+
+		// allow some tolerance, so runs don't drift towards the end of the window
+		if active && now.Sub(lastRun) >= interval-dbMaintenanceCheckInterval {
+			dm.runMaintenance(now, windowEnd)
+		}
+
+		time.Sleep(dbMaintenanceCheckInterval)
+	}
+}
+
+// getActiveWindowEnd returns the end of the maintenance window the given time is in.
+func (dm *DatabaseMaintenance) getActiveWindowEnd(now time.Time) (time.Time, bool) {
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	offset := now.Sub(midnight)
+
+	for _, window := range dm.windows {
+		if window.start < window.end {
+			if offset >= window.start && offset < window.end {
+				return midnight.Add(window.end), true
+			}
+		} else if offset >= window.start {
+			return midnight.Add(24 * time.Hour).Add(window.end), true
+		} else if offset < window.end {
+			return midnight.Add(window.end), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (dm *DatabaseMaintenance) runMaintenance(now time.Time, windowEnd time.Time) {
+	dm.stateMutex.Lock()
+	dm.running = true
+	dm.lastRun = now
+	dm.stateMutex.Unlock()
+
+	dm.logger.Infof("starting database maintenance (window ends %v)", windowEnd.Format("15:04"))
+
+	ctx, cancel := context.WithDeadline(context.Background(), windowEnd)
+	defer cancel()
+
+	result, err := db.RunDatabaseMaintenance(ctx)
+	if err != nil {
+		dm.logger.WithError(err).Errorf("database maintenance failed")
+	} else if !result.Completed {
+		dm.logger.Warnf("database maintenance interrupted at the end of the maintenance window (%v tables, %v pages freed)", len(result.Tables), result.FreedPages)
+	} else {
+		dm.logger.Infof("database maintenance completed in %v (%v tables, %v pages freed)", time.Since(now).Round(time.Second), len(result.Tables), result.FreedPages)
+	}
+
+	success := err == nil && result.Completed
+
+	dm.stateMutex.Lock()
+	dm.running = false
+	dm.lastEnd = time.Now()
+	dm.lastResult = result
+	dm.lastError = err
+	dm.stateMutex.Unlock()
+
+	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.SetExplorerState("dbmaintenance.state", &dbtypes.DatabaseMaintenanceState{
+			LastRun:     now.Unix(),
+			LastSuccess: success,
+		}, tx)
+	})
+	if err != nil {
+		dm.logger.WithError(err).Errorf("failed updating database maintenance state")
+	}
+}
+
+// GetStatus returns the state of the maintenance scheduler, including the last run and the next scheduled run.
+func (dm *DatabaseMaintenance) GetStatus() *DatabaseMaintenanceStatus {
+	dm.stateMutex.RLock()
+	defer dm.stateMutex.RUnlock()
+
+	status := &DatabaseMaintenanceStatus{
+		Running:     dm.running,
+		Windows:     make([]string, len(dm.windows)),
+		Interval:    dm.interval,
+		LastRun:     dm.lastRun,
+		LastEnd:     dm.lastEnd,
+		LastSuccess: dm.lastError == nil && dm.lastResult != nil && dm.lastResult.Completed,
+		LastResult:  dm.lastResult,
+	}
+	for idx, window := range dm.windows {
+		status.Windows[idx] = window.name
+	}
+	if dm.lastError != nil {
+		status.LastError = dm.lastError.Error()
+	}
+
+	if !dm.running {
+		status.NextRun = dm.getNextRunTime()
+	}
+
+	return status
+}
+
+// getNextRunTime returns the earliest time the scheduler will start the next run.
+func (dm *DatabaseMaintenance) getNextRunTime() time.Time {
+	earliest := time.Now().UTC()
+	if nextInterval := dm.lastRun.Add(dm.interval - dbMaintenanceCheckInterval).UTC(); nextInterval.After(earliest) {
+		earliest = nextInterval
+	}
+	if _, active := dm.getActiveWindowEnd(earliest); active {
+		return earliest
+	}
+
+	midnight := time.Date(earliest.Year(), earliest.Month(), earliest.Day(), 0, 0, 0, 0, time.UTC)
+	var nextRun time.Time
+	for _, window := range dm.windows {
+		windowStart := midnight.Add(window.start)
+		if windowStart.Before(earliest) {
+			windowStart = windowStart.Add(24 * time.Hour)
+		}
+		if nextRun.IsZero() || windowStart.Before(nextRun) {
+			nextRun = windowStart
+		}
+	}
+	return nextRun
+}
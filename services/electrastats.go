@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// electraStatsInterval is the number of epochs between two stored electra stats snapshots.
+const electraStatsInterval = 32
+
+// ElectraStats holds the compounding credential adoption & effective balance utilization of the active validator set.
+type ElectraStats struct {
+	dbtypes.ElectraStats
+	EffectiveBalanceBuckets []*ElectraStatsBucket
+}
+
+// ElectraStatsBucket holds the number of compounding validators within an effective balance range.
+type ElectraStatsBucket struct {
+	MinBalance     uint64
+	MaxBalance     uint64
+	ValidatorCount uint64
+	Balance        uint64
+}
+
+// GetElectraStats computes the electra stats from the current validator set.
+// returns nil if electra is not scheduled or not active yet.
+func (bs *ChainService) GetElectraStats(ctx context.Context) *ElectraStats {
+	chainState := bs.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	currentEpoch := chainState.CurrentEpoch()
+	if specs == nil || specs.ElectraForkEpoch == nil || uint64(currentEpoch) < *specs.ElectraForkEpoch {
+		return nil
+	}
+
+	stats := &ElectraStats{
+		ElectraStats: dbtypes.ElectraStats{
+			Epoch: uint64(currentEpoch),
+		},
+	}
+
+	// buckets are set up in ETH, the last bucket is reserved for validators at max effective balance
+	bucketLimits := []uint64{32, 64, 256, 1024}
+	lastLimit := uint64(0)
+	for _, limit := range bucketLimits {
+		limit *= utils.GWEI.Uint64()
+		if limit >= specs.MaxEffectiveBalanceElectra {
+			break
+		}
+		stats.EffectiveBalanceBuckets = append(stats.EffectiveBalanceBuckets, &ElectraStatsBucket{
+			MinBalance: lastLimit + 1,
+			MaxBalance: limit,
+		})
+		lastLimit = limit
+	}
+	stats.EffectiveBalanceBuckets = append(stats.EffectiveBalanceBuckets, &ElectraStatsBucket{
+		MinBalance: lastLimit + 1,
+		MaxBalance: specs.MaxEffectiveBalanceElectra - 1,
+	}, &ElectraStatsBucket{
+		MinBalance: specs.MaxEffectiveBalanceElectra,
+		MaxBalance: specs.MaxEffectiveBalanceElectra,
+	})
+
+	validatorSet := bs.GetCachedValidatorSet(false)
+	for index := 0; index < validatorSet.Len(); index++ {
+		validator, found := validatorSet.GetRecord(phase0.ValidatorIndex(index))
+		if !found || !validator.Status.IsActive() {
+			continue
+		}
+
+		effectiveBalance := uint64(validator.EffectiveBalance)
+		stats.TotalEffectiveBalance += effectiveBalance
+
+		switch validator.WithdrawalCredentials[0] {
+		case 0x00:
+			stats.BlsValidators++
+		case 0x01:
+			stats.ExecValidators++
+		case 0x02:
+			stats.CompoundingValidators++
+			stats.CompoundingBalance += effectiveBalance
+			if effectiveBalance >= specs.MaxEffectiveBalanceElectra {
+				stats.CompoundingMaxEb++
+			}
+
+			for _, bucket := range stats.EffectiveBalanceBuckets {
+				if effectiveBalance <= bucket.MaxBalance {
+					bucket.ValidatorCount++
+					bucket.Balance += effectiveBalance
+					break
+				}
+			}
+		}
+	}
+
+	finalizedEpoch, _ := bs.GetFinalizedEpoch()
+	stats.Consolidations, stats.CredentialSwitches = db.GetConsolidationRequestCounts(ctx, uint64(chainState.EpochToSlot(finalizedEpoch)))
+
+	return stats
+}
+
+// runElectraStatsWorker stores a snapshot of the electra stats every electraStatsInterval epochs to track the adoption over time.
+func (bs *ChainService) runElectraStatsWorker() {
+	defer utils.HandleSubroutinePanic("ChainService.runElectraStatsWorker")
+
+	chainState := bs.consensusPool.GetChainState()
+	lastEpoch := phase0.Epoch(0)
+	if lastStats := db.GetElectraStats(context.Background(), 1); len(lastStats) > 0 {
+		lastEpoch = phase0.Epoch(lastStats[0].Epoch)
+	}
+
+	for {
+		time.Sleep(1 * time.Minute)
+
+		if syncRunning, _ := bs.beaconIndexer.GetSynchronizerState(); syncRunning {
+			continue
+		}
+
+		currentEpoch := chainState.CurrentEpoch()
+		if lastEpoch > 0 && currentEpoch < lastEpoch+electraStatsInterval {
+			continue
+		}
+
+		stats := bs.GetElectraStats(context.Background())
+		if stats == nil || stats.TotalEffectiveBalance == 0 {
+			continue
+		}
+
+		err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			return db.InsertElectraStats(&stats.ElectraStats, tx)
+		})
+		if err != nil {
+			bs.logger.Errorf("failed storing electra stats for epoch %v: %v", stats.Epoch, err)
+			continue
+		}
+
+		lastEpoch = currentEpoch
+	}
+}
@@ -38,14 +38,14 @@ func StartTxSignaturesService() error {
 		return nil
 	}
 
-	concurrencyLimit := utils.Config.TxSignature.ConcurrencyLimit
+	concurrencyLimit := utils.Config().TxSignature.ConcurrencyLimit
 	if concurrencyLimit == 0 {
 		concurrencyLimit = 10
 	}
 
 	GlobalTxSignaturesService = &TxSignaturesService{}
 
-	if !utils.Config.TxSignature.DisableLookupLoop {
+	if !utils.Config().TxSignature.DisableLookupLoop {
 		go GlobalTxSignaturesService.runLookupLoop()
 	}
 	return nil
@@ -104,7 +104,7 @@ func (tss *TxSignaturesService) LookupSignatures(sigBytes []types.TxSignatureByt
 
 	// check unknown signatures in DB (previous failed sig lookups)
 	if len(unresolvedLookups) > 0 {
-		recheckTime := int64(utils.Config.TxSignature.RecheckTimeout.Seconds())
+		recheckTime := int64(utils.Config().TxSignature.RecheckTimeout.Seconds())
 		if recheckTime == 0 {
 			recheckTime = 86400
 		}
@@ -143,7 +143,7 @@ func (tss *TxSignaturesService) LookupSignatures(sigBytes []types.TxSignatureByt
 	}
 
 	// add pending signature lookups
-	if len(unresolvedLookups) > 0 && !utils.Config.TxSignature.DisableLookupLoop {
+	if len(unresolvedLookups) > 0 && !utils.Config().TxSignature.DisableLookupLoop {
 		pendingLookups := make([]*dbtypes.TxPendingFunctionSignature, 0)
 
 		for _, l := range unresolvedLookups {
@@ -170,7 +170,7 @@ func (tss *TxSignaturesService) LookupSignatures(sigBytes []types.TxSignatureByt
 func (tss *TxSignaturesService) runLookupLoop() {
 	defer utils.HandleSubroutinePanic("txsig.loop")
 
-	loopInterval := utils.Config.TxSignature.LookupInterval
+	loopInterval := utils.Config().TxSignature.LookupInterval
 	if loopInterval == 0 {
 		loopInterval = 10 * time.Second
 	}
@@ -188,7 +188,7 @@ func (tss *TxSignaturesService) runLookupLoop() {
 }
 
 func (tss *TxSignaturesService) processPendingSignatures() {
-	batchLimit := utils.Config.TxSignature.LookupBatchSize
+	batchLimit := utils.Config().TxSignature.LookupBatchSize
 	if batchLimit == 0 {
 		batchLimit = 10
 	}
@@ -270,7 +270,7 @@ func (tss *TxSignaturesService) processPendingSignatures() {
 func (tss *TxSignaturesService) lookupSignature(lookup *TxSignaturesLookup) error {
 	var resErr error
 
-	if !utils.Config.TxSignature.Disable4Bytes {
+	if !utils.Config().TxSignature.Disable4Bytes {
 		err := tss.lookup4Bytes(lookup)
 		if err != nil {
 			resErr = fmt.Errorf("4bytes lookup failed: %w", err)
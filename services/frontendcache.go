@@ -4,11 +4,14 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"runtime"
 	"runtime/debug"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethpandaops/dora/cache"
@@ -17,25 +20,61 @@ import (
 	"github.com/timandy/routine"
 )
 
+// pageBuildLockPollInterval is the interval in which the shared cache is checked while another instance builds a page
+const pageBuildLockPollInterval = 250 * time.Millisecond
+
+// asyncPageResultTimeout is the time a finished async page build is kept until the waiting client reloads the page
+const asyncPageResultTimeout = 2 * time.Minute
+
 type FrontendCacheService struct {
 	pageCallCounter      uint64
 	pageCallCounterMutex sync.Mutex
 	tieredCache          *cache.TieredCache
 	processingMutex      sync.Mutex
 	processingDict       map[string]*FrontendCacheProcessingPage
+	asyncBuilds          map[string]*FrontendCacheProcessingPage
 	callStackMutex       sync.RWMutex
 	callStackBuffer      []byte
+
+	cacheHits   atomic.Uint64
+	cacheMisses atomic.Uint64
+	sharedCalls atomic.Uint64
+}
+
+// FrontendCacheStats holds the page call counters of the frontend cache since startup
+type FrontendCacheStats struct {
+	CacheHits   uint64 // page calls served from the local or remote cache
+	CacheMisses uint64 // page calls that had to build the page
+	SharedCalls uint64 // page calls that joined an already running build of the same page
 }
 
 type FrontendCacheProcessingPage struct {
 	CallCtx      context.Context
-	modelMutex   sync.RWMutex
+	callCancel   context.CancelFunc
+	waiterCount  int
+	doneChan     chan bool
 	pageModel    interface{}
 	pageError    error
 	PageKey      string
 	CacheTimeout time.Duration
+
+	startTime      time.Time
+	progressMutex  sync.Mutex
+	progress       float64
+	progressStatus string
+}
+
+// FrontendCacheBuildStatus holds the state of a page build that continues in background, see ProcessCachedPageAsync
+type FrontendCacheBuildStatus struct {
+	BuildId  string  `json:"build_id"`
+	Done     bool    `json:"done"`
+	Elapsed  float64 `json:"elapsed"`  // seconds since the build started
+	Progress float64 `json:"progress"` // percentage, 0 if the page does not report its progress
+	Status   string  `json:"status"`
 }
 
+type frontendCachePageKey struct{}
+
 type PageDataHandlerFn = func(pageCall *FrontendCacheProcessingPage) interface{}
 
 var GlobalFrontendCache *FrontendCacheService
@@ -62,8 +101,8 @@ func StartFrontendCache() error {
 		return nil
 	}
 
-	cachePrefix := fmt.Sprintf("%sgui-", utils.Config.BeaconApi.RedisCachePrefix)
-	tieredCache, err := cache.NewTieredCache(utils.Config.BeaconApi.LocalCacheSize, utils.Config.BeaconApi.RedisCacheAddr, cachePrefix)
+	cachePrefix := fmt.Sprintf("%sgui-", utils.Config().BeaconApi.RedisCachePrefix)
+	tieredCache, err := cache.NewTieredCache(utils.Config().BeaconApi.LocalCacheSize, utils.Config().BeaconApi.RedisCacheAddr, cachePrefix)
 	if err != nil {
 		return err
 	}
@@ -71,50 +110,215 @@ func StartFrontendCache() error {
 	GlobalFrontendCache = &FrontendCacheService{
 		tieredCache:     tieredCache,
 		processingDict:  make(map[string]*FrontendCacheProcessingPage),
+		asyncBuilds:     make(map[string]*FrontendCacheProcessingPage),
 		callStackBuffer: make([]byte, 1024*1024*5),
 	}
 	return nil
 }
 
-func (fc *FrontendCacheService) ProcessCachedPage(pageKey string, caching bool, returnValue interface{}, buildFn PageDataHandlerFn) (interface{}, error) {
+// GetStats returns the page call counters of the frontend cache.
+func (fc *FrontendCacheService) GetStats() *FrontendCacheStats {
+	return &FrontendCacheStats{
+		CacheHits:   fc.cacheHits.Load(),
+		CacheMisses: fc.cacheMisses.Load(),
+		SharedCalls: fc.sharedCalls.Load(),
+	}
+}
+
+// ProcessCachedPage returns the page model from cache or builds it with buildFn.
+// concurrent calls for the same page share a single build, which gets cancelled via pageCall.CallCtx when all requests waiting for it are gone.
+func (fc *FrontendCacheService) ProcessCachedPage(ctx context.Context, pageKey string, caching bool, returnValue interface{}, buildFn PageDataHandlerFn) (interface{}, error) {
 	//fmt.Printf("page call %v (goid: %v)\n", pageKey, utils.Goid())
+	if renderInfo := GetPageRenderInfo(ctx); renderInfo != nil {
+		renderInfo.CacheKey = pageKey
+		defer func() {
+			renderInfo.DataReady = time.Now()
+		}()
+	}
+
+	processingPage := fc.joinPageCall(pageKey, caching, returnValue, buildFn)
+
+	select {
+	case <-processingPage.doneChan:
+		return processingPage.pageModel, processingPage.pageError
+	case <-ctx.Done():
+		fc.releasePageWaiter(processingPage)
+		return nil, ctx.Err()
+	}
+}
+
+// ProcessCachedPageAsync works like ProcessCachedPage, but waits at most waitTime for the page build.
+// slower builds continue in background and only their build status is returned, so the client can show a placeholder and poll GetPageBuildStatus.
+// the finished page is kept for asyncPageResultTimeout and returned to the next call for the same page.
+func (fc *FrontendCacheService) ProcessCachedPageAsync(ctx context.Context, pageKey string, caching bool, returnValue interface{}, buildFn PageDataHandlerFn, waitTime time.Duration) (interface{}, *FrontendCacheBuildStatus, error) {
+	if renderInfo := GetPageRenderInfo(ctx); renderInfo != nil {
+		renderInfo.CacheKey = pageKey
+		defer func() {
+			renderInfo.DataReady = time.Now()
+		}()
+	}
+
+	buildId := getPageBuildId(pageKey)
 
 	fc.processingMutex.Lock()
+	if asyncPage := fc.asyncBuilds[buildId]; asyncPage != nil {
+		select {
+		case <-asyncPage.doneChan:
+			// the result is handed out once, later calls are served from cache
+			delete(fc.asyncBuilds, buildId)
+			fc.processingMutex.Unlock()
+			return asyncPage.pageModel, nil, asyncPage.pageError
+		default:
+			fc.processingMutex.Unlock()
+			return nil, asyncPage.getBuildStatus(buildId), nil
+		}
+	}
+	fc.processingMutex.Unlock()
+
+	processingPage := fc.joinPageCall(pageKey, caching, returnValue, buildFn)
+
+	select {
+	case <-processingPage.doneChan:
+		return processingPage.pageModel, nil, processingPage.pageError
+	case <-ctx.Done():
+		fc.releasePageWaiter(processingPage)
+		return nil, nil, ctx.Err()
+	case <-time.After(waitTime):
+	}
+
+	fc.processingMutex.Lock()
+	defer fc.processingMutex.Unlock()
+
+	if asyncPage := fc.asyncBuilds[buildId]; asyncPage != nil {
+		// a concurrent call already moved the build to background
+		processingPage.waiterCount--
+		return nil, asyncPage.getBuildStatus(buildId), nil
+	}
+
+	// the waiter reference of this call is kept by the background build, so it isn't cancelled when the request ends
+	fc.asyncBuilds[buildId] = processingPage
+	go func() {
+		<-processingPage.doneChan
+		time.Sleep(asyncPageResultTimeout)
+
+		fc.processingMutex.Lock()
+		if fc.asyncBuilds[buildId] == processingPage {
+			delete(fc.asyncBuilds, buildId)
+		}
+		fc.processingMutex.Unlock()
+	}()
+
+	return nil, processingPage.getBuildStatus(buildId), nil
+}
+
+// GetPageBuildStatus returns the status of a page build that has been moved to background by ProcessCachedPageAsync.
+// nil is returned for unknown builds and for builds whose result has already been picked up or expired.
+func (fc *FrontendCacheService) GetPageBuildStatus(buildId string) *FrontendCacheBuildStatus {
+	fc.processingMutex.Lock()
+	asyncPage := fc.asyncBuilds[buildId]
+	fc.processingMutex.Unlock()
+
+	if asyncPage == nil {
+		return nil
+	}
+	return asyncPage.getBuildStatus(buildId)
+}
+
+// SetPageBuildProgress reports the progress (percentage) & current step of the page build running with the given context.
+// the progress is shown on the placeholder of slow async page builds, calls outside of page builds are ignored.
+func SetPageBuildProgress(ctx context.Context, progress float64, status string) {
+	processingPage, ok := ctx.Value(frontendCachePageKey{}).(*FrontendCacheProcessingPage)
+	if !ok {
+		return
+	}
+
+	processingPage.progressMutex.Lock()
+	defer processingPage.progressMutex.Unlock()
+	processingPage.progress = progress
+	processingPage.progressStatus = status
+}
+
+func (page *FrontendCacheProcessingPage) getBuildStatus(buildId string) *FrontendCacheBuildStatus {
+	status := &FrontendCacheBuildStatus{
+		BuildId: buildId,
+		Elapsed: time.Since(page.startTime).Seconds(),
+	}
+
+	select {
+	case <-page.doneChan:
+		status.Done = true
+	default:
+	}
+
+	page.progressMutex.Lock()
+	defer page.progressMutex.Unlock()
+	status.Progress = page.progress
+	status.Status = page.progressStatus
+	return status
+}
+
+// getPageBuildId returns the id of a page build used in the build status polling urls, the page keys might contain user input.
+func getPageBuildId(pageKey string) string {
+	hash := sha256.Sum256([]byte(pageKey))
+	return hex.EncodeToString(hash[:12])
+}
+
+// joinPageCall returns the running build of a page or starts a new one, the caller is registered as waiter of the build.
+func (fc *FrontendCacheService) joinPageCall(pageKey string, caching bool, returnValue interface{}, buildFn PageDataHandlerFn) *FrontendCacheProcessingPage {
+	fc.processingMutex.Lock()
+	defer fc.processingMutex.Unlock()
+
 	processingPage := fc.processingDict[pageKey]
 	if processingPage != nil {
-		fc.processingMutex.Unlock()
 		logrus.Debugf("page already processing: %v", pageKey)
-
-		processingPage.modelMutex.RLock()
-		defer processingPage.modelMutex.RUnlock()
-		return processingPage.pageModel, processingPage.pageError
+		fc.sharedCalls.Add(1)
+	} else {
+		processingPage = &FrontendCacheProcessingPage{
+			doneChan:     make(chan bool),
+			PageKey:      pageKey,
+			CacheTimeout: -1,
+			startTime:    time.Now(),
+		}
+		callCtx, callCancel := context.WithCancel(context.WithValue(context.Background(), frontendCachePageKey{}, processingPage))
+		processingPage.CallCtx = callCtx
+		processingPage.callCancel = callCancel
+		fc.processingDict[pageKey] = processingPage
+
+		go func() {
+			defer fc.completePageLoad(pageKey, processingPage)
+			processingPage.pageModel, processingPage.pageError = fc.processPageCall(pageKey, caching, returnValue, buildFn, processingPage)
+		}()
 	}
-	processingPage = &FrontendCacheProcessingPage{
-		PageKey:      pageKey,
-		CacheTimeout: -1,
+	processingPage.waiterCount++
+	return processingPage
+}
+
+// releasePageWaiter removes an abandoned request from the waiters of a processing page.
+// the page build is cancelled when no request is waiting for it anymore.
+func (fc *FrontendCacheService) releasePageWaiter(processingPage *FrontendCacheProcessingPage) {
+	fc.processingMutex.Lock()
+	defer fc.processingMutex.Unlock()
+
+	processingPage.waiterCount--
+	if processingPage.waiterCount > 0 {
+		return
 	}
-	fc.processingDict[pageKey] = processingPage
-	processingPage.modelMutex.Lock()
-	defer fc.completePageLoad(pageKey, processingPage)
-	fc.processingMutex.Unlock()
 
-	var returnError error
-	returnValue, returnError = fc.processPageCall(pageKey, caching, returnValue, buildFn, processingPage)
-	processingPage.pageModel = returnValue
-	processingPage.pageError = returnError
-	return returnValue, returnError
+	logrus.Debugf("page call abandoned: %v", processingPage.PageKey)
+	processingPage.callCancel()
+
+	// new requests for this page must not join the cancelled build
+	if fc.processingDict[processingPage.PageKey] == processingPage {
+		delete(fc.processingDict, processingPage.PageKey)
+	}
 }
 
 func (fc *FrontendCacheService) processPageCall(pageKey string, caching bool, pageData interface{}, buildFn PageDataHandlerFn, pageCall *FrontendCacheProcessingPage) (interface{}, error) {
 	// process page call with timeout
-	returnChan := make(chan interface{})
-	errorChan := make(chan error)
+	returnChan := make(chan interface{}, 1)
+	errorChan := make(chan error, 1)
 	isTimedOut := false
 
-	callCtx, callCtxCancel := context.WithCancel(context.Background())
-	defer callCtxCancel()
-	pageCall.CallCtx = callCtx
-
 	fc.pageCallCounterMutex.Lock()
 	fc.pageCallCounter++
 	callIdx := fc.pageCallCounter
@@ -122,6 +326,11 @@ func (fc *FrontendCacheService) processPageCall(pageKey string, caching bool, pa
 
 	callGoId := int64(0)
 
+	callTimeout := utils.Config().Frontend.PageCallTimeout
+	if callTimeout == 0 {
+		callTimeout = 30 * time.Second
+	}
+
 	go func(callIdx uint64) {
 		defer func() {
 			if err := recover(); err != nil {
@@ -136,21 +345,43 @@ func (fc *FrontendCacheService) processPageCall(pageKey string, caching bool, pa
 		callGoId = routine.Goid()
 
 		// check cache
-		if !utils.Config.Frontend.Debug && caching && fc.getFrontendCache(pageKey, pageData) == nil {
+		if !utils.Config().Frontend.Debug && caching && fc.getFrontendCache(pageKey, pageData) == nil {
 			logrus.Debugf("page served from cache: %v", pageKey)
+			fc.cacheHits.Add(1)
 			if !isTimedOut {
 				returnChan <- pageData
 			}
 			return
 		}
 
+		// with a shared remote cache only one instance builds the page, the others wait for its result
+		if !utils.Config().Frontend.Debug && caching && fc.tieredCache.HasRemoteCache() {
+			unlockFn, fromCache := fc.acquirePageBuildLock(pageKey, pageData, pageCall, callTimeout)
+			if fromCache {
+				logrus.Debugf("page served from cache after remote build: %v", pageKey)
+				fc.cacheHits.Add(1)
+				if !isTimedOut {
+					returnChan <- pageData
+				}
+				return
+			}
+			if pageCall.CallCtx.Err() != nil {
+				return
+			}
+			if unlockFn != nil {
+				defer unlockFn()
+			}
+		}
+
 		// process page call
+		fc.cacheMisses.Add(1)
 		pageData = buildFn(pageCall)
 
-		if isTimedOut {
+		if isTimedOut || pageCall.CallCtx.Err() != nil {
+			// don't cache results of cancelled calls, they might be incomplete
 			return
 		}
-		if !utils.Config.Frontend.Debug && caching && pageCall.CacheTimeout >= 0 {
+		if !utils.Config().Frontend.Debug && caching && pageCall.CacheTimeout >= 0 {
 			fc.setFrontendCache(pageKey, pageData, pageCall.CacheTimeout)
 		}
 		if !isTimedOut {
@@ -158,19 +389,16 @@ func (fc *FrontendCacheService) processPageCall(pageKey string, caching bool, pa
 		}
 	}(callIdx)
 
-	callTimeout := utils.Config.Frontend.PageCallTimeout
-	if callTimeout == 0 {
-		callTimeout = 30 * time.Second
-	}
-
 	select {
 	case returnValue := <-returnChan:
 		return returnValue, nil
 	case returnError := <-errorChan:
 		return nil, returnError
+	case <-pageCall.CallCtx.Done():
+		return nil, pageCall.CallCtx.Err()
 	case <-time.After(callTimeout):
 		isTimedOut = true
-		callCtxCancel()
+		pageCall.callCancel()
 		return nil, &FrontendCachePageError{
 			name:  "page timeout",
 			err:   fmt.Errorf("page call %v timeout", callIdx),
@@ -179,6 +407,40 @@ func (fc *FrontendCacheService) processPageCall(pageKey string, caching bool, pa
 	}
 }
 
+// acquirePageBuildLock acquires the build lock of a page on the remote cache.
+// while another instance holds the lock, it polls the remote cache for the page and returns true once the page has been loaded from there.
+// a nil unlock function is returned if the lock could not be acquired, in that case the page is built anyway.
+func (fc *FrontendCacheService) acquirePageBuildLock(pageKey string, pageData interface{}, pageCall *FrontendCacheProcessingPage, lockTimeout time.Duration) (func(), bool) {
+	lockKey := fmt.Sprintf("lock:%v", pageKey)
+	for {
+		unlockFn, acquired, err := fc.tieredCache.TryLock(lockKey, lockTimeout)
+		if err != nil {
+			// a duplicate build is better than no page at all
+			logrus.WithError(err).Warnf("error acquiring page build lock: %v", pageKey)
+			return nil, false
+		}
+		if acquired {
+			// the other instance might have stored the page right before releasing the lock
+			if fc.getFrontendCache(pageKey, pageData) == nil {
+				unlockFn()
+				return nil, true
+			}
+			return unlockFn, false
+		}
+
+		logrus.Debugf("page build locked by other instance: %v", pageKey)
+		select {
+		case <-pageCall.CallCtx.Done():
+			return nil, false
+		case <-time.After(pageBuildLockPollInterval):
+		}
+
+		if fc.getFrontendCache(pageKey, pageData) == nil {
+			return nil, true
+		}
+	}
+}
+
 func (fc *FrontendCacheService) getFrontendCache(pageKey string, returnValue interface{}) error {
 	_, err := fc.tieredCache.Get(pageKey, returnValue)
 	return err
@@ -189,10 +451,14 @@ func (fc *FrontendCacheService) setFrontendCache(pageKey string, value interface
 }
 
 func (fc *FrontendCacheService) completePageLoad(pageKey string, processingPage *FrontendCacheProcessingPage) {
-	processingPage.modelMutex.Unlock()
 	fc.processingMutex.Lock()
-	delete(fc.processingDict, pageKey)
+	if fc.processingDict[pageKey] == processingPage {
+		delete(fc.processingDict, pageKey)
+	}
 	fc.processingMutex.Unlock()
+
+	processingPage.callCancel()
+	close(processingPage.doneChan)
 }
 
 func (fc *FrontendCacheService) extractPageCallStack(callGoid int64) string {
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethpandaops/dora/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// GeoIpService resolves peer ips to their country & autonomous system using a local ip range database.
+type GeoIpService struct {
+	loadMutex sync.RWMutex
+	loaded    bool
+	ranges    []*geoIpRange
+}
+
+type geoIpRange struct {
+	start   netip.Addr
+	end     netip.Addr
+	asn     uint64
+	country string
+	asName  string
+}
+
+// GeoIpInfo holds the location & autonomous system of an ip.
+type GeoIpInfo struct {
+	Country string
+	Asn     uint64
+	AsName  string
+}
+
+var GlobalGeoIpService *GeoIpService
+var logger_geo = logrus.StandardLogger().WithField("module", "geoip")
+
+// StartGeoIpService is used to start the global geoip service.
+// the ip range database is loaded in background, lookups return nil until it is loaded.
+func StartGeoIpService() error {
+	if GlobalGeoIpService != nil {
+		return nil
+	}
+
+	GlobalGeoIpService = &GeoIpService{}
+
+	if utils.Config().Frontend.PeerGeoIpDatabase != "" {
+		go func() {
+			err := GlobalGeoIpService.loadDatabase(utils.Config().Frontend.PeerGeoIpDatabase)
+			if err != nil {
+				logger_geo.Errorf("error loading geoip database: %v", err)
+			}
+		}()
+	}
+	return nil
+}
+
+// loadDatabase loads a tab separated ip range file in the ip2asn format:
+// range_start, range_end, as_number, country_code, as_description
+func (gs *GeoIpService) loadDatabase(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gzReader, err := gzip.NewReader(file)
+		if err != nil {
+			return err
+		}
+		defer gzReader.Close()
+		reader = gzReader
+	}
+
+	ranges := []*geoIpRange{}
+	scanner := bufio.NewScanner(reader)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.SplitN(line, "\t", 5)
+		if len(fields) < 4 {
+			return fmt.Errorf("invalid line %v: expected at least 4 fields", lineNum)
+		}
+
+		ipRange := &geoIpRange{}
+		if ipRange.start, err = netip.ParseAddr(fields[0]); err != nil {
+			return fmt.Errorf("invalid range start on line %v: %v", lineNum, err)
+		}
+		if ipRange.end, err = netip.ParseAddr(fields[1]); err != nil {
+			return fmt.Errorf("invalid range end on line %v: %v", lineNum, err)
+		}
+		if ipRange.asn, err = strconv.ParseUint(strings.TrimPrefix(fields[2], "AS"), 10, 64); err != nil {
+			return fmt.Errorf("invalid as number on line %v: %v", lineNum, err)
+		}
+		if ipRange.asn == 0 {
+			continue // not routed
+		}
+		ipRange.start = ipRange.start.Unmap()
+		ipRange.end = ipRange.end.Unmap()
+		ipRange.country = strings.ToUpper(fields[3])
+		if len(fields) > 4 {
+			ipRange.asName = fields[4]
+		}
+
+		ranges = append(ranges, ipRange)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	sort.Slice(ranges, func(a, b int) bool {
+		return ranges[a].start.Less(ranges[b].start)
+	})
+
+	gs.loadMutex.Lock()
+	gs.ranges = ranges
+	gs.loaded = true
+	gs.loadMutex.Unlock()
+
+	logger_geo.Infof("loaded geoip database with %v ip ranges", len(ranges))
+	return nil
+}
+
+// IsLoaded returns true if the ip range database has been loaded.
+func (gs *GeoIpService) IsLoaded() bool {
+	gs.loadMutex.RLock()
+	defer gs.loadMutex.RUnlock()
+	return gs.loaded
+}
+
+// Lookup returns the country & autonomous system of the given ip, or nil if unknown.
+func (gs *GeoIpService) Lookup(ip netip.Addr) *GeoIpInfo {
+	gs.loadMutex.RLock()
+	defer gs.loadMutex.RUnlock()
+
+	ip = ip.Unmap()
+	idx := sort.Search(len(gs.ranges), func(i int) bool {
+		return ip.Less(gs.ranges[i].start)
+	}) - 1
+	if idx < 0 {
+		return nil
+	}
+
+	ipRange := gs.ranges[idx]
+	if ipRange.end.Less(ip) || ipRange.start.Is4() != ip.Is4() {
+		return nil
+	}
+
+	return &GeoIpInfo{
+		Country: ipRange.country,
+		Asn:     ipRange.asn,
+		AsName:  ipRange.asName,
+	}
+}
+
+// AnonymizeIp truncates the ip to the configured prefix length (/24 for ipv4 & /48 for ipv6 by default).
+func AnonymizeIp(ip netip.Addr) netip.Prefix {
+	ip = ip.Unmap()
+
+	bits := utils.Config().Frontend.PeerIpv6Prefix
+	if bits <= 0 || bits > 128 {
+		bits = 48
+	}
+	if ip.Is4() {
+		bits = utils.Config().Frontend.PeerIpv4Prefix
+		if bits <= 0 || bits > 32 {
+			bits = 24
+		}
+	}
+
+	prefix, err := ip.Prefix(bits)
+	if err != nil {
+		return netip.PrefixFrom(ip, ip.BitLen())
+	}
+	return prefix
+}
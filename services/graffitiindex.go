@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// graffitiIndexBatchSlots is the max. number of slots aggregated into the graffiti index per transaction
+const graffitiIndexBatchSlots = 10000
+
+const graffitiIndexStateKey = "indexer.graffitistate"
+
+// GetGraffitiIndexState returns the first slot that has not been added to the graffiti index yet.
+func (bs *ChainService) GetGraffitiIndexState(ctx context.Context) uint64 {
+	state := dbtypes.GraffitiIndexerState{}
+	if _, err := db.GetExplorerState(ctx, graffitiIndexStateKey, &state); err != nil {
+		return 0
+	}
+	return state.NextSlot
+}
+
+// runGraffitiIndexWorker adds the graffitis of the finalized blocks to the graffiti index.
+// only finalized slots are indexed, so the per proposer block counts never need to be corrected for reorgs.
+func (bs *ChainService) runGraffitiIndexWorker() {
+	defer utils.HandleSubroutinePanic("ChainService.runGraffitiIndexWorker")
+
+	for {
+		if syncRunning, _ := bs.beaconIndexer.GetSynchronizerState(); !syncRunning {
+			err := bs.updateGraffitiIndex()
+			if err != nil {
+				bs.logger.Warnf("failed updating graffiti index: %v", err)
+			}
+		}
+
+		time.Sleep(1 * time.Minute)
+	}
+}
+
+func (bs *ChainService) updateGraffitiIndex() error {
+	finalizedEpoch, _ := bs.GetFinalizedEpoch()
+	if finalizedEpoch < 1 {
+		return nil
+	}
+
+	// the slots table holds all blocks before the finalized epoch
+	chainState := bs.consensusPool.GetChainState()
+	maxSlot := uint64(chainState.EpochToSlot(finalizedEpoch)) - 1
+	nextSlot := bs.GetGraffitiIndexState(context.Background())
+
+	for nextSlot <= maxSlot {
+		lastSlot := nextSlot + graffitiIndexBatchSlots - 1
+		if lastSlot > maxSlot {
+			lastSlot = maxSlot
+		}
+
+		entries, err := db.GetSlotGraffitiProposers(nextSlot, lastSlot)
+		if err != nil {
+			return fmt.Errorf("failed loading graffitis of slots %v - %v: %v", nextSlot, lastSlot, err)
+		}
+
+		err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			if err := db.InsertGraffitiProposers(entries, tx); err != nil {
+				return err
+			}
+			return db.SetExplorerState(graffitiIndexStateKey, &dbtypes.GraffitiIndexerState{
+				NextSlot: lastSlot + 1,
+			}, tx)
+		})
+		if err != nil {
+			return fmt.Errorf("failed storing graffitis of slots %v - %v: %v", nextSlot, lastSlot, err)
+		}
+
+		nextSlot = lastSlot + 1
+	}
+
+	return nil
+}
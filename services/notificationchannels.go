@@ -0,0 +1,223 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ethpandaops/dora/types"
+)
+
+// notificationChannel delivers notifications to an external destination
+type notificationChannel interface {
+	sendNotification(notification *Notification) error
+}
+
+var notificationHttpClient = &http.Client{Timeout: 15 * time.Second}
+
+// watchlistWebhookHttpClient is used for the webhook urls registered by users.
+// it only connects to public addresses (checked on the resolved address of every connection), doesn't follow redirects & uses a short timeout.
+var watchlistWebhookHttpClient = &http.Client{
+	Timeout: 5 * time.Second,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: 5 * time.Second,
+			Control: checkWatchlistWebhookDial,
+		}).DialContext,
+		TLSHandshakeTimeout: 5 * time.Second,
+		MaxIdleConnsPerHost: 2,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// nonPublicNetworks are special purpose ranges that are not covered by the net.IP checks in isPublicIP
+var nonPublicNetworks = func() []*net.IPNet {
+	cidrs := []string{
+		"0.0.0.0/8",       // this network
+		"100.64.0.0/10",   // carrier-grade nat
+		"192.0.0.0/24",    // ietf protocol assignments
+		"192.0.2.0/24",    // documentation
+		"198.18.0.0/15",   // benchmarking
+		"198.51.100.0/24", // documentation
+		"203.0.113.0/24",  // documentation
+		"240.0.0.0/4",     // reserved & broadcast
+		"64:ff9b::/96",    // nat64, may map to private ipv4 addresses
+		"2001:db8::/32",   // documentation
+	}
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, _ := net.ParseCIDR(cidr)
+		networks = append(networks, network)
+	}
+	return networks
+}()
+
+// isPublicIP returns false for loopback, private, link-local (incl. cloud metadata endpoints) & other special purpose addresses
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkWatchlistWebhookDial rejects connections of the watchlist webhook client to non-public addresses.
+// it runs after name resolution, so hostnames that resolve (or re-bind) to internal addresses are caught as well.
+func checkWatchlistWebhookDial(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return fmt.Errorf("connection to non-public address %v refused", host)
+	}
+	return nil
+}
+
+// checkWatchlistWebhookHost resolves the host of a webhook url and returns an error if it isn't a public address.
+func checkWatchlistWebhookHost(ctx context.Context, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if !isPublicIP(ip) {
+			return fmt.Errorf("webhook url must point to a public address")
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return fmt.Errorf("could not resolve webhook host %v", host)
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return fmt.Errorf("webhook url must point to a public address")
+		}
+	}
+	return nil
+}
+
+func newNotificationChannel(config *types.NotificationChannelConfig) (notificationChannel, error) {
+	switch config.Type {
+	case "webhook":
+		return &webhookNotificationChannel{config: config}, nil
+	case "telegram":
+		return &telegramNotificationChannel{config: config}, nil
+	case "smtp":
+		return &smtpNotificationChannel{config: config}, nil
+	default:
+		return nil, fmt.Errorf("unknown notification channel type %q", config.Type)
+	}
+}
+
+// checkNotificationResponse returns an error with the start of the response body for non 2xx responses
+func checkNotificationResponse(res *http.Response) error {
+	defer res.Body.Close()
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+	return fmt.Errorf("http %v: %v", res.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// webhookNotificationChannel posts the notification as json to the configured url
+type webhookNotificationChannel struct {
+	config *types.NotificationChannelConfig
+	client *http.Client // defaults to notificationHttpClient
+}
+
+func (ch *webhookNotificationChannel) sendNotification(notification *Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, ch.config.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range ch.config.Headers {
+		req.Header.Set(key, value)
+	}
+
+	client := ch.client
+	if client == nil {
+		client = notificationHttpClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	return checkNotificationResponse(res)
+}
+
+// telegramNotificationChannel sends the notification as plain text message via the telegram bot api
+type telegramNotificationChannel struct {
+	config *types.NotificationChannelConfig
+}
+
+func (ch *telegramNotificationChannel) sendNotification(notification *Notification) error {
+	text := notification.Title + "\n" + notification.Message
+	if notification.Link != "" {
+		text += "\n" + notification.Link
+	}
+
+	res, err := notificationHttpClient.PostForm(fmt.Sprintf("https://api.telegram.org/bot%v/sendMessage", ch.config.BotToken), url.Values{
+		"chat_id":                  {ch.config.ChatId},
+		"text":                     {text},
+		"disable_web_page_preview": {"true"},
+	})
+	if err != nil {
+		// the request url contains the bot token, so don't return the url error as is
+		if urlErr, ok := err.(*url.Error); ok {
+			err = urlErr.Err
+		}
+		return err
+	}
+	return checkNotificationResponse(res)
+}
+
+// smtpNotificationChannel sends the notification as plain text mail
+type smtpNotificationChannel struct {
+	config *types.NotificationChannelConfig
+}
+
+func (ch *smtpNotificationChannel) sendNotification(notification *Notification) error {
+	port := ch.config.SmtpPort
+	if port == 0 {
+		port = 587
+	}
+
+	var auth smtp.Auth
+	if ch.config.SmtpUsername != "" {
+		auth = smtp.PlainAuth("", ch.config.SmtpUsername, ch.config.SmtpPassword, ch.config.SmtpHost)
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %v\r\n", ch.config.SmtpFrom)
+	fmt.Fprintf(&msg, "To: %v\r\n", strings.Join(ch.config.SmtpTo, ", "))
+	fmt.Fprintf(&msg, "Subject: %v\r\n", notification.Title)
+	fmt.Fprintf(&msg, "Date: %v\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprint(&msg, "MIME-Version: 1.0\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&msg, "%v\r\n", notification.Message)
+	if notification.Link != "" {
+		fmt.Fprintf(&msg, "\r\n%v\r\n", notification.Link)
+	}
+
+	return smtp.SendMail(fmt.Sprintf("%v:%v", ch.config.SmtpHost, port), auth, ch.config.SmtpFrom, ch.config.SmtpTo, []byte(msg.String()))
+}
@@ -0,0 +1,715 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	NotificationEventSlashed           = "slashed"
+	NotificationEventOffline           = "offline"
+	NotificationEventOnline            = "online"
+	NotificationEventExit              = "exit"
+	NotificationEventProposal          = "proposal"
+	NotificationEventMissedProposal    = "missed_proposal"
+	NotificationEventWithdrawal        = "withdrawal"
+	NotificationEventWithdrawalRequest = "withdrawal_request"
+	NotificationEventStatusChange      = "status_change"
+)
+
+// notificationMaxCatchupEpochs limits the number of epochs processed after a restart, older events are not sent anymore
+const notificationMaxCatchupEpochs = 32
+
+const notificationStateKey = "notifications.epoch"
+
+// watchlist webhooks are delivered by a few background workers, so slow user endpoints don't delay the configured channels
+const (
+	watchlistWebhookWorkers   = 4
+	watchlistWebhookQueueSize = 256
+)
+
+// NotificationEngine evaluates the configured rules against the indexed events of each completed epoch
+// and delivers the resulting notifications to the configured channels.
+type NotificationEngine struct {
+	logger         logrus.FieldLogger
+	channels       map[string]notificationChannel
+	rules          []*notificationRule
+	loadedConfig   *types.Config
+	processedEpoch phase0.Epoch
+	initialized    bool
+
+	watchlistsUpdated time.Time
+	validatorStatus   map[phase0.ValidatorIndex]v1.ValidatorState
+	webhookQueue      chan *watchlistWebhookDelivery
+}
+
+// watchlistWebhookDelivery is a queued notification for a watchlist webhook
+type watchlistWebhookDelivery struct {
+	notification *Notification
+	logEntry     *dbtypes.NotificationLogEntry
+	channel      notificationChannel
+}
+
+type notificationRule struct {
+	name          string
+	label         string
+	watchlist     string
+	events        map[string]bool
+	offlineEpochs uint64
+	unresolved    []string
+	validators    map[phase0.ValidatorIndex]bool
+	channels      map[string]notificationChannel
+	states        map[phase0.ValidatorIndex]*dbtypes.NotificationValidatorState
+}
+
+// Notification is a validator event matched by a notification rule
+type Notification struct {
+	Rule           string `json:"rule"`
+	Event          string `json:"event"`
+	ValidatorIndex uint64 `json:"validator_index"`
+	ValidatorName  string `json:"validator_name"`
+	Epoch          uint64 `json:"epoch"`
+	Slot           uint64 `json:"slot"`
+	Title          string `json:"title"`
+	Message        string `json:"message"`
+	Link           string `json:"link,omitempty"`
+	Watchlist      string `json:"watchlist,omitempty"`
+	Status         string `json:"status,omitempty"`
+	PreviousStatus string `json:"previous_status,omitempty"`
+}
+
+var GlobalNotificationEngine *NotificationEngine
+
+// StartNotificationEngine is used to start the global notification engine
+func StartNotificationEngine(logger logrus.FieldLogger) error {
+	if GlobalNotificationEngine != nil || (!utils.Config().Notifications.Enabled && !utils.Config().Notifications.WatchlistWebhooks) {
+		return nil
+	}
+
+	engine := &NotificationEngine{
+		logger:          logger,
+		channels:        map[string]notificationChannel{},
+		validatorStatus: map[phase0.ValidatorIndex]v1.ValidatorState{},
+	}
+
+	if utils.Config().Notifications.WatchlistWebhooks {
+		engine.webhookQueue = make(chan *watchlistWebhookDelivery, watchlistWebhookQueueSize)
+		for i := 0; i < watchlistWebhookWorkers; i++ {
+			go engine.runWatchlistWebhookWorker()
+		}
+	}
+
+	if !utils.Config().Notifications.Enabled {
+		// only the rules of the watchlist webhooks are evaluated
+		GlobalNotificationEngine = engine
+		go engine.runNotificationLoop()
+		return nil
+	}
+
+	if err := engine.loadConfigRules(); err != nil {
+		return err
+	}
+
+	GlobalNotificationEngine = engine
+	go engine.runNotificationLoop()
+	return nil
+}
+
+// loadConfigRules builds the channels & rules of the active config, the offline state of the kept rules is preserved.
+// the notification loop re-runs it when the config got reloaded, an invalid config keeps the previous rules.
+func (ne *NotificationEngine) loadConfigRules() error {
+	loadedConfig := utils.Config()
+	ne.loadedConfig = loadedConfig
+
+	channels := map[string]notificationChannel{}
+	for idx := range loadedConfig.Notifications.Channels {
+		channelConfig := &loadedConfig.Notifications.Channels[idx]
+		channel, err := newNotificationChannel(channelConfig)
+		if err != nil {
+			return fmt.Errorf("notification channel %v: %v", channelConfig.Name, err)
+		}
+		channels[channelConfig.Name] = channel
+	}
+
+	currentRules := map[string]*notificationRule{}
+	watchlistRules := []*notificationRule{}
+	for _, rule := range ne.rules {
+		if rule.watchlist == "" {
+			currentRules[rule.name] = rule
+		} else {
+			watchlistRules = append(watchlistRules, rule)
+		}
+	}
+
+	rules := make([]*notificationRule, 0, len(loadedConfig.Notifications.Rules)+len(watchlistRules))
+	for idx := range loadedConfig.Notifications.Rules {
+		ruleConfig := &loadedConfig.Notifications.Rules[idx]
+		rule := &notificationRule{
+			name:          ruleConfig.Name,
+			label:         ruleConfig.Name,
+			events:        map[string]bool{},
+			offlineEpochs: ruleConfig.OfflineEpochs,
+			unresolved:    ruleConfig.Validators,
+			validators:    map[phase0.ValidatorIndex]bool{},
+			channels:      map[string]notificationChannel{},
+			states:        map[phase0.ValidatorIndex]*dbtypes.NotificationValidatorState{},
+		}
+		if rule.offlineEpochs == 0 {
+			rule.offlineEpochs = 2
+		}
+		for _, event := range ruleConfig.Events {
+			rule.events[event] = true
+		}
+		for _, channelName := range ruleConfig.Channels {
+			if channels[channelName] == nil {
+				return fmt.Errorf("notification rule %v: unknown channel %v", ruleConfig.Name, channelName)
+			}
+			rule.channels[channelName] = channels[channelName]
+		}
+
+		// the states of new rules are loaded on initialization, so only rules added by a reload need to load them here
+		if currentRule := currentRules[rule.name]; currentRule != nil && currentRule.events[NotificationEventOffline] {
+			rule.states = currentRule.states
+		} else if ne.initialized {
+			ne.loadRuleStates(rule)
+		}
+		rules = append(rules, rule)
+	}
+
+	ne.channels = channels
+	ne.rules = append(rules, watchlistRules...)
+	return nil
+}
+
+func (ne *NotificationEngine) runNotificationLoop() {
+	defer utils.HandleSubroutinePanic("NotificationEngine.runNotificationLoop")
+
+	for {
+		ne.updateNotifications()
+
+		interval := 12 * time.Second
+		if chainState := GlobalBeaconService.GetChainState(); chainState != nil && chainState.GetSpecs() != nil && chainState.GetSpecs().SecondsPerSlot > 0 {
+			interval = chainState.GetSpecs().SecondsPerSlot
+		}
+		time.Sleep(interval)
+	}
+}
+
+func (ne *NotificationEngine) updateNotifications() {
+	chainState := GlobalBeaconService.GetChainState()
+	if chainState == nil || chainState.GetSpecs() == nil || GlobalBeaconService.GetBeaconIndexer() == nil {
+		return
+	}
+
+	if config := utils.Config(); config.Notifications.Enabled && config != ne.loadedConfig {
+		if err := ne.loadConfigRules(); err != nil {
+			ne.logger.Warnf("failed loading reloaded notification rules, keeping the previous rules: %v", err)
+		}
+	}
+
+	for _, rule := range ne.rules {
+		ne.resolveValidators(rule)
+	}
+
+	// votes for an epoch can be included until the end of the next epoch
+	currentEpoch := chainState.CurrentEpoch()
+	if currentEpoch < 2 {
+		return
+	}
+	checkEpoch := currentEpoch - 2
+
+	if !ne.initialized {
+		ne.processedEpoch = checkEpoch
+		var processedEpoch uint64
+		if _, err := db.GetExplorerState(context.Background(), notificationStateKey, &processedEpoch); err == nil && processedEpoch < uint64(checkEpoch) {
+			ne.processedEpoch = phase0.Epoch(processedEpoch)
+		}
+		if checkEpoch-ne.processedEpoch > notificationMaxCatchupEpochs {
+			ne.logger.Warnf("skipping notifications for epochs %v - %v", ne.processedEpoch+1, checkEpoch-notificationMaxCatchupEpochs)
+			ne.processedEpoch = checkEpoch - notificationMaxCatchupEpochs
+		}
+
+		for _, rule := range ne.rules {
+			ne.loadRuleStates(rule)
+		}
+		ne.initialized = true
+	}
+
+	if utils.Config().Notifications.WatchlistWebhooks && time.Since(ne.watchlistsUpdated) >= time.Minute {
+		ne.updateWatchlistRules()
+	}
+
+	for ne.processedEpoch < checkEpoch {
+		err := ne.processEpoch(ne.processedEpoch + 1)
+		if err != nil {
+			ne.logger.Warnf("failed processing notifications for epoch %v: %v", ne.processedEpoch+1, err)
+			return
+		}
+		ne.processedEpoch++
+	}
+}
+
+// loadRuleStates restores the offline tracking state of a rule from the db.
+func (ne *NotificationEngine) loadRuleStates(rule *notificationRule) {
+	if !rule.events[NotificationEventOffline] {
+		return
+	}
+	for _, state := range db.GetNotificationValidatorStates(rule.name) {
+		rule.states[phase0.ValidatorIndex(state.ValidatorIndex)] = state
+	}
+}
+
+// updateWatchlistRules rebuilds the rules of the registered watchlist webhooks, so changes to the watchlists are picked up.
+// every webhook is a separate rule with the webhook as only channel, the offline state of existing rules is kept.
+func (ne *NotificationEngine) updateWatchlistRules() {
+	ne.watchlistsUpdated = time.Now()
+
+	watchlistRules := map[string]*notificationRule{}
+	rules := make([]*notificationRule, 0, len(ne.rules))
+	for _, rule := range ne.rules {
+		if rule.watchlist == "" {
+			rules = append(rules, rule)
+		} else {
+			watchlistRules[rule.name] = rule
+		}
+	}
+
+	sessionWatchlists := map[string]map[string][]uint64{}
+	for _, webhook := range db.GetAllValidatorWatchlistWebhooks() {
+		watchlists := sessionWatchlists[string(webhook.SessionHash)]
+		if watchlists == nil {
+			watchlists = map[string][]uint64{}
+			for _, entry := range db.GetValidatorWatchlistEntries(context.Background(), webhook.SessionHash) {
+				watchlists[entry.ListName] = append(watchlists[entry.ListName], entry.ValidatorIndex)
+			}
+			sessionWatchlists[string(webhook.SessionHash)] = watchlists
+		}
+		if len(watchlists[webhook.ListName]) == 0 {
+			continue
+		}
+
+		ruleHash := sha256.Sum256([]byte(fmt.Sprintf("%x/%v/%v", webhook.SessionHash, webhook.ListName, webhook.Url)))
+		ruleName := fmt.Sprintf("watchlist:%x", ruleHash[:8])
+		rule := watchlistRules[ruleName]
+		isNew := rule == nil
+		if isNew {
+			rule = &notificationRule{
+				name:      ruleName,
+				label:     webhook.ListName,
+				watchlist: webhook.ListName,
+				channels: map[string]notificationChannel{
+					"webhook": &webhookNotificationChannel{
+						config: &types.NotificationChannelConfig{
+							Name: "webhook",
+							Type: "webhook",
+							Url:  webhook.Url,
+						},
+						client: watchlistWebhookHttpClient,
+					},
+				},
+				states: map[phase0.ValidatorIndex]*dbtypes.NotificationValidatorState{},
+			}
+		}
+
+		rule.events = map[string]bool{}
+		for _, event := range strings.Split(webhook.Events, ",") {
+			rule.events[event] = true
+		}
+		rule.offlineEpochs = webhook.OfflineEpochs
+		rule.validators = map[phase0.ValidatorIndex]bool{}
+		for _, validatorIndex := range watchlists[webhook.ListName] {
+			rule.validators[phase0.ValidatorIndex(validatorIndex)] = true
+		}
+		if isNew {
+			ne.loadRuleStates(rule)
+		}
+		rules = append(rules, rule)
+	}
+
+	ne.rules = rules
+}
+
+// resolveValidators resolves the validator indexes & pubkeys of a rule, unknown pubkeys are retried on the next update.
+func (ne *NotificationEngine) resolveValidators(rule *notificationRule) {
+	if len(rule.unresolved) == 0 {
+		return
+	}
+
+	unresolved := []string{}
+	for _, validatorStr := range rule.unresolved {
+		validatorStr = strings.TrimSpace(validatorStr)
+		if validatorStr == "" {
+			continue
+		}
+
+		if strings.HasPrefix(validatorStr, "0x") {
+			pubkeyBytes, err := hex.DecodeString(validatorStr[2:])
+			if err != nil || len(pubkeyBytes) != len(phase0.BLSPubKey{}) {
+				ne.logger.Warnf("invalid validator pubkey in notification rule %v: %v", rule.name, validatorStr)
+				continue
+			}
+
+			index, found := GlobalBeaconService.GetValidatorIndexByPubkey(phase0.BLSPubKey(pubkeyBytes))
+			if !found {
+				unresolved = append(unresolved, validatorStr)
+				continue
+			}
+			rule.validators[index] = true
+		} else {
+			index, err := strconv.ParseUint(validatorStr, 10, 64)
+			if err != nil {
+				ne.logger.Warnf("invalid validator index in notification rule %v: %v", rule.name, validatorStr)
+				continue
+			}
+			rule.validators[phase0.ValidatorIndex(index)] = true
+		}
+	}
+	rule.unresolved = unresolved
+}
+
+// processEpoch evaluates all rules against the events of a completed epoch and sends the matching notifications.
+func (ne *NotificationEngine) processEpoch(epoch phase0.Epoch) error {
+	chainState := GlobalBeaconService.GetChainState()
+	firstSlot := chainState.EpochToSlot(epoch)
+	lastSlot := chainState.EpochToSlot(epoch+1) - 1
+
+	notifications := []*Notification{}
+	addEvent := func(event string, validatorIndex uint64, slot phase0.Slot, message string, link string) {
+		for _, rule := range ne.rules {
+			if !rule.events[event] && !(event == NotificationEventOnline && rule.events[NotificationEventOffline]) {
+				continue
+			}
+			if !rule.validators[phase0.ValidatorIndex(validatorIndex)] {
+				continue
+			}
+			notifications = append(notifications, ne.buildNotification(rule, event, validatorIndex, epoch, slot, message, link))
+		}
+	}
+
+	if ne.hasRulesFor(NotificationEventSlashed) {
+		slashings, _ := GlobalBeaconService.GetSlashingsByFilter(context.Background(), &dbtypes.SlashingFilter{
+			MinSlot: uint64(firstSlot),
+			MaxSlot: uint64(lastSlot),
+		}, 0, 1000)
+		for _, slashing := range slashings {
+			reason := "slashed"
+			switch slashing.Reason {
+			case dbtypes.ProposerSlashing:
+				reason = "slashed for a proposer violation"
+			case dbtypes.AttesterSlashing:
+				reason = "slashed for an attester violation"
+			}
+			addEvent(NotificationEventSlashed, slashing.ValidatorIndex, phase0.Slot(slashing.SlotNumber), fmt.Sprintf("was %v in slot %v", reason, slashing.SlotNumber), fmt.Sprintf("/slot/%v", slashing.SlotNumber))
+		}
+	}
+
+	if ne.hasRulesFor(NotificationEventExit) {
+		exits, _ := GlobalBeaconService.GetVoluntaryExitsByFilter(context.Background(), &dbtypes.VoluntaryExitFilter{
+			MinSlot: uint64(firstSlot),
+			MaxSlot: uint64(lastSlot),
+		}, 0, 1000)
+		for _, exit := range exits {
+			addEvent(NotificationEventExit, exit.ValidatorIndex, phase0.Slot(exit.SlotNumber), fmt.Sprintf("voluntary exit included in slot %v", exit.SlotNumber), fmt.Sprintf("/slot/%v", exit.SlotNumber))
+		}
+	}
+
+	if ne.hasRulesFor(NotificationEventWithdrawalRequest) {
+		requests, _ := GlobalBeaconService.GetWithdrawalRequestOperationsByFilter(context.Background(), &dbtypes.WithdrawalRequestFilter{
+			MinSlot: uint64(firstSlot),
+			MaxSlot: uint64(lastSlot),
+		}, 0, 1000)
+		for _, request := range requests {
+			if request.ValidatorIndex == nil {
+				continue
+			}
+			amount := "full exit"
+			if request.Amount > 0 {
+				amount = utils.FormatETHFromGwei(request.Amount)
+			}
+			addEvent(NotificationEventWithdrawalRequest, *request.ValidatorIndex, phase0.Slot(request.SlotNumber), fmt.Sprintf("withdrawal request (%v) included in slot %v", amount, request.SlotNumber), fmt.Sprintf("/slot/%v", request.SlotNumber))
+		}
+	}
+
+	hasWithdrawalRules := ne.hasRulesFor(NotificationEventWithdrawal)
+	if ne.hasRulesFor(NotificationEventProposal) || ne.hasRulesFor(NotificationEventMissedProposal) || hasWithdrawalRules {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		blocks := GlobalBeaconService.GetDbBlocksForSlots(ctx, uint64(lastSlot), uint32(lastSlot-firstSlot+1), true, false)
+
+		for _, block := range blocks {
+			if block.Slot < uint64(firstSlot) || block.Slot > uint64(lastSlot) {
+				continue
+			}
+			switch block.Status {
+			case dbtypes.Canonical:
+				addEvent(NotificationEventProposal, block.Proposer, phase0.Slot(block.Slot), fmt.Sprintf("proposed the block in slot %v", block.Slot), fmt.Sprintf("/slot/%v", block.Slot))
+				if hasWithdrawalRules {
+					ne.addWithdrawalEvents(ctx, block, addEvent)
+				}
+			case dbtypes.Missing:
+				addEvent(NotificationEventMissedProposal, block.Proposer, phase0.Slot(block.Slot), fmt.Sprintf("missed the block proposal in slot %v", block.Slot), fmt.Sprintf("/slot/%v", block.Slot))
+			}
+		}
+		cancel()
+	}
+
+	if ne.hasRulesFor(NotificationEventStatusChange) {
+		notifications = append(notifications, ne.checkStatusChanges(epoch, firstSlot)...)
+	}
+
+	changedStates := []*dbtypes.NotificationValidatorState{}
+	if ne.hasRulesFor(NotificationEventOffline) {
+		epochStatsValues := GlobalBeaconService.GetBeaconIndexer().GetEpochStats(epoch, nil).GetValues(true)
+		if epochStatsValues == nil {
+			return fmt.Errorf("epoch stats not available")
+		}
+
+		for _, rule := range ne.rules {
+			if !rule.events[NotificationEventOffline] {
+				continue
+			}
+
+			for validatorIndex := range rule.validators {
+				activeIdx := sort.Search(len(epochStatsValues.ActiveIndices), func(i int) bool {
+					return epochStatsValues.ActiveIndices[i] >= validatorIndex
+				})
+				if activeIdx >= len(epochStatsValues.ActiveIndices) || epochStatsValues.ActiveIndices[activeIdx] != validatorIndex {
+					continue
+				}
+
+				voted := false
+				activity, _ := GlobalBeaconService.GetValidatorVotingActivity(validatorIndex)
+				for _, vote := range activity {
+					if chainState.EpochOfSlot(vote.VoteBlock.Slot-phase0.Slot(vote.VoteDelay)) == epoch {
+						voted = true
+						break
+					}
+				}
+
+				state := rule.states[validatorIndex]
+				if state == nil {
+					state = &dbtypes.NotificationValidatorState{
+						RuleName:       rule.name,
+						ValidatorIndex: uint64(validatorIndex),
+					}
+					rule.states[validatorIndex] = state
+				}
+
+				switch {
+				case voted && state.MissedEpochs == 0:
+					continue
+				case voted:
+					if state.Offline {
+						notifications = append(notifications, ne.buildNotification(rule, NotificationEventOnline, uint64(validatorIndex), epoch, firstSlot, fmt.Sprintf("is attesting again in epoch %v after %v missed epochs", epoch, state.MissedEpochs), fmt.Sprintf("/validator/%v", validatorIndex)))
+					}
+					state.MissedEpochs = 0
+					state.Offline = false
+				default:
+					state.MissedEpochs++
+					if !state.Offline && state.MissedEpochs >= rule.offlineEpochs {
+						state.Offline = true
+						notifications = append(notifications, ne.buildNotification(rule, NotificationEventOffline, uint64(validatorIndex), epoch, firstSlot, fmt.Sprintf("missed attestations for %v consecutive epochs (last missed epoch %v)", state.MissedEpochs, epoch), fmt.Sprintf("/validator/%v", validatorIndex)))
+					}
+				}
+				changedStates = append(changedStates, state)
+			}
+		}
+	}
+
+	ne.sendNotifications(notifications)
+
+	return db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		if err := db.InsertNotificationValidatorStates(changedStates, tx); err != nil {
+			return err
+		}
+		return db.SetExplorerState(notificationStateKey, uint64(epoch), tx)
+	})
+}
+
+// addWithdrawalEvents reports the withdrawals processed in the execution payload of a canonical block.
+// these are the balance sweeps (partial withdrawals & full withdrawals of exited validators), unlike the withdrawal_request event for included el requests.
+func (ne *NotificationEngine) addWithdrawalEvents(ctx context.Context, block *dbtypes.Slot, addEvent func(event string, validatorIndex uint64, slot phase0.Slot, message string, link string)) {
+	blockDetails, err := GlobalBeaconService.GetSlotDetailsByBlockroot(ctx, phase0.Root(block.Root))
+	if err != nil || blockDetails == nil || blockDetails.Block == nil {
+		ne.logger.Warnf("failed loading block %v for withdrawal notifications: %v", block.Slot, err)
+		return
+	}
+
+	withdrawals, err := blockDetails.Block.Withdrawals()
+	if err != nil {
+		// no execution payload withdrawals before capella
+		return
+	}
+
+	for _, withdrawal := range withdrawals {
+		addEvent(NotificationEventWithdrawal, uint64(withdrawal.ValidatorIndex), phase0.Slot(block.Slot), fmt.Sprintf("withdrawal of %v processed in slot %v", utils.FormatETHFromGwei(uint64(withdrawal.Amount)), block.Slot), fmt.Sprintf("/slot/%v", block.Slot))
+	}
+}
+
+// checkStatusChanges compares the current status of all validators with a status_change rule against the status seen on the previous check.
+// the status of a validator is not known before its first check, so no notifications are sent for the first check after a restart.
+func (ne *NotificationEngine) checkStatusChanges(epoch phase0.Epoch, slot phase0.Slot) []*Notification {
+	notifications := []*Notification{}
+	validatorStatus := map[phase0.ValidatorIndex]v1.ValidatorState{}
+	for _, rule := range ne.rules {
+		if !rule.events[NotificationEventStatusChange] {
+			continue
+		}
+
+		for validatorIndex := range rule.validators {
+			status, checked := validatorStatus[validatorIndex]
+			if !checked {
+				validator := GlobalBeaconService.GetValidatorByIndex(validatorIndex, false)
+				if validator == nil {
+					continue
+				}
+				status = validator.Status
+				validatorStatus[validatorIndex] = status
+			}
+
+			prevStatus, known := ne.validatorStatus[validatorIndex]
+			if !known || prevStatus == status {
+				continue
+			}
+
+			notification := ne.buildNotification(rule, NotificationEventStatusChange, uint64(validatorIndex), epoch, slot, fmt.Sprintf("changed status from %v to %v", prevStatus.String(), status.String()), fmt.Sprintf("/validator/%v", validatorIndex))
+			notification.Status = status.String()
+			notification.PreviousStatus = prevStatus.String()
+			notifications = append(notifications, notification)
+		}
+	}
+	ne.validatorStatus = validatorStatus
+	return notifications
+}
+
+func (ne *NotificationEngine) hasRulesFor(event string) bool {
+	for _, rule := range ne.rules {
+		if rule.events[event] {
+			return true
+		}
+	}
+	return false
+}
+
+func (ne *NotificationEngine) buildNotification(rule *notificationRule, event string, validatorIndex uint64, epoch phase0.Epoch, slot phase0.Slot, message string, link string) *Notification {
+	validatorName := GlobalBeaconService.GetValidatorName(validatorIndex)
+	validatorLabel := fmt.Sprintf("Validator %v", validatorIndex)
+	if validatorName != "" {
+		validatorLabel = fmt.Sprintf("Validator %v (%v)", validatorIndex, validatorName)
+	}
+
+	notification := &Notification{
+		Rule:           rule.name,
+		Event:          event,
+		ValidatorIndex: validatorIndex,
+		ValidatorName:  validatorName,
+		Epoch:          uint64(epoch),
+		Slot:           uint64(slot),
+		Title:          fmt.Sprintf("[%v] %v: %v", rule.label, validatorLabel, strings.ReplaceAll(event, "_", " ")),
+		Message:        fmt.Sprintf("%v %v", validatorLabel, message),
+		Watchlist:      rule.watchlist,
+	}
+	if baseUrl := strings.TrimRight(utils.Config().Notifications.BaseUrl, "/"); baseUrl != "" && link != "" {
+		notification.Link = baseUrl + link
+	}
+	return notification
+}
+
+// sendNotifications delivers the notifications to the channels of their rules.
+// every notification is logged before sending, so notifications of re-processed epochs are not sent again.
+func (ne *NotificationEngine) sendNotifications(notifications []*Notification) {
+	for _, notification := range notifications {
+		logEntry := &dbtypes.NotificationLogEntry{
+			RuleName:       notification.Rule,
+			EventType:      notification.Event,
+			ValidatorIndex: notification.ValidatorIndex,
+			EventSlot:      notification.Slot,
+			Message:        notification.Message,
+			CreatedAt:      time.Now().Unix(),
+		}
+
+		isNew := false
+		err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			var err error
+			isNew, err = db.InsertNotificationLogEntry(logEntry, tx)
+			return err
+		})
+		if err != nil {
+			ne.logger.Warnf("failed logging notification: %v", err)
+			continue
+		}
+		if !isNew {
+			continue
+		}
+
+		sendErrors := []string{}
+		for _, rule := range ne.rules {
+			if rule.name != notification.Rule {
+				continue
+			}
+			if rule.watchlist != "" {
+				// user webhooks are sent in background, the worker logs delivery errors itself
+				for _, channel := range rule.channels {
+					select {
+					case ne.webhookQueue <- &watchlistWebhookDelivery{notification: notification, logEntry: logEntry, channel: channel}:
+					default:
+						sendErrors = append(sendErrors, "webhook: delivery queue full")
+					}
+				}
+				continue
+			}
+			for channelName, channel := range rule.channels {
+				if err := channel.sendNotification(notification); err != nil {
+					ne.logger.Warnf("failed sending notification via %v: %v", channelName, err)
+					sendErrors = append(sendErrors, fmt.Sprintf("%v: %v", channelName, err))
+				}
+			}
+		}
+
+		if len(sendErrors) > 0 {
+			ne.logNotificationError(logEntry, strings.Join(sendErrors, "; "))
+		} else {
+			ne.logger.Debugf("sent notification: %v", notification.Title)
+		}
+	}
+}
+
+// runWatchlistWebhookWorker delivers the queued watchlist webhook notifications.
+func (ne *NotificationEngine) runWatchlistWebhookWorker() {
+	defer utils.HandleSubroutinePanic("NotificationEngine.runWatchlistWebhookWorker")
+
+	for delivery := range ne.webhookQueue {
+		if err := delivery.channel.sendNotification(delivery.notification); err != nil {
+			ne.logger.Debugf("failed sending watchlist webhook notification: %v", err)
+			ne.logNotificationError(delivery.logEntry, fmt.Sprintf("webhook: %v", err))
+		} else {
+			ne.logger.Debugf("sent watchlist webhook notification: %v", delivery.notification.Title)
+		}
+	}
+}
+
+// logNotificationError stores the delivery error in the notification log.
+func (ne *NotificationEngine) logNotificationError(logEntry *dbtypes.NotificationLogEntry, sendError string) {
+	logEntry.Error = sendError
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.UpdateNotificationLogError(logEntry, tx)
+	})
+	if err != nil {
+		ne.logger.Warnf("failed logging notification error: %v", err)
+	}
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// participationHistory maintains the participation rollups: the participation of the finalized epochs summed up per
+// hour (bucket), kept for the configured history range. the latest bucket is recomputed until it is complete.
+type participationHistory struct {
+	mutex         sync.RWMutex
+	bucketEpochs  uint64
+	historyEpochs uint64
+	rollups       []*dbtypes.ParticipationRollup
+}
+
+func newParticipationHistory(epochDuration time.Duration) *participationHistory {
+	history := &participationHistory{
+		bucketEpochs: uint64(time.Hour / epochDuration),
+	}
+	if history.bucketEpochs == 0 {
+		history.bucketEpochs = 1
+	}
+
+	historyDuration := utils.Config().Indexer.ParticipationHistory
+	if historyDuration == 0 {
+		historyDuration = 7 * 24 * time.Hour
+	}
+	history.historyEpochs = uint64(historyDuration / epochDuration)
+	if history.historyEpochs < history.bucketEpochs {
+		history.historyEpochs = history.bucketEpochs
+	}
+
+	return history
+}
+
+// GetParticipationHistory returns the participation rollups of the history range in ascending order and the number of epochs per rollup.
+func (bs *ChainService) GetParticipationHistory() ([]*dbtypes.ParticipationRollup, uint64) {
+	history := bs.participationHistory
+	if history == nil {
+		return nil, 0
+	}
+
+	history.mutex.RLock()
+	defer history.mutex.RUnlock()
+	return history.rollups, history.bucketEpochs
+}
+
+func (bs *ChainService) runParticipationHistoryWorker() {
+	defer utils.HandleSubroutinePanic("ChainService.runParticipationHistoryWorker")
+
+	history := bs.participationHistory
+	finalizedEpoch, _ := bs.GetFinalizedEpoch()
+	rollups := db.GetParticipationRollups(history.getHistoryStart(uint64(finalizedEpoch)))
+	history.mutex.Lock()
+	history.rollups = rollups
+	history.mutex.Unlock()
+
+	for {
+		if syncRunning, _ := bs.beaconIndexer.GetSynchronizerState(); !syncRunning {
+			err := bs.updateParticipationHistory()
+			if err != nil {
+				bs.logger.Warnf("failed updating participation history: %v", err)
+			}
+		}
+
+		time.Sleep(1 * time.Minute)
+	}
+}
+
+// getHistoryStart returns the first bucket epoch of the history range
+func (history *participationHistory) getHistoryStart(finalizedEpoch uint64) uint64 {
+	if finalizedEpoch <= history.historyEpochs {
+		return 0
+	}
+	startEpoch := finalizedEpoch - history.historyEpochs
+	return startEpoch - startEpoch%history.bucketEpochs
+}
+
+// updateParticipationHistory rolls up the epochs finalized since the last update.
+func (bs *ChainService) updateParticipationHistory() error {
+	history := bs.participationHistory
+	finalizedEpoch, _ := bs.GetFinalizedEpoch()
+	if finalizedEpoch < 1 {
+		return nil
+	}
+
+	// the epochs table holds all epochs before the finalized epoch
+	historyStart := history.getHistoryStart(uint64(finalizedEpoch))
+	startEpoch := historyStart
+
+	history.mutex.RLock()
+	if rollupCount := len(history.rollups); rollupCount > 0 {
+		lastRollup := history.rollups[rollupCount-1]
+		if lastRollup.LastEpoch+1 >= uint64(finalizedEpoch) {
+			history.mutex.RUnlock()
+			return nil
+		}
+		if lastRollup.BucketEpoch > startEpoch {
+			startEpoch = lastRollup.BucketEpoch
+		}
+	}
+	history.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	lastEpoch := uint64(finalizedEpoch) - 1
+	epochs := db.GetEpochs(ctx, lastEpoch, uint32(lastEpoch-startEpoch+1))
+
+	rollupMap := map[uint64]*dbtypes.ParticipationRollup{}
+	rollups := []*dbtypes.ParticipationRollup{}
+	for idx := len(epochs) - 1; idx >= 0; idx-- {
+		epoch := epochs[idx]
+		if epoch.Epoch < startEpoch {
+			continue
+		}
+
+		bucketEpoch := epoch.Epoch - epoch.Epoch%history.bucketEpochs
+		rollup := rollupMap[bucketEpoch]
+		if rollup == nil {
+			rollup = &dbtypes.ParticipationRollup{
+				BucketEpoch: bucketEpoch,
+			}
+			rollupMap[bucketEpoch] = rollup
+			rollups = append(rollups, rollup)
+		}
+		rollup.LastEpoch = epoch.Epoch
+		rollup.EpochCount++
+		rollup.Eligible += epoch.Eligible
+		rollup.VotedTarget += epoch.VotedTarget
+		rollup.VotedHead += epoch.VotedHead
+		rollup.VotedTotal += epoch.VotedTotal
+	}
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		err := db.InsertParticipationRollups(rollups, tx)
+		if err != nil {
+			return err
+		}
+		return db.DeleteParticipationRollupsBefore(historyStart, tx)
+	})
+	if err != nil {
+		return err
+	}
+
+	history.mutex.Lock()
+	defer history.mutex.Unlock()
+
+	newRollups := make([]*dbtypes.ParticipationRollup, 0, len(history.rollups)+len(rollups))
+	for _, rollup := range history.rollups {
+		if rollup.BucketEpoch >= historyStart && rollup.BucketEpoch < rollups[0].BucketEpoch {
+			newRollups = append(newRollups, rollup)
+		}
+	}
+	history.rollups = append(newRollups, rollups...)
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// proposalLuckRefreshEpochs is the number of newly finalized epochs after which the proposal luck windows are recomputed.
+const proposalLuckRefreshEpochs = 8
+
+// ValidatorLuckWindow describes a time window the proposal luck is computed for.
+type ValidatorLuckWindow struct {
+	Name         string
+	Duration     time.Duration
+	WindowEpochs uint64
+	FirstEpoch   uint64
+	LastEpoch    uint64
+	proposals    []uint32
+	expected     []float32
+}
+
+// ValidatorProposalLuck holds the actual & expected number of proposals of a validator (or a group of validators) within a luck window.
+type ValidatorProposalLuck struct {
+	Proposals uint64
+	Expected  float64
+	Luck      float64 // actual / expected proposals in percent
+}
+
+// proposalLuck is the proposer history aggregation: it counts the assigned proposals (canonical & missed slots) per
+// validator within the luck windows and compares them to the number of proposals expected by the stake share.
+// the expected count is based on the current effective balances and the active epochs of each validator within the window.
+type proposalLuck struct {
+	mutex   sync.RWMutex
+	windows []*ValidatorLuckWindow
+}
+
+func newProposalLuck(epochDuration time.Duration) *proposalLuck {
+	luck := &proposalLuck{}
+	for _, window := range []struct {
+		name     string
+		duration time.Duration
+	}{
+		{"7d", 7 * 24 * time.Hour},
+		{"30d", 30 * 24 * time.Hour},
+	} {
+		windowEpochs := uint64(window.duration / epochDuration)
+		if windowEpochs == 0 {
+			windowEpochs = 1
+		}
+		luck.windows = append(luck.windows, &ValidatorLuckWindow{
+			Name:         window.name,
+			Duration:     window.duration,
+			WindowEpochs: windowEpochs,
+		})
+	}
+	return luck
+}
+
+// GetValidatorLuckWindows returns the windows of the proposal luck aggregation.
+func (bs *ChainService) GetValidatorLuckWindows() []*ValidatorLuckWindow {
+	if bs.proposalLuck == nil {
+		return nil
+	}
+
+	bs.proposalLuck.mutex.RLock()
+	defer bs.proposalLuck.mutex.RUnlock()
+
+	windows := make([]*ValidatorLuckWindow, len(bs.proposalLuck.windows))
+	for idx, window := range bs.proposalLuck.windows {
+		windowCopy := *window
+		windowCopy.proposals = nil
+		windowCopy.expected = nil
+		windows[idx] = &windowCopy
+	}
+	return windows
+}
+
+// GetValidatorProposalLuck returns the summed up actual & expected proposals of the given validators within the named luck window.
+// The second return value is false if the window has not been computed yet or none of the validators was expected to propose.
+func (bs *ChainService) GetValidatorProposalLuck(validatorIndexes []phase0.ValidatorIndex, window string) (*ValidatorProposalLuck, bool) {
+	if bs.proposalLuck == nil {
+		return nil, false
+	}
+
+	bs.proposalLuck.mutex.RLock()
+	defer bs.proposalLuck.mutex.RUnlock()
+
+	for _, luckWindow := range bs.proposalLuck.windows {
+		if luckWindow.Name != window {
+			continue
+		}
+
+		result := &ValidatorProposalLuck{}
+		for _, validatorIndex := range validatorIndexes {
+			if uint64(validatorIndex) < uint64(len(luckWindow.proposals)) {
+				result.Proposals += uint64(luckWindow.proposals[validatorIndex])
+			}
+			if uint64(validatorIndex) < uint64(len(luckWindow.expected)) {
+				result.Expected += float64(luckWindow.expected[validatorIndex])
+			}
+		}
+		if result.Expected == 0 {
+			return nil, false
+		}
+		result.Luck = float64(result.Proposals) / result.Expected * 100
+		return result, true
+	}
+	return nil, false
+}
+
+func (bs *ChainService) runProposalLuckWorker() {
+	defer utils.HandleSubroutinePanic("ChainService.runProposalLuckWorker")
+
+	specs := bs.consensusPool.GetChainState().GetSpecs()
+	lastEpoch := int64(-1)
+
+	for {
+		finalizedEpoch, _ := bs.GetFinalizedEpoch()
+		maxEpoch := int64(finalizedEpoch) - 1
+		syncRunning, _ := bs.beaconIndexer.GetSynchronizerState()
+
+		// the proposer history is incomplete while the synchronizer is filling up the db
+		if !syncRunning && maxEpoch >= 0 && (lastEpoch < 0 || maxEpoch >= lastEpoch+proposalLuckRefreshEpochs) {
+			if bs.updateProposalLuck(uint64(maxEpoch), specs.SlotsPerEpoch) {
+				lastEpoch = maxEpoch
+			}
+		}
+
+		time.Sleep(1 * time.Minute)
+	}
+}
+
+// updateProposalLuck recomputes the actual & expected proposals of all validators for all windows ending at lastEpoch.
+func (bs *ChainService) updateProposalLuck(lastEpoch uint64, slotsPerEpoch uint64) bool {
+	validatorSet := bs.GetCachedValidatorSet(false)
+	if validatorSet == nil {
+		return false
+	}
+
+	// stake share of each validator as of the end of the window
+	totalActiveBalance := uint64(0)
+	for index := 0; index < validatorSet.Len(); index++ {
+		validator, found := validatorSet.GetRecord(phase0.ValidatorIndex(index))
+		if found && uint64(validator.ActivationEpoch) <= lastEpoch && uint64(validator.ExitEpoch) > lastEpoch {
+			totalActiveBalance += uint64(validator.EffectiveBalance)
+		}
+	}
+	if totalActiveBalance == 0 {
+		return false
+	}
+
+	luck := bs.proposalLuck
+	windows := make([]*ValidatorLuckWindow, len(luck.windows))
+	for idx, window := range luck.windows {
+		firstEpoch := uint64(0)
+		if lastEpoch+1 > window.WindowEpochs {
+			firstEpoch = lastEpoch + 1 - window.WindowEpochs
+		}
+
+		validatorCount := validatorSet.Len()
+		luckWindow := &ValidatorLuckWindow{
+			Name:         window.Name,
+			Duration:     window.Duration,
+			WindowEpochs: window.WindowEpochs,
+			FirstEpoch:   firstEpoch,
+			LastEpoch:    lastEpoch,
+			proposals:    make([]uint32, validatorCount),
+			expected:     make([]float32, validatorCount),
+		}
+
+		slotCounts := db.GetProposerSlotCounts(firstEpoch*slotsPerEpoch, (lastEpoch+1)*slotsPerEpoch-1)
+		if slotCounts == nil {
+			return false
+		}
+		for _, slotCount := range slotCounts {
+			if slotCount.Proposer < uint64(validatorCount) {
+				luckWindow.proposals[slotCount.Proposer] = uint32(slotCount.Count)
+			}
+		}
+
+		for index := 0; index < validatorCount; index++ {
+			validator, found := validatorSet.GetRecord(phase0.ValidatorIndex(index))
+			if !found {
+				continue
+			}
+
+			activeFrom := max(uint64(validator.ActivationEpoch), firstEpoch)
+			activeTo := min(uint64(validator.ExitEpoch), lastEpoch+1)
+			if activeTo <= activeFrom {
+				continue
+			}
+
+			activeSlots := float64((activeTo - activeFrom) * slotsPerEpoch)
+			luckWindow.expected[index] = float32(activeSlots * float64(validator.EffectiveBalance) / float64(totalActiveBalance))
+		}
+
+		windows[idx] = luckWindow
+	}
+
+	luck.mutex.Lock()
+	luck.windows = windows
+	luck.mutex.Unlock()
+
+	bs.logger.Debugf("updated proposal luck up to epoch %v", lastEpoch)
+	return true
+}
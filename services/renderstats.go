@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// renderStatsSampleSize is the number of recent render durations kept per page for the percentile calculation.
+const renderStatsSampleSize = 1000
+
+// RenderStats tracks the template render durations of all frontend pages.
+type RenderStats struct {
+	mutex sync.Mutex
+	pages map[string]*renderStatsPage
+}
+
+type renderStatsPage struct {
+	count     uint64
+	total     time.Duration
+	max       time.Duration
+	samples   []time.Duration
+	sampleIdx int
+}
+
+// RenderStatsPage holds the aggregated render durations of a page.
+type RenderStatsPage struct {
+	Page    string
+	Count   uint64
+	Total   time.Duration
+	Average time.Duration
+	Max     time.Duration
+	P50     time.Duration
+	P90     time.Duration
+	P99     time.Duration
+}
+
+// PageRenderInfo is attached to the request context of frontend calls and collects the timing of a page call.
+type PageRenderInfo struct {
+	Start     time.Time
+	DataReady time.Time
+	CacheKey  string
+}
+
+type pageRenderInfoKey struct{}
+
+var GlobalRenderStats = &RenderStats{
+	pages: map[string]*renderStatsPage{},
+}
+
+// WithPageRenderInfo returns a copy of ctx with a new page render info attached.
+func WithPageRenderInfo(ctx context.Context) (context.Context, *PageRenderInfo) {
+	info := &PageRenderInfo{
+		Start: time.Now(),
+	}
+	return context.WithValue(ctx, pageRenderInfoKey{}, info), info
+}
+
+// GetPageRenderInfo returns the page render info attached to ctx or nil.
+func GetPageRenderInfo(ctx context.Context) *PageRenderInfo {
+	info, _ := ctx.Value(pageRenderInfoKey{}).(*PageRenderInfo)
+	return info
+}
+
+// AddRender records the render duration of a page.
+func (rs *RenderStats) AddRender(page string, duration time.Duration) {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	stats := rs.pages[page]
+	if stats == nil {
+		stats = &renderStatsPage{
+			samples: make([]time.Duration, 0, renderStatsSampleSize),
+		}
+		rs.pages[page] = stats
+	}
+
+	stats.count++
+	stats.total += duration
+	if duration > stats.max {
+		stats.max = duration
+	}
+	if len(stats.samples) < renderStatsSampleSize {
+		stats.samples = append(stats.samples, duration)
+	} else {
+		stats.samples[stats.sampleIdx] = duration
+		stats.sampleIdx = (stats.sampleIdx + 1) % renderStatsSampleSize
+	}
+}
+
+// GetRenderStats returns the render stats of all pages sorted by page name.
+// The percentiles refer to the most recent renders of each page.
+func (rs *RenderStats) GetRenderStats() []*RenderStatsPage {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	result := make([]*RenderStatsPage, 0, len(rs.pages))
+	for page, stats := range rs.pages {
+		samples := make([]time.Duration, len(stats.samples))
+		copy(samples, stats.samples)
+		sort.Slice(samples, func(a, b int) bool {
+			return samples[a] < samples[b]
+		})
+
+		percentile := func(p int) time.Duration {
+			if len(samples) == 0 {
+				return 0
+			}
+			return samples[(len(samples)-1)*p/100]
+		}
+
+		result = append(result, &RenderStatsPage{
+			Page:    page,
+			Count:   stats.count,
+			Total:   stats.total,
+			Average: stats.total / time.Duration(stats.count),
+			Max:     stats.max,
+			P50:     percentile(50),
+			P90:     percentile(90),
+			P99:     percentile(99),
+		})
+	}
+
+	sort.Slice(result, func(a, b int) bool {
+		return result[a].Page < result[b].Page
+	})
+	return result
+}
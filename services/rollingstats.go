@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// rollingStatsBatchSize is the maximum number of epochs loaded from the db per aggregation step.
+const rollingStatsBatchSize = 100
+
+// RollingStatsWindow holds the aggregated stats of the finalized epochs within a rolling time window.
+type RollingStatsWindow struct {
+	Name          string
+	Duration      time.Duration
+	WindowEpochs  uint64
+	FirstEpoch    uint64
+	LastEpoch     uint64
+	EpochCount    uint64
+	Eligible      uint64
+	VotedTarget   uint64
+	VotedHead     uint64
+	VotedTotal    uint64
+	SlotCount     uint64
+	BlockCount    uint64
+	MissedCount   uint64
+	OrphanedCount uint64
+	DepositCount  uint64
+	DepositAmount uint64
+	firstIdx      int
+}
+
+type rollingStatsEntry struct {
+	epoch         uint64
+	eligible      uint64
+	votedTarget   uint64
+	votedHead     uint64
+	votedTotal    uint64
+	blockCount    uint64
+	missedCount   uint64
+	orphanedCount uint64
+	depositCount  uint64
+	depositAmount uint64
+}
+
+// rollingStats aggregates the epoch stats for multiple rolling windows.
+// new epochs are added to all windows and epochs that drop out of a window are subtracted again,
+// so the aggregation never needs to reload the whole window from the db.
+type rollingStats struct {
+	mutex      sync.RWMutex
+	entries    []*rollingStatsEntry
+	windows    []*RollingStatsWindow
+	lastEpoch  uint64
+	hasEntries bool
+}
+
+func newRollingStats(epochDuration time.Duration) *rollingStats {
+	stats := &rollingStats{}
+	for _, window := range []struct {
+		name     string
+		duration time.Duration
+	}{
+		{"1d", 24 * time.Hour},
+		{"7d", 7 * 24 * time.Hour},
+		{"31d", 31 * 24 * time.Hour},
+	} {
+		windowEpochs := uint64(window.duration / epochDuration)
+		if windowEpochs == 0 {
+			windowEpochs = 1
+		}
+		stats.windows = append(stats.windows, &RollingStatsWindow{
+			Name:         window.name,
+			Duration:     window.duration,
+			WindowEpochs: windowEpochs,
+		})
+	}
+	return stats
+}
+
+func (window *RollingStatsWindow) addEntry(entry *rollingStatsEntry, sign int) {
+	apply := func(value *uint64, delta uint64) {
+		if sign > 0 {
+			*value += delta
+		} else {
+			*value -= delta
+		}
+	}
+
+	apply(&window.EpochCount, 1)
+	apply(&window.Eligible, entry.eligible)
+	apply(&window.VotedTarget, entry.votedTarget)
+	apply(&window.VotedHead, entry.votedHead)
+	apply(&window.VotedTotal, entry.votedTotal)
+	apply(&window.SlotCount, entry.blockCount+entry.missedCount)
+	apply(&window.BlockCount, entry.blockCount)
+	apply(&window.MissedCount, entry.missedCount)
+	apply(&window.OrphanedCount, entry.orphanedCount)
+	apply(&window.DepositCount, entry.depositCount)
+	apply(&window.DepositAmount, entry.depositAmount)
+}
+
+// addEntries adds the given epochs (ascending order) to all windows and drops epochs that fell out of the windows.
+func (stats *rollingStats) addEntries(entries []*rollingStatsEntry) {
+	stats.mutex.Lock()
+	defer stats.mutex.Unlock()
+
+	for _, entry := range entries {
+		if stats.hasEntries && entry.epoch <= stats.lastEpoch {
+			continue
+		}
+
+		stats.entries = append(stats.entries, entry)
+		stats.lastEpoch = entry.epoch
+		stats.hasEntries = true
+
+		for _, window := range stats.windows {
+			window.addEntry(entry, 1)
+			window.LastEpoch = entry.epoch
+
+			for stats.entries[window.firstIdx].epoch+window.WindowEpochs <= entry.epoch {
+				window.addEntry(stats.entries[window.firstIdx], -1)
+				window.firstIdx++
+			}
+			window.FirstEpoch = stats.entries[window.firstIdx].epoch
+		}
+	}
+
+	// drop entries that are not referenced by any window anymore
+	minIdx := len(stats.entries)
+	for _, window := range stats.windows {
+		if window.firstIdx < minIdx {
+			minIdx = window.firstIdx
+		}
+	}
+	if minIdx > 0 {
+		stats.entries = append([]*rollingStatsEntry{}, stats.entries[minIdx:]...)
+		for _, window := range stats.windows {
+			window.firstIdx -= minIdx
+		}
+	}
+}
+
+// GetRollingStats returns a copy of the rolling windows aggregated by the rolling stats worker.
+// returns nil if no finalized epochs have been aggregated yet.
+func (bs *ChainService) GetRollingStats() []*RollingStatsWindow {
+	if bs.rollingStats == nil {
+		return nil
+	}
+
+	bs.rollingStats.mutex.RLock()
+	defer bs.rollingStats.mutex.RUnlock()
+
+	if !bs.rollingStats.hasEntries {
+		return nil
+	}
+
+	windows := make([]*RollingStatsWindow, len(bs.rollingStats.windows))
+	for idx, window := range bs.rollingStats.windows {
+		windowCopy := *window
+		windows[idx] = &windowCopy
+	}
+	return windows
+}
+
+// runRollingStatsWorker aggregates newly finalized epochs into the rolling stats windows.
+func (bs *ChainService) runRollingStatsWorker() {
+	defer utils.HandleSubroutinePanic("ChainService.runRollingStatsWorker")
+
+	specs := bs.consensusPool.GetChainState().GetSpecs()
+	maxWindowEpochs := int64(bs.rollingStats.windows[len(bs.rollingStats.windows)-1].WindowEpochs)
+	nextEpoch := int64(-1)
+
+	for {
+		finalizedEpoch, _ := bs.GetFinalizedEpoch()
+		maxEpoch := int64(finalizedEpoch) - 1
+		if syncRunning, syncHead := bs.beaconIndexer.GetSynchronizerState(); syncRunning {
+			maxEpoch = int64(syncHead) - 1
+		}
+
+		if nextEpoch < 0 {
+			// start with the full window on first run
+			nextEpoch = maxEpoch - maxWindowEpochs + 1
+			if nextEpoch < 0 {
+				nextEpoch = 0
+			}
+		}
+
+		if maxEpoch < nextEpoch {
+			time.Sleep(1 * time.Minute)
+			continue
+		}
+
+		lastEpoch := nextEpoch + rollingStatsBatchSize - 1
+		if lastEpoch > maxEpoch {
+			lastEpoch = maxEpoch
+		}
+
+		bs.aggregateRollingStats(uint64(nextEpoch), uint64(lastEpoch), specs.SlotsPerEpoch)
+		nextEpoch = lastEpoch + 1
+
+		if lastEpoch == maxEpoch {
+			time.Sleep(1 * time.Minute)
+		}
+	}
+}
+
+func (bs *ChainService) aggregateRollingStats(firstEpoch uint64, lastEpoch uint64, slotsPerEpoch uint64) {
+	epochs := db.GetEpochs(context.Background(), lastEpoch, uint32(lastEpoch-firstEpoch+1))
+	depositAmounts := map[uint64]*dbtypes.DepositEpochAmount{}
+	for _, deposits := range db.GetDepositAmountsByEpoch(firstEpoch, lastEpoch, slotsPerEpoch) {
+		depositAmounts[deposits.Epoch] = deposits
+	}
+
+	entries := make([]*rollingStatsEntry, 0, len(epochs))
+	for idx := len(epochs) - 1; idx >= 0; idx-- {
+		epoch := epochs[idx]
+		if epoch.Epoch < firstEpoch {
+			continue
+		}
+
+		entry := &rollingStatsEntry{
+			epoch:         epoch.Epoch,
+			eligible:      epoch.Eligible,
+			votedTarget:   epoch.VotedTarget,
+			votedHead:     epoch.VotedHead,
+			votedTotal:    epoch.VotedTotal,
+			blockCount:    uint64(epoch.BlockCount),
+			orphanedCount: uint64(epoch.OrphanedCount),
+		}
+		if entry.blockCount < slotsPerEpoch {
+			entry.missedCount = slotsPerEpoch - entry.blockCount
+		}
+		if deposits := depositAmounts[epoch.Epoch]; deposits != nil {
+			entry.depositCount = deposits.DepositCount
+			entry.depositAmount = deposits.Amount
+		}
+		entries = append(entries, entry)
+	}
+
+	bs.rollingStats.addEntries(entries)
+}
@@ -0,0 +1,319 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// ScreenshotService periodically renders the configured explorer pages with a headless browser
+// and stores the png snapshots in a local directory and/or s3 bucket.
+type ScreenshotService struct {
+	logger logrus.FieldLogger
+}
+
+// screenshotCheckInterval is the interval the capture loop checks for pages to capture
+const screenshotCheckInterval = 1 * time.Minute
+
+var screenshotNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+var GlobalScreenshotService *ScreenshotService
+
+// StartScreenshotService is used to start the global screenshot service
+func StartScreenshotService(logger logrus.FieldLogger) error {
+	config := &utils.Config().Screenshots
+	if GlobalScreenshotService != nil || !config.Enabled {
+		return nil
+	}
+
+	if config.Path == "" && config.S3.Bucket == "" {
+		return fmt.Errorf("screenshots enabled, but neither a path nor a s3 bucket configured")
+	}
+	if len(config.Pages) == 0 {
+		return fmt.Errorf("screenshots enabled, but no pages configured")
+	}
+	for idx := range config.Pages {
+		if err := validateScreenshotPage(&config.Pages[idx]); err != nil {
+			return err
+		}
+	}
+
+	browserPath := config.BrowserPath
+	if browserPath == "" {
+		browserPath = "chromium"
+	}
+	if _, err := exec.LookPath(browserPath); err != nil {
+		return fmt.Errorf("screenshot browser %v not found: %v", browserPath, err)
+	}
+
+	GlobalScreenshotService = &ScreenshotService{
+		logger: logger,
+	}
+
+	go GlobalScreenshotService.runCaptureLoop()
+	return nil
+}
+
+func validateScreenshotPage(page *types.ScreenshotPageConfig) error {
+	if !screenshotNamePattern.MatchString(page.Name) {
+		return fmt.Errorf("invalid screenshot page name %v", page.Name)
+	}
+	if !strings.HasPrefix(page.Path, "/") {
+		return fmt.Errorf("invalid screenshot page path for %v: must start with /", page.Name)
+	}
+	return nil
+}
+
+// runCaptureLoop captures the configured pages once their interval passed.
+// the pages are re-read on every run, so changes by a config reload are picked up.
+func (ss *ScreenshotService) runCaptureLoop() {
+	defer utils.HandleSubroutinePanic("ScreenshotService.runCaptureLoop")
+
+	// give the frontend some time to start up & the indexer to load the recent chain state
+	time.Sleep(1 * time.Minute)
+
+	lastCaptures := map[string]time.Time{}
+	for {
+		pages := utils.Config().Screenshots.Pages
+		for idx := range pages {
+			page := &pages[idx]
+			if err := validateScreenshotPage(page); err != nil {
+				ss.logger.Warnf("skipping screenshot page: %v", err)
+				continue
+			}
+
+			interval := page.Interval
+			if interval == 0 {
+				interval = 6 * time.Hour
+			}
+			if time.Since(lastCaptures[page.Name]) < interval {
+				continue
+			}
+			lastCaptures[page.Name] = time.Now()
+
+			t1 := time.Now()
+			err := ss.capturePage(page)
+			if err != nil {
+				ss.logger.Warnf("failed capturing screenshot of %v: %v", page.Name, err)
+			} else {
+				ss.logger.Infof("captured screenshot of %v (%v ms)", page.Name, time.Since(t1).Milliseconds())
+			}
+		}
+
+		time.Sleep(screenshotCheckInterval)
+	}
+}
+
+// getBaseUrl returns the url the pages are loaded from, defaults to the local webserver.
+func (ss *ScreenshotService) getBaseUrl() string {
+	baseUrl := strings.TrimSuffix(utils.Config().Screenshots.BaseUrl, "/")
+	if baseUrl == "" {
+		host := utils.Config().Server.Host
+		if host == "" || host == "0.0.0.0" {
+			host = "localhost"
+		}
+		baseUrl = "http://" + net.JoinHostPort(host, utils.Config().Server.Port)
+	}
+	return baseUrl
+}
+
+func (ss *ScreenshotService) capturePage(page *types.ScreenshotPageConfig) error {
+	config := &utils.Config().Screenshots
+
+	pngData, err := ss.renderPage(page.Path)
+	if err != nil {
+		return err
+	}
+
+	fileNames := []string{fmt.Sprintf("%v-%v.png", page.Name, time.Now().UTC().Format("20060102-150405"))}
+	if config.KeepLatest {
+		fileNames = append(fileNames, fmt.Sprintf("%v-latest.png", page.Name))
+	}
+
+	if config.Path != "" {
+		err := os.MkdirAll(config.Path, 0755)
+		if err != nil {
+			return fmt.Errorf("failed creating screenshot directory: %v", err)
+		}
+
+		for _, fileName := range fileNames {
+			// the -latest.png is overwritten on every capture, so swap it in via rename to not serve a truncated image
+			filePath := filepath.Join(config.Path, fileName)
+			err := os.WriteFile(filePath+".tmp", pngData, 0644)
+			if err == nil {
+				err = os.Rename(filePath+".tmp", filePath)
+			}
+			if err != nil {
+				return fmt.Errorf("failed writing screenshot file: %v", err)
+			}
+		}
+	}
+
+	if config.S3.Bucket != "" {
+		for _, fileName := range fileNames {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			err := utils.S3PutObject(ctx, &utils.S3Target{
+				Endpoint:  config.S3.Endpoint,
+				Region:    config.S3.Region,
+				Bucket:    config.S3.Bucket,
+				Key:       config.S3.Prefix + fileName,
+				AccessKey: config.S3.AccessKey,
+				SecretKey: config.S3.SecretKey,
+				PathStyle: config.S3.PathStyle,
+			}, pngData, "image/png")
+			cancel()
+			if err != nil {
+				return fmt.Errorf("failed uploading screenshot to s3: %v", err)
+			}
+		}
+	}
+
+	if config.MaxFiles > 0 {
+		ss.pruneScreenshots(page)
+	}
+
+	return nil
+}
+
+// pruneScreenshots deletes the oldest timestamped screenshots of the page beyond the configured max. number of files.
+// the timestamps in the file names sort chronologically, so the names are sorted to find the oldest ones.
+func (ss *ScreenshotService) pruneScreenshots(page *types.ScreenshotPageConfig) {
+	config := &utils.Config().Screenshots
+	fileNamePattern := regexp.MustCompile(`^` + regexp.QuoteMeta(page.Name) + `-[0-9]{8}-[0-9]{6}\.png$`)
+
+	if config.Path != "" {
+		entries, err := os.ReadDir(config.Path)
+		if err != nil {
+			ss.logger.Warnf("failed listing screenshots of %v: %v", page.Name, err)
+		} else {
+			fileNames := []string{}
+			for _, entry := range entries {
+				if !entry.IsDir() && fileNamePattern.MatchString(entry.Name()) {
+					fileNames = append(fileNames, entry.Name())
+				}
+			}
+
+			for _, fileName := range getExpiredScreenshots(fileNames, config.MaxFiles) {
+				if err := os.Remove(filepath.Join(config.Path, fileName)); err != nil {
+					ss.logger.Warnf("failed deleting screenshot %v: %v", fileName, err)
+				}
+			}
+		}
+	}
+
+	if config.S3.Bucket != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+		defer cancel()
+
+		target := &utils.S3Target{
+			Endpoint:  config.S3.Endpoint,
+			Region:    config.S3.Region,
+			Bucket:    config.S3.Bucket,
+			AccessKey: config.S3.AccessKey,
+			SecretKey: config.S3.SecretKey,
+			PathStyle: config.S3.PathStyle,
+		}
+
+		keys, err := utils.S3ListObjects(ctx, target, config.S3.Prefix+page.Name+"-")
+		if err != nil {
+			ss.logger.Warnf("failed listing s3 screenshots of %v: %v", page.Name, err)
+			return
+		}
+
+		fileNames := []string{}
+		for _, key := range keys {
+			fileName := strings.TrimPrefix(key, config.S3.Prefix)
+			if fileNamePattern.MatchString(fileName) {
+				fileNames = append(fileNames, fileName)
+			}
+		}
+
+		for _, fileName := range getExpiredScreenshots(fileNames, config.MaxFiles) {
+			target.Key = config.S3.Prefix + fileName
+			if err := utils.S3DeleteObject(ctx, target); err != nil {
+				ss.logger.Warnf("failed deleting s3 screenshot %v: %v", fileName, err)
+			}
+		}
+	}
+}
+
+// getExpiredScreenshots returns the oldest file names beyond maxFiles.
+func getExpiredScreenshots(fileNames []string, maxFiles uint64) []string {
+	if uint64(len(fileNames)) <= maxFiles {
+		return nil
+	}
+
+	sort.Strings(fileNames)
+	return fileNames[:uint64(len(fileNames))-maxFiles]
+}
+
+// renderPage renders the given explorer page with the headless browser and returns the png image.
+func (ss *ScreenshotService) renderPage(pagePath string) ([]byte, error) {
+	config := &utils.Config().Screenshots
+
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = 1 * time.Minute
+	}
+	width := config.Width
+	if width == 0 {
+		width = 1920
+	}
+	height := config.Height
+	if height == 0 {
+		height = 1080
+	}
+	browserPath := config.BrowserPath
+	if browserPath == "" {
+		browserPath = "chromium"
+	}
+
+	tmpDir, err := os.MkdirTemp("", "dora-screenshot-")
+	if err != nil {
+		return nil, fmt.Errorf("failed creating temporary directory: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	screenshotPath := filepath.Join(tmpDir, "screenshot.png")
+	args := []string{
+		"--headless",
+		"--disable-gpu",
+		"--hide-scrollbars",
+		"--no-first-run",
+		fmt.Sprintf("--user-data-dir=%v", filepath.Join(tmpDir, "profile")),
+		fmt.Sprintf("--window-size=%v,%v", width, height),
+		fmt.Sprintf("--screenshot=%v", screenshotPath),
+	}
+	args = append(args, config.BrowserArgs...)
+	args = append(args, ss.getBaseUrl()+pagePath)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, browserPath, args...).CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("browser timed out after %v", timeout)
+		}
+		return nil, fmt.Errorf("browser failed: %v (%v)", err, strings.TrimSpace(string(output)))
+	}
+
+	pngData, err := os.ReadFile(screenshotPath)
+	if err != nil {
+		return nil, fmt.Errorf("browser did not produce a screenshot: %v", err)
+	}
+
+	return pngData, nil
+}
@@ -0,0 +1,273 @@
+package services
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// searchIndexRefreshInterval is the interval the in-memory search indexes are rebuilt in.
+const searchIndexRefreshInterval = 5 * time.Minute
+
+// searchIndexGraffitiLimit is the max. number of distinct graffitis kept in the search index.
+const searchIndexGraffitiLimit = 10000
+
+// SearchSuggestion is a typed suggestion for the search-as-you-type box.
+type SearchSuggestion struct {
+	Type  string
+	Label string
+	Value string
+	Link  string
+	Count uint64
+}
+
+type searchPrefixEntry struct {
+	key   string // lower case search key
+	value string
+	count uint64
+}
+
+// searchPrefixIndex is a sorted list of search keys that allows prefix lookups via binary search.
+type searchPrefixIndex struct {
+	entries []searchPrefixEntry
+}
+
+func newSearchPrefixIndex(entries []searchPrefixEntry) *searchPrefixIndex {
+	sort.Slice(entries, func(a, b int) bool {
+		return entries[a].key < entries[b].key
+	})
+	return &searchPrefixIndex{
+		entries: entries,
+	}
+}
+
+// lookup returns the values with the highest counts whose key starts with the given prefix.
+// values indexed with multiple keys are only returned once.
+func (idx *searchPrefixIndex) lookup(prefix string, limit int) []searchPrefixEntry {
+	startIdx := sort.Search(len(idx.entries), func(i int) bool {
+		return idx.entries[i].key >= prefix
+	})
+
+	matches := map[string]searchPrefixEntry{}
+	for i := startIdx; i < len(idx.entries) && strings.HasPrefix(idx.entries[i].key, prefix); i++ {
+		matches[idx.entries[i].value] = idx.entries[i]
+	}
+
+	results := make([]searchPrefixEntry, 0, len(matches))
+	for _, entry := range matches {
+		results = append(results, entry)
+	}
+	sort.Slice(results, func(a, b int) bool {
+		if results[a].count != results[b].count {
+			return results[a].count > results[b].count
+		}
+		return results[a].value < results[b].value
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// searchIndex holds the in-memory prefix indexes used for search suggestions.
+type searchIndex struct {
+	mutex          sync.RWMutex
+	names          *searchPrefixIndex
+	graffitis      *searchPrefixIndex
+	addresses      *searchPrefixIndex
+	validatorCount uint64
+}
+
+func newSearchIndex() *searchIndex {
+	return &searchIndex{
+		names:     newSearchPrefixIndex(nil),
+		graffitis: newSearchPrefixIndex(nil),
+		addresses: newSearchPrefixIndex(nil),
+	}
+}
+
+func (bs *ChainService) runSearchIndexWorker() {
+	defer utils.HandleSubroutinePanic("ChainService.runSearchIndexWorker")
+
+	for {
+		bs.rebuildSearchIndex()
+		time.Sleep(searchIndexRefreshInterval)
+	}
+}
+
+func (bs *ChainService) rebuildSearchIndex() {
+	t1 := time.Now()
+
+	// validator names, indexed by the full name and every word within the name
+	nameCounts := map[string]uint64{}
+	for _, name := range bs.validatorNames.getAllValidatorNames() {
+		nameCounts[name]++
+	}
+	nameEntries := make([]searchPrefixEntry, 0, len(nameCounts))
+	for name, count := range nameCounts {
+		for _, key := range getSearchWordKeys(name) {
+			nameEntries = append(nameEntries, searchPrefixEntry{key: key, value: name, count: count})
+		}
+	}
+
+	// block graffitis
+	graffitiEntries := []searchPrefixEntry{}
+	for _, graffiti := range db.GetGraffitiCounts(searchIndexGraffitiLimit) {
+		for _, key := range getSearchWordKeys(graffiti.Graffiti) {
+			graffitiEntries = append(graffitiEntries, searchPrefixEntry{key: key, value: graffiti.Graffiti, count: graffiti.Count})
+		}
+	}
+
+	// withdrawal addresses of 0x01 & 0x02 validators
+	addressCounts := map[string]uint64{}
+	validatorSet := bs.beaconIndexer.GetValidatorSetView(nil)
+	for index := 0; index < validatorSet.Len(); index++ {
+		validator, found := validatorSet.GetRecord(phase0.ValidatorIndex(index))
+		if !found {
+			continue
+		}
+		if validator.WithdrawalCredentials[0] == 0x01 || validator.WithdrawalCredentials[0] == 0x02 {
+			addressCounts[fmt.Sprintf("%x", validator.WithdrawalCredentials[12:])]++
+		}
+	}
+	addressEntries := make([]searchPrefixEntry, 0, len(addressCounts))
+	for address, count := range addressCounts {
+		addressEntries = append(addressEntries, searchPrefixEntry{key: address, value: address, count: count})
+	}
+
+	names := newSearchPrefixIndex(nameEntries)
+	graffitis := newSearchPrefixIndex(graffitiEntries)
+	addresses := newSearchPrefixIndex(addressEntries)
+
+	bs.searchIndex.mutex.Lock()
+	bs.searchIndex.names = names
+	bs.searchIndex.graffitis = graffitis
+	bs.searchIndex.addresses = addresses
+	bs.searchIndex.validatorCount = uint64(validatorSet.Len())
+	bs.searchIndex.mutex.Unlock()
+
+	bs.logger.Debugf("rebuilt search index (%v names, %v graffitis, %v addresses) in %v", len(nameCounts), len(graffitiEntries), len(addressCounts), time.Since(t1))
+}
+
+// getSearchWordKeys returns the lower case search keys for a text: the full text and the remainder from each word start on.
+func getSearchWordKeys(text string) []string {
+	text = strings.ToLower(strings.TrimSpace(text))
+	if text == "" {
+		return nil
+	}
+
+	keys := []string{text}
+	wordStart := false
+	for pos, char := range text {
+		isWordChar := unicode.IsLetter(char) || unicode.IsDigit(char)
+		if isWordChar && wordStart && pos > 0 {
+			keys = append(keys, text[pos:])
+		}
+		wordStart = !isWordChar
+	}
+	return keys
+}
+
+// GetSearchSuggestions returns typed search suggestions (validators, names, graffitis & withdrawal addresses) for the given query.
+// the suggestions are served from the in-memory indexes and do not hit the database.
+func (bs *ChainService) GetSearchSuggestions(query string, limit int) []*SearchSuggestion {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" || bs.searchIndex == nil {
+		return []*SearchSuggestion{}
+	}
+
+	bs.searchIndex.mutex.RLock()
+	defer bs.searchIndex.mutex.RUnlock()
+
+	suggestions := []*SearchSuggestion{}
+
+	// numeric queries refer to validator indices, slots & epochs
+	if number, err := strconv.ParseUint(query, 10, 64); err == nil {
+		if number < bs.searchIndex.validatorCount {
+			label := fmt.Sprintf("Validator %v", number)
+			if name := bs.validatorNames.GetValidatorName(number); name != "" {
+				label = fmt.Sprintf("%v (%v)", label, name)
+			}
+			suggestions = append(suggestions, &SearchSuggestion{
+				Type:  "validator",
+				Label: label,
+				Value: query,
+				Link:  fmt.Sprintf("/validator/%v", number),
+			})
+		}
+
+		chainState := bs.consensusPool.GetChainState()
+		if number <= uint64(chainState.CurrentSlot()) {
+			suggestions = append(suggestions, &SearchSuggestion{
+				Type:  "slot",
+				Label: fmt.Sprintf("Slot %v", number),
+				Value: query,
+				Link:  fmt.Sprintf("/slot/%v", number),
+			})
+		}
+		if number <= uint64(chainState.CurrentEpoch()) {
+			suggestions = append(suggestions, &SearchSuggestion{
+				Type:  "epoch",
+				Label: fmt.Sprintf("Epoch %v", number),
+				Value: query,
+				Link:  fmt.Sprintf("/epoch/%v", number),
+			})
+		}
+	}
+
+	for _, entry := range bs.searchIndex.names.lookup(query, limit) {
+		suggestions = append(suggestions, &SearchSuggestion{
+			Type:  "name",
+			Label: entry.value,
+			Value: entry.value,
+			Link:  "/validators?f&f.name=" + url.QueryEscape(entry.value),
+			Count: entry.count,
+		})
+	}
+
+	for _, entry := range bs.searchIndex.graffitis.lookup(query, limit) {
+		suggestions = append(suggestions, &SearchSuggestion{
+			Type:  "graffiti",
+			Label: entry.value,
+			Value: entry.value,
+			Link:  "/slots/filtered?f&f.missing=1&f.orphaned=1&f.graffiti=" + url.QueryEscape(entry.value),
+			Count: entry.count,
+		})
+	}
+
+	if addressQuery := strings.TrimPrefix(query, "0x"); len(addressQuery) >= 4 && isSearchHexString(addressQuery) {
+		for _, entry := range bs.searchIndex.addresses.lookup(addressQuery, limit) {
+			suggestions = append(suggestions, &SearchSuggestion{
+				Type:  "address",
+				Label: "0x" + entry.value,
+				Value: "0x" + entry.value,
+				Link:  "/validators?f&f.expr=" + url.QueryEscape("withdrawal_address = 0x"+entry.value),
+				Count: entry.count,
+			})
+		}
+	}
+
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+func isSearchHexString(text string) bool {
+	for _, char := range text {
+		if !strings.ContainsRune("0123456789abcdef", char) {
+			return false
+		}
+	}
+	return true
+}
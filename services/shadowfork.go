@@ -0,0 +1,246 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// ShadowForkDetector tracks the point at which a shadow fork diverged from its origin network.
+// it compares the execution block hashes of the indexed canonical blocks with the blocks of an upstream (origin network) execution rpc.
+type ShadowForkDetector struct {
+	logger    logrus.FieldLogger
+	rpcClient *rpc.Client
+	mutex     sync.RWMutex
+	status    *ShadowForkStatus
+}
+
+// ShadowForkStatus is the result of the latest divergence check.
+type ShadowForkStatus struct {
+	LastCheck         time.Time
+	CheckError        string
+	UpstreamHeadBlock uint64
+	LocalFirstBlock   uint64
+	LocalHeadBlock    uint64
+	Diverged          bool
+	// last execution block both networks agree on, nil if the networks diverged before the first indexed block
+	LastCommonBlock *dbtypes.EthBlockRef
+	// first indexed execution block that differs from the upstream block with the same number
+	ForkBlock        *dbtypes.EthBlockRef
+	ForkTime         time.Time
+	UpstreamForkHash []byte
+}
+
+type shadowForkRpcBlock struct {
+	Number hexutil.Uint64 `json:"number"`
+	Hash   common.Hash    `json:"hash"`
+}
+
+var GlobalShadowForkDetector *ShadowForkDetector
+
+// StartShadowForkDetector is used to start the global shadow fork divergence detector
+func StartShadowForkDetector(logger logrus.FieldLogger) error {
+	if GlobalShadowForkDetector != nil || !utils.Config().ShadowFork.Enabled {
+		return nil
+	}
+
+	rpcClient, err := rpc.DialContext(context.Background(), utils.Config().ShadowFork.UpstreamRpc)
+	if err != nil {
+		return fmt.Errorf("failed connecting upstream rpc: %v", err)
+	}
+
+	GlobalShadowForkDetector = &ShadowForkDetector{
+		logger:    logger,
+		rpcClient: rpcClient,
+	}
+
+	go GlobalShadowForkDetector.runCheckLoop()
+	return nil
+}
+
+// GetStatus returns the result of the latest divergence check, or nil if no check completed yet.
+func (sfd *ShadowForkDetector) GetStatus() *ShadowForkStatus {
+	sfd.mutex.RLock()
+	defer sfd.mutex.RUnlock()
+	return sfd.status
+}
+
+func (sfd *ShadowForkDetector) runCheckLoop() {
+	defer utils.HandleSubroutinePanic("ShadowForkDetector.runCheckLoop")
+
+	interval := utils.Config().ShadowFork.Interval
+	if interval == 0 {
+		interval = 1 * time.Minute
+	}
+
+	for {
+		status := sfd.checkDivergence()
+		if status.CheckError != "" {
+			sfd.logger.Warnf("shadow fork divergence check failed: %v", status.CheckError)
+		}
+
+		sfd.mutex.Lock()
+		if status.CheckError != "" && sfd.status != nil {
+			// keep the last known divergence point, only report the error
+			lastStatus := *sfd.status
+			lastStatus.CheckError = status.CheckError
+			status = &lastStatus
+		}
+		sfd.status = status
+		sfd.mutex.Unlock()
+
+		time.Sleep(interval)
+	}
+}
+
+func (sfd *ShadowForkDetector) checkDivergence() *ShadowForkStatus {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	status := &ShadowForkStatus{
+		LastCheck: time.Now(),
+	}
+
+	upstreamHead, err := sfd.getUpstreamBlock(ctx, "latest")
+	if err != nil {
+		status.CheckError = fmt.Sprintf("failed loading upstream head: %v", err)
+		return status
+	}
+	status.UpstreamHeadBlock = uint64(upstreamHead.Number)
+
+	firstBlock, lastBlock, found := db.GetCanonicalEthBlockRange()
+	if !found {
+		status.CheckError = "no indexed execution blocks"
+		return status
+	}
+	status.LocalFirstBlock = firstBlock
+	status.LocalHeadBlock = lastBlock
+
+	// only blocks known to both networks can be compared
+	lastBlock = min(lastBlock, status.UpstreamHeadBlock)
+	if lastBlock < firstBlock {
+		status.CheckError = "upstream is behind the first indexed block"
+		return status
+	}
+
+	// the fork point does not move once the diverging blocks are finalized, so start with the previously found range
+	lastStatus := sfd.GetStatus()
+	if lastStatus != nil && lastStatus.Diverged && lastStatus.ForkBlock.Number <= lastBlock {
+		forkBlock := lastStatus.ForkBlock
+		if matches, err := sfd.compareBlock(ctx, forkBlock); err == nil && !matches {
+			lastCommonMatches := lastStatus.LastCommonBlock == nil
+			if !lastCommonMatches {
+				lastCommonMatches, err = sfd.compareBlock(ctx, lastStatus.LastCommonBlock)
+			}
+			if err == nil && lastCommonMatches {
+				status.Diverged = true
+				status.LastCommonBlock = lastStatus.LastCommonBlock
+				status.ForkBlock = forkBlock
+				status.ForkTime = lastStatus.ForkTime
+				status.UpstreamForkHash = lastStatus.UpstreamForkHash
+				return status
+			}
+		}
+	}
+
+	lowRef := db.GetCanonicalEthBlockAtOrAfter(firstBlock)
+	highRef := db.GetCanonicalEthBlockAtOrAfter(lastBlock)
+	if lowRef == nil || highRef == nil {
+		status.CheckError = "failed loading indexed execution blocks"
+		return status
+	}
+
+	matches, err := sfd.compareBlock(ctx, highRef)
+	if err != nil {
+		status.CheckError = err.Error()
+		return status
+	}
+	if matches {
+		// no divergence up to the latest comparable block
+		status.LastCommonBlock = highRef
+		return status
+	}
+
+	matches, err = sfd.compareBlock(ctx, lowRef)
+	if err != nil {
+		status.CheckError = err.Error()
+		return status
+	}
+	if !matches {
+		// diverged before the first indexed block
+		highRef = lowRef
+		lowRef = nil
+	} else {
+		// binary search for the first diverging block, gaps in the indexed blocks are skipped
+		lowBound := lowRef.Number
+		highBound := highRef.Number
+		for highBound-lowBound > 1 {
+			midRef := db.GetCanonicalEthBlockAtOrAfter(lowBound + (highBound-lowBound)/2)
+			if midRef == nil || midRef.Number >= highRef.Number {
+				highBound = lowBound + (highBound-lowBound)/2
+				continue
+			}
+
+			matches, err := sfd.compareBlock(ctx, midRef)
+			if err != nil {
+				status.CheckError = err.Error()
+				return status
+			}
+			if matches {
+				lowRef = midRef
+				lowBound = midRef.Number
+			} else {
+				highRef = midRef
+				highBound = midRef.Number
+			}
+		}
+	}
+
+	upstreamBlock, err := sfd.getUpstreamBlock(ctx, hexutil.EncodeUint64(highRef.Number))
+	if err != nil {
+		status.CheckError = fmt.Sprintf("failed loading upstream block %v: %v", highRef.Number, err)
+		return status
+	}
+
+	status.Diverged = true
+	status.LastCommonBlock = lowRef
+	status.ForkBlock = highRef
+	status.ForkTime = GlobalBeaconService.GetChainState().SlotToTime(phase0.Slot(highRef.Slot))
+	status.UpstreamForkHash = upstreamBlock.Hash[:]
+
+	sfd.logger.Infof("shadow fork diverged from upstream at execution block %v (slot %v)", highRef.Number, highRef.Slot)
+	return status
+}
+
+// compareBlock returns true if the upstream block with the same number has the same hash as the indexed block.
+func (sfd *ShadowForkDetector) compareBlock(ctx context.Context, blockRef *dbtypes.EthBlockRef) (bool, error) {
+	upstreamBlock, err := sfd.getUpstreamBlock(ctx, hexutil.EncodeUint64(blockRef.Number))
+	if err != nil {
+		return false, fmt.Errorf("failed loading upstream block %v: %v", blockRef.Number, err)
+	}
+	return bytes.Equal(upstreamBlock.Hash[:], blockRef.Hash), nil
+}
+
+func (sfd *ShadowForkDetector) getUpstreamBlock(ctx context.Context, blockNumber string) (*shadowForkRpcBlock, error) {
+	var block *shadowForkRpcBlock
+	err := sfd.rpcClient.CallContext(ctx, &block, "eth_getBlockByNumber", blockNumber, false)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block not found")
+	}
+	return block, nil
+}
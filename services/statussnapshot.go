@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// StatusSnapshotPublisher periodically publishes a network summary json to a local file and/or s3 bucket,
+// so static status pages can embed the data without querying the explorer.
+type StatusSnapshotPublisher struct {
+	logger logrus.FieldLogger
+}
+
+// StatusSnapshot is the network summary published by the StatusSnapshotPublisher
+type StatusSnapshot struct {
+	Network         string                       `json:"network"`
+	Timestamp       int64                        `json:"timestamp"`
+	CurrentSlot     uint64                       `json:"current_slot"`
+	CurrentEpoch    uint64                       `json:"current_epoch"`
+	Head            StatusSnapshotHead           `json:"head"`
+	Finality        StatusSnapshotFinality       `json:"finality"`
+	Participation   *StatusSnapshotParticipation `json:"participation"`
+	Validators      StatusSnapshotValidators     `json:"validators"`
+	IndexerSynced   bool                         `json:"indexer_synced"`
+	ExplorerRelease string                       `json:"explorer_release"`
+}
+
+type StatusSnapshotHead struct {
+	Slot uint64 `json:"slot"`
+	Root string `json:"root"`
+}
+
+type StatusSnapshotFinality struct {
+	FinalizedEpoch uint64 `json:"finalized_epoch"`
+	FinalizedRoot  string `json:"finalized_root"`
+	JustifiedEpoch uint64 `json:"justified_epoch"`
+	JustifiedRoot  string `json:"justified_root"`
+}
+
+type StatusSnapshotParticipation struct {
+	Epoch               uint64  `json:"epoch"`
+	TargetParticipation float64 `json:"target_participation"`
+	HeadParticipation   float64 `json:"head_participation"`
+	TotalParticipation  float64 `json:"total_participation"`
+}
+
+type StatusSnapshotValidators struct {
+	Total            uint64 `json:"total"`
+	Active           uint64 `json:"active"`
+	Pending          uint64 `json:"pending"`
+	Exiting          uint64 `json:"exiting"`
+	Slashed          uint64 `json:"slashed"`
+	Exited           uint64 `json:"exited"`
+	EffectiveBalance uint64 `json:"active_effective_balance"`
+}
+
+var GlobalStatusSnapshotPublisher *StatusSnapshotPublisher
+
+// StartStatusSnapshotPublisher is used to start the global status snapshot publisher
+func StartStatusSnapshotPublisher(logger logrus.FieldLogger) error {
+	if GlobalStatusSnapshotPublisher != nil || !utils.Config().StatusSnapshot.Enabled {
+		return nil
+	}
+
+	if utils.Config().StatusSnapshot.Path == "" && utils.Config().StatusSnapshot.S3.Bucket == "" {
+		return fmt.Errorf("status snapshot publishing enabled, but neither a path nor a s3 bucket configured")
+	}
+
+	GlobalStatusSnapshotPublisher = &StatusSnapshotPublisher{
+		logger: logger,
+	}
+
+	go GlobalStatusSnapshotPublisher.runPublishLoop()
+	return nil
+}
+
+func (ssp *StatusSnapshotPublisher) runPublishLoop() {
+	defer utils.HandleSubroutinePanic("StatusSnapshotPublisher.runPublishLoop")
+
+	for {
+		err := ssp.publishSnapshot()
+		if err != nil {
+			ssp.logger.Warnf("failed publishing status snapshot: %v", err)
+		}
+
+		// re-read on every run, so changes by a config reload are picked up
+		interval := utils.Config().StatusSnapshot.Interval
+		if interval == 0 {
+			interval = 1 * time.Minute
+		}
+		time.Sleep(interval)
+	}
+}
+
+func (ssp *StatusSnapshotPublisher) publishSnapshot() error {
+	snapshot := ssp.buildSnapshot()
+	snapshotJson, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed encoding snapshot: %v", err)
+	}
+
+	config := &utils.Config().StatusSnapshot
+	if config.Path != "" {
+		// write to a temporary file first, so readers never see a partially written snapshot
+		tmpPath := config.Path + ".tmp"
+		err := os.MkdirAll(filepath.Dir(config.Path), 0755)
+		if err == nil {
+			err = os.WriteFile(tmpPath, snapshotJson, 0644)
+		}
+		if err == nil {
+			err = os.Rename(tmpPath, config.Path)
+		}
+		if err != nil {
+			return fmt.Errorf("failed writing snapshot file: %v", err)
+		}
+	}
+
+	if config.S3.Bucket != "" {
+		key := config.S3.Key
+		if key == "" {
+			key = "status.json"
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		err := utils.S3PutObject(ctx, &utils.S3Target{
+			Endpoint:  config.S3.Endpoint,
+			Region:    config.S3.Region,
+			Bucket:    config.S3.Bucket,
+			Key:       key,
+			AccessKey: config.S3.AccessKey,
+			SecretKey: config.S3.SecretKey,
+			PathStyle: config.S3.PathStyle,
+		}, snapshotJson, "application/json")
+		if err != nil {
+			return fmt.Errorf("failed uploading snapshot to s3: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (ssp *StatusSnapshotPublisher) buildSnapshot() *StatusSnapshot {
+	chainState := GlobalBeaconService.GetChainState()
+	specs := chainState.GetSpecs()
+
+	snapshot := &StatusSnapshot{
+		Network:         specs.ConfigName,
+		Timestamp:       time.Now().Unix(),
+		CurrentSlot:     uint64(chainState.CurrentSlot()),
+		CurrentEpoch:    uint64(chainState.CurrentEpoch()),
+		ExplorerRelease: utils.BuildRelease,
+	}
+	if utils.Config().Chain.DisplayName != "" {
+		snapshot.Network = utils.Config().Chain.DisplayName
+	}
+
+	if headBlock := GlobalBeaconService.GetBeaconIndexer().GetCanonicalHead(nil); headBlock != nil {
+		snapshot.Head.Slot = uint64(headBlock.Slot)
+		snapshot.Head.Root = fmt.Sprintf("0x%x", headBlock.Root[:])
+	}
+
+	finalizedEpoch, finalizedRoot := chainState.GetFinalizedCheckpoint()
+	justifiedEpoch, justifiedRoot := chainState.GetJustifiedCheckpoint()
+	snapshot.Finality = StatusSnapshotFinality{
+		FinalizedEpoch: uint64(finalizedEpoch),
+		FinalizedRoot:  fmt.Sprintf("0x%x", finalizedRoot[:]),
+		JustifiedEpoch: uint64(justifiedEpoch),
+		JustifiedRoot:  fmt.Sprintf("0x%x", justifiedRoot[:]),
+	}
+
+	// participation of the last finalized epoch
+	if finalizedEpoch > 0 {
+		epochs := db.GetEpochs(context.Background(), uint64(finalizedEpoch-1), 1)
+		if len(epochs) > 0 && epochs[0].Eligible > 0 {
+			epoch := epochs[0]
+			snapshot.Participation = &StatusSnapshotParticipation{
+				Epoch:               epoch.Epoch,
+				TargetParticipation: float64(epoch.VotedTarget) * 100 / float64(epoch.Eligible),
+				HeadParticipation:   float64(epoch.VotedHead) * 100 / float64(epoch.Eligible),
+				TotalParticipation:  float64(epoch.VotedTotal) * 100 / float64(epoch.Eligible),
+			}
+		}
+
+		syncRunning, syncHead := GlobalBeaconService.GetBeaconIndexer().GetSynchronizerState()
+		snapshot.IndexerSynced = !syncRunning || syncHead >= finalizedEpoch-1
+	} else {
+		snapshot.IndexerSynced = true
+	}
+
+	validatorSet := GlobalBeaconService.GetCachedValidatorSet(false)
+	for index := 0; index < validatorSet.Len(); index++ {
+		validator, found := validatorSet.GetRecord(phase0.ValidatorIndex(index))
+		if !found {
+			continue
+		}
+		status := validator.Status
+
+		snapshot.Validators.Total++
+		switch {
+		case status == v1.ValidatorStateActiveExiting:
+			snapshot.Validators.Exiting++
+		case status == v1.ValidatorStateActiveSlashed:
+			snapshot.Validators.Slashed++
+		case strings.HasPrefix(status.String(), "pending"):
+			snapshot.Validators.Pending++
+		case strings.HasPrefix(status.String(), "exited"), strings.HasPrefix(status.String(), "withdrawal"):
+			snapshot.Validators.Exited++
+		}
+		if status.IsActive() {
+			snapshot.Validators.Active++
+			snapshot.Validators.EffectiveBalance += uint64(validator.EffectiveBalance)
+		}
+	}
+
+	return snapshot
+}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/clients/consensus/rpc"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// validatorIncome is the income index: it loads the attestation, sync committee & proposal rewards of all validators
+// for each finalized epoch from the beacon api and stores them aggregated per income period.
+// the aggregation of the current period is kept in memory and written once the period is complete, so the indexer
+// restarts at the beginning of the unfinished period after a restart.
+type validatorIncome struct {
+	periodEpochs    uint64
+	retentionEpochs uint64
+	initialized     bool
+	periodStart     uint64
+	nextEpoch       uint64
+	incomes         []dbtypes.ValidatorIncome
+}
+
+func newValidatorIncome(epochDuration time.Duration) *validatorIncome {
+	income := &validatorIncome{
+		periodEpochs: utils.Config().IncomeIndexer.PeriodEpochs,
+	}
+	if income.periodEpochs == 0 {
+		income.periodEpochs = uint64(24 * time.Hour / epochDuration)
+		if income.periodEpochs == 0 {
+			income.periodEpochs = 1
+		}
+	}
+	if utils.Config().IncomeIndexer.Retention > 0 {
+		income.retentionEpochs = uint64(utils.Config().IncomeIndexer.Retention / epochDuration)
+	}
+	return income
+}
+
+// GetValidatorIncomePeriod returns the number of epochs aggregated per income record, or 0 if the income index is disabled.
+func (bs *ChainService) GetValidatorIncomePeriod() uint64 {
+	if bs.validatorIncome == nil {
+		return 0
+	}
+	return bs.validatorIncome.periodEpochs
+}
+
+// GetValidatorIncome returns the latest income records of a validator in descending order.
+func (bs *ChainService) GetValidatorIncome(ctx context.Context, validatorIndex phase0.ValidatorIndex, limit uint64) []*dbtypes.ValidatorIncome {
+	if bs.validatorIncome == nil {
+		return nil
+	}
+	return db.GetValidatorIncome(ctx, uint64(validatorIndex), limit)
+}
+
+func (bs *ChainService) runValidatorIncomeWorker() {
+	defer utils.HandleSubroutinePanic("ChainService.runValidatorIncomeWorker")
+
+	income := bs.validatorIncome
+	incomeState := dbtypes.ValidatorIncomeIndexerState{}
+	if _, err := db.GetExplorerState(context.Background(), "indexer.incomestate", &incomeState); err == nil && incomeState.NextEpoch > 0 {
+		income.startPeriod(incomeState.NextEpoch)
+		income.initialized = true
+	}
+
+	for {
+		if syncRunning, _ := bs.beaconIndexer.GetSynchronizerState(); !syncRunning {
+			err := bs.processValidatorIncome()
+			if err != nil {
+				bs.logger.Warnf("failed processing validator income: %v", err)
+			}
+		}
+
+		time.Sleep(1 * time.Minute)
+	}
+}
+
+func (income *validatorIncome) startPeriod(epoch uint64) {
+	income.periodStart = epoch - epoch%income.periodEpochs
+	income.nextEpoch = income.periodStart
+	income.incomes = income.incomes[:0]
+}
+
+// processValidatorIncome processes all epochs that are old enough to have their rewards finalized.
+func (bs *ChainService) processValidatorIncome() error {
+	income := bs.validatorIncome
+	finalizedEpoch, _ := bs.GetFinalizedEpoch()
+
+	// the attestation rewards of an epoch are known after the following epoch, so skip the last finalized epoch
+	if finalizedEpoch < 2 {
+		return nil
+	}
+	maxEpoch := uint64(finalizedEpoch) - 2
+
+	if !income.initialized {
+		// the first period is only indexed from the current epoch on, earlier states are usually not available anymore
+		income.startPeriod(maxEpoch)
+		income.nextEpoch = maxEpoch
+		income.initialized = true
+	}
+
+	for {
+		if income.nextEpoch == income.periodStart+income.periodEpochs {
+			err := bs.storeValidatorIncomePeriod()
+			if err != nil {
+				return fmt.Errorf("failed storing income period %v: %v", income.periodStart, err)
+			}
+			income.startPeriod(income.nextEpoch)
+		}
+		if income.nextEpoch > maxEpoch {
+			return nil
+		}
+
+		err := bs.processValidatorIncomeEpoch(income.nextEpoch)
+		if err != nil {
+			if maxEpoch < income.nextEpoch+income.periodEpochs {
+				return fmt.Errorf("epoch %v: %v", income.nextEpoch, err)
+			}
+
+			// the rewards of old epochs might not be available anymore, skip the epoch instead of getting stuck
+			bs.logger.Warnf("skipping validator income for epoch %v: %v", income.nextEpoch, err)
+		}
+		income.nextEpoch++
+	}
+}
+
+func (bs *ChainService) processValidatorIncomeEpoch(epoch uint64) error {
+	client := bs.beaconIndexer.GetReadyClient(true)
+	if client == nil {
+		return fmt.Errorf("no clients available")
+	}
+	rpcClient := client.GetClient().GetRPCClient()
+	chainState := bs.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	attestationRewards, err := rpcClient.GetAttestationRewards(ctx, epoch)
+	if err != nil {
+		return err
+	}
+
+	var syncRewards [][]*rpc.SyncCommitteeReward
+	var blockRewards []*rpc.BlockReward
+	firstSlot := uint64(chainState.EpochToSlot(phase0.Epoch(epoch)))
+	lastSlot := firstSlot + specs.SlotsPerEpoch - 1
+	syncCommitteeActive := specs.AltairForkEpoch != nil && epoch >= *specs.AltairForkEpoch
+	for _, block := range bs.GetDbBlocksForSlots(ctx, lastSlot, uint32(specs.SlotsPerEpoch), false, false) {
+		if block.Slot < firstSlot || block.Slot > lastSlot || block.Status != dbtypes.Canonical {
+			continue
+		}
+
+		blockReward, err := rpcClient.GetBlockRewards(ctx, block.Root)
+		if err != nil {
+			return fmt.Errorf("slot %v: %v", block.Slot, err)
+		}
+		if blockReward != nil {
+			blockRewards = append(blockRewards, blockReward)
+		}
+
+		if syncCommitteeActive {
+			syncReward, err := rpcClient.GetSyncCommitteeRewards(ctx, block.Root)
+			if err != nil {
+				return fmt.Errorf("slot %v: %v", block.Slot, err)
+			}
+			syncRewards = append(syncRewards, syncReward)
+		}
+	}
+
+	// all rewards of the epoch are loaded, so the epoch can not be accounted twice on errors
+	income := bs.validatorIncome
+	for _, reward := range attestationRewards {
+		validatorIncome := income.getIncome(reward.ValidatorIndex)
+		for _, amount := range []int64{reward.Head, reward.Target, reward.Source, reward.InclusionDelay} {
+			if amount > 0 {
+				validatorIncome.AttestationReward += amount
+			} else {
+				validatorIncome.Penalty -= amount
+			}
+		}
+		validatorIncome.Penalty -= reward.Inactivity
+	}
+	for _, blockSyncRewards := range syncRewards {
+		for _, reward := range blockSyncRewards {
+			validatorIncome := income.getIncome(reward.ValidatorIndex)
+			if reward.Reward > 0 {
+				validatorIncome.SyncReward += reward.Reward
+			} else {
+				validatorIncome.Penalty -= reward.Reward
+			}
+		}
+	}
+	for _, reward := range blockRewards {
+		income.getIncome(reward.ProposerIndex).ProposalReward += reward.Total
+	}
+
+	return nil
+}
+
+func (income *validatorIncome) getIncome(validatorIndex uint64) *dbtypes.ValidatorIncome {
+	if validatorIndex >= uint64(len(income.incomes)) {
+		income.incomes = append(income.incomes, make([]dbtypes.ValidatorIncome, validatorIndex+1-uint64(len(income.incomes)))...)
+	}
+	return &income.incomes[validatorIndex]
+}
+
+func (bs *ChainService) storeValidatorIncomePeriod() error {
+	income := bs.validatorIncome
+
+	incomes := make([]*dbtypes.ValidatorIncome, 0, len(income.incomes))
+	for index := range income.incomes {
+		validatorIncome := &income.incomes[index]
+		if validatorIncome.AttestationReward == 0 && validatorIncome.SyncReward == 0 && validatorIncome.ProposalReward == 0 && validatorIncome.Penalty == 0 {
+			continue
+		}
+		validatorIncome.ValidatorIndex = uint64(index)
+		validatorIncome.Epoch = income.periodStart
+		incomes = append(incomes, validatorIncome)
+	}
+
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		err := db.InsertValidatorIncome(incomes, tx)
+		if err != nil {
+			return err
+		}
+		if income.retentionEpochs > 0 && income.periodStart > income.retentionEpochs {
+			err = db.DeleteValidatorIncomeBefore(income.periodStart-income.retentionEpochs, tx)
+			if err != nil {
+				return err
+			}
+		}
+		return db.SetExplorerState("indexer.incomestate", &dbtypes.ValidatorIncomeIndexerState{
+			NextEpoch: income.periodStart + income.periodEpochs,
+		}, tx)
+	})
+	if err != nil {
+		return err
+	}
+
+	bs.logger.Infof("stored validator income for epochs %v-%v (%v validators)", income.periodStart, income.periodStart+income.periodEpochs-1, len(incomes))
+	return nil
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// ValidatorNameExport is a single validator name mapping with the source it was loaded from.
+type ValidatorNameExport struct {
+	Index  uint64
+	Name   string
+	Source string // "label" (imported at runtime), "config" (names yaml/inventory) or "resolved" (withdrawal/deposit address)
+}
+
+// loadFromDbLabels loads the runtime imported validator labels from the database.
+func (vn *ValidatorNames) loadFromDbLabels() int {
+	labels := db.GetValidatorLabels()
+
+	vn.namesMutex.Lock()
+	defer vn.namesMutex.Unlock()
+
+	for _, label := range labels {
+		vn.labelsByIndex[label.Index] = &validatorNameEntry{
+			name: label.Name,
+		}
+	}
+
+	return len(labels)
+}
+
+// ImportValidatorLabels persists the given validator labels and applies them immediately.
+// labels take precedence over config based names, an empty name removes the label for the index.
+// all changes are recorded in the label audit log with the given source.
+func (vn *ValidatorNames) ImportValidatorLabels(ctx context.Context, labels map[uint64]string, source string) (int, error) {
+	now := time.Now().Unix()
+	updateLabels := []*dbtypes.ValidatorLabel{}
+	deleteLabels := []uint64{}
+	changes := []*dbtypes.ValidatorLabelChange{}
+
+	vn.namesMutex.RLock()
+	for index, name := range labels {
+		oldName := ""
+		if oldLabel := vn.labelsByIndex[index]; oldLabel != nil {
+			oldName = oldLabel.name
+		}
+		if oldName == name {
+			continue
+		}
+
+		if name == "" {
+			deleteLabels = append(deleteLabels, index)
+		} else {
+			updateLabels = append(updateLabels, &dbtypes.ValidatorLabel{
+				Index:     index,
+				Name:      name,
+				UpdatedAt: now,
+			})
+		}
+
+		changes = append(changes, &dbtypes.ValidatorLabelChange{
+			Index:      index,
+			ChangeTime: now,
+			OldName:    oldName,
+			NewName:    name,
+			Source:     source,
+		})
+	}
+	vn.namesMutex.RUnlock()
+
+	if len(changes) == 0 {
+		return 0, nil
+	}
+
+	batchSize := 5000
+	err := db.RunDBTransactionContext(ctx, func(tx *sqlx.Tx) error {
+		for idx := 0; idx < len(updateLabels); idx += batchSize {
+			endIdx := min(idx+batchSize, len(updateLabels))
+			if err := db.InsertValidatorLabels(updateLabels[idx:endIdx], tx); err != nil {
+				return fmt.Errorf("error while inserting validator labels: %v", err)
+			}
+		}
+
+		for idx := 0; idx < len(deleteLabels); idx += batchSize {
+			endIdx := min(idx+batchSize, len(deleteLabels))
+			if err := db.DeleteValidatorLabels(deleteLabels[idx:endIdx], tx); err != nil {
+				return fmt.Errorf("error while deleting validator labels: %v", err)
+			}
+		}
+
+		for idx := 0; idx < len(changes); idx += batchSize {
+			endIdx := min(idx+batchSize, len(changes))
+			if err := db.InsertValidatorLabelChanges(changes[idx:endIdx], tx); err != nil {
+				return fmt.Errorf("error while inserting validator label changes: %v", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	vn.namesMutex.Lock()
+	if vn.labelsByIndex == nil {
+		vn.labelsByIndex = make(map[uint64]*validatorNameEntry)
+	}
+	for _, label := range updateLabels {
+		vn.labelsByIndex[label.Index] = &validatorNameEntry{
+			name: label.Name,
+		}
+	}
+	for _, index := range deleteLabels {
+		delete(vn.labelsByIndex, index)
+	}
+	vn.namesMutex.Unlock()
+
+	logger_vn.Infof("imported %v validator label changes from %v", len(changes), source)
+
+	go func() {
+		err := vn.UpdateDb()
+		if err != nil {
+			logger_vn.WithError(err).Errorf("error while updating validator names after label import")
+		}
+	}()
+
+	return len(changes), nil
+}
+
+// ExportValidatorNames returns all index based validator name mappings with their source, sorted by index.
+func (vn *ValidatorNames) ExportValidatorNames() []*ValidatorNameExport {
+	vn.namesMutex.RLock()
+	defer vn.namesMutex.RUnlock()
+
+	exportMap := map[uint64]*ValidatorNameExport{}
+	addNames := func(names map[uint64]*validatorNameEntry, source string) {
+		for index, name := range names {
+			if exportMap[index] != nil {
+				continue
+			}
+
+			exportMap[index] = &ValidatorNameExport{
+				Index:  index,
+				Name:   name.name,
+				Source: source,
+			}
+		}
+	}
+
+	// in order of precedence
+	addNames(vn.labelsByIndex, "label")
+	addNames(vn.namesByIndex, "config")
+	addNames(vn.resolvedNamesByIndex, "resolved")
+
+	exportList := make([]*ValidatorNameExport, 0, len(exportMap))
+	for _, entry := range exportMap {
+		exportList = append(exportList, entry)
+	}
+
+	sort.Slice(exportList, func(a, b int) bool {
+		return exportList[a].Index < exportList[b].Index
+	})
+
+	return exportList
+}
@@ -0,0 +1,255 @@
+package services
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// ValidatorMetrics tracks the attestation & proposal performance of the watched validators,
+// which is exposed in the prometheus exposition format for external alerting.
+type ValidatorMetrics struct {
+	logger         logrus.FieldLogger
+	metricsMutex   sync.RWMutex
+	validators     []*WatchedValidator
+	unresolved     []string
+	processedEpoch phase0.Epoch
+	initialized    bool
+}
+
+// WatchedValidator holds the tracked metrics of a watched validator.
+type WatchedValidator struct {
+	Index              phase0.ValidatorIndex
+	AttestationDuties  uint64 // number of checked epochs the validator was active in
+	MissedAttestations uint64 // number of checked epochs without an included vote
+	LastProposalSlot   *phase0.Slot
+}
+
+var GlobalValidatorMetrics *ValidatorMetrics
+
+// StartValidatorMetrics is used to start the global validator metrics tracker
+func StartValidatorMetrics(logger logrus.FieldLogger) error {
+	if GlobalValidatorMetrics != nil || !utils.Config().ValidatorMetrics.Enabled {
+		return nil
+	}
+
+	GlobalValidatorMetrics = &ValidatorMetrics{
+		logger:     logger,
+		validators: []*WatchedValidator{},
+		unresolved: utils.Config().ValidatorMetrics.Validators,
+	}
+
+	go GlobalValidatorMetrics.runMetricsLoop()
+	return nil
+}
+
+func (vm *ValidatorMetrics) runMetricsLoop() {
+	defer utils.HandleSubroutinePanic("ValidatorMetrics.runMetricsLoop")
+
+	for {
+		vm.updateMetrics()
+
+		interval := 12 * time.Second
+		if chainState := GlobalBeaconService.GetChainState(); chainState != nil && chainState.GetSpecs() != nil && chainState.GetSpecs().SecondsPerSlot > 0 {
+			interval = chainState.GetSpecs().SecondsPerSlot
+		}
+		time.Sleep(interval)
+	}
+}
+
+// GetWatchedValidators returns a copy of the tracked metrics of all resolved watched validators.
+func (vm *ValidatorMetrics) GetWatchedValidators() []WatchedValidator {
+	vm.metricsMutex.RLock()
+	defer vm.metricsMutex.RUnlock()
+
+	validators := make([]WatchedValidator, len(vm.validators))
+	for idx, validator := range vm.validators {
+		validators[idx] = *validator
+	}
+	return validators
+}
+
+func (vm *ValidatorMetrics) updateMetrics() {
+	chainState := GlobalBeaconService.GetChainState()
+	if chainState == nil || chainState.GetSpecs() == nil || GlobalBeaconService.GetBeaconIndexer() == nil {
+		return
+	}
+
+	vm.resolveValidators()
+
+	// votes for an epoch can be included until the end of the next epoch
+	currentEpoch := chainState.CurrentEpoch()
+	if currentEpoch < 2 {
+		return
+	}
+	checkEpoch := currentEpoch - 2
+
+	if !vm.initialized {
+		// the counters start with the current epoch, older epochs are not checked
+		vm.processedEpoch = checkEpoch
+		vm.initialized = true
+		return
+	}
+
+	for vm.processedEpoch < checkEpoch {
+		vm.processEpoch(vm.processedEpoch + 1)
+		vm.processedEpoch++
+	}
+}
+
+// resolveValidators resolves the configured validator indexes & pubkeys, unknown pubkeys are retried on the next update.
+func (vm *ValidatorMetrics) resolveValidators() {
+	if len(vm.unresolved) == 0 {
+		return
+	}
+
+	unresolved := []string{}
+	resolved := []*WatchedValidator{}
+	for _, validatorStr := range vm.unresolved {
+		validatorStr = strings.TrimSpace(validatorStr)
+		if validatorStr == "" {
+			continue
+		}
+
+		if strings.HasPrefix(validatorStr, "0x") {
+			pubkeyBytes, err := hex.DecodeString(validatorStr[2:])
+			if err != nil || len(pubkeyBytes) != len(phase0.BLSPubKey{}) {
+				vm.logger.Warnf("invalid watched validator pubkey: %v", validatorStr)
+				continue
+			}
+
+			index, found := GlobalBeaconService.GetValidatorIndexByPubkey(phase0.BLSPubKey(pubkeyBytes))
+			if !found {
+				unresolved = append(unresolved, validatorStr)
+				continue
+			}
+			resolved = append(resolved, &WatchedValidator{Index: index})
+		} else {
+			index, err := strconv.ParseUint(validatorStr, 10, 64)
+			if err != nil {
+				vm.logger.Warnf("invalid watched validator index: %v", validatorStr)
+				continue
+			}
+			resolved = append(resolved, &WatchedValidator{Index: phase0.ValidatorIndex(index)})
+		}
+	}
+	vm.unresolved = unresolved
+
+	if len(resolved) == 0 {
+		return
+	}
+
+	for _, validator := range resolved {
+		validator.LastProposalSlot = vm.getLastProposalSlot(validator.Index, nil)
+	}
+
+	vm.metricsMutex.Lock()
+	defer vm.metricsMutex.Unlock()
+
+	knownIndexes := map[phase0.ValidatorIndex]bool{}
+	for _, validator := range vm.validators {
+		knownIndexes[validator.Index] = true
+	}
+	for _, validator := range resolved {
+		if knownIndexes[validator.Index] {
+			continue
+		}
+		knownIndexes[validator.Index] = true
+		vm.validators = append(vm.validators, validator)
+	}
+	sort.Slice(vm.validators, func(a, b int) bool {
+		return vm.validators[a].Index < vm.validators[b].Index
+	})
+}
+
+// processEpoch checks the attestation duties & proposals of the watched validators for a completed epoch.
+func (vm *ValidatorMetrics) processEpoch(epoch phase0.Epoch) {
+	chainState := GlobalBeaconService.GetChainState()
+	beaconIndexer := GlobalBeaconService.GetBeaconIndexer()
+
+	epochStats := beaconIndexer.GetEpochStats(epoch, nil)
+	if epochStats == nil {
+		vm.logger.Debugf("skipping validator metrics for epoch %v: epoch stats not available", epoch)
+		return
+	}
+	epochStatsValues := epochStats.GetValues(true)
+	if epochStatsValues == nil {
+		vm.logger.Debugf("skipping validator metrics for epoch %v: epoch stats not loaded", epoch)
+		return
+	}
+
+	vm.metricsMutex.RLock()
+	validators := make([]*WatchedValidator, len(vm.validators))
+	copy(validators, vm.validators)
+	vm.metricsMutex.RUnlock()
+
+	firstSlot := chainState.EpochToSlot(epoch)
+	for _, validator := range validators {
+		activeIdx := sort.Search(len(epochStatsValues.ActiveIndices), func(i int) bool {
+			return epochStatsValues.ActiveIndices[i] >= validator.Index
+		})
+		isActive := activeIdx < len(epochStatsValues.ActiveIndices) && epochStatsValues.ActiveIndices[activeIdx] == validator.Index
+
+		voted := false
+		if isActive {
+			activity, _ := GlobalBeaconService.GetValidatorVotingActivity(validator.Index)
+			for _, vote := range activity {
+				if chainState.EpochOfSlot(vote.VoteBlock.Slot-phase0.Slot(vote.VoteDelay)) == epoch {
+					voted = true
+					break
+				}
+			}
+		}
+
+		lastProposal := vm.getLastProposalSlot(validator.Index, &firstSlot)
+
+		vm.metricsMutex.Lock()
+		if isActive {
+			validator.AttestationDuties++
+			if !voted {
+				validator.MissedAttestations++
+			}
+		}
+		if lastProposal != nil {
+			validator.LastProposalSlot = lastProposal
+		}
+		vm.metricsMutex.Unlock()
+	}
+}
+
+// getLastProposalSlot returns the slot of the latest canonical block proposed by the validator, optionally limited to blocks after minSlot.
+func (vm *ValidatorMetrics) getLastProposalSlot(validatorIndex phase0.ValidatorIndex, minSlot *phase0.Slot) *phase0.Slot {
+	proposerIndex := uint64(validatorIndex)
+	blockFilter := &dbtypes.BlockFilter{
+		ProposerIndex: &proposerIndex,
+		WithOrphaned:  0,
+		WithMissing:   0,
+	}
+	if minSlot != nil {
+		minSlotNum := uint64(*minSlot)
+		blockFilter.MinSlot = &minSlotNum
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	dbBlocks := GlobalBeaconService.GetDbBlocksByFilter(ctx, blockFilter, 0, 1, 0)
+	for _, dbBlock := range dbBlocks {
+		if dbBlock.Block == nil {
+			continue
+		}
+		slot := phase0.Slot(dbBlock.Slot)
+		return &slot
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -42,6 +43,7 @@ type ValidatorNames struct {
 	namesByDepositOrigin  map[common.Address]*validatorNameEntry
 	namesByDepositTarget  map[common.Address]*validatorNameEntry
 	resolvedNamesByIndex  map[uint64]*validatorNameEntry
+	labelsByIndex         map[uint64]*validatorNameEntry
 }
 
 type validatorNameEntry struct {
@@ -60,13 +62,6 @@ func (vn *ValidatorNames) StartUpdater() {
 	if vn.updaterRunning {
 		return
 	}
-	if utils.Config.Frontend.ValidatorNamesRefreshInterval == 0 {
-		utils.Config.Frontend.ValidatorNamesRefreshInterval = 2 * time.Hour
-	}
-	if utils.Config.Frontend.ValidatorNamesResolveInterval == 0 {
-		utils.Config.Frontend.ValidatorNamesResolveInterval = 6 * time.Hour
-	}
-
 	vn.updaterRunning = true
 	go vn.runUpdaterLoop()
 }
@@ -87,14 +82,24 @@ func (vn *ValidatorNames) runUpdaterLoop() {
 func (vn *ValidatorNames) runUpdater() error {
 	needUpdate := false
 
-	if utils.Config.Frontend.ValidatorNamesRefreshInterval > 0 && time.Since(vn.lastInventoryRefresh) > utils.Config.Frontend.ValidatorNamesRefreshInterval {
+	// the intervals are re-read on every run, so changes by a config reload are picked up
+	refreshInterval := utils.Config().Frontend.ValidatorNamesRefreshInterval
+	if refreshInterval == 0 {
+		refreshInterval = 2 * time.Hour
+	}
+	resolveInterval := utils.Config().Frontend.ValidatorNamesResolveInterval
+	if resolveInterval == 0 {
+		resolveInterval = 6 * time.Hour
+	}
+
+	if time.Since(vn.lastInventoryRefresh) > refreshInterval {
 		logger_vn.Infof("refreshing validator inventory")
 		loadingChan := vn.LoadValidatorNames()
 		<-loadingChan
 		needUpdate = true
 	}
 
-	if time.Since(vn.lastResolvedMapUpdate) > utils.Config.Frontend.ValidatorNamesResolveInterval {
+	if time.Since(vn.lastResolvedMapUpdate) > resolveInterval {
 		changes, err := vn.resolveNames()
 		if err != nil {
 			return err
@@ -135,8 +140,8 @@ func (vn *ValidatorNames) getDefaultValidatorNames() string {
 }
 
 func (vn *ValidatorNames) resolveNames() (bool, error) {
-	validatorSet := vn.beaconIndexer.GetValidatorSet(nil)
-	if validatorSet == nil {
+	validatorSet := vn.beaconIndexer.GetValidatorSetView(nil)
+	if validatorSet.Len() == 0 {
 		return false, fmt.Errorf("validator set not ready")
 	}
 
@@ -153,12 +158,13 @@ func (vn *ValidatorNames) resolveNames() (bool, error) {
 
 	// resolve names by withdrawal address
 	validatorSetMap := map[phase0.BLSPubKey]uint64{}
-	for vidx, validator := range validatorSet {
-		if validator == nil {
+	for vidx := 0; vidx < validatorSet.Len(); vidx++ {
+		validator, found := validatorSet.GetRecord(phase0.ValidatorIndex(vidx))
+		if !found {
 			continue
 		}
 
-		validatorSetMap[validator.PublicKey] = uint64(vidx)
+		validatorSetMap[validator.Pubkey] = uint64(vidx)
 
 		if validator.WithdrawalCredentials[0] == 0x00 {
 			continue
@@ -177,7 +183,7 @@ func (vn *ValidatorNames) resolveNames() (bool, error) {
 		pageSize := uint64(5000)
 
 		for {
-			deposits, depositCount, _ := db.GetDepositTxsFiltered(offset, uint32(pageSize), 0, &dbtypes.DepositTxFilter{
+			deposits, depositCount, _ := db.GetDepositTxsFiltered(context.Background(), offset, uint32(pageSize), 0, &dbtypes.DepositTxFilter{
 				Address: address[:],
 			})
 			for _, deposit := range deposits {
@@ -200,7 +206,7 @@ func (vn *ValidatorNames) resolveNames() (bool, error) {
 		pageSize := uint64(5000)
 
 		for {
-			deposits, depositCount, _ := db.GetDepositTxsFiltered(offset, uint32(pageSize), 0, &dbtypes.DepositTxFilter{
+			deposits, depositCount, _ := db.GetDepositTxsFiltered(context.Background(), offset, uint32(pageSize), 0, &dbtypes.DepositTxFilter{
 				TargetAddress: address[:],
 			})
 			for _, deposit := range deposits {
@@ -242,7 +248,12 @@ func (vn *ValidatorNames) GetValidatorName(index uint64) string {
 		return ""
 	}
 
-	name := vn.namesByIndex[index]
+	name := vn.labelsByIndex[index]
+	if name != nil {
+		return name.name
+	}
+
+	name = vn.namesByIndex[index]
 	if name != nil {
 		return name.name
 	}
@@ -255,6 +266,45 @@ func (vn *ValidatorNames) GetValidatorName(index uint64) string {
 	return ""
 }
 
+// GetValidatorNames returns the names of the given validators, validators without a name are omitted.
+// the names lock is acquired once for all indices, which avoids per-row locking on large list pages.
+func (vn *ValidatorNames) GetValidatorNames(indices []uint64) map[uint64]string {
+	names := make(map[uint64]string, len(indices))
+	if !vn.namesMutex.TryRLock() {
+		return names
+	}
+	defer vn.namesMutex.RUnlock()
+	if vn.namesByIndex == nil {
+		return names
+	}
+
+	for _, index := range indices {
+		if name := vn.labelsByIndex[index]; name != nil {
+			names[index] = name.name
+		} else if name := vn.namesByIndex[index]; name != nil {
+			names[index] = name.name
+		} else if name := vn.resolvedNamesByIndex[index]; name != nil {
+			names[index] = name.name
+		}
+	}
+
+	return names
+}
+
+// getAllValidatorNames returns the names of all named validators, labels take precedence over the configured & resolved names.
+func (vn *ValidatorNames) getAllValidatorNames() map[uint64]string {
+	vn.namesMutex.RLock()
+	defer vn.namesMutex.RUnlock()
+
+	names := make(map[uint64]string, len(vn.namesByIndex)+len(vn.resolvedNamesByIndex)+len(vn.labelsByIndex))
+	for _, nameMap := range []map[uint64]*validatorNameEntry{vn.resolvedNamesByIndex, vn.namesByIndex, vn.labelsByIndex} {
+		for index, name := range nameMap {
+			names[index] = name.name
+		}
+	}
+	return names
+}
+
 func (vn *ValidatorNames) GetValidatorNameByPubkey(pubkey []byte) string {
 	validatorIndex, found := vn.beaconIndexer.GetValidatorIndexByPubkey(phase0.BLSPubKey(pubkey))
 	if !found {
@@ -272,7 +322,7 @@ func (vn *ValidatorNames) GetValidatorNamesCount() uint64 {
 	if vn.namesByIndex == nil {
 		return 0
 	}
-	return uint64(len(maps.Keys(vn.namesByIndex)) + len(maps.Keys(vn.namesByWithdrawal)))
+	return uint64(len(maps.Keys(vn.namesByIndex)) + len(maps.Keys(vn.namesByWithdrawal)) + len(maps.Keys(vn.labelsByIndex)))
 }
 
 func (vn *ValidatorNames) LoadValidatorNames() chan bool {
@@ -297,9 +347,16 @@ func (vn *ValidatorNames) LoadValidatorNames() chan bool {
 		vn.namesByWithdrawal = make(map[common.Address]*validatorNameEntry)
 		vn.namesByDepositOrigin = make(map[common.Address]*validatorNameEntry)
 		vn.namesByDepositTarget = make(map[common.Address]*validatorNameEntry)
+		vn.labelsByIndex = make(map[uint64]*validatorNameEntry)
 		vn.namesMutex.Unlock()
 
-		validatorNamesYaml := utils.Config.Frontend.ValidatorNamesYaml
+		// load runtime imported labels
+		labelCount := vn.loadFromDbLabels()
+		if labelCount > 0 {
+			logger_vn.Infof("loaded %v validator labels from db", labelCount)
+		}
+
+		validatorNamesYaml := utils.Config().Frontend.ValidatorNamesYaml
 		if validatorNamesYaml == "" {
 			validatorNamesYaml = vn.getDefaultValidatorNames()
 		}
@@ -316,8 +373,8 @@ func (vn *ValidatorNames) LoadValidatorNames() chan bool {
 				logger_vn.WithError(err).Errorf("error while loading validator names from yaml")
 			}
 		}
-		if utils.Config.Frontend.ValidatorNamesInventory != "" {
-			err := vn.loadFromRangesApi(utils.Config.Frontend.ValidatorNamesInventory)
+		if utils.Config().Frontend.ValidatorNamesInventory != "" {
+			err := vn.loadFromRangesApi(utils.Config().Frontend.ValidatorNamesInventory)
 			if err != nil {
 				logger_vn.WithError(err).Errorf("error while loading validator names inventory")
 			}
@@ -451,7 +508,17 @@ func (vn *ValidatorNames) UpdateDb() error {
 	vn.namesMutex.RLock()
 	nameRows := make([]*dbtypes.ValidatorName, 0)
 	hasName := map[uint64]bool{}
+	for index, name := range vn.labelsByIndex {
+		hasName[index] = true
+		nameRows = append(nameRows, &dbtypes.ValidatorName{
+			Index: index,
+			Name:  name.name,
+		})
+	}
 	for index, name := range vn.namesByIndex {
+		if hasName[index] {
+			continue
+		}
 		hasName[index] = true
 		nameRows = append(nameRows, &dbtypes.ValidatorName{
 			Index: index,
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+const (
+	maxValidatorNoteLength = 2000
+	maxValidatorNoteTags   = 10
+)
+
+var validatorNoteTagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.:-]{0,49}$`)
+
+// ValidatorNote is a freeform note attached to a validator, including the tags of the note.
+type ValidatorNote struct {
+	Id             uint64
+	ValidatorIndex uint64
+	Note           string
+	Author         string
+	CreatedAt      time.Time
+	Tags           []string
+}
+
+// NormalizeValidatorNoteTag returns the lower cased tag and whether it is a valid tag.
+func NormalizeValidatorNoteTag(tag string) (string, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	return tag, validatorNoteTagPattern.MatchString(tag)
+}
+
+// AddValidatorNote validates and stores a note with optional tags for the given validator.
+func (bs *ChainService) AddValidatorNote(ctx context.Context, validatorIndex uint64, note string, tags []string, author string) (uint64, error) {
+	note = strings.TrimSpace(note)
+	if note == "" && len(tags) == 0 {
+		return 0, fmt.Errorf("note or tags required")
+	}
+	if len(note) > maxValidatorNoteLength {
+		return 0, fmt.Errorf("note exceeds %v characters", maxValidatorNoteLength)
+	}
+	if len(tags) > maxValidatorNoteTags {
+		return 0, fmt.Errorf("more than %v tags", maxValidatorNoteTags)
+	}
+	if bs.GetValidatorByIndex(phase0.ValidatorIndex(validatorIndex), false) == nil {
+		return 0, fmt.Errorf("validator %v not found", validatorIndex)
+	}
+
+	tagMap := map[string]bool{}
+	noteTags := []string{}
+	for _, tag := range tags {
+		tag, valid := NormalizeValidatorNoteTag(tag)
+		if !valid {
+			return 0, fmt.Errorf("invalid tag %q (allowed: a-z, 0-9, _.:- with up to 50 characters)", tag)
+		}
+		if tagMap[tag] {
+			continue
+		}
+		tagMap[tag] = true
+		noteTags = append(noteTags, tag)
+	}
+	sort.Strings(noteTags)
+
+	// truncate by characters, cutting the bytes could split a multi-byte character
+	if chars := []rune(author); len(chars) > 250 {
+		author = string(chars[:250])
+	}
+
+	var noteId uint64
+	err := db.RunDBTransactionContext(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		noteId, err = db.InsertValidatorNote(&dbtypes.ValidatorNote{
+			ValidatorIndex: validatorIndex,
+			Note:           note,
+			Author:         author,
+			CreatedAt:      time.Now().Unix(),
+		}, noteTags, tx)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error storing validator note: %v", err)
+	}
+
+	return noteId, nil
+}
+
+// DeleteValidatorNote removes the note with the given id, returns the validator index of the removed note.
+func (bs *ChainService) DeleteValidatorNote(ctx context.Context, noteId uint64) (uint64, error) {
+	note := db.GetValidatorNote(ctx, noteId)
+	if note == nil {
+		return 0, fmt.Errorf("note %v not found", noteId)
+	}
+
+	err := db.RunDBTransactionContext(ctx, func(tx *sqlx.Tx) error {
+		_, err := db.DeleteValidatorNote(noteId, tx)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error deleting validator note: %v", err)
+	}
+
+	return note.ValidatorIndex, nil
+}
+
+// GetValidatorNotes returns the notes of a validator, newest first.
+func (bs *ChainService) GetValidatorNotes(ctx context.Context, validatorIndex uint64) []*ValidatorNote {
+	noteTags := map[uint64][]string{}
+	for _, tag := range db.GetValidatorNoteTags(ctx, validatorIndex) {
+		noteTags[tag.NoteId] = append(noteTags[tag.NoteId], tag.Tag)
+	}
+
+	notes := []*ValidatorNote{}
+	for _, dbNote := range db.GetValidatorNotes(ctx, validatorIndex) {
+		notes = append(notes, &ValidatorNote{
+			Id:             dbNote.Id,
+			ValidatorIndex: dbNote.ValidatorIndex,
+			Note:           dbNote.Note,
+			Author:         dbNote.Author,
+			CreatedAt:      time.Unix(dbNote.CreatedAt, 0),
+			Tags:           noteTags[dbNote.Id],
+		})
+	}
+	return notes
+}
+
+// GetValidatorNoteTags returns the distinct tags of all notes of a validator.
+func (bs *ChainService) GetValidatorNoteTags(ctx context.Context, validatorIndex uint64) []string {
+	tags := []string{}
+	for _, tag := range db.GetValidatorNoteTags(ctx, validatorIndex) {
+		if len(tags) == 0 || tags[len(tags)-1] != tag.Tag {
+			tags = append(tags, tag.Tag)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+	blsu "github.com/protolambda/bls12-381-util"
+	zrnt_common "github.com/protolambda/zrnt/eth2/beacon/common"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+const (
+	maxValidatorPrivateLabelLength = 100
+	ownershipChallengeWindow       = 1 * time.Hour
+)
+
+// ownershipProofDomainType is an application domain type (DOMAIN_APPLICATION_MASK), so ownership proofs can't be replayed as beacon chain messages
+var ownershipProofDomainType = zrnt_common.BLSDomainType{0x00, 0x00, 0x00, 0x01}
+
+// ValidatorOwnershipChallenge is the message a validator key has to sign to prove its ownership to a session.
+type ValidatorOwnershipChallenge struct {
+	Challenge   phase0.Root
+	Domain      phase0.Domain
+	SigningRoot phase0.Root
+	ValidUntil  time.Time
+}
+
+// ValidatorOwnership is a validator that has been proven to be owned by a session.
+type ValidatorOwnership struct {
+	ValidatorIndex uint64
+	ProvenAt       time.Time
+	PrivateLabel   string
+}
+
+// GetValidatorOwnershipChallenge returns the current ownership challenge for a session.
+// the challenge is derived from the session hash and rotates every hour, so no challenge state needs to be kept.
+func (bs *ChainService) GetValidatorOwnershipChallenge(sessionHash []byte) *ValidatorOwnershipChallenge {
+	window := uint64(time.Now().Unix()) / uint64(ownershipChallengeWindow.Seconds())
+	return bs.getValidatorOwnershipChallenge(sessionHash, window)
+}
+
+func (bs *ChainService) getValidatorOwnershipChallenge(sessionHash []byte, window uint64) *ValidatorOwnershipChallenge {
+	genesis := bs.consensusPool.GetChainState().GetGenesis()
+	if genesis == nil {
+		return nil
+	}
+
+	challengeData := make([]byte, 0, 64)
+	challengeData = append(challengeData, []byte("dora validator ownership proof")...)
+	challengeData = append(challengeData, sessionHash...)
+	challengeData = binary.BigEndian.AppendUint64(challengeData, window)
+	challenge := sha256.Sum256(challengeData)
+
+	domain := zrnt_common.ComputeDomain(ownershipProofDomainType, zrnt_common.Version(genesis.GenesisForkVersion), zrnt_common.Root(genesis.GenesisValidatorsRoot))
+	signingRoot := zrnt_common.ComputeSigningRoot(challenge, domain)
+
+	return &ValidatorOwnershipChallenge{
+		Challenge:   challenge,
+		Domain:      phase0.Domain(domain),
+		SigningRoot: phase0.Root(signingRoot),
+		ValidUntil:  time.Unix(int64((window+1)*uint64(ownershipChallengeWindow.Seconds())), 0),
+	}
+}
+
+// VerifyValidatorOwnership checks the signature over the sessions ownership challenge and associates the validator with the session.
+// signatures over the previous challenge are accepted too, so a proof doesn't fail when the challenge rotates while signing.
+func (bs *ChainService) VerifyValidatorOwnership(ctx context.Context, sessionHash []byte, validatorIndex phase0.ValidatorIndex, signature []byte) error {
+	validator := bs.GetValidatorByIndex(validatorIndex, false)
+	if validator == nil {
+		return fmt.Errorf("validator %v not found", validatorIndex)
+	}
+	if len(signature) != 96 {
+		return fmt.Errorf("invalid signature length")
+	}
+
+	pubkeyData := zrnt_common.BLSPubkey(validator.Validator.PublicKey)
+	pubkey, err := pubkeyData.Pubkey()
+	if err != nil {
+		return fmt.Errorf("invalid validator pubkey: %v", err)
+	}
+	sigData := zrnt_common.BLSSignature(signature)
+	sig, err := sigData.Signature()
+	if err != nil {
+		return fmt.Errorf("invalid signature: %v", err)
+	}
+
+	window := uint64(time.Now().Unix()) / uint64(ownershipChallengeWindow.Seconds())
+	validSignature := false
+	for _, challengeWindow := range []uint64{window, window - 1} {
+		challenge := bs.getValidatorOwnershipChallenge(sessionHash, challengeWindow)
+		if challenge == nil {
+			return fmt.Errorf("chain genesis not loaded")
+		}
+		if blsu.Verify(pubkey, challenge.SigningRoot[:], sig) {
+			validSignature = true
+			break
+		}
+	}
+	if !validSignature {
+		return fmt.Errorf("signature does not match the ownership challenge of validator %v", validatorIndex)
+	}
+
+	err = db.RunDBTransactionContext(ctx, func(tx *sqlx.Tx) error {
+		return db.InsertValidatorOwnershipProof(&dbtypes.ValidatorOwnershipProof{
+			SessionHash:    sessionHash,
+			ValidatorIndex: uint64(validatorIndex),
+			ProvenAt:       time.Now().Unix(),
+		}, tx)
+	})
+	if err != nil {
+		return fmt.Errorf("error storing ownership proof: %v", err)
+	}
+
+	return nil
+}
+
+// GetValidatorOwnerships returns the validators that have been proven to be owned by a session.
+func (bs *ChainService) GetValidatorOwnerships(ctx context.Context, sessionHash []byte) []*ValidatorOwnership {
+	ownerships := []*ValidatorOwnership{}
+	for _, proof := range db.GetValidatorOwnershipProofs(ctx, sessionHash) {
+		ownerships = append(ownerships, &ValidatorOwnership{
+			ValidatorIndex: proof.ValidatorIndex,
+			ProvenAt:       time.Unix(proof.ProvenAt, 0),
+			PrivateLabel:   proof.PrivateLabel,
+		})
+	}
+	return ownerships
+}
+
+// GetValidatorOwnership returns the ownership of a validator by a session, or nil if the session didn't prove its ownership.
+func (bs *ChainService) GetValidatorOwnership(ctx context.Context, sessionHash []byte, validatorIndex uint64) *ValidatorOwnership {
+	proof := db.GetValidatorOwnershipProof(ctx, sessionHash, validatorIndex)
+	if proof == nil {
+		return nil
+	}
+	return &ValidatorOwnership{
+		ValidatorIndex: proof.ValidatorIndex,
+		ProvenAt:       time.Unix(proof.ProvenAt, 0),
+		PrivateLabel:   proof.PrivateLabel,
+	}
+}
+
+// SetValidatorPrivateLabel sets the private label of a validator, which is only visible to the session that proved its ownership.
+func (bs *ChainService) SetValidatorPrivateLabel(ctx context.Context, sessionHash []byte, validatorIndex uint64, label string) error {
+	label = strings.TrimSpace(label)
+	if len(label) > maxValidatorPrivateLabelLength {
+		return fmt.Errorf("label exceeds %v characters", maxValidatorPrivateLabelLength)
+	}
+
+	updated := false
+	err := db.RunDBTransactionContext(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		updated, err = db.UpdateValidatorOwnershipLabel(sessionHash, validatorIndex, label, tx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error storing private label: %v", err)
+	}
+	if !updated {
+		return fmt.Errorf("ownership of validator %v not proven", validatorIndex)
+	}
+	return nil
+}
+
+// RemoveValidatorOwnership removes the association of a validator with a session, including its private label.
+func (bs *ChainService) RemoveValidatorOwnership(ctx context.Context, sessionHash []byte, validatorIndex uint64) error {
+	err := db.RunDBTransactionContext(ctx, func(tx *sqlx.Tx) error {
+		_, err := db.DeleteValidatorOwnershipProof(sessionHash, validatorIndex, tx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error removing ownership proof: %v", err)
+	}
+	return nil
+}
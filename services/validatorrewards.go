@@ -0,0 +1,430 @@
+package services
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer/beacon"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// validatorRewardsBlockDelay is the number of slots a block needs to be buried before its balance flows are accounted.
+const validatorRewardsBlockDelay = 64
+
+// validatorRewardsSnapshotSize is the encoded size of a validator in a reward snapshot (balance & cumulative flow).
+const validatorRewardsSnapshotSize = 16
+
+// ValidatorApyWindow describes a time window the validator apy is computed for.
+type ValidatorApyWindow struct {
+	Name         string
+	Duration     time.Duration
+	WindowEpochs uint64
+	FirstEpoch   uint64 // epoch of the reference snapshot
+	LastEpoch    uint64 // epoch of the latest snapshot
+	apyValues    []float32
+}
+
+type validatorRewardsFlow struct {
+	index  phase0.ValidatorIndex
+	amount int64
+}
+
+type validatorRewardsBlock struct {
+	slot  phase0.Slot
+	flows []validatorRewardsFlow
+}
+
+// validatorRewards is the rewards index: it stores a snapshot of all validator balances once per day and computes the
+// annualized return of each validator between the latest snapshot and the snapshots at the start of the apy windows.
+// withdrawals and deposits between two snapshots are tracked from the processed blocks and stored as cumulative flow
+// per validator, so balance changes that are no rewards do not affect the apy.
+// snapshots that follow each other without interruption share the same base epoch, flows are not known across chains.
+type validatorRewards struct {
+	snapshotInterval uint64
+	pendingBlocks    []*beacon.Block
+	resolvedBlocks   []*validatorRewardsBlock
+	lastSnapshot     *dbtypes.ValidatorRewardSnapshot
+	lastFlows        []int64
+
+	apyMutex sync.RWMutex
+	windows  []*ValidatorApyWindow
+}
+
+func newValidatorRewards(epochDuration time.Duration) *validatorRewards {
+	rewards := &validatorRewards{}
+	for _, window := range []struct {
+		name     string
+		duration time.Duration
+	}{
+		{"1d", 24 * time.Hour},
+		{"7d", 7 * 24 * time.Hour},
+		{"30d", 30 * 24 * time.Hour},
+	} {
+		windowEpochs := uint64(window.duration / epochDuration)
+		if windowEpochs == 0 {
+			windowEpochs = 1
+		}
+		rewards.windows = append(rewards.windows, &ValidatorApyWindow{
+			Name:         window.name,
+			Duration:     window.duration,
+			WindowEpochs: windowEpochs,
+		})
+	}
+	rewards.snapshotInterval = rewards.windows[0].WindowEpochs
+	return rewards
+}
+
+// GetValidatorApyWindows returns the apy windows of the rewards index.
+func (bs *ChainService) GetValidatorApyWindows() []*ValidatorApyWindow {
+	if bs.validatorRewards == nil {
+		return nil
+	}
+
+	bs.validatorRewards.apyMutex.RLock()
+	defer bs.validatorRewards.apyMutex.RUnlock()
+
+	windows := make([]*ValidatorApyWindow, len(bs.validatorRewards.windows))
+	for idx, window := range bs.validatorRewards.windows {
+		windowCopy := *window
+		windowCopy.apyValues = nil
+		windows[idx] = &windowCopy
+	}
+	return windows
+}
+
+// GetValidatorApy returns the annualized return in percent of a validator within the named apy window.
+// The second return value is false if there is no apy for the validator in this window.
+func (bs *ChainService) GetValidatorApy(validatorIndex phase0.ValidatorIndex, window string) (float64, bool) {
+	if bs.validatorRewards == nil {
+		return 0, false
+	}
+
+	bs.validatorRewards.apyMutex.RLock()
+	defer bs.validatorRewards.apyMutex.RUnlock()
+
+	for _, apyWindow := range bs.validatorRewards.windows {
+		if apyWindow.Name != window {
+			continue
+		}
+		if uint64(validatorIndex) >= uint64(len(apyWindow.apyValues)) {
+			return 0, false
+		}
+		apy := apyWindow.apyValues[validatorIndex]
+		if math.IsNaN(float64(apy)) {
+			return 0, false
+		}
+		return float64(apy), true
+	}
+	return 0, false
+}
+
+// GetHistoricValidatorSet returns the validator set as of a finalized epoch for historical views.
+// the balances are taken from the reward snapshot of the epoch, if no snapshot was stored for the epoch the set only holds effective balances.
+func (bs *ChainService) GetHistoricValidatorSet(ctx context.Context, epoch phase0.Epoch) *beacon.ValidatorSetView {
+	var balances []phase0.Gwei
+	if snapshot := db.GetValidatorRewardSnapshot(ctx, uint64(epoch)); snapshot != nil && uint64(len(snapshot.Balances)) >= snapshot.ValidatorCount*validatorRewardsSnapshotSize {
+		balances = make([]phase0.Gwei, snapshot.ValidatorCount)
+		for index := range balances {
+			balances[index] = phase0.Gwei(binary.LittleEndian.Uint64(snapshot.Balances[index*validatorRewardsSnapshotSize:]))
+		}
+	}
+
+	return bs.beaconIndexer.GetHistoricValidatorSetView(epoch, balances)
+}
+
+// GetValidatorBalanceSnapshotEpochs returns the epochs with stored validator balances in descending order.
+// historical views of these epochs include the full validator balances.
+func (bs *ChainService) GetValidatorBalanceSnapshotEpochs(ctx context.Context) []uint64 {
+	snapshots := db.GetValidatorRewardSnapshotEpochs(ctx)
+	epochs := make([]uint64, len(snapshots))
+	for idx, snapshot := range snapshots {
+		epochs[idx] = snapshot.Epoch
+	}
+	return epochs
+}
+
+func (bs *ChainService) runValidatorRewardsWorker() {
+	defer utils.HandleSubroutinePanic("ChainService.runValidatorRewardsWorker")
+
+	rewards := bs.validatorRewards
+	chainState := bs.consensusPool.GetChainState()
+	blockSubscription := bs.beaconIndexer.SubscribeBlockEvent(100)
+	defer blockSubscription.Unsubscribe()
+
+	// the flows since the last stored snapshot are unknown after a restart, so the next snapshot starts a new chain
+	if snapshots := db.GetValidatorRewardSnapshotEpochs(context.Background()); len(snapshots) > 0 {
+		rewards.lastSnapshot = db.GetValidatorRewardSnapshot(context.Background(), snapshots[0].Epoch)
+		if rewards.lastSnapshot != nil {
+			rewards.updateApy(rewards.lastSnapshot, snapshots)
+			rewards.lastSnapshot = nil
+		}
+	}
+
+	ticker := time.NewTicker(1 * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case block := <-blockSubscription.Channel():
+			rewards.pendingBlocks = append(rewards.pendingBlocks, block)
+		case <-ticker.C:
+			if headSlot := chainState.CurrentSlot(); headSlot > validatorRewardsBlockDelay {
+				rewards.resolvePendingBlocks(bs.beaconIndexer, headSlot-validatorRewardsBlockDelay, nil)
+			}
+
+			if syncRunning, _ := bs.beaconIndexer.GetSynchronizerState(); syncRunning {
+				continue
+			}
+
+			err := bs.storeValidatorRewardSnapshot()
+			if err != nil {
+				bs.logger.Errorf("failed storing validator reward snapshot: %v", err)
+			}
+		}
+	}
+}
+
+// resolvePendingBlocks converts the pending canonical blocks up to maxSlot to balance flows and drops orphaned blocks.
+// if a dependent root is given, blocks are checked against it instead of the canonical head.
+func (rewards *validatorRewards) resolvePendingBlocks(indexer *beacon.Indexer, maxSlot phase0.Slot, dependentRoot *phase0.Root) {
+	pendingBlocks := make([]*beacon.Block, 0, len(rewards.pendingBlocks))
+	for _, block := range rewards.pendingBlocks {
+		if block.Slot > maxSlot {
+			pendingBlocks = append(pendingBlocks, block)
+			continue
+		}
+
+		isCanonical := false
+		if dependentRoot != nil {
+			isCanonical, _ = indexer.GetBlockDistance(block.Root, *dependentRoot)
+		} else {
+			isCanonical = indexer.IsCanonicalBlock(block, nil)
+		}
+		if !isCanonical {
+			continue
+		}
+
+		blockBody := block.GetBlock()
+		if blockBody == nil {
+			continue
+		}
+
+		rewardsBlock := &validatorRewardsBlock{
+			slot: block.Slot,
+		}
+
+		withdrawals, _ := blockBody.Withdrawals()
+		for _, withdrawal := range withdrawals {
+			rewardsBlock.flows = append(rewardsBlock.flows, validatorRewardsFlow{
+				index:  withdrawal.ValidatorIndex,
+				amount: int64(withdrawal.Amount),
+			})
+		}
+
+		// deposits are accounted on inclusion, top-ups of new validators do not matter as their initial balance is unknown
+		deposits, _ := blockBody.Deposits()
+		for _, deposit := range deposits {
+			if validatorIndex, found := indexer.GetValidatorIndexByPubkey(deposit.Data.PublicKey); found {
+				rewardsBlock.flows = append(rewardsBlock.flows, validatorRewardsFlow{
+					index:  validatorIndex,
+					amount: -int64(deposit.Data.Amount),
+				})
+			}
+		}
+		if executionRequests, err := blockBody.ExecutionRequests(); err == nil && executionRequests != nil {
+			for _, deposit := range executionRequests.Deposits {
+				if validatorIndex, found := indexer.GetValidatorIndexByPubkey(deposit.Pubkey); found {
+					rewardsBlock.flows = append(rewardsBlock.flows, validatorRewardsFlow{
+						index:  validatorIndex,
+						amount: -int64(deposit.Amount),
+					})
+				}
+			}
+		}
+
+		if len(rewardsBlock.flows) > 0 {
+			rewards.resolvedBlocks = append(rewards.resolvedBlocks, rewardsBlock)
+		}
+	}
+	rewards.pendingBlocks = pendingBlocks
+}
+
+func (bs *ChainService) storeValidatorRewardSnapshot() error {
+	rewards := bs.validatorRewards
+
+	balances, epochStats := bs.beaconIndexer.GetRecentValidatorBalances(nil)
+	if epochStats == nil {
+		return nil
+	}
+
+	epoch := uint64(epochStats.GetEpoch())
+	if rewards.lastSnapshot != nil && epoch < rewards.lastSnapshot.Epoch+rewards.snapshotInterval {
+		return nil
+	}
+	if rewards.lastSnapshot == nil {
+		if snapshots := db.GetValidatorRewardSnapshotEpochs(context.Background()); len(snapshots) > 0 && epoch < snapshots[0].Epoch+rewards.snapshotInterval {
+			return nil
+		}
+	}
+
+	dependentRoot := epochStats.GetDependentRoot()
+	dependentBlock := bs.beaconIndexer.GetBlockByRoot(dependentRoot)
+	if dependentBlock == nil {
+		return nil
+	}
+
+	// collect the flows of all blocks included in the balance state
+	rewards.resolvePendingBlocks(bs.beaconIndexer, dependentBlock.Slot, &dependentRoot)
+	flows := make([]int64, len(balances))
+	if rewards.lastSnapshot != nil {
+		copy(flows, rewards.lastFlows)
+	}
+	resolvedBlocks := make([]*validatorRewardsBlock, 0, len(rewards.resolvedBlocks))
+	for _, block := range rewards.resolvedBlocks {
+		if block.slot > dependentBlock.Slot {
+			resolvedBlocks = append(resolvedBlocks, block)
+			continue
+		}
+		if rewards.lastSnapshot == nil {
+			continue
+		}
+		for _, flow := range block.flows {
+			if uint64(flow.index) < uint64(len(flows)) {
+				flows[flow.index] += flow.amount
+			}
+		}
+	}
+	rewards.resolvedBlocks = resolvedBlocks
+
+	snapshot := &dbtypes.ValidatorRewardSnapshot{
+		Epoch:          epoch,
+		BaseEpoch:      epoch,
+		ValidatorCount: uint64(len(balances)),
+		Balances:       make([]byte, len(balances)*validatorRewardsSnapshotSize),
+	}
+	if rewards.lastSnapshot != nil {
+		snapshot.BaseEpoch = rewards.lastSnapshot.BaseEpoch
+	}
+	for index, balance := range balances {
+		offset := index * validatorRewardsSnapshotSize
+		binary.LittleEndian.PutUint64(snapshot.Balances[offset:], uint64(balance))
+		binary.LittleEndian.PutUint64(snapshot.Balances[offset+8:], uint64(flows[index]))
+	}
+
+	// keep the snapshots of the longest window & one additional interval
+	retentionEpochs := rewards.windows[len(rewards.windows)-1].WindowEpochs + rewards.snapshotInterval
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		err := db.InsertValidatorRewardSnapshot(snapshot, tx)
+		if err != nil {
+			return err
+		}
+		if epoch > retentionEpochs {
+			return db.DeleteValidatorRewardSnapshotsBefore(epoch-retentionEpochs, tx)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	rewards.lastSnapshot = snapshot
+	rewards.lastFlows = flows
+	rewards.updateApy(snapshot, db.GetValidatorRewardSnapshotEpochs(context.Background()))
+
+	bs.logger.Infof("stored validator reward snapshot for epoch %v (%v validators)", epoch, len(balances))
+	return nil
+}
+
+// updateApy computes the apy of all validators for all windows from the latest snapshot and the
+// snapshots of the same chain that are closest to the window start.
+func (rewards *validatorRewards) updateApy(latest *dbtypes.ValidatorRewardSnapshot, snapshots []*dbtypes.ValidatorRewardSnapshot) {
+	windows := make([]*ValidatorApyWindow, len(rewards.windows))
+	loadedSnapshots := map[uint64]*dbtypes.ValidatorRewardSnapshot{}
+
+	for idx, window := range rewards.windows {
+		windows[idx] = &ValidatorApyWindow{
+			Name:         window.Name,
+			Duration:     window.Duration,
+			WindowEpochs: window.WindowEpochs,
+			LastEpoch:    latest.Epoch,
+		}
+
+		// windows that are covered by less than half of their length are not shown
+		var reference *dbtypes.ValidatorRewardSnapshot
+		for _, snapshot := range snapshots {
+			if snapshot.BaseEpoch != latest.BaseEpoch || snapshot.Epoch+window.WindowEpochs/2 > latest.Epoch {
+				continue
+			}
+			if reference == nil || absDiff(snapshot.Epoch+window.WindowEpochs, latest.Epoch) < absDiff(reference.Epoch+window.WindowEpochs, latest.Epoch) {
+				reference = snapshot
+			}
+		}
+		if reference == nil {
+			// keep the previous values until the new snapshot chain covers the window
+			if len(window.apyValues) > 0 {
+				windows[idx] = window
+			}
+			continue
+		}
+
+		if loadedSnapshots[reference.Epoch] == nil {
+			loadedSnapshots[reference.Epoch] = db.GetValidatorRewardSnapshot(context.Background(), reference.Epoch)
+		}
+		reference = loadedSnapshots[reference.Epoch]
+		if reference == nil {
+			continue
+		}
+
+		windows[idx].FirstEpoch = reference.Epoch
+		windows[idx].apyValues = computeValidatorApy(reference, latest, float64(window.WindowEpochs)*float64(365*24*time.Hour)/float64(window.Duration))
+	}
+
+	rewards.apyMutex.Lock()
+	rewards.windows = windows
+	rewards.apyMutex.Unlock()
+}
+
+// computeValidatorApy returns the annualized return in percent of all validators between two snapshots.
+func computeValidatorApy(reference *dbtypes.ValidatorRewardSnapshot, latest *dbtypes.ValidatorRewardSnapshot, epochsPerYear float64) []float32 {
+	annualizeFactor := epochsPerYear / float64(latest.Epoch-reference.Epoch) * 100
+	validatorCount := latest.ValidatorCount
+	apyValues := make([]float32, validatorCount)
+
+	for index := uint64(0); index < validatorCount; index++ {
+		offset := index * validatorRewardsSnapshotSize
+		if index >= reference.ValidatorCount || offset+validatorRewardsSnapshotSize > uint64(len(reference.Balances)) || offset+validatorRewardsSnapshotSize > uint64(len(latest.Balances)) {
+			apyValues[index] = float32(math.NaN())
+			continue
+		}
+
+		refBalance := binary.LittleEndian.Uint64(reference.Balances[offset:])
+		if refBalance == 0 {
+			apyValues[index] = float32(math.NaN())
+			continue
+		}
+
+		refFlow := int64(binary.LittleEndian.Uint64(reference.Balances[offset+8:]))
+		balance := binary.LittleEndian.Uint64(latest.Balances[offset:])
+		flow := int64(binary.LittleEndian.Uint64(latest.Balances[offset+8:]))
+
+		reward := int64(balance) - int64(refBalance) + flow - refFlow
+		apyValues[index] = float32(float64(reward) / float64(refBalance) * annualizeFactor)
+	}
+
+	return apyValues
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
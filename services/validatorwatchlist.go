@@ -0,0 +1,366 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	maxValidatorWatchlists    = 10
+	maxValidatorWatchlistSize = 500
+	maxWatchlistWebhooks      = 5
+)
+
+var validatorWatchlistNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9 _.:-]{0,49}$`)
+
+// ValidatorWatchlistWebhookEvents are the notification events a watchlist webhook can subscribe to
+var ValidatorWatchlistWebhookEvents = []string{
+	NotificationEventStatusChange,
+	NotificationEventOffline,
+	NotificationEventProposal,
+	NotificationEventMissedProposal,
+	NotificationEventSlashed,
+	NotificationEventExit,
+	NotificationEventWithdrawal,
+	NotificationEventWithdrawalRequest,
+}
+
+// defaultValidatorWatchlistWebhookEvents are used for webhooks registered without explicit events
+var defaultValidatorWatchlistWebhookEvents = []string{
+	NotificationEventStatusChange,
+	NotificationEventOffline,
+	NotificationEventProposal,
+}
+
+// ValidatorWatchlist is a named set of validators tracked by a session.
+type ValidatorWatchlist struct {
+	Name       string
+	CreatedAt  time.Time
+	Validators []uint64
+	Webhooks   []*dbtypes.ValidatorWatchlistWebhook
+}
+
+// ValidatorUpcomingDuties holds the duties of a validator in the current & next epoch that are not due yet.
+type ValidatorUpcomingDuties struct {
+	Proposals       []phase0.Slot
+	HasAttestation  bool
+	AttestationSlot phase0.Slot
+	SyncCommittee   bool
+}
+
+// GetValidatorWatchlists returns the watchlists of a session, sorted by name.
+func (bs *ChainService) GetValidatorWatchlists(ctx context.Context, sessionHash []byte) []*ValidatorWatchlist {
+	watchlists := []*ValidatorWatchlist{}
+	var watchlist *ValidatorWatchlist
+	for _, entry := range db.GetValidatorWatchlistEntries(ctx, sessionHash) {
+		if watchlist == nil || watchlist.Name != entry.ListName {
+			watchlist = &ValidatorWatchlist{
+				Name:      entry.ListName,
+				CreatedAt: time.Unix(entry.AddedAt, 0),
+			}
+			watchlists = append(watchlists, watchlist)
+		}
+		if addedAt := time.Unix(entry.AddedAt, 0); addedAt.Before(watchlist.CreatedAt) {
+			watchlist.CreatedAt = addedAt
+		}
+		watchlist.Validators = append(watchlist.Validators, entry.ValidatorIndex)
+	}
+
+	if utils.Config().Notifications.WatchlistWebhooks && len(watchlists) > 0 {
+		for _, webhook := range db.GetValidatorWatchlistWebhooks(ctx, sessionHash) {
+			for _, list := range watchlists {
+				if list.Name == webhook.ListName {
+					list.Webhooks = append(list.Webhooks, webhook)
+					break
+				}
+			}
+		}
+	}
+	return watchlists
+}
+
+// AddValidatorWatchlistValidators adds validators to a watchlist of a session, the watchlist is created if it doesn't exist yet.
+// returns the number of validators that were not on the watchlist before.
+func (bs *ChainService) AddValidatorWatchlistValidators(ctx context.Context, sessionHash []byte, listName string, validators []phase0.ValidatorIndex) (int, error) {
+	listName = strings.TrimSpace(listName)
+	if !validatorWatchlistNamePattern.MatchString(listName) {
+		return 0, fmt.Errorf("invalid watchlist name (1-50 letters, digits, spaces or _.:-)")
+	}
+	if len(validators) == 0 {
+		return 0, fmt.Errorf("no validators given")
+	}
+
+	var watchlist *ValidatorWatchlist
+	watchlists := bs.GetValidatorWatchlists(ctx, sessionHash)
+	for _, list := range watchlists {
+		if list.Name == listName {
+			watchlist = list
+			break
+		}
+	}
+	if watchlist == nil && len(watchlists) >= maxValidatorWatchlists {
+		return 0, fmt.Errorf("a session is limited to %v watchlists", maxValidatorWatchlists)
+	}
+
+	knownValidators := map[uint64]bool{}
+	if watchlist != nil {
+		for _, validatorIndex := range watchlist.Validators {
+			knownValidators[validatorIndex] = true
+		}
+	}
+
+	now := time.Now().Unix()
+	entries := []*dbtypes.ValidatorWatchlistEntry{}
+	for _, validatorIndex := range validators {
+		if knownValidators[uint64(validatorIndex)] {
+			continue
+		}
+		if bs.GetValidatorByIndex(validatorIndex, false) == nil {
+			return 0, fmt.Errorf("validator %v not found", validatorIndex)
+		}
+
+		knownValidators[uint64(validatorIndex)] = true
+		entries = append(entries, &dbtypes.ValidatorWatchlistEntry{
+			SessionHash:    sessionHash,
+			ListName:       listName,
+			ValidatorIndex: uint64(validatorIndex),
+			AddedAt:        now,
+		})
+	}
+	if len(knownValidators) > maxValidatorWatchlistSize {
+		return 0, fmt.Errorf("a watchlist is limited to %v validators", maxValidatorWatchlistSize)
+	}
+
+	err := db.RunDBTransactionContext(ctx, func(tx *sqlx.Tx) error {
+		return db.InsertValidatorWatchlistEntries(entries, tx)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error storing watchlist: %v", err)
+	}
+
+	return len(entries), nil
+}
+
+// RemoveValidatorWatchlistValidator removes a validator from a watchlist of a session.
+// the watchlist is gone once its last validator has been removed.
+func (bs *ChainService) RemoveValidatorWatchlistValidator(ctx context.Context, sessionHash []byte, listName string, validatorIndex uint64) error {
+	removed := false
+	err := db.RunDBTransactionContext(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		removed, err = db.DeleteValidatorWatchlistEntry(sessionHash, listName, validatorIndex, tx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error removing validator from watchlist: %v", err)
+	}
+	if !removed {
+		return fmt.Errorf("validator %v is not on watchlist %q", validatorIndex, listName)
+	}
+	return nil
+}
+
+// DeleteValidatorWatchlist removes a watchlist with all its validators from a session.
+func (bs *ChainService) DeleteValidatorWatchlist(ctx context.Context, sessionHash []byte, listName string) error {
+	removed := false
+	err := db.RunDBTransactionContext(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		removed, err = db.DeleteValidatorWatchlist(sessionHash, listName, tx)
+		if err != nil {
+			return err
+		}
+		return db.DeleteValidatorWatchlistWebhooks(sessionHash, listName, tx)
+	})
+	if err != nil {
+		return fmt.Errorf("error removing watchlist: %v", err)
+	}
+	if !removed {
+		return fmt.Errorf("watchlist %q not found", listName)
+	}
+	return nil
+}
+
+// AddValidatorWatchlistWebhook registers a webhook url that receives the notifications for the validators of a watchlist.
+// registering an url again replaces its events & offline threshold.
+func (bs *ChainService) AddValidatorWatchlistWebhook(ctx context.Context, sessionHash []byte, listName string, hookUrl string, events []string, offlineEpochs uint64) error {
+	if !utils.Config().Notifications.WatchlistWebhooks {
+		return fmt.Errorf("watchlist webhooks are not enabled")
+	}
+
+	var watchlist *ValidatorWatchlist
+	for _, list := range bs.GetValidatorWatchlists(ctx, sessionHash) {
+		if list.Name == listName {
+			watchlist = list
+			break
+		}
+	}
+	if watchlist == nil {
+		return fmt.Errorf("watchlist %q not found", listName)
+	}
+
+	hookUrl = strings.TrimSpace(hookUrl)
+	parsedUrl, err := url.Parse(hookUrl)
+	if err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+		return fmt.Errorf("invalid webhook url (http or https url required)")
+	}
+	if len(hookUrl) > 500 {
+		return fmt.Errorf("webhook url is limited to 500 characters")
+	}
+	if err := checkWatchlistWebhookHost(ctx, parsedUrl.Hostname()); err != nil {
+		return err
+	}
+
+	isKnown := false
+	for _, webhook := range watchlist.Webhooks {
+		if webhook.Url == hookUrl {
+			isKnown = true
+			break
+		}
+	}
+	if !isKnown && len(watchlist.Webhooks) >= maxWatchlistWebhooks {
+		return fmt.Errorf("a watchlist is limited to %v webhooks", maxWatchlistWebhooks)
+	}
+
+	if len(events) == 0 {
+		events = defaultValidatorWatchlistWebhookEvents
+	}
+	eventSet := map[string]bool{}
+	for _, event := range events {
+		event = strings.TrimSpace(event)
+		if !slices.Contains(ValidatorWatchlistWebhookEvents, event) {
+			return fmt.Errorf("unknown webhook event %q (supported: %v)", event, strings.Join(ValidatorWatchlistWebhookEvents, ", "))
+		}
+		eventSet[event] = true
+	}
+	hookEvents := []string{}
+	for _, event := range ValidatorWatchlistWebhookEvents {
+		if eventSet[event] {
+			hookEvents = append(hookEvents, event)
+		}
+	}
+
+	if offlineEpochs == 0 {
+		offlineEpochs = 2
+	} else if offlineEpochs > 100 {
+		return fmt.Errorf("offline epochs are limited to 100")
+	}
+
+	err = db.RunDBTransactionContext(ctx, func(tx *sqlx.Tx) error {
+		return db.InsertValidatorWatchlistWebhook(&dbtypes.ValidatorWatchlistWebhook{
+			SessionHash:   sessionHash,
+			ListName:      listName,
+			Url:           hookUrl,
+			Events:        strings.Join(hookEvents, ","),
+			OfflineEpochs: offlineEpochs,
+			CreatedAt:     time.Now().Unix(),
+		}, tx)
+	})
+	if err != nil {
+		return fmt.Errorf("error storing webhook: %v", err)
+	}
+	return nil
+}
+
+// RemoveValidatorWatchlistWebhook removes a webhook url from a watchlist of a session.
+func (bs *ChainService) RemoveValidatorWatchlistWebhook(ctx context.Context, sessionHash []byte, listName string, hookUrl string) error {
+	removed := false
+	err := db.RunDBTransactionContext(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		removed, err = db.DeleteValidatorWatchlistWebhook(sessionHash, listName, strings.TrimSpace(hookUrl), tx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error removing webhook: %v", err)
+	}
+	if !removed {
+		return fmt.Errorf("webhook not found on watchlist %q", listName)
+	}
+	return nil
+}
+
+// GetValidatorUpcomingDuties returns the proposal, attestation & sync committee duties of the given validators that are not due yet.
+// only the current and next epoch are checked, as duties of later epochs are not known yet.
+// validators without upcoming duties are not included in the result.
+func (bs *ChainService) GetValidatorUpcomingDuties(validators []phase0.ValidatorIndex) map[phase0.ValidatorIndex]*ValidatorUpcomingDuties {
+	result := map[phase0.ValidatorIndex]*ValidatorUpcomingDuties{}
+	if len(validators) == 0 {
+		return result
+	}
+
+	watched := make(map[phase0.ValidatorIndex]bool, len(validators))
+	for _, validatorIndex := range validators {
+		watched[validatorIndex] = true
+	}
+
+	getDuties := func(validatorIndex phase0.ValidatorIndex) *ValidatorUpcomingDuties {
+		duties := result[validatorIndex]
+		if duties == nil {
+			duties = &ValidatorUpcomingDuties{}
+			result[validatorIndex] = duties
+		}
+		return duties
+	}
+
+	chainState := bs.consensusPool.GetChainState()
+	currentSlot := chainState.CurrentSlot()
+	currentEpoch := chainState.CurrentEpoch()
+
+	for epoch := currentEpoch; epoch <= currentEpoch+1; epoch++ {
+		epochStatsValues := bs.beaconIndexer.GetEpochStats(epoch, nil).GetValues(true)
+		if epochStatsValues == nil {
+			continue
+		}
+		firstSlot := chainState.EpochToSlot(epoch)
+
+		for slotIdx, proposer := range epochStatsValues.ProposerDuties {
+			slot := firstSlot + phase0.Slot(slotIdx)
+			if slot >= currentSlot && watched[proposer] {
+				duties := getDuties(proposer)
+				duties.Proposals = append(duties.Proposals, slot)
+			}
+		}
+
+		for slotIdx, committees := range epochStatsValues.AttesterDuties {
+			slot := firstSlot + phase0.Slot(slotIdx)
+			if slot < currentSlot {
+				continue
+			}
+			for _, committee := range committees {
+				for _, indice := range committee {
+					if int(indice) >= len(epochStatsValues.ActiveIndices) {
+						continue
+					}
+					validatorIndex := epochStatsValues.ActiveIndices[indice]
+					if !watched[validatorIndex] {
+						continue
+					}
+					if duties := getDuties(validatorIndex); !duties.HasAttestation {
+						duties.HasAttestation = true
+						duties.AttestationSlot = slot
+					}
+				}
+			}
+		}
+
+		if epoch == currentEpoch {
+			for _, validatorIndex := range epochStatsValues.SyncCommitteeDuties {
+				if watched[validatorIndex] {
+					getDuties(validatorIndex).SyncCommittee = true
+				}
+			}
+		}
+	}
+
+	return result
+}
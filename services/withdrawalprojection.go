@@ -0,0 +1,165 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/clients/consensus"
+	"github.com/ethpandaops/dora/indexer/beacon"
+)
+
+// withdrawalProjection estimates the time the withdrawal sweep reaches a validator.
+// the number of withdrawable validators is precomputed per epoch state, so single lookups don't need to walk the validator set.
+type withdrawalProjection struct {
+	mutex              sync.Mutex
+	epochStats         *beacon.EpochStats
+	stateSlot          phase0.Slot
+	sweepIndex         uint64
+	withdrawableCounts []uint32 // number of withdrawable validators with an index lower than i
+}
+
+func newWithdrawalProjection() *withdrawalProjection {
+	return &withdrawalProjection{}
+}
+
+// GetValidatorWithdrawalProjection returns the estimated time of the next withdrawal sweep for the given validator.
+// returns false if the validator is not eligible for a withdrawal or no recent epoch state is available.
+// the estimation assumes that every upcoming slot gets a block.
+func (bs *ChainService) GetValidatorWithdrawalProjection(index phase0.ValidatorIndex) (time.Time, bool) {
+	chainState := bs.consensusPool.GetChainState()
+	specs := chainState.GetSpecs()
+	if specs == nil || specs.CapellaForkEpoch == nil || chainState.CurrentEpoch() < phase0.Epoch(*specs.CapellaForkEpoch) {
+		return time.Time{}, false
+	}
+	if specs.MaxWithdrawalsPerPayload == 0 || specs.MaxValidatorsPerWithdrawalsSweep == 0 {
+		return time.Time{}, false
+	}
+
+	projection := bs.withdrawalProjection
+	if projection == nil || !projection.update(bs, chainState) {
+		return time.Time{}, false
+	}
+
+	projection.mutex.Lock()
+	defer projection.mutex.Unlock()
+
+	counts := projection.withdrawableCounts
+	validatorCount := uint64(len(counts) - 1)
+	target := uint64(index)
+	if target >= validatorCount || counts[target+1] == counts[target] {
+		return time.Time{}, false
+	}
+
+	// count the validators & withdrawals processed by the sweep before reaching the validator
+	cursor := projection.sweepIndex % validatorCount
+	var sweptValidators, sweptWithdrawals uint64
+	if target >= cursor {
+		sweptValidators = target - cursor
+		sweptWithdrawals = uint64(counts[target] - counts[cursor])
+	} else {
+		sweptValidators = validatorCount - cursor + target
+		sweptWithdrawals = uint64(counts[validatorCount]-counts[cursor]) + uint64(counts[target])
+	}
+
+	sweepBlocks := func(validators, withdrawals uint64) uint64 {
+		blocks := withdrawals / specs.MaxWithdrawalsPerPayload
+		if sweepLimit := validators / specs.MaxValidatorsPerWithdrawalsSweep; sweepLimit > blocks {
+			blocks = sweepLimit
+		}
+		return blocks
+	}
+
+	projectedSlot := projection.stateSlot + 1 + phase0.Slot(sweepBlocks(sweptValidators, sweptWithdrawals))
+
+	// the sweep might have passed the validator since the epoch state, continue with the next sweep cycle
+	currentSlot := chainState.CurrentSlot()
+	cycleBlocks := phase0.Slot(sweepBlocks(validatorCount, uint64(counts[validatorCount]))) + 1
+	for projectedSlot < currentSlot {
+		projectedSlot += cycleBlocks
+	}
+
+	return chainState.SlotToTime(projectedSlot), true
+}
+
+// update rebuilds the withdrawable validator counts if a more recent epoch state is available.
+func (projection *withdrawalProjection) update(bs *ChainService, chainState *consensus.ChainState) bool {
+	balances, epochStats := bs.beaconIndexer.GetRecentValidatorBalances(nil)
+	if epochStats == nil {
+		projection.mutex.Lock()
+		defer projection.mutex.Unlock()
+		return projection.epochStats != nil
+	}
+
+	projection.mutex.Lock()
+	defer projection.mutex.Unlock()
+
+	if projection.epochStats == epochStats {
+		return true
+	}
+
+	sweepIndex, ok := epochStats.GetWithdrawalSweepIndex()
+	if !ok {
+		return projection.epochStats != nil
+	}
+
+	dependentBlock := bs.beaconIndexer.GetBlockByRoot(epochStats.GetDependentRoot())
+	if dependentBlock == nil {
+		return projection.epochStats != nil
+	}
+
+	specs := chainState.GetSpecs()
+	epoch := epochStats.GetEpoch()
+	isElectra := specs.ElectraForkEpoch != nil && epoch >= phase0.Epoch(*specs.ElectraForkEpoch)
+
+	validatorSet := bs.beaconIndexer.GetValidatorSetView(nil)
+	validatorCount := validatorSet.Len()
+	if len(balances) < validatorCount {
+		validatorCount = len(balances)
+	}
+	if validatorCount == 0 {
+		return projection.epochStats != nil
+	}
+
+	counts := make([]uint32, validatorCount+1)
+	for i := 0; i < validatorCount; i++ {
+		counts[i+1] = counts[i]
+		if record, found := validatorSet.GetRecord(phase0.ValidatorIndex(i)); found && isWithdrawableValidator(&record, balances[i], epoch, isElectra, specs) {
+			counts[i+1]++
+		}
+	}
+
+	projection.epochStats = epochStats
+	projection.stateSlot = dependentBlock.Slot
+	projection.sweepIndex = uint64(sweepIndex)
+	projection.withdrawableCounts = counts
+
+	return true
+}
+
+// isWithdrawableValidator checks if the withdrawal sweep creates a full or partial withdrawal for the validator.
+func isWithdrawableValidator(validator *beacon.ValidatorRecord, balance phase0.Gwei, epoch phase0.Epoch, isElectra bool, specs *consensus.ChainSpec) bool {
+	if balance == 0 {
+		return false
+	}
+
+	credType := validator.WithdrawalCredentials[0]
+	if credType != 0x01 && (credType != 0x02 || !isElectra) {
+		return false
+	}
+
+	if validator.WithdrawableEpoch <= epoch {
+		return true // fully withdrawable
+	}
+
+	maxEffectiveBalance := phase0.Gwei(specs.MaxEffectiveBalance)
+	if isElectra {
+		maxEffectiveBalance = phase0.Gwei(specs.MinActivationBalance)
+		if credType == 0x02 {
+			maxEffectiveBalance = phase0.Gwei(specs.MaxEffectiveBalanceElectra)
+		}
+	}
+
+	return validator.EffectiveBalance == maxEffectiveBalance && balance > maxEffectiveBalance
+}
@@ -3,15 +3,19 @@
   window.addEventListener('DOMContentLoaded', function() {
     initControls();
     modalFixes();
+    initTimeModeSelector();
     window.setInterval(updateTimers, 1000);
     initHeaderSearch();
+    initChainNowBar();
   });
   var tooltipDict = {};
   var tooltipIdx = 1;
   window.explorer = {
     initControls: initControls,
     renderRecentTime: renderRecentTime,
+    renderTimer: renderTimer,
     tooltipDict: tooltipDict,
+    getChainStream: getChainStream,
   };
 
   function modalFixes() {
@@ -92,10 +96,79 @@
       var textEls = Array.prototype.filter.call(timerEl.querySelectorAll("*"), function(el) { return el.firstChild && el.firstChild.nodeType === 3 });
       var textEl = textEls.length ? textEls[0] : timerEl;
 
-      textEl.innerText = renderRecentTime(time);
+      textEl.innerText = renderTimer(time);
     });
   }
 
+  var timeModeStorageKey = "dora-time-mode";
+
+  function getTimeMode() {
+    return localStorage.getItem(timeModeStorageKey) || "relative";
+  }
+
+  function initTimeModeSelector() {
+    document.querySelectorAll("[data-time-mode]").forEach(function(buttonEl) {
+      buttonEl.addEventListener("click", function() {
+        localStorage.setItem(timeModeStorageKey, buttonEl.getAttribute("data-time-mode"));
+        updateTimeModeSelector();
+        updateTimers();
+      });
+    });
+    updateTimeModeSelector();
+    if (getTimeMode() != "relative") {
+      updateTimers();
+    }
+  }
+
+  function updateTimeModeSelector() {
+    var timeMode = getTimeMode();
+    document.querySelectorAll("[data-time-mode]").forEach(function(buttonEl) {
+      buttonEl.classList.toggle("active", buttonEl.getAttribute("data-time-mode") == timeMode);
+    });
+  }
+
+  // renderTimer renders a unix timestamp in the display mode selected by the user
+  function renderTimer(time) {
+    switch (getTimeMode()) {
+      case "local":
+        return renderDateTime(new Date(time * 1000), false);
+      case "utc":
+        return renderDateTime(new Date(time * 1000), true) + " UTC";
+      case "genesis":
+        var genesisTime = renderGenesisTime(time);
+        if (genesisTime) {
+          return genesisTime;
+        }
+        break;
+    }
+    return renderRecentTime(time);
+  }
+
+  function renderDateTime(date, utc) {
+    var pad = function(num) { return num < 10 ? "0" + num : num.toString(); };
+    if (utc) {
+      return date.getUTCFullYear() + "-" + pad(date.getUTCMonth() + 1) + "-" + pad(date.getUTCDate()) + " " + pad(date.getUTCHours()) + ":" + pad(date.getUTCMinutes()) + ":" + pad(date.getUTCSeconds());
+    }
+    return date.getFullYear() + "-" + pad(date.getMonth() + 1) + "-" + pad(date.getDate()) + " " + pad(date.getHours()) + ":" + pad(date.getMinutes()) + ":" + pad(date.getSeconds());
+  }
+
+  // renderGenesisTime renders a unix timestamp as "epoch E slot S (+Xs)", with X being the offset to the start of the slot
+  function renderGenesisTime(time) {
+    var genesis = parseInt(document.body.getAttribute("data-chain-genesis"));
+    var slotTime = parseInt(document.body.getAttribute("data-chain-slot-time"));
+    var slotsPerEpoch = parseInt(document.body.getAttribute("data-chain-slots-per-epoch"));
+    if (isNaN(genesis) || !slotTime || !slotsPerEpoch) {
+      return null;
+    }
+
+    var offset = time - genesis;
+    if (offset < 0) {
+      return "genesis " + offset + "s";
+    }
+    var slot = Math.floor(offset / slotTime);
+    return "epoch " + Math.floor(slot / slotsPerEpoch) + " slot " + slot + " (+" + (offset - slot * slotTime) + "s)";
+  }
+
   function renderRecentTime(time) {
     var duration = time - Math.floor(new Date().getTime() / 1000);
     var timeStr= "";
@@ -119,6 +192,81 @@
     }
   }
 
+  var chainStream = null;
+
+  // getChainStream returns the shared head & finality event stream, so all live components of a page use a single connection
+  function getChainStream() {
+    if (!chainStream && window.EventSource) {
+      chainStream = new EventSource("/api/v1/stream?topics=head,finalized");
+    }
+    return chainStream;
+  }
+
+  function initChainNowBar() {
+    var barEl = document.getElementById("chain-now-bar");
+    var genesis = parseInt(document.body.getAttribute("data-chain-genesis"));
+    var slotTime = parseInt(document.body.getAttribute("data-chain-slot-time"));
+    var slotsPerEpoch = parseInt(document.body.getAttribute("data-chain-slots-per-epoch"));
+    if (!barEl || isNaN(genesis) || !slotTime || !slotsPerEpoch) {
+      return;
+    }
+
+    var epochEl = document.getElementById("chain-now-epoch");
+    var slotEl = document.getElementById("chain-now-slot");
+    var progressEl = document.getElementById("chain-now-progress");
+    var slotTimeEl = document.getElementById("chain-now-slot-time");
+    var nextEpochEl = document.getElementById("chain-now-next-epoch");
+    var headEl = document.getElementById("chain-now-head");
+    var currentSlot = -1;
+    var headSlot = -1;
+
+    var updateHead = function() {
+      if (headSlot < 0) {
+        return;
+      }
+      var behind = currentSlot - headSlot;
+      headEl.innerText = headSlot.toLocaleString("en-US") + (behind > 1 ? " (" + behind + " slots behind)" : "");
+      headEl.href = "/slot/" + headSlot;
+      headEl.classList.toggle("chain-now-head-behind", behind > 1);
+    };
+
+    var update = function() {
+      var offset = new Date().getTime() / 1000 - genesis;
+      if (offset < 0) {
+        slotTimeEl.innerText = "genesis in " + Math.ceil(-offset) + "s";
+        return;
+      }
+
+      var slot = Math.floor(offset / slotTime);
+      var slotOffset = offset - slot * slotTime;
+      progressEl.style.width = (slotOffset / slotTime * 100).toFixed(1) + "%";
+      slotTimeEl.innerText = Math.floor(slotOffset) + "s / " + slotTime + "s";
+
+      var epoch = Math.floor(slot / slotsPerEpoch);
+      var nextEpochIn = Math.ceil((epoch + 1) * slotsPerEpoch * slotTime - offset);
+      nextEpochEl.innerText = (nextEpochIn >= 60 ? Math.floor(nextEpochIn / 60) + "m " : "") + (nextEpochIn % 60) + "s";
+
+      if (slot != currentSlot) {
+        currentSlot = slot;
+        slotEl.innerText = slot.toLocaleString("en-US");
+        slotEl.href = "/slot/" + slot;
+        epochEl.innerText = epoch.toLocaleString("en-US");
+        epochEl.href = "/epoch/" + epoch;
+        updateHead();
+      }
+    };
+    update();
+    window.setInterval(update, 250);
+
+    var stream = getChainStream();
+    if (stream) {
+      stream.addEventListener("head", function(evt) {
+        headSlot = JSON.parse(evt.data).slot;
+        updateHead();
+      });
+    }
+  }
+
   function initHeaderSearch() {
     var searchEl = jQuery("#explorer-search");
     let requestNum = 9
@@ -2,6 +2,7 @@
 (function() {
   window.addEventListener('DOMContentLoaded', function() {
     window.setInterval(scheduleLoop, 500);
+    connectStream();
   });
 
   var refreshInterval = 15000;
@@ -17,7 +18,7 @@
       var p = /^([0-9-]+)T([0-9:]+).[0-9]+Z$/.exec(d.toISOString());
       return p[1] + " " + p[2] + " +0000 UTC";
     },
-    formatRecentTimeShort: function(x) { return window.explorer.renderRecentTime(Math.floor(new Date(x).getTime() / 1000)); },
+    formatRecentTimeShort: function(x) { return window.explorer.renderTimer(Math.floor(new Date(x).getTime() / 1000)); },
     formatEth: function(x) { return formatFloat(x / 1000000000, 4); },
     formatFloat: function(x) { return formatFloat(x, 2); },
     formatValidator: function(idx, name) { return formatValidator(idx, name); },
@@ -49,6 +50,38 @@
     }
   }
 
+  function connectStream() {
+    // refresh right after head & finality changes instead of waiting for the next refresh interval
+    var stream = window.explorer.getChainStream();
+    if(!stream)
+      return;
+    var streamTimer = null;
+    var onStreamEvent = function() {
+      if(streamTimer)
+        return;
+      streamTimer = setTimeout(function() {
+        streamTimer = null;
+        lastRefresh = new Date().getTime();
+        refresh();
+      }, 500);
+    };
+    // the stream resends the current head & finality on every reconnect, only changes trigger a refresh
+    var lastHeadRoot = null;
+    var lastFinalizedEpoch = null;
+    stream.addEventListener("head", function(evt) {
+      var data = JSON.parse(evt.data);
+      if(lastHeadRoot !== null && lastHeadRoot !== data.root)
+        onStreamEvent();
+      lastHeadRoot = data.root;
+    });
+    stream.addEventListener("finalized", function(evt) {
+      var data = JSON.parse(evt.data);
+      if(lastFinalizedEpoch !== null && lastFinalizedEpoch !== data.finalized_epoch)
+        onStreamEvent();
+      lastFinalizedEpoch = data.finalized_epoch;
+    });
+  }
+
   async function refresh() {
     if(isRefreshing)
       return;
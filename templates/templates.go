@@ -36,7 +36,7 @@ var templateFuncs = utils.GetTemplateFuncs()
 func GetTemplate(files ...string) *template.Template {
 	name := strings.Join(files, "-")
 
-	if utils.Config.Frontend.Debug {
+	if utils.Config().Frontend.Debug {
 		templateFiles := make([]string, len(files))
 		copy(templateFiles, files)
 		for i := range files {
@@ -69,7 +69,7 @@ func readFileFS(fsys fs.FS) func(string) (string, []byte, error) {
 		name = path.Base(file)
 		b, err = fs.ReadFile(fsys, file)
 
-		if utils.Config.Frontend.Minify {
+		if utils.Config().Frontend.Minify {
 			// minfiy template
 			m := minify.New()
 			m.AddFunc("text/html", minifyTemplate)
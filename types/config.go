@@ -10,6 +10,8 @@ type Config struct {
 
 		FilePath  string `yaml:"filePath" envconfig:"LOGGING_FILE_PATH"`
 		FileLevel string `yaml:"fileLevel" envconfig:"LOGGING_FILE_LEVEL"`
+
+		BufferLines int `yaml:"bufferLines" envconfig:"LOGGING_BUFFER_LINES"`
 	} `yaml:"logging"`
 
 	Server struct {
@@ -19,6 +21,7 @@ type Config struct {
 
 	Chain struct {
 		DisplayName string `yaml:"displayName" envconfig:"CHAIN_DISPLAY_NAME"`
+		GenesisTime uint64 `yaml:"genesisTime" envconfig:"CHAIN_GENESIS_TIME"`
 
 		// optional features
 		WhiskForkEpoch *uint64 `yaml:"whiskForkEpoch" envconfig:"WHISK_FORK_EPOCH"`
@@ -30,6 +33,8 @@ type Config struct {
 		Pprof   bool `yaml:"pprof" envconfig:"FRONTEND_PPROF"`
 		Minify  bool `yaml:"minify" envconfig:"FRONTEND_MINIFY"`
 
+		AssetCdnUrl string `yaml:"assetCdnUrl" envconfig:"FRONTEND_ASSET_CDN_URL"`
+
 		SiteDomain      string `yaml:"siteDomain" envconfig:"FRONTEND_SITE_DOMAIN"`
 		SiteLogo        string `yaml:"siteLogo" envconfig:"FRONTEND_SITE_LOGO"`
 		SiteName        string `yaml:"siteName" envconfig:"FRONTEND_SITE_NAME"`
@@ -40,21 +45,35 @@ type Config struct {
 		PublicRPCUrl        string `yaml:"publicRpcUrl" envconfig:"FRONTEND_PUBLIC_RPC_URL"`
 		RainbowkitProjectId string `yaml:"rainbowkitProjectId" envconfig:"FRONTEND_RAINBOWKIT_PROJECT_ID"`
 
+		ExternalLinks []ExternalLinkConfig `yaml:"externalLinks"` // links to other explorers & tools on the slot and validator pages
+
 		ValidatorNamesYaml            string        `yaml:"validatorNamesYaml" envconfig:"FRONTEND_VALIDATOR_NAMES_YAML"`
 		ValidatorNamesInventory       string        `yaml:"validatorNamesInventory" envconfig:"FRONTEND_VALIDATOR_NAMES_INVENTORY"`
+		ValidatorLabelsApiKey         string        `yaml:"validatorLabelsApiKey" envconfig:"FRONTEND_VALIDATOR_LABELS_API_KEY"`
 		ValidatorNamesRefreshInterval time.Duration `yaml:"validatorNamesRefreshInterval" envconfig:"FRONTEND_VALIDATOR_REFRESH_INTERVAL"`
 		ValidatorNamesResolveInterval time.Duration `yaml:"validatorNamesResolveInterval" envconfig:"FRONTEND_VALIDATOR_RESOLVE_INTERVAL"`
 
-		PageCallTimeout  time.Duration `yaml:"pageCallTimeout" envconfig:"FRONTEND_PAGE_CALL_TIMEOUT"`
-		HttpReadTimeout  time.Duration `yaml:"httpReadTimeout" envconfig:"FRONTEND_HTTP_READ_TIMEOUT"`
-		HttpWriteTimeout time.Duration `yaml:"httpWriteTimeout" envconfig:"FRONTEND_HTTP_WRITE_TIMEOUT"`
-		HttpIdleTimeout  time.Duration `yaml:"httpIdleTimeout" envconfig:"FRONTEND_HTTP_IDLE_TIMEOUT"`
-		AllowDutyLoading bool          `yaml:"allowDutyLoading" envconfig:"FRONTEND_ALLOW_DUTY_LOADING"`
+		PageCallTimeout     time.Duration `yaml:"pageCallTimeout" envconfig:"FRONTEND_PAGE_CALL_TIMEOUT"`
+		SlowRenderThreshold time.Duration `yaml:"slowRenderThreshold" envconfig:"FRONTEND_SLOW_RENDER_THRESHOLD"`
+		HttpReadTimeout     time.Duration `yaml:"httpReadTimeout" envconfig:"FRONTEND_HTTP_READ_TIMEOUT"`
+		HttpWriteTimeout    time.Duration `yaml:"httpWriteTimeout" envconfig:"FRONTEND_HTTP_WRITE_TIMEOUT"`
+		HttpIdleTimeout     time.Duration `yaml:"httpIdleTimeout" envconfig:"FRONTEND_HTTP_IDLE_TIMEOUT"`
+		AllowDutyLoading    bool          `yaml:"allowDutyLoading" envconfig:"FRONTEND_ALLOW_DUTY_LOADING"`
+
+		SlotPrefetchCount uint64 `yaml:"slotPrefetchCount" envconfig:"FRONTEND_SLOT_PREFETCH_COUNT"`
+		CsvExportRowLimit uint64 `yaml:"csvExportRowLimit" envconfig:"FRONTEND_CSV_EXPORT_ROW_LIMIT"`
 
 		ShowSensitivePeerInfos bool `yaml:"showSensitivePeerInfos" envconfig:"FRONTEND_SHOW_SENSITIVE_PEER_INFOS"`
 		ShowPeerDASInfos       bool `yaml:"showPeerDASInfos" envconfig:"FRONTEND_SHOW_PEER_DAS_INFOS"`
 		ShowSubmitDeposit      bool `yaml:"showSubmitDeposit" envconfig:"FRONTEND_SHOW_SUBMIT_DEPOSIT"`
 		ShowSubmitElRequests   bool `yaml:"showSubmitElRequests" envconfig:"FRONTEND_SHOW_SUBMIT_EL_REQUESTS"`
+		ShowValidatorOwnership bool `yaml:"showValidatorOwnership" envconfig:"FRONTEND_SHOW_VALIDATOR_OWNERSHIP"`
+		ShowValidatorWatchlist bool `yaml:"showValidatorWatchlist" envconfig:"FRONTEND_SHOW_VALIDATOR_WATCHLIST"`
+		DisableForkSplitView   bool `yaml:"disableForkSplitView" envconfig:"FRONTEND_DISABLE_FORK_SPLIT_VIEW"`
+
+		PeerGeoIpDatabase string `yaml:"peerGeoIpDatabase" envconfig:"FRONTEND_PEER_GEOIP_DATABASE"`
+		PeerIpv4Prefix    int    `yaml:"peerIpv4Prefix" envconfig:"FRONTEND_PEER_IPV4_PREFIX"`
+		PeerIpv6Prefix    int    `yaml:"peerIpv6Prefix" envconfig:"FRONTEND_PEER_IPV6_PREFIX"`
 	} `yaml:"frontend"`
 
 	RateLimit struct {
@@ -79,9 +98,14 @@ type Config struct {
 		Endpoint  string           `yaml:"endpoint" envconfig:"EXECUTIONAPI_ENDPOINT"`
 		Endpoints []EndpointConfig `yaml:"endpoints"`
 
-		LogBatchSize       int `yaml:"logBatchSize" envconfig:"EXECUTIONAPI_LOG_BATCH_SIZE"`
-		DepositDeployBlock int `yaml:"depositDeployBlock" envconfig:"EXECUTIONAPI_DEPOSIT_DEPLOY_BLOCK"` // el block number from where to crawl the deposit system contract (should be <=, but close to deposit contract deployment)
-		ElectraDeployBlock int `yaml:"electraDeployBlock" envconfig:"EXECUTIONAPI_ELECTRA_DEPLOY_BLOCK"` // el block number from where to crawl the electra system contracts (should be <=, but close to electra fork activation block)
+		LogBatchSize       int  `yaml:"logBatchSize" envconfig:"EXECUTIONAPI_LOG_BATCH_SIZE"`
+		LogConcurrency     int  `yaml:"logConcurrency" envconfig:"EXECUTIONAPI_LOG_CONCURRENCY"`          // max number of parallel log requests when crawling finalized blocks (0 = one per ready client)
+		DepositDeployBlock int  `yaml:"depositDeployBlock" envconfig:"EXECUTIONAPI_DEPOSIT_DEPLOY_BLOCK"` // el block number from where to crawl the deposit system contract (should be <=, but close to deposit contract deployment)
+		ElectraDeployBlock int  `yaml:"electraDeployBlock" envconfig:"EXECUTIONAPI_ELECTRA_DEPLOY_BLOCK"` // el block number from where to crawl the electra system contracts (should be <=, but close to electra fork activation block)
+		IndexTransactions  bool `yaml:"indexTransactions" envconfig:"EXECUTIONAPI_INDEX_TRANSACTIONS"`    // decode the execution payload transactions of new beacon blocks and store a summary per block
+
+		DepositContracts []DepositContractConfig `yaml:"depositContracts"` // additional deposit contracts to index (e.g. after a contract redeployment)
+		WatchedContracts []WatchedContractConfig `yaml:"watchedContracts"` // arbitrary contracts whose logs are indexed (e.g. devnet specific contracts)
 	} `yaml:"executionapi"`
 
 	Indexer struct {
@@ -91,14 +115,26 @@ type Config struct {
 		InMemoryEpochs                  uint16 `yaml:"inMemoryEpochs" envconfig:"INDEXER_IN_MEMORY_EPOCHS"`
 		ActivityHistoryLength           uint16 `yaml:"activityHistoryLength" envconfig:"INDEXER_ACTIVITY_HISTORY_LENGTH"`
 		DisableSynchronizer             bool   `yaml:"disableSynchronizer" envconfig:"INDEXER_DISABLE_SYNCHRONIZER"`
+		DisableConsistencyChecker       bool   `yaml:"disableConsistencyChecker" envconfig:"INDEXER_DISABLE_CONSISTENCY_CHECKER"`
 		SyncEpochCooldown               uint   `yaml:"syncEpochCooldown" envconfig:"INDEXER_SYNC_EPOCH_COOLDOWN"`
 		MaxParallelValidatorSetRequests uint   `yaml:"maxParallelValidatorSetRequests" envconfig:"INDEXER_MAX_PARALLEL_VALIDATOR_SET_REQUESTS"`
+		MaxParallelValidatorQueries     uint   `yaml:"maxParallelValidatorQueries" envconfig:"INDEXER_MAX_PARALLEL_VALIDATOR_QUERIES"`
+		MaxParallelBlockRequests        uint   `yaml:"maxParallelBlockRequests" envconfig:"INDEXER_MAX_PARALLEL_BLOCK_REQUESTS"`
+		MaxForkCacheSize                uint   `yaml:"maxForkCacheSize" envconfig:"INDEXER_MAX_FORK_CACHE_SIZE"`
+		BlockCompression                string `yaml:"blockCompression" envconfig:"INDEXER_BLOCK_COMPRESSION"`
+		DisableBlockRecompression       bool   `yaml:"disableBlockRecompression" envconfig:"INDEXER_DISABLE_BLOCK_RECOMPRESSION"`
+		ResetOnChainReset               bool   `yaml:"resetOnChainReset" envconfig:"INDEXER_RESET_ON_CHAIN_RESET"`
+		CleanupOrphanedForks            bool   `yaml:"cleanupOrphanedForks" envconfig:"INDEXER_CLEANUP_ORPHANED_FORKS"`
+		OrphanedForkRetention           uint64 `yaml:"orphanedForkRetention" envconfig:"INDEXER_ORPHANED_FORK_RETENTION"`
+
+		ParticipationHistory time.Duration `yaml:"participationHistory" envconfig:"INDEXER_PARTICIPATION_HISTORY"` // time range of the participation history shown on the index page (default 7 days)
+		EventRetention       time.Duration `yaml:"eventRetention" envconfig:"INDEXER_EVENT_RETENTION"`             // time range events are kept in the indexer event log (default 30 days)
 	} `yaml:"indexer"`
 
 	TxSignature struct {
 		DisableLookupLoop bool          `yaml:"disableLookupLoop" envconfig:"TXSIG_DISABLE_LOOKUP_LOOP"`
 		LookupInterval    time.Duration `yaml:"lookupInterval" envconfig:"TXSIG_LOOKUP_INTERVAL"`
-		LookupBatchSize   uint64        `yaml:"lookupBatchSize" envconfig:"TXSIG_LOOKUP_INTERVAL"`
+		LookupBatchSize   uint64        `yaml:"lookupBatchSize" envconfig:"TXSIG_LOOKUP_BATCH_SIZE"`
 		ConcurrencyLimit  uint64        `yaml:"concurrencyLimit" envconfig:"TXSIG_CONCURRENCY_LIMIT"`
 		Disable4Bytes     bool          `yaml:"disable4Bytes" envconfig:"TXSIG_DISABLE_4BYTES"`
 		RecheckTimeout    time.Duration `yaml:"recheckTimeout" envconfig:"TXSIG_RECHECK_TIMEOUT"`
@@ -109,6 +145,103 @@ type Config struct {
 		RefreshInterval time.Duration    `yaml:"refreshInterval" envconfig:"MEVINDEXER_REFRESH_INTERVAL"`
 	} `yaml:"mevIndexer"`
 
+	BlobIndexer struct {
+		Enabled     bool   `yaml:"enabled" envconfig:"BLOBINDEXER_ENABLED"`
+		Storage     string `yaml:"storage" envconfig:"BLOBINDEXER_STORAGE"`          // "" (metadata only), "db" or "fs"
+		StoragePath string `yaml:"storagePath" envconfig:"BLOBINDEXER_STORAGE_PATH"` // directory for the "fs" storage
+	} `yaml:"blobIndexer"`
+
+	ShadowFork struct {
+		Enabled      bool          `yaml:"enabled" envconfig:"SHADOWFORK_ENABLED"`
+		UpstreamName string        `yaml:"upstreamName" envconfig:"SHADOWFORK_UPSTREAM_NAME"`
+		UpstreamRpc  string        `yaml:"upstreamRpc" envconfig:"SHADOWFORK_UPSTREAM_RPC"`
+		Interval     time.Duration `yaml:"interval" envconfig:"SHADOWFORK_INTERVAL"`
+	} `yaml:"shadowFork"`
+
+	IncomeIndexer struct {
+		Enabled      bool          `yaml:"enabled" envconfig:"INCOME_INDEXER_ENABLED"`
+		PeriodEpochs uint64        `yaml:"periodEpochs" envconfig:"INCOME_INDEXER_PERIOD_EPOCHS"`
+		Retention    time.Duration `yaml:"retention" envconfig:"INCOME_INDEXER_RETENTION"`
+	} `yaml:"incomeIndexer"`
+
+	GrpcApi struct {
+		Enabled bool   `yaml:"enabled" envconfig:"GRPC_API_ENABLED"`
+		Host    string `yaml:"host" envconfig:"GRPC_API_HOST"`
+		Port    string `yaml:"port" envconfig:"GRPC_API_PORT"`
+		ApiKey  string `yaml:"apiKey" envconfig:"GRPC_API_KEY"`
+	} `yaml:"grpcApi"`
+
+	GraphqlApi struct {
+		Enabled  bool `yaml:"enabled" envconfig:"GRAPHQL_API_ENABLED"`
+		MaxDepth int  `yaml:"maxDepth" envconfig:"GRAPHQL_API_MAX_DEPTH"` // max. nesting depth of queries (default: 8)
+		MaxCost  int  `yaml:"maxCost" envconfig:"GRAPHQL_API_MAX_COST"`   // max. cost of a query, db backed fields cost 1 + number of requested items (default: 5000)
+	} `yaml:"graphqlApi"`
+
+	StatusSnapshot struct {
+		Enabled  bool          `yaml:"enabled" envconfig:"STATUS_SNAPSHOT_ENABLED"`
+		Interval time.Duration `yaml:"interval" envconfig:"STATUS_SNAPSHOT_INTERVAL"`
+		Path     string        `yaml:"path" envconfig:"STATUS_SNAPSHOT_PATH"`
+		S3       struct {
+			Endpoint  string `yaml:"endpoint" envconfig:"STATUS_SNAPSHOT_S3_ENDPOINT"`
+			Region    string `yaml:"region" envconfig:"STATUS_SNAPSHOT_S3_REGION"`
+			Bucket    string `yaml:"bucket" envconfig:"STATUS_SNAPSHOT_S3_BUCKET"`
+			Key       string `yaml:"key" envconfig:"STATUS_SNAPSHOT_S3_KEY"`
+			AccessKey string `yaml:"accessKey" envconfig:"STATUS_SNAPSHOT_S3_ACCESS_KEY"`
+			SecretKey string `yaml:"secretKey" envconfig:"STATUS_SNAPSHOT_S3_SECRET_KEY"`
+			PathStyle bool   `yaml:"pathStyle" envconfig:"STATUS_SNAPSHOT_S3_PATH_STYLE"`
+		} `yaml:"s3"`
+	} `yaml:"statusSnapshot"`
+
+	Screenshots struct {
+		Enabled     bool                   `yaml:"enabled" envconfig:"SCREENSHOTS_ENABLED"`
+		BrowserPath string                 `yaml:"browserPath" envconfig:"SCREENSHOTS_BROWSER_PATH"`
+		BrowserArgs []string               `yaml:"browserArgs" envconfig:"SCREENSHOTS_BROWSER_ARGS"`
+		BaseUrl     string                 `yaml:"baseUrl" envconfig:"SCREENSHOTS_BASE_URL"`
+		Width       uint64                 `yaml:"width" envconfig:"SCREENSHOTS_WIDTH"`
+		Height      uint64                 `yaml:"height" envconfig:"SCREENSHOTS_HEIGHT"`
+		Timeout     time.Duration          `yaml:"timeout" envconfig:"SCREENSHOTS_TIMEOUT"`
+		Path        string                 `yaml:"path" envconfig:"SCREENSHOTS_PATH"`
+		KeepLatest  bool                   `yaml:"keepLatest" envconfig:"SCREENSHOTS_KEEP_LATEST"`
+		MaxFiles    uint64                 `yaml:"maxFiles" envconfig:"SCREENSHOTS_MAX_FILES"` // timestamped screenshots kept per page, 0 keeps all
+		Pages       []ScreenshotPageConfig `yaml:"pages"`
+		S3          struct {
+			Endpoint  string `yaml:"endpoint" envconfig:"SCREENSHOTS_S3_ENDPOINT"`
+			Region    string `yaml:"region" envconfig:"SCREENSHOTS_S3_REGION"`
+			Bucket    string `yaml:"bucket" envconfig:"SCREENSHOTS_S3_BUCKET"`
+			Prefix    string `yaml:"prefix" envconfig:"SCREENSHOTS_S3_PREFIX"`
+			AccessKey string `yaml:"accessKey" envconfig:"SCREENSHOTS_S3_ACCESS_KEY"`
+			SecretKey string `yaml:"secretKey" envconfig:"SCREENSHOTS_S3_SECRET_KEY"`
+			PathStyle bool   `yaml:"pathStyle" envconfig:"SCREENSHOTS_S3_PATH_STYLE"`
+		} `yaml:"s3"`
+	} `yaml:"screenshots"`
+
+	ValidatorMetrics struct {
+		Enabled    bool     `yaml:"enabled" envconfig:"VALIDATOR_METRICS_ENABLED"`
+		Validators []string `yaml:"validators" envconfig:"VALIDATOR_METRICS_VALIDATORS"`
+		AuthToken  string   `yaml:"authToken" envconfig:"VALIDATOR_METRICS_AUTH_TOKEN"`
+	} `yaml:"validatorMetrics"`
+
+	Notifications struct {
+		Enabled  bool                        `yaml:"enabled" envconfig:"NOTIFICATIONS_ENABLED"`
+		BaseUrl  string                      `yaml:"baseUrl" envconfig:"NOTIFICATIONS_BASE_URL"`
+		Channels []NotificationChannelConfig `yaml:"channels"`
+		Rules    []NotificationRuleConfig    `yaml:"rules"`
+
+		// allow watchlist owners to register webhook urls that are called by the notification engine (public addresses only)
+		WatchlistWebhooks bool `yaml:"watchlistWebhooks" envconfig:"NOTIFICATIONS_WATCHLIST_WEBHOOKS"`
+	} `yaml:"notifications"`
+
+	Metrics struct {
+		Enabled   bool   `yaml:"enabled" envconfig:"METRICS_ENABLED"`
+		AuthToken string `yaml:"authToken" envconfig:"METRICS_AUTH_TOKEN"`
+	} `yaml:"metrics"`
+
+	AdminApi struct {
+		Enabled      bool                  `yaml:"enabled" envconfig:"ADMIN_API_ENABLED"`
+		Tokens       []AdminApiTokenConfig `yaml:"tokens"`
+		AuditLogSize int                   `yaml:"auditLogSize" envconfig:"ADMIN_API_AUDIT_LOG_SIZE"`
+	} `yaml:"adminApi"`
+
 	Database struct {
 		Engine string `yaml:"engine" envconfig:"DATABASE_ENGINE"`
 		Sqlite struct {
@@ -134,6 +267,11 @@ type Config struct {
 			MaxOpenConns int    `yaml:"maxOpenConns" envconfig:"DATABASE_PGSQL_WRITER_MAX_OPEN_CONNS"`
 			MaxIdleConns int    `yaml:"maxIdleConns" envconfig:"DATABASE_PGSQL_WRITER_MAX_IDLE_CONNS"`
 		} `yaml:"pgsqlWriter"`
+		Maintenance struct {
+			Enabled  bool          `yaml:"enabled" envconfig:"DATABASE_MAINTENANCE_ENABLED"`
+			Windows  []string      `yaml:"windows" envconfig:"DATABASE_MAINTENANCE_WINDOWS"`
+			Interval time.Duration `yaml:"interval" envconfig:"DATABASE_MAINTENANCE_INTERVAL"`
+		} `yaml:"maintenance"`
 	} `yaml:"database"`
 
 	KillSwitch struct {
@@ -153,6 +291,70 @@ type EndpointConfig struct {
 	Headers        map[string]string  `yaml:"headers"`
 }
 
+// ScreenshotPageConfig is a page that is periodically rendered by the screenshot service
+type ScreenshotPageConfig struct {
+	Name     string        `yaml:"name"`
+	Path     string        `yaml:"path"`
+	Interval time.Duration `yaml:"interval"`
+}
+
+// NotificationChannelConfig is a destination notifications are delivered to
+type NotificationChannelConfig struct {
+	Name string `yaml:"name"`
+	Type string `yaml:"type"` // webhook, telegram or smtp
+
+	// webhook
+	Url     string            `yaml:"url"`
+	Headers map[string]string `yaml:"headers"`
+
+	// telegram
+	BotToken string `yaml:"botToken"`
+	ChatId   string `yaml:"chatId"`
+
+	// smtp
+	SmtpHost     string   `yaml:"smtpHost"`
+	SmtpPort     int      `yaml:"smtpPort"`
+	SmtpUsername string   `yaml:"smtpUsername"`
+	SmtpPassword string   `yaml:"smtpPassword"`
+	SmtpFrom     string   `yaml:"smtpFrom"`
+	SmtpTo       []string `yaml:"smtpTo"`
+}
+
+// NotificationRuleConfig selects the events of a set of validators that are sent to the configured channels
+type NotificationRuleConfig struct {
+	Name          string   `yaml:"name"`
+	Events        []string `yaml:"events"`
+	Validators    []string `yaml:"validators"`
+	OfflineEpochs uint64   `yaml:"offlineEpochs"`
+	Channels      []string `yaml:"channels"`
+}
+
+type AdminApiTokenConfig struct {
+	Name   string   `yaml:"name"`
+	Token  string   `yaml:"token"`
+	Scopes []string `yaml:"scopes"` // "read", "indexer", "reload" and/or "notes"
+}
+
+type DepositContractConfig struct {
+	Address   string `yaml:"address"`
+	FromBlock uint64 `yaml:"fromBlock"`
+	ToBlock   uint64 `yaml:"toBlock"`
+}
+
+type ExternalLinkConfig struct {
+	Name string `yaml:"name"` // link caption
+	Page string `yaml:"page"` // "slot" or "validator"
+	Url  string `yaml:"url"`  // url template with {placeholders} of the page (e.g. "https://beaconcha.in/slot/{slot}")
+}
+
+type WatchedContractConfig struct {
+	Name      string   `yaml:"name"`
+	Address   string   `yaml:"address"`
+	Topics    []string `yaml:"topics"` // event signatures or topic0 hashes to index, empty to index all logs
+	FromBlock uint64   `yaml:"fromBlock"`
+	ToBlock   uint64   `yaml:"toBlock"`
+}
+
 type EndpointSshConfig struct {
 	Host     string `yaml:"host"`
 	Port     string `yaml:"port"`
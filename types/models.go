@@ -32,6 +32,12 @@ type PageData struct {
 	MainMenuItems         []MainMenuItem
 }
 
+// ExternalLink is a configured link to another explorer or tool for the object shown on a page
+type ExternalLink struct {
+	Name string `json:"name"`
+	Url  string `json:"url"`
+}
+
 type MainMenuItem struct {
 	Label        string
 	Path         string
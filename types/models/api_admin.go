@@ -0,0 +1,78 @@
+package models
+
+import "time"
+
+// ApiAdminStatusResponse is a struct to hold the response of the admin status api
+type ApiAdminStatusResponse struct {
+	Version        string                  `json:"version"`
+	Token          string                  `json:"token"`
+	Scopes         []string                `json:"scopes"`
+	FinalizedEpoch uint64                  `json:"finalized_epoch"`
+	PrunedEpoch    uint64                  `json:"pruned_epoch"`
+	Synchronizer   *ApiAdminSynchronizer   `json:"synchronizer"`
+	DbMaintenance  *ApiAdminDbMaintenance  `json:"db_maintenance,omitempty"`
+	ForkCleanup    *ApiAdminForkCleanup    `json:"fork_cleanup,omitempty"`
+	Clients        []*ApiAdminStatusClient `json:"clients"`
+}
+
+type ApiAdminSynchronizer struct {
+	Enabled   bool   `json:"enabled"`
+	Running   bool   `json:"running"`
+	Paused    bool   `json:"paused"`
+	HeadEpoch uint64 `json:"head_epoch"`
+}
+
+type ApiAdminDbMaintenance struct {
+	Running     bool      `json:"running"`
+	Windows     []string  `json:"windows"`
+	LastRun     time.Time `json:"last_run"`
+	LastSuccess bool      `json:"last_success"`
+	LastError   string    `json:"last_error,omitempty"`
+	NextRun     time.Time `json:"next_run"`
+}
+
+type ApiAdminForkCleanup struct {
+	RetentionEpochs     uint64    `json:"retention_epochs"`
+	LastRun             time.Time `json:"last_run"`
+	CutoffEpoch         uint64    `json:"cutoff_epoch"`
+	LastError           string    `json:"last_error,omitempty"`
+	DeletedForks        uint64    `json:"deleted_forks"`
+	DeletedBlocks       uint64    `json:"deleted_blocks"`
+	DeletedOperations   uint64    `json:"deleted_operations"`
+	DeletedTransactions uint64    `json:"deleted_transactions"`
+	DeletedBlobs        uint64    `json:"deleted_blobs"`
+}
+
+type ApiAdminStatusClient struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	HeadSlot uint64 `json:"head_slot"`
+}
+
+// ApiAdminActionResponse is a struct to hold the result of an admin action
+type ApiAdminActionResponse struct {
+	Action  string `json:"action"`
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// ApiAdminAuditResponse is a struct to hold the response of the admin audit log api
+type ApiAdminAuditResponse struct {
+	Entries []*ApiAdminAuditEntry `json:"entries"`
+}
+
+type ApiAdminAuditEntry struct {
+	Time    time.Time `json:"time"`
+	Token   string    `json:"token"`
+	Remote  string    `json:"remote"`
+	Action  string    `json:"action"`
+	Success bool      `json:"success"`
+	Message string    `json:"message"`
+}
+
+// ApiAdminValidatorNoteRequest is a struct to hold the request body of the validator note api
+type ApiAdminValidatorNoteRequest struct {
+	Validator uint64   `json:"validator"`
+	Note      string   `json:"note"`
+	Tags      []string `json:"tags"`
+}
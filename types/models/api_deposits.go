@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+)
+
+// ApiIncludedDepositsResponse is a struct to hold the response of the included deposits api
+type ApiIncludedDepositsResponse struct {
+	Filter      ApiIncludedDepositsFilter   `json:"filter"`
+	PageIndex   uint64                      `json:"page_index"`
+	PageSize    uint64                      `json:"page_size"`
+	TotalPages  uint64                      `json:"total_pages"`
+	Count       uint64                      `json:"count"`
+	NextPageApi string                      `json:"next_page_api,omitempty"`
+	Deposits    []*ApiIncludedDepositsEntry `json:"deposits"`
+}
+
+type ApiIncludedDepositsFilter struct {
+	MinIndex      uint64 `json:"min_index,omitempty"`
+	MaxIndex      uint64 `json:"max_index,omitempty"`
+	PubKey        string `json:"pubkey,omitempty"`
+	ValidatorName string `json:"validator_name,omitempty"`
+	MinAmount     uint64 `json:"min_amount,omitempty"`
+	MaxAmount     uint64 `json:"max_amount,omitempty"`
+	WithOrphaned  uint8  `json:"with_orphaned"`
+}
+
+type ApiIncludedDepositsEntry struct {
+	Index                 *uint64   `json:"index"`
+	PublicKey             []byte    `json:"pubkey"`
+	WithdrawalCredentials []byte    `json:"withdrawal_credentials"`
+	Amount                uint64    `json:"amount"`
+	Slot                  uint64    `json:"slot"`
+	SlotRoot              []byte    `json:"slot_root"`
+	Time                  time.Time `json:"time"`
+	Orphaned              bool      `json:"orphaned"`
+	ValidatorStatus       string    `json:"validator_status"`
+}
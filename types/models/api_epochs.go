@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+)
+
+// ApiEpochsResponse is a struct to hold the response of the epochs api
+type ApiEpochsResponse struct {
+	FirstEpoch  uint64           `json:"first_epoch"`
+	LastEpoch   uint64           `json:"last_epoch"`
+	PageSize    uint64           `json:"page_size"`
+	Count       uint64           `json:"count"`
+	NextPageApi string           `json:"next_page_api,omitempty"`
+	Epochs      []*ApiEpochEntry `json:"epochs"`
+}
+
+type ApiEpochEntry struct {
+	Epoch                   uint64    `json:"epoch"`
+	Time                    time.Time `json:"time"`
+	Finalized               bool      `json:"finalized"`
+	Justified               bool      `json:"justified"`
+	CanonicalBlockCount     uint64    `json:"canonical_block_count"`
+	OrphanedBlockCount      uint64    `json:"orphaned_block_count"`
+	AttestationCount        uint64    `json:"attestation_count"`
+	DepositCount            uint64    `json:"deposit_count"`
+	ExitCount               uint64    `json:"exit_count"`
+	ProposerSlashingCount   uint64    `json:"proposer_slashing_count"`
+	AttesterSlashingCount   uint64    `json:"attester_slashing_count"`
+	EligibleEther           uint64    `json:"eligible_ether"`
+	TargetVoted             uint64    `json:"target_voted"`
+	HeadVoted               uint64    `json:"head_voted"`
+	TotalVoted              uint64    `json:"total_voted"`
+	TargetVoteParticipation float64   `json:"target_vote_participation"`
+	HeadVoteParticipation   float64   `json:"head_vote_participation"`
+	TotalVoteParticipation  float64   `json:"total_vote_participation"`
+	EthTransactionCount     uint64    `json:"eth_transaction_count"`
+}
+
+// ApiEpochResponse is a struct to hold the response of the epoch details api
+type ApiEpochResponse struct {
+	Epoch                   uint64          `json:"epoch"`
+	Time                    time.Time       `json:"time"`
+	Finalized               bool            `json:"finalized"`
+	ForkName                string          `json:"fork_name"`
+	ValidatorCount          uint64          `json:"validator_count"`
+	AverageValidatorBalance uint64          `json:"avg_validator_balance"`
+	EligibleEther           uint64          `json:"eligible_ether"`
+	TargetVoted             uint64          `json:"target_voted"`
+	HeadVoted               uint64          `json:"head_voted"`
+	TotalVoted              uint64          `json:"total_voted"`
+	TargetVoteParticipation float64         `json:"target_vote_participation"`
+	HeadVoteParticipation   float64         `json:"head_vote_participation"`
+	TotalVoteParticipation  float64         `json:"total_vote_participation"`
+	SyncParticipation       float64         `json:"sync_participation"`
+	AttestationCount        uint64          `json:"attestation_count"`
+	DepositCount            uint64          `json:"deposit_count"`
+	ExitCount               uint64          `json:"exit_count"`
+	WithdrawalCount         uint64          `json:"withdrawal_count"`
+	WithdrawalAmount        uint64          `json:"withdrawal_amount"`
+	ProposerSlashingCount   uint64          `json:"proposer_slashing_count"`
+	AttesterSlashingCount   uint64          `json:"attester_slashing_count"`
+	CanonicalCount          uint64          `json:"canonical_count"`
+	MissedCount             uint64          `json:"missed_count"`
+	ScheduledCount          uint64          `json:"scheduled_count"`
+	OrphanedCount           uint64          `json:"orphaned_count"`
+	EthTransactionCount     uint64          `json:"eth_transaction_count"`
+	Slots                   []*ApiSlotEntry `json:"slots"`
+}
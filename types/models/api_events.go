@@ -0,0 +1,19 @@
+package models
+
+import "encoding/json"
+
+// ApiEventsResponse is a struct to hold the response of the indexer events api
+type ApiEventsResponse struct {
+	Since       uint64            `json:"since"`
+	LastEventId uint64            `json:"last_event_id"`
+	Count       uint64            `json:"count"`
+	More        bool              `json:"more"`
+	Events      []*ApiEventsEntry `json:"events"`
+}
+
+type ApiEventsEntry struct {
+	Id   uint64          `json:"id"`
+	Type string          `json:"type"`
+	Time int64           `json:"time"`
+	Data json.RawMessage `json:"data"`
+}
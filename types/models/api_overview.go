@@ -0,0 +1,74 @@
+package models
+
+import (
+	"time"
+)
+
+// ApiOverviewResponse is a struct to hold the response of the network overview api
+type ApiOverviewResponse struct {
+	Network         string                      `json:"network"`
+	CurrentSlot     uint64                      `json:"current_slot"`
+	CurrentEpoch    uint64                      `json:"current_epoch"`
+	Synced          bool                        `json:"synced"`
+	Heads           []*ApiOverviewHead          `json:"heads"`
+	Finality        *ApiOverviewFinality        `json:"finality"`
+	Participation   []*ApiOverviewParticipation `json:"participation"`
+	ClientDiversity *ApiOverviewClientDiversity `json:"client_diversity"`
+	Validators      *ApiOverviewValidators      `json:"validators"`
+	Incidents       []*ApiOverviewIncident      `json:"incidents"`
+}
+
+type ApiOverviewHead struct {
+	Slot         uint64 `json:"slot"`
+	Root         string `json:"root"`
+	Canonical    bool   `json:"canonical"`
+	ClientCount  uint64 `json:"client_count"`
+	ReadyClients uint64 `json:"ready_clients"`
+}
+
+type ApiOverviewFinality struct {
+	FinalizedEpoch uint64 `json:"finalized_epoch"`
+	FinalizedRoot  string `json:"finalized_root"`
+	JustifiedEpoch uint64 `json:"justified_epoch"`
+	JustifiedRoot  string `json:"justified_root"`
+	FinalityDelay  uint64 `json:"finality_delay"`
+}
+
+type ApiOverviewParticipation struct {
+	Epoch               uint64  `json:"epoch"`
+	Finalized           bool    `json:"finalized"`
+	TargetParticipation float64 `json:"target_participation"`
+	HeadParticipation   float64 `json:"head_participation"`
+	TotalParticipation  float64 `json:"total_participation"`
+	MissedBlocks        uint64  `json:"missed_blocks"`
+	OrphanedBlocks      uint64  `json:"orphaned_blocks"`
+}
+
+type ApiOverviewClientDiversity struct {
+	Consensus []*ApiOverviewClientType `json:"consensus"`
+	Execution []*ApiOverviewClientType `json:"execution"`
+}
+
+type ApiOverviewClientType struct {
+	Type   string `json:"type"`
+	Count  uint64 `json:"count"`
+	Online uint64 `json:"online"`
+}
+
+type ApiOverviewValidators struct {
+	Total          uint64            `json:"total"`
+	Active         uint64            `json:"active"`
+	Pending        uint64            `json:"pending"`
+	Exited         uint64            `json:"exited"`
+	Slashed        uint64            `json:"slashed"`
+	EffectiveEther uint64            `json:"effective_ether"`
+	Statuses       map[string]uint64 `json:"statuses"`
+}
+
+type ApiOverviewIncident struct {
+	Type        string    `json:"type"`
+	Time        time.Time `json:"time"`
+	Ongoing     bool      `json:"ongoing"`
+	Slot        uint64    `json:"slot,omitempty"`
+	Description string    `json:"description"`
+}
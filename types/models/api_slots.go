@@ -0,0 +1,96 @@
+package models
+
+import (
+	"time"
+)
+
+// ApiSlotsResponse is a struct to hold the response of the slots api
+type ApiSlotsResponse struct {
+	FirstSlot   uint64          `json:"first_slot"`
+	LastSlot    uint64          `json:"last_slot"`
+	PageSize    uint64          `json:"page_size"`
+	Count       uint64          `json:"count"`
+	NextPageApi string          `json:"next_page_api,omitempty"`
+	Slots       []*ApiSlotEntry `json:"slots"`
+}
+
+type ApiSlotEntry struct {
+	Slot                  uint64    `json:"slot"`
+	Epoch                 uint64    `json:"epoch"`
+	Time                  time.Time `json:"time"`
+	Status                string    `json:"status"`
+	Finalized             bool      `json:"finalized"`
+	Proposer              uint64    `json:"proposer"`
+	ProposerName          string    `json:"proposer_name"`
+	BlockRoot             []byte    `json:"block_root,omitempty"`
+	ParentRoot            []byte    `json:"parent_root,omitempty"`
+	Graffiti              []byte    `json:"graffiti,omitempty"`
+	AttestationCount      uint64    `json:"attestation_count"`
+	DepositCount          uint64    `json:"deposit_count"`
+	ExitCount             uint64    `json:"exit_count"`
+	ProposerSlashingCount uint64    `json:"proposer_slashing_count"`
+	AttesterSlashingCount uint64    `json:"attester_slashing_count"`
+	SyncParticipation     float64   `json:"sync_participation"`
+	EthBlockNumber        *uint64   `json:"eth_block_number"`
+	EthTransactionCount   uint64    `json:"eth_transaction_count"`
+}
+
+// ApiSlotResponse is a struct to hold the response of the slot details api
+type ApiSlotResponse struct {
+	Slot                      uint64                `json:"slot"`
+	Epoch                     uint64                `json:"epoch"`
+	Time                      time.Time             `json:"time"`
+	Status                    string                `json:"status"`
+	Finalized                 bool                  `json:"finalized"`
+	ForkName                  string                `json:"fork_name"`
+	Proposer                  uint64                `json:"proposer"`
+	ProposerName              string                `json:"proposer_name"`
+	BlockRoot                 []byte                `json:"block_root,omitempty"`
+	ParentRoot                []byte                `json:"parent_root,omitempty"`
+	StateRoot                 []byte                `json:"state_root,omitempty"`
+	Graffiti                  []byte                `json:"graffiti,omitempty"`
+	AttestationCount          uint64                `json:"attestation_count"`
+	DepositCount              uint64                `json:"deposit_count"`
+	ExitCount                 uint64                `json:"exit_count"`
+	ProposerSlashingCount     uint64                `json:"proposer_slashing_count"`
+	AttesterSlashingCount     uint64                `json:"attester_slashing_count"`
+	BLSChangeCount            uint64                `json:"bls_change_count"`
+	WithdrawalCount           uint64                `json:"withdrawal_count"`
+	BlobCount                 uint64                `json:"blob_count"`
+	DepositRequestCount       uint64                `json:"deposit_request_count"`
+	WithdrawalRequestCount    uint64                `json:"withdrawal_request_count"`
+	ConsolidationRequestCount uint64                `json:"consolidation_request_count"`
+	SyncParticipation         float64               `json:"sync_participation"`
+	Execution                 *ApiSlotExecutionData `json:"execution,omitempty"`
+}
+
+type ApiSlotExecutionData struct {
+	BlockNumber      uint64 `json:"block_number"`
+	BlockHash        []byte `json:"block_hash"`
+	ParentHash       []byte `json:"parent_hash"`
+	FeeRecipient     []byte `json:"fee_recipient"`
+	GasLimit         uint64 `json:"gas_limit"`
+	GasUsed          uint64 `json:"gas_used"`
+	BaseFeePerGas    uint64 `json:"base_fee_per_gas"`
+	Timestamp        uint64 `json:"timestamp"`
+	ExtraData        []byte `json:"extra_data"`
+	TransactionCount uint64 `json:"transaction_count"`
+}
+
+// ApiRecentSlotsResponse is a struct to hold the response of the recent slots api
+type ApiRecentSlotsResponse struct {
+	HeadSlot       uint64                `json:"head_slot"`
+	FinalizedEpoch uint64                `json:"finalized_epoch"`
+	Count          uint64                `json:"count"`
+	Slots          []*ApiRecentSlotEntry `json:"slots"`
+}
+
+type ApiRecentSlotEntry struct {
+	Slot           uint64    `json:"slot"`
+	Epoch          uint64    `json:"epoch"`
+	Time           time.Time `json:"time"`
+	BlockRoot      string    `json:"block_root"`
+	Proposer       uint64    `json:"proposer"`
+	ProposerName   string    `json:"proposer_name,omitempty"`
+	EthBlockNumber *uint64   `json:"eth_block_number,omitempty"`
+}
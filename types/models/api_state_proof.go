@@ -0,0 +1,45 @@
+package models
+
+import "github.com/ethereum/go-ethereum/common/hexutil"
+
+// ApiStateProofResponse is a struct to hold the response of the state proof api
+type ApiStateProofResponse struct {
+	Slot           uint64          `json:"slot"`
+	BlockRoot      hexutil.Bytes   `json:"block_root"`
+	StateRoot      hexutil.Bytes   `json:"state_root"`
+	Version        string          `json:"version"`
+	Field          string          `json:"field"`
+	Index          *uint64         `json:"index,omitempty"`
+	GeneralizedIdx uint64          `json:"gindex"`
+	Leaf           hexutil.Bytes   `json:"leaf"`
+	Branch         []hexutil.Bytes `json:"branch"`
+}
+
+// ApiExecutionProofResponse is a struct to hold the response of the execution storage proof api
+type ApiExecutionProofResponse struct {
+	Slot                 uint64                   `json:"slot"`
+	BlockRoot            hexutil.Bytes            `json:"block_root"`
+	StateRoot            hexutil.Bytes            `json:"state_root"`
+	ExecutionBlockNumber uint64                   `json:"execution_block_number"`
+	ExecutionBlockHash   hexutil.Bytes            `json:"execution_block_hash"`
+	ExecutionStateRoot   hexutil.Bytes            `json:"execution_state_root"`
+	Contract             string                   `json:"contract"`
+	Client               string                   `json:"client"`
+	Proof                *ApiExecutionProofResult `json:"proof"`
+}
+
+type ApiExecutionProofResult struct {
+	Address      hexutil.Bytes               `json:"address"`
+	AccountProof []hexutil.Bytes             `json:"account_proof"`
+	Balance      *hexutil.Big                `json:"balance"`
+	CodeHash     hexutil.Bytes               `json:"code_hash"`
+	Nonce        uint64                      `json:"nonce"`
+	StorageHash  hexutil.Bytes               `json:"storage_hash"`
+	StorageProof []*ApiExecutionProofStorage `json:"storage_proof"`
+}
+
+type ApiExecutionProofStorage struct {
+	Key   string          `json:"key"`
+	Value *hexutil.Big    `json:"value"`
+	Proof []hexutil.Bytes `json:"proof"`
+}
@@ -0,0 +1,17 @@
+package models
+
+// ApiStatsRenderResponse is a struct to hold the response of the render stats api
+type ApiStatsRenderResponse struct {
+	Pages []*ApiStatsRenderPage `json:"pages"`
+}
+
+// ApiStatsRenderPage holds the template render durations of a page in milliseconds
+type ApiStatsRenderPage struct {
+	Page    string  `json:"page"`
+	Count   uint64  `json:"count"`
+	Average float64 `json:"avg_ms"`
+	Max     float64 `json:"max_ms"`
+	P50     float64 `json:"p50_ms"`
+	P90     float64 `json:"p90_ms"`
+	P99     float64 `json:"p99_ms"`
+}
@@ -0,0 +1,27 @@
+package models
+
+// ApiStatsRollingResponse is a struct to hold the response of the rolling stats api
+type ApiStatsRollingResponse struct {
+	FinalizedEpoch uint64                   `json:"finalized_epoch"`
+	Windows        []*ApiStatsRollingWindow `json:"windows"`
+}
+
+type ApiStatsRollingWindow struct {
+	Window              string  `json:"window"`
+	FirstEpoch          uint64  `json:"first_epoch"`
+	LastEpoch           uint64  `json:"last_epoch"`
+	EpochCount          uint64  `json:"epoch_count"`
+	Complete            bool    `json:"complete"`
+	TargetParticipation float64 `json:"target_participation"`
+	HeadParticipation   float64 `json:"head_participation"`
+	TotalParticipation  float64 `json:"total_participation"`
+	SlotCount           uint64  `json:"slot_count"`
+	BlockCount          uint64  `json:"block_count"`
+	MissedSlots         uint64  `json:"missed_slots"`
+	MissedSlotsPercent  float64 `json:"missed_slots_percent"`
+	ReorgCount          uint64  `json:"reorg_count"`
+	AvgBlockTime        float64 `json:"avg_block_time"`
+	AvgBlockTimeDelay   float64 `json:"avg_block_time_delay"`
+	DepositCount        uint64  `json:"deposit_count"`
+	DepositAmount       uint64  `json:"deposit_amount"`
+}
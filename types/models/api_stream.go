@@ -0,0 +1,28 @@
+package models
+
+// ApiStreamBlockEvent is the data of a "block" event on the live update stream
+type ApiStreamBlockEvent struct {
+	Slot       uint64 `json:"slot"`
+	Epoch      uint64 `json:"epoch"`
+	Root       string `json:"root"`
+	ParentRoot string `json:"parent_root"`
+	Proposer   uint64 `json:"proposer"`
+}
+
+// ApiStreamHeadEvent is the data of a "head" event on the live update stream
+type ApiStreamHeadEvent struct {
+	Slot        uint64 `json:"slot"`
+	Epoch       uint64 `json:"epoch"`
+	Root        string `json:"root"`
+	OldHeadSlot uint64 `json:"old_head_slot,omitempty"`
+	OldHeadRoot string `json:"old_head_root,omitempty"`
+	Reorg       bool   `json:"reorg"`
+}
+
+// ApiStreamFinalityEvent is the data of a "finalized" event on the live update stream
+type ApiStreamFinalityEvent struct {
+	JustifiedEpoch uint64 `json:"justified_epoch"`
+	JustifiedRoot  string `json:"justified_root"`
+	FinalizedEpoch uint64 `json:"finalized_epoch"`
+	FinalizedRoot  string `json:"finalized_root"`
+}
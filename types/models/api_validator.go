@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+)
+
+// ApiValidatorResponse is a struct to hold the response of the validator details api
+type ApiValidatorResponse struct {
+	Index               uint64     `json:"index"`
+	Name                string     `json:"name"`
+	PublicKey           []byte     `json:"pubkey"`
+	State               string     `json:"state"`
+	BeaconState         string     `json:"beacon_state"`
+	Balance             uint64     `json:"balance"`
+	EffectiveBalance    uint64     `json:"effective_balance"`
+	EligibilityEpoch    *uint64    `json:"eligibility_epoch"`
+	ActivationEpoch     *uint64    `json:"activation_epoch"`
+	ActivationTime      *time.Time `json:"activation_time,omitempty"`
+	ExitEpoch           *uint64    `json:"exit_epoch"`
+	ExitTime            *time.Time `json:"exit_time,omitempty"`
+	ExitReason          string     `json:"exit_reason,omitempty"`
+	WithdrawCredentials []byte     `json:"withdraw_credentials"`
+	WithdrawAddress     []byte     `json:"withdraw_address,omitempty"`
+	Upcheck             uint8      `json:"upcheck"`
+	UpcheckMaximum      uint8      `json:"upcheck_max"`
+}
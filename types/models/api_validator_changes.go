@@ -0,0 +1,23 @@
+package models
+
+// ApiValidatorChangesResponse is a struct to hold the response of the validator changes api
+type ApiValidatorChangesResponse struct {
+	SinceEpoch   uint64                      `json:"since_epoch"`
+	CurrentEpoch uint64                      `json:"current_epoch"`
+	FullSnapshot bool                        `json:"full_snapshot"`
+	Count        uint64                      `json:"count"`
+	Validators   []*ApiValidatorChangesEntry `json:"validators"`
+}
+
+type ApiValidatorChangesEntry struct {
+	Index                 uint64 `json:"index"`
+	PublicKey             []byte `json:"pubkey"`
+	Status                string `json:"status"`
+	Balance               uint64 `json:"balance"`
+	EffectiveBalance      uint64 `json:"effective_balance"`
+	WithdrawalCredentials []byte `json:"withdrawal_credentials"`
+	Slashed               bool   `json:"slashed"`
+	ActivationEpoch       uint64 `json:"activation_epoch"`
+	ExitEpoch             uint64 `json:"exit_epoch"`
+	WithdrawableEpoch     uint64 `json:"withdrawable_epoch"`
+}
@@ -0,0 +1,19 @@
+package models
+
+// ApiValidatorIncomeResponse is a struct to hold the response of the validator income api
+type ApiValidatorIncomeResponse struct {
+	Validator    uint64                     `json:"validator"`
+	PeriodEpochs uint64                     `json:"period_epochs"`
+	Income       []*ApiValidatorIncomeEntry `json:"income"`
+}
+
+// ApiValidatorIncomeEntry holds the income of the validator within a period (values in gwei)
+type ApiValidatorIncomeEntry struct {
+	Epoch             uint64 `json:"epoch"`
+	Time              int64  `json:"time"`
+	AttestationReward int64  `json:"attestation_reward"`
+	SyncReward        int64  `json:"sync_reward"`
+	ProposalReward    int64  `json:"proposal_reward"`
+	Penalty           int64  `json:"penalty"`
+	NetIncome         int64  `json:"net_income"`
+}
@@ -0,0 +1,32 @@
+package models
+
+// ApiValidatorLabelsResponse is a struct to hold the response of the validator labels export api
+type ApiValidatorLabelsResponse struct {
+	Count  uint64                     `json:"count"`
+	Labels []*ApiValidatorLabelsEntry `json:"labels"`
+}
+
+type ApiValidatorLabelsEntry struct {
+	Index  uint64 `json:"index"`
+	Name   string `json:"name"`
+	Source string `json:"source"`
+}
+
+// ApiValidatorLabelsImportResponse is a struct to hold the response of the validator labels import api
+type ApiValidatorLabelsImportResponse struct {
+	Received uint64 `json:"received"`
+	Changed  uint64 `json:"changed"`
+}
+
+// ApiValidatorLabelChangesResponse is a struct to hold the response of the validator label changes api
+type ApiValidatorLabelChangesResponse struct {
+	Changes []*ApiValidatorLabelChangesEntry `json:"changes"`
+}
+
+type ApiValidatorLabelChangesEntry struct {
+	Index      uint64 `json:"index"`
+	ChangeTime int64  `json:"change_time"`
+	OldName    string `json:"old_name"`
+	NewName    string `json:"new_name"`
+	Source     string `json:"source"`
+}
@@ -0,0 +1,15 @@
+package models
+
+// ApiValidatorNotesResponse is a struct to hold the response of the validator notes api
+type ApiValidatorNotesResponse struct {
+	Notes []*ApiValidatorNotesEntry `json:"notes"`
+}
+
+type ApiValidatorNotesEntry struct {
+	Id        uint64   `json:"id"`
+	Validator uint64   `json:"validator"`
+	Note      string   `json:"note"`
+	Author    string   `json:"author"`
+	CreatedAt int64    `json:"created_at"`
+	Tags      []string `json:"tags"`
+}
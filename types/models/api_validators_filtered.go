@@ -0,0 +1,39 @@
+package models
+
+// ApiValidatorsFilteredResponse is a struct to hold the response of the filtered validators api
+type ApiValidatorsFilteredResponse struct {
+	Filter      ApiValidatorsFilteredFilter   `json:"filter"`
+	Sorting     string                        `json:"sorting"`
+	ApyWindow   string                        `json:"apy_window"`
+	AtEpoch     *uint64                       `json:"at_epoch,omitempty"` // set for historical views
+	FirstIndex  uint64                        `json:"first_index"`
+	PageSize    uint64                        `json:"page_size"`
+	TotalCount  uint64                        `json:"total_count"`
+	Count       uint64                        `json:"count"`
+	PageLink    string                        `json:"page_link"`
+	NextPageApi string                        `json:"next_page_api,omitempty"`
+	Validators  []*ApiValidatorsFilteredEntry `json:"validators"`
+}
+
+type ApiValidatorsFilteredFilter struct {
+	PubKey string   `json:"pubkey,omitempty"`
+	Index  string   `json:"index,omitempty"`
+	Name   string   `json:"name,omitempty"`
+	Status []string `json:"status,omitempty"`
+	Expr   string   `json:"expr,omitempty"`
+	Tag    string   `json:"tag,omitempty"`
+}
+
+type ApiValidatorsFilteredEntry struct {
+	Index            uint64   `json:"index"`
+	Name             string   `json:"name"`
+	PublicKey        []byte   `json:"pubkey"`
+	State            string   `json:"state"`
+	Balance          uint64   `json:"balance"`
+	EffectiveBalance uint64   `json:"effective_balance"`
+	ActivationEpoch  *uint64  `json:"activation_epoch"`
+	ExitEpoch        *uint64  `json:"exit_epoch"`
+	WithdrawAddress  []byte   `json:"withdraw_address,omitempty"`
+	Apy              *float64 `json:"apy"`
+	Upcheck          *uint8   `json:"upcheck,omitempty"`
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+)
+
+// ApiWithdrawalRequestsResponse is a struct to hold the response of the withdrawal requests api
+type ApiWithdrawalRequestsResponse struct {
+	Filter             ApiWithdrawalRequestsFilter   `json:"filter"`
+	PageIndex          uint64                        `json:"page_index"`
+	PageSize           uint64                        `json:"page_size"`
+	TotalPages         uint64                        `json:"total_pages"`
+	Count              uint64                        `json:"count"`
+	NextPageApi        string                        `json:"next_page_api,omitempty"`
+	WithdrawalRequests []*ApiWithdrawalRequestsEntry `json:"withdrawal_requests"`
+}
+
+type ApiWithdrawalRequestsFilter struct {
+	MinSlot       uint64 `json:"min_slot,omitempty"`
+	MaxSlot       uint64 `json:"max_slot,omitempty"`
+	SourceAddress string `json:"source_address,omitempty"`
+	MinIndex      uint64 `json:"min_index,omitempty"`
+	MaxIndex      uint64 `json:"max_index,omitempty"`
+	ValidatorName string `json:"validator_name,omitempty"`
+	PubKey        string `json:"pubkey,omitempty"`
+	WithOrphaned  uint8  `json:"with_orphaned"`
+	WithType      uint8  `json:"with_type"`
+}
+
+type ApiWithdrawalRequestsEntry struct {
+	Status         string    `json:"status"`
+	Slot           uint64    `json:"slot"`
+	SlotRoot       []byte    `json:"slot_root,omitempty"`
+	Time           time.Time `json:"time"`
+	SourceAddress  []byte    `json:"source_address"`
+	Amount         uint64    `json:"amount"`
+	PublicKey      []byte    `json:"pubkey"`
+	ValidatorIndex *uint64   `json:"validator_index"`
+	ValidatorName  string    `json:"validator_name,omitempty"`
+	TxHash         []byte    `json:"tx_hash,omitempty"`
+	TxStatus       string    `json:"tx_status,omitempty"`
+	TxBlockNumber  uint64    `json:"tx_block_number,omitempty"`
+	TxOrigin       string    `json:"tx_origin,omitempty"`
+	TxFee          string    `json:"tx_fee,omitempty"`
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+)
+
+// BlobStatsPageData is a struct to hold info for the blob stats page
+type BlobStatsPageData struct {
+	Period             string                           `json:"period"`
+	PeriodOptions      []*BlobStatsPageDataPeriod       `json:"period_options"`
+	FirstSlot          uint64                           `json:"first_slot"`
+	LastSlot           uint64                           `json:"last_slot"`
+	FirstSlotTs        time.Time                        `json:"first_slot_ts"`
+	BlockCount         uint64                           `json:"block_count"`
+	BlobBlockCount     uint64                           `json:"blob_block_count"`
+	BlobBlockPercent   float64                          `json:"blob_block_percent"`
+	BlobTxCount        uint64                           `json:"blob_tx_count"`
+	BlobTxSize         uint64                           `json:"blob_tx_size"`
+	BlobCount          uint64                           `json:"blob_count"`
+	AvgBlobsPerBlock   float64                          `json:"avg_blobs_per_block"`
+	AvgBlobTxsPerBlock float64                          `json:"avg_blob_txs_per_block"`
+	AvgBlobsPerTx      float64                          `json:"avg_blobs_per_tx"`
+	AvgBlobTxSize      uint64                           `json:"avg_blob_tx_size"`
+	BlobsPerTx         []*BlobStatsPageDataDistribution `json:"blobs_per_tx"`
+	BlobsPerBlock      []*BlobStatsPageDataDistribution `json:"blobs_per_block"`
+}
+
+type BlobStatsPageDataPeriod struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+type BlobStatsPageDataDistribution struct {
+	BlobCount uint64  `json:"blob_count"`
+	Count     uint64  `json:"count"`
+	Percent   float64 `json:"percent"`
+}
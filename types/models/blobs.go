@@ -0,0 +1,71 @@
+package models
+
+import (
+	"time"
+)
+
+// BlobsPageData is a struct to hold info for the blobs page
+type BlobsPageData struct {
+	FilterMinSlot  uint64 `json:"filter_mins"`
+	FilterMaxSlot  uint64 `json:"filter_maxs"`
+	FilterProposer string `json:"filter_proposer"`
+
+	Blobs      []*BlobsPageDataBlob `json:"blobs"`
+	BlobCount  uint64               `json:"blob_count"`
+	FirstIndex uint64               `json:"first_index"`
+	LastIndex  uint64               `json:"last_index"`
+
+	IsDefaultPage    bool   `json:"default_page"`
+	TotalPages       uint64 `json:"total_pages"`
+	PageSize         uint64 `json:"page_size"`
+	CurrentPageIndex uint64 `json:"page_index"`
+	PrevPageIndex    uint64 `json:"prev_page_index"`
+	NextPageIndex    uint64 `json:"next_page_index"`
+	LastPageIndex    uint64 `json:"last_page_index"`
+
+	FirstPageLink string `json:"first_page_link"`
+	PrevPageLink  string `json:"prev_page_link"`
+	NextPageLink  string `json:"next_page_link"`
+	LastPageLink  string `json:"last_page_link"`
+}
+
+type BlobsPageDataBlob struct {
+	Slot          uint64    `json:"slot"`
+	BlockRoot     []byte    `json:"block_root"`
+	Time          time.Time `json:"time"`
+	Orphaned      bool      `json:"orphaned"`
+	BlobIndex     uint64    `json:"blob_index"`
+	Proposer      uint64    `json:"proposer"`
+	ProposerName  string    `json:"proposer_name"`
+	Commitment    []byte    `json:"commitment"`
+	VersionedHash []byte    `json:"versioned_hash"`
+	Size          uint64    `json:"size"`
+	Stored        bool      `json:"stored"`
+}
+
+// BlobPageData is a struct to hold info for the blob details page
+type BlobPageData struct {
+	Commitment    []byte                   `json:"commitment"`
+	VersionedHash []byte                   `json:"versioned_hash"`
+	Proof         []byte                   `json:"proof"`
+	Size          uint64                   `json:"size"`
+	Stored        bool                     `json:"stored"`
+	Inclusions    []*BlobPageDataInclusion `json:"inclusions"`
+
+	HaveData       bool   `json:"have_data"`
+	DataError      string `json:"data_error"`
+	HexPreview     string `json:"hex_preview"`
+	Utf8Preview    string `json:"utf8_preview"`
+	PreviewSize    uint64 `json:"preview_size"`
+	IsShortPreview bool   `json:"short_preview"`
+}
+
+type BlobPageDataInclusion struct {
+	Slot         uint64    `json:"slot"`
+	BlockRoot    []byte    `json:"block_root"`
+	Time         time.Time `json:"time"`
+	Orphaned     bool      `json:"orphaned"`
+	BlobIndex    uint64    `json:"blob_index"`
+	Proposer     uint64    `json:"proposer"`
+	ProposerName string    `json:"proposer_name"`
+}
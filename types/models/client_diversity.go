@@ -0,0 +1,46 @@
+package models
+
+// ClientDiversityPageData is a struct to hold info for the client diversity page
+type ClientDiversityPageData struct {
+	Days         uint64   `json:"days"`
+	DayOptions   []uint64 `json:"day_options"`
+	FirstEpoch   uint64   `json:"first_epoch"`
+	IndexedSlot  uint64   `json:"indexed_slot"`
+	BucketEpochs uint64   `json:"bucket_epochs"`
+	ChartWidth   uint64   `json:"chart_width"`
+	ChartHeight  uint64   `json:"chart_height"`
+
+	Layers []*ClientDiversityPageDataLayer `json:"layers"`
+}
+
+type ClientDiversityPageDataLayer struct {
+	Name            string                           `json:"name"`
+	TotalBlocks     uint64                           `json:"total_blocks"`
+	IdentifiedShare float64                          `json:"identified_share"`
+	Clients         []*ClientDiversityPageDataClient `json:"clients"`
+	Bars            []*ClientDiversityPageDataBar    `json:"bars"`
+}
+
+type ClientDiversityPageDataClient struct {
+	Name            string  `json:"name"`
+	Color           string  `json:"color"`
+	Unknown         bool    `json:"unknown"`
+	BlockCount      uint64  `json:"block_count"`
+	Share           float64 `json:"share"`
+	IdentifiedShare float64 `json:"identified_share"`
+	ShareLevel      string  `json:"share_level"`
+}
+
+type ClientDiversityPageDataBar struct {
+	X        float64                              `json:"x"`
+	Width    float64                              `json:"width"`
+	Title    string                               `json:"title"`
+	Segments []*ClientDiversityPageDataBarSegment `json:"segments"`
+}
+
+type ClientDiversityPageDataBarSegment struct {
+	Y      float64 `json:"y"`
+	Height float64 `json:"height"`
+	Color  string  `json:"color"`
+	Title  string  `json:"title"`
+}
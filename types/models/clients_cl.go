@@ -70,18 +70,31 @@ type ClientCLPageDataPeerDASWarnings struct {
 
 // ClientsCLPageDataClient represents a configured endpoint CL client
 type ClientsCLPageDataClient struct {
-	Index                int       `json:"index"`
-	Name                 string    `json:"name"`
-	Version              string    `json:"version"`
-	HeadSlot             uint64    `json:"head_slot"`
-	HeadRoot             []byte    `json:"head_root"`
-	Status               string    `json:"status"`
-	LastRefresh          time.Time `json:"refresh"`
-	LastError            string    `json:"error"`
-	PeerID               string    `json:"peer_id"`
-	PeerCount            uint32    `json:"peer_count"`
-	PeersInboundCounter  uint32    `json:"peers_inbound_counter"`
-	PeersOutboundCounter uint32    `json:"peers_outbound_counter"`
+	Index                int                             `json:"index"`
+	Name                 string                          `json:"name"`
+	Version              string                          `json:"version"`
+	HeadSlot             uint64                          `json:"head_slot"`
+	HeadRoot             []byte                          `json:"head_root"`
+	Status               string                          `json:"status"`
+	LastRefresh          time.Time                       `json:"refresh"`
+	LastError            string                          `json:"error"`
+	PeerID               string                          `json:"peer_id"`
+	PeerCount            uint32                          `json:"peer_count"`
+	PeersInboundCounter  uint32                          `json:"peers_inbound_counter"`
+	PeersOutboundCounter uint32                          `json:"peers_outbound_counter"`
+	StreamConnected      bool                            `json:"stream_connected"`
+	StreamStalled        bool                            `json:"stream_stalled"`
+	StreamResubscribes   uint64                          `json:"stream_resubscribes"`
+	StreamTopics         []*ClientsCLPageDataStreamTopic `json:"stream_topics"`
+	IsSyncing            bool                            `json:"is_syncing"`
+	SyncPercent          float64                         `json:"sync_percent"`
+}
+
+type ClientsCLPageDataStreamTopic struct {
+	Topic      string    `json:"topic"`
+	EventCount uint64    `json:"event_count"`
+	LastEvent  time.Time `json:"last_event"`
+	StallCount uint64    `json:"stall_count"`
 }
 
 // ClientCLPageDataNode represents a generic node on the CL network. Can be a client or a peer of a client
@@ -0,0 +1,37 @@
+package models
+
+// ClientsCLGeoPageData is a struct to hold info for the consensus peer geo page
+type ClientsCLGeoPageData struct {
+	DatabaseConfigured bool                           `json:"db_configured"`
+	DatabaseLoaded     bool                           `json:"db_loaded"`
+	ShowIps            bool                           `json:"show_ips"`
+	FilterClient       string                         `json:"filter_client"`
+	ClientOpts         []string                       `json:"client_opts"`
+	NodeCount          uint64                         `json:"node_count"`
+	LocatedCount       uint64                         `json:"located_count"`
+	CountryCount       uint64                         `json:"country_count"`
+	AsnCount           uint64                         `json:"asn_count"`
+	Countries          []*ClientsCLGeoPageDataBucket  `json:"countries"`
+	Asns               []*ClientsCLGeoPageDataBucket  `json:"asns"`
+	Nodes              []*ClientsCLGeoPageDataGeoNode `json:"nodes"`
+}
+
+// ClientsCLGeoPageDataBucket represents a country or autonomous system in the distribution charts
+type ClientsCLGeoPageDataBucket struct {
+	Key     string  `json:"key"`
+	Name    string  `json:"name"`
+	Count   uint64  `json:"count"`
+	Percent float64 `json:"percent"`
+}
+
+// ClientsCLGeoPageDataGeoNode represents a node known from the connected clients & their peers
+type ClientsCLGeoPageDataGeoNode struct {
+	PeerID   string   `json:"peer_id"`
+	Alias    string   `json:"alias"`
+	Type     string   `json:"type"` // "internal" for connected clients, "external" for their peers
+	IpPrefix string   `json:"ip_prefix,omitempty"`
+	Country  string   `json:"country"`
+	Asn      uint64   `json:"asn"`
+	AsName   string   `json:"as_name"`
+	SeenBy   []string `json:"seen_by"`
+}
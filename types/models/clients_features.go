@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+)
+
+// ClientsFeaturesPageData is a struct to hold info for the consensus client feature matrix page
+type ClientsFeaturesPageData struct {
+	Probes        []*ClientsFeaturesPageDataProbe  `json:"probes"`
+	Clients       []*ClientsFeaturesPageDataClient `json:"clients"`
+	ClientCount   uint64                           `json:"client_count"`
+	DegradedCount uint64                           `json:"degraded_count"`
+}
+
+type ClientsFeaturesPageDataProbe struct {
+	Name             string `json:"name"`
+	Title            string `json:"title"`
+	Method           string `json:"method"`
+	Path             string `json:"path"`
+	Degrades         string `json:"degrades"`
+	UnsupportedCount uint64 `json:"unsupported_count"`
+}
+
+type ClientsFeaturesPageDataClient struct {
+	Index            int                               `json:"index"`
+	Name             string                            `json:"name"`
+	Version          string                            `json:"version"`
+	Status           string                            `json:"status"`
+	Checked          bool                              `json:"checked"`
+	CheckedAt        time.Time                         `json:"checked_at"`
+	Features         []*ClientsFeaturesPageDataFeature `json:"features"`
+	PresetBase       string                            `json:"preset_base"`
+	ConfigName       string                            `json:"config_name"`
+	SpecCount        uint64                            `json:"spec_count"`
+	SpecMissing      []string                          `json:"spec_missing"`
+	SpecMissingCount uint64                            `json:"spec_missing_count"`
+	SpecCheckErr     string                            `json:"spec_check_err"`
+}
+
+type ClientsFeaturesPageDataFeature struct {
+	Support    string `json:"support"` // supported, unsupported or unknown
+	StatusCode int    `json:"status_code"`
+	Error      string `json:"error"`
+}
@@ -0,0 +1,37 @@
+package models
+
+// ClientsLatencyPageData is a struct to hold info for the block import latency page
+type ClientsLatencyPageData struct {
+	Clients        []*ClientsLatencyPageDataClient `json:"clients"`
+	ClientCount    uint64                          `json:"client_count"`
+	SlotSeconds    float64                         `json:"slot_seconds"`
+	DeadlineOffset float64                         `json:"deadline_offset"` // attestation deadline in percent of the slot
+	BucketLabels   []string                        `json:"bucket_labels"`
+}
+
+type ClientsLatencyPageDataClient struct {
+	Index          int                             `json:"index"`
+	Name           string                          `json:"name"`
+	Version        string                          `json:"version"`
+	Status         string                          `json:"status"`
+	SampleCount    uint64                          `json:"sample_count"`
+	FirstSlot      uint64                          `json:"first_slot"`
+	LastSlot       uint64                          `json:"last_slot"`
+	AvgMs          float64                         `json:"avg_ms"`
+	P50Ms          float64                         `json:"p50_ms"`
+	P90Ms          float64                         `json:"p90_ms"`
+	P99Ms          float64                         `json:"p99_ms"`
+	MaxMs          float64                         `json:"max_ms"`
+	P50Percent     float64                         `json:"p50_percent"` // p50 in percent of the slot time
+	P90Percent     float64                         `json:"p90_percent"` // p90 - p50 in percent of the slot time
+	LateCount      uint64                          `json:"late_count"`  // heads reported after the attestation deadline
+	LatePercent    float64                         `json:"late_percent"`
+	Histogram      []*ClientsLatencyPageDataBucket `json:"histogram"`
+	HistogramScale uint64                          `json:"histogram_scale"`
+}
+
+type ClientsLatencyPageDataBucket struct {
+	Label   string  `json:"label"`
+	Count   uint64  `json:"count"`
+	Percent float64 `json:"percent"` // bar height relative to the largest bucket
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+)
+
+// ClientsSyncingPageData is a struct to hold info for the client synchronization page
+type ClientsSyncingPageData struct {
+	Clients        []*ClientsSyncingPageDataClient `json:"clients"`
+	ClientCount    uint64                          `json:"client_count"`
+	SyncingCount   uint64                          `json:"syncing_count"`
+	ReadyCount     uint64                          `json:"ready_count"`
+	CurrentSlot    uint64                          `json:"current_slot"`
+	IndexerWaiting bool                            `json:"indexer_waiting"`
+}
+
+type ClientsSyncingPageDataClient struct {
+	Index          int       `json:"index"`
+	Name           string    `json:"name"`
+	Version        string    `json:"version"`
+	Status         string    `json:"status"`
+	LastError      string    `json:"error"`
+	IsSyncing      bool      `json:"is_syncing"`
+	HeadSlot       uint64    `json:"head_slot"`
+	SyncDistance   uint64    `json:"sync_distance"`
+	SyncPercent    float64   `json:"sync_percent"`
+	SlotsPerSecond float64   `json:"slots_per_second"`
+	HasEta         bool      `json:"has_eta"`
+	Eta            time.Time `json:"eta"`
+	SyncingSince   time.Time `json:"syncing_since"`
+	LastUpdate     time.Time `json:"last_update"`
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+)
+
+// ContractLogsPageData is a struct to hold info for the watched contract logs page
+type ContractLogsPageData struct {
+	FilterContract     string `json:"filter_contract"`
+	FilterTopic        string `json:"filter_topic"`
+	FilterSender       string `json:"filter_sender"`
+	FilterTxHash       string `json:"filter_tx"`
+	FilterMinBlock     uint64 `json:"filter_minb"`
+	FilterMaxBlock     uint64 `json:"filter_maxb"`
+	FilterWithOrphaned uint8  `json:"filter_orphaned"`
+
+	Contracts []*ContractLogsPageDataContract `json:"contracts"`
+	Logs      []*ContractLogsPageDataLog      `json:"logs"`
+	LogCount  uint64                          `json:"log_count"`
+
+	IsDefaultPage    bool   `json:"default_page"`
+	TotalPages       uint64 `json:"total_pages"`
+	PageSize         uint64 `json:"page_size"`
+	CurrentPageIndex uint64 `json:"page_index"`
+	PrevPageIndex    uint64 `json:"prev_page_index"`
+	NextPageIndex    uint64 `json:"next_page_index"`
+	LastPageIndex    uint64 `json:"last_page_index"`
+
+	FirstPageLink string `json:"first_page_link"`
+	PrevPageLink  string `json:"prev_page_link"`
+	NextPageLink  string `json:"next_page_link"`
+	LastPageLink  string `json:"last_page_link"`
+}
+
+type ContractLogsPageDataContract struct {
+	Name    string   `json:"name"`
+	Address []byte   `json:"address"`
+	Topics  []string `json:"topics"`
+}
+
+type ContractLogsPageDataLog struct {
+	ContractName    string    `json:"contract_name"`
+	ContractAddress []byte    `json:"contract_address"`
+	BlockNumber     uint64    `json:"block_number"`
+	BlockHash       []byte    `json:"block_hash"`
+	LogIndex        uint64    `json:"log_index"`
+	Time            time.Time `json:"time"`
+	EventName       string    `json:"event_name"`
+	Topics          [][]byte  `json:"topics"`
+	Data            []byte    `json:"data"`
+	TxHash          []byte    `json:"tx_hash"`
+	TxSender        []byte    `json:"tx_sender"`
+	Orphaned        bool      `json:"orphaned"`
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+)
+
+// DebugAnomaliesPageData is a struct to hold info for the slot anomalies debug page
+type DebugAnomaliesPageData struct {
+	Anomalies    []*DebugAnomaliesPageDataAnomaly `json:"anomalies"`
+	AnomalyCount uint64                           `json:"anomaly_count"`
+
+	CommitteeAnomalies    []*DebugAnomaliesPageDataCommitteeAnomaly `json:"committee_anomalies"`
+	CommitteeAnomalyCount uint64                                    `json:"committee_anomaly_count"`
+}
+
+type DebugAnomaliesPageDataAnomaly struct {
+	Type          string    `json:"type"`
+	Slot          uint64    `json:"slot"`
+	BlockRoot     []byte    `json:"block_root"`
+	SlotTime      time.Time `json:"slot_time"`
+	ExecutionTime time.Time `json:"exec_time"`
+	DetectedAt    time.Time `json:"detected_at"`
+	Description   string    `json:"description"`
+}
+
+type DebugAnomaliesPageDataCommitteeAnomaly struct {
+	Type          string    `json:"type"`
+	Epoch         uint64    `json:"epoch"`
+	Slot          uint64    `json:"slot"`
+	Committee     int64     `json:"committee"`
+	DependentRoot []byte    `json:"dependent_root"`
+	BlockRoot     []byte    `json:"block_root"`
+	Expected      uint64    `json:"expected"`
+	Actual        uint64    `json:"actual"`
+	DetectedAt    time.Time `json:"detected_at"`
+	Description   string    `json:"description"`
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+)
+
+// DebugBundleInfo is a struct to hold the general info of a diagnostic bundle
+type DebugBundleInfo struct {
+	Version     string    `json:"version"`
+	NetworkName string    `json:"network"`
+	CreatedAt   time.Time `json:"created_at"`
+	CurrentSlot uint64    `json:"current_slot"`
+	LogLines    int       `json:"log_lines"`
+}
+
+// DebugBundleClients is a struct to hold the client states of a diagnostic bundle
+type DebugBundleClients struct {
+	Consensus []*DebugBundleClient `json:"consensus"`
+	Execution []*DebugBundleClient `json:"execution"`
+}
+
+type DebugBundleClient struct {
+	Index     int       `json:"index"`
+	Name      string    `json:"name"`
+	Version   string    `json:"version"`
+	Status    string    `json:"status"`
+	HeadSlot  uint64    `json:"head_slot"`
+	HeadRoot  []byte    `json:"head_root"`
+	LastEvent time.Time `json:"last_event"`
+	LastError string    `json:"last_error,omitempty"`
+
+	FinalizedEpoch *uint64 `json:"finalized_epoch,omitempty"`
+	FinalizedRoot  []byte  `json:"finalized_root,omitempty"`
+	JustifiedEpoch *uint64 `json:"justified_epoch,omitempty"`
+	JustifiedRoot  []byte  `json:"justified_root,omitempty"`
+}
+
+// DebugBundleFinality is a struct to hold the finality & indexer state of a diagnostic bundle
+type DebugBundleFinality struct {
+	CurrentEpoch      uint64                  `json:"current_epoch"`
+	FinalizedEpoch    uint64                  `json:"finalized_epoch"`
+	FinalizedRoot     []byte                  `json:"finalized_root"`
+	JustifiedEpoch    uint64                  `json:"justified_epoch"`
+	JustifiedRoot     []byte                  `json:"justified_root"`
+	CacheFinalized    uint64                  `json:"cache_finalized_epoch"`
+	CachePruned       uint64                  `json:"cache_pruned_epoch"`
+	SynchronizerRun   bool                    `json:"synchronizer_running"`
+	SynchronizerEpoch uint64                  `json:"synchronizer_epoch"`
+	CanonicalHeadSlot uint64                  `json:"canonical_head_slot"`
+	CanonicalHeadRoot []byte                  `json:"canonical_head_root"`
+	ChainHeads        []*DebugBundleChainHead `json:"chain_heads"`
+}
+
+type DebugBundleChainHead struct {
+	HeadSlot      uint64    `json:"head_slot"`
+	HeadRoot      []byte    `json:"head_root"`
+	HeadVotes     uint64    `json:"head_votes"`
+	Participation []float64 `json:"participation"`
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+)
+
+// DebugConsistencyPageData is a struct to hold info for the chain consistency debug page
+type DebugConsistencyPageData struct {
+	Running        bool                              `json:"running"`
+	CheckedEpoch   uint64                            `json:"checked_epoch"`
+	FinalizedEpoch uint64                            `json:"finalized_epoch"`
+	LastCheck      time.Time                         `json:"last_check"`
+	IssueCount     uint64                            `json:"issue_count"`
+	RepairedCount  uint64                            `json:"repaired_count"`
+	Repairs        []*DebugConsistencyPageDataRepair `json:"repairs"`
+	RepairCount    uint64                            `json:"repair_count"`
+}
+
+type DebugConsistencyPageDataRepair struct {
+	Epoch      uint64    `json:"epoch"`
+	Issues     []string  `json:"issues"`
+	DetectedAt time.Time `json:"detected_at"`
+	RepairedAt time.Time `json:"repaired_at"`
+	Success    bool      `json:"success"`
+	Error      string    `json:"error"`
+}
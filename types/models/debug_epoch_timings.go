@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+)
+
+// DebugEpochTimingsPageData is a struct to hold info for the epoch processing timings debug page
+type DebugEpochTimingsPageData struct {
+	Process   string                                   `json:"process"`
+	Processes []*DebugEpochTimingsPageDataProcess      `json:"processes"`
+	Runs      []*DebugEpochTimingsPageDataRun          `json:"runs"`
+	RunCount  uint64                                   `json:"run_count"`
+	Summary   []*DebugEpochTimingsPageDataStageSummary `json:"summary"`
+}
+
+type DebugEpochTimingsPageDataProcess struct {
+	Name     string `json:"name"`
+	RunCount uint64 `json:"run_count"`
+}
+
+type DebugEpochTimingsPageDataRun struct {
+	Process       string                            `json:"process"`
+	Epoch         uint64                            `json:"epoch"`
+	DependentRoot []byte                            `json:"dependent_root"`
+	StartedAt     time.Time                         `json:"started_at"`
+	TotalMs       uint64                            `json:"total_ms"`
+	Stages        []*DebugEpochTimingsPageDataStage `json:"stages"`
+}
+
+type DebugEpochTimingsPageDataStage struct {
+	Name       string  `json:"name"`
+	DurationMs uint64  `json:"duration_ms"`
+	Percent    float64 `json:"percent"`
+	Color      string  `json:"color"`
+}
+
+type DebugEpochTimingsPageDataStageSummary struct {
+	Process string `json:"process"`
+	Name    string `json:"name"`
+	Color   string `json:"color"`
+	Runs    uint64 `json:"runs"`
+	AvgMs   uint64 `json:"avg_ms"`
+	MaxMs   uint64 `json:"max_ms"`
+	LastMs  uint64 `json:"last_ms"`
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+)
+
+// DebugMaintenancePageData is a struct to hold info for the database maintenance debug page
+type DebugMaintenancePageData struct {
+	Enabled       bool      `json:"enabled"`
+	Engine        string    `json:"engine"`
+	Running       bool      `json:"running"`
+	Windows       []string  `json:"windows"`
+	Interval      string    `json:"interval"`
+	LastRun       time.Time `json:"last_run"`
+	LastEnd       time.Time `json:"last_end"`
+	HasResult     bool      `json:"has_result"`
+	LastSuccess   bool      `json:"last_success"`
+	LastCompleted bool      `json:"last_completed"`
+	LastError     string    `json:"last_error"`
+	TableCount    uint64    `json:"table_count"`
+	FreedPages    uint64    `json:"freed_pages"`
+	FullVacuum    bool      `json:"full_vacuum"`
+	NextRun       time.Time `json:"next_run"`
+
+	ForkCleanup *DebugMaintenancePageDataForkCleanup `json:"fork_cleanup"`
+}
+
+type DebugMaintenancePageDataForkCleanup struct {
+	RetentionEpochs   uint64    `json:"retention_epochs"`
+	LastRun           time.Time `json:"last_run"`
+	CutoffEpoch       uint64    `json:"cutoff_epoch"`
+	LastError         string    `json:"last_error"`
+	LastForks         uint64    `json:"last_forks"`
+	LastBlocks        uint64    `json:"last_blocks"`
+	LastOperations    uint64    `json:"last_operations"`
+	LastTransactions  uint64    `json:"last_transactions"`
+	LastBlobs         uint64    `json:"last_blobs"`
+	TotalForks        uint64    `json:"total_forks"`
+	TotalBlocks       uint64    `json:"total_blocks"`
+	TotalOperations   uint64    `json:"total_operations"`
+	TotalTransactions uint64    `json:"total_transactions"`
+	TotalBlobs        uint64    `json:"total_blobs"`
+}
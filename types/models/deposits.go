@@ -28,6 +28,12 @@ type DepositsPageDataInitiatedDeposit struct {
 	ShowUpcheck           bool      `json:"show_upcheck"`
 	UpcheckActivity       uint8     `json:"upcheck_act"`
 	UpcheckMaximum        uint8     `json:"upcheck_max"`
+	IsInitial             bool      `json:"is_initial"`
+	IsTopUp               bool      `json:"is_topup"`
+	PubkeyDepositCount    uint64    `json:"pubkey_deposits"`
+	PubkeyDepositAmount   uint64    `json:"pubkey_amount"`
+	FrontrunInitial       bool      `json:"frontrun_initial"`
+	FrontrunVictim        bool      `json:"frontrun_victim"`
 }
 
 type DepositsPageDataIncludedDeposit struct {
@@ -66,4 +66,5 @@ type ElConsolidationsPageDataConsolidationTxDetails struct {
 	TxOrigin    string `json:"tx_origin"`
 	TxTarget    string `json:"tx_target"`
 	TxHash      string `json:"tx_hash"`
+	TxFee       string `json:"tx_fee"`
 }
@@ -61,4 +61,5 @@ type ElWithdrawalsPageDataWithdrawalTxDetails struct {
 	TxOrigin    string `json:"tx_origin"`
 	TxTarget    string `json:"tx_target"`
 	TxHash      string `json:"tx_hash"`
+	TxFee       string `json:"tx_fee"`
 }
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+)
+
+// ElectraStatsPageData is a struct to hold info for the electra stats page
+type ElectraStatsPageData struct {
+	Available             bool                          `json:"available"`
+	Epoch                 uint64                        `json:"epoch"`
+	Ts                    time.Time                     `json:"ts"`
+	IsHistoric            bool                          `json:"is_historic"`
+	HistoricEpoch         uint64                        `json:"historic_epoch,omitempty"`
+	FinalizedEpoch        uint64                        `json:"finalized_epoch"`
+	MaxEffectiveBalance   uint64                        `json:"max_eb"`
+	ActiveValidators      uint64                        `json:"active_validators"`
+	TotalEffectiveBalance uint64                        `json:"total_eb"`
+	BlsValidators         uint64                        `json:"bls_validators"`
+	BlsPercent            float64                       `json:"bls_percent"`
+	ExecValidators        uint64                        `json:"exec_validators"`
+	ExecPercent           float64                       `json:"exec_percent"`
+	CompoundingValidators uint64                        `json:"compounding_validators"`
+	CompoundingPercent    float64                       `json:"compounding_percent"`
+	CompoundingBalance    uint64                        `json:"compounding_balance"`
+	CompoundingEbPercent  float64                       `json:"compounding_eb_percent"`
+	CompoundingAvgBalance uint64                        `json:"compounding_avg_balance"`
+	CompoundingMaxEb      uint64                        `json:"compounding_max_eb"`
+	UtilizationPercent    float64                       `json:"utilization_percent"`
+	Consolidations        uint64                        `json:"consolidations"`
+	CredentialSwitches    uint64                        `json:"credential_switches"`
+	Buckets               []*ElectraStatsPageDataBucket `json:"buckets"`
+	History               []*ElectraStatsPageDataEpoch  `json:"history"`
+}
+
+type ElectraStatsPageDataBucket struct {
+	MinBalance     uint64  `json:"min_balance"`
+	MaxBalance     uint64  `json:"max_balance"`
+	ValidatorCount uint64  `json:"validator_count"`
+	Balance        uint64  `json:"balance"`
+	Percent        float64 `json:"percent"`
+}
+
+type ElectraStatsPageDataEpoch struct {
+	Epoch                  uint64    `json:"epoch"`
+	Ts                     time.Time `json:"ts"`
+	CompoundingValidators  uint64    `json:"compounding_validators"`
+	CompoundingPercent     float64   `json:"compounding_percent"`
+	CompoundingBalance     uint64    `json:"compounding_balance"`
+	CompoundingEbPercent   float64   `json:"compounding_eb_percent"`
+	CompoundingBalanceDiff int64     `json:"compounding_balance_diff"`
+	CompoundingMaxEb       uint64    `json:"compounding_max_eb"`
+	Consolidations         uint64    `json:"consolidations"`
+	CredentialSwitches     uint64    `json:"credential_switches"`
+}
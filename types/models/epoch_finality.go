@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+)
+
+// EpochFinalityPageData is a struct to hold info for the epoch finality page
+type EpochFinalityPageData struct {
+	Epoch                  uint64                         `json:"epoch"`
+	PreviousEpoch          uint64                         `json:"prev_epoch"`
+	NextEpoch              uint64                         `json:"next_epoch"`
+	Ts                     time.Time                      `json:"ts"`
+	Finalized              bool                           `json:"finalized"`
+	Available              bool                           `json:"available"`
+	TargetRoot             []byte                         `json:"target_root"`
+	EligibleAmount         uint64                         `json:"eligible_amount"`
+	RequiredAmount         uint64                         `json:"required_amount"`
+	TargetVoteAmount       uint64                         `json:"target_vote_amount"`
+	TargetVotePercent      float64                        `json:"target_vote_percent"`
+	MissingAmount          uint64                         `json:"missing_amount"`
+	MissingCount           uint64                         `json:"missing_count"`
+	MissingPercent         float64                        `json:"missing_percent"`
+	HasJustification       bool                           `json:"has_justification"`
+	JustificationEpoch     uint64                         `json:"justification_epoch"`
+	JustificationBits      string                         `json:"justification_bits"`
+	JustificationFinal     bool                           `json:"justification_final"`
+	Justified              bool                           `json:"justified"`
+	PreviousJustifiedEpoch uint64                         `json:"prev_justified_epoch"`
+	PreviousJustifiedRoot  []byte                         `json:"prev_justified_root"`
+	CurrentJustifiedEpoch  uint64                         `json:"cur_justified_epoch"`
+	CurrentJustifiedRoot   []byte                         `json:"cur_justified_root"`
+	FinalizedEpoch         uint64                         `json:"finalized_epoch"`
+	FinalizedRoot          []byte                         `json:"finalized_root"`
+	Targets                []*EpochFinalityPageDataTarget `json:"targets"`
+	Groups                 []*EpochFinalityPageDataGroup  `json:"groups"`
+}
+
+type EpochFinalityPageDataTarget struct {
+	Root           []byte  `json:"root"`
+	Epoch          uint64  `json:"epoch"`
+	Canonical      bool    `json:"canonical"`
+	VoteAmount     uint64  `json:"vote_amount"`
+	VotePercent    float64 `json:"vote_percent"`
+	ValidatorCount uint64  `json:"validator_count"`
+}
+
+type EpochFinalityPageDataGroup struct {
+	Name           string                             `json:"name"`
+	ValidatorCount uint64                             `json:"validator_count"`
+	EligibleAmount uint64                             `json:"eligible_amount"`
+	TargetPercent  float64                            `json:"target_percent"`
+	Votes          []*EpochFinalityPageDataGroupVotes `json:"votes"`
+	Missing        *EpochFinalityPageDataGroupVotes   `json:"missing"`
+}
+
+type EpochFinalityPageDataGroupVotes struct {
+	VoteAmount     uint64  `json:"vote_amount"`
+	ValidatorCount uint64  `json:"validator_count"`
+	VotePercent    float64 `json:"vote_percent"`
+}
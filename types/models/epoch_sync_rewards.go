@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+)
+
+// EpochSyncRewardsPageData is a struct to hold info for the epoch sync rewards page
+type EpochSyncRewardsPageData struct {
+	Epoch                uint64                            `json:"epoch"`
+	PreviousEpoch        uint64                            `json:"prev_epoch"`
+	NextEpoch            uint64                            `json:"next_epoch"`
+	Ts                   time.Time                         `json:"ts"`
+	Finalized            bool                              `json:"finalized"`
+	Available            bool                              `json:"available"`
+	ParticipantReward    uint64                            `json:"participant_reward"`
+	BlockCount           uint64                            `json:"block_count"`
+	EstimatedCount       uint64                            `json:"estimated_count"`
+	MemberCount          uint64                            `json:"member_count"`
+	ExpectedReward       uint64                            `json:"expected_reward"`
+	ActualReward         int64                             `json:"actual_reward"`
+	ParticipationPercent float64                           `json:"participation_percent"`
+	Groups               []*EpochSyncRewardsPageDataGroup  `json:"groups"`
+	Members              []*EpochSyncRewardsPageDataMember `json:"members"`
+}
+
+type EpochSyncRewardsPageDataGroup struct {
+	Name                 string  `json:"name"`
+	MemberCount          uint64  `json:"member_count"`
+	DutyCount            uint64  `json:"duty_count"`
+	MissedCount          uint64  `json:"missed_count"`
+	ParticipationPercent float64 `json:"participation_percent"`
+	ExpectedReward       uint64  `json:"expected_reward"`
+	ActualReward         int64   `json:"actual_reward"`
+	MissedReward         uint64  `json:"missed_reward"`
+	Failing              bool    `json:"failing"`
+}
+
+type EpochSyncRewardsPageDataMember struct {
+	Index                uint64  `json:"index"`
+	Name                 string  `json:"name"`
+	Group                string  `json:"group"`
+	Positions            uint64  `json:"positions"`
+	DutyCount            uint64  `json:"duty_count"`
+	MissedCount          uint64  `json:"missed_count"`
+	ParticipationPercent float64 `json:"participation_percent"`
+	ExpectedReward       uint64  `json:"expected_reward"`
+	ActualReward         int64   `json:"actual_reward"`
+}
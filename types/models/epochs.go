@@ -21,6 +21,14 @@ type EpochsPageData struct {
 	NextPageIndex    uint64 `json:"next_page_index"`
 	NextPageEpoch    uint64 `json:"next_page_epoch"`
 	LastPageEpoch    uint64 `json:"last_page_epoch"`
+
+	FilterFork  string                `json:"filter_fork"`
+	ForkOptions []*EpochsPageDataFork `json:"fork_options"`
+}
+
+type EpochsPageDataFork struct {
+	Name  string `json:"name"`
+	Epoch uint64 `json:"epoch"`
 }
 
 type EpochsPageDataEpoch struct {
@@ -44,4 +52,5 @@ type EpochsPageDataEpoch struct {
 	HeadVoteParticipation   float64   `json:"head_vote_participation"`
 	TotalVoteParticipation  float64   `json:"total_vote_participation"`
 	EthTransactionCount     uint64    `json:"eth_transaction_count"`
+	Forks                   []string  `json:"forks"`
 }
@@ -4,8 +4,32 @@ import "time"
 
 // ForksPageData is a struct to hold info for the forks page
 type ForksPageData struct {
-	Forks     []*ForksPageDataFork `json:"forks"`
-	ForkCount uint64               `json:"fork_count"`
+	Forks      []*ForksPageDataFork     `json:"forks"`
+	ForkCount  uint64                   `json:"fork_count"`
+	ShadowFork *ForksPageDataShadowFork `json:"shadow_fork,omitempty"`
+}
+
+// ForksPageDataShadowFork holds the divergence of a shadow fork from its origin network
+type ForksPageDataShadowFork struct {
+	UpstreamName            string    `json:"upstream_name"`
+	HasStatus               bool      `json:"has_status"`
+	LastCheck               time.Time `json:"last_check"`
+	CheckError              string    `json:"check_error,omitempty"`
+	Diverged                bool      `json:"diverged"`
+	LocalFirstBlock         uint64    `json:"local_first_block"`
+	LocalHeadBlock          uint64    `json:"local_head_block"`
+	UpstreamHeadBlock       uint64    `json:"upstream_head_block"`
+	HasLastCommon           bool      `json:"has_last_common"`
+	LastCommonBlock         uint64    `json:"last_common_block"`
+	LastCommonSlot          uint64    `json:"last_common_slot"`
+	LastCommonHash          []byte    `json:"last_common_hash"`
+	ForkBlock               uint64    `json:"fork_block"`
+	ForkSlot                uint64    `json:"fork_slot"`
+	ForkTime                time.Time `json:"fork_time"`
+	ForkHash                []byte    `json:"fork_hash"`
+	UpstreamForkHash        []byte    `json:"upstream_fork_hash"`
+	LocalBlocksSinceFork    uint64    `json:"local_blocks_since_fork"`
+	UpstreamBlocksSinceFork uint64    `json:"upstream_blocks_since_fork"`
 }
 
 type ForksPageDataFork struct {
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+)
+
+// GenesisPageData is a struct to hold info for the genesis countdown page
+type GenesisPageData struct {
+	GenesisKnown    bool      `json:"genesis_known"`
+	GenesisTime     time.Time `json:"genesis_time"`
+	IsConfigured    bool      `json:"is_configured"`
+	NetworkName     string    `json:"network_name"`
+	ActivationLimit uint64    `json:"activation_limit"`
+
+	Validators          []*GenesisPageDataValidator `json:"validators"`
+	ValidatorCount      uint64                      `json:"validator_count"`
+	ShownValidatorCount uint64                      `json:"shown_validator_count"`
+	ActiveCount         uint64                      `json:"active_count"`
+	TotalDeposited      uint64                      `json:"total_deposited"`
+}
+
+type GenesisPageDataValidator struct {
+	Index                 uint64 `json:"index"`
+	PublicKey             []byte `json:"pubkey"`
+	WithdrawalCredentials []byte `json:"wdcreds"`
+	Amount                uint64 `json:"amount"`
+	DepositCount          uint64 `json:"deposit_count"`
+	Active                bool   `json:"active"`
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+)
+
+// GraffitiPageData is a struct to hold info for the graffiti page
+type GraffitiPageData struct {
+	FilterSearch   string `json:"filter_search"`
+	FilterRegex    bool   `json:"filter_regex"`
+	FilterProposer string `json:"filter_proposer"`
+	FilterError    string `json:"filter_error"`
+	IndexedSlot    uint64 `json:"indexed_slot"`
+
+	Graffiti      []*GraffitiPageDataEntry `json:"graffiti"`
+	GraffitiCount uint64                   `json:"graffiti_count"`
+
+	// proposers of a single graffiti (?g=<graffiti>)
+	ShowProposers bool                        `json:"show_proposers"`
+	Selected      string                      `json:"selected"`
+	SelectedLink  string                      `json:"selected_link"`
+	Proposers     []*GraffitiPageDataProposer `json:"proposers"`
+
+	IsDefaultPage    bool   `json:"default_page"`
+	TotalPages       uint64 `json:"total_pages"`
+	PageSize         uint64 `json:"page_size"`
+	CurrentPageIndex uint64 `json:"page_index"`
+	PrevPageIndex    uint64 `json:"prev_page_index"`
+	NextPageIndex    uint64 `json:"next_page_index"`
+	LastPageIndex    uint64 `json:"last_page_index"`
+
+	FirstPageLink string `json:"first_page_link"`
+	PrevPageLink  string `json:"prev_page_link"`
+	NextPageLink  string `json:"next_page_link"`
+	LastPageLink  string `json:"last_page_link"`
+}
+
+type GraffitiPageDataEntry struct {
+	Graffiti      string    `json:"graffiti"`
+	Link          string    `json:"link"`
+	BlocksLink    string    `json:"blocks_link"`
+	BlockCount    uint64    `json:"block_count"`
+	ProposerCount uint64    `json:"proposer_count"`
+	FirstSlot     uint64    `json:"first_slot"`
+	LastSlot      uint64    `json:"last_slot"`
+	LastTime      time.Time `json:"last_time"`
+}
+
+type GraffitiPageDataProposer struct {
+	ValidatorIndex uint64    `json:"validator_index"`
+	ValidatorName  string    `json:"validator_name"`
+	BlocksLink     string    `json:"blocks_link"`
+	BlockCount     uint64    `json:"block_count"`
+	FirstSlot      uint64    `json:"first_slot"`
+	LastSlot       uint64    `json:"last_slot"`
+	LastTime       time.Time `json:"last_time"`
+}
@@ -0,0 +1,38 @@
+package models
+
+// HeadVotesPageData is a struct to hold info for the head vote accuracy page
+type HeadVotesPageData struct {
+	FirstEpoch   uint64                    `json:"first_epoch"`
+	LastEpoch    uint64                    `json:"last_epoch"`
+	EpochCount   uint64                    `json:"epoch_count"`
+	VoteCount    uint64                    `json:"vote_count"`
+	CorrectCount uint64                    `json:"correct_count"`
+	Accuracy     float64                   `json:"accuracy"`
+	Chart        string                    `json:"chart"` // svg polyline points of the overall accuracy
+	Groups       []*HeadVotesPageDataGroup `json:"groups"`
+	Epochs       []*HeadVotesPageDataEpoch `json:"epochs"`
+}
+
+type HeadVotesPageDataGroup struct {
+	Name         string  `json:"name"`
+	Color        string  `json:"color"`
+	VoteCount    uint64  `json:"vote_count"`
+	CorrectCount uint64  `json:"correct_count"`
+	Accuracy     float64 `json:"accuracy"`
+	Chart        string  `json:"chart"` // svg polyline points of the group accuracy
+}
+
+type HeadVotesPageDataEpoch struct {
+	Epoch        uint64                         `json:"epoch"`
+	Available    bool                           `json:"available"`
+	VoteCount    uint64                         `json:"vote_count"`
+	CorrectCount uint64                         `json:"correct_count"`
+	Accuracy     float64                        `json:"accuracy"`
+	Groups       []*HeadVotesPageDataEpochGroup `json:"groups"` // same order as HeadVotesPageData.Groups
+}
+
+type HeadVotesPageDataEpochGroup struct {
+	VoteCount    uint64  `json:"vote_count"`
+	CorrectCount uint64  `json:"correct_count"`
+	Accuracy     float64 `json:"accuracy"`
+}
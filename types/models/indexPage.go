@@ -36,6 +36,44 @@ type IndexPageData struct {
 	RecentSlots      []*IndexPageDataSlots  `json:"slots"`
 	RecentSlotCount  uint64                 `json:"slot_count"`
 	ForkTreeWidth    int                    `json:"forktree_width"`
+
+	HeadForks     []*IndexPageDataHeadFork `json:"head_forks"`
+	HeadForkCount uint64                   `json:"head_fork_count"`
+
+	ParticipationHistory *IndexPageDataParticipationHistory `json:"participation_history"`
+}
+
+type IndexPageDataParticipationHistory struct {
+	FirstEpoch   uint64                            `json:"first_epoch"`
+	LastEpoch    uint64                            `json:"last_epoch"`
+	BucketEpochs uint64                            `json:"bucket_epochs"`
+	Days         float64                           `json:"days"`
+	Sparklines   []*IndexPageDataParticipationLine `json:"sparklines"`
+}
+
+type IndexPageDataParticipationLine struct {
+	Name    string  `json:"name"`
+	Points  string  `json:"points"`
+	Current float64 `json:"current"`
+	Min     float64 `json:"min"`
+	Avg     float64 `json:"avg"`
+}
+
+type IndexPageDataHeadFork struct {
+	HeadSlot              uint64                         `json:"slot"`
+	HeadRoot              []byte                         `json:"root"`
+	Canonical             bool                           `json:"canonical"`
+	PreviousParticipation float64                        `json:"prev_part"`
+	CurrentParticipation  float64                        `json:"cur_part"`
+	ClientCount           uint64                         `json:"client_count"`
+	Clients               []*IndexPageDataHeadForkClient `json:"clients"`
+}
+
+type IndexPageDataHeadForkClient struct {
+	Index    int    `json:"index"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	HeadSlot uint64 `json:"head_slot"`
 }
 
 type IndexPageDataForks struct {
@@ -66,6 +104,9 @@ type IndexPageDataBlocks struct {
 	ProposerName string    `json:"proposer_name"`
 	Status       uint64    `json:"status"`
 	BlockRoot    []byte    `json:"block_root"`
+	MevRelayed   bool      `json:"mev"`
+	MevRelays    string    `json:"mev_relays"`
+	MevValue     uint64    `json:"mev_value"`
 }
 
 type IndexPageDataSlots struct {
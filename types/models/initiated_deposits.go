@@ -6,19 +6,27 @@ import (
 
 // DepositsPageData is a struct to hold info for the deposits page
 type InitiatedDepositsPageData struct {
-	FilterAddress       string `json:"filter_address"`
-	FilterPubKey        string `json:"filter_publickey"`
-	FilterValidatorName string `json:"filter_vname"`
-	FilterMinAmount     uint64 `json:"filter_mina"`
-	FilterMaxAmount     uint64 `json:"filter_maxa"`
-	FilterWithOrphaned  uint8  `json:"filter_orphaned"`
-	FilterWithValid     uint8  `json:"filter_valid"`
+	FilterAddress       string   `json:"filter_address"`
+	FilterPubKey        string   `json:"filter_publickey"`
+	FilterValidatorName string   `json:"filter_vname"`
+	FilterMinAmount     uint64   `json:"filter_mina"`
+	FilterMaxAmount     uint64   `json:"filter_maxa"`
+	FilterWithOrphaned  uint8    `json:"filter_orphaned"`
+	FilterWithValid     uint8    `json:"filter_valid"`
+	FilterDepositType   uint8    `json:"filter_type"`
+	FilterContract      string   `json:"filter_contract"`
+	DepositContracts    []string `json:"deposit_contracts"`
 
 	Deposits     []*InitiatedDepositsPageDataDeposit `json:"deposits"`
 	DepositCount uint64                              `json:"deposit_count"`
 	FirstIndex   uint64                              `json:"first_index"`
 	LastIndex    uint64                              `json:"last_index"`
 
+	DepositRootMismatch      bool   `json:"deposit_root_mismatch"`
+	DepositRootBlock         uint64 `json:"deposit_root_block"`
+	DepositRootContractCount uint64 `json:"deposit_root_contract_count"`
+	DepositRootLocalCount    uint64 `json:"deposit_root_local_count"`
+
 	IsDefaultPage    bool   `json:"default_page"`
 	TotalPages       uint64 `json:"total_pages"`
 	PageSize         uint64 `json:"page_size"`
@@ -48,4 +56,10 @@ type InitiatedDepositsPageDataDeposit struct {
 	ShowUpcheck           bool      `json:"show_upcheck"`
 	UpcheckActivity       uint8     `json:"upcheck_act"`
 	UpcheckMaximum        uint8     `json:"upcheck_max"`
+	IsInitial             bool      `json:"is_initial"`
+	IsTopUp               bool      `json:"is_topup"`
+	PubkeyDepositCount    uint64    `json:"pubkey_deposits"`
+	PubkeyDepositAmount   uint64    `json:"pubkey_amount"`
+	FrontrunInitial       bool      `json:"frontrun_initial"`
+	FrontrunVictim        bool      `json:"frontrun_victim"`
 }
@@ -0,0 +1,10 @@
+package models
+
+// PageBuildPageData is a struct to hold info for the placeholder shown while a slow page is built in background
+type PageBuildPageData struct {
+	BuildId  string  `json:"build_id"`
+	Title    string  `json:"title"`
+	Elapsed  float64 `json:"elapsed"`
+	Progress float64 `json:"progress"`
+	Status   string  `json:"status"`
+}
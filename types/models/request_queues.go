@@ -0,0 +1,46 @@
+package models
+
+import (
+	"math/big"
+	"time"
+)
+
+// RequestQueuesPageData is a struct to hold info for the el request queues page
+type RequestQueuesPageData struct {
+	Available      bool                          `json:"available"`
+	BlockNumber    uint64                        `json:"block_number"`
+	Slot           uint64                        `json:"slot"`
+	Time           time.Time                     `json:"time"`
+	HistoryBlocks  uint64                        `json:"history_blocks"`
+	Withdrawals    *RequestQueuesPageDataQueue   `json:"withdrawals"`
+	Consolidations *RequestQueuesPageDataQueue   `json:"consolidations"`
+	History        []*RequestQueuesPageDataBlock `json:"history"`
+}
+
+type RequestQueuesPageDataQueue struct {
+	Name          string   `json:"name"`
+	Contract      []byte   `json:"contract"`
+	Excess        uint64   `json:"excess"`
+	RequestCount  uint64   `json:"request_count"`
+	QueueLength   uint64   `json:"queue_length"`
+	DequeueRate   uint64   `json:"dequeue_rate"`
+	RequestFee    *big.Int `json:"request_fee"`
+	IsSaturated   bool     `json:"saturated"`
+	IsInactivated bool     `json:"inactivated"`
+	MaxFee        *big.Int `json:"max_fee"`
+	MaxQueue      uint64   `json:"max_queue"`
+	FeeChart      string   `json:"fee_chart"`   // svg polyline points of the request fee (log scale)
+	QueueChart    string   `json:"queue_chart"` // svg polyline points of the queue length
+}
+
+type RequestQueuesPageDataBlock struct {
+	BlockNumber            uint64    `json:"block_number"`
+	Slot                   uint64    `json:"slot"`
+	Time                   time.Time `json:"time"`
+	WithdrawalQueue        uint64    `json:"withdrawal_queue"`
+	WithdrawalFee          *big.Int  `json:"withdrawal_fee"`
+	WithdrawalSaturated    bool      `json:"withdrawal_saturated"`
+	ConsolidationQueue     uint64    `json:"consolidation_queue"`
+	ConsolidationFee       *big.Int  `json:"consolidation_fee"`
+	ConsolidationSaturated bool      `json:"consolidation_saturated"`
+}
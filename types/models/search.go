@@ -46,3 +46,12 @@ type SearchAheadValidatorNameResult struct {
 	Name  string `json:"name,omitempty"`
 	Count string `json:"count,omitempty"`
 }
+
+// SearchSuggestResult is a struct to hold a typed search-as-you-type suggestion
+type SearchSuggestResult struct {
+	Type  string `json:"type"`
+	Label string `json:"label"`
+	Value string `json:"value"`
+	Link  string `json:"link"`
+	Count uint64 `json:"count,omitempty"`
+}
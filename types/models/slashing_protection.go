@@ -0,0 +1,46 @@
+package models
+
+// SlashingProtectionPageData is a struct to hold info for the slashing protection cross-check page
+type SlashingProtectionPageData struct {
+	MaxValidators uint64 `json:"max_validators"`
+
+	HasResult        bool   `json:"has_result"`
+	Error            string `json:"error"`
+	FormatVersion    string `json:"format_version"`
+	GenesisRoot      []byte `json:"genesis_root"`
+	ValidatorCount   uint64 `json:"validator_count"`
+	SkippedCount     uint64 `json:"skipped_count"`
+	BlockCount       uint64 `json:"block_count"`
+	AttestationCount uint64 `json:"attestation_count"`
+	ConflictCount    uint64 `json:"conflict_count"`
+	GapCount         uint64 `json:"gap_count"`
+	WarningCount     uint64 `json:"warning_count"`
+
+	Validators []*SlashingProtectionPageDataValidator `json:"validators"`
+}
+
+type SlashingProtectionPageDataValidator struct {
+	PublicKey        []byte `json:"pubkey"`
+	Known            bool   `json:"known"`
+	Index            uint64 `json:"index"`
+	Name             string `json:"name"`
+	BlockCount       uint64 `json:"block_count"`
+	AttestationCount uint64 `json:"attestation_count"`
+	HasBlocks        bool   `json:"has_blocks"`
+	MaxBlockSlot     uint64 `json:"max_block_slot"`
+	HasAttestations  bool   `json:"has_attestations"`
+	MaxTargetEpoch   uint64 `json:"max_target_epoch"`
+	ChainBlockCount  uint64 `json:"chain_block_count"`
+	HasChainBlocks   bool   `json:"has_chain_blocks"`
+	LatestChainBlock uint64 `json:"latest_chain_block"`
+	HasChainVote     bool   `json:"has_chain_vote"`
+	LatestVoteEpoch  uint64 `json:"latest_vote_epoch"`
+
+	Issues []*SlashingProtectionPageDataIssue `json:"issues"`
+}
+
+type SlashingProtectionPageDataIssue struct {
+	Severity string `json:"severity"` // conflict, gap or warning
+	Message  string `json:"message"`
+	Link     string `json:"link"`
+}
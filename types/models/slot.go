@@ -12,6 +12,8 @@ type SlotPageData struct {
 	Epoch                  uint64                `json:"epoch"`
 	EpochFinalized         bool                  `json:"epoch_finalized"`
 	EpochParticipationRate float64               `json:"epoch_participation_rate"`
+	ForkName               string                `json:"fork_name"`
+	ForkVersion            []byte                `json:"fork_version"`
 	Ts                     time.Time             `json:"time"`
 	NextSlot               uint64                `json:"next_slot"`
 	PreviousSlot           uint64                `json:"prev_slot"`
@@ -21,6 +23,34 @@ type SlotPageData struct {
 	ProposerName           string                `json:"proposer_name"`
 	Block                  *SlotPageBlockData    `json:"block"`
 	Badges                 []*SlotPageBlockBadge `json:"badges"`
+	Missed                 *SlotPageMissedData   `json:"missed"`
+	ExternalLinks          []*types.ExternalLink `json:"external_links"`
+}
+
+// SlotPageMissedData holds the postmortem details for a missed slot
+type SlotPageMissedData struct {
+	ClientGuess       string                         `json:"client_guess"`
+	ClientGuessSource string                         `json:"client_guess_source"`
+	OrphanedBlocks    []*SlotPageMissedOrphanedBlock `json:"orphaned_blocks"`
+	RecentProposals   []*SlotPageMissedProposal      `json:"recent_proposals"`
+	ProposedCount     uint64                         `json:"proposed_count"`
+	MissedCount       uint64                         `json:"missed_count"`
+	OrphanedCount     uint64                         `json:"orphaned_count"`
+}
+
+type SlotPageMissedOrphanedBlock struct {
+	BlockRoot    []byte `json:"block_root"`
+	Proposer     uint64 `json:"proposer"`
+	ProposerName string `json:"proposer_name"`
+	Graffiti     []byte `json:"graffiti"`
+}
+
+type SlotPageMissedProposal struct {
+	Slot      uint64    `json:"slot"`
+	Ts        time.Time `json:"ts"`
+	Status    uint16    `json:"status"`
+	BlockRoot []byte    `json:"block_root"`
+	Current   bool      `json:"current"`
 }
 
 type SlotPageBlockBadge struct {
@@ -62,6 +92,9 @@ type SlotPageBlockData struct {
 	SlashingsCount             uint64                 `json:"slashings_count"`
 	BlobsCount                 uint64                 `json:"blobs_count"`
 	TransactionsCount          uint64                 `json:"transactions_count"`
+	BlobTransactionsCount      uint64                 `json:"blob_transactions_count"`
+	BlobTransactionsSize       uint64                 `json:"blob_transactions_size"`
+	BlobsPerTransaction        []*SlotPageBlobTxDist  `json:"blobs_per_transaction"`
 	DepositRequestsCount       uint64                 `json:"deposit_receipts_count"`
 	WithdrawalRequestsCount    uint64                 `json:"withdrawal_requests_count"`
 	ConsolidationRequestsCount uint64                 `json:"consolidation_requests_count"`
@@ -96,6 +129,21 @@ type SlotPageExecutionData struct {
 	BaseFeePerGas uint64    `json:"base_fee_per_gas"`
 	BlockHash     []byte    `json:"block_hash"`
 	BlockNumber   uint64    `json:"block_number"`
+
+	ShowBuilder   bool     `json:"show_builder"`
+	MevRelayed    bool     `json:"mev_relayed"`
+	MevRelays     []string `json:"mev_relays"`
+	MevBlockValue uint64   `json:"mev_block_value"`
+	MevBuilder    []byte   `json:"mev_builder"`
+
+	ContractCreations uint64              `json:"contract_creations"`
+	TopTargets        []*SlotPageTxTarget `json:"top_targets"`
+}
+
+type SlotPageTxTarget struct {
+	Address  []byte `json:"address"`
+	TxCount  uint64 `json:"tx_count"`
+	GasLimit uint64 `json:"gas_limit"`
 }
 
 type SlotPageAttestation struct {
@@ -121,6 +169,13 @@ type SlotPageDeposit struct {
 	Withdrawalcredentials []byte `json:"withdrawalcredentials"`
 	Amount                uint64 `json:"amount"`
 	Signature             []byte `json:"signature"`
+	HasDepositTx          bool   `json:"has_deposit_tx"`
+	DepositIndex          uint64 `json:"deposit_index,omitempty"`
+	ValidSignature        bool   `json:"valid_signature,omitempty"`
+	TxHash                []byte `json:"tx_hash,omitempty"`
+	TxSender              []byte `json:"tx_sender,omitempty"`
+	TxBlockNumber         uint64 `json:"tx_block_number,omitempty"`
+	TxOrphaned            bool   `json:"tx_orphaned,omitempty"`
 }
 
 type SlotPageVoluntaryExit struct {
@@ -215,6 +270,13 @@ type SlotPageTransaction struct {
 	FuncName      string  `json:"func_name"`
 	FuncSig       string  `json:"func_sig"`
 	Type          uint64  `json:"type"`
+	Size          uint64  `json:"size"`
+	BlobCount     uint64  `json:"blob_count"`
+}
+
+type SlotPageBlobTxDist struct {
+	BlobCount uint64 `json:"blob_count"`
+	TxCount   uint64 `json:"tx_count"`
 }
 
 type SlotPageDepositRequest struct {
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"time"
+)
+
+// SlotMevPageData is a struct to hold info for the block production flow page
+type SlotMevPageData struct {
+	Slot          uint64    `json:"slot"`
+	Epoch         uint64    `json:"epoch"`
+	BlockRoot     []byte    `json:"block_root"`
+	Ts            time.Time `json:"ts"`
+	Orphaned      bool      `json:"orphaned"`
+	HasBlock      bool      `json:"has_block"`
+	BlockHash     []byte    `json:"block_hash"`
+	ProposerIndex uint64    `json:"proposer_index"`
+	ProposerName  string    `json:"proposer_name"`
+	HasProposer   bool      `json:"has_proposer"`
+
+	MevRelayed       bool     `json:"mev_relayed"`
+	DeliveringRelays []string `json:"delivering_relays"`
+	WinningValue     uint64   `json:"winning_value"`
+	WinningBuilder   []byte   `json:"winning_builder"`
+	HasWinningBid    bool     `json:"has_winning_bid"`
+	WinningBidOffset int64    `json:"winning_bid_offset"`
+	HigherBidsAfter  uint64   `json:"higher_bids_after"`
+	BidCount         uint64   `json:"bid_count"`
+	TopBidValue      uint64   `json:"top_bid_value"`
+	LastBidOffset    int64    `json:"last_bid_offset"`
+
+	Relays       []*SlotMevPageDataRelay `json:"relays"`
+	TopBids      []*SlotMevPageDataBid   `json:"top_bids"`
+	TopBidsLimit uint64                  `json:"top_bids_limit"`
+
+	ChartWidth     uint64                      `json:"chart_width"`
+	ChartHeight    uint64                      `json:"chart_height"`
+	ChartMinOffset int64                       `json:"chart_min_offset"`
+	ChartMaxOffset int64                       `json:"chart_max_offset"`
+	ChartMaxValue  uint64                      `json:"chart_max_value"`
+	ChartSlotX     float64                     `json:"chart_slot_x"`
+	ChartWinX      float64                     `json:"chart_win_x"`
+	ChartWinY      float64                     `json:"chart_win_y"`
+	ChartLines     []*SlotMevPageDataChartLine `json:"chart_lines"`
+}
+
+type SlotMevPageDataRelay struct {
+	Name            string `json:"name"`
+	Color           string `json:"color"`
+	Error           string `json:"error"`
+	BidCount        uint64 `json:"bid_count"`
+	TopValue        uint64 `json:"top_value"`
+	FirstBidOffset  int64  `json:"first_bid_offset"`
+	LastBidOffset   int64  `json:"last_bid_offset"`
+	ReceivedWinning bool   `json:"received_winning"`
+	Delivered       bool   `json:"delivered"`
+}
+
+type SlotMevPageDataBid struct {
+	RelayName     string `json:"relay_name"`
+	Color         string `json:"color"`
+	Offset        int64  `json:"offset"`
+	Value         uint64 `json:"value"`
+	BlockHash     []byte `json:"block_hash"`
+	BuilderPubkey []byte `json:"builder_pubkey"`
+	Optimistic    bool   `json:"optimistic"`
+	IsWinning     bool   `json:"is_winning"`
+}
+
+type SlotMevPageDataChartLine struct {
+	RelayName string `json:"relay_name"`
+	Color     string `json:"color"`
+	Points    string `json:"points"`
+}
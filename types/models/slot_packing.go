@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+)
+
+// SlotPackingPageData is a struct to hold info for the block packing simulation page
+type SlotPackingPageData struct {
+	Slot          uint64    `json:"slot"`
+	Epoch         uint64    `json:"epoch"`
+	BlockRoot     []byte    `json:"block_root"`
+	Ts            time.Time `json:"ts"`
+	Orphaned      bool      `json:"orphaned"`
+	Available     bool      `json:"available"`
+	ProposerIndex uint64    `json:"proposer_index"`
+	ProposerName  string    `json:"proposer_name"`
+	SourceBlocks  uint64    `json:"source_blocks"`
+
+	MaxAttestations           uint64  `json:"max_attestations"`
+	IncludedAttestations      uint64  `json:"included_attestations"`
+	IncludedVotes             uint64  `json:"included_votes"`
+	IncludedAttestationReward uint64  `json:"included_attestation_reward"`
+	OptimalAttestations       uint64  `json:"optimal_attestations"`
+	OptimalVotes              uint64  `json:"optimal_votes"`
+	OptimalAttestationReward  uint64  `json:"optimal_attestation_reward"`
+	IncludedSlashings         uint64  `json:"included_slashings"`
+	IncludedSlashingReward    uint64  `json:"included_slashing_reward"`
+	OptimalSlashings          uint64  `json:"optimal_slashings"`
+	OptimalSlashingReward     uint64  `json:"optimal_slashing_reward"`
+	IncludedExits             uint64  `json:"included_exits"`
+	IncludedReward            uint64  `json:"included_reward"`
+	OptimalReward             uint64  `json:"optimal_reward"`
+	MissedReward              uint64  `json:"missed_reward"`
+	PackingEfficiency         float64 `json:"packing_efficiency"`
+
+	MissedAttestationCount uint64                              `json:"missed_attestation_count"`
+	MissedAttestations     []*SlotPackingPageDataAttestation   `json:"missed_attestations"`
+	MissedSlashings        []*SlotPackingPageDataSlashing      `json:"missed_slashings"`
+	MissedExits            []*SlotPackingPageDataVoluntaryExit `json:"missed_exits"`
+}
+
+type SlotPackingPageDataAttestation struct {
+	Slot            uint64 `json:"slot"`
+	Committee       uint64 `json:"committee"`
+	BeaconBlockRoot []byte `json:"beacon_block_root"`
+	TargetRoot      []byte `json:"target_root"`
+	TimelySource    bool   `json:"timely_source"`
+	TimelyTarget    bool   `json:"timely_target"`
+	TimelyHead      bool   `json:"timely_head"`
+	VoteCount       uint64 `json:"vote_count"`
+	NewVotes        uint64 `json:"new_votes"`
+	Reward          uint64 `json:"reward"`
+	InOptimal       bool   `json:"in_optimal"`
+	SeenInSlot      uint64 `json:"seen_in_slot"`
+	SeenInRoot      []byte `json:"seen_in_root"`
+}
+
+type SlotPackingPageDataSlashing struct {
+	Type       string                          `json:"type"`
+	Validators []*SlotPackingPageDataValidator `json:"validators"`
+	Reward     uint64                          `json:"reward"`
+	InOptimal  bool                            `json:"in_optimal"`
+	SeenInSlot uint64                          `json:"seen_in_slot"`
+	SeenInRoot []byte                          `json:"seen_in_root"`
+}
+
+type SlotPackingPageDataVoluntaryExit struct {
+	ValidatorIndex uint64 `json:"validator_index"`
+	ValidatorName  string `json:"validator_name"`
+	Epoch          uint64 `json:"epoch"`
+	SeenInSlot     uint64 `json:"seen_in_slot"`
+	SeenInRoot     []byte `json:"seen_in_root"`
+}
+
+type SlotPackingPageDataValidator struct {
+	Index uint64 `json:"index"`
+	Name  string `json:"name"`
+}
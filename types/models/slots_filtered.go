@@ -12,6 +12,9 @@ type SlotsFilteredPageData struct {
 	FilterProposerName string `json:"filter_pname"`
 	FilterWithOrphaned uint8  `json:"filter_orphaned"`
 	FilterWithMissing  uint8  `json:"filter_missing"`
+	FilterFork         string `json:"filter_fork"`
+
+	ForkOptions []*SlotsFilteredPageDataFork `json:"fork_options"`
 
 	DisplayEpoch        bool   `json:"dp_epoch"`
 	DisplaySlot         bool   `json:"dp_slot"`
@@ -49,6 +52,11 @@ type SlotsFilteredPageData struct {
 	LastPageLink  string `json:"last_page_link"`
 }
 
+type SlotsFilteredPageDataFork struct {
+	Name  string `json:"name"`
+	Epoch uint64 `json:"epoch"`
+}
+
 type SlotsFilteredPageDataSlot struct {
 	Slot                  uint64    `json:"slot"`
 	Epoch                 uint64    `json:"epoch"`
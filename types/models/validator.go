@@ -2,6 +2,8 @@ package models
 
 import (
 	"time"
+
+	"github.com/ethpandaops/dora/types"
 )
 
 // ValidatorPageData is a struct to hold info for the validator page
@@ -24,12 +26,16 @@ type ValidatorPageData struct {
 	WasActive                bool                                  `json:"was_active"`
 	UpcheckActivity          uint8                                 `json:"upcheck_act"`
 	UpcheckMaximum           uint8                                 `json:"upcheck_max"`
+	Apy                      []*ValidatorPageDataApy               `json:"apy"`
+	ProposalLuck             []*ValidatorPageDataLuck              `json:"proposal_luck"`
 	ShowExit                 bool                                  `json:"show_exit"`
 	ExitTs                   time.Time                             `json:"exit_ts"`
 	ExitEpoch                uint64                                `json:"exit_epoch"`
 	WithdrawCredentials      []byte                                `json:"withdraw_credentials"`
 	ShowWithdrawAddress      bool                                  `json:"show_withdraw_address"`
 	WithdrawAddress          []byte                                `json:"withdraw_address"`
+	ShowNextWithdrawal       bool                                  `json:"show_next_withdrawal"`
+	NextWithdrawalTs         time.Time                             `json:"next_withdrawal_ts"`
 	ExitReason               string                                `json:"exit_reason"`
 	ExitReasonSlot           uint64                                `json:"exit_reason_slot"`
 	ExitReasonSlashing       bool                                  `json:"exit_reason_slashing"`
@@ -41,6 +47,7 @@ type ValidatorPageData struct {
 	ExitReasonTargetName     string                                `json:"exit_reason_target_name"`
 	ExitReasonTxHash         []byte                                `json:"exit_reason_tx_hash"`
 	ExitReasonTxDetails      *ValidatorPageDataWithdrawalTxDetails `json:"exit_reason_tx_details"`
+	ExternalLinks            []*types.ExternalLink                 `json:"external_links"`
 
 	TabView         string `json:"tab_view"`
 	ElectraIsActive bool   `json:"electra_is_active"`
@@ -59,6 +66,61 @@ type ValidatorPageData struct {
 	WithdrawalRequests                  []*ValidatorPageDataWithdrawal    `json:"withdrawal_requests"`
 	WithdrawalRequestCount              uint64                            `json:"withdrawal_request_count"`
 	AdditionalWithdrawalRequestCount    uint64                            `json:"additional_withdrawal_request_count"`
+	DepositFrontrun                     bool                              `json:"deposit_frontrun"`
+	DepositFrontrunSender               []byte                            `json:"deposit_frontrun_sender"`
+	DepositFrontrunCredentials          []byte                            `json:"deposit_frontrun_credentials"`
+	DepositFrontrunCount                uint64                            `json:"deposit_frontrun_count"`
+	IncomeEnabled                       bool                              `json:"income_enabled"`
+	IncomePeriodEpochs                  uint64                            `json:"income_period_epochs"`
+	Income                              []*ValidatorPageDataIncome        `json:"income"`
+	IncomeChart                         string                            `json:"income_chart"`
+	IncomeChartZero                     float64                           `json:"income_chart_zero"`
+	IncomeTotal                         int64                             `json:"income_total"`
+	Notes                               []*ValidatorPageDataNote          `json:"notes"`
+	NoteCount                           uint64                            `json:"note_count"`
+	NoteTags                            []string                          `json:"note_tags"`
+	OwnershipProven                     bool                              `json:"ownership_proven"`
+	PrivateLabel                        string                            `json:"private_label"`
+}
+
+// ValidatorPageDataNote holds a note attached to the validator via the admin api
+type ValidatorPageDataNote struct {
+	Id        uint64    `json:"id"`
+	Note      string    `json:"note"`
+	Author    string    `json:"author"`
+	CreatedAt time.Time `json:"created_at"`
+	Tags      []string  `json:"tags"`
+}
+
+// ValidatorPageDataIncome holds the consensus layer income of the validator within an income period
+type ValidatorPageDataIncome struct {
+	Epoch             uint64    `json:"epoch"`
+	Time              time.Time `json:"time"`
+	AttestationReward uint64    `json:"attestation_reward"`
+	SyncReward        uint64    `json:"sync_reward"`
+	ProposalReward    uint64    `json:"proposal_reward"`
+	Penalty           uint64    `json:"penalty"`
+	NetIncome         int64     `json:"net_income"`
+}
+
+// ValidatorPageDataApy holds the annualized return of a validator within an apy window
+type ValidatorPageDataApy struct {
+	Window     string  `json:"window"`
+	HasApy     bool    `json:"has_apy"`
+	Apy        float64 `json:"apy"`
+	FirstEpoch uint64  `json:"first_epoch"`
+	LastEpoch  uint64  `json:"last_epoch"`
+}
+
+// ValidatorPageDataLuck holds the actual & expected proposals of a validator within a luck window
+type ValidatorPageDataLuck struct {
+	Window     string  `json:"window"`
+	HasLuck    bool    `json:"has_luck"`
+	Proposals  uint64  `json:"proposals"`
+	Expected   float64 `json:"expected"`
+	Luck       float64 `json:"luck"`
+	FirstEpoch uint64  `json:"first_epoch"`
+	LastEpoch  uint64  `json:"last_epoch"`
 }
 
 type ValidatorPageDataBlock struct {
@@ -98,6 +160,7 @@ type ValidatorPageDataDeposit struct {
 	TxStatus        uint64                             `json:"tx_status"`
 	TxDetails       *ValidatorPageDataDepositTxDetails `json:"tx_details"`
 	TxHash          []byte                             `json:"tx_hash"`
+	Frontrun        bool                               `json:"frontrun"`
 }
 
 type ValidatorPageDataDepositTxDetails struct {
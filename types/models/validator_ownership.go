@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+)
+
+// ValidatorOwnershipPageData is a struct to hold info for the validator ownership proof page
+type ValidatorOwnershipPageData struct {
+	Challenge         []byte    `json:"challenge"`
+	Domain            []byte    `json:"domain"`
+	SigningRoot       []byte    `json:"signing_root"`
+	ChallengeValidTil time.Time `json:"challenge_valid_til"`
+
+	Error   string `json:"error"`
+	Message string `json:"message"`
+
+	Validators []*ValidatorOwnershipPageDataValidator `json:"validators"`
+}
+
+type ValidatorOwnershipPageDataValidator struct {
+	Index        uint64    `json:"index"`
+	Name         string    `json:"name"`
+	PublicKey    []byte    `json:"pubkey"`
+	State        string    `json:"state"`
+	ProvenAt     time.Time `json:"proven_at"`
+	PrivateLabel string    `json:"private_label"`
+}
@@ -11,6 +11,10 @@ type ValidatorsPageData struct {
 	FilterName       string                           `json:"filter_name"`
 	FilterStatus     string                           `json:"filter_status"`
 	FilterStatusOpts []ValidatorsPageDataStatusOption `json:"filter_status_opts"`
+	FilterExpr       string                           `json:"filter_expr"`
+	FilterExprError  string                           `json:"filter_expr_error,omitempty"`
+	FilterTag        string                           `json:"filter_tag"`
+	FilterTagOpts    []ValidatorsPageDataTagOption    `json:"filter_tag_opts"`
 
 	Validators        []*ValidatorsPageDataValidator `json:"validators"`
 	ValidatorCount    uint64                         `json:"validator_count"`
@@ -30,6 +34,16 @@ type ValidatorsPageData struct {
 	NextPageValIdx    uint64                         `json:"next_page_validx"`
 	LastPageValIdx    uint64                         `json:"last_page_validx"`
 	FilteredPageLink  string                         `json:"filtered_page_link"`
+	ApiLink           string                         `json:"api_link"`
+	FilteredCount     uint64                         `json:"filtered_count"`
+	ApyWindow         string                         `json:"apy_window"`
+	ApyWindows        []string                       `json:"apy_windows"`
+
+	IsHistoric             bool      `json:"is_historic"`
+	HistoricEpoch          uint64    `json:"historic_epoch,omitempty"`
+	HistoricTs             time.Time `json:"historic_ts,omitempty"`
+	HistoricBalances       bool      `json:"historic_balances,omitempty"`
+	HistoricSnapshotEpochs []uint64  `json:"historic_snapshot_epochs,omitempty"`
 }
 
 type ValidatorsPageDataStatusOption struct {
@@ -37,6 +51,11 @@ type ValidatorsPageDataStatusOption struct {
 	Count  uint64 `json:"count"`
 }
 
+type ValidatorsPageDataTagOption struct {
+	Tag   string `json:"tag"`
+	Count uint64 `json:"count"`
+}
+
 type ValidatorsPageDataValidator struct {
 	Index               uint64    `json:"index"`
 	Name                string    `json:"name"`
@@ -55,4 +74,6 @@ type ValidatorsPageDataValidator struct {
 	ExitEpoch           uint64    `json:"exit_epoch"`
 	ShowWithdrawAddress bool      `json:"show_withdraw_address"`
 	WithdrawAddress     []byte    `json:"withdraw_address"`
+	HasApy              bool      `json:"has_apy"`
+	Apy                 float64   `json:"apy"`
 }
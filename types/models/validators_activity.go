@@ -9,6 +9,7 @@ type ValidatorsActivityPageData struct {
 	FirstValidator uint64                            `json:"first_validx"`
 	LastValidator  uint64                            `json:"last_validx"`
 	Sorting        string                            `json:"sorting"`
+	LuckWindow     string                            `json:"luck_window"`
 
 	FirstGroup uint64 `json:"first_group"`
 	LastGroup  uint64 `json:"last_group"`
@@ -38,4 +39,9 @@ type ValidatorsActiviyPageDataGroup struct {
 	Offline    uint64 `json:"offline"`
 	Exited     uint64 `json:"exited"`
 	Slashed    uint64 `json:"slashed"`
+
+	HasLuck           bool    `json:"has_luck"`
+	Proposals         uint64  `json:"proposals"`
+	ExpectedProposals float64 `json:"expected_proposals"`
+	Luck              float64 `json:"luck"`
 }
@@ -0,0 +1,108 @@
+package models
+
+import (
+	"time"
+)
+
+// ValidatorWatchlistPageData is a struct to hold info for the validator watchlist page
+type ValidatorWatchlistPageData struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+
+	AttestationLookback uint64                            `json:"attestation_lookback"`
+	Lists               []*ValidatorWatchlistPageDataList `json:"lists"`
+
+	WebhooksEnabled bool     `json:"webhooks_enabled"`
+	WebhookEvents   []string `json:"webhook_events"`
+}
+
+type ValidatorWatchlistPageDataList struct {
+	Name               string                                 `json:"name"`
+	CreatedAt          time.Time                              `json:"created_at"`
+	ActiveCount        uint64                                 `json:"active_count"`
+	TotalBalance       uint64                                 `json:"total_balance"`
+	MissedAttestations uint64                                 `json:"missed_attestations"`
+	UpcomingProposals  uint64                                 `json:"upcoming_proposals"`
+	Validators         []*ValidatorWatchlistPageDataValidator `json:"validators"`
+	Webhooks           []*ValidatorWatchlistPageDataWebhook   `json:"webhooks"`
+}
+
+type ValidatorWatchlistPageDataWebhook struct {
+	Url           string    `json:"url"`
+	Events        []string  `json:"events"`
+	OfflineEpochs uint64    `json:"offline_epochs"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type ValidatorWatchlistPageDataValidator struct {
+	Index              uint64                                `json:"index"`
+	Name               string                                `json:"name"`
+	PublicKey          []byte                                `json:"pubkey"`
+	State              string                                `json:"state"`
+	Balance            uint64                                `json:"balance"`
+	EffectiveBalance   uint64                                `json:"eff_balance"`
+	ShowUpcheck        bool                                  `json:"show_upcheck"`
+	UpcheckActivity    uint8                                 `json:"upcheck_act"`
+	UpcheckMaximum     uint8                                 `json:"upcheck_max"`
+	AttestationEpochs  uint64                                `json:"attestation_epochs"`
+	MissedAttestations uint64                                `json:"missed_attestations"`
+	Proposals          []*ValidatorWatchlistPageDataDutySlot `json:"proposals"`
+	HasAttestation     bool                                  `json:"has_attestation"`
+	Attestation        *ValidatorWatchlistPageDataDutySlot   `json:"attestation"`
+	SyncCommittee      bool                                  `json:"sync_committee"`
+}
+
+type ValidatorWatchlistPageDataDutySlot struct {
+	Slot uint64    `json:"slot"`
+	Time time.Time `json:"time"`
+}
+
+// ApiValidatorWatchlistsResponse is the response of the watchlists api, with the status of all watched validators
+type ApiValidatorWatchlistsResponse struct {
+	Watchlists []*ApiValidatorWatchlist `json:"watchlists"`
+}
+
+type ApiValidatorWatchlist struct {
+	Name       string                            `json:"name"`
+	CreatedAt  int64                             `json:"created_at"`
+	Validators []*ApiValidatorWatchlistValidator `json:"validators"`
+	Webhooks   []*ApiValidatorWatchlistWebhook   `json:"webhooks,omitempty"`
+}
+
+type ApiValidatorWatchlistValidator struct {
+	Index              uint64   `json:"index"`
+	Name               string   `json:"name"`
+	PublicKey          string   `json:"pubkey"`
+	Status             string   `json:"status"`
+	Balance            uint64   `json:"balance"`
+	EffectiveBalance   uint64   `json:"effective_balance"`
+	AttestationEpochs  uint64   `json:"attestation_epochs"`
+	MissedAttestations uint64   `json:"missed_attestations"`
+	UpcomingProposals  []uint64 `json:"upcoming_proposals"`
+	NextAttestation    *uint64  `json:"next_attestation"`
+	SyncCommittee      bool     `json:"sync_committee"`
+}
+
+// ApiValidatorWatchlistRequest is the body of watchlist updates, validators can be given by index or pubkey
+type ApiValidatorWatchlistRequest struct {
+	Validators []string `json:"validators"`
+}
+
+// ApiValidatorWatchlistWebhookRequest is the body of webhook registrations, the default events are used if no events are given
+type ApiValidatorWatchlistWebhookRequest struct {
+	Url           string   `json:"url"`
+	Events        []string `json:"events"`
+	OfflineEpochs uint64   `json:"offline_epochs"`
+}
+
+type ApiValidatorWatchlistWebhook struct {
+	Url           string   `json:"url"`
+	Events        []string `json:"events"`
+	OfflineEpochs uint64   `json:"offline_epochs"`
+	CreatedAt     int64    `json:"created_at"`
+}
+
+type ApiValidatorWatchlistUpdateResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+)
+
+// assetHashLength is the number of hex chars of the content hash that are added to fingerprinted asset file names.
+const assetHashLength = 10
+
+var assetFingerprints = struct {
+	mutex    sync.RWMutex
+	byPath   map[string]string // asset path -> fingerprinted path
+	byHashed map[string]string // fingerprinted path -> asset path
+}{
+	byPath:   map[string]string{},
+	byHashed: map[string]string{},
+}
+
+// RegisterAssetFS computes the content hashes of all files in the embedded file system, which is served below urlPrefix.
+// registered assets are referenced by their fingerprinted path (e.g. /css/layout.1a2b3c4d5e.css) in the templates.
+func RegisterAssetFS(urlPrefix string, fsys fs.FS) error {
+	urlPrefix = strings.TrimSuffix(urlPrefix, "/")
+
+	return fs.WalkDir(fsys, ".", func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		content, err := fs.ReadFile(fsys, filePath)
+		if err != nil {
+			return err
+		}
+		hash := sha256.Sum256(content)
+
+		assetPath := urlPrefix + "/" + filePath
+		ext := path.Ext(assetPath)
+		hashedPath := strings.TrimSuffix(assetPath, ext) + "." + hex.EncodeToString(hash[:])[:assetHashLength] + ext
+
+		assetFingerprints.mutex.Lock()
+		assetFingerprints.byPath[assetPath] = hashedPath
+		assetFingerprints.byHashed[hashedPath] = assetPath
+		assetFingerprints.mutex.Unlock()
+		return nil
+	})
+}
+
+// GetAssetUrl returns the url of a static asset for use in templates.
+// registered assets are referenced by their fingerprinted path and served from the asset cdn, if configured.
+func GetAssetUrl(assetPath string) string {
+	assetFingerprints.mutex.RLock()
+	hashedPath, found := assetFingerprints.byPath[assetPath]
+	assetFingerprints.mutex.RUnlock()
+	if !found {
+		return assetPath
+	}
+
+	return strings.TrimSuffix(Config().Frontend.AssetCdnUrl, "/") + hashedPath
+}
+
+// GetAssetBaseUrl returns the base url that static assets are served from (the asset cdn, if configured).
+// it is used for assets that are referenced relative to other assets (e.g. the webfonts of the stylesheets).
+func GetAssetBaseUrl() string {
+	assetFingerprints.mutex.RLock()
+	registered := len(assetFingerprints.byPath) > 0
+	assetFingerprints.mutex.RUnlock()
+	if !registered {
+		return ""
+	}
+
+	return strings.TrimSuffix(Config().Frontend.AssetCdnUrl, "/")
+}
+
+// ResolveAssetPath returns the asset path for a fingerprinted path.
+func ResolveAssetPath(hashedPath string) (string, bool) {
+	assetFingerprints.mutex.RLock()
+	defer assetFingerprints.mutex.RUnlock()
+
+	assetPath, found := assetFingerprints.byHashed[hashedPath]
+	return assetPath, found
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"sync/atomic"
 
 	"github.com/kelseyhightower/envconfig"
 	"gopkg.in/yaml.v3"
@@ -12,8 +13,22 @@ import (
 	"github.com/ethpandaops/dora/types"
 )
 
-// Config is the globally accessible configuration
-var Config *types.Config
+// the globally accessible configuration, swapped as a whole by ReloadConfig
+var activeConfig atomic.Pointer[types.Config]
+
+// Config returns the globally accessible configuration.
+// the returned config must not be modified, re-read it instead of keeping references to pick up reloaded settings.
+func Config() *types.Config {
+	return activeConfig.Load()
+}
+
+// SetConfig replaces the globally accessible configuration.
+func SetConfig(cfg *types.Config) {
+	activeConfig.Store(cfg)
+}
+
+// path of the last loaded config file, used by ReloadConfig
+var configPath string
 
 // ReadConfig will process a configuration
 func ReadConfig(cfg *types.Config, path string) error {
@@ -21,8 +36,12 @@ func ReadConfig(cfg *types.Config, path string) error {
 	if err != nil {
 		return err
 	}
+	configPath = path
 
-	readConfigEnv(cfg)
+	err = readConfigEnv(cfg)
+	if err != nil {
+		return fmt.Errorf("error processing config env variables: %v", err)
+	}
 
 	// endpoints
 	if cfg.BeaconApi.Endpoints == nil && cfg.BeaconApi.Endpoint != "" {
@@ -67,7 +86,7 @@ func ReadConfig(cfg *types.Config, path string) error {
 		}
 	}
 
-	return nil
+	return ValidateConfig(cfg)
 }
 
 func readConfigFile(cfg *types.Config, path string) error {
@@ -92,3 +111,47 @@ func readConfigFile(cfg *types.Config, path string) error {
 func readConfigEnv(cfg *types.Config) error {
 	return envconfig.Process("", cfg)
 }
+
+// ReloadConfig re-reads the config file and applies the settings that are evaluated at runtime (site settings, feature toggles, api tokens,
+// notification rules, screenshot & status snapshot settings and database maintenance windows).
+// all other settings (endpoints, database, indexer & webserver settings) are kept and require a restart to change.
+func ReloadConfig() error {
+	cfg := &types.Config{}
+	if err := ReadConfig(cfg, configPath); err != nil {
+		return err
+	}
+
+	current := Config()
+	reloaded := *current
+	reloaded.Chain.DisplayName = cfg.Chain.DisplayName
+
+	reloaded.Frontend = cfg.Frontend
+	reloaded.Frontend.Enabled = current.Frontend.Enabled
+	reloaded.Frontend.Debug = current.Frontend.Debug
+	reloaded.Frontend.Pprof = current.Frontend.Pprof
+	reloaded.Frontend.Minify = current.Frontend.Minify
+	reloaded.Frontend.HttpReadTimeout = current.Frontend.HttpReadTimeout
+	reloaded.Frontend.HttpWriteTimeout = current.Frontend.HttpWriteTimeout
+	reloaded.Frontend.HttpIdleTimeout = current.Frontend.HttpIdleTimeout
+	reloaded.Frontend.PeerGeoIpDatabase = current.Frontend.PeerGeoIpDatabase
+
+	reloaded.ValidatorMetrics.AuthToken = cfg.ValidatorMetrics.AuthToken
+	reloaded.AdminApi.Tokens = cfg.AdminApi.Tokens
+
+	// the services are started on startup only, so their feature toggles are kept
+	reloaded.Notifications = cfg.Notifications
+	reloaded.Notifications.Enabled = current.Notifications.Enabled
+	reloaded.Notifications.WatchlistWebhooks = current.Notifications.WatchlistWebhooks
+
+	reloaded.Screenshots = cfg.Screenshots
+	reloaded.Screenshots.Enabled = current.Screenshots.Enabled
+
+	reloaded.StatusSnapshot = cfg.StatusSnapshot
+	reloaded.StatusSnapshot.Enabled = current.StatusSnapshot.Enabled
+
+	reloaded.Database.Maintenance = cfg.Database.Maintenance
+	reloaded.Database.Maintenance.Enabled = current.Database.Maintenance.Enabled
+
+	SetConfig(&reloaded)
+	return nil
+}
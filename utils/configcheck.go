@@ -0,0 +1,292 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ethpandaops/dora/types"
+)
+
+// ConfigErrors is the list of problems found while validating a config.
+type ConfigErrors []string
+
+func (errs ConfigErrors) Error() string {
+	return fmt.Sprintf("invalid config:\n  - %v", strings.Join(errs, "\n  - "))
+}
+
+// configChecker collects validation errors with the yaml path of the affected setting.
+type configChecker struct {
+	errors ConfigErrors
+}
+
+func (cc *configChecker) fail(key string, format string, args ...any) {
+	cc.errors = append(cc.errors, fmt.Sprintf("%v: %v", key, fmt.Sprintf(format, args...)))
+}
+
+func (cc *configChecker) checkDuration(key string, value time.Duration) {
+	if value < 0 {
+		cc.fail(key, "must not be negative (got %v)", value)
+	}
+}
+
+func (cc *configChecker) checkNotNegative(key string, value int) {
+	if value < 0 {
+		cc.fail(key, "must not be negative (got %v)", value)
+	}
+}
+
+func (cc *configChecker) checkRange(key string, value int, min int, max int) {
+	if value < min || value > max {
+		cc.fail(key, "must be between %v and %v (got %v)", min, max, value)
+	}
+}
+
+func (cc *configChecker) checkOneOf(key string, value string, allowed ...string) {
+	for _, option := range allowed {
+		if value == option {
+			return
+		}
+	}
+	cc.fail(key, "must be one of %v (got %q)", strings.Join(allowed, ", "), value)
+}
+
+func (cc *configChecker) checkLogLevel(key string, value string) {
+	if value == "" {
+		return
+	}
+	if _, err := logrus.ParseLevel(value); err != nil {
+		cc.fail(key, "unknown log level %q", value)
+	}
+}
+
+func (cc *configChecker) checkUrl(key string, value string) {
+	parsedUrl, err := url.Parse(value)
+	if err != nil {
+		cc.fail(key, "invalid url: %v", err)
+	} else if parsedUrl.Scheme == "" || parsedUrl.Host == "" {
+		cc.fail(key, "url must contain a scheme and host (got %q)", value)
+	}
+}
+
+func (cc *configChecker) checkAddress(key string, value string) {
+	if !common.IsHexAddress(value) {
+		cc.fail(key, "invalid address %q", value)
+	}
+}
+
+func (cc *configChecker) checkBlockRange(key string, fromBlock uint64, toBlock uint64) {
+	if toBlock > 0 && toBlock < fromBlock {
+		cc.fail(key, "toBlock (%v) must not be smaller than fromBlock (%v)", toBlock, fromBlock)
+	}
+}
+
+// ValidateConfig checks the value ranges & formats of all settings and returns all problems at once.
+func ValidateConfig(cfg *types.Config) error {
+	cc := &configChecker{}
+
+	cc.checkLogLevel("logging.outputLevel", cfg.Logging.OutputLevel)
+	cc.checkLogLevel("logging.fileLevel", cfg.Logging.FileLevel)
+	cc.checkNotNegative("logging.bufferLines", cfg.Logging.BufferLines)
+
+	// frontend
+	cc.checkDuration("frontend.validatorNamesRefreshInterval", cfg.Frontend.ValidatorNamesRefreshInterval)
+	cc.checkDuration("frontend.validatorNamesResolveInterval", cfg.Frontend.ValidatorNamesResolveInterval)
+	cc.checkDuration("frontend.pageCallTimeout", cfg.Frontend.PageCallTimeout)
+	cc.checkDuration("frontend.slowRenderThreshold", cfg.Frontend.SlowRenderThreshold)
+	cc.checkDuration("frontend.httpReadTimeout", cfg.Frontend.HttpReadTimeout)
+	cc.checkDuration("frontend.httpWriteTimeout", cfg.Frontend.HttpWriteTimeout)
+	cc.checkDuration("frontend.httpIdleTimeout", cfg.Frontend.HttpIdleTimeout)
+	if cfg.Frontend.AssetCdnUrl != "" {
+		cc.checkUrl("frontend.assetCdnUrl", cfg.Frontend.AssetCdnUrl)
+	}
+	for idx, link := range cfg.Frontend.ExternalLinks {
+		key := fmt.Sprintf("frontend.externalLinks[%v]", idx)
+		if link.Name == "" {
+			cc.fail(key+".name", "must not be empty")
+		}
+		cc.checkOneOf(key+".page", link.Page, "slot", "validator")
+		cc.checkUrl(key+".url", link.Url)
+	}
+	cc.checkRange("frontend.peerIpv4Prefix", cfg.Frontend.PeerIpv4Prefix, 0, 32)
+	cc.checkRange("frontend.peerIpv6Prefix", cfg.Frontend.PeerIpv6Prefix, 0, 128)
+	if cfg.RateLimit.Enabled && cfg.RateLimit.Rate == 0 {
+		cc.fail("rateLimit.rate", "must be greater than 0 when the rate limit is enabled")
+	}
+
+	// clients
+	for idx, endpoint := range cfg.BeaconApi.Endpoints {
+		cc.checkUrl(fmt.Sprintf("beaconapi.endpoints[%v].url", idx), endpoint.Url)
+	}
+	cc.checkNotNegative("beaconapi.localCacheSize", cfg.BeaconApi.LocalCacheSize)
+	cc.checkNotNegative("beaconapi.assignmentsCacheSize", cfg.BeaconApi.AssignmentsCacheSize)
+
+	for idx, endpoint := range cfg.ExecutionApi.Endpoints {
+		cc.checkUrl(fmt.Sprintf("executionapi.endpoints[%v].url", idx), endpoint.Url)
+	}
+	cc.checkNotNegative("executionapi.logBatchSize", cfg.ExecutionApi.LogBatchSize)
+	cc.checkNotNegative("executionapi.logConcurrency", cfg.ExecutionApi.LogConcurrency)
+	cc.checkNotNegative("executionapi.depositDeployBlock", cfg.ExecutionApi.DepositDeployBlock)
+	cc.checkNotNegative("executionapi.electraDeployBlock", cfg.ExecutionApi.ElectraDeployBlock)
+	for idx, contract := range cfg.ExecutionApi.DepositContracts {
+		key := fmt.Sprintf("executionapi.depositContracts[%v]", idx)
+		cc.checkAddress(key+".address", contract.Address)
+		cc.checkBlockRange(key, contract.FromBlock, contract.ToBlock)
+	}
+	for idx, contract := range cfg.ExecutionApi.WatchedContracts {
+		key := fmt.Sprintf("executionapi.watchedContracts[%v]", idx)
+		if contract.Name == "" {
+			cc.fail(key+".name", "must not be empty")
+		}
+		cc.checkAddress(key+".address", contract.Address)
+		cc.checkBlockRange(key, contract.FromBlock, contract.ToBlock)
+	}
+
+	// indexers
+	cc.checkOneOf("indexer.blockCompression", cfg.Indexer.BlockCompression, "", "zstd", "zlib")
+	cc.checkDuration("txsig.lookupInterval", cfg.TxSignature.LookupInterval)
+	cc.checkDuration("txsig.recheckTimeout", cfg.TxSignature.RecheckTimeout)
+	cc.checkDuration("mevIndexer.refreshInterval", cfg.MevIndexer.RefreshInterval)
+	for idx, relay := range cfg.MevIndexer.Relays {
+		cc.checkUrl(fmt.Sprintf("mevIndexer.relays[%v].url", idx), relay.Url)
+	}
+	cc.checkOneOf("blobIndexer.storage", cfg.BlobIndexer.Storage, "", "db", "fs")
+	if cfg.BlobIndexer.Storage == "fs" && cfg.BlobIndexer.StoragePath == "" {
+		cc.fail("blobIndexer.storagePath", "must not be empty when using the fs storage")
+	}
+
+	if cfg.GraphqlApi.MaxDepth < 0 {
+		cc.fail("graphqlApi.maxDepth", "must not be negative")
+	}
+
+	// background services
+	if cfg.ShadowFork.Enabled {
+		cc.checkUrl("shadowFork.upstreamRpc", cfg.ShadowFork.UpstreamRpc)
+	}
+	cc.checkDuration("shadowFork.interval", cfg.ShadowFork.Interval)
+	cc.checkDuration("incomeIndexer.retention", cfg.IncomeIndexer.Retention)
+	cc.checkDuration("statusSnapshot.interval", cfg.StatusSnapshot.Interval)
+	cc.checkDuration("screenshots.timeout", cfg.Screenshots.Timeout)
+	if cfg.Screenshots.Enabled {
+		for idx, page := range cfg.Screenshots.Pages {
+			key := fmt.Sprintf("screenshots.pages[%v]", idx)
+			if page.Path == "" {
+				cc.fail(key+".path", "must not be empty")
+			}
+			cc.checkDuration(key+".interval", page.Interval)
+		}
+	}
+	if cfg.AdminApi.Enabled {
+		for idx, token := range cfg.AdminApi.Tokens {
+			key := fmt.Sprintf("adminApi.tokens[%v]", idx)
+			if token.Token == "" {
+				cc.fail(key+".token", "must not be empty")
+			}
+			for _, scope := range token.Scopes {
+				cc.checkOneOf(key+".scopes", scope, "read", "indexer", "reload", "notes")
+			}
+		}
+	}
+	cc.checkNotNegative("adminApi.auditLogSize", cfg.AdminApi.AuditLogSize)
+	if cfg.Notifications.Enabled {
+		channelNames := map[string]bool{}
+		for idx, channel := range cfg.Notifications.Channels {
+			key := fmt.Sprintf("notifications.channels[%v]", idx)
+			if channel.Name == "" {
+				cc.fail(key+".name", "must not be empty")
+			} else if channelNames[channel.Name] {
+				cc.fail(key+".name", "duplicate channel name %q", channel.Name)
+			}
+			channelNames[channel.Name] = true
+
+			cc.checkOneOf(key+".type", channel.Type, "webhook", "telegram", "smtp")
+			switch channel.Type {
+			case "webhook":
+				cc.checkUrl(key+".url", channel.Url)
+			case "telegram":
+				if channel.BotToken == "" || channel.ChatId == "" {
+					cc.fail(key, "botToken and chatId are required for telegram channels")
+				}
+			case "smtp":
+				if channel.SmtpHost == "" || channel.SmtpFrom == "" || len(channel.SmtpTo) == 0 {
+					cc.fail(key, "smtpHost, smtpFrom and smtpTo are required for smtp channels")
+				}
+				cc.checkRange(key+".smtpPort", channel.SmtpPort, 0, 65535)
+			}
+		}
+		for idx, rule := range cfg.Notifications.Rules {
+			key := fmt.Sprintf("notifications.rules[%v]", idx)
+			if rule.Name == "" {
+				cc.fail(key+".name", "must not be empty")
+			}
+			if len(rule.Events) == 0 {
+				cc.fail(key+".events", "at least one event is required")
+			}
+			for _, event := range rule.Events {
+				cc.checkOneOf(key+".events", event, "slashed", "offline", "exit", "proposal", "missed_proposal", "withdrawal", "withdrawal_request", "status_change")
+			}
+			for _, channelName := range rule.Channels {
+				if !channelNames[channelName] {
+					cc.fail(key+".channels", "unknown channel %q", channelName)
+				}
+			}
+		}
+	}
+
+	// database
+	cc.checkOneOf("database.engine", cfg.Database.Engine, "sqlite", "pgsql")
+	if cfg.Database.Engine == "sqlite" && cfg.Database.Sqlite.File == "" {
+		cc.fail("database.sqlite.file", "must not be empty when using the sqlite engine")
+	}
+	cc.checkDuration("database.maintenance.interval", cfg.Database.Maintenance.Interval)
+	if cfg.Database.Maintenance.Enabled && len(cfg.Database.Maintenance.Windows) == 0 {
+		cc.fail("database.maintenance.windows", "at least one window is required when the maintenance is enabled")
+	}
+
+	if len(cc.errors) > 0 {
+		return cc.errors
+	}
+	return nil
+}
+
+// GetUnknownConfigKeys decodes the config file strictly and returns the errors for settings that are not known to the explorer.
+// unknown settings are ignored on startup, so typos in setting names are silently dropped.
+func GetUnknownConfigKeys(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening config file %v: %v", path, err)
+	}
+	defer f.Close()
+
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+
+	cfg := &types.Config{}
+	err = decoder.Decode(cfg)
+	if err == nil {
+		return nil, nil
+	}
+
+	if typeErr, ok := err.(*yaml.TypeError); ok {
+		unknownKeys := make([]string, len(typeErr.Errors))
+		for idx, keyErr := range typeErr.Errors {
+			// strip the go type of the parent struct, it's not helpful for fixing the config
+			if typeIdx := strings.Index(keyErr, " in type "); typeIdx > 0 {
+				keyErr = keyErr[:typeIdx]
+			}
+			unknownKeys[idx] = keyErr
+		}
+		return unknownKeys, nil
+	}
+	return nil, fmt.Errorf("error decoding explorer config: %v", err)
+}
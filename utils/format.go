@@ -32,6 +32,10 @@ func FormatETHFromGweiShort(gwei uint64) string {
 	return fmt.Sprintf("%.4f", float64(gwei)/math.Pow10(9))
 }
 
+func FormatSignedETHFromGwei(gwei int64) string {
+	return fmt.Sprintf("%+.4f", float64(gwei)/math.Pow10(9)) + " ETH"
+}
+
 func FormatFullETHFromGwei(gwei uint64) string {
 	return fmt.Sprintf("%v ETH", uint64(float64(gwei)/math.Pow10(9)))
 }
@@ -68,6 +72,15 @@ func FormatAddCommas(n uint64) template.HTML {
 	return template.HTML(number)
 }
 
+func FormatSignedAddCommas(n int64) template.HTML {
+	if n < 0 {
+		return "-" + FormatAddCommas(uint64(-n))
+	} else if n > 0 {
+		return "+" + FormatAddCommas(uint64(n))
+	}
+	return "0"
+}
+
 func FormatBitlist(b []byte, v []types.NamedValidator) template.HTML {
 	p := bitfield.Bitlist(b)
 	return formatBits(p.BytesNoTrim(), int(p.Len()), v)
@@ -277,8 +290,8 @@ func trimAmount(amount *big.Int, unitDigits int, maxPreCommaDigitsBeforeTrim int
 
 func FormatEthBlockLink(blockNum uint64) template.HTML {
 	caption := FormatAddCommas(blockNum)
-	if Config.Frontend.EthExplorerLink != "" {
-		link, err := url.JoinPath(Config.Frontend.EthExplorerLink, "block", strconv.FormatUint(uint64(blockNum), 10))
+	if Config().Frontend.EthExplorerLink != "" {
+		link, err := url.JoinPath(Config().Frontend.EthExplorerLink, "block", strconv.FormatUint(uint64(blockNum), 10))
 		if err == nil {
 			return template.HTML(fmt.Sprintf(`<a href="%v">%v</a>`, link, caption))
 		}
@@ -288,8 +301,8 @@ func FormatEthBlockLink(blockNum uint64) template.HTML {
 
 func FormatEthBlockHashLink(blockHash []byte) template.HTML {
 	caption := fmt.Sprintf("0x%x", blockHash)
-	if Config.Frontend.EthExplorerLink != "" {
-		link, err := url.JoinPath(Config.Frontend.EthExplorerLink, "block", caption)
+	if Config().Frontend.EthExplorerLink != "" {
+		link, err := url.JoinPath(Config().Frontend.EthExplorerLink, "block", caption)
 		if err == nil {
 			return template.HTML(fmt.Sprintf(`<a href="%v">%v</a>`, link, caption))
 		}
@@ -299,8 +312,8 @@ func FormatEthBlockHashLink(blockHash []byte) template.HTML {
 
 func FormatEthAddressLink(address []byte) template.HTML {
 	caption := common.BytesToAddress(address).String()
-	if Config.Frontend.EthExplorerLink != "" {
-		link, err := url.JoinPath(Config.Frontend.EthExplorerLink, "address", caption)
+	if Config().Frontend.EthExplorerLink != "" {
+		link, err := url.JoinPath(Config().Frontend.EthExplorerLink, "address", caption)
 		if err == nil {
 			return template.HTML(fmt.Sprintf(`<a href="%v">%v</a>`, link, caption))
 		}
@@ -315,8 +328,8 @@ func FormatEthTransactionLink(hash []byte, width uint64) template.HTML {
 		caption = caption[:width] + "…"
 	}
 
-	if Config.Frontend.EthExplorerLink != "" {
-		link, err := url.JoinPath(Config.Frontend.EthExplorerLink, "tx", txhash)
+	if Config().Frontend.EthExplorerLink != "" {
+		link, err := url.JoinPath(Config().Frontend.EthExplorerLink, "tx", txhash)
 		if err == nil {
 			return template.HTML(fmt.Sprintf(`<a href="%v">%v</a>`, link, caption))
 		}
@@ -405,8 +418,8 @@ func FormatWithdawalCredentials(hash []byte) template.HTML {
 		return "INVALID CREDENTIALS"
 	}
 
-	if hash[0] == 0x01 && Config.Frontend.EthExplorerLink != "" {
-		link, err := url.JoinPath(Config.Frontend.EthExplorerLink, "address", fmt.Sprintf("0x%x", hash[12:]))
+	if hash[0] == 0x01 && Config().Frontend.EthExplorerLink != "" {
+		link, err := url.JoinPath(Config().Frontend.EthExplorerLink, "address", fmt.Sprintf("0x%x", hash[12:]))
 		if err == nil {
 			return template.HTML(fmt.Sprintf(`<a href="%v">%v</a>`, link, formatWithdrawalHash(hash)))
 		}
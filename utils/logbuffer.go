@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LogBufferHook keeps the most recent log lines in memory, so they can be attached to diagnostic bundles.
+type LogBufferHook struct {
+	mutex    sync.Mutex
+	lines    []string
+	next     int
+	full     bool
+	capacity int
+}
+
+var logBuffer *LogBufferHook
+
+func newLogBufferHook(capacity int) *LogBufferHook {
+	return &LogBufferHook{
+		lines:    make([]string, capacity),
+		capacity: capacity,
+	}
+}
+
+// Fire will be called when some logging function is called with current hook
+func (hook *LogBufferHook) Fire(entry *logrus.Entry) error {
+	line, err := entry.String()
+	if err != nil {
+		return err
+	}
+
+	hook.mutex.Lock()
+	defer hook.mutex.Unlock()
+
+	hook.lines[hook.next] = line
+	hook.next++
+	if hook.next >= hook.capacity {
+		hook.next = 0
+		hook.full = true
+	}
+	return nil
+}
+
+func (hook *LogBufferHook) Levels() []logrus.Level {
+	return getLogLevels(logrus.DebugLevel)
+}
+
+// GetRecentLogLines returns the buffered log lines in chronological order.
+// returns nil if log buffering is disabled.
+func GetRecentLogLines() []string {
+	if logBuffer == nil {
+		return nil
+	}
+
+	logBuffer.mutex.Lock()
+	defer logBuffer.mutex.Unlock()
+
+	if !logBuffer.full {
+		lines := make([]string, logBuffer.next)
+		copy(lines, logBuffer.lines[:logBuffer.next])
+		return lines
+	}
+
+	lines := make([]string, 0, logBuffer.capacity)
+	lines = append(lines, logBuffer.lines[logBuffer.next:]...)
+	lines = append(lines, logBuffer.lines[:logBuffer.next]...)
+	return lines
+}
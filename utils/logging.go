@@ -25,8 +25,8 @@ func InitLogger() (*LogWriter, logrus.FieldLogger) {
 	logWriter := &LogWriter{}
 
 	outputLevel := getLogLevels(logrus.InfoLevel)
-	if Config.Logging.OutputLevel != "" {
-		levelParts := strings.Split(Config.Logging.OutputLevel, "|")
+	if Config().Logging.OutputLevel != "" {
+		levelParts := strings.Split(Config().Logging.OutputLevel, "|")
 		if len(levelParts) > 1 {
 			outputLevel = []logrus.Level{}
 			for _, level := range levelParts {
@@ -46,7 +46,7 @@ func InitLogger() (*LogWriter, logrus.FieldLogger) {
 	}
 	if len(outputLevel) > 0 {
 		var writer io.Writer
-		if Config.Logging.OutputStderr {
+		if Config().Logging.OutputStderr {
 			writer = os.Stderr
 		} else {
 			writer = os.Stdout
@@ -57,10 +57,10 @@ func InitLogger() (*LogWriter, logrus.FieldLogger) {
 		})
 	}
 
-	if Config.Logging.FilePath != "" {
+	if Config().Logging.FilePath != "" {
 		fileLevel := getLogLevels(logrus.InfoLevel)
-		if Config.Logging.FileLevel != "" {
-			levelParts := strings.Split(Config.Logging.FileLevel, "|")
+		if Config().Logging.FileLevel != "" {
+			levelParts := strings.Split(Config().Logging.FileLevel, "|")
 			if len(levelParts) > 1 {
 				fileLevel = []logrus.Level{}
 				for _, level := range levelParts {
@@ -79,10 +79,10 @@ func InitLogger() (*LogWriter, logrus.FieldLogger) {
 			}
 		}
 
-		fmt.Printf("logging to file: %v (%v)\n", Config.Logging.FilePath, fileLevel)
-		f, err := os.OpenFile(Config.Logging.FilePath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		fmt.Printf("logging to file: %v (%v)\n", Config().Logging.FilePath, fileLevel)
+		f, err := os.OpenFile(Config().Logging.FilePath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
 		if err != nil {
-			fmt.Println("Failed to create logfile" + Config.Logging.FilePath)
+			fmt.Println("Failed to create logfile" + Config().Logging.FilePath)
 			panic(err)
 		}
 		logWriter.logFile = f
@@ -92,6 +92,16 @@ func InitLogger() (*LogWriter, logrus.FieldLogger) {
 		})
 	}
 
+	if Config().Frontend.Pprof {
+		// buffer recent logs for the diagnostic bundle on the debug pages
+		bufferLines := Config().Logging.BufferLines
+		if bufferLines <= 0 {
+			bufferLines = 1000
+		}
+		logBuffer = newLogBufferHook(bufferLines)
+		logger.AddHook(logBuffer)
+	}
+
 	return logWriter, logger
 }
 
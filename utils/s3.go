@@ -0,0 +1,203 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// S3Target describes an object location on a s3 compatible storage.
+type S3Target struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	Key       string
+	AccessKey string
+	SecretKey string
+	PathStyle bool
+}
+
+// S3PutObject uploads the given data to the s3 target using a AWS signature v4 signed PUT request.
+func S3PutObject(ctx context.Context, target *S3Target, data []byte, contentType string) error {
+	resp, err := s3Request(ctx, target, http.MethodPut, target.Key, nil, data, contentType)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("s3 upload failed with status %v: %v", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// S3DeleteObject deletes the object of the s3 target.
+func S3DeleteObject(ctx context.Context, target *S3Target) error {
+	resp, err := s3Request(ctx, target, http.MethodDelete, target.Key, nil, nil, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("s3 delete failed with status %v: %v", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// S3ListObjects returns the keys of all objects in the bucket of the s3 target that start with the given prefix.
+func S3ListObjects(ctx context.Context, target *S3Target, prefix string) ([]string, error) {
+	keys := []string{}
+	continuationToken := ""
+
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+
+		resp, err := s3Request(ctx, target, http.MethodGet, "", query, nil, "")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 16*1024*1024))
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			if len(body) > 1024 {
+				body = body[:1024]
+			}
+			return nil, fmt.Errorf("s3 list failed with status %v: %v", resp.StatusCode, string(body))
+		}
+
+		result := struct {
+			Contents []struct {
+				Key string `xml:"Key"`
+			} `xml:"Contents"`
+			IsTruncated           bool   `xml:"IsTruncated"`
+			NextContinuationToken string `xml:"NextContinuationToken"`
+		}{}
+		if err := xml.Unmarshal(body, &result); err != nil {
+			return nil, fmt.Errorf("failed parsing s3 list response: %v", err)
+		}
+
+		for _, object := range result.Contents {
+			keys = append(keys, object.Key)
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return keys, nil
+		}
+		continuationToken = result.NextContinuationToken
+	}
+}
+
+// s3Request sends a AWS signature v4 signed request for the given object key (or the bucket if key is empty) to the s3 endpoint.
+func s3Request(ctx context.Context, target *S3Target, method string, key string, query url.Values, data []byte, contentType string) (*http.Response, error) {
+	endpoint := target.Endpoint
+	if endpoint == "" {
+		endpoint = "s3.amazonaws.com"
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	endpointUrl, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint: %v", err)
+	}
+
+	objectPath := "/" + strings.TrimPrefix(key, "/")
+	if target.PathStyle {
+		objectPath = "/" + target.Bucket + objectPath
+	} else {
+		endpointUrl.Host = target.Bucket + "." + endpointUrl.Host
+	}
+	endpointUrl.Path = objectPath
+
+	// the canonical query string needs sorted keys and %20 encoded spaces
+	canonicalQuery := strings.ReplaceAll(query.Encode(), "+", "%20")
+	endpointUrl.RawQuery = canonicalQuery
+
+	req, err := http.NewRequestWithContext(ctx, method, endpointUrl.String(), bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	region := target.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	now := time.Now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	shortDate := now.Format("20060102")
+	payloadHash := sha256Hex(data)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	// canonical request, see https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := fmt.Sprintf("host:%v\nx-amz-content-sha256:%v\nx-amz-date:%v\n", endpointUrl.Host, payloadHash, amzDate)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+		signedHeaders = "content-type;" + signedHeaders
+		canonicalHeaders = fmt.Sprintf("content-type:%v\n", contentType) + canonicalHeaders
+	}
+	canonicalRequest := strings.Join([]string{
+		method,
+		endpointUrl.EscapedPath(),
+		canonicalQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	credentialScope := fmt.Sprintf("%v/%v/s3/aws4_request", shortDate, region)
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		credentialScope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSha256([]byte("AWS4"+target.SecretKey), []byte(shortDate))
+	signingKey = hmacSha256(signingKey, []byte(region))
+	signingKey = hmacSha256(signingKey, []byte("s3"))
+	signingKey = hmacSha256(signingKey, []byte("aws4_request"))
+	signature := hex.EncodeToString(hmacSha256(signingKey, []byte(stringToSign)))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%v/%v, SignedHeaders=%v, Signature=%v", target.AccessKey, credentialScope, signedHeaders, signature))
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	return client.Do(req)
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSha256(key []byte, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
@@ -48,12 +48,16 @@ func GetTemplateFuncs() template.FuncMap {
 		"percent":                      func(i float64) float64 { return i * 100 },
 		"contains":                     strings.Contains,
 		"formatAddCommas":              FormatAddCommas,
+		"formatSignedAddCommas":        FormatSignedAddCommas,
 		"formatFloat":                  FormatFloat,
 		"formatBitlist":                FormatBitlist,
 		"formatBitvectorValidators":    formatBitvectorValidators,
 		"formatParticipation":          FormatParticipation,
 		"formatEthFromGwei":            FormatETHFromGwei,
 		"formatEthFromGweiShort":       FormatETHFromGweiShort,
+		"formatSignedEthFromGwei":      FormatSignedETHFromGwei,
+		"assetUrl":                     GetAssetUrl,
+		"assetBaseUrl":                 GetAssetBaseUrl,
 		"formatFullEthFromGwei":        FormatFullETHFromGwei,
 		"formatEthAddCommasFromGwei":   FormatETHAddCommasFromGwei,
 		"formatAmount":                 FormatAmount,